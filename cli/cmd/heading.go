@@ -153,5 +153,50 @@ var headingCmd = &cobra.Command{
 			}
 		}
 		w.Flush()
+
+		// Detailed target quotas rank their applicants separately, so their primary results are shown apart
+		for _, res := range primaryResultsForVarsity {
+			if res.Heading.Code() != targetHeading.Code() {
+				continue
+			}
+			subQuotas := res.SubQuotaResults()
+			if len(subQuotas) == 0 {
+				break
+			}
+
+			fmt.Println("\nDetailed target quotas:")
+			w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "Sub-quota\tAdmitted\tPassing Score\tLast Admitted Rating Place")
+			fmt.Fprintln(w, "---------\t--------\t-------------\t--------------------------")
+			for _, sq := range subQuotas {
+				psStr, larpStr := "N/A", "N/A"
+				if sq.Admitted > 0 {
+					psStr = strconv.Itoa(sq.PassingScore)
+					larpStr = "#" + strconv.Itoa(sq.LastAdmittedRatingPlace)
+				}
+				fmt.Fprintf(w, "%s\t%d/%d\t%s\t%s\n", sq.Name, sq.Admitted, sq.Capacity, psStr, larpStr)
+			}
+			w.Flush()
+			break
+		}
+
+		// Paid places are filled after the budget enrollment among the students left without a budget seat
+		if paid := targetHeading.Capacities().Paid; paid > 0 {
+			for _, res := range primaryResultsForVarsity {
+				if res.Heading.Code() != targetHeading.Code() {
+					continue
+				}
+
+				psStr, larpStr := "N/A", "N/A"
+				if ps, err := res.PaidPassingScore(); err == nil {
+					psStr = strconv.Itoa(ps)
+				}
+				if larp, err := res.PaidLastAdmittedRatingPlace(); err == nil {
+					larpStr = "#" + strconv.Itoa(larp)
+				}
+				fmt.Printf("\nPaid places: %d/%d admitted, passing score %s, last admitted rating place %s\n", len(res.PaidAdmitted), paid, psStr, larpStr)
+				break
+			}
+		}
 	},
 }
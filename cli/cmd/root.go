@@ -4,9 +4,11 @@ import (
 	"github.com/trueegorletov/analabit/cli/config"
 	"github.com/trueegorletov/analabit/cli/corestate"
 	"github.com/trueegorletov/analabit/cli/shell"
+	"github.com/trueegorletov/analabit/core"
 	"github.com/trueegorletov/analabit/core/drainer"
 	"github.com/trueegorletov/analabit/core/registry"
 	"github.com/trueegorletov/analabit/core/source"
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -14,7 +16,6 @@ import (
 	"time"
 
 	_ "github.com/lib/pq" // PostgreSQL driver
-	_ "github.com/trueegorletov/analabit/core/source/msu" // Initialize MSU support
 	"github.com/spf13/cobra"
 )
 
@@ -44,9 +45,6 @@ var rootCmd = &cobra.Command{
 		}
 		log.Println("Configuration loaded.")
 
-		// MSU support is automatically initialized via init() function
-		log.Println("MSU support initialized.")
-
 		if err := performCrawling(); err != nil {
 			corestate.InitError = fmt.Errorf("failed during crawling: %w", err)
 			log.Printf("rootCmd PersistentPreRunE: Error during crawling: %v", corestate.InitError) // New log
@@ -93,25 +91,54 @@ func init() {
 	rootCmd.AddCommand(headingsCmd)
 	rootCmd.AddCommand(headingCmd)
 	rootCmd.AddCommand(studentCmd)
+	rootCmd.AddCommand(traceCmd)
+	rootCmd.AddCommand(whatifCmd)
 	rootCmd.AddCommand(progressCmd)
 	rootCmd.AddCommand(uploadCmd)
 	log.Println("rootCmd init(): Finished") // New log
 }
 
 func performCrawling() error {
+	drainSeed := config.AppConfig.DrainSim.Seed
+	if drainSeed == 0 {
+		drainSeed = time.Now().UnixNano()
+	}
+
 	params := registry.CrawlOptions{
-		VarsitiesList:    config.AppConfig.Varsities.List,
-		VarsitiesExclude: config.AppConfig.Varsities.Excluded,
-		CacheDir:         config.AppConfig.Cache.Directory,
-		CacheTTLMinutes:  config.AppConfig.Cache.TTLMinutes,
-		DrainStages:      config.AppConfig.DrainSim.Stages,
-		DrainIterations:  config.AppConfig.DrainSim.Iterations,
+		VarsitiesList:      config.AppConfig.Varsities.List,
+		VarsitiesExclude:   config.AppConfig.Varsities.Excluded,
+		CacheDir:           config.AppConfig.Cache.Directory,
+		CacheTTLMinutes:    config.AppConfig.Cache.TTLMinutes,
+		DrainStages:        config.AppConfig.DrainSim.Stages,
+		DrainIterations:    config.AppConfig.DrainSim.Iterations,
+		DrainMaxIterations: config.AppConfig.DrainSim.MaxIterations,
+		DrainTolerance:     config.AppConfig.DrainSim.Tolerance,
+		DrainSeed:          drainSeed,
+		CacheFile:          config.AppConfig.Cache.File,
+		DrainModel:         config.AppConfig.DrainSim.Model,
+		DrainModels:        config.AppConfig.DrainSim.Models,
+		PreviousCacheFile:  config.AppConfig.Cache.PreviousFile,
+		HTTPRecord:         config.AppConfig.Cache.RecordHTTP,
+		HTTPReplayFile:     config.AppConfig.Cache.ReplayHTTPFile,
 	}
-	result, err := registry.CrawlWithOptions(registry.AllDefinitions, params)
+	result, err := registry.CrawlWithOptions(context.Background(), registry.AllDefinitions, params)
 	if err != nil {
 		return err
 	}
 	corestate.LoadedVarsities = result.LoadedVarsities
+	corestate.DrainSeed = params.DrainSeed
+	corestate.DrainModels = make(map[string]drainer.DrainModel, len(result.LoadedVarsities))
+	for _, v := range result.LoadedVarsities {
+		model, err := drainer.NewModel(params.DrainModelFor(v.Code), v, result.LoadedVarsities, result.PreviousVarsity(v.Code))
+		if err != nil {
+			return err
+		}
+		corestate.DrainModels[v.Code] = model
+	}
+	log.Printf("Drain simulations seed: %d, data file: %q", params.DrainSeed, result.DataFile)
+	if result.HTTPArchiveFile != "" {
+		log.Printf("HTTP exchanges of the crawl recorded into %q", result.HTTPArchiveFile)
+	}
 	return nil
 }
 
@@ -124,11 +151,31 @@ func performPrimaryCalculations() {
 		corestate.ResultsMutex.Unlock()
 	}()
 
+	if config.AppConfig.Calculation.CrossVarsity {
+		calculators := make([]*core.VarsityCalculator, 0, len(corestate.LoadedVarsities))
+		for _, v := range corestate.LoadedVarsities {
+			calculators = append(calculators, v.Clone().VarsityCalculator)
+		}
+		log.Printf("performPrimaryCalculations: Running cross-varsity calculation over %d varsities", len(calculators))
+		multiCalculator := core.NewMultiVarsityCalculator(calculators...)
+		multiCalculator.EnableTrace()
+		for code, results := range multiCalculator.CalculateAdmissions() {
+			corestate.PrimaryResults[code] = results
+		}
+		for i, v := range corestate.LoadedVarsities {
+			corestate.PrimaryTraces[v.Code] = calculators[i].Trace()
+		}
+		log.Println("performPrimaryCalculations: Finished.")
+		return
+	}
+
 	for i, v := range corestate.LoadedVarsities {
 		log.Printf("performPrimaryCalculations: Processing varsity %d/%d: %s (%s) - Before Clone", i+1, len(corestate.LoadedVarsities), v.Name, v.Code) // New log
 		clonedVarsity := v.Clone()
+		clonedVarsity.VarsityCalculator.EnableTrace()
 		log.Printf("performPrimaryCalculations: Processing varsity %s (%s) - After Clone, Before CalculateAdmissions", v.Name, v.Code) // New log
 		results := clonedVarsity.VarsityCalculator.CalculateAdmissions()
+		corestate.PrimaryTraces[v.Code] = clonedVarsity.VarsityCalculator.Trace()
 		log.Printf("performPrimaryCalculations: Processing varsity %s (%s) - After CalculateAdmissions, Before storing results", v.Name, v.Code) // New log
 		corestate.PrimaryResults[v.Code] = results
 		log.Printf("performPrimaryCalculations: Processing varsity %s (%s) - Results stored", v.Name, v.Code) // New log
@@ -171,8 +218,8 @@ func startDrainSimulations() {
 					defer wg.Done() // Decrement WaitGroup counter when this simulation task is done
 
 					// Drainer.New takes the prototype; its Run method clones it internally.
-					drainerInstance := drainer.New(v, s)
-					drainedResultSlice := drainerInstance.Run(config.AppConfig.DrainSim.Iterations)
+					drainerInstance := drainer.New(v, s, drainer.DeriveSeed(corestate.DrainSeed, v.Code, s), corestate.DrainModels[v.Code])
+					drainedResultSlice := drainerInstance.RunAdaptive(config.AppConfig.DrainSim.Iterations, config.AppConfig.DrainSim.MaxIterations, config.AppConfig.DrainSim.Tolerance)
 
 					corestate.ResultsMutex.Lock()
 					corestate.DrainedResults[v.Code][s] = drainedResultSlice // Store results
@@ -168,22 +168,20 @@ var studentCmd = &cobra.Command{
 					continue
 				}
 
-				// Find student's application and check against DrainedResult.AvgLastAdmittedRatingPlace
+				// Pick the heading the student was admitted to most often across the stage's iterations
 				admittedToHeadingName := "--"
-				studentApplications := studentObj.Applications() // ApplicationsCache are sorted by priority
-
-				for _, app := range studentApplications {
-					appRatingPlace := app.RatingPlace()
+				bestProbability := 0.0
+				for _, app := range studentObj.Applications() { // Applications are sorted by priority
 					for _, drainedRes := range resultsForStage {
-						if drainedRes.Heading.Code() == app.Heading().Code() {
-							if appRatingPlace <= drainedRes.AvgLastAdmittedRatingPlace {
-								admittedToHeadingName = drainedRes.Heading.PrettyName()
-								foundForStage = true
-								break
-							}
+						if drainedRes.Heading.Code() != app.Heading().Code() || drainedRes.Iterations == 0 {
+							continue
+						}
+						probability := float64(drainedRes.AdmittedCounts[studentID]) / float64(drainedRes.Iterations)
+						if probability > bestProbability {
+							bestProbability = probability
+							admittedToHeadingName = fmt.Sprintf("%s (%.0f%%)", drainedRes.Heading.PrettyName(), probability*100)
+							foundForStage = true
 						}
-					}
-					if admittedToHeadingName != "--" { // Found admission for this student in this varsity for this stage
 						break
 					}
 				}
@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"github.com/trueegorletov/analabit/cli/corestate"
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/utils"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+)
+
+var traceCmd = &cobra.Command{
+	Use:   "trace [student ID]",
+	Short: "Explains the primary admission results of a specific student step by step",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if !corestate.CrawlingDone {
+			fmt.Println("Crawling not yet complete. Please wait.")
+			return
+		}
+		corestate.ResultsMutex.RLock()
+		defer corestate.ResultsMutex.RUnlock()
+
+		studentID, err := utils.PrepareStudentID(strings.TrimSpace(args[0]))
+		if err != nil {
+			fmt.Printf("Error: Invalid student ID %q: %v\n", args[0], err)
+			return
+		}
+
+		found := false
+		for _, varsity := range corestate.LoadedVarsities { // Iterate in fixed order
+			trace, ok := corestate.PrimaryTraces[varsity.Code]
+			if !ok || trace == nil {
+				continue
+			}
+
+			explanation := core.ExplainAdmission(studentID, trace.StudentEvents(studentID))
+			if len(explanation.Events) == 0 {
+				continue
+			}
+			found = true
+
+			fmt.Printf("\n%s:\n", varsity.Name)
+			if explanation.AdmittedHeadingCode != "" {
+				fmt.Printf("Admitted to %s (priority %d)\n", headingName(varsity.VarsityCalculator, explanation.AdmittedHeadingCode), explanation.AdmittedPriority)
+			} else {
+				fmt.Println("Not admitted")
+			}
+
+			if len(explanation.Losses) > 0 {
+				fmt.Println("Higher-priority headings:")
+				w := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', tabwriter.Debug)
+				fmt.Fprintln(w, "Priority\tHeading\tCompetition\tOutcome\tLost to")
+				for _, loss := range explanation.Losses {
+					lostTo := loss.LostToStudentID
+					if lostTo == "" {
+						lostTo = "-- (no seats)"
+					}
+					fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\n", loss.Priority, headingName(varsity.VarsityCalculator, loss.HeadingCode), loss.CompetitionType, loss.Kind, lostTo)
+				}
+				w.Flush()
+			}
+
+			fmt.Println("Events:")
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', tabwriter.Debug)
+			fmt.Fprintln(w, "#\tStage\tEvent\tPriority\tHeading\tCompetition\tOther student")
+			for _, event := range explanation.Events {
+				other := event.OtherStudentID
+				if other == "" {
+					other = "--"
+				}
+				fmt.Fprintf(w, "%d\t%s\t%s\t%d\t%s\t%s\t%s\n", event.Seq, event.Stage, event.Kind, event.Priority, headingName(varsity.VarsityCalculator, event.HeadingCode), event.CompetitionType, other)
+			}
+			w.Flush()
+		}
+
+		if !found {
+			fmt.Printf("No admission events recorded for student ID: %s\n", studentID)
+		}
+	},
+}
+
+// headingName returns the pretty name of the heading with the given full code, or the code itself if it's unknown.
+func headingName(vc *core.VarsityCalculator, fullCode string) string {
+	for _, h := range vc.Headings() {
+		if h.FullCode() == fullCode {
+			return h.PrettyName()
+		}
+	}
+	return fullCode
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/trueegorletov/analabit/core"
 	"github.com/trueegorletov/analabit/core/database"
 	"github.com/trueegorletov/analabit/core/ent"
+	"github.com/trueegorletov/analabit/core/source"
 	"github.com/trueegorletov/analabit/core/upload"
 	"log"
 
@@ -79,30 +80,53 @@ var uploadCmd = &cobra.Command{
 			// Convert VarsityCalculator and results to UploadPayload
 			// We need to convert drained results to the right format for this varsity
 			var drainedDTOs map[int][]core.DrainedResultDTO
+			var chanceDTOs []core.AdmissionChanceDTO
 			corestate.ResultsMutex.RLock()
 			if stageMap, exists := corestate.DrainedResults[varsityCode]; exists {
 				drainedDTOs = make(map[int][]core.DrainedResultDTO)
 				for stage, drainedResults := range stageMap {
 					if len(drainedResults) > 0 {
 						drainedDTOs[stage] = drainer.NewDrainedResultDTOs(drainedResults)
+						chanceDTOs = append(chanceDTOs, drainer.NewAdmissionChanceDTOs(drainedResults)...)
 					}
 				}
 			}
 			corestate.ResultsMutex.RUnlock()
 
-			// Pass MSU internal IDs from the loaded varsity
-			var msuInternalIDs map[string]string
+			// Pass internal IDs from the loaded varsity
+			var internalIDs map[string]string
 			if targetVarsity != nil {
-				msuInternalIDs = targetVarsity.MSUInternalIDs
+				internalIDs = targetVarsity.InternalIDs
 			}
 
-			payload := core.NewUploadPayloadFromCalculator(targetVarsityCalculator, results, drainedDTOs, msuInternalIDs)
+			payload := core.NewUploadPayloadFromCalculator(targetVarsityCalculator, results, drainedDTOs, internalIDs)
+			if targetVarsity != nil {
+				payload.SourceReports = targetVarsity.SourceReports
+				payload.CapacityDrifts = targetVarsity.CapacityDrifts
+			}
 
 			// Call the updated upload.Primary function with runID and payload
 			if err := upload.Primary(ctx, client, run.ID, payload); err != nil {
 				log.Printf("Error uploading primary results for varsity %s: %v", varsityCode, err)
 			} else {
 				fmt.Printf("Successfully uploaded primary results for %s.\n", varsityCode)
+
+				if err := upload.AdmissionChances(ctx, client, run.ID, chanceDTOs); err != nil {
+					log.Printf("Error uploading admission chances for varsity %s: %v", varsityCode, err)
+				}
+
+				if trace, ok := corestate.PrimaryTraces[varsityCode]; ok {
+					if err := upload.AdmissionTrace(ctx, client, run.ID, trace.Events()); err != nil {
+						log.Printf("Error uploading admission trace for varsity %s: %v", varsityCode, err)
+					}
+				}
+
+				if err := upload.SourceReports(ctx, client, run.ID, varsityCode, payload.SourceReports); err != nil {
+					log.Printf("Error uploading source reports for varsity %s: %v", varsityCode, err)
+				}
+				if err := upload.CapacityDrifts(ctx, client, run.ID, varsityCode, payload.CapacityDrifts); err != nil {
+					log.Printf("Error uploading capacity drifts for varsity %s: %v", varsityCode, err)
+				}
 			}
 		}
 		corestate.ResultsMutex.RUnlock()
@@ -159,6 +183,9 @@ var uploadCmd = &cobra.Command{
 			if cleanupErr := dbClient.PerformBackupAndCleanup(ctx, config.AppConfig.Cleanup.RetentionRuns, config.AppConfig.Cleanup.BackupDir); cleanupErr != nil {
 				log.Printf("Warning: Cleanup job failed for run %d: %v", run.ID, cleanupErr)
 			}
+			if _, cleanupErr := dbClient.CleanupAdmissionEvents(ctx, run.ID, config.AppConfig.Cleanup.TraceRetentionRuns); cleanupErr != nil {
+				log.Printf("Warning: Admission trace cleanup failed for run %d: %v", run.ID, cleanupErr)
+			}
 		}
 
 		fmt.Printf("Upload process complete. All data uploaded under run ID: %d\n", run.ID)
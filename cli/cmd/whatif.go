@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"github.com/trueegorletov/analabit/cli/config"
+	"github.com/trueegorletov/analabit/cli/corestate"
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/source"
+	"github.com/trueegorletov/analabit/core/whatif"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+)
+
+var whatifCmd = &cobra.Command{
+	Use:   "whatif [varsity:heading:competition:score:priority[:original]]...",
+	Short: "Calculates whether a hypothetical applicant with the given applications would be admitted",
+	Long: `Inserts a hypothetical applicant into the loaded varsity data and recalculates the admissions without
+changing the loaded results. Every argument is an application, e.g. "spbsu:123:regular:275:1:original";
+the competition is one of Regular, BVI, TargetQuota, DedicatedQuota, SpecialQuota and Paid.`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if !corestate.CrawlingDone {
+			fmt.Println("Crawling not yet complete. Please wait.")
+			return
+		}
+
+		req := whatif.Request{
+			CrossVarsity: config.AppConfig.Calculation.CrossVarsity,
+			DrainSeed:    corestate.DrainSeed,
+		}
+		req.DrainStages, _ = cmd.Flags().GetIntSlice("drain")
+		req.DrainIterations, _ = cmd.Flags().GetInt("iterations")
+		for _, arg := range args {
+			app, err := parseWhatIfApplication(arg)
+			if err != nil {
+				fmt.Printf("Error: Invalid application %q: %v\n", arg, err)
+				return
+			}
+			req.Applications = append(req.Applications, app)
+		}
+
+		corestate.ResultsMutex.RLock()
+		caches := make([]*source.VarsityDataCache, 0, len(corestate.LoadedVarsities))
+		for _, v := range corestate.LoadedVarsities {
+			if v.VarsityDataCache != nil {
+				caches = append(caches, v.VarsityDataCache)
+			}
+		}
+		corestate.ResultsMutex.RUnlock()
+
+		result, err := whatif.Calculate(caches, req)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', tabwriter.Debug)
+		header := "Varsity\tHeading\tCompetition\tPriority\tRating place\tAdmitted\tPassing score"
+		for _, stage := range req.DrainStages {
+			header += fmt.Sprintf("\tDrained %d%%", stage)
+		}
+		fmt.Fprintln(w, header)
+		for _, outcome := range result.Applications {
+			admitted := "no"
+			if outcome.Admitted {
+				admitted = "yes"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%d\t%s\t%d", outcome.VarsityCode, outcome.HeadingCode, outcome.Competition, outcome.Priority, outcome.RatingPlace, admitted, outcome.PassingScore)
+			for _, drained := range outcome.Drained {
+				fmt.Fprintf(w, "\t%.0f%%", drained.AdmittedShare*100)
+			}
+			fmt.Fprintln(w)
+		}
+		w.Flush()
+	},
+}
+
+// parseWhatIfApplication parses an application given as varsity:heading:competition:score:priority[:original].
+// The heading code is everything between the varsity code and the competition, so it may contain colons.
+func parseWhatIfApplication(arg string) (whatif.Application, error) {
+	fields := strings.Split(arg, ":")
+
+	var app whatif.Application
+	if len(fields) > 0 && strings.EqualFold(fields[len(fields)-1], "original") {
+		app.OriginalSubmitted = true
+		fields = fields[:len(fields)-1]
+	}
+	if len(fields) < 5 {
+		return app, fmt.Errorf("expected varsity:heading:competition:score:priority[:original]")
+	}
+
+	n := len(fields)
+	app.VarsityCode = fields[0]
+	app.HeadingCode = strings.Join(fields[1:n-3], ":")
+
+	var err error
+	if app.Competition, err = core.ParseCompetition(fields[n-3]); err != nil {
+		return app, err
+	}
+	if app.Score, err = strconv.Atoi(fields[n-2]); err != nil {
+		return app, fmt.Errorf("invalid score: %w", err)
+	}
+	if app.Priority, err = strconv.Atoi(fields[n-1]); err != nil {
+		return app, fmt.Errorf("invalid priority: %w", err)
+	}
+	return app, nil
+}
+
+func init() {
+	whatifCmd.Flags().IntSlice("drain", nil, "drain stages (percent of drained students) to simulate, e.g. 25,50")
+	whatifCmd.Flags().Int("iterations", whatif.DefaultDrainIterations, "iterations of every drain stage")
+}
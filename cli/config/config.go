@@ -14,9 +14,18 @@ type Config struct {
 		List     []string `mapstructure:"list"`
 		Excluded []string `mapstructure:"excluded"`
 	} `mapstructure:"varsities"`
+	Calculation struct {
+		CrossVarsity bool `mapstructure:"cross_varsity"` // Run one admission pass over all varsities at once
+	} `mapstructure:"calculation"`
 	DrainSim struct {
 		Stages     []int `mapstructure:"stages"`
 		Iterations int   `mapstructure:"iterations"`
+		// Adaptive simulations: keep iterating up to max_iterations until passing scores converge to tolerance points
+		MaxIterations int               `mapstructure:"max_iterations"`
+		Tolerance     float64           `mapstructure:"tolerance"`
+		Seed          int64             `mapstructure:"seed"`   // Master seed of the simulations, 0 picks a fresh one
+		Model         string            `mapstructure:"model"`  // Drain model, see drainer.NewModel
+		Models        map[string]string `mapstructure:"models"` // Drain models per varsity code
 	} `mapstructure:"drain_sim"`
 	Upload struct {
 		Database struct {
@@ -28,12 +37,19 @@ type Config struct {
 		} `mapstructure:"database"`
 	} `mapstructure:"upload"`
 	Cache struct {
-		Directory  string `mapstructure:"directory"`
-		TTLMinutes int    `mapstructure:"ttl_minutes"`
+		Directory    string `mapstructure:"directory"`
+		TTLMinutes   int    `mapstructure:"ttl_minutes"`
+		File         string `mapstructure:"file"`          // Pinned cache file to use regardless of TTL
+		PreviousFile string `mapstructure:"previous_file"` // Previous run's cache file for the calibrated drain model
+		// Record the HTTP exchanges of the crawl into an archive, or replay the crawl from one offline
+		RecordHTTP     bool   `mapstructure:"record_http"`
+		ReplayHTTPFile string `mapstructure:"replay_http_file"`
 	} `mapstructure:"cache"`
 	Cleanup struct {
 		RetentionRuns int    `mapstructure:"retention_runs"`
 		BackupDir     string `mapstructure:"backup_dir"`
+		// Number of latest runs whose admission traces are kept, 0 keeps them as long as the rest of the data
+		TraceRetentionRuns int `mapstructure:"trace_retention_runs"`
 	} `mapstructure:"cleanup"`
 	Logging struct {
 		File string `mapstructure:"file"` // Path to the log file. If empty, logs to stderr.
@@ -11,7 +11,10 @@ import (
 var (
 	LoadedVarsities []*source.Varsity
 	PrimaryResults  map[string][]core.CalculationResult        // Key: Varsity Code
+	PrimaryTraces   map[string]*core.AdmissionTrace            // Key: Varsity Code
 	DrainedResults  map[string]map[int][]drainer.DrainedResult // Key: Varsity Code, Key: Drain Percent
+	DrainSeed       int64                                      // Master seed of the drain simulations
+	DrainModels     map[string]drainer.DrainModel              // Key: Varsity Code
 
 	// Background simulation tracking
 	TotalSimulations     int32
@@ -30,6 +33,7 @@ var (
 
 func InitializeState() {
 	PrimaryResults = make(map[string][]core.CalculationResult)
+	PrimaryTraces = make(map[string]*core.AdmissionTrace)
 	DrainedResults = make(map[string]map[int][]drainer.DrainedResult)
 	SimulationsDone = false
 	CrawlingDone = false
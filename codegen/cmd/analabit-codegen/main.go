@@ -0,0 +1,166 @@
+// Command analabit-codegen generates the heading sources of varsities from the registries of their lists
+// and capacities, and compares them with or writes them into the varsity definition files.
+//
+//	analabit-codegen [flags] generate|diff|write [varsity...]
+//
+// generate prints the generated "sources" lists, diff reports the headings added, removed and changed
+// against the definition files and exits with status 1 if there are any, and write merges the generated
+// heading sources into the definition files. All varsities with a generator are processed if none are given.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/trueegorletov/analabit/codegen"
+	"github.com/trueegorletov/analabit/core/registry"
+
+	// The generators of all varsities
+	_ "github.com/trueegorletov/analabit/codegen/fmsmu"
+	_ "github.com/trueegorletov/analabit/codegen/hse"
+	_ "github.com/trueegorletov/analabit/codegen/itmo"
+	_ "github.com/trueegorletov/analabit/codegen/mephi"
+	_ "github.com/trueegorletov/analabit/codegen/mipt"
+	_ "github.com/trueegorletov/analabit/codegen/mirea"
+	_ "github.com/trueegorletov/analabit/codegen/rsmu"
+	_ "github.com/trueegorletov/analabit/codegen/spbstu"
+	_ "github.com/trueegorletov/analabit/codegen/spbsu"
+)
+
+func main() {
+	dataDir := flag.String("data", "sample_data", "directory with the saved registries of the varsities")
+	definitionsDir := flag.String("definitions", "core/registry/definitions", "directory with the varsity definition files")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] generate|diff|write [varsity...]\n\nVarsities: %s\n\nFlags:\n",
+			os.Args[0], strings.Join(codegen.Varsities(), ", "))
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	mode, varsities := flag.Arg(0), flag.Args()[1:]
+	if mode != "generate" && mode != "diff" && mode != "write" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if len(varsities) == 0 {
+		varsities = codegen.Varsities()
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	differs := false
+	for _, varsity := range varsities {
+		g, err := codegen.Lookup(varsity)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		result, err := g.Generate(ctx, *dataDir)
+		if err != nil {
+			log.Fatalf("Failed to generate %s: %v", varsity, err)
+		}
+		for _, warning := range result.Warnings {
+			log.Printf("%s: %s", varsity, warning)
+		}
+
+		switch mode {
+		case "generate":
+			err = printSources(varsity, result)
+		case "diff":
+			var d *codegen.Diff
+			d, err = diff(*definitionsDir, varsity, result)
+			differs = differs || (d != nil && !d.Empty())
+		case "write":
+			err = write(*definitionsDir, varsity, result)
+		}
+		if err != nil {
+			log.Fatalf("Failed to %s %s: %v", mode, varsity, err)
+		}
+	}
+
+	if differs {
+		os.Exit(1)
+	}
+}
+
+func printSources(varsity string, result *codegen.Result) error {
+	list, err := registry.EncodeSources(result.Sources)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("# %s: %d headings\n", varsity, len(result.Sources))
+	enc := yaml.NewEncoder(os.Stdout)
+	enc.SetIndent(2)
+	if err := enc.Encode(map[string]any{"sources": list}); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+func diff(definitionsDir, varsity string, result *codegen.Result) (*codegen.Diff, error) {
+	def, err := codegen.ReadDefinition(definitionsDir, varsity)
+	if err != nil {
+		return nil, err
+	}
+
+	d := codegen.Compare(def.HeadingSources, result.Sources)
+	if d.Empty() {
+		fmt.Printf("%s: up to date, %d headings\n", varsity, len(def.HeadingSources))
+		return d, nil
+	}
+
+	fmt.Printf("%s: %d added, %d removed, %d changed\n", varsity, len(d.Added), len(d.Removed), len(d.Changed))
+	for _, hs := range d.Added {
+		fmt.Printf("  + %s", codegen.HeadingName(hs))
+		if c, ok := codegen.Capacities(hs); ok {
+			fmt.Printf(" %v", c)
+		}
+		fmt.Println()
+	}
+	for _, hs := range d.Removed {
+		fmt.Printf("  - %s\n", codegen.HeadingName(hs))
+	}
+	for _, change := range d.Changed {
+		fmt.Printf("  ~ %s", change.Name)
+		if change.CapacitiesChanged() {
+			fmt.Printf(" %v -> %v", change.Old, change.New)
+		}
+		if len(change.Fields) > 0 {
+			fmt.Printf(" (%s)", strings.Join(change.Fields, ", "))
+		}
+		fmt.Println()
+	}
+	return d, nil
+}
+
+func write(definitionsDir, varsity string, result *codegen.Result) error {
+	def, err := codegen.ReadDefinition(definitionsDir, varsity)
+	if err != nil {
+		return err
+	}
+
+	d := codegen.Compare(def.HeadingSources, result.Sources)
+	if d.Empty() {
+		fmt.Printf("%s: up to date\n", varsity)
+		return nil
+	}
+
+	if err := codegen.WriteDefinition(definitionsDir, varsity, codegen.Merge(def.HeadingSources, result.Sources)); err != nil {
+		return err
+	}
+	fmt.Printf("%s: wrote %d added, %d removed, %d changed headings\n", varsity, len(d.Added), len(d.Removed), len(d.Changed))
+	return nil
+}
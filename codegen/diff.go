@@ -0,0 +1,173 @@
+package codegen
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/source"
+)
+
+// nameFields are the fields heading sources are told apart by, in the order of preference; the first
+// one a source has set is its name. Headings of HSE have no names but the URLs of their lists.
+var nameFields = []string{"PrettyName", "HeadingName", "ProgramName", "URL", "FilePath", "Path"}
+
+// HeadingName returns the name a heading source is matched by between the registry and a generator.
+func HeadingName(hs source.HeadingSource) string {
+	v := reflect.Indirect(reflect.ValueOf(hs))
+	if v.Kind() != reflect.Struct {
+		return ""
+	}
+	for _, name := range nameFields {
+		if f := v.FieldByName(name); f.IsValid() && f.Kind() == reflect.String && f.String() != "" {
+			return f.String()
+		}
+	}
+	return ""
+}
+
+// headingKeys returns the keys sources are matched by: their names, with the repeated ones numbered in
+// order, as in "История #2".
+func headingKeys(sources []source.HeadingSource) []string {
+	seen := make(map[string]int, len(sources))
+	keys := make([]string, len(sources))
+	for i, hs := range sources {
+		name := HeadingName(hs)
+		seen[name]++
+		keys[i] = name
+		if n := seen[name]; n > 1 {
+			keys[i] = fmt.Sprintf("%s #%d", name, n)
+		}
+	}
+	return keys
+}
+
+var capacitiesType = reflect.TypeOf(core.Capacities{})
+
+// capacitiesField returns the capacities field of a heading source, an invalid value if it has none.
+func capacitiesField(hs source.HeadingSource) reflect.Value {
+	v := reflect.Indirect(reflect.ValueOf(hs))
+	if v.Kind() != reflect.Struct {
+		return reflect.Value{}
+	}
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Field(i)
+		if f.Type() == capacitiesType || (f.Kind() == reflect.Pointer && f.Type().Elem() == capacitiesType) {
+			return f
+		}
+	}
+	return reflect.Value{}
+}
+
+// Capacities returns the capacities of a heading source, false if it has no capacities field.
+func Capacities(hs source.HeadingSource) (core.Capacities, bool) {
+	f := capacitiesField(hs)
+	if !f.IsValid() {
+		return core.Capacities{}, false
+	}
+	if f.Kind() == reflect.Pointer {
+		if f.IsNil() {
+			return core.Capacities{}, true
+		}
+		f = f.Elem()
+	}
+	return f.Interface().(core.Capacities), true
+}
+
+// HeadingChange is a heading found both in the registry and by a generator, but different.
+type HeadingChange struct {
+	Name string
+	// Old and New are the capacities of the heading in the registry and the generated ones, equal
+	// unless the capacities changed.
+	Old, New core.Capacities
+	// Fields are the Go names of the other fields that differ, like the IDs or URLs of the lists.
+	Fields []string
+}
+
+// CapacitiesChanged reports whether the capacities of the heading differ.
+func (c HeadingChange) CapacitiesChanged() bool {
+	return !reflect.DeepEqual(c.Old, c.New)
+}
+
+// Diff is the difference between the heading sources of a varsity in the registry and the generated ones.
+type Diff struct {
+	Added   []source.HeadingSource
+	Removed []source.HeadingSource
+	Changed []HeadingChange
+}
+
+// Empty reports whether the generated heading sources match the registry.
+func (d *Diff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// Compare returns the difference between the current heading sources of a varsity and the generated
+// ones, as Merge would apply it. Headings are matched by their names.
+func Compare(current, generated []source.HeadingSource) *Diff {
+	diff := &Diff{}
+
+	currentKeys := headingKeys(current)
+	byKey := make(map[string]source.HeadingSource, len(current))
+	for i, hs := range current {
+		byKey[currentKeys[i]] = hs
+	}
+
+	matched := make(map[string]bool, len(generated))
+	for i, key := range headingKeys(generated) {
+		hs := generated[i]
+		old, ok := byKey[key]
+		if !ok {
+			diff.Added = append(diff.Added, hs)
+			continue
+		}
+		matched[key] = true
+
+		if change, ok := compareHeading(key, old, hs); ok {
+			diff.Changed = append(diff.Changed, change)
+		}
+	}
+
+	for i, hs := range current {
+		if !matched[currentKeys[i]] {
+			diff.Removed = append(diff.Removed, hs)
+		}
+	}
+	return diff
+}
+
+// compareHeading returns the change of a matched heading once merged into the registry, false if it
+// didn't change.
+func compareHeading(name string, old, generated source.HeadingSource) (HeadingChange, bool) {
+	change := HeadingChange{Name: name}
+	oldValue := reflect.Indirect(reflect.ValueOf(old))
+	if oldValue.Type() != reflect.Indirect(reflect.ValueOf(generated)).Type() {
+		change.Fields = append(change.Fields, "type")
+		return change, true
+	}
+
+	merged := reflect.Indirect(reflect.ValueOf(mergeHeading(old, generated)))
+	change.Old, _ = Capacities(old)
+	change.New, _ = Capacities(merged.Addr().Interface().(source.HeadingSource))
+
+	capacities := capacitiesField(old)
+	for i := 0; i < oldValue.NumField(); i++ {
+		f := oldValue.Type().Field(i)
+		if !f.IsExported() || (capacities.IsValid() && f.Type == capacities.Type()) {
+			continue
+		}
+		if !reflect.DeepEqual(normalize(oldValue.Field(i)), normalize(merged.Field(i))) {
+			change.Fields = append(change.Fields, f.Name)
+		}
+	}
+
+	return change, change.CapacitiesChanged() || len(change.Fields) > 0
+}
+
+// normalize returns the value of v with empty slices and maps made nil, which definition files don't
+// tell apart.
+func normalize(v reflect.Value) any {
+	if (v.Kind() == reflect.Slice || v.Kind() == reflect.Map) && v.Len() == 0 {
+		return reflect.Zero(v.Type()).Interface()
+	}
+	return v.Interface()
+}
@@ -0,0 +1,74 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/source"
+	"github.com/trueegorletov/analabit/core/source/hse"
+	"github.com/trueegorletov/analabit/core/source/rsmu"
+	"github.com/trueegorletov/analabit/core/source/spbstu"
+)
+
+func TestHeadingName(t *testing.T) {
+	assert.Equal(t, "Физика", HeadingName(&spbstu.HTTPHeadingSource{PrettyName: "Физика"}))
+	assert.Equal(t, "Лечебное дело", HeadingName(&rsmu.HTTPHeadingSource{ProgramName: "Лечебное дело"}))
+	assert.Equal(t, "https://example.org/BD_moscow_AMI_O.xlsx", HeadingName(&hse.HTTPHeadingSource{URL: "https://example.org/BD_moscow_AMI_O.xlsx"}))
+}
+
+func TestCompare(t *testing.T) {
+	current := []source.HeadingSource{
+		&spbstu.HTTPHeadingSource{PrettyName: "Физика", RegularListID: 1, Capacities: core.Capacities{Regular: 10}},
+		&spbstu.HTTPHeadingSource{PrettyName: "Химия", RegularListID: 2, Capacities: core.Capacities{Regular: 20}},
+		&spbstu.HTTPHeadingSource{PrettyName: "Биология", RegularListID: 3, Capacities: core.Capacities{Regular: 30}},
+		&spbstu.HTTPHeadingSource{PrettyName: "История", RegularListID: 4, Metadata: core.HeadingMetadata{DirectionCode: "46.03.01"}},
+	}
+	generated := []source.HeadingSource{
+		&spbstu.HTTPHeadingSource{PrettyName: "Физика", RegularListID: 1, Capacities: core.Capacities{Regular: 12, TargetQuota: 1}},
+		// Unknown capacities leave the current ones be
+		&spbstu.HTTPHeadingSource{PrettyName: "Химия", RegularListID: 5},
+		&spbstu.HTTPHeadingSource{PrettyName: "История", RegularListID: 4, TargetQuotaListIDs: []int{}},
+		&spbstu.HTTPHeadingSource{PrettyName: "Геология", RegularListID: 6},
+	}
+
+	diff := Compare(current, generated)
+	assert.False(t, diff.Empty())
+	assert.Equal(t, []source.HeadingSource{generated[3]}, diff.Added)
+	assert.Equal(t, []source.HeadingSource{current[2]}, diff.Removed)
+
+	require.Len(t, diff.Changed, 2)
+	assert.Equal(t, HeadingChange{
+		Name: "Физика",
+		Old:  core.Capacities{Regular: 10},
+		New:  core.Capacities{Regular: 12, TargetQuota: 1},
+	}, diff.Changed[0])
+	assert.True(t, diff.Changed[0].CapacitiesChanged())
+	assert.Equal(t, HeadingChange{
+		Name:   "Химия",
+		Old:    core.Capacities{Regular: 20},
+		New:    core.Capacities{Regular: 20},
+		Fields: []string{"RegularListID"},
+	}, diff.Changed[1])
+	assert.False(t, diff.Changed[1].CapacitiesChanged())
+
+	assert.True(t, Compare(current, current).Empty())
+}
+
+func TestCompare_RepeatedNames(t *testing.T) {
+	current := []source.HeadingSource{
+		&spbstu.HTTPHeadingSource{PrettyName: "Физика", RegularListID: 1},
+		&spbstu.HTTPHeadingSource{PrettyName: "Физика", RegularListID: 2},
+	}
+	generated := []source.HeadingSource{
+		&spbstu.HTTPHeadingSource{PrettyName: "Физика", RegularListID: 1},
+		&spbstu.HTTPHeadingSource{PrettyName: "Физика", RegularListID: 3},
+	}
+
+	diff := Compare(current, generated)
+	require.Len(t, diff.Changed, 1)
+	assert.Equal(t, "Физика #2", diff.Changed[0].Name)
+	assert.Equal(t, []string{"RegularListID"}, diff.Changed[0].Fields)
+}
@@ -1,19 +1,35 @@
-//go:build ignore
-
-package main
+// Package fmsmu generates the heading sources of FMSMU from the pages of its site listing the budget
+// competitions.
+package fmsmu
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
-	"log"
 	"net/http"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 
 	"golang.org/x/net/html"
+
+	"github.com/trueegorletov/analabit/codegen"
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/source/fmsmu"
 )
 
+func init() {
+	codegen.Register(Generator{})
+}
+
+// Generator generates the heading sources of FMSMU from the competitions listed on its site, needing
+// no data files.
+type Generator struct{}
+
+func (Generator) Varsity() string {
+	return "fmsmu"
+}
+
 // Local type definitions for codegen
 type SpoilerData struct {
 	ID              string
@@ -25,27 +41,10 @@ type SpoilerData struct {
 
 type HeadingGroup struct {
 	Spoilers    []SpoilerData
-	Capacities  Capacities
+	Capacities  core.Capacities
 	CleanedName string
 }
 
-type Capacities struct {
-	Total          int
-	Regular        int
-	TargetQuota    int
-	SpecialQuota   int
-	DedicatedQuota int
-}
-
-type HTTPHeadingSource struct {
-	PrettyName           string
-	RegularListID        string
-	SpecialQuotaListID   string
-	DedicatedQuotaListID string
-	TargetQuotaListIDs   []string
-	Capacities           Capacities
-}
-
 // Competition type constants
 const (
 	CompetitionRegular        = "regular"
@@ -54,27 +53,30 @@ const (
 	CompetitionDedicatedQuota = "dedicated"
 )
 
-func main() {
+func (Generator) Generate(ctx context.Context, _ string) (*codegen.Result, error) {
 	// Fetch all registry pages
-	allSpoilers, err := fetchAllRegistryPages()
+	allSpoilers, err := fetchAllRegistryPages(ctx)
 	if err != nil {
-		log.Fatalf("Error fetching registry pages: %v", err)
+		return nil, err
 	}
 
-	// Generate source definitions
-	sources := generateSources(allSpoilers)
-
-	// Output as Go code
-	outputGoCode(sources)
+	result := &codegen.Result{}
+	for _, httpSource := range generateSources(allSpoilers) {
+		if httpSource.RegularListID == "" {
+			result.Warnf("Missing regular list for: %s", httpSource.PrettyName)
+		}
+		result.Sources = append(result.Sources, httpSource)
+	}
+	return result, nil
 }
 
 // fetchAllRegistryPages fetches spoilers from all registry pages
-func fetchAllRegistryPages() ([]SpoilerData, error) {
+func fetchAllRegistryPages(ctx context.Context) ([]SpoilerData, error) {
 	var allSpoilers []SpoilerData
 	page := 1
 
 	for {
-		spoilers, err := fetchRegistryPage(page)
+		spoilers, err := fetchRegistryPage(ctx, page)
 		if err != nil {
 			return nil, fmt.Errorf("failed to fetch page %d: %w", page, err)
 		}
@@ -96,15 +98,23 @@ func fetchAllRegistryPages() ([]SpoilerData, error) {
 }
 
 // generateSources converts spoilers to HTTPHeadingSource definitions
-func generateSources(spoilers []SpoilerData) []HTTPHeadingSource {
+func generateSources(spoilers []SpoilerData) []*fmsmu.HTTPHeadingSource {
 	// Group spoilers by heading name first
 	groups := groupByHeading(spoilers)
 
-	var sources []HTTPHeadingSource
+	// Sort headings for consistent output
+	var names []string
+	for name := range groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var sources []*fmsmu.HTTPHeadingSource
 
 	// Create one HTTPHeadingSource per group
-	for _, group := range groups {
-		httpSource := HTTPHeadingSource{
+	for _, name := range names {
+		group := groups[name]
+		httpSource := &fmsmu.HTTPHeadingSource{
 			PrettyName: group.CleanedName,
 			Capacities: group.Capacities,
 		}
@@ -129,61 +139,18 @@ func generateSources(spoilers []SpoilerData) []HTTPHeadingSource {
 	return sources
 }
 
-// outputGoCode outputs the sources as Go code
-func outputGoCode(sources []HTTPHeadingSource) {
-	for _, httpSrc := range sources {
-		fmt.Printf("&fmsmu.HTTPHeadingSource{\n")
-		fmt.Printf("\tPrettyName: %s,\n", formatGoString(httpSrc.PrettyName))
-
-		if httpSrc.RegularListID != "" {
-			fmt.Printf("\tRegularListID: %s,\n", formatGoString(httpSrc.RegularListID))
-		}
-		if httpSrc.SpecialQuotaListID != "" {
-			fmt.Printf("\tSpecialQuotaListID: %s,\n", formatGoString(httpSrc.SpecialQuotaListID))
-		}
-		if httpSrc.DedicatedQuotaListID != "" {
-			fmt.Printf("\tDedicatedQuotaListID: %s,\n", formatGoString(httpSrc.DedicatedQuotaListID))
-		}
-		if len(httpSrc.TargetQuotaListIDs) > 0 {
-			fmt.Printf("\tTargetQuotaListIDs: []string{\n")
-			for _, id := range httpSrc.TargetQuotaListIDs {
-				fmt.Printf("\t\t%s,\n", formatGoString(id))
-			}
-			fmt.Printf("\t},\n")
-		}
-
-		fmt.Printf("\tCapacities: source.Capacities{\n")
-		if httpSrc.Capacities.Regular > 0 {
-			fmt.Printf("\t\tRegular: %d,\n", httpSrc.Capacities.Regular)
-		}
-		if httpSrc.Capacities.TargetQuota > 0 {
-			fmt.Printf("\t\tTargetQuota: %d,\n", httpSrc.Capacities.TargetQuota)
-		}
-		if httpSrc.Capacities.SpecialQuota > 0 {
-			fmt.Printf("\t\tSpecialQuota: %d,\n", httpSrc.Capacities.SpecialQuota)
-		}
-		if httpSrc.Capacities.DedicatedQuota > 0 {
-			fmt.Printf("\t\tDedicatedQuota: %d,\n", httpSrc.Capacities.DedicatedQuota)
-		}
-		fmt.Printf("\t},\n")
-		fmt.Printf("},\n")
-		fmt.Println()
-	}
-}
-
 // fetchRegistryPage fetches spoilers from a single registry page
-func fetchRegistryPage(pageNum int) ([]SpoilerData, error) {
+func fetchRegistryPage(ctx context.Context, pageNum int) ([]SpoilerData, error) {
 	url := fmt.Sprintf("https://priem.sechenov.ru/submitted-applicants/?search=&search_terms=&type-of-financing%%5B0%%5D=57&page=page-%d", pageNum)
 
 	// Create request with proper headers
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request for page %d: %w", pageNum, err)
 	}
 	req.Header.Set("User-Agent", "Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/91.0.4472.124 Safari/537.36")
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch page %d: %w", pageNum, err)
 	}
@@ -346,8 +313,6 @@ func groupByHeading(spoilers []SpoilerData) map[string]HeadingGroup {
 			group.Capacities.DedicatedQuota += spoiler.Capacity
 		}
 
-		group.Capacities.Total = group.Capacities.Regular + group.Capacities.TargetQuota + group.Capacities.SpecialQuota + group.Capacities.DedicatedQuota
-
 		groups[cleanedName] = group
 	}
 
@@ -394,10 +359,3 @@ func getTextContent(node *html.Node) string {
 
 	return text.String()
 }
-
-// formatGoString formats a string for Go code output
-func formatGoString(s string) string {
-	// Use JSON marshaling to properly escape the string
-	b, _ := json.Marshal(s)
-	return string(b)
-}
@@ -0,0 +1,79 @@
+// Package codegen generates the heading sources of varsities from the registries of their lists and
+// capacities, compares them with the varsity definition files of core/registry and updates the files.
+//
+// Each varsity implements a Generator in its own subpackage, which registers it on init; the
+// analabit-codegen command runs them.
+package codegen
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/trueegorletov/analabit/core/source"
+)
+
+// Generator generates the heading sources of a varsity.
+type Generator interface {
+	// Varsity returns the code of the varsity whose definition file the generated heading sources belong to.
+	Varsity() string
+	// Generate generates the heading sources from the registry files found under dataDir, or fetched from
+	// the varsity's site by the generators that need no files.
+	Generate(ctx context.Context, dataDir string) (*Result, error)
+}
+
+// Result is what a Generator generated.
+type Result struct {
+	Sources []source.HeadingSource
+	// Warnings describe the anomalies of the registries worth checking by hand, like lists no heading
+	// was found for or headings without some of their lists.
+	Warnings []string
+}
+
+// Warnf adds a warning to the result.
+func (r *Result) Warnf(format string, args ...any) {
+	r.Warnings = append(r.Warnings, fmt.Sprintf(format, args...))
+}
+
+var (
+	generatorsMu sync.RWMutex
+	generators   = make(map[string]Generator)
+)
+
+// Register makes g available by the code of its varsity. It panics if the varsity already has a generator.
+func Register(g Generator) {
+	generatorsMu.Lock()
+	defer generatorsMu.Unlock()
+	if _, ok := generators[g.Varsity()]; ok {
+		panic(fmt.Sprintf("generator of varsity %s registered twice", g.Varsity()))
+	}
+	generators[g.Varsity()] = g
+}
+
+// Lookup returns the generator of the varsity with the given code.
+func Lookup(varsity string) (Generator, error) {
+	generatorsMu.RLock()
+	defer generatorsMu.RUnlock()
+	g, ok := generators[varsity]
+	if !ok {
+		return nil, fmt.Errorf("no generator for varsity %q, expected one of %v", varsity, varsitiesLocked())
+	}
+	return g, nil
+}
+
+// Varsities returns the codes of the varsities that have a generator, sorted.
+func Varsities() []string {
+	generatorsMu.RLock()
+	defer generatorsMu.RUnlock()
+	return varsitiesLocked()
+}
+
+func varsitiesLocked() []string {
+	codes := make([]string, 0, len(generators))
+	for code := range generators {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
@@ -1,19 +1,41 @@
-package main
+// Package hse generates the heading sources of the HSE campuses from the page of the links to their lists
+// and the pages of their capacities.
+package hse
 
 import (
-	"github.com/trueegorletov/analabit/core"
-	"flag"
+	"context"
 	"fmt"
-	"io/ioutil"
-	"log"
+	"os"
+	"path/filepath"
 	"regexp"
 	"sort"
 	"strconv"
 	"strings"
 
 	"golang.org/x/net/html"
+
+	"github.com/trueegorletov/analabit/codegen"
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/source/hse"
 )
 
+func init() {
+	for campus := range campusConfigs {
+		codegen.Register(Generator{Campus: campus})
+	}
+}
+
+// Generator generates the heading sources of an HSE campus, matching the programs of the lists page to
+// the rows of the campus' capacities page by their normalized names.
+type Generator struct {
+	// Campus is one of the keys of campusConfigs
+	Campus string
+}
+
+func (g Generator) Varsity() string {
+	return "hse_" + g.Campus
+}
+
 // ProgramInfo stores information about an educational program
 type ProgramInfo struct {
 	Name           string
@@ -30,65 +52,70 @@ type CampusConfig struct {
 	URLPrefix  string
 }
 
+// campusConfigs are the campuses by their suffixes of the varsity codes, with the paths of their pages
+// under hse/lists_and_caps/ of the data directory
 var campusConfigs = map[string]CampusConfig{
 	"msk": {
 		Name:       "Москва",
-		LinksPath:  "lists_and_caps/lists.html",
-		PlacesPath: "lists_and_caps/places_msk.html",
+		LinksPath:  "lists.html",
+		PlacesPath: "places_msk.html",
 		URLPrefix:  "moscow",
 	},
 	"nn": {
 		Name:       "Нижний Новгород",
-		LinksPath:  "lists_and_caps/lists.html",
-		PlacesPath: "lists_and_caps/places_nn.html",
+		LinksPath:  "lists.html",
+		PlacesPath: "places_nn.html",
 		URLPrefix:  "nn",
 	},
 	"perm": {
 		Name:       "Пермь",
-		LinksPath:  "lists_and_caps/lists.html",
-		PlacesPath: "lists_and_caps/places_perm.html",
+		LinksPath:  "lists.html",
+		PlacesPath: "places_perm.html",
 		URLPrefix:  "perm",
 	},
 	"spb": {
 		Name:       "Санкт-Петербург",
-		LinksPath:  "lists_and_caps/lists.html",
-		PlacesPath: "lists_and_caps/places_spb.html",
+		LinksPath:  "lists.html",
+		PlacesPath: "places_spb.html",
 		URLPrefix:  "spb",
 	},
 }
 
-func main() {
-	var campus string
-	flag.StringVar(&campus, "c", "", "Campus to process: msk, nn, perm, spb")
-	flag.StringVar(&campus, "campus", "", "Campus to process: msk, nn, perm, spb")
-	flag.Parse()
-
-	if campus == "" {
-		log.Fatal("Campus must be specified with -c or --campus flag")
-	}
-
-	config, exists := campusConfigs[campus]
-	if !exists {
-		log.Fatalf("Invalid campus: %s. Valid options: msk, nn, perm, spb", campus)
-	}
+func (g Generator) Generate(_ context.Context, dataDir string) (*codegen.Result, error) {
+	config := campusConfigs[g.Campus]
+	dir := filepath.Join(dataDir, "hse", "lists_and_caps")
 
 	// Parse links from lists.html
-	programs, err := parseLinksForCampus(config.LinksPath, config.Name)
+	programs, err := parseLinksForCampus(filepath.Join(dir, config.LinksPath), config.Name)
 	if err != nil {
-		log.Fatalf("Error parsing links: %v", err)
+		return nil, fmt.Errorf("error parsing links: %w", err)
 	}
 
 	// Parse capacities from places file
-	capacities, err := parseCapacitiesHTML(config.PlacesPath)
+	capacities, err := parseCapacitiesHTML(filepath.Join(dir, config.PlacesPath))
 	if err != nil {
-		log.Fatalf("Error parsing capacities: %v", err)
+		return nil, fmt.Errorf("error parsing capacities: %w", err)
 	}
 
 	// Match programs with capacities
 	matchedPrograms, filteredPrograms, anomalies := matchProgramsWithCapacities(programs, capacities)
 
-	// Output results
-	outputResults(matchedPrograms, filteredPrograms, anomalies)
+	// Sort programs by name for consistent output
+	sort.Slice(matchedPrograms, func(i, j int) bool {
+		return matchedPrograms[i].Name < matchedPrograms[j].Name
+	})
+
+	result := &codegen.Result{Warnings: anomalies}
+	for _, program := range matchedPrograms {
+		result.Sources = append(result.Sources, &hse.HTTPHeadingSource{
+			URL:        program.URL,
+			Capacities: program.Capacities,
+		})
+	}
+	for _, program := range filteredPrograms {
+		result.Warnf("Program filtered out with KCP = 0: %s", program.Name)
+	}
+	return result, nil
 }
 
 func normalizeHeadingName(name string) string {
@@ -167,7 +194,7 @@ func extractIntFromCell(cellContent string) int {
 }
 
 func parseLinksForCampus(filePath, campusName string) ([]ProgramInfo, error) {
-	content, err := ioutil.ReadFile(filePath)
+	content, err := os.ReadFile(filePath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read file %s: %v", filePath, err)
 	}
@@ -278,7 +305,7 @@ func parseTable(table *html.Node, programs *[]ProgramInfo) {
 func parseCapacitiesHTML(filePath string) (map[string]core.Capacities, error) {
 	capacitiesMap := make(map[string]core.Capacities)
 
-	content, err := ioutil.ReadFile(filePath)
+	content, err := os.ReadFile(filePath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read file %s: %v", filePath, err)
 	}
@@ -483,41 +510,3 @@ func matchProgramsWithCapacities(programs []ProgramInfo, capacities map[string]c
 
 	return matched, filtered, anomalies
 }
-
-func outputResults(programs []ProgramInfo, filtered []ProgramInfo, anomalies []string) {
-	// Sort programs by name for consistent output
-	sort.Slice(programs, func(i, j int) bool {
-		return programs[i].Name < programs[j].Name
-	})
-
-	for _, program := range programs {
-		fmt.Printf("// %s\n", program.Name)
-		fmt.Printf("&hse.HTTPHeadingSource{\n")
-		fmt.Printf("    URL: \"%s\",\n", program.URL)
-		fmt.Printf("    Capacities: core.Capacities{\n")
-		fmt.Printf("        Regular:        %d,\n", program.Capacities.Regular)
-		fmt.Printf("        TargetQuota:    %d,\n", program.Capacities.TargetQuota)
-		fmt.Printf("        DedicatedQuota: %d,\n", program.Capacities.DedicatedQuota)
-		fmt.Printf("        SpecialQuota:   %d,\n", program.Capacities.SpecialQuota)
-		fmt.Printf("    },\n")
-		fmt.Printf("},\n")
-	}
-
-	if len(filtered) > 0 {
-		fmt.Printf("\n\n// ===\n")
-		fmt.Printf("// FILTERED OUT\n")
-		fmt.Printf("// ===\n")
-		for _, program := range filtered {
-			fmt.Printf("// %s (KCP = 0)\n", program.Name)
-		}
-	}
-
-	if len(anomalies) > 0 {
-		fmt.Printf("\n\n// ===\n")
-		fmt.Printf("// FOUND ANOMALIES\n")
-		fmt.Printf("// ===\n")
-		for _, anomaly := range anomalies {
-			fmt.Printf("// %s\n", anomaly)
-		}
-	}
-}
@@ -1,10 +0,0 @@
-module hse-parser
-
-go 1.24
-
-require (
-	analabit v0.0.0
-	golang.org/x/net v0.40.0
-)
-
-replace analabit => ../../
@@ -1,10 +0,0 @@
-module github.com/trueegorletov/analabit/codegen/itmo
-
-go 1.24
-
-require (
-	github.com/trueegorletov/analabit v0.0.0-00010101000000-000000000000
-	golang.org/x/net v0.41.0
-)
-
-replace github.com/trueegorletov/analabit => ../..
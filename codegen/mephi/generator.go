@@ -0,0 +1,209 @@
+// Package mephi generates the heading sources of MEPhI from its pages of capacities and of the links to
+// the lists.
+package mephi
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/net/html"
+
+	"github.com/trueegorletov/analabit/codegen"
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/source/mephi"
+)
+
+const baseURL = "https://org.mephi.ru"
+
+// The pages saved under mephi/ of the data directory
+const (
+	capacitiesFile = "mephi_heading_names_and_capacities_registry_body.html"
+	linksFile      = "mephi_links_to_lists_registry_body.html"
+)
+
+func init() {
+	codegen.Register(Generator{})
+}
+
+// Generator generates the heading sources of MEPhI. Only the total capacities are published, so the
+// quotas are estimated as 10% of them each.
+type Generator struct{}
+
+func (Generator) Varsity() string {
+	return "mephi"
+}
+
+type MephiHeading struct {
+	Name               string
+	Capacities         core.Capacities
+	RegularURLs        []string
+	TargetQuotaURLs    []string
+	DedicatedQuotaURLs []string
+	SpecialQuotaURLs   []string
+}
+
+func (Generator) Generate(_ context.Context, dataDir string) (*codegen.Result, error) {
+	// Parse capacities from the first file
+	capacities, err := parseCapacitiesFromFile(filepath.Join(dataDir, "mephi", capacitiesFile))
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse links from the second file
+	links, err := parseLinksFromFile(filepath.Join(dataDir, "mephi", linksFile))
+	if err != nil {
+		return nil, err
+	}
+
+	classifications := combineDataAndGenerate(capacities, links)
+
+	result := &codegen.Result{}
+	for _, heading := range classifications.Complete {
+		result.Sources = append(result.Sources, &mephi.HTTPHeadingSource{
+			HeadingName:        heading.Name,
+			Capacities:         heading.Capacities,
+			RegularURLs:        heading.RegularURLs,
+			TargetQuotaURLs:    heading.TargetQuotaURLs,
+			DedicatedQuotaURLs: heading.DedicatedQuotaURLs,
+			SpecialQuotaURLs:   heading.SpecialQuotaURLs,
+		})
+	}
+	for _, heading := range classifications.Incomplete {
+		if len(heading.RegularURLs) == 0 {
+			result.Warnf("Incomplete heading without regular lists: %s", heading.Name)
+		} else {
+			result.Warnf("Incomplete heading without capacity: %s", heading.Name)
+		}
+	}
+	return result, nil
+}
+
+func parseCapacitiesFromFile(filename string) (map[string]int, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open capacities file: %w", err)
+	}
+	defer file.Close()
+
+	doc, err := html.Parse(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse HTML: %w", err)
+	}
+
+	return mephi.ParseCapacitiesRegistry(doc)
+}
+
+func parseLinksFromFile(filename string) (map[string]map[core.Competition][]string, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open links file: %w", err)
+	}
+	defer file.Close()
+
+	doc, err := html.Parse(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse HTML: %w", err)
+	}
+
+	return mephi.ParseListLinksRegistry(doc)
+}
+
+type HeadingClassification struct {
+	Complete   []MephiHeading
+	Incomplete []MephiHeading
+}
+
+func combineDataAndGenerate(capacities map[string]int, links map[string]map[core.Competition][]string) HeadingClassification {
+	var complete []MephiHeading
+	var incomplete []MephiHeading
+
+	// Create a map to track which headings we've processed
+	processed := make(map[string]bool)
+
+	// Process all headings that have either capacities or links
+	allHeadings := make(map[string]bool)
+	for name := range capacities {
+		allHeadings[name] = true
+	}
+	for name := range links {
+		allHeadings[name] = true
+	}
+
+	for headingName := range allHeadings {
+		canonicalName := mephi.CapacitiesRegistryName(headingName)
+		totalCapacity := capacities[headingName]
+		if totalCapacity == 0 {
+			totalCapacity = capacities[canonicalName]
+		}
+		headingLinks := links[headingName]
+		if headingLinks == nil {
+			headingLinks = links[canonicalName]
+		}
+		if headingLinks == nil {
+			headingLinks = make(map[core.Competition][]string)
+		}
+
+		hasLists := len(headingLinks[core.CompetitionRegular]) > 0 || len(headingLinks[core.CompetitionTargetQuota]) > 0 || len(headingLinks[core.CompetitionDedicatedQuota]) > 0 || len(headingLinks[core.CompetitionSpecialQuota]) > 0
+		hasRegular := len(headingLinks[core.CompetitionRegular]) > 0
+
+		var caps core.Capacities
+		if totalCapacity > 0 {
+			caps = mephi.EstimateCapacities(totalCapacity)
+		} else {
+			caps = core.Capacities{}
+		}
+
+		heading := MephiHeading{
+			Name:               headingName,
+			Capacities:         caps,
+			RegularURLs:        makeAbsoluteURLs(headingLinks[core.CompetitionRegular]),
+			TargetQuotaURLs:    makeAbsoluteURLs(headingLinks[core.CompetitionTargetQuota]),
+			DedicatedQuotaURLs: makeAbsoluteURLs(headingLinks[core.CompetitionDedicatedQuota]),
+			SpecialQuotaURLs:   makeAbsoluteURLs(headingLinks[core.CompetitionSpecialQuota]),
+		}
+
+		if totalCapacity > 0 && hasRegular {
+			complete = append(complete, heading)
+		} else if (totalCapacity > 0 && !hasRegular) || (totalCapacity == 0 && hasLists) {
+			incomplete = append(incomplete, heading)
+		}
+
+		processed[headingName] = true
+	}
+
+	// Sort headings for consistent output
+	sort.Slice(complete, func(i, j int) bool {
+		return complete[i].Name < complete[j].Name
+	})
+	sort.Slice(incomplete, func(i, j int) bool {
+		return incomplete[i].Name < incomplete[j].Name
+	})
+
+	return HeadingClassification{
+		Complete:   complete,
+		Incomplete: incomplete,
+	}
+}
+
+func makeAbsoluteURLs(urls []string) []string {
+	var absoluteURLs []string
+	for _, url := range urls {
+		if url != "" {
+			// Check if URL is already absolute
+			if strings.HasPrefix(url, "http://") || strings.HasPrefix(url, "https://") {
+				absoluteURLs = append(absoluteURLs, url)
+			} else if strings.HasPrefix(url, "/") {
+				// Relative URL starting with /
+				absoluteURLs = append(absoluteURLs, baseURL+url)
+			} else {
+				// Assume it's already absolute or handle as needed
+				absoluteURLs = append(absoluteURLs, url)
+			}
+		}
+	}
+	return absoluteURLs
+}
@@ -1,15 +1,41 @@
-package main
+// Package mipt generates the heading sources of MIPT from its pages of capacities and of the links to
+// the lists.
+package mipt
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
-	"io/ioutil"
 	"os"
+	"path/filepath"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+
+	"github.com/trueegorletov/analabit/codegen"
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/source/mipt"
+)
+
+// The pages saved under mipt/ of the data directory
+const (
+	capacitiesFile = "mipt-CapacitiesByHeadingName-capacities-page-UNCOMPRESSED.html"
+	registryFile   = "mipt-lists-registry_UNCOMPRESSED.html"
 )
 
+func init() {
+	codegen.Register(Generator{})
+}
+
+// Generator generates the heading sources of MIPT, matching the programs of the capacities page to the
+// rows of the lists registry page by their exact names.
+type Generator struct{}
+
+func (Generator) Varsity() string {
+	return "mipt"
+}
+
 type MiptProgram struct {
 	Name       string
 	Capacities MiptCapacities
@@ -32,56 +58,33 @@ type MiptURLs struct {
 	Contract        string
 }
 
-func main() {
-	if len(os.Args) < 3 {
-		fmt.Fprintf(os.Stderr, "Usage: %s <capacities-html-file> <registry-html-file>\n", os.Args[0])
-		fmt.Fprintf(os.Stderr, "Example: %s ../../sample_data/mipt/mipt-CapacitiesByHeadingName-capacities-page-UNCOMPRESSED.html ../../sample_data/mipt/mipt-lists-registry_UNCOMPRESSED.html\n", os.Args[0])
-		os.Exit(1)
-	}
-
-	capacitiesFile := os.Args[1]
-	registryFile := os.Args[2]
-
+func (Generator) Generate(_ context.Context, dataDir string) (*codegen.Result, error) {
 	// Read the capacities HTML file
-	capacitiesContent, err := ioutil.ReadFile(capacitiesFile)
+	capacitiesContent, err := os.ReadFile(filepath.Join(dataDir, "mipt", capacitiesFile))
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error reading capacities file: %v\n", err)
-		os.Exit(1)
+		return nil, fmt.Errorf("failed to read capacities file: %w", err)
 	}
 
 	// Read the registry HTML file
-	registryContent, err := ioutil.ReadFile(registryFile)
+	registryContent, err := os.ReadFile(filepath.Join(dataDir, "mipt", registryFile))
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error reading registry file: %v\n", err)
-		os.Exit(1)
+		return nil, fmt.Errorf("failed to read registry file: %w", err)
 	}
 
 	// Parse educational programs from capacities file
-	programs, err := parsePrograms(string(capacitiesContent))
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error parsing programs: %v\n", err)
-		os.Exit(1)
-	}
+	programs := parsePrograms(string(capacitiesContent))
 
 	// Parse URLs from the registry file using table structure
-	urlMap, err := parseRegistryURLsTableStructured(string(registryContent))
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error parsing registry URLs: %v\n", err)
-		os.Exit(1)
-	}
+	urlMap := parseRegistryURLsTableStructured(string(registryContent))
 
-	// Generate HTTPHeadingSource entries
-	generateHTTPHeadingSources(programs, urlMap)
+	return generateHTTPHeadingSources(programs, urlMap), nil
 }
 
-func parsePrograms(content string) ([]MiptProgram, error) {
-	fmt.Println("// Parsing educational programs from capacities file...")
-
+func parsePrograms(content string) []MiptProgram {
 	programCapacities := make(map[string]MiptCapacities)
 
 	// Split content into table rows
 	rows := strings.Split(content, "<tr")
-	fmt.Printf("// Found %d table row sections\n", len(rows))
 
 	for _, row := range rows {
 		// Skip header and empty rows
@@ -134,9 +137,6 @@ func parsePrograms(content string) ([]MiptProgram, error) {
 						BVI:            0, // BVI will be calculated or set separately
 						TargetQuota:    target,
 					}
-
-					fmt.Printf("// Found program: %s - Total: %d, Regular: %d, Special: %d, Dedicated: %d, Target: %d\n",
-						programName, total, regular, special, dedicated, target)
 				}
 			}
 		}
@@ -151,20 +151,16 @@ func parsePrograms(content string) ([]MiptProgram, error) {
 		})
 	}
 
-	fmt.Printf("// Extracted capacities for %d programs\n", len(programs))
-	return programs, nil
+	return programs
 }
 
-func parseRegistryURLsTableStructured(content string) (map[string]MiptURLs, error) {
-	fmt.Println("// Parsing URLs from registry file using table structure...")
-
+func parseRegistryURLsTableStructured(content string) map[string]MiptURLs {
 	urlMap := make(map[string]MiptURLs)
 
 	// Split by table rows to process each row individually
 	rows := strings.Split(content, "<tr")
-	fmt.Printf("// Processing %d table rows\n", len(rows))
 
-	for rowIndex, row := range rows {
+	for _, row := range rows {
 		// Skip very short rows
 		if len(row) < 200 {
 			continue
@@ -214,7 +210,6 @@ func parseRegistryURLsTableStructured(content string) (map[string]MiptURLs, erro
 		}
 
 		programName := cleanContent
-		fmt.Printf("// Found program in row %d: '%s'\n", rowIndex, programName)
 
 		// Extract all URLs from this same row
 		urls := MiptURLs{}
@@ -243,16 +238,12 @@ func parseRegistryURLsTableStructured(content string) (map[string]MiptURLs, erro
 					// Classify URL by type based on decoded path
 					if strings.Contains(decodedPath, "_Byudzhet_Na") || strings.Contains(decodedPath, "_Na obshchikh") {
 						urls.RegularBVI = url
-						fmt.Printf("//   + RegularBVI URL\n")
 					} else if strings.Contains(decodedPath, "_Imeyushchie osoboe") || strings.Contains(decodedPath, "Osoboe pravo") {
 						urls.SpecialQuota = url
-						fmt.Printf("//   + SpecialQuota URL\n")
 					} else if strings.Contains(decodedPath, "_Otdelnaya kvota") {
 						urls.DedicatedQuota = url
-						fmt.Printf("//   + DedicatedQuota URL\n")
 					} else if strings.Contains(decodedPath, "_Tselevoe") {
 						urls.TargetQuotaURLs = append(urls.TargetQuotaURLs, url)
-						fmt.Printf("//   + TargetQuota URL\n")
 					}
 				}
 			}
@@ -261,19 +252,10 @@ func parseRegistryURLsTableStructured(content string) (map[string]MiptURLs, erro
 		// Only include programs that have at least a budget URL
 		if urls.RegularBVI != "" {
 			urlMap[programName] = urls
-			fmt.Printf("// Stored program with %d total URLs\n", 1+len(urls.TargetQuotaURLs)+boolToInt(urls.SpecialQuota != "")+boolToInt(urls.DedicatedQuota != ""))
 		}
 	}
 
-	fmt.Printf("// Extracted URLs for %d programs with budget places\n", len(urlMap))
-	return urlMap, nil
-}
-
-func boolToInt(b bool) int {
-	if b {
-		return 1
-	}
-	return 0
+	return urlMap
 }
 
 // Helper function to identify if a string is likely an educational program name
@@ -352,122 +334,48 @@ func isEducationalProgram(candidate string) bool {
 	return hasKeyword
 }
 
-func generateHTTPHeadingSources(programs []MiptProgram, urlMap map[string]MiptURLs) {
-	fmt.Println("// Generated MIPT HTTPHeadingSource entries")
-	fmt.Println("// Educational program names used as keys (not direction codes)")
-	fmt.Println("// URLs extracted from MIPT registry file - REAL URLs, not hallucinated")
-	fmt.Println("// Capacities extracted from MIPT capacities file")
-	fmt.Println("// TABLE STRUCTURE PARSING - Program names matched to URLs from same table row")
-	fmt.Println("// EXACT MATCHES ONLY - No fuzzy matching")
-	fmt.Println("")
-
-	// Debug: Print all available program names from registry
-	fmt.Println("// Available programs in registry:")
-	for programName := range urlMap {
-		fmt.Printf("//   '%s'\n", programName)
-	}
-	fmt.Println("")
+func generateHTTPHeadingSources(programs []MiptProgram, urlMap map[string]MiptURLs) *codegen.Result {
+	// Programs are matched to their lists by exact names only
+	sort.Slice(programs, func(i, j int) bool {
+		return programs[i].Name < programs[j].Name
+	})
 
-	// Debug: Print all program names from capacities
-	fmt.Println("// Available programs in capacities:")
+	result := &codegen.Result{}
+	capacitiesFound := make(map[string]bool, len(programs))
 	for _, program := range programs {
-		fmt.Printf("//   '%s'\n", program.Name)
-	}
-	fmt.Println("")
+		capacitiesFound[program.Name] = true
 
-	exactMatched := 0
-	unmatched := []string{}
-
-	for _, program := range programs {
 		urls, found := urlMap[program.Name]
-
-		if found {
-			exactMatched++
-			fmt.Printf("// EXACT MATCH: '%s'\n", program.Name)
-			generateSingleHTTPHeadingSource(program, urls)
-		} else {
-			unmatched = append(unmatched, program.Name)
+		if !found {
+			result.Warnf("Program from capacities file with no exact match in registry: %s", program.Name)
+			continue
 		}
-	}
 
-	fmt.Printf("\n// EXACT MATCHES SUMMARY: %d/%d programs matched, %d unmatched\n", exactMatched, len(programs), len(unmatched))
-
-	// Detailed anomaly reporting
-	if len(unmatched) > 0 {
-		fmt.Println("\n// ANOMALIES - Programs from capacities file with NO EXACT MATCH in registry:")
-		for _, name := range unmatched {
-			fmt.Printf("//   UNMATCHED CAPACITY: '%s'\n", name)
-		}
+		result.Sources = append(result.Sources, &mipt.HTTPHeadingSource{
+			PrettyName:            program.Name,
+			RegularBVIListURL:     urls.RegularBVI,
+			TargetQuotaListURLs:   urls.TargetQuotaURLs,
+			DedicatedQuotaListURL: urls.DedicatedQuota,
+			SpecialQuotaListURL:   urls.SpecialQuota,
+			Capacities: core.Capacities{
+				Regular:        program.Capacities.Regular,
+				TargetQuota:    program.Capacities.TargetQuota,
+				DedicatedQuota: program.Capacities.DedicatedQuota,
+				SpecialQuota:   program.Capacities.SpecialQuota,
+			},
+		})
 	}
 
-	// Report URLs without matching capacities (exact match only)
-	registryOnly := []string{}
+	var registryOnly []string
 	for registryName := range urlMap {
-		foundInCapacities := false
-		for _, program := range programs {
-			if program.Name == registryName {
-				foundInCapacities = true
-				break
-			}
-		}
-		if !foundInCapacities {
+		if !capacitiesFound[registryName] {
 			registryOnly = append(registryOnly, registryName)
 		}
 	}
-
-	if len(registryOnly) > 0 {
-		fmt.Println("\n// ANOMALIES - Programs in registry with NO EXACT MATCH in capacities:")
-		for _, name := range registryOnly {
-			fmt.Printf("//   UNMATCHED REGISTRY: '%s'\n", name)
-		}
-	}
-
-	// Additional detailed comparison for better debugging
-	fmt.Println("\n// DETAILED COMPARISON FOR DEBUGGING:")
-	fmt.Println("// Programs that might be similar but don't match exactly:")
-	for _, program := range programs {
-		if _, found := urlMap[program.Name]; !found {
-			fmt.Printf("//   CAPACITY: '%s'\n", program.Name)
-			// Look for potential similar names in registry
-			for registryName := range urlMap {
-				if strings.Contains(strings.ToLower(program.Name), strings.ToLower(registryName)) ||
-					strings.Contains(strings.ToLower(registryName), strings.ToLower(program.Name)) {
-					fmt.Printf("//     -> Possible match in registry: '%s'\n", registryName)
-				}
-			}
-		}
-	}
-}
-
-func generateSingleHTTPHeadingSource(program MiptProgram, urls MiptURLs) {
-	fmt.Printf("&mipt.HTTPHeadingSource{\n")
-	fmt.Printf("\tPrettyName: \"%s\",\n", program.Name)
-
-	if urls.RegularBVI != "" {
-		fmt.Printf("\tRegularBVIListURL: \"%s\",\n", urls.RegularBVI)
-	}
-
-	if len(urls.TargetQuotaURLs) > 0 {
-		fmt.Printf("\tTargetQuotaListURLs: []string{\n")
-		for _, targetURL := range urls.TargetQuotaURLs {
-			fmt.Printf("\t\t\"%s\",\n", targetURL)
-		}
-		fmt.Printf("\t},\n")
-	}
-
-	if urls.DedicatedQuota != "" {
-		fmt.Printf("\tDedicatedQuotaListURL: \"%s\",\n", urls.DedicatedQuota)
-	}
-
-	if urls.SpecialQuota != "" {
-		fmt.Printf("\tSpecialQuotaListURL: \"%s\",\n", urls.SpecialQuota)
+	sort.Strings(registryOnly)
+	for _, name := range registryOnly {
+		result.Warnf("Program in registry with no exact match in capacities: %s", name)
 	}
 
-	fmt.Printf("\tCapacities: core.Capacities{\n")
-	fmt.Printf("\t\tRegular: %d,\n", program.Capacities.Regular)
-	fmt.Printf("\t\tTargetQuota: %d,\n", program.Capacities.TargetQuota)
-	fmt.Printf("\t\tDedicatedQuota: %d,\n", program.Capacities.DedicatedQuota)
-	fmt.Printf("\t\tSpecialQuota: %d,\n", program.Capacities.SpecialQuota)
-	fmt.Printf("\t},\n")
-	fmt.Printf("},\n\n")
+	return result
 }
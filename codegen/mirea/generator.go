@@ -0,0 +1,163 @@
+// Package mirea generates the heading sources of MIREA from the registry of its competitions.
+package mirea
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/trueegorletov/analabit/codegen"
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/source/mirea"
+)
+
+func init() {
+	codegen.Register(Generator{})
+}
+
+// Generator generates the heading sources of MIREA from mirea/lists_registry_ALL.json of the data
+// directory, the registry of all competitions saved from its lists API.
+type Generator struct{}
+
+func (Generator) Varsity() string {
+	return "mirea"
+}
+
+// Competition represents a single competition within a heading
+type Competition struct {
+	CompTypeID int      `json:"comp_type_id"`
+	CompType   string   `json:"comp_type"`
+	Plan       int      `json:"plan"`
+	AppCount   int      `json:"app_count"`
+	CompIDs    []string `json:"comp_ids"`
+}
+
+// RegistryEntry represents a single heading entry in the MIREA registry JSON
+type RegistryEntry struct {
+	ID           string        `json:"id"`
+	Title        string        `json:"title"`
+	SubjectTitle string        `json:"programSubject_title"` // Direction code and name, e.g. "01.03.02 Прикладная математика и информатика"
+	Plan         int           `json:"plan"`
+	AppCount     int           `json:"app_count"`
+	Competitions []Competition `json:"competitions"`
+	// Skip foreign_competitions entirely
+}
+
+// HeadingInfo stores information about a heading and its associated lists
+type HeadingInfo struct {
+	Name                  string
+	DirectionCode         string
+	RegularListIDs        []string
+	BVIListIDs            []string
+	TargetQuotaListIDs    []string
+	DedicatedQuotaListIDs []string
+	SpecialQuotaListIDs   []string
+}
+
+// extractHeadingName extracts clean heading names by cutting at the first slash
+func extractHeadingName(title string) string {
+	// Cut at first slash as specified in the format reference
+	if slashIndex := strings.LastIndex(title, "/"); slashIndex != -1 {
+		return strings.TrimSpace(title[:slashIndex])
+	}
+	return strings.TrimSpace(title)
+}
+
+// shouldIgnoreCompetition checks if a competition should be ignored
+func shouldIgnoreCompetition(compTypeID int) bool {
+	// Ignore non-budgetary competitions (comp_type_id 6)
+	return compTypeID == 6
+}
+
+// readRegistryFile reads and parses the MIREA registry JSON file
+func readRegistryFile(filename string) ([]RegistryEntry, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file %s: %w", filename, err)
+	}
+
+	var registry []RegistryEntry
+	if err := json.Unmarshal(data, &registry); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON from %s: %w", filename, err)
+	}
+
+	return registry, nil
+}
+
+func (Generator) Generate(_ context.Context, dataDir string) (*codegen.Result, error) {
+	registry, err := readRegistryFile(filepath.Join(dataDir, "mirea", "lists_registry_ALL.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	result := &codegen.Result{}
+
+	// Group headings by name
+	headings := make(map[string]*HeadingInfo)
+
+	// Process each heading entry
+	for _, entry := range registry {
+		headingName := extractHeadingName(entry.Title)
+
+		if headings[headingName] == nil {
+			headings[headingName] = &HeadingInfo{
+				Name:          headingName,
+				DirectionCode: core.ParseDirectionCode(entry.SubjectTitle),
+			}
+		}
+
+		// Process competitions for this heading
+		for _, competition := range entry.Competitions {
+			if shouldIgnoreCompetition(competition.CompTypeID) {
+				continue
+			}
+
+			// Add competition IDs to appropriate slices
+			switch competition.CompTypeID {
+			case 4: // Regular
+				headings[headingName].RegularListIDs = append(headings[headingName].RegularListIDs, competition.CompIDs...)
+			case 1: // BVI
+				headings[headingName].BVIListIDs = append(headings[headingName].BVIListIDs, competition.CompIDs...)
+			case 2: // SpecialQuota
+				headings[headingName].SpecialQuotaListIDs = append(headings[headingName].SpecialQuotaListIDs, competition.CompIDs...)
+			case 3: // TargetQuota
+				headings[headingName].TargetQuotaListIDs = append(headings[headingName].TargetQuotaListIDs, competition.CompIDs...)
+			case 7: // DedicatedQuota
+				headings[headingName].DedicatedQuotaListIDs = append(headings[headingName].DedicatedQuotaListIDs, competition.CompIDs...)
+			default:
+				result.Warnf("Unknown competition type %d (%s) of: %s", competition.CompTypeID, competition.CompType, entry.Title)
+			}
+		}
+	}
+
+	// Sort headings for consistent output
+	var sortedNames []string
+	for name := range headings {
+		sortedNames = append(sortedNames, name)
+	}
+	sort.Strings(sortedNames)
+
+	for _, name := range sortedNames {
+		heading := headings[name]
+		if len(heading.RegularListIDs) == 0 {
+			result.Warnf("Missing Regular lists for: %s", name)
+		}
+
+		// The level is derived from the direction code on loading
+		result.Sources = append(result.Sources, &mirea.HTTPHeadingSource{
+			PrettyName:            name,
+			RegularListIDs:        heading.RegularListIDs,
+			BVIListIDs:            heading.BVIListIDs,
+			TargetQuotaListIDs:    heading.TargetQuotaListIDs,
+			DedicatedQuotaListIDs: heading.DedicatedQuotaListIDs,
+			SpecialQuotaListIDs:   heading.SpecialQuotaListIDs,
+			Metadata:              core.HeadingMetadata{DirectionCode: heading.DirectionCode},
+		})
+	}
+
+	return result, nil
+}
@@ -1,8 +0,0 @@
-package main
-
-func main() {
-	printMskSourcesListFunc()
-	printSpbSourcesListFunc()
-	printNnSourcesListFunc()
-	printPermSourcesListFunc()
-}
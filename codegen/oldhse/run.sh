@@ -1,3 +0,0 @@
-#!/bin/bash
-
-go run . > generated.txt
\ No newline at end of file
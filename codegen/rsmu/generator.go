@@ -1,63 +1,68 @@
-package main
+// Package rsmu generates the heading sources of RSMU from the registry of its lists.
+package rsmu
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
+
+	"github.com/trueegorletov/analabit/codegen"
+	"github.com/trueegorletov/analabit/core/source/rsmu"
 )
 
+// registryFile is the registry of the lists saved under rsmu/ of the data directory
+const registryFile = "p2_560.json"
+
+func init() {
+	codegen.Register(Generator{})
+}
+
+// Generator generates the heading sources of RSMU, one per program of the budget lists of the registry.
+type Generator struct{}
+
+func (Generator) Varsity() string {
+	return "rsmu"
+}
+
 // RegistryEntry represents a single entry in the registry JSON
 type RegistryEntry struct {
 	Title string `json:"title"`
 	File  string `json:"file"`
 }
 
-
-
 // ProgramData holds categorized URLs for a program
 type ProgramData struct {
-	TargetQuotaURLs    []string
-	RegularURL         string
-	SpecialQuotaURL    string
-	DedicatedQuotaURL  string
+	TargetQuotaURLs   []string
+	RegularURL        string
+	SpecialQuotaURL   string
+	DedicatedQuotaURL string
 }
 
-func main() {
-	if len(os.Args) < 2 {
-		fmt.Fprintf(os.Stderr, "Usage: %s <registry-json-file>\n", os.Args[0])
-		fmt.Fprintf(os.Stderr, "Example: %s ../../sample_data/rsmu/p2_560.json\n", os.Args[0])
-		os.Exit(1)
-	}
-
-	registryFile := os.Args[1]
-
+func (Generator) Generate(_ context.Context, dataDir string) (*codegen.Result, error) {
 	// Read the registry JSON file
-	registryContent, err := os.ReadFile(registryFile)
+	registryPath := filepath.Join(dataDir, "rsmu", registryFile)
+	registryContent, err := os.ReadFile(registryPath)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error reading registry file: %v\n", err)
-		os.Exit(1)
+		return nil, fmt.Errorf("failed to read registry file: %w", err)
 	}
 
 	// Parse registry
 	var registryEntries []RegistryEntry
 	if err := json.Unmarshal(registryContent, &registryEntries); err != nil {
-		fmt.Fprintf(os.Stderr, "Error parsing registry JSON: %v\n", err)
-		os.Exit(1)
+		return nil, fmt.Errorf("failed to parse registry JSON from %s: %w", registryPath, err)
 	}
 
-	// Get the base directory for individual list files
-	baseDir := filepath.Dir(registryFile)
-
 	// Process registry entries and categorize by program
-	programs := processRegistryEntries(registryEntries, baseDir)
+	programs := processRegistryEntries(registryEntries)
 
-	// Generate HTTPHeadingSource entries
-	generateHTTPHeadingSources(programs, registryFile)
+	return generateHTTPHeadingSources(programs), nil
 }
 
-func processRegistryEntries(registryEntries []RegistryEntry, baseDir string) map[string]*ProgramData {
+func processRegistryEntries(registryEntries []RegistryEntry) map[string]*ProgramData {
 	programs := make(map[string]*ProgramData)
 
 	for _, entry := range registryEntries {
@@ -95,41 +100,30 @@ func processRegistryEntries(registryEntries []RegistryEntry, baseDir string) map
 	return programs
 }
 
-func generateHTTPHeadingSources(programs map[string]*ProgramData, registryFile string) {
-	fmt.Println("// Generated RSMU HTTPHeadingSource entries")
-	fmt.Println("// Based on registry:", registryFile)
-	fmt.Println()
-
-	for programName, data := range programs {
-		// Generate HTTPHeadingSource entry
-		fmt.Printf("&rsmu.HTTPHeadingSource{\n")
-		fmt.Printf("\tProgramName: \"%s\",\n", programName)
-
-		// Add TargetQuotaListURLs if any
-		if len(data.TargetQuotaURLs) > 0 {
-			fmt.Printf("\tTargetQuotaListURLs: []string{\n")
-			for _, url := range data.TargetQuotaURLs {
-				fmt.Printf("\t\t\"%s\",\n", url)
-			}
-			fmt.Printf("\t},\n")
-		}
+func generateHTTPHeadingSources(programs map[string]*ProgramData) *codegen.Result {
+	// Sort programs for consistent output
+	var programNames []string
+	for programName := range programs {
+		programNames = append(programNames, programName)
+	}
+	sort.Strings(programNames)
 
-		// Add other URLs if they exist
-		if data.RegularURL != "" {
-			fmt.Printf("\tRegularListURL: \"%s\",\n", data.RegularURL)
-		}
-		if data.SpecialQuotaURL != "" {
-			fmt.Printf("\tSpecialQuotaListURL: \"%s\",\n", data.SpecialQuotaURL)
-		}
-		if data.DedicatedQuotaURL != "" {
-			fmt.Printf("\tDedicatedQuotaListURL: \"%s\",\n", data.DedicatedQuotaURL)
+	result := &codegen.Result{}
+	for _, programName := range programNames {
+		data := programs[programName]
+		if data.RegularURL == "" {
+			result.Warnf("Missing regular list for: %s", programName)
 		}
 
-		fmt.Printf("},\n")
-		fmt.Println()
+		result.Sources = append(result.Sources, &rsmu.HTTPHeadingSource{
+			ProgramName:           programName,
+			TargetQuotaListURLs:   data.TargetQuotaURLs,
+			RegularListURL:        data.RegularURL,
+			SpecialQuotaListURL:   data.SpecialQuotaURL,
+			DedicatedQuotaListURL: data.DedicatedQuotaURL,
+		})
 	}
-
-	fmt.Printf("// Total RSMU headings generated: %d\n", len(programs))
+	return result
 }
 
 func extractProgramName(entry RegistryEntry) string {
@@ -138,7 +132,7 @@ func extractProgramName(entry RegistryEntry) string {
 
 func cleanProgramName(programName string) string {
 	cleaned := strings.TrimSpace(programName)
-	
+
 	// Competition type markers to split on
 	competitionMarkers := []string{
 		" Целевая квота",
@@ -147,7 +141,7 @@ func cleanProgramName(programName string) string {
 		" Отдельная квота",
 		" Контракт",
 	}
-	
+
 	// Find the first competition marker and extract everything before it
 	programPart := cleaned
 	for _, marker := range competitionMarkers {
@@ -156,7 +150,7 @@ func cleanProgramName(programName string) string {
 			break
 		}
 	}
-	
+
 	// Look for the FIRST parentheses pair in the program part
 	start := strings.Index(programPart, "(")
 	if start != -1 {
@@ -167,14 +161,14 @@ func cleanProgramName(programName string) string {
 			return strings.TrimSpace(programPart[start+1 : start+end])
 		}
 	}
-	
+
 	// If no parentheses found, return the cleaned program part
 	return strings.TrimSpace(programPart)
 }
 
 func detectCompetitionType(title string) string {
 	titleLower := strings.ToLower(title)
-	
+
 	if strings.Contains(titleLower, "целевая квота") {
 		return "target"
 	}
@@ -188,7 +182,7 @@ func detectCompetitionType(title string) string {
 		return "dedicated"
 	}
 	// Note: contract competitions are excluded
-	
+
 	return "regular" // Default to regular
 }
 
@@ -197,4 +191,4 @@ func convertToURL(filePath string) string {
 	// This is a placeholder - in real usage, this would be the actual URL
 	// For now, we'll use a placeholder URL structure
 	return fmt.Sprintf("https://submitted.rsmu.ru/data/%s", filePath)
-}
\ No newline at end of file
+}
@@ -0,0 +1,192 @@
+// Package spbstu generates the heading sources of SPbSTU from the registries of its lists by competition type.
+package spbstu
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/trueegorletov/analabit/codegen"
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/source/spbstu"
+)
+
+func init() {
+	codegen.Register(Generator{})
+}
+
+// Generator generates the heading sources of SPbSTU from the registries saved from its lists API
+// under spbstu/ of the data directory.
+type Generator struct{}
+
+func (Generator) Varsity() string {
+	return "spbstu"
+}
+
+// RegistryEntry represents a single entry in the SPbSTU registry JSON.
+type RegistryEntry struct {
+	ID    int    `json:"id"`
+	Title string `json:"title"`
+}
+
+// RegistryFile represents the structure of SPbSTU registry JSON files.
+type RegistryFile struct {
+	CodeList []RegistryEntry `json:"code_list"`
+}
+
+// HeadingInfo stores information about a heading and its associated lists.
+type HeadingInfo struct {
+	Name                 string
+	DirectionCode        string
+	RegularListID        int
+	TargetQuotaListIDs   []int
+	DedicatedQuotaListID int
+	SpecialQuotaListID   int
+}
+
+// noteDirectionCode takes the heading's direction code from the registry title of one of its lists,
+// unless it's already known.
+func (h *HeadingInfo) noteDirectionCode(title string) {
+	if h.DirectionCode == "" {
+		h.DirectionCode = core.ParseDirectionCode(title)
+	}
+}
+
+// extractHeadingName extracts clean heading names by removing registry code prefixes
+// and optionally target organization suffixes in parentheses for target quota lists.
+func extractHeadingName(title string, isTargetQuota bool) string {
+	// Remove DD.DD.DD pattern prefix
+	codePattern := regexp.MustCompile(`^\d{2}\.\d{2}\.\d{2}\s*`)
+	cleaned := codePattern.ReplaceAllString(title, "")
+
+	// Only remove organization suffix for target quota lists (for debugging purposes)
+	if isTargetQuota {
+		suffixPattern := regexp.MustCompile(`\s*\([^)]*\)\s*$`)
+		cleaned = suffixPattern.ReplaceAllString(cleaned, "")
+	}
+
+	return strings.TrimSpace(cleaned)
+}
+
+// shouldIgnoreEntry checks if an entry should be ignored (e.g., (ИНО) entries in Regular&BVI lists)
+func shouldIgnoreEntry(title string, registryType string) bool {
+	// Ignore (ИНО) entries in Regular&BVI lists
+	if registryType == "regular" && strings.Contains(title, "(ИНО)") {
+		return true
+	}
+	return false
+}
+
+// readRegistryFile reads and parses a SPbSTU registry JSON file.
+func readRegistryFile(filename string) (*RegistryFile, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file %s: %w", filename, err)
+	}
+
+	var registry RegistryFile
+	if err := json.Unmarshal(data, &registry); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON from %s: %w", filename, err)
+	}
+
+	return &registry, nil
+}
+
+func (Generator) Generate(_ context.Context, dataDir string) (*codegen.Result, error) {
+	// Define the registry files to process
+	registryFiles := map[string]string{
+		"regular":      "full_lists_registry_REGULARBVI.json",
+		"special":      "full_lists_registry_SPECIALQUOTA.json",
+		"dedicated":    "full_lists_registry_DEDICATEDQUOTA.json",
+		"targetquotas": "full_lists_registry_TARGETQUOTAS.json",
+	}
+
+	// Read all registry files
+	registries := make(map[string]*RegistryFile)
+	for name, filename := range registryFiles {
+		registry, err := readRegistryFile(filepath.Join(dataDir, "spbstu", filename))
+		if err != nil {
+			return nil, err
+		}
+		registries[name] = registry
+	}
+
+	// Group headings by name
+	headings := make(map[string]*HeadingInfo)
+	heading := func(name string) *HeadingInfo {
+		if headings[name] == nil {
+			headings[name] = &HeadingInfo{
+				Name:                 name,
+				RegularListID:        -1,
+				DedicatedQuotaListID: -1,
+				SpecialQuotaListID:   -1,
+			}
+		}
+		return headings[name]
+	}
+
+	// Process Regular/BVI lists
+	for _, entry := range registries["regular"].CodeList {
+		// Skip (ИНО) entries in Regular&BVI lists
+		if shouldIgnoreEntry(entry.Title, "regular") {
+			continue
+		}
+
+		h := heading(extractHeadingName(entry.Title, false))
+		h.RegularListID = entry.ID
+		h.noteDirectionCode(entry.Title)
+	}
+
+	// Process Special Quota lists
+	for _, entry := range registries["special"].CodeList {
+		h := heading(extractHeadingName(entry.Title, false))
+		h.SpecialQuotaListID = entry.ID
+		h.noteDirectionCode(entry.Title)
+	}
+
+	// Process Dedicated Quota lists
+	for _, entry := range registries["dedicated"].CodeList {
+		h := heading(extractHeadingName(entry.Title, false))
+		h.DedicatedQuotaListID = entry.ID
+		h.noteDirectionCode(entry.Title)
+	}
+
+	// Process Target Quota lists
+	for _, entry := range registries["targetquotas"].CodeList {
+		h := heading(extractHeadingName(entry.Title, true)) // Remove organization suffix for target quotas
+		h.TargetQuotaListIDs = append(h.TargetQuotaListIDs, entry.ID)
+		h.noteDirectionCode(entry.Title)
+	}
+
+	// Sort headings for consistent output
+	var sortedNames []string
+	for name := range headings {
+		sortedNames = append(sortedNames, name)
+	}
+	sort.Strings(sortedNames)
+
+	result := &codegen.Result{}
+	for _, name := range sortedNames {
+		h := headings[name]
+		if h.RegularListID == -1 {
+			result.Warnf("Missing Regular&BVI list for: %s", name)
+		}
+
+		// The capacities are fetched on loading and the level is derived from the direction code
+		result.Sources = append(result.Sources, &spbstu.HTTPHeadingSource{
+			PrettyName:           h.Name,
+			RegularListID:        h.RegularListID,
+			TargetQuotaListIDs:   h.TargetQuotaListIDs,
+			DedicatedQuotaListID: h.DedicatedQuotaListID,
+			SpecialQuotaListID:   h.SpecialQuotaListID,
+			Metadata:             core.HeadingMetadata{DirectionCode: h.DirectionCode},
+		})
+	}
+
+	return result, nil
+}
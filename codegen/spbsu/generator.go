@@ -0,0 +1,361 @@
+// Package spbsu generates the heading sources of SPbSU from the registries of its competitive groups.
+package spbsu
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/trueegorletov/analabit/codegen"
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/source/spbsu"
+)
+
+func init() {
+	codegen.Register(Generator{})
+}
+
+// Generator generates the heading sources of SPbSU from the registries saved under spbsu/ of the data
+// directory: the list IDs come from the NEW format registries of the current lists API and the
+// capacities from the LEGACY format ones, matched by external ID.
+type Generator struct{}
+
+func (Generator) Varsity() string {
+	return "spbsu"
+}
+
+const (
+	primaryNewFile        = "primary-NEW.json"
+	targetQuotaNewFile    = "target_quota-NEW.json"
+	primaryLegacyFile     = "primary-LEGACY.json"
+	targetQuotaLegacyFile = "target_quota-LEGACY.json"
+	targetQuotaMarker     = "Целевой прием"
+	specialQuotaMarker    = "Особая квота"
+	dedicatedQuotaMarker  = "Отдельная квота"
+	quotaTypeRegular      = "regular"
+	quotaTypeTarget       = "target"
+	quotaTypeSpecial      = "special"
+	quotaTypeDedicated    = "dedicated"
+)
+
+type NewName struct {
+	ID                   int    `json:"id"`
+	CompetitiveGroupID   int    `json:"competitive_group_id"`
+	CompetitiveGroupName string `json:"competitive_group_name"`
+	CreatedAt            string `json:"created_at"`
+	UpdatedAt            string `json:"updated_at"`
+}
+
+// ListEntry represents a LEGACY format entry
+type ListEntry struct {
+	ID                     int      `json:"id"`
+	CompetitiveName        string   `json:"competitive_name"`
+	FormPaymentsID         int      `json:"form_payments_id"`
+	FormEducationID        int      `json:"form_education_id"`
+	ScenarioID             int      `json:"scenario_id"`
+	ExternalID             string   `json:"external_id"`
+	CompetitiveGroupPlaces int      `json:"competitive_group_places"`
+	NewName                *NewName `json:"new_name"`
+}
+
+// NewFormatEntry represents a NEW format entry
+type NewFormatEntry struct {
+	ID         int     `json:"id"`
+	Name       string  `json:"name"`
+	Code       int     `json:"code"`
+	Visible    bool    `json:"visible"`
+	ExternalID string  `json:"external_id"`
+	CustomName *string `json:"custom_name"`
+}
+
+// NewFormatResponse represents the NEW format API response
+type NewFormatResponse struct {
+	Data []NewFormatEntry `json:"data"`
+}
+
+type ListIDCapacity struct {
+	ID       int
+	Capacity int
+}
+
+// HeadingInfo stores the lists of a heading with their capacities.
+type HeadingInfo struct {
+	RegularBVI     []ListIDCapacity
+	SpecialQuota   []ListIDCapacity
+	DedicatedQuota []ListIDCapacity
+	TargetQuota    []ListIDCapacity
+}
+
+func readEntriesFromFile(filePath string) ([]ListEntry, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	var entries []ListEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON from %s: %w", filePath, err)
+	}
+	return entries, nil
+}
+
+func readNewFormatFromFile(filePath string) ([]NewFormatEntry, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	var response NewFormatResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON from %s: %w", filePath, err)
+	}
+	return response.Data, nil
+}
+
+// extractEducationalProgram extracts the educational program name from a competitive name
+// by taking the last part after splitting by semicolons.
+// For target quota entries, it extracts the part right after "Целевой прием"
+func extractEducationalProgram(competitiveName string) string {
+	parts := splitCompetitiveName(competitiveName)
+
+	// Special handling for target quota entries
+	if strings.Contains(competitiveName, targetQuotaMarker) {
+		for i, part := range parts {
+			if strings.TrimSpace(part) == targetQuotaMarker && i+1 < len(parts) {
+				return strings.TrimSpace(parts[i+1])
+			}
+		}
+	}
+
+	return strings.TrimSpace(parts[len(parts)-1])
+}
+
+// splitCompetitiveName splits a competitive name by the semicolons that aren't parenthesized, since
+// some program names have semicolons of their own, e.g. "(древнегреческий и латинский языки; античная литература)".
+func splitCompetitiveName(competitiveName string) []string {
+	var parts []string
+	depth, start := 0, 0
+	for i, r := range competitiveName {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ';':
+			if depth == 0 {
+				parts = append(parts, competitiveName[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, competitiveName[start:])
+}
+
+// determineQuotaType determines the quota type based on the competitive name and new_name field
+func determineQuotaType(competitiveName string, newName *NewName) string {
+	if newName != nil && strings.Contains(newName.CompetitiveGroupName, specialQuotaMarker) {
+		return quotaTypeSpecial
+	}
+	return determineQuotaTypeFromNewFormat(competitiveName)
+}
+
+// determineQuotaTypeFromNewFormat determines quota type from NEW format name field
+func determineQuotaTypeFromNewFormat(name string) string {
+	switch {
+	case strings.Contains(name, specialQuotaMarker):
+		return quotaTypeSpecial
+	case strings.Contains(name, dedicatedQuotaMarker):
+		return quotaTypeDedicated
+	case strings.Contains(name, targetQuotaMarker):
+		return quotaTypeTarget
+	default:
+		return quotaTypeRegular
+	}
+}
+
+func (Generator) Generate(_ context.Context, dataDir string) (*codegen.Result, error) {
+	dir := filepath.Join(dataDir, "spbsu")
+
+	primaryNew, err := readNewFormatFromFile(filepath.Join(dir, primaryNewFile))
+	if err != nil {
+		return nil, err
+	}
+	targetQuotaNew, err := readNewFormatFromFile(filepath.Join(dir, targetQuotaNewFile))
+	if err != nil {
+		return nil, err
+	}
+	primaryLegacy, err := readEntriesFromFile(filepath.Join(dir, primaryLegacyFile))
+	if err != nil {
+		return nil, err
+	}
+	targetQuotaLegacy, err := readEntriesFromFile(filepath.Join(dir, targetQuotaLegacyFile))
+	if err != nil {
+		return nil, err
+	}
+
+	result := &codegen.Result{}
+
+	// Build lookup maps from LEGACY data
+	legacyByExternalID := make(map[string]ListEntry)
+	legacyByProgram := make(map[string][]ListEntry)
+	for _, entry := range append(append([]ListEntry(nil), primaryLegacy...), targetQuotaLegacy...) {
+		legacyByExternalID[entry.ExternalID] = entry
+		if program := extractEducationalProgram(entry.CompetitiveName); program != "" {
+			legacyByProgram[program] = append(legacyByProgram[program], entry)
+		}
+	}
+
+	processedLegacyIDs := make(map[string]bool)
+
+	// findLegacy finds the LEGACY entry of a NEW format one by its external ID, falling back to the
+	// entries of the same program that match
+	findLegacy := func(newEntry NewFormatEntry, program string, matches func(ListEntry) bool) *ListEntry {
+		if legacy, found := legacyByExternalID[newEntry.ExternalID]; found {
+			processedLegacyIDs[newEntry.ExternalID] = true
+			return &legacy
+		}
+		for _, entry := range legacyByProgram[program] {
+			if matches(entry) {
+				processedLegacyIDs[entry.ExternalID] = true
+				return &entry
+			}
+		}
+		return nil
+	}
+
+	// Build headings from NEW format files using LEGACY capacity data
+	headings := make(map[string]*HeadingInfo)
+	heading := func(program string) *HeadingInfo {
+		if headings[program] == nil {
+			headings[program] = &HeadingInfo{}
+		}
+		return headings[program]
+	}
+
+	// Process primary NEW entries (all except target quota)
+	for _, newEntry := range primaryNew {
+		program := extractEducationalProgram(newEntry.Name)
+		if program == "" {
+			result.Warnf("NEW: Empty educational program for ID=%d, name=%q", newEntry.ID, newEntry.Name)
+			continue
+		}
+
+		quotaType := determineQuotaTypeFromNewFormat(newEntry.Name)
+		legacyEntry := findLegacy(newEntry, program, func(entry ListEntry) bool {
+			return determineQuotaType(entry.CompetitiveName, entry.NewName) == quotaType
+		})
+		if legacyEntry == nil {
+			result.Warnf("NEW: No matching LEGACY entry for ID=%d, external_id=%s, program=%q", newEntry.ID, newEntry.ExternalID, program)
+			continue
+		}
+
+		h := heading(program)
+		idcap := ListIDCapacity{ID: newEntry.ID, Capacity: legacyEntry.CompetitiveGroupPlaces}
+		switch quotaType {
+		case quotaTypeSpecial:
+			h.SpecialQuota = append(h.SpecialQuota, idcap)
+		case quotaTypeDedicated:
+			h.DedicatedQuota = append(h.DedicatedQuota, idcap)
+		default:
+			h.RegularBVI = append(h.RegularBVI, idcap)
+		}
+	}
+
+	// Process target quota NEW entries (always target quota)
+	for _, newEntry := range targetQuotaNew {
+		program := extractEducationalProgram(newEntry.Name)
+		if program == "" {
+			result.Warnf("NEW: Empty educational program for target quota ID=%d, name=%q", newEntry.ID, newEntry.Name)
+			continue
+		}
+
+		legacyEntry := findLegacy(newEntry, program, func(entry ListEntry) bool {
+			return determineQuotaType(entry.CompetitiveName, entry.NewName) == quotaTypeTarget ||
+				strings.Contains(entry.CompetitiveName, "Целевой")
+		})
+		if legacyEntry == nil {
+			result.Warnf("NEW: No matching LEGACY entry for target quota ID=%d, external_id=%s, program=%q", newEntry.ID, newEntry.ExternalID, program)
+			continue
+		}
+
+		h := heading(program)
+		h.TargetQuota = append(h.TargetQuota, ListIDCapacity{ID: newEntry.ID, Capacity: legacyEntry.CompetitiveGroupPlaces})
+	}
+
+	// Check for unprocessed LEGACY entries
+	for _, entry := range primaryLegacy {
+		if !processedLegacyIDs[entry.ExternalID] {
+			result.Warnf("LEGACY: Unmatched primary entry ID=%d, external_id=%s, program=%q", entry.ID, entry.ExternalID, extractEducationalProgram(entry.CompetitiveName))
+		}
+	}
+	for _, entry := range targetQuotaLegacy {
+		if !processedLegacyIDs[entry.ExternalID] {
+			result.Warnf("LEGACY: Unmatched target quota entry ID=%d, external_id=%s, program=%q", entry.ID, entry.ExternalID, extractEducationalProgram(entry.CompetitiveName))
+		}
+	}
+
+	// Sort headings for consistent output
+	programs := make([]string, 0, len(headings))
+	for program := range headings {
+		programs = append(programs, program)
+	}
+	sort.Strings(programs)
+
+	for _, program := range programs {
+		hs := newHeadingSource(program, headings[program])
+		if hs.RegularListID == -1 || hs.Capacities.Regular == 0 {
+			result.Warnf("Skipped heading with missing or zero regular capacity: %s", program)
+			continue
+		}
+		result.Sources = append(result.Sources, hs)
+	}
+
+	return result, nil
+}
+
+// newHeadingSource makes the heading source of a heading: the first list of each competition type and
+// all target quota lists, with the places of all lists of a type summed up.
+func newHeadingSource(program string, info *HeadingInfo) *spbsu.HttpHeadingSource {
+	firstID := func(lists []ListIDCapacity) int {
+		if len(lists) == 0 {
+			return -1
+		}
+		return lists[0].ID
+	}
+	sumCapacity := func(lists []ListIDCapacity) int {
+		sum := 0
+		for _, c := range lists {
+			sum += c.Capacity
+		}
+		return sum
+	}
+
+	hs := &spbsu.HttpHeadingSource{
+		PrettyName:           program,
+		RegularListID:        firstID(info.RegularBVI),
+		DedicatedQuotaListID: firstID(info.DedicatedQuota),
+		SpecialQuotaListID:   firstID(info.SpecialQuota),
+		Capacities: core.Capacities{
+			Regular:        sumCapacity(info.RegularBVI),
+			TargetQuota:    sumCapacity(info.TargetQuota),
+			DedicatedQuota: sumCapacity(info.DedicatedQuota),
+			SpecialQuota:   sumCapacity(info.SpecialQuota),
+		},
+	}
+	for _, c := range info.TargetQuota {
+		hs.TargetQuotaListIDs = append(hs.TargetQuotaListIDs, c.ID)
+	}
+
+	// Several target quota lists are detailed target quotas, each with its own capacity
+	if len(info.TargetQuota) > 1 {
+		hs.Capacities.TargetSubQuotas = make(map[string]int, len(info.TargetQuota))
+		for _, c := range info.TargetQuota {
+			hs.Capacities.TargetSubQuotas[strconv.Itoa(c.ID)] = c.Capacity
+		}
+	}
+
+	return hs
+}
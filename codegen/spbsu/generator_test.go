@@ -0,0 +1,37 @@
+package spbsu
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/trueegorletov/analabit/core/source/spbsu"
+)
+
+func TestGenerate(t *testing.T) {
+	result, err := Generator{}.Generate(context.Background(), filepath.Join("..", "..", "sample_data"))
+	require.NoError(t, err)
+	require.NotEmpty(t, result.Sources)
+
+	byName := make(map[string]*spbsu.HttpHeadingSource)
+	for _, hs := range result.Sources {
+		h := hs.(*spbsu.HttpHeadingSource)
+		byName[h.PrettyName] = h
+	}
+
+	// The semicolon of the program name doesn't split it
+	h := byName["Классическая филология (древнегреческий и латинский языки; античная литература) (с дополнительной квалификацией «Учитель древнегреческого и латинского языков»)"]
+	require.NotNil(t, h)
+	assert.Equal(t, 562, h.RegularListID)
+	assert.Equal(t, []int{672}, h.TargetQuotaListIDs)
+	assert.Equal(t, 7, h.Capacities.Regular)
+
+	// Several target quota lists are detailed target quotas
+	h = byName["Гидрометеорология"]
+	require.NotNil(t, h)
+	assert.Equal(t, []int{1318, 933}, h.TargetQuotaListIDs)
+	assert.Equal(t, map[string]int{"1318": 1, "933": 0}, h.Capacities.TargetSubQuotas)
+}
@@ -0,0 +1,98 @@
+package codegen
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+
+	"github.com/trueegorletov/analabit/core/registry"
+	"github.com/trueegorletov/analabit/core/source"
+)
+
+// Merge returns the heading sources of a varsity updated with the generated ones: the headings found in
+// both are updated in place, the new ones are appended in the generated order and the ones the generator
+// didn't find are dropped. The fields a generator leaves zero keep their current values, as some
+// registries lack e.g. capacities or direction codes.
+func Merge(current, generated []source.HeadingSource) []source.HeadingSource {
+	generatedKeys := headingKeys(generated)
+	byKey := make(map[string]source.HeadingSource, len(generated))
+	for i, hs := range generated {
+		byKey[generatedKeys[i]] = hs
+	}
+
+	merged := make([]source.HeadingSource, 0, len(generated))
+	kept := make(map[string]bool, len(current))
+	for i, key := range headingKeys(current) {
+		if hs, ok := byKey[key]; ok {
+			merged = append(merged, mergeHeading(current[i], hs))
+			kept[key] = true
+		}
+	}
+	for i, key := range generatedKeys {
+		if !kept[key] {
+			merged = append(merged, generated[i])
+		}
+	}
+	return merged
+}
+
+// mergeHeading returns a copy of the generated heading source with its zero fields set from the current one.
+func mergeHeading(current, generated source.HeadingSource) source.HeadingSource {
+	currentValue, generatedValue := reflect.Indirect(reflect.ValueOf(current)), reflect.Indirect(reflect.ValueOf(generated))
+	if currentValue.Type() != generatedValue.Type() || currentValue.Kind() != reflect.Struct {
+		return generated
+	}
+
+	merged := reflect.New(generatedValue.Type())
+	merged.Elem().Set(generatedValue)
+	for i := 0; i < merged.Elem().NumField(); i++ {
+		f := merged.Elem().Field(i)
+		if f.CanSet() && isEmpty(f) {
+			f.Set(currentValue.Field(i))
+		}
+	}
+	return merged.Interface().(source.HeadingSource)
+}
+
+func isEmpty(v reflect.Value) bool {
+	return v.IsZero() || ((v.Kind() == reflect.Slice || v.Kind() == reflect.Map) && v.Len() == 0)
+}
+
+// definitionPath returns the path of the definition file of a varsity in dir.
+func definitionPath(dir, varsity string) string {
+	return filepath.Join(dir, varsity+".yaml")
+}
+
+// ReadDefinition decodes the definition file of a varsity in dir.
+func ReadDefinition(dir, varsity string) (source.VarsityDefinition, error) {
+	data, err := os.ReadFile(definitionPath(dir, varsity))
+	if err != nil {
+		return source.VarsityDefinition{}, err
+	}
+	def, err := registry.DecodeDefinition(data)
+	if err != nil {
+		return source.VarsityDefinition{}, fmt.Errorf("%s: %w", definitionPath(dir, varsity), err)
+	}
+	return def, nil
+}
+
+// WriteDefinition replaces the heading sources in the definition file of a varsity in dir, keeping the
+// rest of the file. The file is left untouched if the result doesn't decode.
+func WriteDefinition(dir, varsity string, sources []source.HeadingSource) error {
+	path := definitionPath(dir, varsity)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	updated, err := registry.ReplaceSources(data, sources)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	if _, err := registry.DecodeDefinition(updated); err != nil {
+		return fmt.Errorf("%s: the updated definition is invalid: %w", path, err)
+	}
+
+	return os.WriteFile(path, updated, 0644)
+}
@@ -0,0 +1,88 @@
+package codegen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/source"
+	"github.com/trueegorletov/analabit/core/source/spbstu"
+)
+
+func TestMerge(t *testing.T) {
+	current := []source.HeadingSource{
+		&spbstu.HTTPHeadingSource{PrettyName: "Химия", RegularListID: 2, Capacities: core.Capacities{Regular: 20}},
+		&spbstu.HTTPHeadingSource{PrettyName: "Биология", RegularListID: 3},
+		&spbstu.HTTPHeadingSource{PrettyName: "Физика", RegularListID: 1, Metadata: core.HeadingMetadata{DirectionCode: "03.03.02"}},
+	}
+	generated := []source.HeadingSource{
+		&spbstu.HTTPHeadingSource{PrettyName: "Геология", RegularListID: 6},
+		&spbstu.HTTPHeadingSource{PrettyName: "Физика", RegularListID: 1, Capacities: core.Capacities{Regular: 12}},
+		&spbstu.HTTPHeadingSource{PrettyName: "Химия", RegularListID: 5, TargetQuotaListIDs: []int{7}},
+	}
+
+	// The current order is kept, the new headings follow it
+	assert.Equal(t, []source.HeadingSource{
+		&spbstu.HTTPHeadingSource{PrettyName: "Химия", RegularListID: 5, TargetQuotaListIDs: []int{7}, Capacities: core.Capacities{Regular: 20}},
+		&spbstu.HTTPHeadingSource{PrettyName: "Физика", RegularListID: 1, Capacities: core.Capacities{Regular: 12}, Metadata: core.HeadingMetadata{DirectionCode: "03.03.02"}},
+		&spbstu.HTTPHeadingSource{PrettyName: "Геология", RegularListID: 6},
+	}, Merge(current, generated))
+
+	// The generated heading sources aren't modified
+	assert.Equal(t, core.Capacities{}, generated[2].(*spbstu.HTTPHeadingSource).Capacities)
+}
+
+func TestWriteDefinition(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "spbstu.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`version: 1
+code: spbstu
+order: 8
+name: СПбПУ
+# Kept as is
+load_timeout: 1h
+sources:
+  - type: spbstu.http
+    pretty_name: Физика
+    regular_list_id: 1
+`), 0644))
+
+	def, err := ReadDefinition(dir, "spbstu")
+	require.NoError(t, err)
+	sources := Merge(def.HeadingSources, []source.HeadingSource{
+		&spbstu.HTTPHeadingSource{PrettyName: "Физика", RegularListID: 1, TargetQuotaListIDs: []int{2, 3}, Capacities: core.Capacities{Regular: 10}},
+		&spbstu.HTTPHeadingSource{PrettyName: "Химия", RegularListID: 4, DedicatedQuotaListID: -1},
+	})
+	require.NoError(t, WriteDefinition(dir, "spbstu", sources))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, `version: 1
+code: spbstu
+order: 8
+name: СПбПУ
+# Kept as is
+load_timeout: 1h
+sources:
+  - type: spbstu.http
+    pretty_name: Физика
+    regular_list_id: 1
+    target_quota_list_ids: [2, 3]
+    capacities: {regular: 10}
+  - type: spbstu.http
+    pretty_name: Химия
+    regular_list_id: 4
+    dedicated_quota_list_id: -1
+`, string(data))
+
+	def, err = ReadDefinition(dir, "spbstu")
+	require.NoError(t, err)
+	assert.True(t, Compare(def.HeadingSources, sources).Empty())
+
+	_, err = ReadDefinition(dir, "mirea")
+	assert.Error(t, err)
+}
@@ -5,13 +5,14 @@ import (
 	"container/heap"
 	"container/list" // Added for O(1) queue operations
 	"encoding/gob"
+	"encoding/json"
 	"fmt"
 	"log/slog"
+	"math"
 	"math/rand"
 	"sort"
 	"strings"
 	"sync"
-	"time"
 
 	"github.com/trueegorletov/analabit/core/utils"
 )
@@ -27,6 +28,13 @@ type Capacities struct {
 	TargetQuota    int
 	DedicatedQuota int
 	SpecialQuota   int
+	// TargetSubQuotas splits TargetQuota into named detailed target quotas (e.g. per employer organization),
+	// keyed by the identifier of the sub-quota's list. Every sub-quota ranks its own applicants and admits
+	// at most its own capacity; target quota applicants outside of any sub-quota share the rest of TargetQuota.
+	TargetSubQuotas map[string]int
+	// Paid is the number of paid (contract) places. They are not part of Total: paid enrollment runs
+	// after the budget one, among the students left without a budget seat.
+	Paid int
 }
 
 // Helper for Capacities to get quota capacity by type.
@@ -46,6 +54,11 @@ func (c Capacities) quotaCapacity(compType Competition) int {
 	}
 }
 
+// Total returns the sum of all quotas and the Regular capacity.
+func (c Capacities) Total() int {
+	return c.Regular + c.TargetQuota + c.DedicatedQuota + c.SpecialQuota
+}
+
 func (c Capacities) String() string {
 	return fmt.Sprintf("[S%d/D%d/T%d/G%d]",
 		c.SpecialQuota, c.DedicatedQuota, c.TargetQuota, c.Regular,
@@ -54,12 +67,28 @@ func (c Capacities) String() string {
 
 // PrintRvalue returns a string representation of the Capacities struct as a Go literal.
 func (c Capacities) PrintRvalue() string {
+	subQuotas := ""
+	if len(c.TargetSubQuotas) > 0 {
+		var b strings.Builder
+		b.WriteString("\n\t\t\tTargetSubQuotas: map[string]int{\n")
+		for _, name := range c.SubQuotaNames() {
+			fmt.Fprintf(&b, "\t\t\t\t%q: %d,\n", name, c.TargetSubQuotas[name])
+		}
+		b.WriteString("\t\t\t},")
+		subQuotas = b.String()
+	}
+
+	paid := ""
+	if c.Paid > 0 {
+		paid = fmt.Sprintf("\n\t\t\tPaid:           %d,", c.Paid)
+	}
+
 	return fmt.Sprintf(`Capacities{
 			Regular:        %d,
 			TargetQuota:    %d,
 			DedicatedQuota: %d,
-			SpecialQuota:   %d,
-		}`, c.Regular, c.TargetQuota, c.DedicatedQuota, c.SpecialQuota)
+			SpecialQuota:   %d,%s%s
+		}`, c.Regular, c.TargetQuota, c.DedicatedQuota, c.SpecialQuota, subQuotas, paid)
 }
 
 type Competition int
@@ -71,6 +100,8 @@ const (
 	CompetitionTargetQuota
 	CompetitionDedicatedQuota
 	CompetitionSpecialQuota
+	// CompetitionPaid is the competition for paid (contract) places, enrolled after all budget competitions.
+	CompetitionPaid
 )
 
 func (c Competition) String() string {
@@ -85,11 +116,53 @@ func (c Competition) String() string {
 		return "DedicatedQuota"
 	case CompetitionSpecialQuota:
 		return "SpecialQuota"
+	case CompetitionPaid:
+		return "Paid"
 	default:
 		return "UnknownCompetition"
 	}
 }
 
+// ParseCompetition returns the competition with the given name as returned by Competition.String, ignoring case.
+func ParseCompetition(name string) (Competition, error) {
+	for c := CompetitionRegular; c <= CompetitionPaid; c++ {
+		if strings.EqualFold(name, c.String()) {
+			return c, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown competition %q", name)
+}
+
+// UnmarshalJSON decodes a competition given either as its number or by its name, like the "TargetQuota" one the
+// CLI whatif command takes (see ParseCompetition), so that the API requests may name competitions too.
+func (c *Competition) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		var n int
+		if err := json.Unmarshal(data, &n); err != nil {
+			return fmt.Errorf("competition must be a number or a name: %s", data)
+		}
+		*c = Competition(n)
+		return nil
+	}
+
+	parsed, err := ParseCompetition(name)
+	if err != nil {
+		return err
+	}
+	*c = parsed
+	return nil
+}
+
+// IsQuota reports whether the competition is one of the budget quotas (target, dedicated or special).
+func (c Competition) IsQuota() bool {
+	return c == CompetitionTargetQuota || c == CompetitionDedicatedQuota || c == CompetitionSpecialQuota
+}
+
 // Application represents a student's application to a specific heading.
 type Application struct {
 	// The student who made this application.
@@ -104,6 +177,12 @@ type Application struct {
 	competitionType Competition
 	// The score of the student in this application, used for further uploading
 	score int
+	// Subject scores in the order of the heading's subject priority, used to break ties between equal scores.
+	subjectScores []int
+	// Individual achievement points, the last tie-break criterion.
+	achievementScore int
+	// Name of the detailed target quota the application competes in; empty for all other applications.
+	subQuota string
 }
 
 func (a *Application) RatingPlace() int {
@@ -130,6 +209,20 @@ func (a *Application) Score() int {
 	return a.score
 }
 
+// SubjectScores returns the subject scores of the application in the order of the heading's subject priority.
+func (a *Application) SubjectScores() []int {
+	return a.subjectScores
+}
+
+func (a *Application) AchievementScore() int {
+	return a.achievementScore
+}
+
+// SubQuota returns the name of the detailed target quota the application competes in, if any.
+func (a *Application) SubQuota() string {
+	return a.subQuota
+}
+
 // Student represents a student in the system.
 type Student struct {
 	mu sync.Mutex
@@ -137,6 +230,9 @@ type Student struct {
 	IDValue string
 	// List of applications made by the student, sorted by priority (ascending, e.g., priority 1 first).
 	applications []*Application
+	// Applications to paid places, sorted by priority. Kept apart from the budget applications, which
+	// take part in the budget enrollment only.
+	paidApplications []*Application
 	// If true, the student has withdrawn their application from this varsity and is ignored in calculations.
 	quit bool
 	// If true, the student has submitted their original documents for this varsity. Students who did can't be
@@ -152,6 +248,11 @@ func (s *Student) Applications() []*Application {
 	return s.applications
 }
 
+// PaidApplications returns the student's applications to paid places, sorted by priority.
+func (s *Student) PaidApplications() []*Application {
+	return s.paidApplications
+}
+
 func (s *Student) ID() string {
 	return s.IDValue
 }
@@ -170,16 +271,25 @@ func (s *Student) Application(heading *Heading) *Application {
 }
 
 // addApplication adds a new application for the student and keeps the applications list sorted by priority.
-func (s *Student) addApplication(heading *Heading, ratingPlace int, priority int, competitionType Competition, score int) {
+func (s *Student) addApplication(heading *Heading, ratingPlace int, priority int, competitionType Competition, score int, details ScoreDetails, subQuota string) {
 	s.mu.Lock()
 
 	app := Application{
-		student:         s, // Link student to application
-		heading:         heading,
-		ratingPlace:     ratingPlace,
-		priority:        priority,
-		competitionType: competitionType,
-		score:           score,
+		student:          s, // Link student to application
+		heading:          heading,
+		ratingPlace:      ratingPlace,
+		priority:         priority,
+		competitionType:  competitionType,
+		score:            score,
+		subjectScores:    details.SubjectScores,
+		achievementScore: details.AchievementScore,
+		subQuota:         subQuota,
+	}
+
+	if competitionType == CompetitionPaid {
+		s.addPaidApplication(&app)
+		s.mu.Unlock()
+		return
 	}
 
 	defer func() {
@@ -221,6 +331,25 @@ func (s *Student) addApplication(heading *Heading, ratingPlace int, priority int
 	s.applications = append(s.applications, &app)
 }
 
+// addPaidApplication adds an application to paid places, keeping the one with the better rating place if the
+// student already applied to the heading's paid places. The caller must hold s.mu.
+func (s *Student) addPaidApplication(app *Application) {
+	defer sort.Slice(s.paidApplications, func(i, j int) bool {
+		return s.paidApplications[i].priority < s.paidApplications[j].priority
+	})
+
+	for i, existingApp := range s.paidApplications {
+		if existingApp.heading.Code() == app.heading.Code() {
+			if app.ratingPlace < existingApp.ratingPlace {
+				s.paidApplications[i] = app
+			}
+			return
+		}
+	}
+
+	s.paidApplications = append(s.paidApplications, app)
+}
+
 // Heading represents a program or specialization within a varsity.
 type Heading struct {
 	// Unique identifier for the heading. Exported so that encoding/gob can access it.
@@ -231,6 +360,10 @@ type Heading struct {
 	CapacitiesValue Capacities
 	// A human-readable code or identifier for the heading.
 	PrettyNameValue string
+	// Entrance exam subjects in the order of their priority for tie-breaking, if known.
+	SubjectsValue []string
+	// Direction code, level, study form and entrance exams of the heading, as far as known.
+	MetadataValue HeadingMetadata
 
 	// Cached vars for serialization. They are skipped by gob as we implement custom encoding but kept for runtime access.
 	varsityCodeCached       string
@@ -243,11 +376,17 @@ func (h *Heading) Capacities() Capacities {
 }
 
 func (h *Heading) TotalCapacity() int {
-	// Total capacity is the sum of all quotas and the Regular capacity.
-	return h.CapacitiesValue.Regular +
-		h.CapacitiesValue.TargetQuota +
-		h.CapacitiesValue.DedicatedQuota +
-		h.CapacitiesValue.SpecialQuota
+	return h.CapacitiesValue.Total()
+}
+
+// Subjects returns the heading's entrance exam subjects in the order of their tie-break priority.
+func (h *Heading) Subjects() []string {
+	return h.SubjectsValue
+}
+
+// Metadata returns the heading's direction code, level, study form and entrance exams, as far as known.
+func (h *Heading) Metadata() HeadingMetadata {
+	return h.MetadataValue
 }
 
 // PrettyName returns the human-readable name of the heading.
@@ -312,6 +451,19 @@ type CalculationResult struct {
 	Heading *Heading
 	// List of students admitted to the heading, sorted according to admission criteria (e.g., quota, BVI, Regular, then rating).
 	Admitted []*Student
+	// Stage each admitted student came in through (student ID -> stage); nil if the calculation was single-pass.
+	Stages map[string]AdmissionStage
+	// Students admitted to the heading's paid places by the paid enrollment, sorted by rating place.
+	PaidAdmitted []*Student
+}
+
+// StageOf returns the enrollment stage the given admitted student came in through.
+// It is StageSingle for results of a single-pass calculation.
+func (r *CalculationResult) StageOf(studentID string) AdmissionStage {
+	if stage, ok := r.Stages[studentID]; ok {
+		return stage
+	}
+	return StageSingle
 }
 
 func (r *CalculationResult) CheckRegularsAdmitted() bool {
@@ -503,32 +655,50 @@ func (h *GeneralApplicationHeap) Pop() interface{} {
 // HeadingAdmissionStateGS tracks the admission status for a single heading using heaps for Gale-Shapley.
 type HeadingAdmissionStateGS struct {
 	heading         *Heading
-	quotaAdmitted   map[Competition]heap.Interface // Stores *QuotaApplicationHeap
-	generalAdmitted heap.Interface                 // Stores *GeneralApplicationHeap
+	quotaAdmitted   map[quotaKey]heap.Interface // Stores *QuotaApplicationHeap, one per quota and detailed target quota
+	generalAdmitted heap.Interface              // Stores *GeneralApplicationHeap
+}
+
+// generalCapacity returns the number of seats available to the general competition: the total capacity
+// of the heading minus the seats currently taken by quota applicants.
+func (s *HeadingAdmissionStateGS) generalCapacity(c Capacities) int {
+	filledQuotas := 0
+	for _, quotaHeap := range s.quotaAdmitted {
+		filledQuotas += quotaHeap.Len()
+	}
+	return max(c.Total()-filledQuotas, 0)
 }
 
 // NewHeadingAdmissionStateGS creates a new state for a heading for Gale-Shapley.
 func NewHeadingAdmissionStateGS(h *Heading) *HeadingAdmissionStateGS {
-	state := &HeadingAdmissionStateGS{
-		heading: h,
-		quotaAdmitted: map[Competition]heap.Interface{
-			CompetitionTargetQuota:    &QuotaApplicationHeap{applications: make([]*Application, 0, h.CapacitiesValue.TargetQuota)},
-			CompetitionDedicatedQuota: &QuotaApplicationHeap{applications: make([]*Application, 0, h.CapacitiesValue.DedicatedQuota)},
-			CompetitionSpecialQuota:   &QuotaApplicationHeap{applications: make([]*Application, 0, h.CapacitiesValue.SpecialQuota)},
-		},
-		generalAdmitted: &GeneralApplicationHeap{applications: make([]*Application, 0, h.CapacitiesValue.Regular), heading: h},
+	return newHeadingAdmissionStateGS(h, h.CapacitiesValue)
+}
+
+// newHeadingAdmissionStateGS creates a new state for a heading whose seats are limited by the given capacities.
+func newHeadingAdmissionStateGS(h *Heading, c Capacities) *HeadingAdmissionStateGS {
+	keys := []quotaKey{
+		{competition: CompetitionTargetQuota},
+		{competition: CompetitionDedicatedQuota},
+		{competition: CompetitionSpecialQuota},
 	}
-	// Initialize the heaps
-	if h.CapacitiesValue.TargetQuota > 0 {
-		heap.Init(state.quotaAdmitted[CompetitionTargetQuota])
+	for _, name := range c.SubQuotaNames() {
+		keys = append(keys, quotaKey{competition: CompetitionTargetQuota, subQuota: name})
 	}
-	if h.CapacitiesValue.DedicatedQuota > 0 {
-		heap.Init(state.quotaAdmitted[CompetitionDedicatedQuota])
+
+	state := &HeadingAdmissionStateGS{
+		heading:         h,
+		quotaAdmitted:   make(map[quotaKey]heap.Interface, len(keys)),
+		generalAdmitted: &GeneralApplicationHeap{applications: make([]*Application, 0, c.Regular), heading: h},
 	}
-	if h.CapacitiesValue.SpecialQuota > 0 {
-		heap.Init(state.quotaAdmitted[CompetitionSpecialQuota])
+	// Initialize the heaps
+	for _, key := range keys {
+		capacity := c.quotaKeyCapacity(key)
+		state.quotaAdmitted[key] = &QuotaApplicationHeap{applications: make([]*Application, 0, capacity)}
+		if capacity > 0 {
+			heap.Init(state.quotaAdmitted[key])
+		}
 	}
-	if h.CapacitiesValue.Regular > 0 {
+	if c.Regular > 0 {
 		heap.Init(state.generalAdmitted)
 	}
 	return state
@@ -547,6 +717,18 @@ type VarsityCalculator struct {
 	originalSubmittedStudentsMu sync.RWMutex
 	originalSubmittedStudents   map[string]bool
 
+	// If true, rating places are always recomputed by the official tie-break rules instead of using the published ones.
+	recomputeRatings bool
+	// If true, CalculateAdmissions runs the priority stage and the main stage separately.
+	stagedEnrollment bool
+
+	// Location and parent organization of the varsity; not used in calculations.
+	metadata VarsityMetadata
+
+	// If true, CalculateAdmissions records an AdmissionTrace available via Trace afterwards.
+	traceEnabled bool
+	trace        *AdmissionTrace
+
 	drainedPercent int
 	wasted         bool
 }
@@ -604,8 +786,65 @@ func (v *VarsityCalculator) AddHeading(code string, capacities Capacities, prett
 	v.headings.Store(code, heading)
 }
 
+// SetHeadingSubjects sets the entrance exam subjects of the heading in the order of their tie-break priority.
+func (v *VarsityCalculator) SetHeadingSubjects(code string, subjects []string) {
+	h := v.GetHeading(code)
+	if h == nil {
+		panic(fmt.Sprintf("heading with code %s not found", code))
+	}
+	h.SubjectsValue = subjects
+}
+
+// SetHeadingMetadata sets the direction code, level, study form and entrance exams of the heading.
+func (v *VarsityCalculator) SetHeadingMetadata(code string, metadata HeadingMetadata) {
+	h := v.GetHeading(code)
+	if h == nil {
+		panic(fmt.Sprintf("heading with code %s not found", code))
+	}
+	h.MetadataValue = metadata
+}
+
+// SetVarsityMetadata sets the location and parent organization of the varsity.
+func (v *VarsityCalculator) SetVarsityMetadata(metadata VarsityMetadata) {
+	v.metadata = metadata
+}
+
+// VarsityMetadata returns the location and parent organization of the varsity.
+func (v *VarsityCalculator) VarsityMetadata() VarsityMetadata {
+	return v.metadata
+}
+
+// SetRecomputeRatings makes NormalizeApplications rank applicants by the official tie-break rules
+// even when the published rating places look consistent, e.g. for varsities that publish unordered lists.
+func (v *VarsityCalculator) SetRecomputeRatings(recompute bool) {
+	v.recomputeRatings = recompute
+}
+
+// SetStagedEnrollment switches CalculateAdmissions between a single deferred-acceptance pass and
+// the two-stage mode: the priority stage (BVI and quotas) first, then the main stage over the rest.
+func (v *VarsityCalculator) SetStagedEnrollment(staged bool) {
+	v.stagedEnrollment = staged
+}
+
+// EnableTrace makes CalculateAdmissions record every proposal, acceptance, rejection and displacement.
+// Tracing slows the calculation down and is meant for explaining results, not for drain simulations.
+func (v *VarsityCalculator) EnableTrace() {
+	v.traceEnabled = true
+}
+
+// Trace returns the trace recorded by CalculateAdmissions, or nil if tracing wasn't enabled.
+func (v *VarsityCalculator) Trace() *AdmissionTrace {
+	return v.trace
+}
+
 // AddApplication adds a student's application to a specific heading.
 func (v *VarsityCalculator) AddApplication(headingCode, studentID string, ratingPlace, priority int, competitionType Competition, scoresSum int) {
+	v.AddApplicationDetailed(headingCode, studentID, ratingPlace, priority, competitionType, scoresSum, ScoreDetails{})
+}
+
+// AddApplicationDetailed adds a student's application to a specific heading together with the score details
+// used by the official tie-break rules.
+func (v *VarsityCalculator) AddApplicationDetailed(headingCode, studentID string, ratingPlace, priority int, competitionType Competition, scoresSum int, details ScoreDetails) {
 	headingCode = strings.TrimSpace(headingCode)
 	id, err := utils.PrepareStudentID(studentID)
 
@@ -619,7 +858,26 @@ func (v *VarsityCalculator) AddApplication(headingCode, studentID string, rating
 		panic(fmt.Sprintf("heading with code %s not found", headingCode))
 	}
 	s := v.student(id)
-	s.addApplication(h.(*Heading), ratingPlace, priority, competitionType, scoresSum)
+	s.addApplication(h.(*Heading), ratingPlace, priority, competitionType, scoresSum, details, "")
+}
+
+// AddSubQuotaApplication adds a student's target quota application to a specific heading that competes
+// in the named detailed target quota of the heading (see Capacities.TargetSubQuotas).
+func (v *VarsityCalculator) AddSubQuotaApplication(headingCode, studentID, subQuota string, ratingPlace, priority int, scoresSum int, details ScoreDetails) {
+	headingCode = strings.TrimSpace(headingCode)
+	id, err := utils.PrepareStudentID(studentID)
+
+	if err != nil {
+		slog.Warn("Invalid too-long numeric student ID found", "studentID", studentID)
+		return
+	}
+
+	h, ok := v.headings.Load(headingCode)
+	if !ok {
+		panic(fmt.Sprintf("heading with code %s not found", headingCode))
+	}
+	s := v.student(id)
+	s.addApplication(h.(*Heading), ratingPlace, priority, CompetitionTargetQuota, scoresSum, details, strings.TrimSpace(subQuota))
 }
 
 // isValidPrioritySequence checks if the applications have a valid priority sequence (1, 2, 3, ..., N)
@@ -649,8 +907,7 @@ func isValidPrioritySequence(applications []*Application) bool {
 }
 
 // normalizePriorities fixes priority sequence while preserving relative order
-func normalizePriorities(student *Student) {
-	applications := student.Applications()
+func normalizePriorities(student *Student, applications []*Application) {
 	if len(applications) == 0 {
 		return
 	}
@@ -683,6 +940,7 @@ func normalizePriorities(student *Student) {
 // This should be called after all applications have been loaded and before any calculation is performed.
 func (v *VarsityCalculator) NormalizeApplications() {
 	headingToApplications := make(map[string][]*Application)
+	headingToPaidApplications := make(map[string][]*Application)
 
 	v.students.Range(func(key, value interface{}) bool {
 		student := value.(*Student)
@@ -698,7 +956,12 @@ func (v *VarsityCalculator) NormalizeApplications() {
 			}())
 
 			// Normalize priorities while preserving relative order
-			normalizePriorities(student)
+			normalizePriorities(student, student.Applications())
+		}
+
+		// Paid applications form their own priority list and their own rankings
+		if !isValidPrioritySequence(student.PaidApplications()) {
+			normalizePriorities(student, student.PaidApplications())
 		}
 
 		for _, app := range student.Applications() {
@@ -709,6 +972,10 @@ func (v *VarsityCalculator) NormalizeApplications() {
 			headingToApplications[app.Heading().Code()] = append(headingToApplications[app.Heading().Code()], app)
 		}
 
+		for _, app := range student.PaidApplications() {
+			headingToPaidApplications[app.Heading().Code()] = append(headingToPaidApplications[app.Heading().Code()], app)
+		}
+
 		return true
 	})
 
@@ -716,8 +983,13 @@ func (v *VarsityCalculator) NormalizeApplications() {
 		heading := value.(*Heading)
 
 		normalizer := newApplicationsNormalizer(headingToApplications[heading.Code()])
+		normalizer.recompute = v.recomputeRatings
 		normalizer.normalize()
 
+		paidNormalizer := newApplicationsNormalizer(headingToPaidApplications[heading.Code()])
+		paidNormalizer.recompute = v.recomputeRatings
+		paidNormalizer.normalize()
+
 		return true // continue iteration
 	})
 }
@@ -852,26 +1124,78 @@ func (v *VarsityCalculator) CalculateAdmissions() []CalculationResult {
 	}
 	defer func() { v.wasted = true }()
 
+	allHeadings := v.Headings()
+	allStudents := v.Students() // Students() already sorts in order if needed
+
+	if v.traceEnabled {
+		v.trace = NewAdmissionTrace()
+	}
+
+	results := calculateAdmissions(allHeadings, allStudents, v.stagedEnrollment, v.trace)
+
+	slog.Debug("CalculateAdmissions: finished", "staged", v.stagedEnrollment)
+	return results
+}
+
+// calculateAdmissions runs the budget enrollment (single-pass or staged) over the given headings and the students
+// who haven't quit, then the paid enrollment over the students left without a budget seat. trace may be nil.
+func calculateAdmissions(allHeadings []*Heading, allStudents []*Student, staged bool, trace *AdmissionTrace) []CalculationResult {
+	var results []CalculationResult
+	if staged {
+		results = calculateStaged(allHeadings, allStudents, trace)
+	} else {
+		proposers := make([]*admissionProposer, 0, len(allStudents))
+		for _, s := range allStudents {
+			if !s.Quit() {
+				proposers = append(proposers, &admissionProposer{id: s.ID(), applications: s.Applications()})
+			}
+		}
+
+		provisionalMatches := runDeferredAcceptance(allHeadings, proposers, nil, trace)
+		results = collectResults(allHeadings, provisionalMatches, nil)
+	}
+
+	attachPaidResults(results, calculatePaid(allHeadings, paidProposers(allStudents, admittedIn(results))))
+	return results
+}
+
+// admissionProposer is a single participant of the deferred-acceptance pass: a student identified
+// by their prepared ID, together with the applications they propose with, in order of preference.
+type admissionProposer struct {
+	id           string
+	applications []*Application
+}
+
+// runDeferredAcceptance runs the student-proposing Gale-Shapley algorithm over the given headings
+// and returns the final match of every admitted proposer (proposer ID -> winning Application).
+// Each proposer holds at most one seat at any moment, so the result is a one-seat-per-student matching.
+// The capacities map overrides the capacities of the given headings; headings missing from it (or a nil map)
+// use their own capacities.
+// Every step is recorded to trace, which may be nil.
+func runDeferredAcceptance(allHeadings []*Heading, proposers []*admissionProposer, capacities map[*Heading]Capacities, trace *AdmissionTrace) map[string]*Application {
+	capacitiesOf := func(h *Heading) Capacities {
+		if c, ok := capacities[h]; ok {
+			return c
+		}
+		return h.CapacitiesValue
+	}
+
 	// 1. Initialization
 	admissionStates := make(map[*Heading]*HeadingAdmissionStateGS)
-	allHeadings := v.Headings()
 	for _, h := range allHeadings {
-		admissionStates[h] = NewHeadingAdmissionStateGS(h)
+		admissionStates[h] = newHeadingAdmissionStateGS(h, capacitiesOf(h))
 	}
 
-	freeStudentsQueue := list.New()         // Changed from slice to container/list
-	allStudents := v.Students()             // Students() already sorts in order if needed
-	studentMap := make(map[string]*Student) // For quick lookup of student objects by ID
+	freeStudentsQueue := list.New()                    // Changed from slice to container/list
+	proposerMap := make(map[string]*admissionProposer) // For quick lookup of proposers by ID
 
 	studentNextProposalIndex := make(map[string]int)    // student.ID() -> index
 	provisionalMatches := make(map[string]*Application) // student.ID() -> provisionally accepted Application
 
-	for _, s := range allStudents {
-		studentMap[s.ID()] = s
-		studentNextProposalIndex[s.ID()] = 0 // Initialize proposal index
-		if !s.Quit() {
-			freeStudentsQueue.PushBack(s) // Changed to PushBack
-		}
+	for _, p := range proposers {
+		proposerMap[p.id] = p
+		studentNextProposalIndex[p.id] = 0 // Initialize proposal index
+		freeStudentsQueue.PushBack(p)      // Changed to PushBack
 	}
 
 	slog.Debug("CalculateAdmissions: initialized", "freeStudents", freeStudentsQueue.Len())
@@ -879,19 +1203,23 @@ func (v *VarsityCalculator) CalculateAdmissions() []CalculationResult {
 	// 2. Iteration (Gale-Shapley main loop)
 	for freeStudentsQueue.Len() > 0 { // Changed to Len()
 		element := freeStudentsQueue.Front() // Dequeue student (O(1))
-		student := element.Value.(*Student)
+		student := element.Value.(*admissionProposer)
 		freeStudentsQueue.Remove(element) // O(1)
 
-		studentID := student.ID()
+		studentID := student.id
 
-		slog.Debug("Processing proposals for student", "studentID", studentID, "currentProposalIndex", studentNextProposalIndex[studentID], "apps", len(student.Applications()))
+		slog.Debug("Processing proposals for student", "studentID", studentID, "currentProposalIndex", studentNextProposalIndex[studentID], "apps", len(student.applications))
 
 		// Student makes proposals in order of their preference list
-		for proposalIdx := studentNextProposalIndex[studentID]; proposalIdx < len(student.Applications()); proposalIdx++ {
-			app := student.Applications()[proposalIdx]
+		for proposalIdx := studentNextProposalIndex[studentID]; proposalIdx < len(student.applications); proposalIdx++ {
+			app := student.applications[proposalIdx]
 			heading := app.Heading()
-			headingState := admissionStates[heading]
-
+			headingState, ok := admissionStates[heading]
+			if !ok {
+				slog.Debug("Student", "studentID", studentID, "unknownHeading", heading.FullCode(), "action", "skippingThisApp")
+				studentNextProposalIndex[studentID] = proposalIdx + 1
+				continue
+			}
 			slog.Debug("Student", "studentID", studentID, "appIndex", proposalIdx+1, "priority", app.Priority(), "headingCode", heading.Code(), "competitionType", app.CompetitionType(), "ratingPlace", app.RatingPlace())
 
 			var targetHeap heap.Interface
@@ -900,33 +1228,16 @@ func (v *VarsityCalculator) CalculateAdmissions() []CalculationResult {
 
 			switch app.CompetitionType() {
 			case CompetitionTargetQuota, CompetitionDedicatedQuota, CompetitionSpecialQuota:
-				targetHeap = headingState.quotaAdmitted[app.CompetitionType()]
-				capacity = heading.CapacitiesValue.quotaCapacity(app.CompetitionType())
+				key := capacitiesOf(heading).quotaKeyOf(app)
+				targetHeap = headingState.quotaAdmitted[key]
+				capacity = capacitiesOf(heading).quotaKeyCapacity(key)
 				isQuotaHeap = true
-			case CompetitionRegular, CompetitionBVI:
+			case CompetitionRegular, CompetitionBVI, CompetitionPaid:
 				targetHeap = headingState.generalAdmitted
 
-				currentFilledTargetQuota := 0
-				if tqHeap, ok := headingState.quotaAdmitted[CompetitionTargetQuota]; ok {
-					currentFilledTargetQuota = tqHeap.Len()
-				}
-				currentFilledDedicatedQuota := 0
-				if dqHeap, ok := headingState.quotaAdmitted[CompetitionDedicatedQuota]; ok {
-					currentFilledDedicatedQuota = dqHeap.Len()
-				}
-				currentFilledSpecialQuota := 0
-				if sqHeap, ok := headingState.quotaAdmitted[CompetitionSpecialQuota]; ok {
-					currentFilledSpecialQuota = sqHeap.Len()
-				}
-
-				currentFilledQuotasOverall := currentFilledTargetQuota + currentFilledDedicatedQuota + currentFilledSpecialQuota
-
 				// The capacity for the generalAdmitted heap is the remaining total capacity of the heading
 				// after accounting for students already provisionally admitted to specific quotas.
-				capacity = heading.TotalCapacity() - currentFilledQuotasOverall
-				if capacity < 0 { // Ensure capacity is not negative
-					capacity = 0
-				}
+				capacity = headingState.generalCapacity(capacitiesOf(heading))
 
 			default:
 				slog.Debug("Student", "studentID", studentID, "unknownCompetitionType", app.CompetitionType(), "headingCode", heading.Code(), "action", "skippingThisApp")
@@ -934,7 +1245,10 @@ func (v *VarsityCalculator) CalculateAdmissions() []CalculationResult {
 				continue                                              // Try next application
 			}
 
+			trace.record(TraceProposed, app, nil)
+
 			if capacity == 0 {
+				trace.record(TraceRejected, app, nil)
 				slog.Debug("Student", "studentID", studentID, "headingCode", heading.Code(), "competitionType", app.CompetitionType(), "reason", "has0CapacityRejected")
 				studentNextProposalIndex[studentID] = proposalIdx + 1 // Mark this proposal as considered
 				continue                                              // Try next application
@@ -948,6 +1262,7 @@ func (v *VarsityCalculator) CalculateAdmissions() []CalculationResult {
 			if targetHeap.Len() < capacity {
 				heap.Push(targetHeap, app)
 				acceptedThisProposal = true
+				trace.record(TraceAccepted, app, nil)
 				slog.Debug("Student", "studentID", studentID, "acceptedToHeading", heading.Code(), "competitionType", app.CompetitionType(), "reason", "capacityAvailable")
 			} else {
 				// Heap is full, compare with the worst student currently in the heap (root)
@@ -965,8 +1280,11 @@ func (v *VarsityCalculator) CalculateAdmissions() []CalculationResult {
 					wasDisplaced = true
 					heap.Push(targetHeap, app)
 					acceptedThisProposal = true
+					trace.record(TraceAccepted, app, displacedApplication)
+					trace.record(TraceDisplaced, displacedApplication, app)
 					slog.Debug("Student", "studentID", studentID, "acceptedToHeading", heading.Code(), "competitionType", app.CompetitionType(), "outscoresWorst", worstAppInHeap.StudentID())
 				} else {
+					trace.record(TraceRejected, app, worstAppInHeap)
 					slog.Debug("Student", "studentID", studentID, "rejectedByHeading", heading.Code(), "competitionType", app.CompetitionType(), "doesNotOutscoreWorst", worstAppInHeap.StudentID())
 					// Student is rejected for this specific proposal, will try their next one.
 				}
@@ -990,7 +1308,7 @@ func (v *VarsityCalculator) CalculateAdmissions() []CalculationResult {
 					delete(provisionalMatches, displacedStudentID) // Displaced student loses their provisional match
 
 					// Add displaced student back to the free queue to find a new match
-					displacedStudentObj := studentMap[displacedStudentID]
+					displacedStudentObj := proposerMap[displacedStudentID]
 					if displacedStudentObj != nil {
 						// Check if already in queue to prevent duplicates if logic allows (though GS typically processes one student fully)
 						// For simplicity here, we add. If a student is processed multiple times due to re-queuing,
@@ -1001,6 +1319,22 @@ func (v *VarsityCalculator) CalculateAdmissions() []CalculationResult {
 						slog.Debug("ERROR", "couldNotFindStudentObjectForDisplacedID", displacedStudentID)
 					}
 				}
+
+				if isQuotaHeap && !wasDisplaced {
+					// The newly taken quota seat is no longer available to the general competition,
+					// so general applicants who don't fit anymore lose their seats, the worst first
+					generalCapacity := headingState.generalCapacity(capacitiesOf(heading))
+					for headingState.generalAdmitted.Len() > generalCapacity {
+						evicted := heap.Pop(headingState.generalAdmitted).(*Application)
+						trace.record(TraceDisplaced, evicted, app)
+						slog.Debug("Student", "evictedStudentID", evicted.StudentID(), "evictedFromHeading", heading.Code(), "byQuotaApplicant", studentID)
+
+						delete(provisionalMatches, evicted.StudentID())
+						if evictedStudentObj := proposerMap[evicted.StudentID()]; evictedStudentObj != nil {
+							freeStudentsQueue.PushBack(evictedStudentObj)
+						}
+					}
+				}
 				// Student is now provisionally matched, so they stop proposing in this turn.
 				goto nextStudentInQueue // Break out of the inner proposal loop for the current student
 			}
@@ -1024,6 +1358,13 @@ func (v *VarsityCalculator) CalculateAdmissions() []CalculationResult {
 
 	slog.Debug("CalculateAdmissions: all proposals processed")
 
+	return provisionalMatches
+}
+
+// collectResults turns the final matches into one CalculationResult per heading, with admitted students
+// sorted by the heading's preference criteria. Results are sorted by heading code for deterministic output.
+// If stages is non-nil (student ID -> stage), every result gets the stages of its admitted students.
+func collectResults(allHeadings []*Heading, provisionalMatches map[string]*Application, stages map[string]AdmissionStage) []CalculationResult {
 	// 3. Collect Results
 	finalAdmissionsByHeading := make(map[*Heading][]*Student)
 	for _, app := range provisionalMatches { // Iterate over final matches
@@ -1057,9 +1398,18 @@ func (v *VarsityCalculator) CalculateAdmissions() []CalculationResult {
 			sortedAdmittedStudents[i] = app.student
 		}
 
+		var admittedStages map[string]AdmissionStage
+		if stages != nil {
+			admittedStages = make(map[string]AdmissionStage, len(sortedAdmittedStudents))
+			for _, student := range sortedAdmittedStudents {
+				admittedStages[student.ID()] = stages[student.ID()]
+			}
+		}
+
 		results = append(results, CalculationResult{
 			Heading:  h,
 			Admitted: sortedAdmittedStudents,
+			Stages:   admittedStages,
 		})
 	}
 
@@ -1068,7 +1418,6 @@ func (v *VarsityCalculator) CalculateAdmissions() []CalculationResult {
 		return results[i].Heading.Code() < results[j].Heading.Code()
 	})
 
-	slog.Debug("CalculateAdmissions: finished")
 	return results
 }
 
@@ -1081,33 +1430,87 @@ func (h *GeneralApplicationHeap) Peek() *Application {
 }
 
 // SimulateOriginalsDrain drains randomly selected drainPercent% of students who DID not submit their original but
-// who hasn't already quit the varsity.
-func (v *VarsityCalculator) SimulateOriginalsDrain(drainPercent int) {
+// who hasn't already quit the varsity. The selection is fully determined by seed: the same calculator state
+// and the same seed always drain the same students.
+func (v *VarsityCalculator) SimulateOriginalsDrain(drainPercent int, seed int64) {
+	drainableStudents := v.drainableStudents(drainPercent)
+	if len(drainableStudents) == 0 {
+		return
+	}
+
+	v.drain(pickDrained(drainableStudents, drainPercent, seed, nil), drainPercent)
+}
+
+// SimulateWeightedOriginalsDrain works like SimulateOriginalsDrain, but a student is drained with a probability
+// proportional to weight(student) instead of uniformly. Students are sampled without replacement, so a student
+// with weight 0 is only drained if there are not enough students with positive weights.
+func (v *VarsityCalculator) SimulateWeightedOriginalsDrain(drainPercent int, seed int64, weight func(*Student) float64) {
+	drainableStudents := v.drainableStudents(drainPercent)
+	if len(drainableStudents) == 0 {
+		return
+	}
+
+	v.drain(pickDrained(drainableStudents, drainPercent, seed, weight), drainPercent)
+}
+
+// pickDrained picks drainPercent% of the given drainable students, which must be sorted by ID. A nil weight picks
+// them uniformly at random, otherwise with probabilities proportional to weight. The choice is fully determined by
+// seed. drainableStudents is reordered in place.
+func pickDrained(drainableStudents []*Student, drainPercent int, seed int64, weight func(*Student) float64) []*Student {
+	numToDrain := (len(drainableStudents) * drainPercent) / 100
+	r := rand.New(rand.NewSource(seed))
+
+	if weight == nil {
+		// Shuffle eligible students to pick randomly
+		r.Shuffle(len(drainableStudents), func(i, j int) {
+			drainableStudents[i], drainableStudents[j] = drainableStudents[j], drainableStudents[i]
+		})
+		return drainableStudents[:min(numToDrain, len(drainableStudents))]
+	}
+
+	// Efraimidis-Spirakis sampling: the students with the largest log(u)/weight keys are drained
+	keys := make(map[*Student]float64, len(drainableStudents))
+	for _, student := range drainableStudents {
+		w := weight(student)
+		u := r.Float64()
+		if w <= 0 || u == 0 {
+			keys[student] = math.Inf(-1)
+		} else {
+			keys[student] = math.Log(u) / w
+		}
+	}
+	sort.SliceStable(drainableStudents, func(i, j int) bool {
+		return keys[drainableStudents[i]] > keys[drainableStudents[j]]
+	})
+
+	return drainableStudents[:min(numToDrain, len(drainableStudents))]
+}
+
+// drainableStudents returns the students who may be drained, sorted by ID, or nil if the calculator
+// can't be drained by drainPercent.
+func (v *VarsityCalculator) drainableStudents(drainPercent int) []*Student {
 	v.checkNotWasted()
 
 	if drainPercent == 0 {
-		return
+		return nil
 	}
 
 	if drainPercent < 0 || drainPercent > 100 {
 		slog.Warn("Invalid value, must be in [0, 100]", "drainPercent", drainPercent)
-		return
+		return nil
 	}
 
 	if v.drainedPercent != 0 {
 		slog.Warn("Already drained students, cannot drain again", "drainedPercent", v.drainedPercent)
-		return
+		return nil
 	}
 
-	drainableCount := 0
-
 	var drainableStudents []*Student
 	v.students.Range(func(key, value interface{}) bool {
 		student := value.(*Student)
 		student.mu.Lock()
 
 		if !student.quit && !student.originalSubmitted {
-			drainableCount += 1
 			drainableStudents = append(drainableStudents, student)
 		}
 
@@ -1115,20 +1518,17 @@ func (v *VarsityCalculator) SimulateOriginalsDrain(drainPercent int) {
 		return true
 	})
 
-	if len(drainableStudents) == 0 {
-		return
-	}
-
-	numToDrain := (len(drainableStudents) * drainPercent) / 100
-
-	// Shuffle eligible students to pick randomly
-	r := rand.New(rand.NewSource(time.Now().UnixNano()))
-	r.Shuffle(len(drainableStudents), func(i, j int) {
-		drainableStudents[i], drainableStudents[j] = drainableStudents[j], drainableStudents[i]
+	// sync.Map iteration order is random, so fix the order before shuffling to keep the result reproducible
+	sort.Slice(drainableStudents, func(i, j int) bool {
+		return drainableStudents[i].IDValue < drainableStudents[j].IDValue
 	})
 
-	for i := 0; i < numToDrain && i < len(drainableStudents); i++ {
-		studentToDrain := drainableStudents[i]
+	return drainableStudents
+}
+
+// drain marks the given students as quit and remembers the drained percent.
+func (v *VarsityCalculator) drain(students []*Student, drainPercent int) {
+	for _, studentToDrain := range students {
 		studentToDrain.mu.Lock()
 		studentToDrain.quit = true
 		studentToDrain.mu.Unlock()
@@ -1150,6 +1550,8 @@ func (h *Heading) GobEncode() ([]byte, error) {
 		CodeValue         string
 		CapacitiesValue   Capacities
 		PrettyNameValue   string
+		SubjectsValue     []string
+		MetadataValue     HeadingMetadata
 		VarsityCode       string
 		VarsityPrettyName string
 	}
@@ -1160,6 +1562,8 @@ func (h *Heading) GobEncode() ([]byte, error) {
 		CodeValue:         h.CodeValue,
 		CapacitiesValue:   h.CapacitiesValue,
 		PrettyNameValue:   h.PrettyNameValue,
+		SubjectsValue:     h.SubjectsValue,
+		MetadataValue:     h.MetadataValue,
 		VarsityCode:       h.VarsityCode(),
 		VarsityPrettyName: h.VarsityPrettyName(),
 	}); err != nil {
@@ -1173,6 +1577,8 @@ func (h *Heading) GobDecode(data []byte) error {
 		CodeValue         string
 		CapacitiesValue   Capacities
 		PrettyNameValue   string
+		SubjectsValue     []string
+		MetadataValue     HeadingMetadata
 		VarsityCode       string
 		VarsityPrettyName string
 	}
@@ -1185,6 +1591,8 @@ func (h *Heading) GobDecode(data []byte) error {
 	h.CodeValue = aux.CodeValue
 	h.CapacitiesValue = aux.CapacitiesValue
 	h.PrettyNameValue = aux.PrettyNameValue
+	h.SubjectsValue = aux.SubjectsValue
+	h.MetadataValue = aux.MetadataValue
 	// varsity pointer is nil after decoding; store cached data for getters.
 	h.varsity = nil
 	h.varsityCodeCached = aux.VarsityCode
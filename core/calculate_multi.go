@@ -0,0 +1,212 @@
+package core
+
+import (
+	"fmt"
+	"log/slog"
+	"sort"
+)
+
+// MultiVarsityCalculator runs a single admission pass over several varsities at once.
+// Students are merged across varsities by their prepared ID, so a student can hold at most one seat
+// in the whole system instead of one seat per varsity. Each underlying VarsityCalculator keeps
+// owning its headings and students, which lets the results be split back per varsity.
+type MultiVarsityCalculator struct {
+	calculators []*VarsityCalculator
+
+	traceEnabled bool
+	trace        *AdmissionTrace
+}
+
+// NewMultiVarsityCalculator creates a calculator over the given varsity calculators.
+// The order of calculators matters: it breaks ties between applications of equal priority
+// made to different varsities (an earlier varsity is preferred).
+func NewMultiVarsityCalculator(calculators ...*VarsityCalculator) *MultiVarsityCalculator {
+	return &MultiVarsityCalculator{calculators: calculators}
+}
+
+// EnableTrace makes CalculateAdmissions record the trace of the global pass. After the calculation,
+// every underlying VarsityCalculator's Trace holds the events of its own headings.
+func (m *MultiVarsityCalculator) EnableTrace() {
+	m.traceEnabled = true
+}
+
+// Trace returns the trace of the global pass over all varsities, or nil if tracing wasn't enabled.
+func (m *MultiVarsityCalculator) Trace() *AdmissionTrace {
+	return m.trace
+}
+
+// multiVarsityApplication is an application tagged with the index of the varsity it belongs to,
+// used to build the merged preference list of a student.
+type multiVarsityApplication struct {
+	app          *Application
+	varsityIndex int
+}
+
+// CalculateAdmissions performs one global deferred-acceptance pass over all varsities and returns
+// the results split per varsity (varsity code -> results), in the same shape as VarsityCalculator.CalculateAdmissions.
+//
+// A student's merged preference list orders applications by their per-varsity priority first and by the
+// varsity order second. A student who submitted their original to some varsity is only considered there:
+// applications to every other varsity are dropped, as are applications to varsities the student has quit.
+// Paid places are filled afterwards by a separate global pass over the students left without a budget seat.
+func (m *MultiVarsityCalculator) CalculateAdmissions() map[string][]CalculationResult {
+	slog.Debug("MultiVarsityCalculator.CalculateAdmissions: starting", "varsities", len(m.calculators))
+
+	for _, v := range m.calculators {
+		v.mu.Lock()
+	}
+	defer func() {
+		for _, v := range m.calculators {
+			v.wasted = true
+			v.mu.Unlock()
+		}
+	}()
+
+	seenCodes := make(map[string]bool)
+	for _, v := range m.calculators {
+		if v.wasted {
+			panic(fmt.Errorf("attempt to use a wasted calculator for varsity %s", v.code))
+		}
+		if seenCodes[v.code] {
+			panic(fmt.Errorf("varsity %s is passed to the multi-varsity calculator more than once", v.code))
+		}
+		seenCodes[v.code] = true
+	}
+
+	in := m.mergeInput()
+
+	if m.traceEnabled {
+		m.trace = NewAdmissionTrace()
+	}
+
+	provisionalMatches := runDeferredAcceptance(in.allHeadings, in.proposers, nil, m.trace)
+
+	// Paid places are filled by one more global pass among the students left without a budget seat anywhere
+	budgetAdmitted := make(map[string]bool, len(provisionalMatches))
+	for id := range provisionalMatches {
+		budgetAdmitted[id] = true
+	}
+	paidMatches := calculatePaid(in.allHeadings, in.paidProposers(budgetAdmitted))
+
+	results := make(map[string][]CalculationResult, len(m.calculators))
+	for i, v := range m.calculators {
+		results[v.code] = collectResults(in.headingsByVarsity[i], provisionalMatches, nil)
+		attachPaidResults(results[v.code], paidMatches)
+
+		if m.trace != nil {
+			v.trace = m.trace.subset(in.headingsByVarsity[i])
+		}
+	}
+
+	slog.Debug("MultiVarsityCalculator.CalculateAdmissions: finished", "students", len(in.proposers))
+	return results
+}
+
+// multiVarsityInput is the input of the global pass merged from all varsities.
+type multiVarsityInput struct {
+	allHeadings       []*Heading
+	headingsByVarsity [][]*Heading
+	proposers         []*admissionProposer
+	// Paid applications of every student, merged from the varsities the student hasn't quit
+	paidApplications map[string][]multiVarsityApplication
+}
+
+// mergeInput merges the headings and students of all varsities into the input of the global pass.
+func (m *MultiVarsityCalculator) mergeInput() multiVarsityInput {
+	in := multiVarsityInput{
+		headingsByVarsity: make([][]*Heading, len(m.calculators)),
+		paidApplications:  make(map[string][]multiVarsityApplication),
+	}
+	mergedApplications := make(map[string][]multiVarsityApplication)
+	originalVarsity := make(map[string]int) // student ID -> index of the varsity holding their original
+
+	for i, v := range m.calculators {
+		in.headingsByVarsity[i] = v.Headings()
+		in.allHeadings = append(in.allHeadings, in.headingsByVarsity[i]...)
+
+		for _, s := range v.Students() {
+			if s.OriginalSubmitted() {
+				if _, found := originalVarsity[s.ID()]; !found {
+					originalVarsity[s.ID()] = i
+				} else {
+					slog.Debug("Student submitted original to multiple varsities", "studentID", s.ID(), "chosen", m.calculators[originalVarsity[s.ID()]].code, "ignored", v.code)
+				}
+			}
+
+			if s.Quit() {
+				continue
+			}
+
+			for _, app := range s.Applications() {
+				mergedApplications[s.ID()] = append(mergedApplications[s.ID()], multiVarsityApplication{app: app, varsityIndex: i})
+			}
+			for _, app := range s.PaidApplications() {
+				in.paidApplications[s.ID()] = append(in.paidApplications[s.ID()], multiVarsityApplication{app: app, varsityIndex: i})
+			}
+		}
+	}
+
+	studentIDs := make([]string, 0, len(mergedApplications))
+	for id := range mergedApplications {
+		studentIDs = append(studentIDs, id)
+	}
+	sort.Strings(studentIDs)
+
+	in.proposers = make([]*admissionProposer, 0, len(studentIDs))
+	for _, id := range studentIDs {
+		apps := mergedApplications[id]
+
+		if origIdx, ok := originalVarsity[id]; ok {
+			filtered := apps[:0]
+			for _, a := range apps {
+				if a.varsityIndex == origIdx {
+					filtered = append(filtered, a)
+				}
+			}
+			apps = filtered
+		}
+
+		if len(apps) == 0 {
+			continue
+		}
+
+		in.proposers = append(in.proposers, &admissionProposer{id: id, applications: mergedPreferences(apps)})
+	}
+
+	return in
+}
+
+// paidProposers returns the participants of the global paid pass: the students with paid applications
+// who weren't admitted to a budget place in any varsity.
+func (in multiVarsityInput) paidProposers(budgetAdmitted map[string]bool) []*admissionProposer {
+	studentIDs := make([]string, 0, len(in.paidApplications))
+	for id := range in.paidApplications {
+		if !budgetAdmitted[id] {
+			studentIDs = append(studentIDs, id)
+		}
+	}
+	sort.Strings(studentIDs)
+
+	proposers := make([]*admissionProposer, 0, len(studentIDs))
+	for _, id := range studentIDs {
+		proposers = append(proposers, &admissionProposer{id: id, applications: mergedPreferences(in.paidApplications[id])})
+	}
+	return proposers
+}
+
+// mergedPreferences orders the merged applications of a student by their per-varsity priority first
+// and by the varsity order second.
+func mergedPreferences(apps []multiVarsityApplication) []*Application {
+	sort.SliceStable(apps, func(i, j int) bool {
+		if apps[i].app.priority != apps[j].app.priority {
+			return apps[i].app.priority < apps[j].app.priority
+		}
+		return apps[i].varsityIndex < apps[j].varsityIndex
+	})
+
+	preferences := make([]*Application, len(apps))
+	for i, a := range apps {
+		preferences[i] = a.app
+	}
+	return preferences
+}
@@ -0,0 +1,120 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func admittedIDs(results []CalculationResult, headingCode string) []string {
+	for _, res := range results {
+		if res.Heading.Code() == headingCode {
+			ids := make([]string, len(res.Admitted))
+			for i, s := range res.Admitted {
+				ids[i] = s.ID()
+			}
+			return ids
+		}
+	}
+	return []string{}
+}
+
+// TestMultiVarsity_OneSeatPerStudent checks that a strong student is admitted only once across varsities,
+// freeing the seat at the other varsity for the next applicant.
+func TestMultiVarsity_OneSeatPerStudent(t *testing.T) {
+	a := NewVarsityCalculator("a", "Varsity A")
+	a.AddHeading("H", Capacities{Regular: 1}, "A heading")
+	b := NewVarsityCalculator("b", "Varsity B")
+	b.AddHeading("H", Capacities{Regular: 1}, "B heading")
+
+	a.AddApplication("H", "1", 1, 1, CompetitionRegular, 300)
+	b.AddApplication("H", "1", 1, 2, CompetitionRegular, 300)
+	b.AddApplication("H", "2", 2, 1, CompetitionRegular, 250)
+
+	results := NewMultiVarsityCalculator(a, b).CalculateAdmissions()
+
+	assert.Equal(t, []string{"0000000000001"}, admittedIDs(results["a"], "H"))
+	assert.Equal(t, []string{"0000000000002"}, admittedIDs(results["b"], "H"))
+}
+
+// TestMultiVarsity_PriorityAcrossVarsities checks that priority 1 at any varsity beats priority 2 elsewhere
+// and that the student falls back to their next choice when displaced.
+func TestMultiVarsity_PriorityAcrossVarsities(t *testing.T) {
+	a := NewVarsityCalculator("a", "Varsity A")
+	a.AddHeading("H", Capacities{Regular: 1}, "A heading")
+	b := NewVarsityCalculator("b", "Varsity B")
+	b.AddHeading("H", Capacities{Regular: 1}, "B heading")
+
+	// Student 1 prefers B, but student 3 outranks them there.
+	a.AddApplication("H", "1", 1, 2, CompetitionRegular, 280)
+	b.AddApplication("H", "1", 2, 1, CompetitionRegular, 280)
+	b.AddApplication("H", "3", 1, 1, CompetitionRegular, 290)
+	a.AddApplication("H", "2", 2, 1, CompetitionRegular, 270)
+
+	results := NewMultiVarsityCalculator(a, b).CalculateAdmissions()
+
+	assert.Equal(t, []string{"0000000000001"}, admittedIDs(results["a"], "H"))
+	assert.Equal(t, []string{"0000000000003"}, admittedIDs(results["b"], "H"))
+}
+
+// TestMultiVarsity_OriginalSubmittedElsewhere checks that a student whose original is at varsity A
+// cannot be taken by varsity B, even if B is their first priority.
+func TestMultiVarsity_OriginalSubmittedElsewhere(t *testing.T) {
+	a := NewVarsityCalculator("a", "Varsity A")
+	a.AddHeading("H", Capacities{Regular: 1}, "A heading")
+	b := NewVarsityCalculator("b", "Varsity B")
+	b.AddHeading("H", Capacities{Regular: 1}, "B heading")
+
+	a.AddApplication("H", "1", 1, 2, CompetitionRegular, 300)
+	b.AddApplication("H", "1", 1, 1, CompetitionRegular, 300)
+	b.AddApplication("H", "2", 2, 1, CompetitionRegular, 250)
+	a.SetOriginalSubmitted("1")
+
+	results := NewMultiVarsityCalculator(a, b).CalculateAdmissions()
+
+	assert.Equal(t, []string{"0000000000001"}, admittedIDs(results["a"], "H"))
+	assert.Equal(t, []string{"0000000000002"}, admittedIDs(results["b"], "H"))
+}
+
+// TestMultiVarsity_QuitIsRespected checks that applications to a varsity the student has quit are ignored.
+func TestMultiVarsity_QuitIsRespected(t *testing.T) {
+	a := NewVarsityCalculator("a", "Varsity A")
+	a.AddHeading("H", Capacities{Regular: 1}, "A heading")
+	b := NewVarsityCalculator("b", "Varsity B")
+	b.AddHeading("H", Capacities{Regular: 1}, "B heading")
+
+	a.AddApplication("H", "1", 1, 1, CompetitionRegular, 300)
+	b.AddApplication("H", "1", 1, 1, CompetitionRegular, 300)
+	a.SetQuit("1")
+
+	results := NewMultiVarsityCalculator(a, b).CalculateAdmissions()
+
+	assert.Empty(t, admittedIDs(results["a"], "H"))
+	assert.Equal(t, []string{"0000000000001"}, admittedIDs(results["b"], "H"))
+}
+
+// TestMultiVarsity_SingleVarsityMatchesCalculateAdmissions checks that with one varsity the multi-varsity
+// pass gives the same result as the regular per-varsity calculation.
+func TestMultiVarsity_SingleVarsityMatchesCalculateAdmissions(t *testing.T) {
+	build := func() *VarsityCalculator {
+		v := NewVarsityCalculator("a", "Varsity A")
+		v.AddHeading("H1", Capacities{Regular: 1, TargetQuota: 1}, "Heading 1")
+		v.AddHeading("H2", Capacities{Regular: 2}, "Heading 2")
+		v.AddApplication("H1", "1", 1, 1, CompetitionRegular, 290)
+		v.AddApplication("H2", "1", 1, 2, CompetitionRegular, 290)
+		v.AddApplication("H1", "2", 2, 1, CompetitionRegular, 280)
+		v.AddApplication("H2", "2", 2, 2, CompetitionRegular, 280)
+		v.AddApplication("H1", "3", 1, 1, CompetitionTargetQuota, 200)
+		v.AddApplication("H2", "4", 3, 1, CompetitionBVI, 0)
+		return v
+	}
+
+	single := build().CalculateAdmissions()
+	multi := NewMultiVarsityCalculator(build()).CalculateAdmissions()["a"]
+
+	assert.Len(t, multi, len(single))
+	for i := range single {
+		assert.Equal(t, single[i].Heading.Code(), multi[i].Heading.Code())
+		assert.Equal(t, admittedIDs(single, single[i].Heading.Code()), admittedIDs(multi, multi[i].Heading.Code()))
+	}
+}
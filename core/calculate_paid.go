@@ -0,0 +1,118 @@
+package core
+
+import (
+	"fmt"
+	"log/slog"
+	"sort"
+)
+
+// admittedIn returns the set of IDs of the students admitted to budget places in the given results.
+func admittedIn(results []CalculationResult) map[string]bool {
+	admitted := make(map[string]bool)
+	for _, r := range results {
+		for _, s := range r.Admitted {
+			admitted[s.ID()] = true
+		}
+	}
+	return admitted
+}
+
+// paidProposers returns the participants of the paid enrollment: the students who haven't quit,
+// weren't admitted to a budget place and applied to paid places.
+func paidProposers(allStudents []*Student, budgetAdmitted map[string]bool) []*admissionProposer {
+	proposers := make([]*admissionProposer, 0)
+	for _, s := range allStudents {
+		if s.Quit() || budgetAdmitted[s.ID()] || len(s.PaidApplications()) == 0 {
+			continue
+		}
+		proposers = append(proposers, &admissionProposer{id: s.ID(), applications: s.PaidApplications()})
+	}
+	return proposers
+}
+
+// calculatePaid runs the paid enrollment: a deferred-acceptance pass over the paid applications of the given
+// proposers, where every heading offers its Capacities.Paid places. It isn't recorded to the admission trace,
+// which explains budget admissions only.
+func calculatePaid(allHeadings []*Heading, proposers []*admissionProposer) map[string]*Application {
+	if len(proposers) == 0 {
+		return nil
+	}
+
+	matches := runDeferredAcceptance(allHeadings, proposers, paidCapacities(allHeadings), nil)
+	slog.Debug("Paid enrollment finished", "proposers", len(proposers), "admitted", len(matches))
+	return matches
+}
+
+// paidCapacities returns the capacities of the paid enrollment: every heading offers its paid places
+// to a single general competition.
+func paidCapacities(allHeadings []*Heading) map[*Heading]Capacities {
+	capacities := make(map[*Heading]Capacities, len(allHeadings))
+	for _, h := range allHeadings {
+		capacities[h] = Capacities{Regular: h.CapacitiesValue.Paid}
+	}
+	return capacities
+}
+
+// attachPaidResults fills the PaidAdmitted lists of the results from the matches of the paid enrollment.
+func attachPaidResults(results []CalculationResult, paidMatches map[string]*Application) {
+	if len(paidMatches) == 0 {
+		return
+	}
+
+	appsByHeading := make(map[*Heading][]*Application)
+	for _, app := range paidMatches {
+		appsByHeading[app.Heading()] = append(appsByHeading[app.Heading()], app)
+	}
+
+	for i := range results {
+		h := results[i].Heading
+		apps := appsByHeading[h]
+		sort.Slice(apps, func(a, b int) bool {
+			return h.outscores(apps[a], apps[b])
+		})
+
+		admitted := make([]*Student, len(apps))
+		for j, app := range apps {
+			admitted[j] = app.student
+		}
+		results[i].PaidAdmitted = admitted
+	}
+}
+
+// paidApplication returns the student's application to the paid places of the heading, or nil if there is none.
+func (s *Student) paidApplication(heading *Heading) *Application {
+	for _, app := range s.paidApplications {
+		if app.heading == heading {
+			return app
+		}
+	}
+	return nil
+}
+
+// PaidPassingScore returns the score of the last student admitted to the heading's paid places.
+func (r *CalculationResult) PaidPassingScore() (int, error) {
+	if len(r.PaidAdmitted) == 0 {
+		return 0, fmt.Errorf("no students admitted to paid places for heading %s", r.Heading.FullCode())
+	}
+
+	lastAdmitted := r.PaidAdmitted[len(r.PaidAdmitted)-1]
+	if app := lastAdmitted.paidApplication(r.Heading); app != nil {
+		return app.score, nil
+	}
+
+	return 0, fmt.Errorf("no paid application found for last admitted student %s in heading %s", lastAdmitted.ID(), r.Heading.FullCode())
+}
+
+// PaidLastAdmittedRatingPlace returns the rating place of the last student admitted to the heading's paid places.
+func (r *CalculationResult) PaidLastAdmittedRatingPlace() (int, error) {
+	if len(r.PaidAdmitted) == 0 {
+		return 0, fmt.Errorf("no students admitted to paid places for heading %s", r.Heading.FullCode())
+	}
+
+	lastAdmitted := r.PaidAdmitted[len(r.PaidAdmitted)-1]
+	if app := lastAdmitted.paidApplication(r.Heading); app != nil {
+		return app.ratingPlace, nil
+	}
+
+	return 0, fmt.Errorf("no paid application found for last admitted student %s in heading %s", lastAdmitted.ID(), r.Heading.FullCode())
+}
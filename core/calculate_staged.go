@@ -0,0 +1,119 @@
+package core
+
+import "log/slog"
+
+// AdmissionStage tells through which enrollment stage a student was admitted.
+type AdmissionStage int
+
+const (
+	// StageSingle marks admissions made by a single deferred-acceptance pass over all competition types.
+	StageSingle AdmissionStage = iota
+	// StagePriority marks admissions of the priority stage: BVI and all quota applicants.
+	StagePriority
+	// StageMain marks admissions of the main stage: the general competition over the seats left after the priority stage.
+	StageMain
+)
+
+func (s AdmissionStage) String() string {
+	switch s {
+	case StageSingle:
+		return "Single"
+	case StagePriority:
+		return "Priority"
+	case StageMain:
+		return "Main"
+	default:
+		return "UnknownStage"
+	}
+}
+
+// calculateStaged runs the two-stage enrollment over the given headings and students.
+//
+// The priority stage admits BVI and quota applicants only, using their BVI and quota applications.
+// Its results are frozen: students admitted there don't take part in the main stage.
+// Then every heading's unfilled quota seats are moved into Capacities.Regular, and the main stage
+// admits the remaining students by their Regular applications. Both stages are recorded to trace, which may be nil.
+func calculateStaged(allHeadings []*Heading, allStudents []*Student, trace *AdmissionTrace) []CalculationResult {
+	trace.setStage(StagePriority)
+	priorityMatches := runDeferredAcceptance(allHeadings, stagedPriorityProposers(allStudents), nil, trace)
+
+	// Seats taken at the priority stage are gone; everything else goes to the general competition
+	takenSeats := make(map[*Heading]int)
+	priorityAdmitted := make(map[string]bool, len(priorityMatches))
+	for id, app := range priorityMatches {
+		takenSeats[app.Heading()]++
+		priorityAdmitted[id] = true
+	}
+
+	trace.setStage(StageMain)
+	mainMatches := runDeferredAcceptance(allHeadings, stagedMainProposers(allStudents, priorityAdmitted), stagedMainCapacities(allHeadings, takenSeats), trace)
+
+	slog.Debug("Staged enrollment finished", "priorityAdmitted", len(priorityMatches), "mainAdmitted", len(mainMatches))
+
+	matches := make(map[string]*Application, len(priorityMatches)+len(mainMatches))
+	stages := make(map[string]AdmissionStage, len(priorityMatches)+len(mainMatches))
+	for id, app := range priorityMatches {
+		matches[id] = app
+		stages[id] = StagePriority
+	}
+	for id, app := range mainMatches {
+		matches[id] = app
+		stages[id] = StageMain
+	}
+
+	return collectResults(allHeadings, matches, stages)
+}
+
+// stagedPriorityProposers returns the participants of the priority stage: the students who haven't quit,
+// proposing with their BVI and quota applications.
+func stagedPriorityProposers(allStudents []*Student) []*admissionProposer {
+	proposers := make([]*admissionProposer, 0)
+	for _, s := range allStudents {
+		if s.Quit() {
+			continue
+		}
+
+		var apps []*Application
+		for _, app := range s.Applications() {
+			if app.CompetitionType() != CompetitionRegular {
+				apps = append(apps, app)
+			}
+		}
+		if len(apps) > 0 {
+			proposers = append(proposers, &admissionProposer{id: s.ID(), applications: apps})
+		}
+	}
+	return proposers
+}
+
+// stagedMainCapacities returns the capacities of the main stage: all seats of every heading not taken
+// at the priority stage (heading -> number of seats taken) go to the general competition.
+func stagedMainCapacities(allHeadings []*Heading, takenSeats map[*Heading]int) map[*Heading]Capacities {
+	capacities := make(map[*Heading]Capacities, len(allHeadings))
+	for _, h := range allHeadings {
+		capacities[h] = Capacities{Regular: max(h.TotalCapacity()-takenSeats[h], 0)}
+	}
+	return capacities
+}
+
+// stagedMainProposers returns the participants of the main stage: the students who haven't quit and weren't
+// admitted at the priority stage, proposing with their Regular applications.
+func stagedMainProposers(allStudents []*Student, priorityAdmitted map[string]bool) []*admissionProposer {
+	proposers := make([]*admissionProposer, 0, len(allStudents))
+	for _, s := range allStudents {
+		if s.Quit() || priorityAdmitted[s.ID()] {
+			continue
+		}
+
+		var apps []*Application
+		for _, app := range s.Applications() {
+			if app.CompetitionType() == CompetitionRegular {
+				apps = append(apps, app)
+			}
+		}
+		if len(apps) > 0 {
+			proposers = append(proposers, &admissionProposer{id: s.ID(), applications: apps})
+		}
+	}
+	return proposers
+}
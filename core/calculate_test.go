@@ -3,6 +3,7 @@ package core
 import (
 	"fmt"
 	"sort"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -535,3 +536,251 @@ func TestCalculateAdmissions_ComplexPrioritiesAndDisplacement(t *testing.T) {
 	assert.Contains(t, admittedPrio2, sid(4), "S4 should be in H_LOW_CAP_PRIO2")
 	assert.NotContains(t, admittedHighCap, sid(4), "S4 should not have taken its P2 to H_HIGH_CAP")
 }
+
+func TestSimulateOriginalsDrain_SameSeedSameResult(t *testing.T) {
+	build := func() *VarsityCalculator {
+		v := NewVarsityCalculator("v", "Varsity")
+		v.AddHeading("H", Capacities{Regular: 5}, "Heading")
+		for i := 1; i <= 20; i++ {
+			v.AddApplication("H", strconv.Itoa(i), 300-i, 1, CompetitionRegular, 1)
+		}
+		return v
+	}
+	quitIDs := func(v *VarsityCalculator) []string {
+		var ids []string
+		for _, s := range v.Students() {
+			if s.Quit() {
+				ids = append(ids, s.ID())
+			}
+		}
+		sort.Strings(ids)
+		return ids
+	}
+
+	a, b, c := build(), build(), build()
+	a.SimulateOriginalsDrain(50, 42)
+	b.SimulateOriginalsDrain(50, 42)
+	c.SimulateOriginalsDrain(50, 43)
+
+	assert.Len(t, quitIDs(a), 10)
+	assert.Equal(t, quitIDs(a), quitIDs(b))
+	assert.NotEqual(t, quitIDs(a), quitIDs(c))
+}
+
+// TestSimulateWeightedOriginalsDrain_SkipsZeroWeights tests that weighted drains never pick zero-weight students
+// while enough students with positive weights are available
+func TestSimulateWeightedOriginalsDrain_SkipsZeroWeights(t *testing.T) {
+	v := NewVarsityCalculator("v", "Varsity")
+	v.AddHeading("H", Capacities{Regular: 5}, "Heading")
+	for i := 1; i <= 20; i++ {
+		v.AddApplication("H", strconv.Itoa(i), i, 1, CompetitionRegular, 300-i)
+	}
+
+	// Only the ten students scoring 290 or more may quit
+	v.SimulateWeightedOriginalsDrain(50, 42, func(s *Student) float64 {
+		if s.Applications()[0].Score() >= 290 {
+			return 1
+		}
+		return 0
+	})
+
+	quit := 0
+	for _, s := range v.Students() {
+		if s.Quit() {
+			quit++
+			assert.GreaterOrEqual(t, s.Applications()[0].Score(), 290, "student %s", s.ID())
+		}
+	}
+	assert.Equal(t, 10, quit)
+	assert.Equal(t, 50, v.DrainedPercent())
+}
+
+// TestCalculateAdmissions_TieBreakBySubjectScores tests that equal total scores are resolved by the exam score sum
+// and then by subject scores when ratings are recomputed
+func TestCalculateAdmissions_TieBreakBySubjectScores(t *testing.T) {
+	v := NewVarsityCalculator("TEST_VARSITY", "")
+	v.SetRecomputeRatings(true)
+	v.AddHeading("H1", Capacities{Regular: 1}, "Heading 1")
+	v.SetHeadingSubjects("H1", []string{"Math", "Physics", "Russian"})
+
+	// Published places put student 1 first, but student 2 has the better score in the priority subject
+	v.AddApplicationDetailed("H1", sid(1), 1, 1, CompetitionRegular, 270, ScoreDetails{SubjectScores: []int{90, 95, 85}})
+	v.AddApplicationDetailed("H1", sid(2), 2, 1, CompetitionRegular, 270, ScoreDetails{SubjectScores: []int{92, 88, 90}})
+
+	v.NormalizeApplications()
+	results := v.CalculateAdmissions()
+	assert.Equal(t, []string{"0000000000002"}, getAdmittedStudentIDs(results, "H1"))
+
+	// Totals are equal, but student 1 owes fewer points to individual achievements, so their exam sum is higher.
+	// It decides before the subject scores, although student 2 has the better score in the priority subject
+	w := NewVarsityCalculator("TEST_VARSITY", "")
+	w.SetRecomputeRatings(true)
+	w.AddHeading("H1", Capacities{Regular: 1}, "Heading 1")
+	w.AddApplicationDetailed("H1", sid(1), 2, 1, CompetitionRegular, 275, ScoreDetails{SubjectScores: []int{90, 90, 90}, AchievementScore: 5})
+	w.AddApplicationDetailed("H1", sid(2), 1, 1, CompetitionRegular, 275, ScoreDetails{SubjectScores: []int{95, 85, 85}, AchievementScore: 10})
+
+	w.NormalizeApplications()
+	results = w.CalculateAdmissions()
+	assert.Equal(t, []string{"0000000000001"}, getAdmittedStudentIDs(results, "H1"))
+}
+
+// TestCalculateAdmissions_InconsistentRanksRecomputed tests that ratings are recomputed from scores
+// when the published rating places contradict them
+func TestCalculateAdmissions_InconsistentRanksRecomputed(t *testing.T) {
+	v := NewVarsityCalculator("TEST_VARSITY", "")
+	v.AddHeading("H1", Capacities{Regular: 1}, "Heading 1")
+
+	// Student 1 is placed first despite the lower total score
+	v.AddApplicationDetailed("H1", sid(1), 1, 1, CompetitionRegular, 250, ScoreDetails{})
+	v.AddApplicationDetailed("H1", sid(2), 2, 1, CompetitionRegular, 260, ScoreDetails{})
+
+	v.NormalizeApplications()
+	results := v.CalculateAdmissions()
+	assert.Equal(t, []string{"0000000000002"}, getAdmittedStudentIDs(results, "H1"))
+}
+
+// TestCalculateAdmissions_StagedEnrollment tests that the priority stage is frozen before the main stage
+// and that unfilled quota seats are released into the general competition
+func TestCalculateAdmissions_StagedEnrollment(t *testing.T) {
+	v := NewVarsityCalculator("TEST_VARSITY", "")
+	v.SetStagedEnrollment(true)
+	v.AddHeading("H1", Capacities{Regular: 1, TargetQuota: 1, SpecialQuota: 1}, "Heading 1")
+	v.AddHeading("H2", Capacities{Regular: 1}, "Heading 2")
+
+	// Student 1 prefers H2 by the general competition but is enrolled to H1 by the quota at the priority stage
+	v.AddApplication("H2", sid(1), 1, 1, CompetitionRegular, 290)
+	v.AddApplication("H1", sid(1), 1, 2, CompetitionTargetQuota, 290)
+	// The special quota of H1 stays unfilled, so both regular applicants fit into H1
+	v.AddApplication("H1", sid(2), 1, 1, CompetitionRegular, 280)
+	v.AddApplication("H1", sid(3), 2, 1, CompetitionRegular, 270)
+	v.AddApplication("H2", sid(4), 2, 1, CompetitionRegular, 260)
+	v.NormalizeApplications()
+
+	results := v.CalculateAdmissions()
+
+	assert.ElementsMatch(t, []string{"0000000000001", "0000000000002", "0000000000003"}, getAdmittedStudentIDs(results, "H1"))
+	assert.Equal(t, []string{"0000000000004"}, getAdmittedStudentIDs(results, "H2"))
+
+	for _, res := range results {
+		for _, s := range res.Admitted {
+			expected := StageMain
+			if s.ID() == "0000000000001" {
+				expected = StagePriority
+			}
+			assert.Equal(t, expected, res.StageOf(s.ID()), "stage of student %s", s.ID())
+		}
+	}
+}
+
+// TestCalculateAdmissions_TraceExplainsLoss tests that the recorded trace names the applicant a student lost
+// their higher-priority heading to
+func TestCalculateAdmissions_TraceExplainsLoss(t *testing.T) {
+	v := NewVarsityCalculator("TEST_VARSITY", "")
+	v.EnableTrace()
+	v.AddHeading("H1", Capacities{Regular: 1}, "Heading 1")
+	v.AddHeading("H2", Capacities{Regular: 1}, "Heading 2")
+
+	v.AddApplication("H1", sid(1), 2, 1, CompetitionRegular, 250)
+	v.AddApplication("H2", sid(1), 1, 2, CompetitionRegular, 250)
+	v.AddApplication("H1", sid(2), 1, 1, CompetitionRegular, 280)
+
+	v.CalculateAdmissions()
+
+	trace := v.Trace()
+	assert.NotNil(t, trace)
+
+	explanation := ExplainAdmission("0000000000001", trace.Events())
+	assert.Equal(t, "TEST_VARSITY:H2", explanation.AdmittedHeadingCode)
+	assert.Equal(t, 2, explanation.AdmittedPriority)
+	if assert.Len(t, explanation.Losses, 1) {
+		assert.Equal(t, "TEST_VARSITY:H1", explanation.Losses[0].HeadingCode)
+		assert.Equal(t, "0000000000002", explanation.Losses[0].LostToStudentID)
+	}
+
+	explanation = ExplainAdmission("0000000000002", trace.Events())
+	assert.Equal(t, "TEST_VARSITY:H1", explanation.AdmittedHeadingCode)
+	assert.Empty(t, explanation.Losses)
+}
+
+// TestCalculateAdmissions_TargetSubQuotas tests that detailed target quotas rank and admit their applicants separately
+func TestCalculateAdmissions_TargetSubQuotas(t *testing.T) {
+	v := NewVarsityCalculator("TEST_VARSITY", "")
+	v.AddHeading("H1", Capacities{TargetQuota: 3, TargetSubQuotas: map[string]int{"A": 1, "B": 1}}, "Heading 1")
+
+	// Both applicants of A outrank the applicant of B within the merged list, but A has a single seat
+	v.AddSubQuotaApplication("H1", sid(1), "A", 1, 1, 250, ScoreDetails{})
+	v.AddSubQuotaApplication("H1", sid(2), "A", 2, 1, 240, ScoreDetails{})
+	v.AddSubQuotaApplication("H1", sid(3), "B", 5, 1, 200, ScoreDetails{})
+	// Unknown sub-quotas compete in the general target quota, which has a single seat left
+	v.AddSubQuotaApplication("H1", sid(4), "C", 3, 1, 230, ScoreDetails{})
+	v.AddApplication("H1", sid(5), 4, 1, CompetitionTargetQuota, 220)
+
+	results := v.CalculateAdmissions()
+
+	assert.ElementsMatch(t, []string{"0000000000001", "0000000000003", "0000000000004"}, getAdmittedStudentIDs(results, "H1"))
+
+	for _, res := range results {
+		if res.Heading.Code() != "H1" {
+			continue
+		}
+		assert.Equal(t, []SubQuotaResult{
+			{Name: "A", Capacity: 1, Admitted: 1, PassingScore: 250, LastAdmittedRatingPlace: 1},
+			{Name: "B", Capacity: 1, Admitted: 1, PassingScore: 200, LastAdmittedRatingPlace: 5},
+		}, res.SubQuotaResults())
+	}
+}
+
+func TestCalculateAdmissions_PaidAfterBudget(t *testing.T) {
+	v := NewVarsityCalculator("TEST_VARSITY", "")
+	v.AddHeading("H1", Capacities{Regular: 1, Paid: 1}, "Heading 1")
+	v.AddHeading("H2", Capacities{Regular: 1}, "Heading 2")
+
+	// Student 1 wins the only budget seat of H1, so their paid application doesn't take a paid seat
+	v.AddApplication("H1", sid(1), 1, 1, CompetitionRegular, 250)
+	v.AddApplication("H1", sid(1), 1, 2, CompetitionPaid, 250)
+	// Student 2 loses the budget seat and takes the paid seat over student 3
+	v.AddApplication("H1", sid(2), 2, 1, CompetitionRegular, 240)
+	v.AddApplication("H1", sid(2), 2, 2, CompetitionPaid, 240)
+	// Student 3 prefers paid H1 to budget H2, but budget admissions come first
+	v.AddApplication("H1", sid(3), 3, 1, CompetitionPaid, 230)
+	v.AddApplication("H2", sid(3), 1, 2, CompetitionRegular, 230)
+
+	v.NormalizeApplications()
+	results := v.CalculateAdmissions()
+
+	assert.Equal(t, []string{"0000000000001"}, getAdmittedStudentIDs(results, "H1"))
+	assert.Equal(t, []string{"0000000000003"}, getAdmittedStudentIDs(results, "H2"))
+
+	for _, res := range results {
+		if res.Heading.Code() != "H1" {
+			assert.Empty(t, res.PaidAdmitted)
+			continue
+		}
+		if assert.Len(t, res.PaidAdmitted, 1) {
+			assert.Equal(t, "0000000000002", res.PaidAdmitted[0].ID())
+		}
+
+		paidPassingScore, err := res.PaidPassingScore()
+		assert.NoError(t, err)
+		assert.Equal(t, 240, paidPassingScore)
+	}
+}
+
+// TestCalculateAdmissions_QuotaSeatShrinksGeneralCompetition tests that general applicants admitted before a quota
+// applicant takes their quota seat lose the seats they don't fit into anymore and move on to their next choices
+func TestCalculateAdmissions_QuotaSeatShrinksGeneralCompetition(t *testing.T) {
+	v := NewVarsityCalculator("TEST_VARSITY", "")
+	v.AddHeading("H1", Capacities{Regular: 1, TargetQuota: 1}, "Heading 1")
+	v.AddHeading("H2", Capacities{Regular: 1}, "Heading 2")
+
+	// Both regular applicants propose before the target one, while the unused quota seat is still general
+	v.AddApplication("H1", sid(1), 1, 1, CompetitionRegular, 250)
+	v.AddApplication("H1", sid(2), 2, 1, CompetitionRegular, 240)
+	v.AddApplication("H2", sid(2), 1, 2, CompetitionRegular, 240)
+	v.AddApplication("H1", sid(3), 1, 1, CompetitionTargetQuota, 200)
+
+	results := v.CalculateAdmissions()
+
+	assert.ElementsMatch(t, []string{"0000000000001", "0000000000003"}, getAdmittedStudentIDs(results, "H1"))
+	assert.Equal(t, []string{"0000000000002"}, getAdmittedStudentIDs(results, "H2"))
+}
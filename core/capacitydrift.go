@@ -0,0 +1,35 @@
+package core
+
+import "maps"
+
+// CapacityDrift compares the capacities of a heading in the registry with the ones its varsity currently
+// publishes, as varsities revise their control numbers and quota splits during the admission campaign.
+type CapacityDrift struct {
+	HeadingCode string     `json:"heading_code,omitempty"` // Code of the heading the source sent, if it loaded
+	Name        string     `json:"name,omitempty"`         // Heading name the source is defined with, if any
+	Registry    Capacities `json:"registry"`
+	Published   Capacities `json:"published"`
+	// TotalOnly tells that the varsity only publishes the total capacities, held as the Regular ones of
+	// Published
+	TotalOnly bool `json:"total_only,omitempty"`
+	// Applied tells whether the heading was loaded with the published capacities instead of the registry ones
+	Applied bool `json:"applied"`
+}
+
+// Drifted reports whether the published capacities differ from the ones in the registry. Headings without
+// capacities in the registry don't drift, and neither do the paid places, which aren't control numbers.
+// The detailed target quotas are only compared if the varsity publishes them, and only the totals if it
+// publishes nothing else.
+func (d CapacityDrift) Drifted() bool {
+	r, p := d.Registry, d.Published
+	if r.Total() == 0 {
+		return false
+	}
+	if d.TotalOnly {
+		return r.Total() != p.Total()
+	}
+	if r.Regular != p.Regular || r.TargetQuota != p.TargetQuota || r.DedicatedQuota != p.DedicatedQuota || r.SpecialQuota != p.SpecialQuota {
+		return true
+	}
+	return len(p.TargetSubQuotas) > 0 && !maps.Equal(r.TargetSubQuotas, p.TargetSubQuotas)
+}
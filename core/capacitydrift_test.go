@@ -0,0 +1,24 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCapacityDrift_Drifted(t *testing.T) {
+	registry := Capacities{Regular: 40, TargetQuota: 5, DedicatedQuota: 5, SpecialQuota: 5, Paid: 30}
+
+	assert.False(t, CapacityDrift{Registry: registry, Published: Capacities{Regular: 40, TargetQuota: 5, DedicatedQuota: 5, SpecialQuota: 5}}.Drifted())
+	assert.True(t, CapacityDrift{Registry: registry, Published: Capacities{Regular: 38, TargetQuota: 7, DedicatedQuota: 5, SpecialQuota: 5}}.Drifted())
+	// Nothing to drift from
+	assert.False(t, CapacityDrift{Published: Capacities{Regular: 10}}.Drifted())
+
+	// Only the totals are compared if only they are published
+	assert.False(t, CapacityDrift{Registry: registry, Published: Capacities{Regular: 55}, TotalOnly: true}.Drifted())
+	assert.True(t, CapacityDrift{Registry: registry, Published: Capacities{Regular: 60}, TotalOnly: true}.Drifted())
+
+	withSubQuotas := Capacities{Regular: 40, TargetQuota: 5, TargetSubQuotas: map[string]int{"1": 3, "2": 2}}
+	assert.False(t, CapacityDrift{Registry: withSubQuotas, Published: Capacities{Regular: 40, TargetQuota: 5}}.Drifted())
+	assert.True(t, CapacityDrift{Registry: withSubQuotas, Published: Capacities{Regular: 40, TargetQuota: 5, TargetSubQuotas: map[string]int{"1": 4, "2": 1}}}.Drifted())
+}
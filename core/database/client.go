@@ -11,6 +11,7 @@ import (
 
 	"github.com/Masterminds/squirrel"
 	"github.com/trueegorletov/analabit/core/ent"
+	"github.com/trueegorletov/analabit/core/ent/admissionevent"
 	"github.com/trueegorletov/analabit/core/ent/run"
 	"github.com/trueegorletov/analabit/core/metrics"
 )
@@ -114,7 +115,7 @@ func (c *Client) BackupDataToBeDeleted(ctx context.Context, backupDir string, th
 	}
 
 	// Tables that contain run_id and will have data deleted
-	tables := []string{"applications", "calculations", "drained_results"}
+	tables := []string{"applications", "calculations", "drained_results", "admission_chances", "admission_events", "source_reports"}
 	backupFile := fmt.Sprintf("%s/cleanup_backup_%d.csv.gz", backupDir, time.Now().Unix())
 
 	file, err := os.Create(backupFile)
@@ -244,7 +245,7 @@ func (c *Client) PerformBackupAndCleanup(ctx context.Context, retention int, bac
 	}
 
 	// Cleanup old data regardless of backup success
-	tables := []string{"applications", "calculations", "drained_results"}
+	tables := []string{"applications", "calculations", "drained_results", "admission_chances", "admission_events", "source_reports"}
 	for _, table := range tables {
 		query := fmt.Sprintf("DELETE FROM %s WHERE run_id < %d", table, thresholdRunID)
 		_, err := c.Client.ExecContext(ctx, query)
@@ -256,3 +257,20 @@ func (c *Client) PerformBackupAndCleanup(ctx context.Context, retention int, bac
 	// Return backup error if it occurred (cleanup was successful)
 	return backupErr
 }
+
+// CleanupAdmissionEvents deletes the admission traces of all runs but the latest retention ones up to
+// latestRunID, since the traces are by far the largest data of a run. A retention below 1 keeps them all,
+// leaving them to PerformBackupAndCleanup.
+func (c *Client) CleanupAdmissionEvents(ctx context.Context, latestRunID, retention int) (int, error) {
+	if retention < 1 {
+		return 0, nil
+	}
+
+	deleted, err := c.Client.AdmissionEvent.Delete().
+		Where(admissionevent.HasRunWith(run.IDLTE(latestRunID - retention))).
+		Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to cleanup admission events: %w", err)
+	}
+	return deleted, nil
+}
@@ -0,0 +1,55 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/ent/admissionevent"
+	"github.com/trueegorletov/analabit/core/ent/enttest"
+)
+
+func TestCleanupAdmissionEvents(t *testing.T) {
+	entClient := enttest.Open(t, "sqlite3", "file:cleanup_admission_events?mode=memory&cache=shared&_fk=1")
+	defer entClient.Close()
+	ctx := context.Background()
+
+	v := entClient.Varsity.Create().SetCode("test").SetName("Test Varsity").SaveX(ctx)
+	h := entClient.Heading.Create().
+		SetCode("test:H1").SetName("Heading 1").
+		SetRegularCapacity(10).SetTargetQuotaCapacity(0).SetDedicatedQuotaCapacity(0).SetSpecialQuotaCapacity(0).
+		SetVarsity(v).
+		SaveX(ctx)
+
+	var runIDs []int
+	for i := 0; i < 3; i++ {
+		run := entClient.Run.Create().SaveX(ctx)
+		runIDs = append(runIDs, run.ID)
+		entClient.AdmissionEvent.Create().
+			SetSeq(1).SetKind(core.TraceProposed).SetStudentID("1").SetPriority(1).
+			SetCompetitionType(core.CompetitionRegular).SetHeading(h).SetRun(run).
+			SaveX(ctx)
+	}
+
+	client, err := NewClient(entClient)
+	require.NoError(t, err)
+
+	// A retention below 1 keeps all traces
+	deleted, err := client.CleanupAdmissionEvents(ctx, runIDs[2], 0)
+	require.NoError(t, err)
+	assert.Equal(t, 0, deleted)
+
+	// Only the traces of the latest 2 runs are kept
+	deleted, err = client.CleanupAdmissionEvents(ctx, runIDs[2], 2)
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+
+	remaining := entClient.AdmissionEvent.Query().Order(admissionevent.ByRunID()).AllX(ctx)
+	require.Len(t, remaining, 2)
+	assert.Equal(t, runIDs[1], remaining[0].RunID)
+	assert.Equal(t, runIDs[2], remaining[1].RunID)
+}
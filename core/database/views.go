@@ -18,7 +18,8 @@ func NewViewManager(client *Client) *ViewManager {
 
 
 
-// CreateApplicationFlagsView creates the application_flags materialized view
+// CreateApplicationFlagsView creates the application_flags materialized view.
+// Paid applications (competition type 5) are only compared with paid applications and paid calculations.
 func (vm *ViewManager) CreateApplicationFlagsView(ctx context.Context) error {
 	query := `
 CREATE MATERIALIZED VIEW IF NOT EXISTS application_flags AS
@@ -34,14 +35,17 @@ SELECT
      AND a2.run_id = a.run_id
      AND a2.priority < a.priority
      AND a2.heading_applications != a.heading_applications
+     AND (a2.competition_type = 5) = (a.competition_type = 5)
      AND EXISTS (SELECT 1 FROM calculations c
                  WHERE c.student_id = a2.student_id
                    AND c.heading_calculations = a2.heading_applications
-                   AND c.run_id = a2.run_id)) AS passing_to_more_priority,
+                   AND c.run_id = a2.run_id
+                   AND c.paid = (a2.competition_type = 5))) AS passing_to_more_priority,
   EXISTS (SELECT 1 FROM calculations c
           WHERE c.student_id = a.student_id
             AND c.heading_calculations = a.heading_applications
-            AND c.run_id = a.run_id) AS passing_now,
+            AND c.run_id = a.run_id
+            AND c.paid = (a.competition_type = 5)) AS passing_now,
   (SELECT COUNT(*) FROM applications a2
    JOIN headings h2 ON a2.heading_applications = h2.id
    WHERE a2.student_id = a.student_id
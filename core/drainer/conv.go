@@ -21,13 +21,44 @@ func ConvResults(results []core.CalculationResult) []DrainedResult {
 			continue
 		}
 
+		// Both are left 0 for headings nobody was admitted to on paid places
+		paidPassingScore, _ := result.PaidPassingScore()
+		paidLastAdmittedRatingPlace, _ := result.PaidLastAdmittedRatingPlace()
+
 		drained = append(drained, DrainedResult{
 			Heading:                    result.Heading,
 			AvgPassingScore:            passingScore,
 			AvgLastAdmittedRatingPlace: lastAdmittedRatingPlace,
 			DrainedPercent:             0,
+			SubQuotas:                  convSubQuotas(result.SubQuotaResults()),
+
+			AvgPaidPassingScore:            paidPassingScore,
+			AvgPaidLastAdmittedRatingPlace: paidLastAdmittedRatingPlace,
 		})
 	}
 
 	return drained
 }
+
+// convSubQuotas converts the sub-quota results of a single calculation to drained results of one iteration.
+func convSubQuotas(results []core.SubQuotaResult) []SubQuotaDrainedResult {
+	if len(results) == 0 {
+		return nil
+	}
+
+	converted := make([]SubQuotaDrainedResult, 0, len(results))
+	for _, r := range results {
+		converted = append(converted, SubQuotaDrainedResult{
+			Name:                       r.Name,
+			Capacity:                   r.Capacity,
+			AvgAdmitted:                r.Admitted,
+			MinPassingScore:            r.PassingScore,
+			MaxPassingScore:            r.PassingScore,
+			AvgPassingScore:            r.PassingScore,
+			MinLastAdmittedRatingPlace: r.LastAdmittedRatingPlace,
+			MaxLastAdmittedRatingPlace: r.LastAdmittedRatingPlace,
+			AvgLastAdmittedRatingPlace: r.LastAdmittedRatingPlace,
+		})
+	}
+	return converted
+}
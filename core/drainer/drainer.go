@@ -3,6 +3,8 @@ package drainer
 import (
 	"fmt"
 	"log/slog"
+	"math"
+	"runtime"
 	"sort"
 	"sync"
 
@@ -13,137 +15,297 @@ import (
 type Drainer struct {
 	prototype    *source.Varsity
 	drainPercent int
+	seed         int64
+	model        DrainModel
 }
 
-func New(prototype *source.Varsity, drainPercent int) *Drainer {
+// New creates a drainer for the given prototype varsity and drain stage. The seed fully determines which
+// students are drained at every iteration, so two runs with the same seed over the same data give the same results.
+// Use DeriveSeed to obtain a per-varsity, per-stage seed from the master seed of a run.
+// The model picks the drained students; nil means UniformModel.
+func New(prototype *source.Varsity, drainPercent int, seed int64, model DrainModel) *Drainer {
 	if drainPercent < 0 || drainPercent > 100 {
 		panic("drain percent must be between 0 and 100")
 	}
 
+	if model == nil {
+		model = UniformModel{}
+	}
+
 	return &Drainer{
 		prototype:    prototype,
 		drainPercent: drainPercent,
+		seed:         seed,
+		model:        model,
 	}
 }
 
-const maxComputeGoroutines = 64
+// Seed returns the seed the drainer derives its iteration seeds from.
+func (d *Drainer) Seed() int64 {
+	return d.seed
+}
 
+// Run runs exactly the given number of drain iterations and aggregates their results per heading.
 func (d *Drainer) Run(iterations int) []DrainedResult {
-	type headingResults struct {
-		prototypeHeading      *core.Heading
-		psValues              []int
-		larpValues            []int
-		psSum                 int
-		larpSum               int
-		regularsAdmittedCount int
+	return d.RunAdaptive(iterations, iterations, 0)
+}
+
+// RunAdaptive runs minIterations drain iterations, then keeps running further batches of minIterations until the
+// average passing score of every heading converges: its standard error is at most tolerance points. Only the
+// iterations that admitted regulars are judged, as the passing score of the others is the noRegularsPassingScore
+// sentinel rather than a measurement. It never runs
+// more than maxIterations in total. A tolerance of 0 disables the convergence check, so exactly minIterations run.
+// The number of iterations run depends on the results only, so it's reproducible from the same seed and data.
+func (d *Drainer) RunAdaptive(minIterations, maxIterations int, tolerance float64) []DrainedResult {
+	if minIterations <= 0 {
+		return nil
+	}
+	maxIterations = max(maxIterations, minIterations)
+
+	// Every iteration runs on its own overlay of the same snapshot instead of a reloaded copy of the varsity
+	snapshot := d.prototype.VarsityCalculator.Snapshot()
+	codeToResult := make(map[string]*headingResults)
+
+	iterations := 0
+	for {
+		batch := min(minIterations, maxIterations-iterations)
+		for step := range d.runIterations(snapshot, iterations, iterations+batch) {
+			for _, result := range step {
+				code := result.Heading.Code()
+
+				results, ok := codeToResult[code]
+				if !ok {
+					results = newHeadingResults(d.prototype.GetHeading(code), maxIterations)
+					codeToResult[code] = results
+				}
+				results.add(result)
+			}
+		}
+		iterations += batch
+
+		if tolerance <= 0 || iterations >= maxIterations {
+			break
+		}
+		if converged(codeToResult, tolerance) {
+			slog.Debug("Drain iterations converged", "seed", d.seed, "drainPercent", d.drainPercent, "iterations", iterations)
+			break
+		}
 	}
 
-	codeToResult := make(map[string]headingResults)
+	drained := make([]DrainedResult, 0, len(codeToResult))
+
+	for _, results := range codeToResult {
+		if len(results.psValues) == 0 || len(results.larpValues) == 0 {
+			fmt.Println("No results for heading", "heading", results.prototypeHeading.PrettyName())
+			continue
+		}
+
+		sort.Ints(results.psValues)
+		sort.Ints(results.larpValues)
+
+		drained = append(drained, DrainedResult{
+			Heading:                        results.prototypeHeading,
+			MinPassingScore:                results.psValues[0],
+			MaxPassingScore:                results.psValues[len(results.psValues)-1],
+			AvgPassingScore:                results.psSum / len(results.psValues),
+			MedPassingScore:                median(results.psValues),
+			MinLastAdmittedRatingPlace:     results.larpValues[0],
+			MaxLastAdmittedRatingPlace:     results.larpValues[len(results.larpValues)-1],
+			AvgLastAdmittedRatingPlace:     results.larpSum / len(results.larpValues),
+			MedLastAdmittedRatingPlace:     median(results.larpValues),
+			P10PassingScore:                percentile(results.psValues, 10),
+			P25PassingScore:                percentile(results.psValues, 25),
+			P75PassingScore:                percentile(results.psValues, 75),
+			P90PassingScore:                percentile(results.psValues, 90),
+			P10LastAdmittedRatingPlace:     percentile(results.larpValues, 10),
+			P25LastAdmittedRatingPlace:     percentile(results.larpValues, 25),
+			P75LastAdmittedRatingPlace:     percentile(results.larpValues, 75),
+			P90LastAdmittedRatingPlace:     percentile(results.larpValues, 90),
+			DrainedPercent:                 d.drainPercent,
+			Seed:                           d.seed,
+			Model:                          d.model.Name(),
+			RegularsAdmitted:               results.regularsAdmittedCount > 0,
+			AvgPriorityStageAdmitted:       results.stageAdmittedSums[core.StagePriority] / iterations,
+			AvgMainStageAdmitted:           results.stageAdmittedSums[core.StageMain] / iterations,
+			Iterations:                     iterations,
+			AdmittedCounts:                 results.admittedCounts,
+			SubQuotas:                      aggregateSubQuotas(results.subQuotas, iterations),
+			AvgPaidPassingScore:            average(results.paidPsValues),
+			AvgPaidLastAdmittedRatingPlace: average(results.paidLarpValues),
+
+			PassingScoreHistogram:            newHistogram(results.psValues),
+			LastAdmittedRatingPlaceHistogram: newHistogram(results.larpValues),
+		})
+	}
 
-	resultsChan := make(chan []core.CalculationResult, iterations)
+	return drained
+}
 
-	sema := make(chan struct{}, maxComputeGoroutines)
+// runIterations runs the drain iterations from (inclusive) to to (exclusive) on overlays of the snapshot, spread
+// over all CPUs, and sends the results of every iteration to the returned channel, closed when all of them are done.
+func (d *Drainer) runIterations(snapshot *core.VarsitySnapshot, from, to int) <-chan []core.CalculationResult {
+	iterationsChan := make(chan int)
+	resultsChan := make(chan []core.CalculationResult, runtime.GOMAXPROCS(0))
 
 	wg := sync.WaitGroup{}
-	for it := 0; it < iterations; it++ {
+	for range runtime.GOMAXPROCS(0) {
 		wg.Add(1)
 		go func() {
-			sema <- struct{}{}
-			defer func() {
-				wg.Done()
-				<-sema
-			}()
-
-			vc := d.prototype.Clone().VarsityCalculator
-			vc.SimulateOriginalsDrain(d.drainPercent)
-			resultsChan <- vc.CalculateAdmissions()
+			defer wg.Done()
+			for it := range iterationsChan {
+				overlay := snapshot.NewOverlay()
+				d.model.Drain(overlay, d.drainPercent, IterationSeed(d.seed, it))
+				resultsChan <- overlay.CalculateAdmissions()
+			}
 		}()
 	}
 
 	go func() {
+		for it := from; it < to; it++ {
+			iterationsChan <- it
+		}
+		close(iterationsChan)
 		wg.Wait()
 		close(resultsChan)
 	}()
 
-	for step := range resultsChan {
-		for _, result := range step {
-			code := result.Heading.Code()
+	return resultsChan
+}
 
-			results, ok := codeToResult[code]
+// noRegularsPassingScore is the passing score recorded for the iterations in which a heading admitted no
+// regulars: all its places went to BVI and quota applicants, so getting in takes the maximum score.
+const noRegularsPassingScore = 310
 
-			if !ok {
-				results.prototypeHeading = d.prototype.GetHeading(code)
-				results.psValues = make([]int, 0, iterations)
-				results.larpValues = make([]int, 0, iterations)
-			}
+// headingResults accumulates the outcome of a single heading across the drain iterations.
+type headingResults struct {
+	prototypeHeading      *core.Heading
+	psValues              []int
+	larpValues            []int
+	psSum                 int
+	larpSum               int
+	regularPsValues       []int // Passing scores of the iterations that admitted regulars, without the sentinel
+	regularsAdmittedCount int
+	admittedCounts        map[string]int
+	stageAdmittedSums     map[core.AdmissionStage]int
+	subQuotas             map[string]*subQuotaResults
+	paidPsValues          []int
+	paidLarpValues        []int
+}
 
-			hasRegulars := false
-			for _, student := range result.Admitted {
-				app := student.Application(result.Heading)
-				if app.CompetitionType() == core.CompetitionRegular {
-					hasRegulars = true
-					break
-				}
-			}
+func newHeadingResults(prototypeHeading *core.Heading, iterations int) *headingResults {
+	return &headingResults{
+		prototypeHeading:  prototypeHeading,
+		psValues:          make([]int, 0, iterations),
+		larpValues:        make([]int, 0, iterations),
+		admittedCounts:    make(map[string]int),
+		stageAdmittedSums: make(map[core.AdmissionStage]int),
+		subQuotas:         make(map[string]*subQuotaResults),
+	}
+}
 
-			passingScore, err := result.PassingScore()
+// add accounts the heading's result of a single iteration.
+func (r *headingResults) add(result core.CalculationResult) {
+	code := result.Heading.Code()
 
-			if err != nil {
-				slog.Debug("Passing score unavailable", "error", err, "heading", code)
-				continue
-			}
+	for _, sq := range result.SubQuotaResults() {
+		sqResults, ok := r.subQuotas[sq.Name]
+		if !ok {
+			sqResults = &subQuotaResults{capacity: sq.Capacity}
+			r.subQuotas[sq.Name] = sqResults
+		}
+		sqResults.add(sq)
+	}
 
-			if !hasRegulars {
-				passingScore = 310
-			}
+	if paidPassingScore, err := result.PaidPassingScore(); err == nil {
+		paidLarp, _ := result.PaidLastAdmittedRatingPlace()
+		r.paidPsValues = append(r.paidPsValues, paidPassingScore)
+		r.paidLarpValues = append(r.paidLarpValues, paidLarp)
+	}
 
-			lastAdmittedRatingPlace, err := result.LastAdmittedRatingPlace()
+	// Admissions are counted even if the iteration's passing score turns out to be unavailable below
+	for _, student := range result.Admitted {
+		r.admittedCounts[student.ID()]++
+		r.stageAdmittedSums[result.StageOf(student.ID())]++
+	}
 
-			if err != nil {
-				slog.Debug("Last admitted rating place unavailable", "error", err, "heading", code)
-				continue
-			}
+	hasRegulars := false
+	for _, student := range result.Admitted {
+		app := student.Application(result.Heading)
+		if app.CompetitionType() == core.CompetitionRegular {
+			hasRegulars = true
+			break
+		}
+	}
 
-			results.psValues = append(results.psValues, passingScore)
-			results.larpValues = append(results.larpValues, lastAdmittedRatingPlace)
-			results.psSum += passingScore
-			results.larpSum += lastAdmittedRatingPlace
+	passingScore, err := result.PassingScore()
 
-			if hasRegulars {
-				results.regularsAdmittedCount++
-			}
+	if err != nil {
+		slog.Debug("Passing score unavailable", "error", err, "heading", code)
+		return
+	}
 
-			codeToResult[code] = results
-		}
+	if !hasRegulars {
+		passingScore = noRegularsPassingScore
 	}
 
-	drained := make([]DrainedResult, 0, len(codeToResult))
+	lastAdmittedRatingPlace, err := result.LastAdmittedRatingPlace()
+
+	if err != nil {
+		slog.Debug("Last admitted rating place unavailable", "error", err, "heading", code)
+		return
+	}
+
+	r.psValues = append(r.psValues, passingScore)
+	r.larpValues = append(r.larpValues, lastAdmittedRatingPlace)
+	r.psSum += passingScore
+	r.larpSum += lastAdmittedRatingPlace
+
+	if hasRegulars {
+		r.regularPsValues = append(r.regularPsValues, passingScore)
+		r.regularsAdmittedCount++
+	}
+}
 
+// converged reports whether the standard error of the average passing score of every heading is at most tolerance.
+// The passing scores of the iterations that admitted no regulars are left out: the sentinel would inflate the error
+// of the headings that admit regulars only sometimes, and make it zero for the ones that never do. Headings with
+// fewer than two such passing scores can't be estimated yet and don't hold the iterations back.
+func converged(codeToResult map[string]*headingResults, tolerance float64) bool {
 	for _, results := range codeToResult {
-		if len(results.psValues) == 0 || len(results.larpValues) == 0 {
-			fmt.Println("No results for heading", "heading", results.prototypeHeading.PrettyName())
+		n := len(results.regularPsValues)
+		if n < 2 {
 			continue
 		}
 
-		sort.Ints(results.psValues)
-		sort.Ints(results.larpValues)
+		mean := float64(sum(results.regularPsValues)) / float64(n)
+		var squares float64
+		for _, ps := range results.regularPsValues {
+			squares += (float64(ps) - mean) * (float64(ps) - mean)
+		}
+		stdErr := math.Sqrt(squares/float64(n-1)) / math.Sqrt(float64(n))
 
-		drained = append(drained, DrainedResult{
-			Heading:                    results.prototypeHeading,
-			MinPassingScore:            results.psValues[0],
-			MaxPassingScore:            results.psValues[len(results.psValues)-1],
-			AvgPassingScore:            results.psSum / len(results.psValues),
-			MedPassingScore:            median(results.psValues),
-			MinLastAdmittedRatingPlace: results.larpValues[0],
-			MaxLastAdmittedRatingPlace: results.larpValues[len(results.larpValues)-1],
-			AvgLastAdmittedRatingPlace: results.larpSum / len(results.larpValues),
-			MedLastAdmittedRatingPlace: median(results.larpValues),
-			DrainedPercent:             d.drainPercent,
-			RegularsAdmitted:           results.regularsAdmittedCount > 0,
-		})
+		if stdErr > tolerance {
+			return false
+		}
 	}
+	return true
+}
 
-	return drained
+// percentile returns the p-th percentile of data by the nearest-rank method; data is expected to be sorted.
+func percentile(data []int, p int) int {
+	if len(data) == 0 {
+		return 0
+	}
+	rank := (p*len(data) + 99) / 100 // ceil(p/100 * n)
+	return data[max(rank, 1)-1]
+}
+
+// average returns the integer mean of data, or 0 if it's empty.
+func average(data []int) int {
+	if len(data) == 0 {
+		return 0
+	}
+	return sum(data) / len(data)
 }
 
 func median(data []int) int {
@@ -157,3 +319,63 @@ func median(data []int) int {
 	}
 	return (data[n/2-1] + data[n/2]) / 2
 }
+
+// subQuotaResults accumulates the outcome of a single detailed target quota across the drain iterations.
+type subQuotaResults struct {
+	capacity    int
+	admittedSum int
+	psValues    []int // Only iterations where somebody was admitted through the sub-quota
+	larpValues  []int
+}
+
+func (r *subQuotaResults) add(sq core.SubQuotaResult) {
+	r.admittedSum += sq.Admitted
+	if sq.Admitted > 0 {
+		r.psValues = append(r.psValues, sq.PassingScore)
+		r.larpValues = append(r.larpValues, sq.LastAdmittedRatingPlace)
+	}
+}
+
+// aggregateSubQuotas turns the accumulated sub-quota outcomes into results sorted by sub-quota name.
+func aggregateSubQuotas(subQuotas map[string]*subQuotaResults, iterations int) []SubQuotaDrainedResult {
+	if len(subQuotas) == 0 {
+		return nil
+	}
+
+	aggregated := make([]SubQuotaDrainedResult, 0, len(subQuotas))
+	for name, r := range subQuotas {
+		result := SubQuotaDrainedResult{
+			Name:        name,
+			Capacity:    r.capacity,
+			AvgAdmitted: r.admittedSum / iterations,
+		}
+
+		if len(r.psValues) > 0 {
+			sort.Ints(r.psValues)
+			sort.Ints(r.larpValues)
+
+			result.MinPassingScore = r.psValues[0]
+			result.MaxPassingScore = r.psValues[len(r.psValues)-1]
+			result.AvgPassingScore = sum(r.psValues) / len(r.psValues)
+			result.MinLastAdmittedRatingPlace = r.larpValues[0]
+			result.MaxLastAdmittedRatingPlace = r.larpValues[len(r.larpValues)-1]
+			result.AvgLastAdmittedRatingPlace = sum(r.larpValues) / len(r.larpValues)
+		}
+
+		aggregated = append(aggregated, result)
+	}
+
+	sort.Slice(aggregated, func(i, j int) bool {
+		return aggregated[i].Name < aggregated[j].Name
+	})
+
+	return aggregated
+}
+
+func sum(data []int) int {
+	total := 0
+	for _, v := range data {
+		total += v
+	}
+	return total
+}
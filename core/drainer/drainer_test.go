@@ -0,0 +1,250 @@
+package drainer
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/source"
+)
+
+// testVarsity returns a loaded varsity with a single heading of 10 places and 60 applicants ranked by score.
+func testVarsity() *source.Varsity {
+	vc := core.NewVarsityCalculator("TEST_VARSITY", "Test Varsity")
+	vc.AddHeading("H1", core.Capacities{Regular: 10}, "Heading 1")
+	for i := 1; i <= 60; i++ {
+		vc.AddApplication("H1", fmt.Sprint(i), i, 1, core.CompetitionRegular, 300-i)
+	}
+	vc.NormalizeApplications()
+
+	return &source.Varsity{VarsityCalculator: vc}
+}
+
+func TestPercentile(t *testing.T) {
+	data := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+
+	assert.Equal(t, 1, percentile(data, 10))
+	assert.Equal(t, 3, percentile(data, 25))
+	assert.Equal(t, 8, percentile(data, 75))
+	assert.Equal(t, 9, percentile(data, 90))
+	assert.Equal(t, 7, percentile([]int{7}, 10))
+	assert.Equal(t, 0, percentile(nil, 50))
+}
+
+func TestNewHistogram(t *testing.T) {
+	assert.Equal(t, Histogram{Start: 250, Width: 1, Counts: []int{2, 0, 1, 1}}, newHistogram([]int{250, 250, 252, 253}))
+
+	// 100 distinct values don't fit into maxHistogramBins one-value bins
+	wide := make([]int, 100)
+	for i := range wide {
+		wide[i] = 101 + i
+	}
+	h := newHistogram(wide)
+	assert.Equal(t, 101, h.Start)
+	assert.Equal(t, 4, h.Width)
+	assert.Len(t, h.Counts, 25)
+	assert.LessOrEqual(t, len(h.Counts), maxHistogramBins)
+
+	assert.Equal(t, Histogram{}, newHistogram(nil))
+}
+
+func TestRunAdaptive(t *testing.T) {
+	v := testVarsity()
+
+	fixed := New(v, 50, 42, nil).Run(20)
+	if assert.Len(t, fixed, 1) {
+		r := fixed[0]
+		assert.Equal(t, 20, r.Iterations)
+		assert.LessOrEqual(t, r.MinPassingScore, r.P10PassingScore)
+		assert.LessOrEqual(t, r.P10PassingScore, r.P25PassingScore)
+		assert.LessOrEqual(t, r.P25PassingScore, r.MedPassingScore)
+		assert.LessOrEqual(t, r.MedPassingScore, r.P75PassingScore)
+		assert.LessOrEqual(t, r.P75PassingScore, r.P90PassingScore)
+		assert.LessOrEqual(t, r.P90PassingScore, r.MaxPassingScore)
+
+		total := 0
+		for _, count := range r.PassingScoreHistogram.Counts {
+			total += count
+		}
+		assert.Equal(t, 20, total)
+		assert.Equal(t, r.MinPassingScore, r.PassingScoreHistogram.Start)
+	}
+
+	// A loose tolerance is met right after the first batch, an impossible one runs the whole budget
+	loose := New(v, 50, 42, nil).RunAdaptive(20, 200, 100)
+	strict := New(v, 50, 42, nil).RunAdaptive(20, 200, 0.0001)
+	if assert.Len(t, loose, 1) && assert.Len(t, strict, 1) {
+		assert.Equal(t, 20, loose[0].Iterations)
+		assert.Equal(t, 200, strict[0].Iterations)
+	}
+
+	// The iterations needed depend on the seed and the data only
+	a := New(v, 50, 42, nil).RunAdaptive(10, 500, 1)
+	b := New(v, 50, 42, nil).RunAdaptive(10, 500, 1)
+	if assert.Len(t, a, 1) && assert.Len(t, b, 1) {
+		assert.Equal(t, a[0].Iterations, b[0].Iterations)
+		assert.Equal(t, a[0].AvgPassingScore, b[0].AvgPassingScore)
+	}
+}
+
+func TestConverged_IgnoresNoRegularsSentinel(t *testing.T) {
+	// Regulars got in with a steady passing score; the iterations without them would make the error huge
+	steady := &headingResults{
+		psValues:        []int{250, noRegularsPassingScore, 251, noRegularsPassingScore, 250},
+		regularPsValues: []int{250, 251, 250},
+	}
+	assert.True(t, converged(map[string]*headingResults{"H1": steady}, 1))
+
+	// A heading that never admits regulars has nothing to estimate
+	never := &headingResults{psValues: []int{noRegularsPassingScore, noRegularsPassingScore}}
+	assert.True(t, converged(map[string]*headingResults{"H1": never}, 0.0001))
+
+	scattered := &headingResults{regularPsValues: []int{200, 260, 230}}
+	assert.False(t, converged(map[string]*headingResults{"H1": steady, "H2": scattered}, 1))
+}
+
+func TestNewAdmissionChanceDTOs(t *testing.T) {
+	vc := core.NewVarsityCalculator("TEST_VARSITY", "Test Varsity")
+	vc.AddHeading("H1", core.Capacities{Regular: 1}, "Heading 1")
+	vc.AddHeading("H2", core.Capacities{Regular: 1}, "Heading 2")
+
+	dtos := NewAdmissionChanceDTOs([]DrainedResult{
+		{Heading: vc.GetHeading("H2"), DrainedPercent: 50, Iterations: 20, AdmittedCounts: map[string]int{"7": 20}},
+		{Heading: vc.GetHeading("H1"), DrainedPercent: 50, Iterations: 20, AdmittedCounts: map[string]int{"9": 5, "10": 15}},
+		// Results that ran no iterations have no chances to report
+		{Heading: vc.GetHeading("H1"), DrainedPercent: 90, AdmittedCounts: map[string]int{"9": 1}},
+	})
+
+	assert.Equal(t, []core.AdmissionChanceDTO{
+		{HeadingCode: "TEST_VARSITY:H1", StudentID: "10", DrainedPercent: 50, AdmittedCount: 15, Iterations: 20},
+		{HeadingCode: "TEST_VARSITY:H1", StudentID: "9", DrainedPercent: 50, AdmittedCount: 5, Iterations: 20},
+		{HeadingCode: "TEST_VARSITY:H2", StudentID: "7", DrainedPercent: 50, AdmittedCount: 20, Iterations: 20},
+	}, dtos)
+}
+
+func TestNewAdmissionChanceDTOs_FromRun(t *testing.T) {
+	results := New(testVarsity(), 50, 42, nil).Run(20)
+	dtos := NewAdmissionChanceDTOs(results)
+
+	// Every iteration fills all 10 places, so the counts of all students add up to 10 per iteration
+	admitted := 0
+	for _, dto := range dtos {
+		assert.Equal(t, 50, dto.DrainedPercent)
+		assert.Equal(t, 20, dto.Iterations)
+		assert.Greater(t, dto.AdmittedCount, 0)
+		assert.LessOrEqual(t, dto.AdmittedCount, dto.Iterations)
+		admitted += dto.AdmittedCount
+	}
+	assert.Equal(t, 10*20, admitted)
+}
+
+// modelVarsity returns a varsity with a single heading and one application per given student score.
+func modelVarsity(code string, ids []string, scores []int) *source.Varsity {
+	vc := core.NewVarsityCalculator(code, code)
+	vc.AddHeading("H1", core.Capacities{Regular: 10}, "Heading 1")
+	for i, id := range ids {
+		vc.AddApplication("H1", id, i+1, 1, core.CompetitionRegular, scores[i])
+	}
+	vc.NormalizeApplications()
+
+	return &source.Varsity{VarsityDefinition: &source.VarsityDefinition{Code: code}, VarsityCalculator: vc}
+}
+
+// weightRecorder is a drain target that only keeps the weight function it is drained with.
+type weightRecorder struct {
+	weight func(*core.Student) float64
+}
+
+func (r *weightRecorder) SimulateOriginalsDrain(int, int64) {}
+
+func (r *weightRecorder) SimulateWeightedOriginalsDrain(_ int, _ int64, weight func(*core.Student) float64) {
+	r.weight = weight
+}
+
+func TestScoreWeightedModel_DefaultPower(t *testing.T) {
+	v := modelVarsity("A", []string{"1", "2"}, []int{299, 99})
+	strong, weak := v.GetStudent("1"), v.GetStudent("2")
+
+	var rec weightRecorder
+	ScoreWeightedModel{}.Drain(&rec, 50, 1)
+	assert.InDelta(t, 300.0*300.0, rec.weight(strong), 1e-6)
+	assert.InDelta(t, 100.0*100.0, rec.weight(weak), 1e-6)
+
+	ScoreWeightedModel{Power: 1}.Drain(&rec, 50, 1)
+	assert.InDelta(t, 300.0, rec.weight(strong), 1e-6)
+}
+
+func TestNewOtherVarsitiesModel(t *testing.T) {
+	a := modelVarsity("A", []string{"1", "2", "3"}, []int{250, 240, 230})
+	b := modelVarsity("B", []string{"1", "2"}, []int{250, 240})
+	c := modelVarsity("C", []string{"1"}, []int{250})
+	noCalculator := &source.Varsity{VarsityDefinition: &source.VarsityDefinition{Code: "D"}}
+
+	m := NewOtherVarsitiesModel(a, []*source.Varsity{a, b, c, noCalculator})
+
+	id := func(studentID string) string { return a.GetStudent(studentID).ID() }
+	assert.Equal(t, map[string]int{id("1"): 2, id("2"): 1}, m.otherVarsities)
+
+	var rec weightRecorder
+	m.Drain(&rec, 50, 1)
+	assert.Equal(t, 3.0, rec.weight(a.GetStudent("1")))
+	assert.Equal(t, 1.0, rec.weight(a.GetStudent("3")))
+}
+
+func TestNewCalibratedModel(t *testing.T) {
+	ids := []string{"1", "2", "3", "4", "5", "6", "7", "8"}
+	previous := modelVarsity("A", ids, []int{275, 272, 255, 254, 253, 251, 250, 250})
+	// submitted originals and quit students aren't drainable, so they don't count
+	previous.SetOriginalSubmitted("7")
+	previous.SetQuit("8")
+
+	// "1" and "2" quit or disappeared from band 27, "3" from band 25
+	current := modelVarsity("A", []string{"2", "3", "4", "5", "6"}, []int{272, 255, 254, 253, 251})
+	current.SetQuit("2")
+	current.SetQuit("3")
+
+	m := NewCalibratedModel(previous, current)
+
+	assert.Equal(t, map[int]float64{
+		27: float64(2+1) / float64(2+2),
+		25: float64(1+1) / float64(4+2),
+	}, m.bandRates)
+	assert.Equal(t, float64(3+1)/float64(6+2), m.overallRate)
+
+	var rec weightRecorder
+	m.Drain(&rec, 50, 1)
+	assert.Equal(t, m.bandRates[25], rec.weight(current.GetStudent("4")))
+
+	// a band unseen in the previous run falls back to the overall rate
+	other := modelVarsity("B", []string{"9"}, []int{120})
+	assert.Equal(t, m.overallRate, rec.weight(other.GetStudent("9")))
+}
+
+func TestNewModel(t *testing.T) {
+	v := modelVarsity("A", []string{"1"}, []int{250})
+	previous := modelVarsity("A", []string{"1"}, []int{250})
+
+	for name, want := range map[string]string{
+		"":                  ModelUniform,
+		ModelUniform:        ModelUniform,
+		ModelScoreWeighted:  ModelScoreWeighted,
+		ModelOtherVarsities: ModelOtherVarsities,
+		ModelCalibrated:     ModelCalibrated,
+	} {
+		m, err := NewModel(name, v, []*source.Varsity{v}, previous)
+		if assert.NoError(t, err, name) {
+			assert.Equal(t, want, m.Name(), name)
+		}
+	}
+
+	_, err := NewModel(ModelCalibrated, v, nil, nil)
+	assert.ErrorContains(t, err, "requires the previous run's data")
+
+	_, err = NewModel(ModelCalibrated, v, nil, &source.Varsity{VarsityDefinition: v.VarsityDefinition})
+	assert.ErrorContains(t, err, "requires the previous run's data")
+
+	_, err = NewModel("optimistic", v, nil, previous)
+	assert.ErrorContains(t, err, `unknown drain model "optimistic"`)
+}
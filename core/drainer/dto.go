@@ -1,6 +1,10 @@
 package drainer
 
-import "github.com/trueegorletov/analabit/core"
+import (
+	"sort"
+
+	"github.com/trueegorletov/analabit/core"
+)
 
 // NewDrainedResultDTOs converts a slice of DrainedResult to a slice of DrainedResultDTO.
 func NewDrainedResultDTOs(results []DrainedResult) []core.DrainedResultDTO {
@@ -9,6 +13,8 @@ func NewDrainedResultDTOs(results []DrainedResult) []core.DrainedResultDTO {
 		dtos = append(dtos, core.DrainedResultDTO{
 			HeadingCode:                result.Heading.FullCode(),
 			DrainedPercent:             result.DrainedPercent,
+			Seed:                       result.Seed,
+			DrainModel:                 result.Model,
 			AvgPassingScore:            result.AvgPassingScore,
 			MinPassingScore:            result.MinPassingScore,
 			MaxPassingScore:            result.MaxPassingScore,
@@ -17,8 +23,90 @@ func NewDrainedResultDTOs(results []DrainedResult) []core.DrainedResultDTO {
 			MinLastAdmittedRatingPlace: result.MinLastAdmittedRatingPlace,
 			MaxLastAdmittedRatingPlace: result.MaxLastAdmittedRatingPlace,
 			MedLastAdmittedRatingPlace: result.MedLastAdmittedRatingPlace,
+			P10PassingScore:            result.P10PassingScore,
+			P25PassingScore:            result.P25PassingScore,
+			P75PassingScore:            result.P75PassingScore,
+			P90PassingScore:            result.P90PassingScore,
+			P10LastAdmittedRatingPlace: result.P10LastAdmittedRatingPlace,
+			P25LastAdmittedRatingPlace: result.P25LastAdmittedRatingPlace,
+			P75LastAdmittedRatingPlace: result.P75LastAdmittedRatingPlace,
+			P90LastAdmittedRatingPlace: result.P90LastAdmittedRatingPlace,
+			Iterations:                 result.Iterations,
 			RegularsAdmitted:           result.RegularsAdmitted,
+			AvgPriorityStageAdmitted:   result.AvgPriorityStageAdmitted,
+			AvgMainStageAdmitted:       result.AvgMainStageAdmitted,
+			SubQuotas:                  newSubQuotaResultDTOs(result.SubQuotas),
+
+			AvgPaidPassingScore:            result.AvgPaidPassingScore,
+			AvgPaidLastAdmittedRatingPlace: result.AvgPaidLastAdmittedRatingPlace,
+
+			PassingScoreHistogram:            newHistogramDTO(result.PassingScoreHistogram),
+			LastAdmittedRatingPlaceHistogram: newHistogramDTO(result.LastAdmittedRatingPlaceHistogram),
 		})
 	}
 	return dtos
 }
+
+// newSubQuotaResultDTOs converts the sub-quota results of a single drained result to DTOs.
+func newSubQuotaResultDTOs(results []SubQuotaDrainedResult) []core.SubQuotaResultDTO {
+	if len(results) == 0 {
+		return nil
+	}
+
+	dtos := make([]core.SubQuotaResultDTO, 0, len(results))
+	for _, r := range results {
+		dtos = append(dtos, core.SubQuotaResultDTO{
+			Name:                       r.Name,
+			Capacity:                   r.Capacity,
+			AvgAdmitted:                r.AvgAdmitted,
+			AvgPassingScore:            r.AvgPassingScore,
+			MinPassingScore:            r.MinPassingScore,
+			MaxPassingScore:            r.MaxPassingScore,
+			AvgLastAdmittedRatingPlace: r.AvgLastAdmittedRatingPlace,
+			MinLastAdmittedRatingPlace: r.MinLastAdmittedRatingPlace,
+			MaxLastAdmittedRatingPlace: r.MaxLastAdmittedRatingPlace,
+		})
+	}
+	return dtos
+}
+
+// newHistogramDTO converts a histogram to a DTO, or returns nil for an empty one.
+func newHistogramDTO(h Histogram) *core.HistogramDTO {
+	if len(h.Counts) == 0 {
+		return nil
+	}
+	return &core.HistogramDTO{Start: h.Start, Width: h.Width, Counts: h.Counts}
+}
+
+// NewAdmissionChanceDTOs flattens the per-student admission counts of the given results into
+// a slice of AdmissionChanceDTO, ordered by heading code and then by student ID.
+func NewAdmissionChanceDTOs(results []DrainedResult) []core.AdmissionChanceDTO {
+	var dtos []core.AdmissionChanceDTO
+	for _, result := range results {
+		if result.Iterations == 0 {
+			continue
+		}
+
+		studentIDs := make([]string, 0, len(result.AdmittedCounts))
+		for studentID := range result.AdmittedCounts {
+			studentIDs = append(studentIDs, studentID)
+		}
+		sort.Strings(studentIDs)
+
+		for _, studentID := range studentIDs {
+			dtos = append(dtos, core.AdmissionChanceDTO{
+				HeadingCode:    result.Heading.FullCode(),
+				StudentID:      studentID,
+				DrainedPercent: result.DrainedPercent,
+				AdmittedCount:  result.AdmittedCounts[studentID],
+				Iterations:     result.Iterations,
+			})
+		}
+	}
+
+	sort.SliceStable(dtos, func(i, j int) bool {
+		return dtos[i].HeadingCode < dtos[j].HeadingCode
+	})
+
+	return dtos
+}
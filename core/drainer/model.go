@@ -0,0 +1,166 @@
+package drainer
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/source"
+)
+
+// Names of the built-in drain models, as accepted by NewModel.
+const (
+	ModelUniform        = "uniform"
+	ModelScoreWeighted  = "score_weighted"
+	ModelOtherVarsities = "other_varsities"
+	ModelCalibrated     = "calibrated"
+)
+
+// DrainModel decides which students without originals quit the varsity at a drain iteration.
+type DrainModel interface {
+	// Name identifies the model in the recorded results.
+	Name() string
+	// Drain makes drainPercent% of the target's drainable students quit. The choice must be fully
+	// determined by seed and the target's state.
+	Drain(target core.DrainTarget, drainPercent int, seed int64)
+}
+
+// UniformModel drains students uniformly at random.
+type UniformModel struct{}
+
+func (UniformModel) Name() string { return ModelUniform }
+
+func (UniformModel) Drain(target core.DrainTarget, drainPercent int, seed int64) {
+	target.SimulateOriginalsDrain(drainPercent, seed)
+}
+
+// ScoreWeightedModel drains students with higher scores more often: the chance of a student is
+// proportional to their best score raised to Power. Strong applicants have more options elsewhere.
+type ScoreWeightedModel struct {
+	Power float64
+}
+
+func (m ScoreWeightedModel) Name() string { return ModelScoreWeighted }
+
+func (m ScoreWeightedModel) Drain(target core.DrainTarget, drainPercent int, seed int64) {
+	power := m.Power
+	if power == 0 {
+		power = 2
+	}
+
+	target.SimulateWeightedOriginalsDrain(drainPercent, seed, func(s *core.Student) float64 {
+		return math.Pow(float64(bestScore(s)+1), power)
+	})
+}
+
+// OtherVarsitiesModel drains students applying to many other varsities more often: the chance of a student
+// is proportional to 1 + the number of other loaded varsities they applied to.
+type OtherVarsitiesModel struct {
+	otherVarsities map[string]int // student ID -> number of other varsities
+}
+
+// NewOtherVarsitiesModel counts, for every student of varsity, how many other of the loaded varsities they applied to.
+func NewOtherVarsitiesModel(varsity *source.Varsity, loaded []*source.Varsity) *OtherVarsitiesModel {
+	m := &OtherVarsitiesModel{otherVarsities: make(map[string]int)}
+	for _, other := range loaded {
+		if other.Code == varsity.Code || other.VarsityCalculator == nil {
+			continue
+		}
+		for _, s := range other.VarsityCalculator.Students() {
+			m.otherVarsities[s.ID()]++
+		}
+	}
+	return m
+}
+
+func (m *OtherVarsitiesModel) Name() string { return ModelOtherVarsities }
+
+func (m *OtherVarsitiesModel) Drain(target core.DrainTarget, drainPercent int, seed int64) {
+	target.SimulateWeightedOriginalsDrain(drainPercent, seed, func(s *core.Student) float64 {
+		return float64(1 + m.otherVarsities[s.ID()])
+	})
+}
+
+// calibrationBandWidth is the width of the score bands the calibrated model estimates quit rates for.
+const calibrationBandWidth = 10
+
+// CalibratedModel drains students with the quit rates observed between the previous run and the current one:
+// students without originals are grouped into score bands, and the chance of a student is proportional to the
+// share of the band's students from the previous run who have quit (or disappeared) since then.
+type CalibratedModel struct {
+	bandRates   map[int]float64
+	overallRate float64
+}
+
+// NewCalibratedModel estimates the quit rates from the previous run's state of the varsity and its current state.
+func NewCalibratedModel(previous, current *source.Varsity) *CalibratedModel {
+	totals := make(map[int]int)
+	quits := make(map[int]int)
+	total, quit := 0, 0
+
+	for _, s := range previous.VarsityCalculator.Students() {
+		if s.Quit() || s.OriginalSubmitted() {
+			continue
+		}
+
+		band := bestScore(s) / calibrationBandWidth
+		totals[band]++
+		total++
+
+		if now := current.VarsityCalculator.GetStudent(s.ID()); now == nil || now.Quit() {
+			quits[band]++
+			quit++
+		}
+	}
+
+	// Laplace smoothing keeps sparse bands from getting a zero or certain quit rate
+	m := &CalibratedModel{
+		bandRates:   make(map[int]float64, len(totals)),
+		overallRate: float64(quit+1) / float64(total+2),
+	}
+	for band, n := range totals {
+		m.bandRates[band] = float64(quits[band]+1) / float64(n+2)
+	}
+	return m
+}
+
+func (m *CalibratedModel) Name() string { return ModelCalibrated }
+
+func (m *CalibratedModel) Drain(target core.DrainTarget, drainPercent int, seed int64) {
+	target.SimulateWeightedOriginalsDrain(drainPercent, seed, func(s *core.Student) float64 {
+		if rate, ok := m.bandRates[bestScore(s)/calibrationBandWidth]; ok {
+			return rate
+		}
+		return m.overallRate
+	})
+}
+
+// NewModel creates the built-in drain model with the given name for varsity. The loaded varsities are used by
+// the other-varsities model, and previous (the same varsity as loaded by the previous run) by the calibrated one.
+// An empty name selects the uniform model.
+func NewModel(name string, varsity *source.Varsity, loaded []*source.Varsity, previous *source.Varsity) (DrainModel, error) {
+	switch name {
+	case "", ModelUniform:
+		return UniformModel{}, nil
+	case ModelScoreWeighted:
+		return ScoreWeightedModel{}, nil
+	case ModelOtherVarsities:
+		return NewOtherVarsitiesModel(varsity, loaded), nil
+	case ModelCalibrated:
+		if previous == nil || previous.VarsityCalculator == nil {
+			return nil, fmt.Errorf("calibrated drain model for varsity %s requires the previous run's data", varsity.Code)
+		}
+		return NewCalibratedModel(previous, varsity), nil
+	default:
+		return nil, fmt.Errorf("unknown drain model %q", name)
+	}
+}
+
+// bestScore returns the highest score among the student's applications.
+func bestScore(s *core.Student) int {
+	best := 0
+	for _, app := range s.Applications() {
+		best = max(best, app.Score())
+	}
+	return best
+}
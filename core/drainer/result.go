@@ -15,8 +15,94 @@ type DrainedResult struct {
 	AvgLastAdmittedRatingPlace int
 	MedLastAdmittedRatingPlace int
 
+	// Percentile bands of the passing score and the last admitted rating place over the iterations
+	P10PassingScore int
+	P25PassingScore int
+	P75PassingScore int
+	P90PassingScore int
+
+	P10LastAdmittedRatingPlace int
+	P25LastAdmittedRatingPlace int
+	P75LastAdmittedRatingPlace int
+	P90LastAdmittedRatingPlace int
+
+	// Distributions of the passing score and the last admitted rating place over the iterations
+	PassingScoreHistogram            Histogram
+	LastAdmittedRatingPlaceHistogram Histogram
+
 	DrainedPercent int
+	// Seed is the drainer seed the iterations of this result were derived from
+	Seed int64
+	// Model is the name of the drain model the iterations were simulated with
+	Model string
 
 	RegularsAdmitted bool
-	IsVirtual bool
+	IsVirtual        bool
+
+	// Iterations is the number of drain iterations the result was aggregated from; with adaptive runs
+	// it's the number actually needed for the passing score to converge
+	Iterations int
+	// Average numbers of students admitted at the priority and the main stage per iteration;
+	// both are 0 unless the varsity uses staged enrollment
+	AvgPriorityStageAdmitted int
+	AvgMainStageAdmitted     int
+
+	// AdmittedCounts maps a student ID to the number of iterations the student was admitted to the heading in
+	AdmittedCounts map[string]int
+
+	// SubQuotas holds the passing data of the heading's detailed target quotas, sorted by name
+	SubQuotas []SubQuotaDrainedResult
+
+	// Average passing score and last admitted rating place of the heading's paid places over the iterations
+	// where somebody was admitted to them; both are 0 if nobody ever was
+	AvgPaidPassingScore            int
+	AvgPaidLastAdmittedRatingPlace int
+}
+
+// SubQuotaDrainedResult aggregates the outcome of a single detailed target quota across the drain iterations.
+// The passing score and rating place statistics only count iterations where somebody was admitted through it.
+type SubQuotaDrainedResult struct {
+	Name     string
+	Capacity int
+
+	AvgAdmitted int
+
+	MinPassingScore int
+	MaxPassingScore int
+	AvgPassingScore int
+
+	MinLastAdmittedRatingPlace int
+	MaxLastAdmittedRatingPlace int
+	AvgLastAdmittedRatingPlace int
+}
+
+// Histogram is a compact histogram of integer values: Counts[i] values fell into [Start + i*Width, Start + (i+1)*Width).
+type Histogram struct {
+	Start  int
+	Width  int
+	Counts []int
+}
+
+// maxHistogramBins bounds the size of a histogram: wider value ranges get wider bins.
+const maxHistogramBins = 32
+
+// newHistogram builds the histogram of data, which is expected to be sorted. Every bin is one value wide
+// unless the values span more than maxHistogramBins.
+func newHistogram(data []int) Histogram {
+	if len(data) == 0 {
+		return Histogram{}
+	}
+
+	start, end := data[0], data[len(data)-1]
+	width := max((end-start+maxHistogramBins)/maxHistogramBins, 1) // ceil((end-start+1) / maxHistogramBins)
+
+	h := Histogram{
+		Start:  start,
+		Width:  width,
+		Counts: make([]int, (end-start)/width+1),
+	}
+	for _, v := range data {
+		h.Counts[(v-start)/width]++
+	}
+	return h
 }
@@ -0,0 +1,32 @@
+package drainer
+
+import (
+	"hash/fnv"
+	"strconv"
+)
+
+// DeriveSeed derives the seed of a single drainer (one varsity at one drain stage) from the master seed of a run.
+// Every varsity and stage gets its own stream of randomness, while the whole run stays reproducible from one number.
+func DeriveSeed(masterSeed int64, varsityCode string, drainPercent int) int64 {
+	h := fnv.New64a()
+	h.Write([]byte(strconv.FormatInt(masterSeed, 10)))
+	h.Write([]byte{0})
+	h.Write([]byte(varsityCode))
+	h.Write([]byte{0})
+	h.Write([]byte(strconv.Itoa(drainPercent)))
+
+	return int64(splitmix64(h.Sum64()))
+}
+
+// IterationSeed derives the seed of the given drain iteration from the drainer's seed.
+func IterationSeed(seed int64, iteration int) int64 {
+	return int64(splitmix64(uint64(seed) + uint64(iteration)*0x9e3779b97f4a7c15))
+}
+
+// splitmix64 is the finalizer of the SplitMix64 generator; it spreads close inputs far apart.
+func splitmix64(x uint64) uint64 {
+	x += 0x9e3779b97f4a7c15
+	x = (x ^ (x >> 30)) * 0xbf58476d1ce4e5b9
+	x = (x ^ (x >> 27)) * 0x94d049bb133111eb
+	return x ^ (x >> 31)
+}
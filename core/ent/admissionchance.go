@@ -0,0 +1,218 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/trueegorletov/analabit/core/ent/admissionchance"
+	"github.com/trueegorletov/analabit/core/ent/heading"
+	"github.com/trueegorletov/analabit/core/ent/run"
+)
+
+// AdmissionChance is the model entity for the AdmissionChance schema.
+type AdmissionChance struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// StudentID holds the value of the "student_id" field.
+	StudentID string `json:"student_id,omitempty"`
+	// DrainedPercent holds the value of the "drained_percent" field.
+	DrainedPercent int `json:"drained_percent,omitempty"`
+	// AdmittedCount holds the value of the "admitted_count" field.
+	AdmittedCount int `json:"admitted_count,omitempty"`
+	// Iterations holds the value of the "iterations" field.
+	Iterations int `json:"iterations,omitempty"`
+	// Probability holds the value of the "probability" field.
+	Probability float64 `json:"probability,omitempty"`
+	// RunID holds the value of the "run_id" field.
+	RunID int `json:"run_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AdmissionChanceQuery when eager-loading is set.
+	Edges                     AdmissionChanceEdges `json:"edges"`
+	heading_admission_chances *int
+	selectValues              sql.SelectValues
+}
+
+// AdmissionChanceEdges holds the relations/edges for other nodes in the graph.
+type AdmissionChanceEdges struct {
+	// Heading holds the value of the heading edge.
+	Heading *Heading `json:"heading,omitempty"`
+	// Run holds the value of the run edge.
+	Run *Run `json:"run,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// HeadingOrErr returns the Heading value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AdmissionChanceEdges) HeadingOrErr() (*Heading, error) {
+	if e.Heading != nil {
+		return e.Heading, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: heading.Label}
+	}
+	return nil, &NotLoadedError{edge: "heading"}
+}
+
+// RunOrErr returns the Run value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AdmissionChanceEdges) RunOrErr() (*Run, error) {
+	if e.Run != nil {
+		return e.Run, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: run.Label}
+	}
+	return nil, &NotLoadedError{edge: "run"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AdmissionChance) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case admissionchance.FieldProbability:
+			values[i] = new(sql.NullFloat64)
+		case admissionchance.FieldID, admissionchance.FieldDrainedPercent, admissionchance.FieldAdmittedCount, admissionchance.FieldIterations, admissionchance.FieldRunID:
+			values[i] = new(sql.NullInt64)
+		case admissionchance.FieldStudentID:
+			values[i] = new(sql.NullString)
+		case admissionchance.ForeignKeys[0]: // heading_admission_chances
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AdmissionChance fields.
+func (ac *AdmissionChance) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case admissionchance.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ac.ID = int(value.Int64)
+		case admissionchance.FieldStudentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field student_id", values[i])
+			} else if value.Valid {
+				ac.StudentID = value.String
+			}
+		case admissionchance.FieldDrainedPercent:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field drained_percent", values[i])
+			} else if value.Valid {
+				ac.DrainedPercent = int(value.Int64)
+			}
+		case admissionchance.FieldAdmittedCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field admitted_count", values[i])
+			} else if value.Valid {
+				ac.AdmittedCount = int(value.Int64)
+			}
+		case admissionchance.FieldIterations:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field iterations", values[i])
+			} else if value.Valid {
+				ac.Iterations = int(value.Int64)
+			}
+		case admissionchance.FieldProbability:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field probability", values[i])
+			} else if value.Valid {
+				ac.Probability = value.Float64
+			}
+		case admissionchance.FieldRunID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field run_id", values[i])
+			} else if value.Valid {
+				ac.RunID = int(value.Int64)
+			}
+		case admissionchance.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field heading_admission_chances", value)
+			} else if value.Valid {
+				ac.heading_admission_chances = new(int)
+				*ac.heading_admission_chances = int(value.Int64)
+			}
+		default:
+			ac.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AdmissionChance.
+// This includes values selected through modifiers, order, etc.
+func (ac *AdmissionChance) Value(name string) (ent.Value, error) {
+	return ac.selectValues.Get(name)
+}
+
+// QueryHeading queries the "heading" edge of the AdmissionChance entity.
+func (ac *AdmissionChance) QueryHeading() *HeadingQuery {
+	return NewAdmissionChanceClient(ac.config).QueryHeading(ac)
+}
+
+// QueryRun queries the "run" edge of the AdmissionChance entity.
+func (ac *AdmissionChance) QueryRun() *RunQuery {
+	return NewAdmissionChanceClient(ac.config).QueryRun(ac)
+}
+
+// Update returns a builder for updating this AdmissionChance.
+// Note that you need to call AdmissionChance.Unwrap() before calling this method if this AdmissionChance
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ac *AdmissionChance) Update() *AdmissionChanceUpdateOne {
+	return NewAdmissionChanceClient(ac.config).UpdateOne(ac)
+}
+
+// Unwrap unwraps the AdmissionChance entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ac *AdmissionChance) Unwrap() *AdmissionChance {
+	_tx, ok := ac.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AdmissionChance is not a transactional entity")
+	}
+	ac.config.driver = _tx.drv
+	return ac
+}
+
+// String implements the fmt.Stringer.
+func (ac *AdmissionChance) String() string {
+	var builder strings.Builder
+	builder.WriteString("AdmissionChance(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ac.ID))
+	builder.WriteString("student_id=")
+	builder.WriteString(ac.StudentID)
+	builder.WriteString(", ")
+	builder.WriteString("drained_percent=")
+	builder.WriteString(fmt.Sprintf("%v", ac.DrainedPercent))
+	builder.WriteString(", ")
+	builder.WriteString("admitted_count=")
+	builder.WriteString(fmt.Sprintf("%v", ac.AdmittedCount))
+	builder.WriteString(", ")
+	builder.WriteString("iterations=")
+	builder.WriteString(fmt.Sprintf("%v", ac.Iterations))
+	builder.WriteString(", ")
+	builder.WriteString("probability=")
+	builder.WriteString(fmt.Sprintf("%v", ac.Probability))
+	builder.WriteString(", ")
+	builder.WriteString("run_id=")
+	builder.WriteString(fmt.Sprintf("%v", ac.RunID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AdmissionChances is a parsable slice of AdmissionChance.
+type AdmissionChances []*AdmissionChance
@@ -0,0 +1,145 @@
+// Code generated by ent, DO NOT EDIT.
+
+package admissionchance
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the admissionchance type in the database.
+	Label = "admission_chance"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldStudentID holds the string denoting the student_id field in the database.
+	FieldStudentID = "student_id"
+	// FieldDrainedPercent holds the string denoting the drained_percent field in the database.
+	FieldDrainedPercent = "drained_percent"
+	// FieldAdmittedCount holds the string denoting the admitted_count field in the database.
+	FieldAdmittedCount = "admitted_count"
+	// FieldIterations holds the string denoting the iterations field in the database.
+	FieldIterations = "iterations"
+	// FieldProbability holds the string denoting the probability field in the database.
+	FieldProbability = "probability"
+	// FieldRunID holds the string denoting the run_id field in the database.
+	FieldRunID = "run_id"
+	// EdgeHeading holds the string denoting the heading edge name in mutations.
+	EdgeHeading = "heading"
+	// EdgeRun holds the string denoting the run edge name in mutations.
+	EdgeRun = "run"
+	// Table holds the table name of the admissionchance in the database.
+	Table = "admission_chances"
+	// HeadingTable is the table that holds the heading relation/edge.
+	HeadingTable = "admission_chances"
+	// HeadingInverseTable is the table name for the Heading entity.
+	// It exists in this package in order to avoid circular dependency with the "heading" package.
+	HeadingInverseTable = "headings"
+	// HeadingColumn is the table column denoting the heading relation/edge.
+	HeadingColumn = "heading_admission_chances"
+	// RunTable is the table that holds the run relation/edge.
+	RunTable = "admission_chances"
+	// RunInverseTable is the table name for the Run entity.
+	// It exists in this package in order to avoid circular dependency with the "run" package.
+	RunInverseTable = "runs"
+	// RunColumn is the table column denoting the run relation/edge.
+	RunColumn = "run_id"
+)
+
+// Columns holds all SQL columns for admissionchance fields.
+var Columns = []string{
+	FieldID,
+	FieldStudentID,
+	FieldDrainedPercent,
+	FieldAdmittedCount,
+	FieldIterations,
+	FieldProbability,
+	FieldRunID,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "admission_chances"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"heading_admission_chances",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// OrderOption defines the ordering options for the AdmissionChance queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByStudentID orders the results by the student_id field.
+func ByStudentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStudentID, opts...).ToFunc()
+}
+
+// ByDrainedPercent orders the results by the drained_percent field.
+func ByDrainedPercent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDrainedPercent, opts...).ToFunc()
+}
+
+// ByAdmittedCount orders the results by the admitted_count field.
+func ByAdmittedCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAdmittedCount, opts...).ToFunc()
+}
+
+// ByIterations orders the results by the iterations field.
+func ByIterations(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIterations, opts...).ToFunc()
+}
+
+// ByProbability orders the results by the probability field.
+func ByProbability(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProbability, opts...).ToFunc()
+}
+
+// ByRunID orders the results by the run_id field.
+func ByRunID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRunID, opts...).ToFunc()
+}
+
+// ByHeadingField orders the results by heading field.
+func ByHeadingField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newHeadingStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByRunField orders the results by run field.
+func ByRunField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRunStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newHeadingStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(HeadingInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, HeadingTable, HeadingColumn),
+	)
+}
+func newRunStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RunInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, RunTable, RunColumn),
+	)
+}
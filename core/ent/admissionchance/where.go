@@ -0,0 +1,390 @@
+// Code generated by ent, DO NOT EDIT.
+
+package admissionchance
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/trueegorletov/analabit/core/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldLTE(FieldID, id))
+}
+
+// StudentID applies equality check predicate on the "student_id" field. It's identical to StudentIDEQ.
+func StudentID(v string) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldEQ(FieldStudentID, v))
+}
+
+// DrainedPercent applies equality check predicate on the "drained_percent" field. It's identical to DrainedPercentEQ.
+func DrainedPercent(v int) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldEQ(FieldDrainedPercent, v))
+}
+
+// AdmittedCount applies equality check predicate on the "admitted_count" field. It's identical to AdmittedCountEQ.
+func AdmittedCount(v int) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldEQ(FieldAdmittedCount, v))
+}
+
+// Iterations applies equality check predicate on the "iterations" field. It's identical to IterationsEQ.
+func Iterations(v int) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldEQ(FieldIterations, v))
+}
+
+// Probability applies equality check predicate on the "probability" field. It's identical to ProbabilityEQ.
+func Probability(v float64) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldEQ(FieldProbability, v))
+}
+
+// RunID applies equality check predicate on the "run_id" field. It's identical to RunIDEQ.
+func RunID(v int) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldEQ(FieldRunID, v))
+}
+
+// StudentIDEQ applies the EQ predicate on the "student_id" field.
+func StudentIDEQ(v string) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldEQ(FieldStudentID, v))
+}
+
+// StudentIDNEQ applies the NEQ predicate on the "student_id" field.
+func StudentIDNEQ(v string) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldNEQ(FieldStudentID, v))
+}
+
+// StudentIDIn applies the In predicate on the "student_id" field.
+func StudentIDIn(vs ...string) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldIn(FieldStudentID, vs...))
+}
+
+// StudentIDNotIn applies the NotIn predicate on the "student_id" field.
+func StudentIDNotIn(vs ...string) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldNotIn(FieldStudentID, vs...))
+}
+
+// StudentIDGT applies the GT predicate on the "student_id" field.
+func StudentIDGT(v string) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldGT(FieldStudentID, v))
+}
+
+// StudentIDGTE applies the GTE predicate on the "student_id" field.
+func StudentIDGTE(v string) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldGTE(FieldStudentID, v))
+}
+
+// StudentIDLT applies the LT predicate on the "student_id" field.
+func StudentIDLT(v string) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldLT(FieldStudentID, v))
+}
+
+// StudentIDLTE applies the LTE predicate on the "student_id" field.
+func StudentIDLTE(v string) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldLTE(FieldStudentID, v))
+}
+
+// StudentIDContains applies the Contains predicate on the "student_id" field.
+func StudentIDContains(v string) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldContains(FieldStudentID, v))
+}
+
+// StudentIDHasPrefix applies the HasPrefix predicate on the "student_id" field.
+func StudentIDHasPrefix(v string) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldHasPrefix(FieldStudentID, v))
+}
+
+// StudentIDHasSuffix applies the HasSuffix predicate on the "student_id" field.
+func StudentIDHasSuffix(v string) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldHasSuffix(FieldStudentID, v))
+}
+
+// StudentIDEqualFold applies the EqualFold predicate on the "student_id" field.
+func StudentIDEqualFold(v string) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldEqualFold(FieldStudentID, v))
+}
+
+// StudentIDContainsFold applies the ContainsFold predicate on the "student_id" field.
+func StudentIDContainsFold(v string) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldContainsFold(FieldStudentID, v))
+}
+
+// DrainedPercentEQ applies the EQ predicate on the "drained_percent" field.
+func DrainedPercentEQ(v int) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldEQ(FieldDrainedPercent, v))
+}
+
+// DrainedPercentNEQ applies the NEQ predicate on the "drained_percent" field.
+func DrainedPercentNEQ(v int) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldNEQ(FieldDrainedPercent, v))
+}
+
+// DrainedPercentIn applies the In predicate on the "drained_percent" field.
+func DrainedPercentIn(vs ...int) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldIn(FieldDrainedPercent, vs...))
+}
+
+// DrainedPercentNotIn applies the NotIn predicate on the "drained_percent" field.
+func DrainedPercentNotIn(vs ...int) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldNotIn(FieldDrainedPercent, vs...))
+}
+
+// DrainedPercentGT applies the GT predicate on the "drained_percent" field.
+func DrainedPercentGT(v int) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldGT(FieldDrainedPercent, v))
+}
+
+// DrainedPercentGTE applies the GTE predicate on the "drained_percent" field.
+func DrainedPercentGTE(v int) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldGTE(FieldDrainedPercent, v))
+}
+
+// DrainedPercentLT applies the LT predicate on the "drained_percent" field.
+func DrainedPercentLT(v int) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldLT(FieldDrainedPercent, v))
+}
+
+// DrainedPercentLTE applies the LTE predicate on the "drained_percent" field.
+func DrainedPercentLTE(v int) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldLTE(FieldDrainedPercent, v))
+}
+
+// AdmittedCountEQ applies the EQ predicate on the "admitted_count" field.
+func AdmittedCountEQ(v int) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldEQ(FieldAdmittedCount, v))
+}
+
+// AdmittedCountNEQ applies the NEQ predicate on the "admitted_count" field.
+func AdmittedCountNEQ(v int) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldNEQ(FieldAdmittedCount, v))
+}
+
+// AdmittedCountIn applies the In predicate on the "admitted_count" field.
+func AdmittedCountIn(vs ...int) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldIn(FieldAdmittedCount, vs...))
+}
+
+// AdmittedCountNotIn applies the NotIn predicate on the "admitted_count" field.
+func AdmittedCountNotIn(vs ...int) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldNotIn(FieldAdmittedCount, vs...))
+}
+
+// AdmittedCountGT applies the GT predicate on the "admitted_count" field.
+func AdmittedCountGT(v int) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldGT(FieldAdmittedCount, v))
+}
+
+// AdmittedCountGTE applies the GTE predicate on the "admitted_count" field.
+func AdmittedCountGTE(v int) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldGTE(FieldAdmittedCount, v))
+}
+
+// AdmittedCountLT applies the LT predicate on the "admitted_count" field.
+func AdmittedCountLT(v int) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldLT(FieldAdmittedCount, v))
+}
+
+// AdmittedCountLTE applies the LTE predicate on the "admitted_count" field.
+func AdmittedCountLTE(v int) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldLTE(FieldAdmittedCount, v))
+}
+
+// IterationsEQ applies the EQ predicate on the "iterations" field.
+func IterationsEQ(v int) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldEQ(FieldIterations, v))
+}
+
+// IterationsNEQ applies the NEQ predicate on the "iterations" field.
+func IterationsNEQ(v int) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldNEQ(FieldIterations, v))
+}
+
+// IterationsIn applies the In predicate on the "iterations" field.
+func IterationsIn(vs ...int) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldIn(FieldIterations, vs...))
+}
+
+// IterationsNotIn applies the NotIn predicate on the "iterations" field.
+func IterationsNotIn(vs ...int) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldNotIn(FieldIterations, vs...))
+}
+
+// IterationsGT applies the GT predicate on the "iterations" field.
+func IterationsGT(v int) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldGT(FieldIterations, v))
+}
+
+// IterationsGTE applies the GTE predicate on the "iterations" field.
+func IterationsGTE(v int) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldGTE(FieldIterations, v))
+}
+
+// IterationsLT applies the LT predicate on the "iterations" field.
+func IterationsLT(v int) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldLT(FieldIterations, v))
+}
+
+// IterationsLTE applies the LTE predicate on the "iterations" field.
+func IterationsLTE(v int) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldLTE(FieldIterations, v))
+}
+
+// ProbabilityEQ applies the EQ predicate on the "probability" field.
+func ProbabilityEQ(v float64) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldEQ(FieldProbability, v))
+}
+
+// ProbabilityNEQ applies the NEQ predicate on the "probability" field.
+func ProbabilityNEQ(v float64) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldNEQ(FieldProbability, v))
+}
+
+// ProbabilityIn applies the In predicate on the "probability" field.
+func ProbabilityIn(vs ...float64) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldIn(FieldProbability, vs...))
+}
+
+// ProbabilityNotIn applies the NotIn predicate on the "probability" field.
+func ProbabilityNotIn(vs ...float64) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldNotIn(FieldProbability, vs...))
+}
+
+// ProbabilityGT applies the GT predicate on the "probability" field.
+func ProbabilityGT(v float64) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldGT(FieldProbability, v))
+}
+
+// ProbabilityGTE applies the GTE predicate on the "probability" field.
+func ProbabilityGTE(v float64) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldGTE(FieldProbability, v))
+}
+
+// ProbabilityLT applies the LT predicate on the "probability" field.
+func ProbabilityLT(v float64) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldLT(FieldProbability, v))
+}
+
+// ProbabilityLTE applies the LTE predicate on the "probability" field.
+func ProbabilityLTE(v float64) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldLTE(FieldProbability, v))
+}
+
+// RunIDEQ applies the EQ predicate on the "run_id" field.
+func RunIDEQ(v int) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldEQ(FieldRunID, v))
+}
+
+// RunIDNEQ applies the NEQ predicate on the "run_id" field.
+func RunIDNEQ(v int) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldNEQ(FieldRunID, v))
+}
+
+// RunIDIn applies the In predicate on the "run_id" field.
+func RunIDIn(vs ...int) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldIn(FieldRunID, vs...))
+}
+
+// RunIDNotIn applies the NotIn predicate on the "run_id" field.
+func RunIDNotIn(vs ...int) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.FieldNotIn(FieldRunID, vs...))
+}
+
+// HasHeading applies the HasEdge predicate on the "heading" edge.
+func HasHeading() predicate.AdmissionChance {
+	return predicate.AdmissionChance(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, HeadingTable, HeadingColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasHeadingWith applies the HasEdge predicate on the "heading" edge with a given conditions (other predicates).
+func HasHeadingWith(preds ...predicate.Heading) predicate.AdmissionChance {
+	return predicate.AdmissionChance(func(s *sql.Selector) {
+		step := newHeadingStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRun applies the HasEdge predicate on the "run" edge.
+func HasRun() predicate.AdmissionChance {
+	return predicate.AdmissionChance(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, RunTable, RunColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRunWith applies the HasEdge predicate on the "run" edge with a given conditions (other predicates).
+func HasRunWith(preds ...predicate.Run) predicate.AdmissionChance {
+	return predicate.AdmissionChance(func(s *sql.Selector) {
+		step := newRunStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AdmissionChance) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AdmissionChance) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AdmissionChance) predicate.AdmissionChance {
+	return predicate.AdmissionChance(sql.NotPredicates(p))
+}
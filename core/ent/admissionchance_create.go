@@ -0,0 +1,302 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/trueegorletov/analabit/core/ent/admissionchance"
+	"github.com/trueegorletov/analabit/core/ent/heading"
+	"github.com/trueegorletov/analabit/core/ent/run"
+)
+
+// AdmissionChanceCreate is the builder for creating a AdmissionChance entity.
+type AdmissionChanceCreate struct {
+	config
+	mutation *AdmissionChanceMutation
+	hooks    []Hook
+}
+
+// SetStudentID sets the "student_id" field.
+func (acc *AdmissionChanceCreate) SetStudentID(s string) *AdmissionChanceCreate {
+	acc.mutation.SetStudentID(s)
+	return acc
+}
+
+// SetDrainedPercent sets the "drained_percent" field.
+func (acc *AdmissionChanceCreate) SetDrainedPercent(i int) *AdmissionChanceCreate {
+	acc.mutation.SetDrainedPercent(i)
+	return acc
+}
+
+// SetAdmittedCount sets the "admitted_count" field.
+func (acc *AdmissionChanceCreate) SetAdmittedCount(i int) *AdmissionChanceCreate {
+	acc.mutation.SetAdmittedCount(i)
+	return acc
+}
+
+// SetIterations sets the "iterations" field.
+func (acc *AdmissionChanceCreate) SetIterations(i int) *AdmissionChanceCreate {
+	acc.mutation.SetIterations(i)
+	return acc
+}
+
+// SetProbability sets the "probability" field.
+func (acc *AdmissionChanceCreate) SetProbability(f float64) *AdmissionChanceCreate {
+	acc.mutation.SetProbability(f)
+	return acc
+}
+
+// SetRunID sets the "run_id" field.
+func (acc *AdmissionChanceCreate) SetRunID(i int) *AdmissionChanceCreate {
+	acc.mutation.SetRunID(i)
+	return acc
+}
+
+// SetHeadingID sets the "heading" edge to the Heading entity by ID.
+func (acc *AdmissionChanceCreate) SetHeadingID(id int) *AdmissionChanceCreate {
+	acc.mutation.SetHeadingID(id)
+	return acc
+}
+
+// SetHeading sets the "heading" edge to the Heading entity.
+func (acc *AdmissionChanceCreate) SetHeading(h *Heading) *AdmissionChanceCreate {
+	return acc.SetHeadingID(h.ID)
+}
+
+// SetRun sets the "run" edge to the Run entity.
+func (acc *AdmissionChanceCreate) SetRun(r *Run) *AdmissionChanceCreate {
+	return acc.SetRunID(r.ID)
+}
+
+// Mutation returns the AdmissionChanceMutation object of the builder.
+func (acc *AdmissionChanceCreate) Mutation() *AdmissionChanceMutation {
+	return acc.mutation
+}
+
+// Save creates the AdmissionChance in the database.
+func (acc *AdmissionChanceCreate) Save(ctx context.Context) (*AdmissionChance, error) {
+	return withHooks(ctx, acc.sqlSave, acc.mutation, acc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (acc *AdmissionChanceCreate) SaveX(ctx context.Context) *AdmissionChance {
+	v, err := acc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (acc *AdmissionChanceCreate) Exec(ctx context.Context) error {
+	_, err := acc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acc *AdmissionChanceCreate) ExecX(ctx context.Context) {
+	if err := acc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (acc *AdmissionChanceCreate) check() error {
+	if _, ok := acc.mutation.StudentID(); !ok {
+		return &ValidationError{Name: "student_id", err: errors.New(`ent: missing required field "AdmissionChance.student_id"`)}
+	}
+	if _, ok := acc.mutation.DrainedPercent(); !ok {
+		return &ValidationError{Name: "drained_percent", err: errors.New(`ent: missing required field "AdmissionChance.drained_percent"`)}
+	}
+	if _, ok := acc.mutation.AdmittedCount(); !ok {
+		return &ValidationError{Name: "admitted_count", err: errors.New(`ent: missing required field "AdmissionChance.admitted_count"`)}
+	}
+	if _, ok := acc.mutation.Iterations(); !ok {
+		return &ValidationError{Name: "iterations", err: errors.New(`ent: missing required field "AdmissionChance.iterations"`)}
+	}
+	if _, ok := acc.mutation.Probability(); !ok {
+		return &ValidationError{Name: "probability", err: errors.New(`ent: missing required field "AdmissionChance.probability"`)}
+	}
+	if _, ok := acc.mutation.RunID(); !ok {
+		return &ValidationError{Name: "run_id", err: errors.New(`ent: missing required field "AdmissionChance.run_id"`)}
+	}
+	if len(acc.mutation.HeadingIDs()) == 0 {
+		return &ValidationError{Name: "heading", err: errors.New(`ent: missing required edge "AdmissionChance.heading"`)}
+	}
+	if len(acc.mutation.RunIDs()) == 0 {
+		return &ValidationError{Name: "run", err: errors.New(`ent: missing required edge "AdmissionChance.run"`)}
+	}
+	return nil
+}
+
+func (acc *AdmissionChanceCreate) sqlSave(ctx context.Context) (*AdmissionChance, error) {
+	if err := acc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := acc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, acc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	acc.mutation.id = &_node.ID
+	acc.mutation.done = true
+	return _node, nil
+}
+
+func (acc *AdmissionChanceCreate) createSpec() (*AdmissionChance, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AdmissionChance{config: acc.config}
+		_spec = sqlgraph.NewCreateSpec(admissionchance.Table, sqlgraph.NewFieldSpec(admissionchance.FieldID, field.TypeInt))
+	)
+	if value, ok := acc.mutation.StudentID(); ok {
+		_spec.SetField(admissionchance.FieldStudentID, field.TypeString, value)
+		_node.StudentID = value
+	}
+	if value, ok := acc.mutation.DrainedPercent(); ok {
+		_spec.SetField(admissionchance.FieldDrainedPercent, field.TypeInt, value)
+		_node.DrainedPercent = value
+	}
+	if value, ok := acc.mutation.AdmittedCount(); ok {
+		_spec.SetField(admissionchance.FieldAdmittedCount, field.TypeInt, value)
+		_node.AdmittedCount = value
+	}
+	if value, ok := acc.mutation.Iterations(); ok {
+		_spec.SetField(admissionchance.FieldIterations, field.TypeInt, value)
+		_node.Iterations = value
+	}
+	if value, ok := acc.mutation.Probability(); ok {
+		_spec.SetField(admissionchance.FieldProbability, field.TypeFloat64, value)
+		_node.Probability = value
+	}
+	if nodes := acc.mutation.HeadingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   admissionchance.HeadingTable,
+			Columns: []string{admissionchance.HeadingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(heading.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.heading_admission_chances = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := acc.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   admissionchance.RunTable,
+			Columns: []string{admissionchance.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(run.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RunID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AdmissionChanceCreateBulk is the builder for creating many AdmissionChance entities in bulk.
+type AdmissionChanceCreateBulk struct {
+	config
+	err      error
+	builders []*AdmissionChanceCreate
+}
+
+// Save creates the AdmissionChance entities in the database.
+func (accb *AdmissionChanceCreateBulk) Save(ctx context.Context) ([]*AdmissionChance, error) {
+	if accb.err != nil {
+		return nil, accb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(accb.builders))
+	nodes := make([]*AdmissionChance, len(accb.builders))
+	mutators := make([]Mutator, len(accb.builders))
+	for i := range accb.builders {
+		func(i int, root context.Context) {
+			builder := accb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AdmissionChanceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, accb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, accb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, accb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (accb *AdmissionChanceCreateBulk) SaveX(ctx context.Context) []*AdmissionChance {
+	v, err := accb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (accb *AdmissionChanceCreateBulk) Exec(ctx context.Context) error {
+	_, err := accb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (accb *AdmissionChanceCreateBulk) ExecX(ctx context.Context) {
+	if err := accb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/trueegorletov/analabit/core/ent/admissionchance"
+	"github.com/trueegorletov/analabit/core/ent/predicate"
+)
+
+// AdmissionChanceDelete is the builder for deleting a AdmissionChance entity.
+type AdmissionChanceDelete struct {
+	config
+	hooks    []Hook
+	mutation *AdmissionChanceMutation
+}
+
+// Where appends a list predicates to the AdmissionChanceDelete builder.
+func (acd *AdmissionChanceDelete) Where(ps ...predicate.AdmissionChance) *AdmissionChanceDelete {
+	acd.mutation.Where(ps...)
+	return acd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (acd *AdmissionChanceDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, acd.sqlExec, acd.mutation, acd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acd *AdmissionChanceDelete) ExecX(ctx context.Context) int {
+	n, err := acd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (acd *AdmissionChanceDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(admissionchance.Table, sqlgraph.NewFieldSpec(admissionchance.FieldID, field.TypeInt))
+	if ps := acd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, acd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	acd.mutation.done = true
+	return affected, err
+}
+
+// AdmissionChanceDeleteOne is the builder for deleting a single AdmissionChance entity.
+type AdmissionChanceDeleteOne struct {
+	acd *AdmissionChanceDelete
+}
+
+// Where appends a list predicates to the AdmissionChanceDelete builder.
+func (acdo *AdmissionChanceDeleteOne) Where(ps ...predicate.AdmissionChance) *AdmissionChanceDeleteOne {
+	acdo.acd.mutation.Where(ps...)
+	return acdo
+}
+
+// Exec executes the deletion query.
+func (acdo *AdmissionChanceDeleteOne) Exec(ctx context.Context) error {
+	n, err := acdo.acd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{admissionchance.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acdo *AdmissionChanceDeleteOne) ExecX(ctx context.Context) {
+	if err := acdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
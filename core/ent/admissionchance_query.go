@@ -0,0 +1,689 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/trueegorletov/analabit/core/ent/admissionchance"
+	"github.com/trueegorletov/analabit/core/ent/heading"
+	"github.com/trueegorletov/analabit/core/ent/predicate"
+	"github.com/trueegorletov/analabit/core/ent/run"
+)
+
+// AdmissionChanceQuery is the builder for querying AdmissionChance entities.
+type AdmissionChanceQuery struct {
+	config
+	ctx         *QueryContext
+	order       []admissionchance.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.AdmissionChance
+	withHeading *HeadingQuery
+	withRun     *RunQuery
+	withFKs     bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the AdmissionChanceQuery builder.
+func (acq *AdmissionChanceQuery) Where(ps ...predicate.AdmissionChance) *AdmissionChanceQuery {
+	acq.predicates = append(acq.predicates, ps...)
+	return acq
+}
+
+// Limit the number of records to be returned by this query.
+func (acq *AdmissionChanceQuery) Limit(limit int) *AdmissionChanceQuery {
+	acq.ctx.Limit = &limit
+	return acq
+}
+
+// Offset to start from.
+func (acq *AdmissionChanceQuery) Offset(offset int) *AdmissionChanceQuery {
+	acq.ctx.Offset = &offset
+	return acq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (acq *AdmissionChanceQuery) Unique(unique bool) *AdmissionChanceQuery {
+	acq.ctx.Unique = &unique
+	return acq
+}
+
+// Order specifies how the records should be ordered.
+func (acq *AdmissionChanceQuery) Order(o ...admissionchance.OrderOption) *AdmissionChanceQuery {
+	acq.order = append(acq.order, o...)
+	return acq
+}
+
+// QueryHeading chains the current query on the "heading" edge.
+func (acq *AdmissionChanceQuery) QueryHeading() *HeadingQuery {
+	query := (&HeadingClient{config: acq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := acq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := acq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(admissionchance.Table, admissionchance.FieldID, selector),
+			sqlgraph.To(heading.Table, heading.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, admissionchance.HeadingTable, admissionchance.HeadingColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(acq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRun chains the current query on the "run" edge.
+func (acq *AdmissionChanceQuery) QueryRun() *RunQuery {
+	query := (&RunClient{config: acq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := acq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := acq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(admissionchance.Table, admissionchance.FieldID, selector),
+			sqlgraph.To(run.Table, run.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, admissionchance.RunTable, admissionchance.RunColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(acq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first AdmissionChance entity from the query.
+// Returns a *NotFoundError when no AdmissionChance was found.
+func (acq *AdmissionChanceQuery) First(ctx context.Context) (*AdmissionChance, error) {
+	nodes, err := acq.Limit(1).All(setContextOp(ctx, acq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{admissionchance.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (acq *AdmissionChanceQuery) FirstX(ctx context.Context) *AdmissionChance {
+	node, err := acq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first AdmissionChance ID from the query.
+// Returns a *NotFoundError when no AdmissionChance ID was found.
+func (acq *AdmissionChanceQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = acq.Limit(1).IDs(setContextOp(ctx, acq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{admissionchance.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (acq *AdmissionChanceQuery) FirstIDX(ctx context.Context) int {
+	id, err := acq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single AdmissionChance entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one AdmissionChance entity is found.
+// Returns a *NotFoundError when no AdmissionChance entities are found.
+func (acq *AdmissionChanceQuery) Only(ctx context.Context) (*AdmissionChance, error) {
+	nodes, err := acq.Limit(2).All(setContextOp(ctx, acq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{admissionchance.Label}
+	default:
+		return nil, &NotSingularError{admissionchance.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (acq *AdmissionChanceQuery) OnlyX(ctx context.Context) *AdmissionChance {
+	node, err := acq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only AdmissionChance ID in the query.
+// Returns a *NotSingularError when more than one AdmissionChance ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (acq *AdmissionChanceQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = acq.Limit(2).IDs(setContextOp(ctx, acq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{admissionchance.Label}
+	default:
+		err = &NotSingularError{admissionchance.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (acq *AdmissionChanceQuery) OnlyIDX(ctx context.Context) int {
+	id, err := acq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of AdmissionChances.
+func (acq *AdmissionChanceQuery) All(ctx context.Context) ([]*AdmissionChance, error) {
+	ctx = setContextOp(ctx, acq.ctx, ent.OpQueryAll)
+	if err := acq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*AdmissionChance, *AdmissionChanceQuery]()
+	return withInterceptors[[]*AdmissionChance](ctx, acq, qr, acq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (acq *AdmissionChanceQuery) AllX(ctx context.Context) []*AdmissionChance {
+	nodes, err := acq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of AdmissionChance IDs.
+func (acq *AdmissionChanceQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if acq.ctx.Unique == nil && acq.path != nil {
+		acq.Unique(true)
+	}
+	ctx = setContextOp(ctx, acq.ctx, ent.OpQueryIDs)
+	if err = acq.Select(admissionchance.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (acq *AdmissionChanceQuery) IDsX(ctx context.Context) []int {
+	ids, err := acq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (acq *AdmissionChanceQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, acq.ctx, ent.OpQueryCount)
+	if err := acq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, acq, querierCount[*AdmissionChanceQuery](), acq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (acq *AdmissionChanceQuery) CountX(ctx context.Context) int {
+	count, err := acq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (acq *AdmissionChanceQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, acq.ctx, ent.OpQueryExist)
+	switch _, err := acq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (acq *AdmissionChanceQuery) ExistX(ctx context.Context) bool {
+	exist, err := acq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the AdmissionChanceQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (acq *AdmissionChanceQuery) Clone() *AdmissionChanceQuery {
+	if acq == nil {
+		return nil
+	}
+	return &AdmissionChanceQuery{
+		config:      acq.config,
+		ctx:         acq.ctx.Clone(),
+		order:       append([]admissionchance.OrderOption{}, acq.order...),
+		inters:      append([]Interceptor{}, acq.inters...),
+		predicates:  append([]predicate.AdmissionChance{}, acq.predicates...),
+		withHeading: acq.withHeading.Clone(),
+		withRun:     acq.withRun.Clone(),
+		// clone intermediate query.
+		sql:  acq.sql.Clone(),
+		path: acq.path,
+	}
+}
+
+// WithHeading tells the query-builder to eager-load the nodes that are connected to
+// the "heading" edge. The optional arguments are used to configure the query builder of the edge.
+func (acq *AdmissionChanceQuery) WithHeading(opts ...func(*HeadingQuery)) *AdmissionChanceQuery {
+	query := (&HeadingClient{config: acq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	acq.withHeading = query
+	return acq
+}
+
+// WithRun tells the query-builder to eager-load the nodes that are connected to
+// the "run" edge. The optional arguments are used to configure the query builder of the edge.
+func (acq *AdmissionChanceQuery) WithRun(opts ...func(*RunQuery)) *AdmissionChanceQuery {
+	query := (&RunClient{config: acq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	acq.withRun = query
+	return acq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		StudentID string `json:"student_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.AdmissionChance.Query().
+//		GroupBy(admissionchance.FieldStudentID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (acq *AdmissionChanceQuery) GroupBy(field string, fields ...string) *AdmissionChanceGroupBy {
+	acq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &AdmissionChanceGroupBy{build: acq}
+	grbuild.flds = &acq.ctx.Fields
+	grbuild.label = admissionchance.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		StudentID string `json:"student_id,omitempty"`
+//	}
+//
+//	client.AdmissionChance.Query().
+//		Select(admissionchance.FieldStudentID).
+//		Scan(ctx, &v)
+func (acq *AdmissionChanceQuery) Select(fields ...string) *AdmissionChanceSelect {
+	acq.ctx.Fields = append(acq.ctx.Fields, fields...)
+	sbuild := &AdmissionChanceSelect{AdmissionChanceQuery: acq}
+	sbuild.label = admissionchance.Label
+	sbuild.flds, sbuild.scan = &acq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a AdmissionChanceSelect configured with the given aggregations.
+func (acq *AdmissionChanceQuery) Aggregate(fns ...AggregateFunc) *AdmissionChanceSelect {
+	return acq.Select().Aggregate(fns...)
+}
+
+func (acq *AdmissionChanceQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range acq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, acq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range acq.ctx.Fields {
+		if !admissionchance.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if acq.path != nil {
+		prev, err := acq.path(ctx)
+		if err != nil {
+			return err
+		}
+		acq.sql = prev
+	}
+	return nil
+}
+
+func (acq *AdmissionChanceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*AdmissionChance, error) {
+	var (
+		nodes       = []*AdmissionChance{}
+		withFKs     = acq.withFKs
+		_spec       = acq.querySpec()
+		loadedTypes = [2]bool{
+			acq.withHeading != nil,
+			acq.withRun != nil,
+		}
+	)
+	if acq.withHeading != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, admissionchance.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*AdmissionChance).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &AdmissionChance{config: acq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, acq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := acq.withHeading; query != nil {
+		if err := acq.loadHeading(ctx, query, nodes, nil,
+			func(n *AdmissionChance, e *Heading) { n.Edges.Heading = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := acq.withRun; query != nil {
+		if err := acq.loadRun(ctx, query, nodes, nil,
+			func(n *AdmissionChance, e *Run) { n.Edges.Run = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (acq *AdmissionChanceQuery) loadHeading(ctx context.Context, query *HeadingQuery, nodes []*AdmissionChance, init func(*AdmissionChance), assign func(*AdmissionChance, *Heading)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*AdmissionChance)
+	for i := range nodes {
+		if nodes[i].heading_admission_chances == nil {
+			continue
+		}
+		fk := *nodes[i].heading_admission_chances
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(heading.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "heading_admission_chances" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (acq *AdmissionChanceQuery) loadRun(ctx context.Context, query *RunQuery, nodes []*AdmissionChance, init func(*AdmissionChance), assign func(*AdmissionChance, *Run)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*AdmissionChance)
+	for i := range nodes {
+		fk := nodes[i].RunID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(run.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "run_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (acq *AdmissionChanceQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := acq.querySpec()
+	_spec.Node.Columns = acq.ctx.Fields
+	if len(acq.ctx.Fields) > 0 {
+		_spec.Unique = acq.ctx.Unique != nil && *acq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, acq.driver, _spec)
+}
+
+func (acq *AdmissionChanceQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(admissionchance.Table, admissionchance.Columns, sqlgraph.NewFieldSpec(admissionchance.FieldID, field.TypeInt))
+	_spec.From = acq.sql
+	if unique := acq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if acq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := acq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, admissionchance.FieldID)
+		for i := range fields {
+			if fields[i] != admissionchance.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if acq.withRun != nil {
+			_spec.Node.AddColumnOnce(admissionchance.FieldRunID)
+		}
+	}
+	if ps := acq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := acq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := acq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := acq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (acq *AdmissionChanceQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(acq.driver.Dialect())
+	t1 := builder.Table(admissionchance.Table)
+	columns := acq.ctx.Fields
+	if len(columns) == 0 {
+		columns = admissionchance.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if acq.sql != nil {
+		selector = acq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if acq.ctx.Unique != nil && *acq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range acq.predicates {
+		p(selector)
+	}
+	for _, p := range acq.order {
+		p(selector)
+	}
+	if offset := acq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := acq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// AdmissionChanceGroupBy is the group-by builder for AdmissionChance entities.
+type AdmissionChanceGroupBy struct {
+	selector
+	build *AdmissionChanceQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (acgb *AdmissionChanceGroupBy) Aggregate(fns ...AggregateFunc) *AdmissionChanceGroupBy {
+	acgb.fns = append(acgb.fns, fns...)
+	return acgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (acgb *AdmissionChanceGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, acgb.build.ctx, ent.OpQueryGroupBy)
+	if err := acgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AdmissionChanceQuery, *AdmissionChanceGroupBy](ctx, acgb.build, acgb, acgb.build.inters, v)
+}
+
+func (acgb *AdmissionChanceGroupBy) sqlScan(ctx context.Context, root *AdmissionChanceQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(acgb.fns))
+	for _, fn := range acgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*acgb.flds)+len(acgb.fns))
+		for _, f := range *acgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*acgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := acgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// AdmissionChanceSelect is the builder for selecting fields of AdmissionChance entities.
+type AdmissionChanceSelect struct {
+	*AdmissionChanceQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (acs *AdmissionChanceSelect) Aggregate(fns ...AggregateFunc) *AdmissionChanceSelect {
+	acs.fns = append(acs.fns, fns...)
+	return acs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (acs *AdmissionChanceSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, acs.ctx, ent.OpQuerySelect)
+	if err := acs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AdmissionChanceQuery, *AdmissionChanceSelect](ctx, acs.AdmissionChanceQuery, acs, acs.inters, v)
+}
+
+func (acs *AdmissionChanceSelect) sqlScan(ctx context.Context, root *AdmissionChanceQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(acs.fns))
+	for _, fn := range acs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*acs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := acs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
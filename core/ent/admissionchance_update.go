@@ -0,0 +1,655 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/trueegorletov/analabit/core/ent/admissionchance"
+	"github.com/trueegorletov/analabit/core/ent/heading"
+	"github.com/trueegorletov/analabit/core/ent/predicate"
+	"github.com/trueegorletov/analabit/core/ent/run"
+)
+
+// AdmissionChanceUpdate is the builder for updating AdmissionChance entities.
+type AdmissionChanceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AdmissionChanceMutation
+}
+
+// Where appends a list predicates to the AdmissionChanceUpdate builder.
+func (acu *AdmissionChanceUpdate) Where(ps ...predicate.AdmissionChance) *AdmissionChanceUpdate {
+	acu.mutation.Where(ps...)
+	return acu
+}
+
+// SetStudentID sets the "student_id" field.
+func (acu *AdmissionChanceUpdate) SetStudentID(s string) *AdmissionChanceUpdate {
+	acu.mutation.SetStudentID(s)
+	return acu
+}
+
+// SetNillableStudentID sets the "student_id" field if the given value is not nil.
+func (acu *AdmissionChanceUpdate) SetNillableStudentID(s *string) *AdmissionChanceUpdate {
+	if s != nil {
+		acu.SetStudentID(*s)
+	}
+	return acu
+}
+
+// SetDrainedPercent sets the "drained_percent" field.
+func (acu *AdmissionChanceUpdate) SetDrainedPercent(i int) *AdmissionChanceUpdate {
+	acu.mutation.ResetDrainedPercent()
+	acu.mutation.SetDrainedPercent(i)
+	return acu
+}
+
+// SetNillableDrainedPercent sets the "drained_percent" field if the given value is not nil.
+func (acu *AdmissionChanceUpdate) SetNillableDrainedPercent(i *int) *AdmissionChanceUpdate {
+	if i != nil {
+		acu.SetDrainedPercent(*i)
+	}
+	return acu
+}
+
+// AddDrainedPercent adds i to the "drained_percent" field.
+func (acu *AdmissionChanceUpdate) AddDrainedPercent(i int) *AdmissionChanceUpdate {
+	acu.mutation.AddDrainedPercent(i)
+	return acu
+}
+
+// SetAdmittedCount sets the "admitted_count" field.
+func (acu *AdmissionChanceUpdate) SetAdmittedCount(i int) *AdmissionChanceUpdate {
+	acu.mutation.ResetAdmittedCount()
+	acu.mutation.SetAdmittedCount(i)
+	return acu
+}
+
+// SetNillableAdmittedCount sets the "admitted_count" field if the given value is not nil.
+func (acu *AdmissionChanceUpdate) SetNillableAdmittedCount(i *int) *AdmissionChanceUpdate {
+	if i != nil {
+		acu.SetAdmittedCount(*i)
+	}
+	return acu
+}
+
+// AddAdmittedCount adds i to the "admitted_count" field.
+func (acu *AdmissionChanceUpdate) AddAdmittedCount(i int) *AdmissionChanceUpdate {
+	acu.mutation.AddAdmittedCount(i)
+	return acu
+}
+
+// SetIterations sets the "iterations" field.
+func (acu *AdmissionChanceUpdate) SetIterations(i int) *AdmissionChanceUpdate {
+	acu.mutation.ResetIterations()
+	acu.mutation.SetIterations(i)
+	return acu
+}
+
+// SetNillableIterations sets the "iterations" field if the given value is not nil.
+func (acu *AdmissionChanceUpdate) SetNillableIterations(i *int) *AdmissionChanceUpdate {
+	if i != nil {
+		acu.SetIterations(*i)
+	}
+	return acu
+}
+
+// AddIterations adds i to the "iterations" field.
+func (acu *AdmissionChanceUpdate) AddIterations(i int) *AdmissionChanceUpdate {
+	acu.mutation.AddIterations(i)
+	return acu
+}
+
+// SetProbability sets the "probability" field.
+func (acu *AdmissionChanceUpdate) SetProbability(f float64) *AdmissionChanceUpdate {
+	acu.mutation.ResetProbability()
+	acu.mutation.SetProbability(f)
+	return acu
+}
+
+// SetNillableProbability sets the "probability" field if the given value is not nil.
+func (acu *AdmissionChanceUpdate) SetNillableProbability(f *float64) *AdmissionChanceUpdate {
+	if f != nil {
+		acu.SetProbability(*f)
+	}
+	return acu
+}
+
+// AddProbability adds f to the "probability" field.
+func (acu *AdmissionChanceUpdate) AddProbability(f float64) *AdmissionChanceUpdate {
+	acu.mutation.AddProbability(f)
+	return acu
+}
+
+// SetRunID sets the "run_id" field.
+func (acu *AdmissionChanceUpdate) SetRunID(i int) *AdmissionChanceUpdate {
+	acu.mutation.SetRunID(i)
+	return acu
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (acu *AdmissionChanceUpdate) SetNillableRunID(i *int) *AdmissionChanceUpdate {
+	if i != nil {
+		acu.SetRunID(*i)
+	}
+	return acu
+}
+
+// SetHeadingID sets the "heading" edge to the Heading entity by ID.
+func (acu *AdmissionChanceUpdate) SetHeadingID(id int) *AdmissionChanceUpdate {
+	acu.mutation.SetHeadingID(id)
+	return acu
+}
+
+// SetHeading sets the "heading" edge to the Heading entity.
+func (acu *AdmissionChanceUpdate) SetHeading(h *Heading) *AdmissionChanceUpdate {
+	return acu.SetHeadingID(h.ID)
+}
+
+// SetRun sets the "run" edge to the Run entity.
+func (acu *AdmissionChanceUpdate) SetRun(r *Run) *AdmissionChanceUpdate {
+	return acu.SetRunID(r.ID)
+}
+
+// Mutation returns the AdmissionChanceMutation object of the builder.
+func (acu *AdmissionChanceUpdate) Mutation() *AdmissionChanceMutation {
+	return acu.mutation
+}
+
+// ClearHeading clears the "heading" edge to the Heading entity.
+func (acu *AdmissionChanceUpdate) ClearHeading() *AdmissionChanceUpdate {
+	acu.mutation.ClearHeading()
+	return acu
+}
+
+// ClearRun clears the "run" edge to the Run entity.
+func (acu *AdmissionChanceUpdate) ClearRun() *AdmissionChanceUpdate {
+	acu.mutation.ClearRun()
+	return acu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (acu *AdmissionChanceUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, acu.sqlSave, acu.mutation, acu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (acu *AdmissionChanceUpdate) SaveX(ctx context.Context) int {
+	affected, err := acu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (acu *AdmissionChanceUpdate) Exec(ctx context.Context) error {
+	_, err := acu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acu *AdmissionChanceUpdate) ExecX(ctx context.Context) {
+	if err := acu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (acu *AdmissionChanceUpdate) check() error {
+	if acu.mutation.HeadingCleared() && len(acu.mutation.HeadingIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AdmissionChance.heading"`)
+	}
+	if acu.mutation.RunCleared() && len(acu.mutation.RunIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AdmissionChance.run"`)
+	}
+	return nil
+}
+
+func (acu *AdmissionChanceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := acu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(admissionchance.Table, admissionchance.Columns, sqlgraph.NewFieldSpec(admissionchance.FieldID, field.TypeInt))
+	if ps := acu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := acu.mutation.StudentID(); ok {
+		_spec.SetField(admissionchance.FieldStudentID, field.TypeString, value)
+	}
+	if value, ok := acu.mutation.DrainedPercent(); ok {
+		_spec.SetField(admissionchance.FieldDrainedPercent, field.TypeInt, value)
+	}
+	if value, ok := acu.mutation.AddedDrainedPercent(); ok {
+		_spec.AddField(admissionchance.FieldDrainedPercent, field.TypeInt, value)
+	}
+	if value, ok := acu.mutation.AdmittedCount(); ok {
+		_spec.SetField(admissionchance.FieldAdmittedCount, field.TypeInt, value)
+	}
+	if value, ok := acu.mutation.AddedAdmittedCount(); ok {
+		_spec.AddField(admissionchance.FieldAdmittedCount, field.TypeInt, value)
+	}
+	if value, ok := acu.mutation.Iterations(); ok {
+		_spec.SetField(admissionchance.FieldIterations, field.TypeInt, value)
+	}
+	if value, ok := acu.mutation.AddedIterations(); ok {
+		_spec.AddField(admissionchance.FieldIterations, field.TypeInt, value)
+	}
+	if value, ok := acu.mutation.Probability(); ok {
+		_spec.SetField(admissionchance.FieldProbability, field.TypeFloat64, value)
+	}
+	if value, ok := acu.mutation.AddedProbability(); ok {
+		_spec.AddField(admissionchance.FieldProbability, field.TypeFloat64, value)
+	}
+	if acu.mutation.HeadingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   admissionchance.HeadingTable,
+			Columns: []string{admissionchance.HeadingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(heading.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := acu.mutation.HeadingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   admissionchance.HeadingTable,
+			Columns: []string{admissionchance.HeadingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(heading.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if acu.mutation.RunCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   admissionchance.RunTable,
+			Columns: []string{admissionchance.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(run.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := acu.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   admissionchance.RunTable,
+			Columns: []string{admissionchance.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(run.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, acu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{admissionchance.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	acu.mutation.done = true
+	return n, nil
+}
+
+// AdmissionChanceUpdateOne is the builder for updating a single AdmissionChance entity.
+type AdmissionChanceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AdmissionChanceMutation
+}
+
+// SetStudentID sets the "student_id" field.
+func (acuo *AdmissionChanceUpdateOne) SetStudentID(s string) *AdmissionChanceUpdateOne {
+	acuo.mutation.SetStudentID(s)
+	return acuo
+}
+
+// SetNillableStudentID sets the "student_id" field if the given value is not nil.
+func (acuo *AdmissionChanceUpdateOne) SetNillableStudentID(s *string) *AdmissionChanceUpdateOne {
+	if s != nil {
+		acuo.SetStudentID(*s)
+	}
+	return acuo
+}
+
+// SetDrainedPercent sets the "drained_percent" field.
+func (acuo *AdmissionChanceUpdateOne) SetDrainedPercent(i int) *AdmissionChanceUpdateOne {
+	acuo.mutation.ResetDrainedPercent()
+	acuo.mutation.SetDrainedPercent(i)
+	return acuo
+}
+
+// SetNillableDrainedPercent sets the "drained_percent" field if the given value is not nil.
+func (acuo *AdmissionChanceUpdateOne) SetNillableDrainedPercent(i *int) *AdmissionChanceUpdateOne {
+	if i != nil {
+		acuo.SetDrainedPercent(*i)
+	}
+	return acuo
+}
+
+// AddDrainedPercent adds i to the "drained_percent" field.
+func (acuo *AdmissionChanceUpdateOne) AddDrainedPercent(i int) *AdmissionChanceUpdateOne {
+	acuo.mutation.AddDrainedPercent(i)
+	return acuo
+}
+
+// SetAdmittedCount sets the "admitted_count" field.
+func (acuo *AdmissionChanceUpdateOne) SetAdmittedCount(i int) *AdmissionChanceUpdateOne {
+	acuo.mutation.ResetAdmittedCount()
+	acuo.mutation.SetAdmittedCount(i)
+	return acuo
+}
+
+// SetNillableAdmittedCount sets the "admitted_count" field if the given value is not nil.
+func (acuo *AdmissionChanceUpdateOne) SetNillableAdmittedCount(i *int) *AdmissionChanceUpdateOne {
+	if i != nil {
+		acuo.SetAdmittedCount(*i)
+	}
+	return acuo
+}
+
+// AddAdmittedCount adds i to the "admitted_count" field.
+func (acuo *AdmissionChanceUpdateOne) AddAdmittedCount(i int) *AdmissionChanceUpdateOne {
+	acuo.mutation.AddAdmittedCount(i)
+	return acuo
+}
+
+// SetIterations sets the "iterations" field.
+func (acuo *AdmissionChanceUpdateOne) SetIterations(i int) *AdmissionChanceUpdateOne {
+	acuo.mutation.ResetIterations()
+	acuo.mutation.SetIterations(i)
+	return acuo
+}
+
+// SetNillableIterations sets the "iterations" field if the given value is not nil.
+func (acuo *AdmissionChanceUpdateOne) SetNillableIterations(i *int) *AdmissionChanceUpdateOne {
+	if i != nil {
+		acuo.SetIterations(*i)
+	}
+	return acuo
+}
+
+// AddIterations adds i to the "iterations" field.
+func (acuo *AdmissionChanceUpdateOne) AddIterations(i int) *AdmissionChanceUpdateOne {
+	acuo.mutation.AddIterations(i)
+	return acuo
+}
+
+// SetProbability sets the "probability" field.
+func (acuo *AdmissionChanceUpdateOne) SetProbability(f float64) *AdmissionChanceUpdateOne {
+	acuo.mutation.ResetProbability()
+	acuo.mutation.SetProbability(f)
+	return acuo
+}
+
+// SetNillableProbability sets the "probability" field if the given value is not nil.
+func (acuo *AdmissionChanceUpdateOne) SetNillableProbability(f *float64) *AdmissionChanceUpdateOne {
+	if f != nil {
+		acuo.SetProbability(*f)
+	}
+	return acuo
+}
+
+// AddProbability adds f to the "probability" field.
+func (acuo *AdmissionChanceUpdateOne) AddProbability(f float64) *AdmissionChanceUpdateOne {
+	acuo.mutation.AddProbability(f)
+	return acuo
+}
+
+// SetRunID sets the "run_id" field.
+func (acuo *AdmissionChanceUpdateOne) SetRunID(i int) *AdmissionChanceUpdateOne {
+	acuo.mutation.SetRunID(i)
+	return acuo
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (acuo *AdmissionChanceUpdateOne) SetNillableRunID(i *int) *AdmissionChanceUpdateOne {
+	if i != nil {
+		acuo.SetRunID(*i)
+	}
+	return acuo
+}
+
+// SetHeadingID sets the "heading" edge to the Heading entity by ID.
+func (acuo *AdmissionChanceUpdateOne) SetHeadingID(id int) *AdmissionChanceUpdateOne {
+	acuo.mutation.SetHeadingID(id)
+	return acuo
+}
+
+// SetHeading sets the "heading" edge to the Heading entity.
+func (acuo *AdmissionChanceUpdateOne) SetHeading(h *Heading) *AdmissionChanceUpdateOne {
+	return acuo.SetHeadingID(h.ID)
+}
+
+// SetRun sets the "run" edge to the Run entity.
+func (acuo *AdmissionChanceUpdateOne) SetRun(r *Run) *AdmissionChanceUpdateOne {
+	return acuo.SetRunID(r.ID)
+}
+
+// Mutation returns the AdmissionChanceMutation object of the builder.
+func (acuo *AdmissionChanceUpdateOne) Mutation() *AdmissionChanceMutation {
+	return acuo.mutation
+}
+
+// ClearHeading clears the "heading" edge to the Heading entity.
+func (acuo *AdmissionChanceUpdateOne) ClearHeading() *AdmissionChanceUpdateOne {
+	acuo.mutation.ClearHeading()
+	return acuo
+}
+
+// ClearRun clears the "run" edge to the Run entity.
+func (acuo *AdmissionChanceUpdateOne) ClearRun() *AdmissionChanceUpdateOne {
+	acuo.mutation.ClearRun()
+	return acuo
+}
+
+// Where appends a list predicates to the AdmissionChanceUpdate builder.
+func (acuo *AdmissionChanceUpdateOne) Where(ps ...predicate.AdmissionChance) *AdmissionChanceUpdateOne {
+	acuo.mutation.Where(ps...)
+	return acuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (acuo *AdmissionChanceUpdateOne) Select(field string, fields ...string) *AdmissionChanceUpdateOne {
+	acuo.fields = append([]string{field}, fields...)
+	return acuo
+}
+
+// Save executes the query and returns the updated AdmissionChance entity.
+func (acuo *AdmissionChanceUpdateOne) Save(ctx context.Context) (*AdmissionChance, error) {
+	return withHooks(ctx, acuo.sqlSave, acuo.mutation, acuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (acuo *AdmissionChanceUpdateOne) SaveX(ctx context.Context) *AdmissionChance {
+	node, err := acuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (acuo *AdmissionChanceUpdateOne) Exec(ctx context.Context) error {
+	_, err := acuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acuo *AdmissionChanceUpdateOne) ExecX(ctx context.Context) {
+	if err := acuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (acuo *AdmissionChanceUpdateOne) check() error {
+	if acuo.mutation.HeadingCleared() && len(acuo.mutation.HeadingIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AdmissionChance.heading"`)
+	}
+	if acuo.mutation.RunCleared() && len(acuo.mutation.RunIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AdmissionChance.run"`)
+	}
+	return nil
+}
+
+func (acuo *AdmissionChanceUpdateOne) sqlSave(ctx context.Context) (_node *AdmissionChance, err error) {
+	if err := acuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(admissionchance.Table, admissionchance.Columns, sqlgraph.NewFieldSpec(admissionchance.FieldID, field.TypeInt))
+	id, ok := acuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AdmissionChance.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := acuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, admissionchance.FieldID)
+		for _, f := range fields {
+			if !admissionchance.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != admissionchance.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := acuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := acuo.mutation.StudentID(); ok {
+		_spec.SetField(admissionchance.FieldStudentID, field.TypeString, value)
+	}
+	if value, ok := acuo.mutation.DrainedPercent(); ok {
+		_spec.SetField(admissionchance.FieldDrainedPercent, field.TypeInt, value)
+	}
+	if value, ok := acuo.mutation.AddedDrainedPercent(); ok {
+		_spec.AddField(admissionchance.FieldDrainedPercent, field.TypeInt, value)
+	}
+	if value, ok := acuo.mutation.AdmittedCount(); ok {
+		_spec.SetField(admissionchance.FieldAdmittedCount, field.TypeInt, value)
+	}
+	if value, ok := acuo.mutation.AddedAdmittedCount(); ok {
+		_spec.AddField(admissionchance.FieldAdmittedCount, field.TypeInt, value)
+	}
+	if value, ok := acuo.mutation.Iterations(); ok {
+		_spec.SetField(admissionchance.FieldIterations, field.TypeInt, value)
+	}
+	if value, ok := acuo.mutation.AddedIterations(); ok {
+		_spec.AddField(admissionchance.FieldIterations, field.TypeInt, value)
+	}
+	if value, ok := acuo.mutation.Probability(); ok {
+		_spec.SetField(admissionchance.FieldProbability, field.TypeFloat64, value)
+	}
+	if value, ok := acuo.mutation.AddedProbability(); ok {
+		_spec.AddField(admissionchance.FieldProbability, field.TypeFloat64, value)
+	}
+	if acuo.mutation.HeadingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   admissionchance.HeadingTable,
+			Columns: []string{admissionchance.HeadingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(heading.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := acuo.mutation.HeadingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   admissionchance.HeadingTable,
+			Columns: []string{admissionchance.HeadingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(heading.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if acuo.mutation.RunCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   admissionchance.RunTable,
+			Columns: []string{admissionchance.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(run.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := acuo.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   admissionchance.RunTable,
+			Columns: []string{admissionchance.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(run.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &AdmissionChance{config: acuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, acuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{admissionchance.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	acuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,239 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/ent/admissionevent"
+	"github.com/trueegorletov/analabit/core/ent/heading"
+	"github.com/trueegorletov/analabit/core/ent/run"
+)
+
+// AdmissionEvent is the model entity for the AdmissionEvent schema.
+type AdmissionEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Seq holds the value of the "seq" field.
+	Seq int `json:"seq,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind core.TraceEventKind `json:"kind,omitempty"`
+	// Stage holds the value of the "stage" field.
+	Stage core.AdmissionStage `json:"stage,omitempty"`
+	// StudentID holds the value of the "student_id" field.
+	StudentID string `json:"student_id,omitempty"`
+	// Priority holds the value of the "priority" field.
+	Priority int `json:"priority,omitempty"`
+	// CompetitionType holds the value of the "competition_type" field.
+	CompetitionType core.Competition `json:"competition_type,omitempty"`
+	// OtherStudentID holds the value of the "other_student_id" field.
+	OtherStudentID string `json:"other_student_id,omitempty"`
+	// RunID holds the value of the "run_id" field.
+	RunID int `json:"run_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AdmissionEventQuery when eager-loading is set.
+	Edges                    AdmissionEventEdges `json:"edges"`
+	heading_admission_events *int
+	selectValues             sql.SelectValues
+}
+
+// AdmissionEventEdges holds the relations/edges for other nodes in the graph.
+type AdmissionEventEdges struct {
+	// Heading holds the value of the heading edge.
+	Heading *Heading `json:"heading,omitempty"`
+	// Run holds the value of the run edge.
+	Run *Run `json:"run,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// HeadingOrErr returns the Heading value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AdmissionEventEdges) HeadingOrErr() (*Heading, error) {
+	if e.Heading != nil {
+		return e.Heading, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: heading.Label}
+	}
+	return nil, &NotLoadedError{edge: "heading"}
+}
+
+// RunOrErr returns the Run value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AdmissionEventEdges) RunOrErr() (*Run, error) {
+	if e.Run != nil {
+		return e.Run, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: run.Label}
+	}
+	return nil, &NotLoadedError{edge: "run"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AdmissionEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case admissionevent.FieldID, admissionevent.FieldSeq, admissionevent.FieldKind, admissionevent.FieldStage, admissionevent.FieldPriority, admissionevent.FieldCompetitionType, admissionevent.FieldRunID:
+			values[i] = new(sql.NullInt64)
+		case admissionevent.FieldStudentID, admissionevent.FieldOtherStudentID:
+			values[i] = new(sql.NullString)
+		case admissionevent.ForeignKeys[0]: // heading_admission_events
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AdmissionEvent fields.
+func (ae *AdmissionEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case admissionevent.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ae.ID = int(value.Int64)
+		case admissionevent.FieldSeq:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field seq", values[i])
+			} else if value.Valid {
+				ae.Seq = int(value.Int64)
+			}
+		case admissionevent.FieldKind:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				ae.Kind = core.TraceEventKind(value.Int64)
+			}
+		case admissionevent.FieldStage:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field stage", values[i])
+			} else if value.Valid {
+				ae.Stage = core.AdmissionStage(value.Int64)
+			}
+		case admissionevent.FieldStudentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field student_id", values[i])
+			} else if value.Valid {
+				ae.StudentID = value.String
+			}
+		case admissionevent.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				ae.Priority = int(value.Int64)
+			}
+		case admissionevent.FieldCompetitionType:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field competition_type", values[i])
+			} else if value.Valid {
+				ae.CompetitionType = core.Competition(value.Int64)
+			}
+		case admissionevent.FieldOtherStudentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field other_student_id", values[i])
+			} else if value.Valid {
+				ae.OtherStudentID = value.String
+			}
+		case admissionevent.FieldRunID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field run_id", values[i])
+			} else if value.Valid {
+				ae.RunID = int(value.Int64)
+			}
+		case admissionevent.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field heading_admission_events", value)
+			} else if value.Valid {
+				ae.heading_admission_events = new(int)
+				*ae.heading_admission_events = int(value.Int64)
+			}
+		default:
+			ae.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AdmissionEvent.
+// This includes values selected through modifiers, order, etc.
+func (ae *AdmissionEvent) Value(name string) (ent.Value, error) {
+	return ae.selectValues.Get(name)
+}
+
+// QueryHeading queries the "heading" edge of the AdmissionEvent entity.
+func (ae *AdmissionEvent) QueryHeading() *HeadingQuery {
+	return NewAdmissionEventClient(ae.config).QueryHeading(ae)
+}
+
+// QueryRun queries the "run" edge of the AdmissionEvent entity.
+func (ae *AdmissionEvent) QueryRun() *RunQuery {
+	return NewAdmissionEventClient(ae.config).QueryRun(ae)
+}
+
+// Update returns a builder for updating this AdmissionEvent.
+// Note that you need to call AdmissionEvent.Unwrap() before calling this method if this AdmissionEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ae *AdmissionEvent) Update() *AdmissionEventUpdateOne {
+	return NewAdmissionEventClient(ae.config).UpdateOne(ae)
+}
+
+// Unwrap unwraps the AdmissionEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ae *AdmissionEvent) Unwrap() *AdmissionEvent {
+	_tx, ok := ae.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AdmissionEvent is not a transactional entity")
+	}
+	ae.config.driver = _tx.drv
+	return ae
+}
+
+// String implements the fmt.Stringer.
+func (ae *AdmissionEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("AdmissionEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ae.ID))
+	builder.WriteString("seq=")
+	builder.WriteString(fmt.Sprintf("%v", ae.Seq))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", ae.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("stage=")
+	builder.WriteString(fmt.Sprintf("%v", ae.Stage))
+	builder.WriteString(", ")
+	builder.WriteString("student_id=")
+	builder.WriteString(ae.StudentID)
+	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", ae.Priority))
+	builder.WriteString(", ")
+	builder.WriteString("competition_type=")
+	builder.WriteString(fmt.Sprintf("%v", ae.CompetitionType))
+	builder.WriteString(", ")
+	builder.WriteString("other_student_id=")
+	builder.WriteString(ae.OtherStudentID)
+	builder.WriteString(", ")
+	builder.WriteString("run_id=")
+	builder.WriteString(fmt.Sprintf("%v", ae.RunID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AdmissionEvents is a parsable slice of AdmissionEvent.
+type AdmissionEvents []*AdmissionEvent
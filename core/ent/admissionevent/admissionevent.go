@@ -0,0 +1,161 @@
+// Code generated by ent, DO NOT EDIT.
+
+package admissionevent
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the admissionevent type in the database.
+	Label = "admission_event"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSeq holds the string denoting the seq field in the database.
+	FieldSeq = "seq"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldStage holds the string denoting the stage field in the database.
+	FieldStage = "stage"
+	// FieldStudentID holds the string denoting the student_id field in the database.
+	FieldStudentID = "student_id"
+	// FieldPriority holds the string denoting the priority field in the database.
+	FieldPriority = "priority"
+	// FieldCompetitionType holds the string denoting the competition_type field in the database.
+	FieldCompetitionType = "competition_type"
+	// FieldOtherStudentID holds the string denoting the other_student_id field in the database.
+	FieldOtherStudentID = "other_student_id"
+	// FieldRunID holds the string denoting the run_id field in the database.
+	FieldRunID = "run_id"
+	// EdgeHeading holds the string denoting the heading edge name in mutations.
+	EdgeHeading = "heading"
+	// EdgeRun holds the string denoting the run edge name in mutations.
+	EdgeRun = "run"
+	// Table holds the table name of the admissionevent in the database.
+	Table = "admission_events"
+	// HeadingTable is the table that holds the heading relation/edge.
+	HeadingTable = "admission_events"
+	// HeadingInverseTable is the table name for the Heading entity.
+	// It exists in this package in order to avoid circular dependency with the "heading" package.
+	HeadingInverseTable = "headings"
+	// HeadingColumn is the table column denoting the heading relation/edge.
+	HeadingColumn = "heading_admission_events"
+	// RunTable is the table that holds the run relation/edge.
+	RunTable = "admission_events"
+	// RunInverseTable is the table name for the Run entity.
+	// It exists in this package in order to avoid circular dependency with the "run" package.
+	RunInverseTable = "runs"
+	// RunColumn is the table column denoting the run relation/edge.
+	RunColumn = "run_id"
+)
+
+// Columns holds all SQL columns for admissionevent fields.
+var Columns = []string{
+	FieldID,
+	FieldSeq,
+	FieldKind,
+	FieldStage,
+	FieldStudentID,
+	FieldPriority,
+	FieldCompetitionType,
+	FieldOtherStudentID,
+	FieldRunID,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "admission_events"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"heading_admission_events",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// OrderOption defines the ordering options for the AdmissionEvent queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// BySeq orders the results by the seq field.
+func BySeq(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeq, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByStage orders the results by the stage field.
+func ByStage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStage, opts...).ToFunc()
+}
+
+// ByStudentID orders the results by the student_id field.
+func ByStudentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStudentID, opts...).ToFunc()
+}
+
+// ByPriority orders the results by the priority field.
+func ByPriority(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPriority, opts...).ToFunc()
+}
+
+// ByCompetitionType orders the results by the competition_type field.
+func ByCompetitionType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompetitionType, opts...).ToFunc()
+}
+
+// ByOtherStudentID orders the results by the other_student_id field.
+func ByOtherStudentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOtherStudentID, opts...).ToFunc()
+}
+
+// ByRunID orders the results by the run_id field.
+func ByRunID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRunID, opts...).ToFunc()
+}
+
+// ByHeadingField orders the results by heading field.
+func ByHeadingField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newHeadingStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByRunField orders the results by run field.
+func ByRunField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRunStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newHeadingStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(HeadingInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, HeadingTable, HeadingColumn),
+	)
+}
+func newRunStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RunInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, RunTable, RunColumn),
+	)
+}
@@ -0,0 +1,571 @@
+// Code generated by ent, DO NOT EDIT.
+
+package admissionevent
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldLTE(FieldID, id))
+}
+
+// Seq applies equality check predicate on the "seq" field. It's identical to SeqEQ.
+func Seq(v int) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldEQ(FieldSeq, v))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v core.TraceEventKind) predicate.AdmissionEvent {
+	vc := int(v)
+	return predicate.AdmissionEvent(sql.FieldEQ(FieldKind, vc))
+}
+
+// Stage applies equality check predicate on the "stage" field. It's identical to StageEQ.
+func Stage(v core.AdmissionStage) predicate.AdmissionEvent {
+	vc := int(v)
+	return predicate.AdmissionEvent(sql.FieldEQ(FieldStage, vc))
+}
+
+// StudentID applies equality check predicate on the "student_id" field. It's identical to StudentIDEQ.
+func StudentID(v string) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldEQ(FieldStudentID, v))
+}
+
+// Priority applies equality check predicate on the "priority" field. It's identical to PriorityEQ.
+func Priority(v int) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldEQ(FieldPriority, v))
+}
+
+// CompetitionType applies equality check predicate on the "competition_type" field. It's identical to CompetitionTypeEQ.
+func CompetitionType(v core.Competition) predicate.AdmissionEvent {
+	vc := int(v)
+	return predicate.AdmissionEvent(sql.FieldEQ(FieldCompetitionType, vc))
+}
+
+// OtherStudentID applies equality check predicate on the "other_student_id" field. It's identical to OtherStudentIDEQ.
+func OtherStudentID(v string) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldEQ(FieldOtherStudentID, v))
+}
+
+// RunID applies equality check predicate on the "run_id" field. It's identical to RunIDEQ.
+func RunID(v int) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldEQ(FieldRunID, v))
+}
+
+// SeqEQ applies the EQ predicate on the "seq" field.
+func SeqEQ(v int) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldEQ(FieldSeq, v))
+}
+
+// SeqNEQ applies the NEQ predicate on the "seq" field.
+func SeqNEQ(v int) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldNEQ(FieldSeq, v))
+}
+
+// SeqIn applies the In predicate on the "seq" field.
+func SeqIn(vs ...int) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldIn(FieldSeq, vs...))
+}
+
+// SeqNotIn applies the NotIn predicate on the "seq" field.
+func SeqNotIn(vs ...int) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldNotIn(FieldSeq, vs...))
+}
+
+// SeqGT applies the GT predicate on the "seq" field.
+func SeqGT(v int) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldGT(FieldSeq, v))
+}
+
+// SeqGTE applies the GTE predicate on the "seq" field.
+func SeqGTE(v int) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldGTE(FieldSeq, v))
+}
+
+// SeqLT applies the LT predicate on the "seq" field.
+func SeqLT(v int) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldLT(FieldSeq, v))
+}
+
+// SeqLTE applies the LTE predicate on the "seq" field.
+func SeqLTE(v int) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldLTE(FieldSeq, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v core.TraceEventKind) predicate.AdmissionEvent {
+	vc := int(v)
+	return predicate.AdmissionEvent(sql.FieldEQ(FieldKind, vc))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v core.TraceEventKind) predicate.AdmissionEvent {
+	vc := int(v)
+	return predicate.AdmissionEvent(sql.FieldNEQ(FieldKind, vc))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...core.TraceEventKind) predicate.AdmissionEvent {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int(vs[i])
+	}
+	return predicate.AdmissionEvent(sql.FieldIn(FieldKind, v...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...core.TraceEventKind) predicate.AdmissionEvent {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int(vs[i])
+	}
+	return predicate.AdmissionEvent(sql.FieldNotIn(FieldKind, v...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v core.TraceEventKind) predicate.AdmissionEvent {
+	vc := int(v)
+	return predicate.AdmissionEvent(sql.FieldGT(FieldKind, vc))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v core.TraceEventKind) predicate.AdmissionEvent {
+	vc := int(v)
+	return predicate.AdmissionEvent(sql.FieldGTE(FieldKind, vc))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v core.TraceEventKind) predicate.AdmissionEvent {
+	vc := int(v)
+	return predicate.AdmissionEvent(sql.FieldLT(FieldKind, vc))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v core.TraceEventKind) predicate.AdmissionEvent {
+	vc := int(v)
+	return predicate.AdmissionEvent(sql.FieldLTE(FieldKind, vc))
+}
+
+// StageEQ applies the EQ predicate on the "stage" field.
+func StageEQ(v core.AdmissionStage) predicate.AdmissionEvent {
+	vc := int(v)
+	return predicate.AdmissionEvent(sql.FieldEQ(FieldStage, vc))
+}
+
+// StageNEQ applies the NEQ predicate on the "stage" field.
+func StageNEQ(v core.AdmissionStage) predicate.AdmissionEvent {
+	vc := int(v)
+	return predicate.AdmissionEvent(sql.FieldNEQ(FieldStage, vc))
+}
+
+// StageIn applies the In predicate on the "stage" field.
+func StageIn(vs ...core.AdmissionStage) predicate.AdmissionEvent {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int(vs[i])
+	}
+	return predicate.AdmissionEvent(sql.FieldIn(FieldStage, v...))
+}
+
+// StageNotIn applies the NotIn predicate on the "stage" field.
+func StageNotIn(vs ...core.AdmissionStage) predicate.AdmissionEvent {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int(vs[i])
+	}
+	return predicate.AdmissionEvent(sql.FieldNotIn(FieldStage, v...))
+}
+
+// StageGT applies the GT predicate on the "stage" field.
+func StageGT(v core.AdmissionStage) predicate.AdmissionEvent {
+	vc := int(v)
+	return predicate.AdmissionEvent(sql.FieldGT(FieldStage, vc))
+}
+
+// StageGTE applies the GTE predicate on the "stage" field.
+func StageGTE(v core.AdmissionStage) predicate.AdmissionEvent {
+	vc := int(v)
+	return predicate.AdmissionEvent(sql.FieldGTE(FieldStage, vc))
+}
+
+// StageLT applies the LT predicate on the "stage" field.
+func StageLT(v core.AdmissionStage) predicate.AdmissionEvent {
+	vc := int(v)
+	return predicate.AdmissionEvent(sql.FieldLT(FieldStage, vc))
+}
+
+// StageLTE applies the LTE predicate on the "stage" field.
+func StageLTE(v core.AdmissionStage) predicate.AdmissionEvent {
+	vc := int(v)
+	return predicate.AdmissionEvent(sql.FieldLTE(FieldStage, vc))
+}
+
+// StageIsNil applies the IsNil predicate on the "stage" field.
+func StageIsNil() predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldIsNull(FieldStage))
+}
+
+// StageNotNil applies the NotNil predicate on the "stage" field.
+func StageNotNil() predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldNotNull(FieldStage))
+}
+
+// StudentIDEQ applies the EQ predicate on the "student_id" field.
+func StudentIDEQ(v string) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldEQ(FieldStudentID, v))
+}
+
+// StudentIDNEQ applies the NEQ predicate on the "student_id" field.
+func StudentIDNEQ(v string) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldNEQ(FieldStudentID, v))
+}
+
+// StudentIDIn applies the In predicate on the "student_id" field.
+func StudentIDIn(vs ...string) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldIn(FieldStudentID, vs...))
+}
+
+// StudentIDNotIn applies the NotIn predicate on the "student_id" field.
+func StudentIDNotIn(vs ...string) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldNotIn(FieldStudentID, vs...))
+}
+
+// StudentIDGT applies the GT predicate on the "student_id" field.
+func StudentIDGT(v string) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldGT(FieldStudentID, v))
+}
+
+// StudentIDGTE applies the GTE predicate on the "student_id" field.
+func StudentIDGTE(v string) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldGTE(FieldStudentID, v))
+}
+
+// StudentIDLT applies the LT predicate on the "student_id" field.
+func StudentIDLT(v string) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldLT(FieldStudentID, v))
+}
+
+// StudentIDLTE applies the LTE predicate on the "student_id" field.
+func StudentIDLTE(v string) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldLTE(FieldStudentID, v))
+}
+
+// StudentIDContains applies the Contains predicate on the "student_id" field.
+func StudentIDContains(v string) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldContains(FieldStudentID, v))
+}
+
+// StudentIDHasPrefix applies the HasPrefix predicate on the "student_id" field.
+func StudentIDHasPrefix(v string) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldHasPrefix(FieldStudentID, v))
+}
+
+// StudentIDHasSuffix applies the HasSuffix predicate on the "student_id" field.
+func StudentIDHasSuffix(v string) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldHasSuffix(FieldStudentID, v))
+}
+
+// StudentIDEqualFold applies the EqualFold predicate on the "student_id" field.
+func StudentIDEqualFold(v string) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldEqualFold(FieldStudentID, v))
+}
+
+// StudentIDContainsFold applies the ContainsFold predicate on the "student_id" field.
+func StudentIDContainsFold(v string) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldContainsFold(FieldStudentID, v))
+}
+
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v int) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldEQ(FieldPriority, v))
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v int) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldNEQ(FieldPriority, v))
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...int) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldIn(FieldPriority, vs...))
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...int) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldNotIn(FieldPriority, vs...))
+}
+
+// PriorityGT applies the GT predicate on the "priority" field.
+func PriorityGT(v int) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldGT(FieldPriority, v))
+}
+
+// PriorityGTE applies the GTE predicate on the "priority" field.
+func PriorityGTE(v int) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldGTE(FieldPriority, v))
+}
+
+// PriorityLT applies the LT predicate on the "priority" field.
+func PriorityLT(v int) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldLT(FieldPriority, v))
+}
+
+// PriorityLTE applies the LTE predicate on the "priority" field.
+func PriorityLTE(v int) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldLTE(FieldPriority, v))
+}
+
+// CompetitionTypeEQ applies the EQ predicate on the "competition_type" field.
+func CompetitionTypeEQ(v core.Competition) predicate.AdmissionEvent {
+	vc := int(v)
+	return predicate.AdmissionEvent(sql.FieldEQ(FieldCompetitionType, vc))
+}
+
+// CompetitionTypeNEQ applies the NEQ predicate on the "competition_type" field.
+func CompetitionTypeNEQ(v core.Competition) predicate.AdmissionEvent {
+	vc := int(v)
+	return predicate.AdmissionEvent(sql.FieldNEQ(FieldCompetitionType, vc))
+}
+
+// CompetitionTypeIn applies the In predicate on the "competition_type" field.
+func CompetitionTypeIn(vs ...core.Competition) predicate.AdmissionEvent {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int(vs[i])
+	}
+	return predicate.AdmissionEvent(sql.FieldIn(FieldCompetitionType, v...))
+}
+
+// CompetitionTypeNotIn applies the NotIn predicate on the "competition_type" field.
+func CompetitionTypeNotIn(vs ...core.Competition) predicate.AdmissionEvent {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int(vs[i])
+	}
+	return predicate.AdmissionEvent(sql.FieldNotIn(FieldCompetitionType, v...))
+}
+
+// CompetitionTypeGT applies the GT predicate on the "competition_type" field.
+func CompetitionTypeGT(v core.Competition) predicate.AdmissionEvent {
+	vc := int(v)
+	return predicate.AdmissionEvent(sql.FieldGT(FieldCompetitionType, vc))
+}
+
+// CompetitionTypeGTE applies the GTE predicate on the "competition_type" field.
+func CompetitionTypeGTE(v core.Competition) predicate.AdmissionEvent {
+	vc := int(v)
+	return predicate.AdmissionEvent(sql.FieldGTE(FieldCompetitionType, vc))
+}
+
+// CompetitionTypeLT applies the LT predicate on the "competition_type" field.
+func CompetitionTypeLT(v core.Competition) predicate.AdmissionEvent {
+	vc := int(v)
+	return predicate.AdmissionEvent(sql.FieldLT(FieldCompetitionType, vc))
+}
+
+// CompetitionTypeLTE applies the LTE predicate on the "competition_type" field.
+func CompetitionTypeLTE(v core.Competition) predicate.AdmissionEvent {
+	vc := int(v)
+	return predicate.AdmissionEvent(sql.FieldLTE(FieldCompetitionType, vc))
+}
+
+// OtherStudentIDEQ applies the EQ predicate on the "other_student_id" field.
+func OtherStudentIDEQ(v string) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldEQ(FieldOtherStudentID, v))
+}
+
+// OtherStudentIDNEQ applies the NEQ predicate on the "other_student_id" field.
+func OtherStudentIDNEQ(v string) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldNEQ(FieldOtherStudentID, v))
+}
+
+// OtherStudentIDIn applies the In predicate on the "other_student_id" field.
+func OtherStudentIDIn(vs ...string) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldIn(FieldOtherStudentID, vs...))
+}
+
+// OtherStudentIDNotIn applies the NotIn predicate on the "other_student_id" field.
+func OtherStudentIDNotIn(vs ...string) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldNotIn(FieldOtherStudentID, vs...))
+}
+
+// OtherStudentIDGT applies the GT predicate on the "other_student_id" field.
+func OtherStudentIDGT(v string) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldGT(FieldOtherStudentID, v))
+}
+
+// OtherStudentIDGTE applies the GTE predicate on the "other_student_id" field.
+func OtherStudentIDGTE(v string) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldGTE(FieldOtherStudentID, v))
+}
+
+// OtherStudentIDLT applies the LT predicate on the "other_student_id" field.
+func OtherStudentIDLT(v string) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldLT(FieldOtherStudentID, v))
+}
+
+// OtherStudentIDLTE applies the LTE predicate on the "other_student_id" field.
+func OtherStudentIDLTE(v string) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldLTE(FieldOtherStudentID, v))
+}
+
+// OtherStudentIDContains applies the Contains predicate on the "other_student_id" field.
+func OtherStudentIDContains(v string) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldContains(FieldOtherStudentID, v))
+}
+
+// OtherStudentIDHasPrefix applies the HasPrefix predicate on the "other_student_id" field.
+func OtherStudentIDHasPrefix(v string) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldHasPrefix(FieldOtherStudentID, v))
+}
+
+// OtherStudentIDHasSuffix applies the HasSuffix predicate on the "other_student_id" field.
+func OtherStudentIDHasSuffix(v string) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldHasSuffix(FieldOtherStudentID, v))
+}
+
+// OtherStudentIDIsNil applies the IsNil predicate on the "other_student_id" field.
+func OtherStudentIDIsNil() predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldIsNull(FieldOtherStudentID))
+}
+
+// OtherStudentIDNotNil applies the NotNil predicate on the "other_student_id" field.
+func OtherStudentIDNotNil() predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldNotNull(FieldOtherStudentID))
+}
+
+// OtherStudentIDEqualFold applies the EqualFold predicate on the "other_student_id" field.
+func OtherStudentIDEqualFold(v string) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldEqualFold(FieldOtherStudentID, v))
+}
+
+// OtherStudentIDContainsFold applies the ContainsFold predicate on the "other_student_id" field.
+func OtherStudentIDContainsFold(v string) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldContainsFold(FieldOtherStudentID, v))
+}
+
+// RunIDEQ applies the EQ predicate on the "run_id" field.
+func RunIDEQ(v int) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldEQ(FieldRunID, v))
+}
+
+// RunIDNEQ applies the NEQ predicate on the "run_id" field.
+func RunIDNEQ(v int) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldNEQ(FieldRunID, v))
+}
+
+// RunIDIn applies the In predicate on the "run_id" field.
+func RunIDIn(vs ...int) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldIn(FieldRunID, vs...))
+}
+
+// RunIDNotIn applies the NotIn predicate on the "run_id" field.
+func RunIDNotIn(vs ...int) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.FieldNotIn(FieldRunID, vs...))
+}
+
+// HasHeading applies the HasEdge predicate on the "heading" edge.
+func HasHeading() predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, HeadingTable, HeadingColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasHeadingWith applies the HasEdge predicate on the "heading" edge with a given conditions (other predicates).
+func HasHeadingWith(preds ...predicate.Heading) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(func(s *sql.Selector) {
+		step := newHeadingStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRun applies the HasEdge predicate on the "run" edge.
+func HasRun() predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, RunTable, RunColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRunWith applies the HasEdge predicate on the "run" edge with a given conditions (other predicates).
+func HasRunWith(preds ...predicate.Run) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(func(s *sql.Selector) {
+		step := newRunStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AdmissionEvent) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AdmissionEvent) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AdmissionEvent) predicate.AdmissionEvent {
+	return predicate.AdmissionEvent(sql.NotPredicates(p))
+}
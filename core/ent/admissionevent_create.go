@@ -0,0 +1,339 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/ent/admissionevent"
+	"github.com/trueegorletov/analabit/core/ent/heading"
+	"github.com/trueegorletov/analabit/core/ent/run"
+)
+
+// AdmissionEventCreate is the builder for creating a AdmissionEvent entity.
+type AdmissionEventCreate struct {
+	config
+	mutation *AdmissionEventMutation
+	hooks    []Hook
+}
+
+// SetSeq sets the "seq" field.
+func (aec *AdmissionEventCreate) SetSeq(i int) *AdmissionEventCreate {
+	aec.mutation.SetSeq(i)
+	return aec
+}
+
+// SetKind sets the "kind" field.
+func (aec *AdmissionEventCreate) SetKind(cek core.TraceEventKind) *AdmissionEventCreate {
+	aec.mutation.SetKind(cek)
+	return aec
+}
+
+// SetStage sets the "stage" field.
+func (aec *AdmissionEventCreate) SetStage(cs core.AdmissionStage) *AdmissionEventCreate {
+	aec.mutation.SetStage(cs)
+	return aec
+}
+
+// SetNillableStage sets the "stage" field if the given value is not nil.
+func (aec *AdmissionEventCreate) SetNillableStage(cs *core.AdmissionStage) *AdmissionEventCreate {
+	if cs != nil {
+		aec.SetStage(*cs)
+	}
+	return aec
+}
+
+// SetStudentID sets the "student_id" field.
+func (aec *AdmissionEventCreate) SetStudentID(s string) *AdmissionEventCreate {
+	aec.mutation.SetStudentID(s)
+	return aec
+}
+
+// SetPriority sets the "priority" field.
+func (aec *AdmissionEventCreate) SetPriority(i int) *AdmissionEventCreate {
+	aec.mutation.SetPriority(i)
+	return aec
+}
+
+// SetCompetitionType sets the "competition_type" field.
+func (aec *AdmissionEventCreate) SetCompetitionType(c core.Competition) *AdmissionEventCreate {
+	aec.mutation.SetCompetitionType(c)
+	return aec
+}
+
+// SetOtherStudentID sets the "other_student_id" field.
+func (aec *AdmissionEventCreate) SetOtherStudentID(s string) *AdmissionEventCreate {
+	aec.mutation.SetOtherStudentID(s)
+	return aec
+}
+
+// SetNillableOtherStudentID sets the "other_student_id" field if the given value is not nil.
+func (aec *AdmissionEventCreate) SetNillableOtherStudentID(s *string) *AdmissionEventCreate {
+	if s != nil {
+		aec.SetOtherStudentID(*s)
+	}
+	return aec
+}
+
+// SetRunID sets the "run_id" field.
+func (aec *AdmissionEventCreate) SetRunID(i int) *AdmissionEventCreate {
+	aec.mutation.SetRunID(i)
+	return aec
+}
+
+// SetHeadingID sets the "heading" edge to the Heading entity by ID.
+func (aec *AdmissionEventCreate) SetHeadingID(id int) *AdmissionEventCreate {
+	aec.mutation.SetHeadingID(id)
+	return aec
+}
+
+// SetHeading sets the "heading" edge to the Heading entity.
+func (aec *AdmissionEventCreate) SetHeading(h *Heading) *AdmissionEventCreate {
+	return aec.SetHeadingID(h.ID)
+}
+
+// SetRun sets the "run" edge to the Run entity.
+func (aec *AdmissionEventCreate) SetRun(r *Run) *AdmissionEventCreate {
+	return aec.SetRunID(r.ID)
+}
+
+// Mutation returns the AdmissionEventMutation object of the builder.
+func (aec *AdmissionEventCreate) Mutation() *AdmissionEventMutation {
+	return aec.mutation
+}
+
+// Save creates the AdmissionEvent in the database.
+func (aec *AdmissionEventCreate) Save(ctx context.Context) (*AdmissionEvent, error) {
+	return withHooks(ctx, aec.sqlSave, aec.mutation, aec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (aec *AdmissionEventCreate) SaveX(ctx context.Context) *AdmissionEvent {
+	v, err := aec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aec *AdmissionEventCreate) Exec(ctx context.Context) error {
+	_, err := aec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aec *AdmissionEventCreate) ExecX(ctx context.Context) {
+	if err := aec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aec *AdmissionEventCreate) check() error {
+	if _, ok := aec.mutation.Seq(); !ok {
+		return &ValidationError{Name: "seq", err: errors.New(`ent: missing required field "AdmissionEvent.seq"`)}
+	}
+	if _, ok := aec.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "AdmissionEvent.kind"`)}
+	}
+	if _, ok := aec.mutation.StudentID(); !ok {
+		return &ValidationError{Name: "student_id", err: errors.New(`ent: missing required field "AdmissionEvent.student_id"`)}
+	}
+	if _, ok := aec.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "AdmissionEvent.priority"`)}
+	}
+	if _, ok := aec.mutation.CompetitionType(); !ok {
+		return &ValidationError{Name: "competition_type", err: errors.New(`ent: missing required field "AdmissionEvent.competition_type"`)}
+	}
+	if _, ok := aec.mutation.RunID(); !ok {
+		return &ValidationError{Name: "run_id", err: errors.New(`ent: missing required field "AdmissionEvent.run_id"`)}
+	}
+	if len(aec.mutation.HeadingIDs()) == 0 {
+		return &ValidationError{Name: "heading", err: errors.New(`ent: missing required edge "AdmissionEvent.heading"`)}
+	}
+	if len(aec.mutation.RunIDs()) == 0 {
+		return &ValidationError{Name: "run", err: errors.New(`ent: missing required edge "AdmissionEvent.run"`)}
+	}
+	return nil
+}
+
+func (aec *AdmissionEventCreate) sqlSave(ctx context.Context) (*AdmissionEvent, error) {
+	if err := aec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := aec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, aec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	aec.mutation.id = &_node.ID
+	aec.mutation.done = true
+	return _node, nil
+}
+
+func (aec *AdmissionEventCreate) createSpec() (*AdmissionEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AdmissionEvent{config: aec.config}
+		_spec = sqlgraph.NewCreateSpec(admissionevent.Table, sqlgraph.NewFieldSpec(admissionevent.FieldID, field.TypeInt))
+	)
+	if value, ok := aec.mutation.Seq(); ok {
+		_spec.SetField(admissionevent.FieldSeq, field.TypeInt, value)
+		_node.Seq = value
+	}
+	if value, ok := aec.mutation.Kind(); ok {
+		_spec.SetField(admissionevent.FieldKind, field.TypeInt, value)
+		_node.Kind = value
+	}
+	if value, ok := aec.mutation.Stage(); ok {
+		_spec.SetField(admissionevent.FieldStage, field.TypeInt, value)
+		_node.Stage = value
+	}
+	if value, ok := aec.mutation.StudentID(); ok {
+		_spec.SetField(admissionevent.FieldStudentID, field.TypeString, value)
+		_node.StudentID = value
+	}
+	if value, ok := aec.mutation.Priority(); ok {
+		_spec.SetField(admissionevent.FieldPriority, field.TypeInt, value)
+		_node.Priority = value
+	}
+	if value, ok := aec.mutation.CompetitionType(); ok {
+		_spec.SetField(admissionevent.FieldCompetitionType, field.TypeInt, value)
+		_node.CompetitionType = value
+	}
+	if value, ok := aec.mutation.OtherStudentID(); ok {
+		_spec.SetField(admissionevent.FieldOtherStudentID, field.TypeString, value)
+		_node.OtherStudentID = value
+	}
+	if nodes := aec.mutation.HeadingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   admissionevent.HeadingTable,
+			Columns: []string{admissionevent.HeadingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(heading.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.heading_admission_events = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := aec.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   admissionevent.RunTable,
+			Columns: []string{admissionevent.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(run.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RunID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AdmissionEventCreateBulk is the builder for creating many AdmissionEvent entities in bulk.
+type AdmissionEventCreateBulk struct {
+	config
+	err      error
+	builders []*AdmissionEventCreate
+}
+
+// Save creates the AdmissionEvent entities in the database.
+func (aecb *AdmissionEventCreateBulk) Save(ctx context.Context) ([]*AdmissionEvent, error) {
+	if aecb.err != nil {
+		return nil, aecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(aecb.builders))
+	nodes := make([]*AdmissionEvent, len(aecb.builders))
+	mutators := make([]Mutator, len(aecb.builders))
+	for i := range aecb.builders {
+		func(i int, root context.Context) {
+			builder := aecb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AdmissionEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, aecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, aecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, aecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aecb *AdmissionEventCreateBulk) SaveX(ctx context.Context) []*AdmissionEvent {
+	v, err := aecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aecb *AdmissionEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := aecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aecb *AdmissionEventCreateBulk) ExecX(ctx context.Context) {
+	if err := aecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,780 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/ent/admissionevent"
+	"github.com/trueegorletov/analabit/core/ent/heading"
+	"github.com/trueegorletov/analabit/core/ent/predicate"
+	"github.com/trueegorletov/analabit/core/ent/run"
+)
+
+// AdmissionEventUpdate is the builder for updating AdmissionEvent entities.
+type AdmissionEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AdmissionEventMutation
+}
+
+// Where appends a list predicates to the AdmissionEventUpdate builder.
+func (aeu *AdmissionEventUpdate) Where(ps ...predicate.AdmissionEvent) *AdmissionEventUpdate {
+	aeu.mutation.Where(ps...)
+	return aeu
+}
+
+// SetSeq sets the "seq" field.
+func (aeu *AdmissionEventUpdate) SetSeq(i int) *AdmissionEventUpdate {
+	aeu.mutation.ResetSeq()
+	aeu.mutation.SetSeq(i)
+	return aeu
+}
+
+// SetNillableSeq sets the "seq" field if the given value is not nil.
+func (aeu *AdmissionEventUpdate) SetNillableSeq(i *int) *AdmissionEventUpdate {
+	if i != nil {
+		aeu.SetSeq(*i)
+	}
+	return aeu
+}
+
+// AddSeq adds i to the "seq" field.
+func (aeu *AdmissionEventUpdate) AddSeq(i int) *AdmissionEventUpdate {
+	aeu.mutation.AddSeq(i)
+	return aeu
+}
+
+// SetKind sets the "kind" field.
+func (aeu *AdmissionEventUpdate) SetKind(cek core.TraceEventKind) *AdmissionEventUpdate {
+	aeu.mutation.ResetKind()
+	aeu.mutation.SetKind(cek)
+	return aeu
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (aeu *AdmissionEventUpdate) SetNillableKind(cek *core.TraceEventKind) *AdmissionEventUpdate {
+	if cek != nil {
+		aeu.SetKind(*cek)
+	}
+	return aeu
+}
+
+// AddKind adds cek to the "kind" field.
+func (aeu *AdmissionEventUpdate) AddKind(cek core.TraceEventKind) *AdmissionEventUpdate {
+	aeu.mutation.AddKind(cek)
+	return aeu
+}
+
+// SetStage sets the "stage" field.
+func (aeu *AdmissionEventUpdate) SetStage(cs core.AdmissionStage) *AdmissionEventUpdate {
+	aeu.mutation.ResetStage()
+	aeu.mutation.SetStage(cs)
+	return aeu
+}
+
+// SetNillableStage sets the "stage" field if the given value is not nil.
+func (aeu *AdmissionEventUpdate) SetNillableStage(cs *core.AdmissionStage) *AdmissionEventUpdate {
+	if cs != nil {
+		aeu.SetStage(*cs)
+	}
+	return aeu
+}
+
+// AddStage adds cs to the "stage" field.
+func (aeu *AdmissionEventUpdate) AddStage(cs core.AdmissionStage) *AdmissionEventUpdate {
+	aeu.mutation.AddStage(cs)
+	return aeu
+}
+
+// ClearStage clears the value of the "stage" field.
+func (aeu *AdmissionEventUpdate) ClearStage() *AdmissionEventUpdate {
+	aeu.mutation.ClearStage()
+	return aeu
+}
+
+// SetStudentID sets the "student_id" field.
+func (aeu *AdmissionEventUpdate) SetStudentID(s string) *AdmissionEventUpdate {
+	aeu.mutation.SetStudentID(s)
+	return aeu
+}
+
+// SetNillableStudentID sets the "student_id" field if the given value is not nil.
+func (aeu *AdmissionEventUpdate) SetNillableStudentID(s *string) *AdmissionEventUpdate {
+	if s != nil {
+		aeu.SetStudentID(*s)
+	}
+	return aeu
+}
+
+// SetPriority sets the "priority" field.
+func (aeu *AdmissionEventUpdate) SetPriority(i int) *AdmissionEventUpdate {
+	aeu.mutation.ResetPriority()
+	aeu.mutation.SetPriority(i)
+	return aeu
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (aeu *AdmissionEventUpdate) SetNillablePriority(i *int) *AdmissionEventUpdate {
+	if i != nil {
+		aeu.SetPriority(*i)
+	}
+	return aeu
+}
+
+// AddPriority adds i to the "priority" field.
+func (aeu *AdmissionEventUpdate) AddPriority(i int) *AdmissionEventUpdate {
+	aeu.mutation.AddPriority(i)
+	return aeu
+}
+
+// SetCompetitionType sets the "competition_type" field.
+func (aeu *AdmissionEventUpdate) SetCompetitionType(c core.Competition) *AdmissionEventUpdate {
+	aeu.mutation.ResetCompetitionType()
+	aeu.mutation.SetCompetitionType(c)
+	return aeu
+}
+
+// SetNillableCompetitionType sets the "competition_type" field if the given value is not nil.
+func (aeu *AdmissionEventUpdate) SetNillableCompetitionType(c *core.Competition) *AdmissionEventUpdate {
+	if c != nil {
+		aeu.SetCompetitionType(*c)
+	}
+	return aeu
+}
+
+// AddCompetitionType adds c to the "competition_type" field.
+func (aeu *AdmissionEventUpdate) AddCompetitionType(c core.Competition) *AdmissionEventUpdate {
+	aeu.mutation.AddCompetitionType(c)
+	return aeu
+}
+
+// SetOtherStudentID sets the "other_student_id" field.
+func (aeu *AdmissionEventUpdate) SetOtherStudentID(s string) *AdmissionEventUpdate {
+	aeu.mutation.SetOtherStudentID(s)
+	return aeu
+}
+
+// SetNillableOtherStudentID sets the "other_student_id" field if the given value is not nil.
+func (aeu *AdmissionEventUpdate) SetNillableOtherStudentID(s *string) *AdmissionEventUpdate {
+	if s != nil {
+		aeu.SetOtherStudentID(*s)
+	}
+	return aeu
+}
+
+// ClearOtherStudentID clears the value of the "other_student_id" field.
+func (aeu *AdmissionEventUpdate) ClearOtherStudentID() *AdmissionEventUpdate {
+	aeu.mutation.ClearOtherStudentID()
+	return aeu
+}
+
+// SetRunID sets the "run_id" field.
+func (aeu *AdmissionEventUpdate) SetRunID(i int) *AdmissionEventUpdate {
+	aeu.mutation.SetRunID(i)
+	return aeu
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (aeu *AdmissionEventUpdate) SetNillableRunID(i *int) *AdmissionEventUpdate {
+	if i != nil {
+		aeu.SetRunID(*i)
+	}
+	return aeu
+}
+
+// SetHeadingID sets the "heading" edge to the Heading entity by ID.
+func (aeu *AdmissionEventUpdate) SetHeadingID(id int) *AdmissionEventUpdate {
+	aeu.mutation.SetHeadingID(id)
+	return aeu
+}
+
+// SetHeading sets the "heading" edge to the Heading entity.
+func (aeu *AdmissionEventUpdate) SetHeading(h *Heading) *AdmissionEventUpdate {
+	return aeu.SetHeadingID(h.ID)
+}
+
+// SetRun sets the "run" edge to the Run entity.
+func (aeu *AdmissionEventUpdate) SetRun(r *Run) *AdmissionEventUpdate {
+	return aeu.SetRunID(r.ID)
+}
+
+// Mutation returns the AdmissionEventMutation object of the builder.
+func (aeu *AdmissionEventUpdate) Mutation() *AdmissionEventMutation {
+	return aeu.mutation
+}
+
+// ClearHeading clears the "heading" edge to the Heading entity.
+func (aeu *AdmissionEventUpdate) ClearHeading() *AdmissionEventUpdate {
+	aeu.mutation.ClearHeading()
+	return aeu
+}
+
+// ClearRun clears the "run" edge to the Run entity.
+func (aeu *AdmissionEventUpdate) ClearRun() *AdmissionEventUpdate {
+	aeu.mutation.ClearRun()
+	return aeu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (aeu *AdmissionEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, aeu.sqlSave, aeu.mutation, aeu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aeu *AdmissionEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := aeu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (aeu *AdmissionEventUpdate) Exec(ctx context.Context) error {
+	_, err := aeu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aeu *AdmissionEventUpdate) ExecX(ctx context.Context) {
+	if err := aeu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aeu *AdmissionEventUpdate) check() error {
+	if aeu.mutation.HeadingCleared() && len(aeu.mutation.HeadingIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AdmissionEvent.heading"`)
+	}
+	if aeu.mutation.RunCleared() && len(aeu.mutation.RunIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AdmissionEvent.run"`)
+	}
+	return nil
+}
+
+func (aeu *AdmissionEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := aeu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(admissionevent.Table, admissionevent.Columns, sqlgraph.NewFieldSpec(admissionevent.FieldID, field.TypeInt))
+	if ps := aeu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aeu.mutation.Seq(); ok {
+		_spec.SetField(admissionevent.FieldSeq, field.TypeInt, value)
+	}
+	if value, ok := aeu.mutation.AddedSeq(); ok {
+		_spec.AddField(admissionevent.FieldSeq, field.TypeInt, value)
+	}
+	if value, ok := aeu.mutation.Kind(); ok {
+		_spec.SetField(admissionevent.FieldKind, field.TypeInt, value)
+	}
+	if value, ok := aeu.mutation.AddedKind(); ok {
+		_spec.AddField(admissionevent.FieldKind, field.TypeInt, value)
+	}
+	if value, ok := aeu.mutation.Stage(); ok {
+		_spec.SetField(admissionevent.FieldStage, field.TypeInt, value)
+	}
+	if value, ok := aeu.mutation.AddedStage(); ok {
+		_spec.AddField(admissionevent.FieldStage, field.TypeInt, value)
+	}
+	if aeu.mutation.StageCleared() {
+		_spec.ClearField(admissionevent.FieldStage, field.TypeInt)
+	}
+	if value, ok := aeu.mutation.StudentID(); ok {
+		_spec.SetField(admissionevent.FieldStudentID, field.TypeString, value)
+	}
+	if value, ok := aeu.mutation.Priority(); ok {
+		_spec.SetField(admissionevent.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := aeu.mutation.AddedPriority(); ok {
+		_spec.AddField(admissionevent.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := aeu.mutation.CompetitionType(); ok {
+		_spec.SetField(admissionevent.FieldCompetitionType, field.TypeInt, value)
+	}
+	if value, ok := aeu.mutation.AddedCompetitionType(); ok {
+		_spec.AddField(admissionevent.FieldCompetitionType, field.TypeInt, value)
+	}
+	if value, ok := aeu.mutation.OtherStudentID(); ok {
+		_spec.SetField(admissionevent.FieldOtherStudentID, field.TypeString, value)
+	}
+	if aeu.mutation.OtherStudentIDCleared() {
+		_spec.ClearField(admissionevent.FieldOtherStudentID, field.TypeString)
+	}
+	if aeu.mutation.HeadingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   admissionevent.HeadingTable,
+			Columns: []string{admissionevent.HeadingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(heading.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aeu.mutation.HeadingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   admissionevent.HeadingTable,
+			Columns: []string{admissionevent.HeadingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(heading.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if aeu.mutation.RunCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   admissionevent.RunTable,
+			Columns: []string{admissionevent.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(run.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aeu.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   admissionevent.RunTable,
+			Columns: []string{admissionevent.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(run.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, aeu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{admissionevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	aeu.mutation.done = true
+	return n, nil
+}
+
+// AdmissionEventUpdateOne is the builder for updating a single AdmissionEvent entity.
+type AdmissionEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AdmissionEventMutation
+}
+
+// SetSeq sets the "seq" field.
+func (aeuo *AdmissionEventUpdateOne) SetSeq(i int) *AdmissionEventUpdateOne {
+	aeuo.mutation.ResetSeq()
+	aeuo.mutation.SetSeq(i)
+	return aeuo
+}
+
+// SetNillableSeq sets the "seq" field if the given value is not nil.
+func (aeuo *AdmissionEventUpdateOne) SetNillableSeq(i *int) *AdmissionEventUpdateOne {
+	if i != nil {
+		aeuo.SetSeq(*i)
+	}
+	return aeuo
+}
+
+// AddSeq adds i to the "seq" field.
+func (aeuo *AdmissionEventUpdateOne) AddSeq(i int) *AdmissionEventUpdateOne {
+	aeuo.mutation.AddSeq(i)
+	return aeuo
+}
+
+// SetKind sets the "kind" field.
+func (aeuo *AdmissionEventUpdateOne) SetKind(cek core.TraceEventKind) *AdmissionEventUpdateOne {
+	aeuo.mutation.ResetKind()
+	aeuo.mutation.SetKind(cek)
+	return aeuo
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (aeuo *AdmissionEventUpdateOne) SetNillableKind(cek *core.TraceEventKind) *AdmissionEventUpdateOne {
+	if cek != nil {
+		aeuo.SetKind(*cek)
+	}
+	return aeuo
+}
+
+// AddKind adds cek to the "kind" field.
+func (aeuo *AdmissionEventUpdateOne) AddKind(cek core.TraceEventKind) *AdmissionEventUpdateOne {
+	aeuo.mutation.AddKind(cek)
+	return aeuo
+}
+
+// SetStage sets the "stage" field.
+func (aeuo *AdmissionEventUpdateOne) SetStage(cs core.AdmissionStage) *AdmissionEventUpdateOne {
+	aeuo.mutation.ResetStage()
+	aeuo.mutation.SetStage(cs)
+	return aeuo
+}
+
+// SetNillableStage sets the "stage" field if the given value is not nil.
+func (aeuo *AdmissionEventUpdateOne) SetNillableStage(cs *core.AdmissionStage) *AdmissionEventUpdateOne {
+	if cs != nil {
+		aeuo.SetStage(*cs)
+	}
+	return aeuo
+}
+
+// AddStage adds cs to the "stage" field.
+func (aeuo *AdmissionEventUpdateOne) AddStage(cs core.AdmissionStage) *AdmissionEventUpdateOne {
+	aeuo.mutation.AddStage(cs)
+	return aeuo
+}
+
+// ClearStage clears the value of the "stage" field.
+func (aeuo *AdmissionEventUpdateOne) ClearStage() *AdmissionEventUpdateOne {
+	aeuo.mutation.ClearStage()
+	return aeuo
+}
+
+// SetStudentID sets the "student_id" field.
+func (aeuo *AdmissionEventUpdateOne) SetStudentID(s string) *AdmissionEventUpdateOne {
+	aeuo.mutation.SetStudentID(s)
+	return aeuo
+}
+
+// SetNillableStudentID sets the "student_id" field if the given value is not nil.
+func (aeuo *AdmissionEventUpdateOne) SetNillableStudentID(s *string) *AdmissionEventUpdateOne {
+	if s != nil {
+		aeuo.SetStudentID(*s)
+	}
+	return aeuo
+}
+
+// SetPriority sets the "priority" field.
+func (aeuo *AdmissionEventUpdateOne) SetPriority(i int) *AdmissionEventUpdateOne {
+	aeuo.mutation.ResetPriority()
+	aeuo.mutation.SetPriority(i)
+	return aeuo
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (aeuo *AdmissionEventUpdateOne) SetNillablePriority(i *int) *AdmissionEventUpdateOne {
+	if i != nil {
+		aeuo.SetPriority(*i)
+	}
+	return aeuo
+}
+
+// AddPriority adds i to the "priority" field.
+func (aeuo *AdmissionEventUpdateOne) AddPriority(i int) *AdmissionEventUpdateOne {
+	aeuo.mutation.AddPriority(i)
+	return aeuo
+}
+
+// SetCompetitionType sets the "competition_type" field.
+func (aeuo *AdmissionEventUpdateOne) SetCompetitionType(c core.Competition) *AdmissionEventUpdateOne {
+	aeuo.mutation.ResetCompetitionType()
+	aeuo.mutation.SetCompetitionType(c)
+	return aeuo
+}
+
+// SetNillableCompetitionType sets the "competition_type" field if the given value is not nil.
+func (aeuo *AdmissionEventUpdateOne) SetNillableCompetitionType(c *core.Competition) *AdmissionEventUpdateOne {
+	if c != nil {
+		aeuo.SetCompetitionType(*c)
+	}
+	return aeuo
+}
+
+// AddCompetitionType adds c to the "competition_type" field.
+func (aeuo *AdmissionEventUpdateOne) AddCompetitionType(c core.Competition) *AdmissionEventUpdateOne {
+	aeuo.mutation.AddCompetitionType(c)
+	return aeuo
+}
+
+// SetOtherStudentID sets the "other_student_id" field.
+func (aeuo *AdmissionEventUpdateOne) SetOtherStudentID(s string) *AdmissionEventUpdateOne {
+	aeuo.mutation.SetOtherStudentID(s)
+	return aeuo
+}
+
+// SetNillableOtherStudentID sets the "other_student_id" field if the given value is not nil.
+func (aeuo *AdmissionEventUpdateOne) SetNillableOtherStudentID(s *string) *AdmissionEventUpdateOne {
+	if s != nil {
+		aeuo.SetOtherStudentID(*s)
+	}
+	return aeuo
+}
+
+// ClearOtherStudentID clears the value of the "other_student_id" field.
+func (aeuo *AdmissionEventUpdateOne) ClearOtherStudentID() *AdmissionEventUpdateOne {
+	aeuo.mutation.ClearOtherStudentID()
+	return aeuo
+}
+
+// SetRunID sets the "run_id" field.
+func (aeuo *AdmissionEventUpdateOne) SetRunID(i int) *AdmissionEventUpdateOne {
+	aeuo.mutation.SetRunID(i)
+	return aeuo
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (aeuo *AdmissionEventUpdateOne) SetNillableRunID(i *int) *AdmissionEventUpdateOne {
+	if i != nil {
+		aeuo.SetRunID(*i)
+	}
+	return aeuo
+}
+
+// SetHeadingID sets the "heading" edge to the Heading entity by ID.
+func (aeuo *AdmissionEventUpdateOne) SetHeadingID(id int) *AdmissionEventUpdateOne {
+	aeuo.mutation.SetHeadingID(id)
+	return aeuo
+}
+
+// SetHeading sets the "heading" edge to the Heading entity.
+func (aeuo *AdmissionEventUpdateOne) SetHeading(h *Heading) *AdmissionEventUpdateOne {
+	return aeuo.SetHeadingID(h.ID)
+}
+
+// SetRun sets the "run" edge to the Run entity.
+func (aeuo *AdmissionEventUpdateOne) SetRun(r *Run) *AdmissionEventUpdateOne {
+	return aeuo.SetRunID(r.ID)
+}
+
+// Mutation returns the AdmissionEventMutation object of the builder.
+func (aeuo *AdmissionEventUpdateOne) Mutation() *AdmissionEventMutation {
+	return aeuo.mutation
+}
+
+// ClearHeading clears the "heading" edge to the Heading entity.
+func (aeuo *AdmissionEventUpdateOne) ClearHeading() *AdmissionEventUpdateOne {
+	aeuo.mutation.ClearHeading()
+	return aeuo
+}
+
+// ClearRun clears the "run" edge to the Run entity.
+func (aeuo *AdmissionEventUpdateOne) ClearRun() *AdmissionEventUpdateOne {
+	aeuo.mutation.ClearRun()
+	return aeuo
+}
+
+// Where appends a list predicates to the AdmissionEventUpdate builder.
+func (aeuo *AdmissionEventUpdateOne) Where(ps ...predicate.AdmissionEvent) *AdmissionEventUpdateOne {
+	aeuo.mutation.Where(ps...)
+	return aeuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aeuo *AdmissionEventUpdateOne) Select(field string, fields ...string) *AdmissionEventUpdateOne {
+	aeuo.fields = append([]string{field}, fields...)
+	return aeuo
+}
+
+// Save executes the query and returns the updated AdmissionEvent entity.
+func (aeuo *AdmissionEventUpdateOne) Save(ctx context.Context) (*AdmissionEvent, error) {
+	return withHooks(ctx, aeuo.sqlSave, aeuo.mutation, aeuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aeuo *AdmissionEventUpdateOne) SaveX(ctx context.Context) *AdmissionEvent {
+	node, err := aeuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aeuo *AdmissionEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := aeuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aeuo *AdmissionEventUpdateOne) ExecX(ctx context.Context) {
+	if err := aeuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aeuo *AdmissionEventUpdateOne) check() error {
+	if aeuo.mutation.HeadingCleared() && len(aeuo.mutation.HeadingIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AdmissionEvent.heading"`)
+	}
+	if aeuo.mutation.RunCleared() && len(aeuo.mutation.RunIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AdmissionEvent.run"`)
+	}
+	return nil
+}
+
+func (aeuo *AdmissionEventUpdateOne) sqlSave(ctx context.Context) (_node *AdmissionEvent, err error) {
+	if err := aeuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(admissionevent.Table, admissionevent.Columns, sqlgraph.NewFieldSpec(admissionevent.FieldID, field.TypeInt))
+	id, ok := aeuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AdmissionEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aeuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, admissionevent.FieldID)
+		for _, f := range fields {
+			if !admissionevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != admissionevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aeuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aeuo.mutation.Seq(); ok {
+		_spec.SetField(admissionevent.FieldSeq, field.TypeInt, value)
+	}
+	if value, ok := aeuo.mutation.AddedSeq(); ok {
+		_spec.AddField(admissionevent.FieldSeq, field.TypeInt, value)
+	}
+	if value, ok := aeuo.mutation.Kind(); ok {
+		_spec.SetField(admissionevent.FieldKind, field.TypeInt, value)
+	}
+	if value, ok := aeuo.mutation.AddedKind(); ok {
+		_spec.AddField(admissionevent.FieldKind, field.TypeInt, value)
+	}
+	if value, ok := aeuo.mutation.Stage(); ok {
+		_spec.SetField(admissionevent.FieldStage, field.TypeInt, value)
+	}
+	if value, ok := aeuo.mutation.AddedStage(); ok {
+		_spec.AddField(admissionevent.FieldStage, field.TypeInt, value)
+	}
+	if aeuo.mutation.StageCleared() {
+		_spec.ClearField(admissionevent.FieldStage, field.TypeInt)
+	}
+	if value, ok := aeuo.mutation.StudentID(); ok {
+		_spec.SetField(admissionevent.FieldStudentID, field.TypeString, value)
+	}
+	if value, ok := aeuo.mutation.Priority(); ok {
+		_spec.SetField(admissionevent.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := aeuo.mutation.AddedPriority(); ok {
+		_spec.AddField(admissionevent.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := aeuo.mutation.CompetitionType(); ok {
+		_spec.SetField(admissionevent.FieldCompetitionType, field.TypeInt, value)
+	}
+	if value, ok := aeuo.mutation.AddedCompetitionType(); ok {
+		_spec.AddField(admissionevent.FieldCompetitionType, field.TypeInt, value)
+	}
+	if value, ok := aeuo.mutation.OtherStudentID(); ok {
+		_spec.SetField(admissionevent.FieldOtherStudentID, field.TypeString, value)
+	}
+	if aeuo.mutation.OtherStudentIDCleared() {
+		_spec.ClearField(admissionevent.FieldOtherStudentID, field.TypeString)
+	}
+	if aeuo.mutation.HeadingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   admissionevent.HeadingTable,
+			Columns: []string{admissionevent.HeadingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(heading.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aeuo.mutation.HeadingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   admissionevent.HeadingTable,
+			Columns: []string{admissionevent.HeadingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(heading.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if aeuo.mutation.RunCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   admissionevent.RunTable,
+			Columns: []string{admissionevent.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(run.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aeuo.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   admissionevent.RunTable,
+			Columns: []string{admissionevent.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(run.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &AdmissionEvent{config: aeuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aeuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{admissionevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	aeuo.mutation.done = true
+	return _node, nil
+}
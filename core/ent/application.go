@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -30,14 +31,20 @@ type Application struct {
 	RatingPlace int `json:"rating_place,omitempty"`
 	// Score holds the value of the "score" field.
 	Score int `json:"score,omitempty"`
+	// SubjectScores holds the value of the "subject_scores" field.
+	SubjectScores []int `json:"subject_scores,omitempty"`
+	// AchievementScore holds the value of the "achievement_score" field.
+	AchievementScore int `json:"achievement_score,omitempty"`
+	// SubQuota holds the value of the "sub_quota" field.
+	SubQuota string `json:"sub_quota,omitempty"`
 	// RunID holds the value of the "run_id" field.
 	RunID int `json:"run_id,omitempty"`
 	// OriginalSubmitted holds the value of the "original_submitted" field.
 	OriginalSubmitted bool `json:"original_submitted,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
-	// MsuInternalID holds the value of the "msu_internal_id" field.
-	MsuInternalID *string `json:"msu_internal_id,omitempty"`
+	// InternalID holds the value of the "internal_id" field.
+	InternalID *string `json:"internal_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ApplicationQuery when eager-loading is set.
 	Edges                ApplicationEdges `json:"edges"`
@@ -83,11 +90,13 @@ func (*Application) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case application.FieldSubjectScores:
+			values[i] = new([]byte)
 		case application.FieldOriginalSubmitted:
 			values[i] = new(sql.NullBool)
-		case application.FieldID, application.FieldPriority, application.FieldCompetitionType, application.FieldRatingPlace, application.FieldScore, application.FieldRunID:
+		case application.FieldID, application.FieldPriority, application.FieldCompetitionType, application.FieldRatingPlace, application.FieldScore, application.FieldAchievementScore, application.FieldRunID:
 			values[i] = new(sql.NullInt64)
-		case application.FieldStudentID, application.FieldMsuInternalID:
+		case application.FieldStudentID, application.FieldSubQuota, application.FieldInternalID:
 			values[i] = new(sql.NullString)
 		case application.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -144,6 +153,26 @@ func (a *Application) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				a.Score = int(value.Int64)
 			}
+		case application.FieldSubjectScores:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field subject_scores", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &a.SubjectScores); err != nil {
+					return fmt.Errorf("unmarshal field subject_scores: %w", err)
+				}
+			}
+		case application.FieldAchievementScore:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field achievement_score", values[i])
+			} else if value.Valid {
+				a.AchievementScore = int(value.Int64)
+			}
+		case application.FieldSubQuota:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sub_quota", values[i])
+			} else if value.Valid {
+				a.SubQuota = value.String
+			}
 		case application.FieldRunID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field run_id", values[i])
@@ -162,12 +191,12 @@ func (a *Application) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				a.UpdatedAt = value.Time
 			}
-		case application.FieldMsuInternalID:
+		case application.FieldInternalID:
 			if value, ok := values[i].(*sql.NullString); !ok {
-				return fmt.Errorf("unexpected type %T for field msu_internal_id", values[i])
+				return fmt.Errorf("unexpected type %T for field internal_id", values[i])
 			} else if value.Valid {
-				a.MsuInternalID = new(string)
-				*a.MsuInternalID = value.String
+				a.InternalID = new(string)
+				*a.InternalID = value.String
 			}
 		case application.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
@@ -237,6 +266,15 @@ func (a *Application) String() string {
 	builder.WriteString("score=")
 	builder.WriteString(fmt.Sprintf("%v", a.Score))
 	builder.WriteString(", ")
+	builder.WriteString("subject_scores=")
+	builder.WriteString(fmt.Sprintf("%v", a.SubjectScores))
+	builder.WriteString(", ")
+	builder.WriteString("achievement_score=")
+	builder.WriteString(fmt.Sprintf("%v", a.AchievementScore))
+	builder.WriteString(", ")
+	builder.WriteString("sub_quota=")
+	builder.WriteString(a.SubQuota)
+	builder.WriteString(", ")
 	builder.WriteString("run_id=")
 	builder.WriteString(fmt.Sprintf("%v", a.RunID))
 	builder.WriteString(", ")
@@ -246,8 +284,8 @@ func (a *Application) String() string {
 	builder.WriteString("updated_at=")
 	builder.WriteString(a.UpdatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
-	if v := a.MsuInternalID; v != nil {
-		builder.WriteString("msu_internal_id=")
+	if v := a.InternalID; v != nil {
+		builder.WriteString("internal_id=")
 		builder.WriteString(*v)
 	}
 	builder.WriteByte(')')
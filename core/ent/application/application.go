@@ -24,14 +24,20 @@ const (
 	FieldRatingPlace = "rating_place"
 	// FieldScore holds the string denoting the score field in the database.
 	FieldScore = "score"
+	// FieldSubjectScores holds the string denoting the subject_scores field in the database.
+	FieldSubjectScores = "subject_scores"
+	// FieldAchievementScore holds the string denoting the achievement_score field in the database.
+	FieldAchievementScore = "achievement_score"
+	// FieldSubQuota holds the string denoting the sub_quota field in the database.
+	FieldSubQuota = "sub_quota"
 	// FieldRunID holds the string denoting the run_id field in the database.
 	FieldRunID = "run_id"
 	// FieldOriginalSubmitted holds the string denoting the original_submitted field in the database.
 	FieldOriginalSubmitted = "original_submitted"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
-	// FieldMsuInternalID holds the string denoting the msu_internal_id field in the database.
-	FieldMsuInternalID = "msu_internal_id"
+	// FieldInternalID holds the string denoting the internal_id field in the database.
+	FieldInternalID = "msu_internal_id"
 	// EdgeHeading holds the string denoting the heading edge name in mutations.
 	EdgeHeading = "heading"
 	// EdgeRun holds the string denoting the run edge name in mutations.
@@ -62,10 +68,13 @@ var Columns = []string{
 	FieldCompetitionType,
 	FieldRatingPlace,
 	FieldScore,
+	FieldSubjectScores,
+	FieldAchievementScore,
+	FieldSubQuota,
 	FieldRunID,
 	FieldOriginalSubmitted,
 	FieldUpdatedAt,
-	FieldMsuInternalID,
+	FieldInternalID,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "applications"
@@ -90,6 +99,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultAchievementScore holds the default value on creation for the "achievement_score" field.
+	DefaultAchievementScore int
 	// DefaultOriginalSubmitted holds the default value on creation for the "original_submitted" field.
 	DefaultOriginalSubmitted bool
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -131,6 +142,16 @@ func ByScore(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldScore, opts...).ToFunc()
 }
 
+// ByAchievementScore orders the results by the achievement_score field.
+func ByAchievementScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAchievementScore, opts...).ToFunc()
+}
+
+// BySubQuota orders the results by the sub_quota field.
+func BySubQuota(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubQuota, opts...).ToFunc()
+}
+
 // ByRunID orders the results by the run_id field.
 func ByRunID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRunID, opts...).ToFunc()
@@ -146,9 +167,9 @@ func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
 }
 
-// ByMsuInternalID orders the results by the msu_internal_id field.
-func ByMsuInternalID(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldMsuInternalID, opts...).ToFunc()
+// ByInternalID orders the results by the internal_id field.
+func ByInternalID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInternalID, opts...).ToFunc()
 }
 
 // ByHeadingField orders the results by heading field.
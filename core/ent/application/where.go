@@ -82,6 +82,16 @@ func Score(v int) predicate.Application {
 	return predicate.Application(sql.FieldEQ(FieldScore, v))
 }
 
+// AchievementScore applies equality check predicate on the "achievement_score" field. It's identical to AchievementScoreEQ.
+func AchievementScore(v int) predicate.Application {
+	return predicate.Application(sql.FieldEQ(FieldAchievementScore, v))
+}
+
+// SubQuota applies equality check predicate on the "sub_quota" field. It's identical to SubQuotaEQ.
+func SubQuota(v string) predicate.Application {
+	return predicate.Application(sql.FieldEQ(FieldSubQuota, v))
+}
+
 // RunID applies equality check predicate on the "run_id" field. It's identical to RunIDEQ.
 func RunID(v int) predicate.Application {
 	return predicate.Application(sql.FieldEQ(FieldRunID, v))
@@ -97,9 +107,9 @@ func UpdatedAt(v time.Time) predicate.Application {
 	return predicate.Application(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
-// MsuInternalID applies equality check predicate on the "msu_internal_id" field. It's identical to MsuInternalIDEQ.
-func MsuInternalID(v string) predicate.Application {
-	return predicate.Application(sql.FieldEQ(FieldMsuInternalID, v))
+// InternalID applies equality check predicate on the "internal_id" field. It's identical to InternalIDEQ.
+func InternalID(v string) predicate.Application {
+	return predicate.Application(sql.FieldEQ(FieldInternalID, v))
 }
 
 // StudentIDEQ applies the EQ predicate on the "student_id" field.
@@ -341,6 +351,131 @@ func ScoreLTE(v int) predicate.Application {
 	return predicate.Application(sql.FieldLTE(FieldScore, v))
 }
 
+// SubjectScoresIsNil applies the IsNil predicate on the "subject_scores" field.
+func SubjectScoresIsNil() predicate.Application {
+	return predicate.Application(sql.FieldIsNull(FieldSubjectScores))
+}
+
+// SubjectScoresNotNil applies the NotNil predicate on the "subject_scores" field.
+func SubjectScoresNotNil() predicate.Application {
+	return predicate.Application(sql.FieldNotNull(FieldSubjectScores))
+}
+
+// AchievementScoreEQ applies the EQ predicate on the "achievement_score" field.
+func AchievementScoreEQ(v int) predicate.Application {
+	return predicate.Application(sql.FieldEQ(FieldAchievementScore, v))
+}
+
+// AchievementScoreNEQ applies the NEQ predicate on the "achievement_score" field.
+func AchievementScoreNEQ(v int) predicate.Application {
+	return predicate.Application(sql.FieldNEQ(FieldAchievementScore, v))
+}
+
+// AchievementScoreIn applies the In predicate on the "achievement_score" field.
+func AchievementScoreIn(vs ...int) predicate.Application {
+	return predicate.Application(sql.FieldIn(FieldAchievementScore, vs...))
+}
+
+// AchievementScoreNotIn applies the NotIn predicate on the "achievement_score" field.
+func AchievementScoreNotIn(vs ...int) predicate.Application {
+	return predicate.Application(sql.FieldNotIn(FieldAchievementScore, vs...))
+}
+
+// AchievementScoreGT applies the GT predicate on the "achievement_score" field.
+func AchievementScoreGT(v int) predicate.Application {
+	return predicate.Application(sql.FieldGT(FieldAchievementScore, v))
+}
+
+// AchievementScoreGTE applies the GTE predicate on the "achievement_score" field.
+func AchievementScoreGTE(v int) predicate.Application {
+	return predicate.Application(sql.FieldGTE(FieldAchievementScore, v))
+}
+
+// AchievementScoreLT applies the LT predicate on the "achievement_score" field.
+func AchievementScoreLT(v int) predicate.Application {
+	return predicate.Application(sql.FieldLT(FieldAchievementScore, v))
+}
+
+// AchievementScoreLTE applies the LTE predicate on the "achievement_score" field.
+func AchievementScoreLTE(v int) predicate.Application {
+	return predicate.Application(sql.FieldLTE(FieldAchievementScore, v))
+}
+
+// SubQuotaEQ applies the EQ predicate on the "sub_quota" field.
+func SubQuotaEQ(v string) predicate.Application {
+	return predicate.Application(sql.FieldEQ(FieldSubQuota, v))
+}
+
+// SubQuotaNEQ applies the NEQ predicate on the "sub_quota" field.
+func SubQuotaNEQ(v string) predicate.Application {
+	return predicate.Application(sql.FieldNEQ(FieldSubQuota, v))
+}
+
+// SubQuotaIn applies the In predicate on the "sub_quota" field.
+func SubQuotaIn(vs ...string) predicate.Application {
+	return predicate.Application(sql.FieldIn(FieldSubQuota, vs...))
+}
+
+// SubQuotaNotIn applies the NotIn predicate on the "sub_quota" field.
+func SubQuotaNotIn(vs ...string) predicate.Application {
+	return predicate.Application(sql.FieldNotIn(FieldSubQuota, vs...))
+}
+
+// SubQuotaGT applies the GT predicate on the "sub_quota" field.
+func SubQuotaGT(v string) predicate.Application {
+	return predicate.Application(sql.FieldGT(FieldSubQuota, v))
+}
+
+// SubQuotaGTE applies the GTE predicate on the "sub_quota" field.
+func SubQuotaGTE(v string) predicate.Application {
+	return predicate.Application(sql.FieldGTE(FieldSubQuota, v))
+}
+
+// SubQuotaLT applies the LT predicate on the "sub_quota" field.
+func SubQuotaLT(v string) predicate.Application {
+	return predicate.Application(sql.FieldLT(FieldSubQuota, v))
+}
+
+// SubQuotaLTE applies the LTE predicate on the "sub_quota" field.
+func SubQuotaLTE(v string) predicate.Application {
+	return predicate.Application(sql.FieldLTE(FieldSubQuota, v))
+}
+
+// SubQuotaContains applies the Contains predicate on the "sub_quota" field.
+func SubQuotaContains(v string) predicate.Application {
+	return predicate.Application(sql.FieldContains(FieldSubQuota, v))
+}
+
+// SubQuotaHasPrefix applies the HasPrefix predicate on the "sub_quota" field.
+func SubQuotaHasPrefix(v string) predicate.Application {
+	return predicate.Application(sql.FieldHasPrefix(FieldSubQuota, v))
+}
+
+// SubQuotaHasSuffix applies the HasSuffix predicate on the "sub_quota" field.
+func SubQuotaHasSuffix(v string) predicate.Application {
+	return predicate.Application(sql.FieldHasSuffix(FieldSubQuota, v))
+}
+
+// SubQuotaIsNil applies the IsNil predicate on the "sub_quota" field.
+func SubQuotaIsNil() predicate.Application {
+	return predicate.Application(sql.FieldIsNull(FieldSubQuota))
+}
+
+// SubQuotaNotNil applies the NotNil predicate on the "sub_quota" field.
+func SubQuotaNotNil() predicate.Application {
+	return predicate.Application(sql.FieldNotNull(FieldSubQuota))
+}
+
+// SubQuotaEqualFold applies the EqualFold predicate on the "sub_quota" field.
+func SubQuotaEqualFold(v string) predicate.Application {
+	return predicate.Application(sql.FieldEqualFold(FieldSubQuota, v))
+}
+
+// SubQuotaContainsFold applies the ContainsFold predicate on the "sub_quota" field.
+func SubQuotaContainsFold(v string) predicate.Application {
+	return predicate.Application(sql.FieldContainsFold(FieldSubQuota, v))
+}
+
 // RunIDEQ applies the EQ predicate on the "run_id" field.
 func RunIDEQ(v int) predicate.Application {
 	return predicate.Application(sql.FieldEQ(FieldRunID, v))
@@ -411,79 +546,79 @@ func UpdatedAtLTE(v time.Time) predicate.Application {
 	return predicate.Application(sql.FieldLTE(FieldUpdatedAt, v))
 }
 
-// MsuInternalIDEQ applies the EQ predicate on the "msu_internal_id" field.
-func MsuInternalIDEQ(v string) predicate.Application {
-	return predicate.Application(sql.FieldEQ(FieldMsuInternalID, v))
+// InternalIDEQ applies the EQ predicate on the "internal_id" field.
+func InternalIDEQ(v string) predicate.Application {
+	return predicate.Application(sql.FieldEQ(FieldInternalID, v))
 }
 
-// MsuInternalIDNEQ applies the NEQ predicate on the "msu_internal_id" field.
-func MsuInternalIDNEQ(v string) predicate.Application {
-	return predicate.Application(sql.FieldNEQ(FieldMsuInternalID, v))
+// InternalIDNEQ applies the NEQ predicate on the "internal_id" field.
+func InternalIDNEQ(v string) predicate.Application {
+	return predicate.Application(sql.FieldNEQ(FieldInternalID, v))
 }
 
-// MsuInternalIDIn applies the In predicate on the "msu_internal_id" field.
-func MsuInternalIDIn(vs ...string) predicate.Application {
-	return predicate.Application(sql.FieldIn(FieldMsuInternalID, vs...))
+// InternalIDIn applies the In predicate on the "internal_id" field.
+func InternalIDIn(vs ...string) predicate.Application {
+	return predicate.Application(sql.FieldIn(FieldInternalID, vs...))
 }
 
-// MsuInternalIDNotIn applies the NotIn predicate on the "msu_internal_id" field.
-func MsuInternalIDNotIn(vs ...string) predicate.Application {
-	return predicate.Application(sql.FieldNotIn(FieldMsuInternalID, vs...))
+// InternalIDNotIn applies the NotIn predicate on the "internal_id" field.
+func InternalIDNotIn(vs ...string) predicate.Application {
+	return predicate.Application(sql.FieldNotIn(FieldInternalID, vs...))
 }
 
-// MsuInternalIDGT applies the GT predicate on the "msu_internal_id" field.
-func MsuInternalIDGT(v string) predicate.Application {
-	return predicate.Application(sql.FieldGT(FieldMsuInternalID, v))
+// InternalIDGT applies the GT predicate on the "internal_id" field.
+func InternalIDGT(v string) predicate.Application {
+	return predicate.Application(sql.FieldGT(FieldInternalID, v))
 }
 
-// MsuInternalIDGTE applies the GTE predicate on the "msu_internal_id" field.
-func MsuInternalIDGTE(v string) predicate.Application {
-	return predicate.Application(sql.FieldGTE(FieldMsuInternalID, v))
+// InternalIDGTE applies the GTE predicate on the "internal_id" field.
+func InternalIDGTE(v string) predicate.Application {
+	return predicate.Application(sql.FieldGTE(FieldInternalID, v))
 }
 
-// MsuInternalIDLT applies the LT predicate on the "msu_internal_id" field.
-func MsuInternalIDLT(v string) predicate.Application {
-	return predicate.Application(sql.FieldLT(FieldMsuInternalID, v))
+// InternalIDLT applies the LT predicate on the "internal_id" field.
+func InternalIDLT(v string) predicate.Application {
+	return predicate.Application(sql.FieldLT(FieldInternalID, v))
 }
 
-// MsuInternalIDLTE applies the LTE predicate on the "msu_internal_id" field.
-func MsuInternalIDLTE(v string) predicate.Application {
-	return predicate.Application(sql.FieldLTE(FieldMsuInternalID, v))
+// InternalIDLTE applies the LTE predicate on the "internal_id" field.
+func InternalIDLTE(v string) predicate.Application {
+	return predicate.Application(sql.FieldLTE(FieldInternalID, v))
 }
 
-// MsuInternalIDContains applies the Contains predicate on the "msu_internal_id" field.
-func MsuInternalIDContains(v string) predicate.Application {
-	return predicate.Application(sql.FieldContains(FieldMsuInternalID, v))
+// InternalIDContains applies the Contains predicate on the "internal_id" field.
+func InternalIDContains(v string) predicate.Application {
+	return predicate.Application(sql.FieldContains(FieldInternalID, v))
 }
 
-// MsuInternalIDHasPrefix applies the HasPrefix predicate on the "msu_internal_id" field.
-func MsuInternalIDHasPrefix(v string) predicate.Application {
-	return predicate.Application(sql.FieldHasPrefix(FieldMsuInternalID, v))
+// InternalIDHasPrefix applies the HasPrefix predicate on the "internal_id" field.
+func InternalIDHasPrefix(v string) predicate.Application {
+	return predicate.Application(sql.FieldHasPrefix(FieldInternalID, v))
 }
 
-// MsuInternalIDHasSuffix applies the HasSuffix predicate on the "msu_internal_id" field.
-func MsuInternalIDHasSuffix(v string) predicate.Application {
-	return predicate.Application(sql.FieldHasSuffix(FieldMsuInternalID, v))
+// InternalIDHasSuffix applies the HasSuffix predicate on the "internal_id" field.
+func InternalIDHasSuffix(v string) predicate.Application {
+	return predicate.Application(sql.FieldHasSuffix(FieldInternalID, v))
 }
 
-// MsuInternalIDIsNil applies the IsNil predicate on the "msu_internal_id" field.
-func MsuInternalIDIsNil() predicate.Application {
-	return predicate.Application(sql.FieldIsNull(FieldMsuInternalID))
+// InternalIDIsNil applies the IsNil predicate on the "internal_id" field.
+func InternalIDIsNil() predicate.Application {
+	return predicate.Application(sql.FieldIsNull(FieldInternalID))
 }
 
-// MsuInternalIDNotNil applies the NotNil predicate on the "msu_internal_id" field.
-func MsuInternalIDNotNil() predicate.Application {
-	return predicate.Application(sql.FieldNotNull(FieldMsuInternalID))
+// InternalIDNotNil applies the NotNil predicate on the "internal_id" field.
+func InternalIDNotNil() predicate.Application {
+	return predicate.Application(sql.FieldNotNull(FieldInternalID))
 }
 
-// MsuInternalIDEqualFold applies the EqualFold predicate on the "msu_internal_id" field.
-func MsuInternalIDEqualFold(v string) predicate.Application {
-	return predicate.Application(sql.FieldEqualFold(FieldMsuInternalID, v))
+// InternalIDEqualFold applies the EqualFold predicate on the "internal_id" field.
+func InternalIDEqualFold(v string) predicate.Application {
+	return predicate.Application(sql.FieldEqualFold(FieldInternalID, v))
 }
 
-// MsuInternalIDContainsFold applies the ContainsFold predicate on the "msu_internal_id" field.
-func MsuInternalIDContainsFold(v string) predicate.Application {
-	return predicate.Application(sql.FieldContainsFold(FieldMsuInternalID, v))
+// InternalIDContainsFold applies the ContainsFold predicate on the "internal_id" field.
+func InternalIDContainsFold(v string) predicate.Application {
+	return predicate.Application(sql.FieldContainsFold(FieldInternalID, v))
 }
 
 // HasHeading applies the HasEdge predicate on the "heading" edge.
@@ -53,6 +53,40 @@ func (ac *ApplicationCreate) SetScore(i int) *ApplicationCreate {
 	return ac
 }
 
+// SetSubjectScores sets the "subject_scores" field.
+func (ac *ApplicationCreate) SetSubjectScores(i []int) *ApplicationCreate {
+	ac.mutation.SetSubjectScores(i)
+	return ac
+}
+
+// SetAchievementScore sets the "achievement_score" field.
+func (ac *ApplicationCreate) SetAchievementScore(i int) *ApplicationCreate {
+	ac.mutation.SetAchievementScore(i)
+	return ac
+}
+
+// SetNillableAchievementScore sets the "achievement_score" field if the given value is not nil.
+func (ac *ApplicationCreate) SetNillableAchievementScore(i *int) *ApplicationCreate {
+	if i != nil {
+		ac.SetAchievementScore(*i)
+	}
+	return ac
+}
+
+// SetSubQuota sets the "sub_quota" field.
+func (ac *ApplicationCreate) SetSubQuota(s string) *ApplicationCreate {
+	ac.mutation.SetSubQuota(s)
+	return ac
+}
+
+// SetNillableSubQuota sets the "sub_quota" field if the given value is not nil.
+func (ac *ApplicationCreate) SetNillableSubQuota(s *string) *ApplicationCreate {
+	if s != nil {
+		ac.SetSubQuota(*s)
+	}
+	return ac
+}
+
 // SetRunID sets the "run_id" field.
 func (ac *ApplicationCreate) SetRunID(i int) *ApplicationCreate {
 	ac.mutation.SetRunID(i)
@@ -87,16 +121,16 @@ func (ac *ApplicationCreate) SetNillableUpdatedAt(t *time.Time) *ApplicationCrea
 	return ac
 }
 
-// SetMsuInternalID sets the "msu_internal_id" field.
-func (ac *ApplicationCreate) SetMsuInternalID(s string) *ApplicationCreate {
-	ac.mutation.SetMsuInternalID(s)
+// SetInternalID sets the "internal_id" field.
+func (ac *ApplicationCreate) SetInternalID(s string) *ApplicationCreate {
+	ac.mutation.SetInternalID(s)
 	return ac
 }
 
-// SetNillableMsuInternalID sets the "msu_internal_id" field if the given value is not nil.
-func (ac *ApplicationCreate) SetNillableMsuInternalID(s *string) *ApplicationCreate {
+// SetNillableInternalID sets the "internal_id" field if the given value is not nil.
+func (ac *ApplicationCreate) SetNillableInternalID(s *string) *ApplicationCreate {
 	if s != nil {
-		ac.SetMsuInternalID(*s)
+		ac.SetInternalID(*s)
 	}
 	return ac
 }
@@ -152,6 +186,10 @@ func (ac *ApplicationCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (ac *ApplicationCreate) defaults() {
+	if _, ok := ac.mutation.AchievementScore(); !ok {
+		v := application.DefaultAchievementScore
+		ac.mutation.SetAchievementScore(v)
+	}
 	if _, ok := ac.mutation.OriginalSubmitted(); !ok {
 		v := application.DefaultOriginalSubmitted
 		ac.mutation.SetOriginalSubmitted(v)
@@ -179,6 +217,9 @@ func (ac *ApplicationCreate) check() error {
 	if _, ok := ac.mutation.Score(); !ok {
 		return &ValidationError{Name: "score", err: errors.New(`ent: missing required field "Application.score"`)}
 	}
+	if _, ok := ac.mutation.AchievementScore(); !ok {
+		return &ValidationError{Name: "achievement_score", err: errors.New(`ent: missing required field "Application.achievement_score"`)}
+	}
 	if _, ok := ac.mutation.RunID(); !ok {
 		return &ValidationError{Name: "run_id", err: errors.New(`ent: missing required field "Application.run_id"`)}
 	}
@@ -240,6 +281,18 @@ func (ac *ApplicationCreate) createSpec() (*Application, *sqlgraph.CreateSpec) {
 		_spec.SetField(application.FieldScore, field.TypeInt, value)
 		_node.Score = value
 	}
+	if value, ok := ac.mutation.SubjectScores(); ok {
+		_spec.SetField(application.FieldSubjectScores, field.TypeJSON, value)
+		_node.SubjectScores = value
+	}
+	if value, ok := ac.mutation.AchievementScore(); ok {
+		_spec.SetField(application.FieldAchievementScore, field.TypeInt, value)
+		_node.AchievementScore = value
+	}
+	if value, ok := ac.mutation.SubQuota(); ok {
+		_spec.SetField(application.FieldSubQuota, field.TypeString, value)
+		_node.SubQuota = value
+	}
 	if value, ok := ac.mutation.OriginalSubmitted(); ok {
 		_spec.SetField(application.FieldOriginalSubmitted, field.TypeBool, value)
 		_node.OriginalSubmitted = value
@@ -248,9 +301,9 @@ func (ac *ApplicationCreate) createSpec() (*Application, *sqlgraph.CreateSpec) {
 		_spec.SetField(application.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
-	if value, ok := ac.mutation.MsuInternalID(); ok {
-		_spec.SetField(application.FieldMsuInternalID, field.TypeString, value)
-		_node.MsuInternalID = &value
+	if value, ok := ac.mutation.InternalID(); ok {
+		_spec.SetField(application.FieldInternalID, field.TypeString, value)
+		_node.InternalID = &value
 	}
 	if nodes := ac.mutation.HeadingIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/trueegorletov/analabit/core"
 	"github.com/trueegorletov/analabit/core/ent/application"
@@ -129,6 +130,65 @@ func (au *ApplicationUpdate) AddScore(i int) *ApplicationUpdate {
 	return au
 }
 
+// SetSubjectScores sets the "subject_scores" field.
+func (au *ApplicationUpdate) SetSubjectScores(i []int) *ApplicationUpdate {
+	au.mutation.SetSubjectScores(i)
+	return au
+}
+
+// AppendSubjectScores appends i to the "subject_scores" field.
+func (au *ApplicationUpdate) AppendSubjectScores(i []int) *ApplicationUpdate {
+	au.mutation.AppendSubjectScores(i)
+	return au
+}
+
+// ClearSubjectScores clears the value of the "subject_scores" field.
+func (au *ApplicationUpdate) ClearSubjectScores() *ApplicationUpdate {
+	au.mutation.ClearSubjectScores()
+	return au
+}
+
+// SetAchievementScore sets the "achievement_score" field.
+func (au *ApplicationUpdate) SetAchievementScore(i int) *ApplicationUpdate {
+	au.mutation.ResetAchievementScore()
+	au.mutation.SetAchievementScore(i)
+	return au
+}
+
+// SetNillableAchievementScore sets the "achievement_score" field if the given value is not nil.
+func (au *ApplicationUpdate) SetNillableAchievementScore(i *int) *ApplicationUpdate {
+	if i != nil {
+		au.SetAchievementScore(*i)
+	}
+	return au
+}
+
+// AddAchievementScore adds i to the "achievement_score" field.
+func (au *ApplicationUpdate) AddAchievementScore(i int) *ApplicationUpdate {
+	au.mutation.AddAchievementScore(i)
+	return au
+}
+
+// SetSubQuota sets the "sub_quota" field.
+func (au *ApplicationUpdate) SetSubQuota(s string) *ApplicationUpdate {
+	au.mutation.SetSubQuota(s)
+	return au
+}
+
+// SetNillableSubQuota sets the "sub_quota" field if the given value is not nil.
+func (au *ApplicationUpdate) SetNillableSubQuota(s *string) *ApplicationUpdate {
+	if s != nil {
+		au.SetSubQuota(*s)
+	}
+	return au
+}
+
+// ClearSubQuota clears the value of the "sub_quota" field.
+func (au *ApplicationUpdate) ClearSubQuota() *ApplicationUpdate {
+	au.mutation.ClearSubQuota()
+	return au
+}
+
 // SetRunID sets the "run_id" field.
 func (au *ApplicationUpdate) SetRunID(i int) *ApplicationUpdate {
 	au.mutation.SetRunID(i)
@@ -163,23 +223,23 @@ func (au *ApplicationUpdate) SetUpdatedAt(t time.Time) *ApplicationUpdate {
 	return au
 }
 
-// SetMsuInternalID sets the "msu_internal_id" field.
-func (au *ApplicationUpdate) SetMsuInternalID(s string) *ApplicationUpdate {
-	au.mutation.SetMsuInternalID(s)
+// SetInternalID sets the "internal_id" field.
+func (au *ApplicationUpdate) SetInternalID(s string) *ApplicationUpdate {
+	au.mutation.SetInternalID(s)
 	return au
 }
 
-// SetNillableMsuInternalID sets the "msu_internal_id" field if the given value is not nil.
-func (au *ApplicationUpdate) SetNillableMsuInternalID(s *string) *ApplicationUpdate {
+// SetNillableInternalID sets the "internal_id" field if the given value is not nil.
+func (au *ApplicationUpdate) SetNillableInternalID(s *string) *ApplicationUpdate {
 	if s != nil {
-		au.SetMsuInternalID(*s)
+		au.SetInternalID(*s)
 	}
 	return au
 }
 
-// ClearMsuInternalID clears the value of the "msu_internal_id" field.
-func (au *ApplicationUpdate) ClearMsuInternalID() *ApplicationUpdate {
-	au.mutation.ClearMsuInternalID()
+// ClearInternalID clears the value of the "internal_id" field.
+func (au *ApplicationUpdate) ClearInternalID() *ApplicationUpdate {
+	au.mutation.ClearInternalID()
 	return au
 }
 
@@ -302,17 +362,40 @@ func (au *ApplicationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := au.mutation.AddedScore(); ok {
 		_spec.AddField(application.FieldScore, field.TypeInt, value)
 	}
+	if value, ok := au.mutation.SubjectScores(); ok {
+		_spec.SetField(application.FieldSubjectScores, field.TypeJSON, value)
+	}
+	if value, ok := au.mutation.AppendedSubjectScores(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, application.FieldSubjectScores, value)
+		})
+	}
+	if au.mutation.SubjectScoresCleared() {
+		_spec.ClearField(application.FieldSubjectScores, field.TypeJSON)
+	}
+	if value, ok := au.mutation.AchievementScore(); ok {
+		_spec.SetField(application.FieldAchievementScore, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.AddedAchievementScore(); ok {
+		_spec.AddField(application.FieldAchievementScore, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.SubQuota(); ok {
+		_spec.SetField(application.FieldSubQuota, field.TypeString, value)
+	}
+	if au.mutation.SubQuotaCleared() {
+		_spec.ClearField(application.FieldSubQuota, field.TypeString)
+	}
 	if value, ok := au.mutation.OriginalSubmitted(); ok {
 		_spec.SetField(application.FieldOriginalSubmitted, field.TypeBool, value)
 	}
 	if value, ok := au.mutation.UpdatedAt(); ok {
 		_spec.SetField(application.FieldUpdatedAt, field.TypeTime, value)
 	}
-	if value, ok := au.mutation.MsuInternalID(); ok {
-		_spec.SetField(application.FieldMsuInternalID, field.TypeString, value)
+	if value, ok := au.mutation.InternalID(); ok {
+		_spec.SetField(application.FieldInternalID, field.TypeString, value)
 	}
-	if au.mutation.MsuInternalIDCleared() {
-		_spec.ClearField(application.FieldMsuInternalID, field.TypeString)
+	if au.mutation.InternalIDCleared() {
+		_spec.ClearField(application.FieldInternalID, field.TypeString)
 	}
 	if au.mutation.HeadingCleared() {
 		edge := &sqlgraph.EdgeSpec{
@@ -490,6 +573,65 @@ func (auo *ApplicationUpdateOne) AddScore(i int) *ApplicationUpdateOne {
 	return auo
 }
 
+// SetSubjectScores sets the "subject_scores" field.
+func (auo *ApplicationUpdateOne) SetSubjectScores(i []int) *ApplicationUpdateOne {
+	auo.mutation.SetSubjectScores(i)
+	return auo
+}
+
+// AppendSubjectScores appends i to the "subject_scores" field.
+func (auo *ApplicationUpdateOne) AppendSubjectScores(i []int) *ApplicationUpdateOne {
+	auo.mutation.AppendSubjectScores(i)
+	return auo
+}
+
+// ClearSubjectScores clears the value of the "subject_scores" field.
+func (auo *ApplicationUpdateOne) ClearSubjectScores() *ApplicationUpdateOne {
+	auo.mutation.ClearSubjectScores()
+	return auo
+}
+
+// SetAchievementScore sets the "achievement_score" field.
+func (auo *ApplicationUpdateOne) SetAchievementScore(i int) *ApplicationUpdateOne {
+	auo.mutation.ResetAchievementScore()
+	auo.mutation.SetAchievementScore(i)
+	return auo
+}
+
+// SetNillableAchievementScore sets the "achievement_score" field if the given value is not nil.
+func (auo *ApplicationUpdateOne) SetNillableAchievementScore(i *int) *ApplicationUpdateOne {
+	if i != nil {
+		auo.SetAchievementScore(*i)
+	}
+	return auo
+}
+
+// AddAchievementScore adds i to the "achievement_score" field.
+func (auo *ApplicationUpdateOne) AddAchievementScore(i int) *ApplicationUpdateOne {
+	auo.mutation.AddAchievementScore(i)
+	return auo
+}
+
+// SetSubQuota sets the "sub_quota" field.
+func (auo *ApplicationUpdateOne) SetSubQuota(s string) *ApplicationUpdateOne {
+	auo.mutation.SetSubQuota(s)
+	return auo
+}
+
+// SetNillableSubQuota sets the "sub_quota" field if the given value is not nil.
+func (auo *ApplicationUpdateOne) SetNillableSubQuota(s *string) *ApplicationUpdateOne {
+	if s != nil {
+		auo.SetSubQuota(*s)
+	}
+	return auo
+}
+
+// ClearSubQuota clears the value of the "sub_quota" field.
+func (auo *ApplicationUpdateOne) ClearSubQuota() *ApplicationUpdateOne {
+	auo.mutation.ClearSubQuota()
+	return auo
+}
+
 // SetRunID sets the "run_id" field.
 func (auo *ApplicationUpdateOne) SetRunID(i int) *ApplicationUpdateOne {
 	auo.mutation.SetRunID(i)
@@ -524,23 +666,23 @@ func (auo *ApplicationUpdateOne) SetUpdatedAt(t time.Time) *ApplicationUpdateOne
 	return auo
 }
 
-// SetMsuInternalID sets the "msu_internal_id" field.
-func (auo *ApplicationUpdateOne) SetMsuInternalID(s string) *ApplicationUpdateOne {
-	auo.mutation.SetMsuInternalID(s)
+// SetInternalID sets the "internal_id" field.
+func (auo *ApplicationUpdateOne) SetInternalID(s string) *ApplicationUpdateOne {
+	auo.mutation.SetInternalID(s)
 	return auo
 }
 
-// SetNillableMsuInternalID sets the "msu_internal_id" field if the given value is not nil.
-func (auo *ApplicationUpdateOne) SetNillableMsuInternalID(s *string) *ApplicationUpdateOne {
+// SetNillableInternalID sets the "internal_id" field if the given value is not nil.
+func (auo *ApplicationUpdateOne) SetNillableInternalID(s *string) *ApplicationUpdateOne {
 	if s != nil {
-		auo.SetMsuInternalID(*s)
+		auo.SetInternalID(*s)
 	}
 	return auo
 }
 
-// ClearMsuInternalID clears the value of the "msu_internal_id" field.
-func (auo *ApplicationUpdateOne) ClearMsuInternalID() *ApplicationUpdateOne {
-	auo.mutation.ClearMsuInternalID()
+// ClearInternalID clears the value of the "internal_id" field.
+func (auo *ApplicationUpdateOne) ClearInternalID() *ApplicationUpdateOne {
+	auo.mutation.ClearInternalID()
 	return auo
 }
 
@@ -693,17 +835,40 @@ func (auo *ApplicationUpdateOne) sqlSave(ctx context.Context) (_node *Applicatio
 	if value, ok := auo.mutation.AddedScore(); ok {
 		_spec.AddField(application.FieldScore, field.TypeInt, value)
 	}
+	if value, ok := auo.mutation.SubjectScores(); ok {
+		_spec.SetField(application.FieldSubjectScores, field.TypeJSON, value)
+	}
+	if value, ok := auo.mutation.AppendedSubjectScores(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, application.FieldSubjectScores, value)
+		})
+	}
+	if auo.mutation.SubjectScoresCleared() {
+		_spec.ClearField(application.FieldSubjectScores, field.TypeJSON)
+	}
+	if value, ok := auo.mutation.AchievementScore(); ok {
+		_spec.SetField(application.FieldAchievementScore, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.AddedAchievementScore(); ok {
+		_spec.AddField(application.FieldAchievementScore, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.SubQuota(); ok {
+		_spec.SetField(application.FieldSubQuota, field.TypeString, value)
+	}
+	if auo.mutation.SubQuotaCleared() {
+		_spec.ClearField(application.FieldSubQuota, field.TypeString)
+	}
 	if value, ok := auo.mutation.OriginalSubmitted(); ok {
 		_spec.SetField(application.FieldOriginalSubmitted, field.TypeBool, value)
 	}
 	if value, ok := auo.mutation.UpdatedAt(); ok {
 		_spec.SetField(application.FieldUpdatedAt, field.TypeTime, value)
 	}
-	if value, ok := auo.mutation.MsuInternalID(); ok {
-		_spec.SetField(application.FieldMsuInternalID, field.TypeString, value)
+	if value, ok := auo.mutation.InternalID(); ok {
+		_spec.SetField(application.FieldInternalID, field.TypeString, value)
 	}
-	if auo.mutation.MsuInternalIDCleared() {
-		_spec.ClearField(application.FieldMsuInternalID, field.TypeString)
+	if auo.mutation.InternalIDCleared() {
+		_spec.ClearField(application.FieldInternalID, field.TypeString)
 	}
 	if auo.mutation.HeadingCleared() {
 		edge := &sqlgraph.EdgeSpec{
@@ -9,6 +9,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/trueegorletov/analabit/core"
 	"github.com/trueegorletov/analabit/core/ent/calculation"
 	"github.com/trueegorletov/analabit/core/ent/heading"
 	"github.com/trueegorletov/analabit/core/ent/run"
@@ -23,6 +24,10 @@ type Calculation struct {
 	StudentID string `json:"student_id,omitempty"`
 	// AdmittedPlace holds the value of the "admitted_place" field.
 	AdmittedPlace int `json:"admitted_place,omitempty"`
+	// Stage holds the value of the "stage" field.
+	Stage core.AdmissionStage `json:"stage,omitempty"`
+	// Paid holds the value of the "paid" field.
+	Paid bool `json:"paid,omitempty"`
 	// RunID holds the value of the "run_id" field.
 	RunID int `json:"run_id,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -72,7 +77,9 @@ func (*Calculation) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case calculation.FieldID, calculation.FieldAdmittedPlace, calculation.FieldRunID:
+		case calculation.FieldPaid:
+			values[i] = new(sql.NullBool)
+		case calculation.FieldID, calculation.FieldAdmittedPlace, calculation.FieldStage, calculation.FieldRunID:
 			values[i] = new(sql.NullInt64)
 		case calculation.FieldStudentID:
 			values[i] = new(sql.NullString)
@@ -113,6 +120,18 @@ func (c *Calculation) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				c.AdmittedPlace = int(value.Int64)
 			}
+		case calculation.FieldStage:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field stage", values[i])
+			} else if value.Valid {
+				c.Stage = core.AdmissionStage(value.Int64)
+			}
+		case calculation.FieldPaid:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field paid", values[i])
+			} else if value.Valid {
+				c.Paid = value.Bool
+			}
 		case calculation.FieldRunID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field run_id", values[i])
@@ -184,6 +203,12 @@ func (c *Calculation) String() string {
 	builder.WriteString("admitted_place=")
 	builder.WriteString(fmt.Sprintf("%v", c.AdmittedPlace))
 	builder.WriteString(", ")
+	builder.WriteString("stage=")
+	builder.WriteString(fmt.Sprintf("%v", c.Stage))
+	builder.WriteString(", ")
+	builder.WriteString("paid=")
+	builder.WriteString(fmt.Sprintf("%v", c.Paid))
+	builder.WriteString(", ")
 	builder.WriteString("run_id=")
 	builder.WriteString(fmt.Sprintf("%v", c.RunID))
 	builder.WriteString(", ")
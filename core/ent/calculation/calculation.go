@@ -18,6 +18,10 @@ const (
 	FieldStudentID = "student_id"
 	// FieldAdmittedPlace holds the string denoting the admitted_place field in the database.
 	FieldAdmittedPlace = "admitted_place"
+	// FieldStage holds the string denoting the stage field in the database.
+	FieldStage = "stage"
+	// FieldPaid holds the string denoting the paid field in the database.
+	FieldPaid = "paid"
 	// FieldRunID holds the string denoting the run_id field in the database.
 	FieldRunID = "run_id"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -49,6 +53,8 @@ var Columns = []string{
 	FieldID,
 	FieldStudentID,
 	FieldAdmittedPlace,
+	FieldStage,
+	FieldPaid,
 	FieldRunID,
 	FieldUpdatedAt,
 }
@@ -75,6 +81,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultPaid holds the default value on creation for the "paid" field.
+	DefaultPaid bool
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
@@ -99,6 +107,16 @@ func ByAdmittedPlace(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAdmittedPlace, opts...).ToFunc()
 }
 
+// ByStage orders the results by the stage field.
+func ByStage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStage, opts...).ToFunc()
+}
+
+// ByPaid orders the results by the paid field.
+func ByPaid(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaid, opts...).ToFunc()
+}
+
 // ByRunID orders the results by the run_id field.
 func ByRunID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRunID, opts...).ToFunc()
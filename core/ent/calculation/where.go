@@ -7,6 +7,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/trueegorletov/analabit/core"
 	"github.com/trueegorletov/analabit/core/ent/predicate"
 )
 
@@ -65,6 +66,17 @@ func AdmittedPlace(v int) predicate.Calculation {
 	return predicate.Calculation(sql.FieldEQ(FieldAdmittedPlace, v))
 }
 
+// Stage applies equality check predicate on the "stage" field. It's identical to StageEQ.
+func Stage(v core.AdmissionStage) predicate.Calculation {
+	vc := int(v)
+	return predicate.Calculation(sql.FieldEQ(FieldStage, vc))
+}
+
+// Paid applies equality check predicate on the "paid" field. It's identical to PaidEQ.
+func Paid(v bool) predicate.Calculation {
+	return predicate.Calculation(sql.FieldEQ(FieldPaid, v))
+}
+
 // RunID applies equality check predicate on the "run_id" field. It's identical to RunIDEQ.
 func RunID(v int) predicate.Calculation {
 	return predicate.Calculation(sql.FieldEQ(FieldRunID, v))
@@ -180,6 +192,80 @@ func AdmittedPlaceLTE(v int) predicate.Calculation {
 	return predicate.Calculation(sql.FieldLTE(FieldAdmittedPlace, v))
 }
 
+// StageEQ applies the EQ predicate on the "stage" field.
+func StageEQ(v core.AdmissionStage) predicate.Calculation {
+	vc := int(v)
+	return predicate.Calculation(sql.FieldEQ(FieldStage, vc))
+}
+
+// StageNEQ applies the NEQ predicate on the "stage" field.
+func StageNEQ(v core.AdmissionStage) predicate.Calculation {
+	vc := int(v)
+	return predicate.Calculation(sql.FieldNEQ(FieldStage, vc))
+}
+
+// StageIn applies the In predicate on the "stage" field.
+func StageIn(vs ...core.AdmissionStage) predicate.Calculation {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int(vs[i])
+	}
+	return predicate.Calculation(sql.FieldIn(FieldStage, v...))
+}
+
+// StageNotIn applies the NotIn predicate on the "stage" field.
+func StageNotIn(vs ...core.AdmissionStage) predicate.Calculation {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int(vs[i])
+	}
+	return predicate.Calculation(sql.FieldNotIn(FieldStage, v...))
+}
+
+// StageGT applies the GT predicate on the "stage" field.
+func StageGT(v core.AdmissionStage) predicate.Calculation {
+	vc := int(v)
+	return predicate.Calculation(sql.FieldGT(FieldStage, vc))
+}
+
+// StageGTE applies the GTE predicate on the "stage" field.
+func StageGTE(v core.AdmissionStage) predicate.Calculation {
+	vc := int(v)
+	return predicate.Calculation(sql.FieldGTE(FieldStage, vc))
+}
+
+// StageLT applies the LT predicate on the "stage" field.
+func StageLT(v core.AdmissionStage) predicate.Calculation {
+	vc := int(v)
+	return predicate.Calculation(sql.FieldLT(FieldStage, vc))
+}
+
+// StageLTE applies the LTE predicate on the "stage" field.
+func StageLTE(v core.AdmissionStage) predicate.Calculation {
+	vc := int(v)
+	return predicate.Calculation(sql.FieldLTE(FieldStage, vc))
+}
+
+// StageIsNil applies the IsNil predicate on the "stage" field.
+func StageIsNil() predicate.Calculation {
+	return predicate.Calculation(sql.FieldIsNull(FieldStage))
+}
+
+// StageNotNil applies the NotNil predicate on the "stage" field.
+func StageNotNil() predicate.Calculation {
+	return predicate.Calculation(sql.FieldNotNull(FieldStage))
+}
+
+// PaidEQ applies the EQ predicate on the "paid" field.
+func PaidEQ(v bool) predicate.Calculation {
+	return predicate.Calculation(sql.FieldEQ(FieldPaid, v))
+}
+
+// PaidNEQ applies the NEQ predicate on the "paid" field.
+func PaidNEQ(v bool) predicate.Calculation {
+	return predicate.Calculation(sql.FieldNEQ(FieldPaid, v))
+}
+
 // RunIDEQ applies the EQ predicate on the "run_id" field.
 func RunIDEQ(v int) predicate.Calculation {
 	return predicate.Calculation(sql.FieldEQ(FieldRunID, v))
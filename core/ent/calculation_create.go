@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/trueegorletov/analabit/core"
 	"github.com/trueegorletov/analabit/core/ent/calculation"
 	"github.com/trueegorletov/analabit/core/ent/heading"
 	"github.com/trueegorletov/analabit/core/ent/run"
@@ -34,6 +35,34 @@ func (cc *CalculationCreate) SetAdmittedPlace(i int) *CalculationCreate {
 	return cc
 }
 
+// SetStage sets the "stage" field.
+func (cc *CalculationCreate) SetStage(cs core.AdmissionStage) *CalculationCreate {
+	cc.mutation.SetStage(cs)
+	return cc
+}
+
+// SetNillableStage sets the "stage" field if the given value is not nil.
+func (cc *CalculationCreate) SetNillableStage(cs *core.AdmissionStage) *CalculationCreate {
+	if cs != nil {
+		cc.SetStage(*cs)
+	}
+	return cc
+}
+
+// SetPaid sets the "paid" field.
+func (cc *CalculationCreate) SetPaid(b bool) *CalculationCreate {
+	cc.mutation.SetPaid(b)
+	return cc
+}
+
+// SetNillablePaid sets the "paid" field if the given value is not nil.
+func (cc *CalculationCreate) SetNillablePaid(b *bool) *CalculationCreate {
+	if b != nil {
+		cc.SetPaid(*b)
+	}
+	return cc
+}
+
 // SetRunID sets the "run_id" field.
 func (cc *CalculationCreate) SetRunID(i int) *CalculationCreate {
 	cc.mutation.SetRunID(i)
@@ -105,6 +134,10 @@ func (cc *CalculationCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (cc *CalculationCreate) defaults() {
+	if _, ok := cc.mutation.Paid(); !ok {
+		v := calculation.DefaultPaid
+		cc.mutation.SetPaid(v)
+	}
 	if _, ok := cc.mutation.UpdatedAt(); !ok {
 		v := calculation.DefaultUpdatedAt()
 		cc.mutation.SetUpdatedAt(v)
@@ -119,6 +152,9 @@ func (cc *CalculationCreate) check() error {
 	if _, ok := cc.mutation.AdmittedPlace(); !ok {
 		return &ValidationError{Name: "admitted_place", err: errors.New(`ent: missing required field "Calculation.admitted_place"`)}
 	}
+	if _, ok := cc.mutation.Paid(); !ok {
+		return &ValidationError{Name: "paid", err: errors.New(`ent: missing required field "Calculation.paid"`)}
+	}
 	if _, ok := cc.mutation.RunID(); !ok {
 		return &ValidationError{Name: "run_id", err: errors.New(`ent: missing required field "Calculation.run_id"`)}
 	}
@@ -165,6 +201,14 @@ func (cc *CalculationCreate) createSpec() (*Calculation, *sqlgraph.CreateSpec) {
 		_spec.SetField(calculation.FieldAdmittedPlace, field.TypeInt, value)
 		_node.AdmittedPlace = value
 	}
+	if value, ok := cc.mutation.Stage(); ok {
+		_spec.SetField(calculation.FieldStage, field.TypeInt, value)
+		_node.Stage = value
+	}
+	if value, ok := cc.mutation.Paid(); ok {
+		_spec.SetField(calculation.FieldPaid, field.TypeBool, value)
+		_node.Paid = value
+	}
 	if value, ok := cc.mutation.UpdatedAt(); ok {
 		_spec.SetField(calculation.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/trueegorletov/analabit/core"
 	"github.com/trueegorletov/analabit/core/ent/calculation"
 	"github.com/trueegorletov/analabit/core/ent/heading"
 	"github.com/trueegorletov/analabit/core/ent/predicate"
@@ -65,6 +66,47 @@ func (cu *CalculationUpdate) AddAdmittedPlace(i int) *CalculationUpdate {
 	return cu
 }
 
+// SetStage sets the "stage" field.
+func (cu *CalculationUpdate) SetStage(cs core.AdmissionStage) *CalculationUpdate {
+	cu.mutation.ResetStage()
+	cu.mutation.SetStage(cs)
+	return cu
+}
+
+// SetNillableStage sets the "stage" field if the given value is not nil.
+func (cu *CalculationUpdate) SetNillableStage(cs *core.AdmissionStage) *CalculationUpdate {
+	if cs != nil {
+		cu.SetStage(*cs)
+	}
+	return cu
+}
+
+// AddStage adds cs to the "stage" field.
+func (cu *CalculationUpdate) AddStage(cs core.AdmissionStage) *CalculationUpdate {
+	cu.mutation.AddStage(cs)
+	return cu
+}
+
+// ClearStage clears the value of the "stage" field.
+func (cu *CalculationUpdate) ClearStage() *CalculationUpdate {
+	cu.mutation.ClearStage()
+	return cu
+}
+
+// SetPaid sets the "paid" field.
+func (cu *CalculationUpdate) SetPaid(b bool) *CalculationUpdate {
+	cu.mutation.SetPaid(b)
+	return cu
+}
+
+// SetNillablePaid sets the "paid" field if the given value is not nil.
+func (cu *CalculationUpdate) SetNillablePaid(b *bool) *CalculationUpdate {
+	if b != nil {
+		cu.SetPaid(*b)
+	}
+	return cu
+}
+
 // SetRunID sets the "run_id" field.
 func (cu *CalculationUpdate) SetRunID(i int) *CalculationUpdate {
 	cu.mutation.SetRunID(i)
@@ -186,6 +228,18 @@ func (cu *CalculationUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := cu.mutation.AddedAdmittedPlace(); ok {
 		_spec.AddField(calculation.FieldAdmittedPlace, field.TypeInt, value)
 	}
+	if value, ok := cu.mutation.Stage(); ok {
+		_spec.SetField(calculation.FieldStage, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.AddedStage(); ok {
+		_spec.AddField(calculation.FieldStage, field.TypeInt, value)
+	}
+	if cu.mutation.StageCleared() {
+		_spec.ClearField(calculation.FieldStage, field.TypeInt)
+	}
+	if value, ok := cu.mutation.Paid(); ok {
+		_spec.SetField(calculation.FieldPaid, field.TypeBool, value)
+	}
 	if value, ok := cu.mutation.UpdatedAt(); ok {
 		_spec.SetField(calculation.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -302,6 +356,47 @@ func (cuo *CalculationUpdateOne) AddAdmittedPlace(i int) *CalculationUpdateOne {
 	return cuo
 }
 
+// SetStage sets the "stage" field.
+func (cuo *CalculationUpdateOne) SetStage(cs core.AdmissionStage) *CalculationUpdateOne {
+	cuo.mutation.ResetStage()
+	cuo.mutation.SetStage(cs)
+	return cuo
+}
+
+// SetNillableStage sets the "stage" field if the given value is not nil.
+func (cuo *CalculationUpdateOne) SetNillableStage(cs *core.AdmissionStage) *CalculationUpdateOne {
+	if cs != nil {
+		cuo.SetStage(*cs)
+	}
+	return cuo
+}
+
+// AddStage adds cs to the "stage" field.
+func (cuo *CalculationUpdateOne) AddStage(cs core.AdmissionStage) *CalculationUpdateOne {
+	cuo.mutation.AddStage(cs)
+	return cuo
+}
+
+// ClearStage clears the value of the "stage" field.
+func (cuo *CalculationUpdateOne) ClearStage() *CalculationUpdateOne {
+	cuo.mutation.ClearStage()
+	return cuo
+}
+
+// SetPaid sets the "paid" field.
+func (cuo *CalculationUpdateOne) SetPaid(b bool) *CalculationUpdateOne {
+	cuo.mutation.SetPaid(b)
+	return cuo
+}
+
+// SetNillablePaid sets the "paid" field if the given value is not nil.
+func (cuo *CalculationUpdateOne) SetNillablePaid(b *bool) *CalculationUpdateOne {
+	if b != nil {
+		cuo.SetPaid(*b)
+	}
+	return cuo
+}
+
 // SetRunID sets the "run_id" field.
 func (cuo *CalculationUpdateOne) SetRunID(i int) *CalculationUpdateOne {
 	cuo.mutation.SetRunID(i)
@@ -453,6 +548,18 @@ func (cuo *CalculationUpdateOne) sqlSave(ctx context.Context) (_node *Calculatio
 	if value, ok := cuo.mutation.AddedAdmittedPlace(); ok {
 		_spec.AddField(calculation.FieldAdmittedPlace, field.TypeInt, value)
 	}
+	if value, ok := cuo.mutation.Stage(); ok {
+		_spec.SetField(calculation.FieldStage, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.AddedStage(); ok {
+		_spec.AddField(calculation.FieldStage, field.TypeInt, value)
+	}
+	if cuo.mutation.StageCleared() {
+		_spec.ClearField(calculation.FieldStage, field.TypeInt)
+	}
+	if value, ok := cuo.mutation.Paid(); ok {
+		_spec.SetField(calculation.FieldPaid, field.TypeBool, value)
+	}
 	if value, ok := cuo.mutation.UpdatedAt(); ok {
 		_spec.SetField(calculation.FieldUpdatedAt, field.TypeTime, value)
 	}
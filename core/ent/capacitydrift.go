@@ -0,0 +1,317 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/trueegorletov/analabit/core/ent/capacitydrift"
+	"github.com/trueegorletov/analabit/core/ent/run"
+)
+
+// CapacityDrift is the model entity for the CapacityDrift schema.
+type CapacityDrift struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// VarsityCode holds the value of the "varsity_code" field.
+	VarsityCode string `json:"varsity_code,omitempty"`
+	// HeadingCode holds the value of the "heading_code" field.
+	HeadingCode string `json:"heading_code,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// RegistryRegular holds the value of the "registry_regular" field.
+	RegistryRegular int `json:"registry_regular,omitempty"`
+	// RegistryTargetQuota holds the value of the "registry_target_quota" field.
+	RegistryTargetQuota int `json:"registry_target_quota,omitempty"`
+	// RegistryDedicatedQuota holds the value of the "registry_dedicated_quota" field.
+	RegistryDedicatedQuota int `json:"registry_dedicated_quota,omitempty"`
+	// RegistrySpecialQuota holds the value of the "registry_special_quota" field.
+	RegistrySpecialQuota int `json:"registry_special_quota,omitempty"`
+	// RegistryTargetSubQuotas holds the value of the "registry_target_sub_quotas" field.
+	RegistryTargetSubQuotas map[string]int `json:"registry_target_sub_quotas,omitempty"`
+	// PublishedRegular holds the value of the "published_regular" field.
+	PublishedRegular int `json:"published_regular,omitempty"`
+	// PublishedTargetQuota holds the value of the "published_target_quota" field.
+	PublishedTargetQuota int `json:"published_target_quota,omitempty"`
+	// PublishedDedicatedQuota holds the value of the "published_dedicated_quota" field.
+	PublishedDedicatedQuota int `json:"published_dedicated_quota,omitempty"`
+	// PublishedSpecialQuota holds the value of the "published_special_quota" field.
+	PublishedSpecialQuota int `json:"published_special_quota,omitempty"`
+	// PublishedTargetSubQuotas holds the value of the "published_target_sub_quotas" field.
+	PublishedTargetSubQuotas map[string]int `json:"published_target_sub_quotas,omitempty"`
+	// TotalOnly holds the value of the "total_only" field.
+	TotalOnly bool `json:"total_only,omitempty"`
+	// Drifted holds the value of the "drifted" field.
+	Drifted bool `json:"drifted,omitempty"`
+	// Applied holds the value of the "applied" field.
+	Applied bool `json:"applied,omitempty"`
+	// RunID holds the value of the "run_id" field.
+	RunID int `json:"run_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CapacityDriftQuery when eager-loading is set.
+	Edges        CapacityDriftEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CapacityDriftEdges holds the relations/edges for other nodes in the graph.
+type CapacityDriftEdges struct {
+	// Run holds the value of the run edge.
+	Run *Run `json:"run,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// RunOrErr returns the Run value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CapacityDriftEdges) RunOrErr() (*Run, error) {
+	if e.Run != nil {
+		return e.Run, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: run.Label}
+	}
+	return nil, &NotLoadedError{edge: "run"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CapacityDrift) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case capacitydrift.FieldRegistryTargetSubQuotas, capacitydrift.FieldPublishedTargetSubQuotas:
+			values[i] = new([]byte)
+		case capacitydrift.FieldTotalOnly, capacitydrift.FieldDrifted, capacitydrift.FieldApplied:
+			values[i] = new(sql.NullBool)
+		case capacitydrift.FieldID, capacitydrift.FieldRegistryRegular, capacitydrift.FieldRegistryTargetQuota, capacitydrift.FieldRegistryDedicatedQuota, capacitydrift.FieldRegistrySpecialQuota, capacitydrift.FieldPublishedRegular, capacitydrift.FieldPublishedTargetQuota, capacitydrift.FieldPublishedDedicatedQuota, capacitydrift.FieldPublishedSpecialQuota, capacitydrift.FieldRunID:
+			values[i] = new(sql.NullInt64)
+		case capacitydrift.FieldVarsityCode, capacitydrift.FieldHeadingCode, capacitydrift.FieldName:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CapacityDrift fields.
+func (cd *CapacityDrift) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case capacitydrift.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			cd.ID = int(value.Int64)
+		case capacitydrift.FieldVarsityCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field varsity_code", values[i])
+			} else if value.Valid {
+				cd.VarsityCode = value.String
+			}
+		case capacitydrift.FieldHeadingCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field heading_code", values[i])
+			} else if value.Valid {
+				cd.HeadingCode = value.String
+			}
+		case capacitydrift.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				cd.Name = value.String
+			}
+		case capacitydrift.FieldRegistryRegular:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field registry_regular", values[i])
+			} else if value.Valid {
+				cd.RegistryRegular = int(value.Int64)
+			}
+		case capacitydrift.FieldRegistryTargetQuota:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field registry_target_quota", values[i])
+			} else if value.Valid {
+				cd.RegistryTargetQuota = int(value.Int64)
+			}
+		case capacitydrift.FieldRegistryDedicatedQuota:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field registry_dedicated_quota", values[i])
+			} else if value.Valid {
+				cd.RegistryDedicatedQuota = int(value.Int64)
+			}
+		case capacitydrift.FieldRegistrySpecialQuota:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field registry_special_quota", values[i])
+			} else if value.Valid {
+				cd.RegistrySpecialQuota = int(value.Int64)
+			}
+		case capacitydrift.FieldRegistryTargetSubQuotas:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field registry_target_sub_quotas", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &cd.RegistryTargetSubQuotas); err != nil {
+					return fmt.Errorf("unmarshal field registry_target_sub_quotas: %w", err)
+				}
+			}
+		case capacitydrift.FieldPublishedRegular:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field published_regular", values[i])
+			} else if value.Valid {
+				cd.PublishedRegular = int(value.Int64)
+			}
+		case capacitydrift.FieldPublishedTargetQuota:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field published_target_quota", values[i])
+			} else if value.Valid {
+				cd.PublishedTargetQuota = int(value.Int64)
+			}
+		case capacitydrift.FieldPublishedDedicatedQuota:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field published_dedicated_quota", values[i])
+			} else if value.Valid {
+				cd.PublishedDedicatedQuota = int(value.Int64)
+			}
+		case capacitydrift.FieldPublishedSpecialQuota:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field published_special_quota", values[i])
+			} else if value.Valid {
+				cd.PublishedSpecialQuota = int(value.Int64)
+			}
+		case capacitydrift.FieldPublishedTargetSubQuotas:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field published_target_sub_quotas", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &cd.PublishedTargetSubQuotas); err != nil {
+					return fmt.Errorf("unmarshal field published_target_sub_quotas: %w", err)
+				}
+			}
+		case capacitydrift.FieldTotalOnly:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field total_only", values[i])
+			} else if value.Valid {
+				cd.TotalOnly = value.Bool
+			}
+		case capacitydrift.FieldDrifted:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field drifted", values[i])
+			} else if value.Valid {
+				cd.Drifted = value.Bool
+			}
+		case capacitydrift.FieldApplied:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field applied", values[i])
+			} else if value.Valid {
+				cd.Applied = value.Bool
+			}
+		case capacitydrift.FieldRunID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field run_id", values[i])
+			} else if value.Valid {
+				cd.RunID = int(value.Int64)
+			}
+		default:
+			cd.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CapacityDrift.
+// This includes values selected through modifiers, order, etc.
+func (cd *CapacityDrift) Value(name string) (ent.Value, error) {
+	return cd.selectValues.Get(name)
+}
+
+// QueryRun queries the "run" edge of the CapacityDrift entity.
+func (cd *CapacityDrift) QueryRun() *RunQuery {
+	return NewCapacityDriftClient(cd.config).QueryRun(cd)
+}
+
+// Update returns a builder for updating this CapacityDrift.
+// Note that you need to call CapacityDrift.Unwrap() before calling this method if this CapacityDrift
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cd *CapacityDrift) Update() *CapacityDriftUpdateOne {
+	return NewCapacityDriftClient(cd.config).UpdateOne(cd)
+}
+
+// Unwrap unwraps the CapacityDrift entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cd *CapacityDrift) Unwrap() *CapacityDrift {
+	_tx, ok := cd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CapacityDrift is not a transactional entity")
+	}
+	cd.config.driver = _tx.drv
+	return cd
+}
+
+// String implements the fmt.Stringer.
+func (cd *CapacityDrift) String() string {
+	var builder strings.Builder
+	builder.WriteString("CapacityDrift(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cd.ID))
+	builder.WriteString("varsity_code=")
+	builder.WriteString(cd.VarsityCode)
+	builder.WriteString(", ")
+	builder.WriteString("heading_code=")
+	builder.WriteString(cd.HeadingCode)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(cd.Name)
+	builder.WriteString(", ")
+	builder.WriteString("registry_regular=")
+	builder.WriteString(fmt.Sprintf("%v", cd.RegistryRegular))
+	builder.WriteString(", ")
+	builder.WriteString("registry_target_quota=")
+	builder.WriteString(fmt.Sprintf("%v", cd.RegistryTargetQuota))
+	builder.WriteString(", ")
+	builder.WriteString("registry_dedicated_quota=")
+	builder.WriteString(fmt.Sprintf("%v", cd.RegistryDedicatedQuota))
+	builder.WriteString(", ")
+	builder.WriteString("registry_special_quota=")
+	builder.WriteString(fmt.Sprintf("%v", cd.RegistrySpecialQuota))
+	builder.WriteString(", ")
+	builder.WriteString("registry_target_sub_quotas=")
+	builder.WriteString(fmt.Sprintf("%v", cd.RegistryTargetSubQuotas))
+	builder.WriteString(", ")
+	builder.WriteString("published_regular=")
+	builder.WriteString(fmt.Sprintf("%v", cd.PublishedRegular))
+	builder.WriteString(", ")
+	builder.WriteString("published_target_quota=")
+	builder.WriteString(fmt.Sprintf("%v", cd.PublishedTargetQuota))
+	builder.WriteString(", ")
+	builder.WriteString("published_dedicated_quota=")
+	builder.WriteString(fmt.Sprintf("%v", cd.PublishedDedicatedQuota))
+	builder.WriteString(", ")
+	builder.WriteString("published_special_quota=")
+	builder.WriteString(fmt.Sprintf("%v", cd.PublishedSpecialQuota))
+	builder.WriteString(", ")
+	builder.WriteString("published_target_sub_quotas=")
+	builder.WriteString(fmt.Sprintf("%v", cd.PublishedTargetSubQuotas))
+	builder.WriteString(", ")
+	builder.WriteString("total_only=")
+	builder.WriteString(fmt.Sprintf("%v", cd.TotalOnly))
+	builder.WriteString(", ")
+	builder.WriteString("drifted=")
+	builder.WriteString(fmt.Sprintf("%v", cd.Drifted))
+	builder.WriteString(", ")
+	builder.WriteString("applied=")
+	builder.WriteString(fmt.Sprintf("%v", cd.Applied))
+	builder.WriteString(", ")
+	builder.WriteString("run_id=")
+	builder.WriteString(fmt.Sprintf("%v", cd.RunID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CapacityDrifts is a parsable slice of CapacityDrift.
+type CapacityDrifts []*CapacityDrift
@@ -0,0 +1,196 @@
+// Code generated by ent, DO NOT EDIT.
+
+package capacitydrift
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the capacitydrift type in the database.
+	Label = "capacity_drift"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldVarsityCode holds the string denoting the varsity_code field in the database.
+	FieldVarsityCode = "varsity_code"
+	// FieldHeadingCode holds the string denoting the heading_code field in the database.
+	FieldHeadingCode = "heading_code"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldRegistryRegular holds the string denoting the registry_regular field in the database.
+	FieldRegistryRegular = "registry_regular"
+	// FieldRegistryTargetQuota holds the string denoting the registry_target_quota field in the database.
+	FieldRegistryTargetQuota = "registry_target_quota"
+	// FieldRegistryDedicatedQuota holds the string denoting the registry_dedicated_quota field in the database.
+	FieldRegistryDedicatedQuota = "registry_dedicated_quota"
+	// FieldRegistrySpecialQuota holds the string denoting the registry_special_quota field in the database.
+	FieldRegistrySpecialQuota = "registry_special_quota"
+	// FieldRegistryTargetSubQuotas holds the string denoting the registry_target_sub_quotas field in the database.
+	FieldRegistryTargetSubQuotas = "registry_target_sub_quotas"
+	// FieldPublishedRegular holds the string denoting the published_regular field in the database.
+	FieldPublishedRegular = "published_regular"
+	// FieldPublishedTargetQuota holds the string denoting the published_target_quota field in the database.
+	FieldPublishedTargetQuota = "published_target_quota"
+	// FieldPublishedDedicatedQuota holds the string denoting the published_dedicated_quota field in the database.
+	FieldPublishedDedicatedQuota = "published_dedicated_quota"
+	// FieldPublishedSpecialQuota holds the string denoting the published_special_quota field in the database.
+	FieldPublishedSpecialQuota = "published_special_quota"
+	// FieldPublishedTargetSubQuotas holds the string denoting the published_target_sub_quotas field in the database.
+	FieldPublishedTargetSubQuotas = "published_target_sub_quotas"
+	// FieldTotalOnly holds the string denoting the total_only field in the database.
+	FieldTotalOnly = "total_only"
+	// FieldDrifted holds the string denoting the drifted field in the database.
+	FieldDrifted = "drifted"
+	// FieldApplied holds the string denoting the applied field in the database.
+	FieldApplied = "applied"
+	// FieldRunID holds the string denoting the run_id field in the database.
+	FieldRunID = "run_id"
+	// EdgeRun holds the string denoting the run edge name in mutations.
+	EdgeRun = "run"
+	// Table holds the table name of the capacitydrift in the database.
+	Table = "capacity_drifts"
+	// RunTable is the table that holds the run relation/edge.
+	RunTable = "capacity_drifts"
+	// RunInverseTable is the table name for the Run entity.
+	// It exists in this package in order to avoid circular dependency with the "run" package.
+	RunInverseTable = "runs"
+	// RunColumn is the table column denoting the run relation/edge.
+	RunColumn = "run_id"
+)
+
+// Columns holds all SQL columns for capacitydrift fields.
+var Columns = []string{
+	FieldID,
+	FieldVarsityCode,
+	FieldHeadingCode,
+	FieldName,
+	FieldRegistryRegular,
+	FieldRegistryTargetQuota,
+	FieldRegistryDedicatedQuota,
+	FieldRegistrySpecialQuota,
+	FieldRegistryTargetSubQuotas,
+	FieldPublishedRegular,
+	FieldPublishedTargetQuota,
+	FieldPublishedDedicatedQuota,
+	FieldPublishedSpecialQuota,
+	FieldPublishedTargetSubQuotas,
+	FieldTotalOnly,
+	FieldDrifted,
+	FieldApplied,
+	FieldRunID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultHeadingCode holds the default value on creation for the "heading_code" field.
+	DefaultHeadingCode string
+	// DefaultTotalOnly holds the default value on creation for the "total_only" field.
+	DefaultTotalOnly bool
+)
+
+// OrderOption defines the ordering options for the CapacityDrift queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByVarsityCode orders the results by the varsity_code field.
+func ByVarsityCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVarsityCode, opts...).ToFunc()
+}
+
+// ByHeadingCode orders the results by the heading_code field.
+func ByHeadingCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHeadingCode, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByRegistryRegular orders the results by the registry_regular field.
+func ByRegistryRegular(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRegistryRegular, opts...).ToFunc()
+}
+
+// ByRegistryTargetQuota orders the results by the registry_target_quota field.
+func ByRegistryTargetQuota(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRegistryTargetQuota, opts...).ToFunc()
+}
+
+// ByRegistryDedicatedQuota orders the results by the registry_dedicated_quota field.
+func ByRegistryDedicatedQuota(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRegistryDedicatedQuota, opts...).ToFunc()
+}
+
+// ByRegistrySpecialQuota orders the results by the registry_special_quota field.
+func ByRegistrySpecialQuota(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRegistrySpecialQuota, opts...).ToFunc()
+}
+
+// ByPublishedRegular orders the results by the published_regular field.
+func ByPublishedRegular(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPublishedRegular, opts...).ToFunc()
+}
+
+// ByPublishedTargetQuota orders the results by the published_target_quota field.
+func ByPublishedTargetQuota(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPublishedTargetQuota, opts...).ToFunc()
+}
+
+// ByPublishedDedicatedQuota orders the results by the published_dedicated_quota field.
+func ByPublishedDedicatedQuota(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPublishedDedicatedQuota, opts...).ToFunc()
+}
+
+// ByPublishedSpecialQuota orders the results by the published_special_quota field.
+func ByPublishedSpecialQuota(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPublishedSpecialQuota, opts...).ToFunc()
+}
+
+// ByTotalOnly orders the results by the total_only field.
+func ByTotalOnly(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalOnly, opts...).ToFunc()
+}
+
+// ByDrifted orders the results by the drifted field.
+func ByDrifted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDrifted, opts...).ToFunc()
+}
+
+// ByApplied orders the results by the applied field.
+func ByApplied(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApplied, opts...).ToFunc()
+}
+
+// ByRunID orders the results by the run_id field.
+func ByRunID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRunID, opts...).ToFunc()
+}
+
+// ByRunField orders the results by run field.
+func ByRunField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRunStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newRunStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RunInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, RunTable, RunColumn),
+	)
+}
@@ -0,0 +1,762 @@
+// Code generated by ent, DO NOT EDIT.
+
+package capacitydrift
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/trueegorletov/analabit/core/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldLTE(FieldID, id))
+}
+
+// VarsityCode applies equality check predicate on the "varsity_code" field. It's identical to VarsityCodeEQ.
+func VarsityCode(v string) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldEQ(FieldVarsityCode, v))
+}
+
+// HeadingCode applies equality check predicate on the "heading_code" field. It's identical to HeadingCodeEQ.
+func HeadingCode(v string) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldEQ(FieldHeadingCode, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldEQ(FieldName, v))
+}
+
+// RegistryRegular applies equality check predicate on the "registry_regular" field. It's identical to RegistryRegularEQ.
+func RegistryRegular(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldEQ(FieldRegistryRegular, v))
+}
+
+// RegistryTargetQuota applies equality check predicate on the "registry_target_quota" field. It's identical to RegistryTargetQuotaEQ.
+func RegistryTargetQuota(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldEQ(FieldRegistryTargetQuota, v))
+}
+
+// RegistryDedicatedQuota applies equality check predicate on the "registry_dedicated_quota" field. It's identical to RegistryDedicatedQuotaEQ.
+func RegistryDedicatedQuota(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldEQ(FieldRegistryDedicatedQuota, v))
+}
+
+// RegistrySpecialQuota applies equality check predicate on the "registry_special_quota" field. It's identical to RegistrySpecialQuotaEQ.
+func RegistrySpecialQuota(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldEQ(FieldRegistrySpecialQuota, v))
+}
+
+// PublishedRegular applies equality check predicate on the "published_regular" field. It's identical to PublishedRegularEQ.
+func PublishedRegular(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldEQ(FieldPublishedRegular, v))
+}
+
+// PublishedTargetQuota applies equality check predicate on the "published_target_quota" field. It's identical to PublishedTargetQuotaEQ.
+func PublishedTargetQuota(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldEQ(FieldPublishedTargetQuota, v))
+}
+
+// PublishedDedicatedQuota applies equality check predicate on the "published_dedicated_quota" field. It's identical to PublishedDedicatedQuotaEQ.
+func PublishedDedicatedQuota(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldEQ(FieldPublishedDedicatedQuota, v))
+}
+
+// PublishedSpecialQuota applies equality check predicate on the "published_special_quota" field. It's identical to PublishedSpecialQuotaEQ.
+func PublishedSpecialQuota(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldEQ(FieldPublishedSpecialQuota, v))
+}
+
+// TotalOnly applies equality check predicate on the "total_only" field. It's identical to TotalOnlyEQ.
+func TotalOnly(v bool) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldEQ(FieldTotalOnly, v))
+}
+
+// Drifted applies equality check predicate on the "drifted" field. It's identical to DriftedEQ.
+func Drifted(v bool) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldEQ(FieldDrifted, v))
+}
+
+// Applied applies equality check predicate on the "applied" field. It's identical to AppliedEQ.
+func Applied(v bool) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldEQ(FieldApplied, v))
+}
+
+// RunID applies equality check predicate on the "run_id" field. It's identical to RunIDEQ.
+func RunID(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldEQ(FieldRunID, v))
+}
+
+// VarsityCodeEQ applies the EQ predicate on the "varsity_code" field.
+func VarsityCodeEQ(v string) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldEQ(FieldVarsityCode, v))
+}
+
+// VarsityCodeNEQ applies the NEQ predicate on the "varsity_code" field.
+func VarsityCodeNEQ(v string) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldNEQ(FieldVarsityCode, v))
+}
+
+// VarsityCodeIn applies the In predicate on the "varsity_code" field.
+func VarsityCodeIn(vs ...string) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldIn(FieldVarsityCode, vs...))
+}
+
+// VarsityCodeNotIn applies the NotIn predicate on the "varsity_code" field.
+func VarsityCodeNotIn(vs ...string) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldNotIn(FieldVarsityCode, vs...))
+}
+
+// VarsityCodeGT applies the GT predicate on the "varsity_code" field.
+func VarsityCodeGT(v string) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldGT(FieldVarsityCode, v))
+}
+
+// VarsityCodeGTE applies the GTE predicate on the "varsity_code" field.
+func VarsityCodeGTE(v string) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldGTE(FieldVarsityCode, v))
+}
+
+// VarsityCodeLT applies the LT predicate on the "varsity_code" field.
+func VarsityCodeLT(v string) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldLT(FieldVarsityCode, v))
+}
+
+// VarsityCodeLTE applies the LTE predicate on the "varsity_code" field.
+func VarsityCodeLTE(v string) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldLTE(FieldVarsityCode, v))
+}
+
+// VarsityCodeContains applies the Contains predicate on the "varsity_code" field.
+func VarsityCodeContains(v string) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldContains(FieldVarsityCode, v))
+}
+
+// VarsityCodeHasPrefix applies the HasPrefix predicate on the "varsity_code" field.
+func VarsityCodeHasPrefix(v string) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldHasPrefix(FieldVarsityCode, v))
+}
+
+// VarsityCodeHasSuffix applies the HasSuffix predicate on the "varsity_code" field.
+func VarsityCodeHasSuffix(v string) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldHasSuffix(FieldVarsityCode, v))
+}
+
+// VarsityCodeEqualFold applies the EqualFold predicate on the "varsity_code" field.
+func VarsityCodeEqualFold(v string) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldEqualFold(FieldVarsityCode, v))
+}
+
+// VarsityCodeContainsFold applies the ContainsFold predicate on the "varsity_code" field.
+func VarsityCodeContainsFold(v string) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldContainsFold(FieldVarsityCode, v))
+}
+
+// HeadingCodeEQ applies the EQ predicate on the "heading_code" field.
+func HeadingCodeEQ(v string) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldEQ(FieldHeadingCode, v))
+}
+
+// HeadingCodeNEQ applies the NEQ predicate on the "heading_code" field.
+func HeadingCodeNEQ(v string) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldNEQ(FieldHeadingCode, v))
+}
+
+// HeadingCodeIn applies the In predicate on the "heading_code" field.
+func HeadingCodeIn(vs ...string) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldIn(FieldHeadingCode, vs...))
+}
+
+// HeadingCodeNotIn applies the NotIn predicate on the "heading_code" field.
+func HeadingCodeNotIn(vs ...string) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldNotIn(FieldHeadingCode, vs...))
+}
+
+// HeadingCodeGT applies the GT predicate on the "heading_code" field.
+func HeadingCodeGT(v string) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldGT(FieldHeadingCode, v))
+}
+
+// HeadingCodeGTE applies the GTE predicate on the "heading_code" field.
+func HeadingCodeGTE(v string) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldGTE(FieldHeadingCode, v))
+}
+
+// HeadingCodeLT applies the LT predicate on the "heading_code" field.
+func HeadingCodeLT(v string) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldLT(FieldHeadingCode, v))
+}
+
+// HeadingCodeLTE applies the LTE predicate on the "heading_code" field.
+func HeadingCodeLTE(v string) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldLTE(FieldHeadingCode, v))
+}
+
+// HeadingCodeContains applies the Contains predicate on the "heading_code" field.
+func HeadingCodeContains(v string) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldContains(FieldHeadingCode, v))
+}
+
+// HeadingCodeHasPrefix applies the HasPrefix predicate on the "heading_code" field.
+func HeadingCodeHasPrefix(v string) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldHasPrefix(FieldHeadingCode, v))
+}
+
+// HeadingCodeHasSuffix applies the HasSuffix predicate on the "heading_code" field.
+func HeadingCodeHasSuffix(v string) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldHasSuffix(FieldHeadingCode, v))
+}
+
+// HeadingCodeEqualFold applies the EqualFold predicate on the "heading_code" field.
+func HeadingCodeEqualFold(v string) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldEqualFold(FieldHeadingCode, v))
+}
+
+// HeadingCodeContainsFold applies the ContainsFold predicate on the "heading_code" field.
+func HeadingCodeContainsFold(v string) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldContainsFold(FieldHeadingCode, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldIsNull(FieldName))
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldNotNull(FieldName))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldContainsFold(FieldName, v))
+}
+
+// RegistryRegularEQ applies the EQ predicate on the "registry_regular" field.
+func RegistryRegularEQ(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldEQ(FieldRegistryRegular, v))
+}
+
+// RegistryRegularNEQ applies the NEQ predicate on the "registry_regular" field.
+func RegistryRegularNEQ(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldNEQ(FieldRegistryRegular, v))
+}
+
+// RegistryRegularIn applies the In predicate on the "registry_regular" field.
+func RegistryRegularIn(vs ...int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldIn(FieldRegistryRegular, vs...))
+}
+
+// RegistryRegularNotIn applies the NotIn predicate on the "registry_regular" field.
+func RegistryRegularNotIn(vs ...int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldNotIn(FieldRegistryRegular, vs...))
+}
+
+// RegistryRegularGT applies the GT predicate on the "registry_regular" field.
+func RegistryRegularGT(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldGT(FieldRegistryRegular, v))
+}
+
+// RegistryRegularGTE applies the GTE predicate on the "registry_regular" field.
+func RegistryRegularGTE(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldGTE(FieldRegistryRegular, v))
+}
+
+// RegistryRegularLT applies the LT predicate on the "registry_regular" field.
+func RegistryRegularLT(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldLT(FieldRegistryRegular, v))
+}
+
+// RegistryRegularLTE applies the LTE predicate on the "registry_regular" field.
+func RegistryRegularLTE(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldLTE(FieldRegistryRegular, v))
+}
+
+// RegistryTargetQuotaEQ applies the EQ predicate on the "registry_target_quota" field.
+func RegistryTargetQuotaEQ(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldEQ(FieldRegistryTargetQuota, v))
+}
+
+// RegistryTargetQuotaNEQ applies the NEQ predicate on the "registry_target_quota" field.
+func RegistryTargetQuotaNEQ(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldNEQ(FieldRegistryTargetQuota, v))
+}
+
+// RegistryTargetQuotaIn applies the In predicate on the "registry_target_quota" field.
+func RegistryTargetQuotaIn(vs ...int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldIn(FieldRegistryTargetQuota, vs...))
+}
+
+// RegistryTargetQuotaNotIn applies the NotIn predicate on the "registry_target_quota" field.
+func RegistryTargetQuotaNotIn(vs ...int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldNotIn(FieldRegistryTargetQuota, vs...))
+}
+
+// RegistryTargetQuotaGT applies the GT predicate on the "registry_target_quota" field.
+func RegistryTargetQuotaGT(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldGT(FieldRegistryTargetQuota, v))
+}
+
+// RegistryTargetQuotaGTE applies the GTE predicate on the "registry_target_quota" field.
+func RegistryTargetQuotaGTE(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldGTE(FieldRegistryTargetQuota, v))
+}
+
+// RegistryTargetQuotaLT applies the LT predicate on the "registry_target_quota" field.
+func RegistryTargetQuotaLT(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldLT(FieldRegistryTargetQuota, v))
+}
+
+// RegistryTargetQuotaLTE applies the LTE predicate on the "registry_target_quota" field.
+func RegistryTargetQuotaLTE(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldLTE(FieldRegistryTargetQuota, v))
+}
+
+// RegistryDedicatedQuotaEQ applies the EQ predicate on the "registry_dedicated_quota" field.
+func RegistryDedicatedQuotaEQ(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldEQ(FieldRegistryDedicatedQuota, v))
+}
+
+// RegistryDedicatedQuotaNEQ applies the NEQ predicate on the "registry_dedicated_quota" field.
+func RegistryDedicatedQuotaNEQ(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldNEQ(FieldRegistryDedicatedQuota, v))
+}
+
+// RegistryDedicatedQuotaIn applies the In predicate on the "registry_dedicated_quota" field.
+func RegistryDedicatedQuotaIn(vs ...int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldIn(FieldRegistryDedicatedQuota, vs...))
+}
+
+// RegistryDedicatedQuotaNotIn applies the NotIn predicate on the "registry_dedicated_quota" field.
+func RegistryDedicatedQuotaNotIn(vs ...int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldNotIn(FieldRegistryDedicatedQuota, vs...))
+}
+
+// RegistryDedicatedQuotaGT applies the GT predicate on the "registry_dedicated_quota" field.
+func RegistryDedicatedQuotaGT(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldGT(FieldRegistryDedicatedQuota, v))
+}
+
+// RegistryDedicatedQuotaGTE applies the GTE predicate on the "registry_dedicated_quota" field.
+func RegistryDedicatedQuotaGTE(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldGTE(FieldRegistryDedicatedQuota, v))
+}
+
+// RegistryDedicatedQuotaLT applies the LT predicate on the "registry_dedicated_quota" field.
+func RegistryDedicatedQuotaLT(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldLT(FieldRegistryDedicatedQuota, v))
+}
+
+// RegistryDedicatedQuotaLTE applies the LTE predicate on the "registry_dedicated_quota" field.
+func RegistryDedicatedQuotaLTE(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldLTE(FieldRegistryDedicatedQuota, v))
+}
+
+// RegistrySpecialQuotaEQ applies the EQ predicate on the "registry_special_quota" field.
+func RegistrySpecialQuotaEQ(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldEQ(FieldRegistrySpecialQuota, v))
+}
+
+// RegistrySpecialQuotaNEQ applies the NEQ predicate on the "registry_special_quota" field.
+func RegistrySpecialQuotaNEQ(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldNEQ(FieldRegistrySpecialQuota, v))
+}
+
+// RegistrySpecialQuotaIn applies the In predicate on the "registry_special_quota" field.
+func RegistrySpecialQuotaIn(vs ...int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldIn(FieldRegistrySpecialQuota, vs...))
+}
+
+// RegistrySpecialQuotaNotIn applies the NotIn predicate on the "registry_special_quota" field.
+func RegistrySpecialQuotaNotIn(vs ...int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldNotIn(FieldRegistrySpecialQuota, vs...))
+}
+
+// RegistrySpecialQuotaGT applies the GT predicate on the "registry_special_quota" field.
+func RegistrySpecialQuotaGT(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldGT(FieldRegistrySpecialQuota, v))
+}
+
+// RegistrySpecialQuotaGTE applies the GTE predicate on the "registry_special_quota" field.
+func RegistrySpecialQuotaGTE(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldGTE(FieldRegistrySpecialQuota, v))
+}
+
+// RegistrySpecialQuotaLT applies the LT predicate on the "registry_special_quota" field.
+func RegistrySpecialQuotaLT(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldLT(FieldRegistrySpecialQuota, v))
+}
+
+// RegistrySpecialQuotaLTE applies the LTE predicate on the "registry_special_quota" field.
+func RegistrySpecialQuotaLTE(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldLTE(FieldRegistrySpecialQuota, v))
+}
+
+// RegistryTargetSubQuotasIsNil applies the IsNil predicate on the "registry_target_sub_quotas" field.
+func RegistryTargetSubQuotasIsNil() predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldIsNull(FieldRegistryTargetSubQuotas))
+}
+
+// RegistryTargetSubQuotasNotNil applies the NotNil predicate on the "registry_target_sub_quotas" field.
+func RegistryTargetSubQuotasNotNil() predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldNotNull(FieldRegistryTargetSubQuotas))
+}
+
+// PublishedRegularEQ applies the EQ predicate on the "published_regular" field.
+func PublishedRegularEQ(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldEQ(FieldPublishedRegular, v))
+}
+
+// PublishedRegularNEQ applies the NEQ predicate on the "published_regular" field.
+func PublishedRegularNEQ(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldNEQ(FieldPublishedRegular, v))
+}
+
+// PublishedRegularIn applies the In predicate on the "published_regular" field.
+func PublishedRegularIn(vs ...int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldIn(FieldPublishedRegular, vs...))
+}
+
+// PublishedRegularNotIn applies the NotIn predicate on the "published_regular" field.
+func PublishedRegularNotIn(vs ...int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldNotIn(FieldPublishedRegular, vs...))
+}
+
+// PublishedRegularGT applies the GT predicate on the "published_regular" field.
+func PublishedRegularGT(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldGT(FieldPublishedRegular, v))
+}
+
+// PublishedRegularGTE applies the GTE predicate on the "published_regular" field.
+func PublishedRegularGTE(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldGTE(FieldPublishedRegular, v))
+}
+
+// PublishedRegularLT applies the LT predicate on the "published_regular" field.
+func PublishedRegularLT(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldLT(FieldPublishedRegular, v))
+}
+
+// PublishedRegularLTE applies the LTE predicate on the "published_regular" field.
+func PublishedRegularLTE(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldLTE(FieldPublishedRegular, v))
+}
+
+// PublishedTargetQuotaEQ applies the EQ predicate on the "published_target_quota" field.
+func PublishedTargetQuotaEQ(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldEQ(FieldPublishedTargetQuota, v))
+}
+
+// PublishedTargetQuotaNEQ applies the NEQ predicate on the "published_target_quota" field.
+func PublishedTargetQuotaNEQ(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldNEQ(FieldPublishedTargetQuota, v))
+}
+
+// PublishedTargetQuotaIn applies the In predicate on the "published_target_quota" field.
+func PublishedTargetQuotaIn(vs ...int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldIn(FieldPublishedTargetQuota, vs...))
+}
+
+// PublishedTargetQuotaNotIn applies the NotIn predicate on the "published_target_quota" field.
+func PublishedTargetQuotaNotIn(vs ...int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldNotIn(FieldPublishedTargetQuota, vs...))
+}
+
+// PublishedTargetQuotaGT applies the GT predicate on the "published_target_quota" field.
+func PublishedTargetQuotaGT(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldGT(FieldPublishedTargetQuota, v))
+}
+
+// PublishedTargetQuotaGTE applies the GTE predicate on the "published_target_quota" field.
+func PublishedTargetQuotaGTE(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldGTE(FieldPublishedTargetQuota, v))
+}
+
+// PublishedTargetQuotaLT applies the LT predicate on the "published_target_quota" field.
+func PublishedTargetQuotaLT(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldLT(FieldPublishedTargetQuota, v))
+}
+
+// PublishedTargetQuotaLTE applies the LTE predicate on the "published_target_quota" field.
+func PublishedTargetQuotaLTE(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldLTE(FieldPublishedTargetQuota, v))
+}
+
+// PublishedDedicatedQuotaEQ applies the EQ predicate on the "published_dedicated_quota" field.
+func PublishedDedicatedQuotaEQ(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldEQ(FieldPublishedDedicatedQuota, v))
+}
+
+// PublishedDedicatedQuotaNEQ applies the NEQ predicate on the "published_dedicated_quota" field.
+func PublishedDedicatedQuotaNEQ(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldNEQ(FieldPublishedDedicatedQuota, v))
+}
+
+// PublishedDedicatedQuotaIn applies the In predicate on the "published_dedicated_quota" field.
+func PublishedDedicatedQuotaIn(vs ...int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldIn(FieldPublishedDedicatedQuota, vs...))
+}
+
+// PublishedDedicatedQuotaNotIn applies the NotIn predicate on the "published_dedicated_quota" field.
+func PublishedDedicatedQuotaNotIn(vs ...int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldNotIn(FieldPublishedDedicatedQuota, vs...))
+}
+
+// PublishedDedicatedQuotaGT applies the GT predicate on the "published_dedicated_quota" field.
+func PublishedDedicatedQuotaGT(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldGT(FieldPublishedDedicatedQuota, v))
+}
+
+// PublishedDedicatedQuotaGTE applies the GTE predicate on the "published_dedicated_quota" field.
+func PublishedDedicatedQuotaGTE(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldGTE(FieldPublishedDedicatedQuota, v))
+}
+
+// PublishedDedicatedQuotaLT applies the LT predicate on the "published_dedicated_quota" field.
+func PublishedDedicatedQuotaLT(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldLT(FieldPublishedDedicatedQuota, v))
+}
+
+// PublishedDedicatedQuotaLTE applies the LTE predicate on the "published_dedicated_quota" field.
+func PublishedDedicatedQuotaLTE(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldLTE(FieldPublishedDedicatedQuota, v))
+}
+
+// PublishedSpecialQuotaEQ applies the EQ predicate on the "published_special_quota" field.
+func PublishedSpecialQuotaEQ(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldEQ(FieldPublishedSpecialQuota, v))
+}
+
+// PublishedSpecialQuotaNEQ applies the NEQ predicate on the "published_special_quota" field.
+func PublishedSpecialQuotaNEQ(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldNEQ(FieldPublishedSpecialQuota, v))
+}
+
+// PublishedSpecialQuotaIn applies the In predicate on the "published_special_quota" field.
+func PublishedSpecialQuotaIn(vs ...int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldIn(FieldPublishedSpecialQuota, vs...))
+}
+
+// PublishedSpecialQuotaNotIn applies the NotIn predicate on the "published_special_quota" field.
+func PublishedSpecialQuotaNotIn(vs ...int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldNotIn(FieldPublishedSpecialQuota, vs...))
+}
+
+// PublishedSpecialQuotaGT applies the GT predicate on the "published_special_quota" field.
+func PublishedSpecialQuotaGT(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldGT(FieldPublishedSpecialQuota, v))
+}
+
+// PublishedSpecialQuotaGTE applies the GTE predicate on the "published_special_quota" field.
+func PublishedSpecialQuotaGTE(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldGTE(FieldPublishedSpecialQuota, v))
+}
+
+// PublishedSpecialQuotaLT applies the LT predicate on the "published_special_quota" field.
+func PublishedSpecialQuotaLT(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldLT(FieldPublishedSpecialQuota, v))
+}
+
+// PublishedSpecialQuotaLTE applies the LTE predicate on the "published_special_quota" field.
+func PublishedSpecialQuotaLTE(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldLTE(FieldPublishedSpecialQuota, v))
+}
+
+// PublishedTargetSubQuotasIsNil applies the IsNil predicate on the "published_target_sub_quotas" field.
+func PublishedTargetSubQuotasIsNil() predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldIsNull(FieldPublishedTargetSubQuotas))
+}
+
+// PublishedTargetSubQuotasNotNil applies the NotNil predicate on the "published_target_sub_quotas" field.
+func PublishedTargetSubQuotasNotNil() predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldNotNull(FieldPublishedTargetSubQuotas))
+}
+
+// TotalOnlyEQ applies the EQ predicate on the "total_only" field.
+func TotalOnlyEQ(v bool) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldEQ(FieldTotalOnly, v))
+}
+
+// TotalOnlyNEQ applies the NEQ predicate on the "total_only" field.
+func TotalOnlyNEQ(v bool) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldNEQ(FieldTotalOnly, v))
+}
+
+// DriftedEQ applies the EQ predicate on the "drifted" field.
+func DriftedEQ(v bool) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldEQ(FieldDrifted, v))
+}
+
+// DriftedNEQ applies the NEQ predicate on the "drifted" field.
+func DriftedNEQ(v bool) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldNEQ(FieldDrifted, v))
+}
+
+// AppliedEQ applies the EQ predicate on the "applied" field.
+func AppliedEQ(v bool) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldEQ(FieldApplied, v))
+}
+
+// AppliedNEQ applies the NEQ predicate on the "applied" field.
+func AppliedNEQ(v bool) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldNEQ(FieldApplied, v))
+}
+
+// RunIDEQ applies the EQ predicate on the "run_id" field.
+func RunIDEQ(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldEQ(FieldRunID, v))
+}
+
+// RunIDNEQ applies the NEQ predicate on the "run_id" field.
+func RunIDNEQ(v int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldNEQ(FieldRunID, v))
+}
+
+// RunIDIn applies the In predicate on the "run_id" field.
+func RunIDIn(vs ...int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldIn(FieldRunID, vs...))
+}
+
+// RunIDNotIn applies the NotIn predicate on the "run_id" field.
+func RunIDNotIn(vs ...int) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.FieldNotIn(FieldRunID, vs...))
+}
+
+// HasRun applies the HasEdge predicate on the "run" edge.
+func HasRun() predicate.CapacityDrift {
+	return predicate.CapacityDrift(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, RunTable, RunColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRunWith applies the HasEdge predicate on the "run" edge with a given conditions (other predicates).
+func HasRunWith(preds ...predicate.Run) predicate.CapacityDrift {
+	return predicate.CapacityDrift(func(s *sql.Selector) {
+		step := newRunStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CapacityDrift) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CapacityDrift) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CapacityDrift) predicate.CapacityDrift {
+	return predicate.CapacityDrift(sql.NotPredicates(p))
+}
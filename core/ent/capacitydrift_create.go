@@ -0,0 +1,442 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/trueegorletov/analabit/core/ent/capacitydrift"
+	"github.com/trueegorletov/analabit/core/ent/run"
+)
+
+// CapacityDriftCreate is the builder for creating a CapacityDrift entity.
+type CapacityDriftCreate struct {
+	config
+	mutation *CapacityDriftMutation
+	hooks    []Hook
+}
+
+// SetVarsityCode sets the "varsity_code" field.
+func (cdc *CapacityDriftCreate) SetVarsityCode(s string) *CapacityDriftCreate {
+	cdc.mutation.SetVarsityCode(s)
+	return cdc
+}
+
+// SetHeadingCode sets the "heading_code" field.
+func (cdc *CapacityDriftCreate) SetHeadingCode(s string) *CapacityDriftCreate {
+	cdc.mutation.SetHeadingCode(s)
+	return cdc
+}
+
+// SetNillableHeadingCode sets the "heading_code" field if the given value is not nil.
+func (cdc *CapacityDriftCreate) SetNillableHeadingCode(s *string) *CapacityDriftCreate {
+	if s != nil {
+		cdc.SetHeadingCode(*s)
+	}
+	return cdc
+}
+
+// SetName sets the "name" field.
+func (cdc *CapacityDriftCreate) SetName(s string) *CapacityDriftCreate {
+	cdc.mutation.SetName(s)
+	return cdc
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cdc *CapacityDriftCreate) SetNillableName(s *string) *CapacityDriftCreate {
+	if s != nil {
+		cdc.SetName(*s)
+	}
+	return cdc
+}
+
+// SetRegistryRegular sets the "registry_regular" field.
+func (cdc *CapacityDriftCreate) SetRegistryRegular(i int) *CapacityDriftCreate {
+	cdc.mutation.SetRegistryRegular(i)
+	return cdc
+}
+
+// SetRegistryTargetQuota sets the "registry_target_quota" field.
+func (cdc *CapacityDriftCreate) SetRegistryTargetQuota(i int) *CapacityDriftCreate {
+	cdc.mutation.SetRegistryTargetQuota(i)
+	return cdc
+}
+
+// SetRegistryDedicatedQuota sets the "registry_dedicated_quota" field.
+func (cdc *CapacityDriftCreate) SetRegistryDedicatedQuota(i int) *CapacityDriftCreate {
+	cdc.mutation.SetRegistryDedicatedQuota(i)
+	return cdc
+}
+
+// SetRegistrySpecialQuota sets the "registry_special_quota" field.
+func (cdc *CapacityDriftCreate) SetRegistrySpecialQuota(i int) *CapacityDriftCreate {
+	cdc.mutation.SetRegistrySpecialQuota(i)
+	return cdc
+}
+
+// SetRegistryTargetSubQuotas sets the "registry_target_sub_quotas" field.
+func (cdc *CapacityDriftCreate) SetRegistryTargetSubQuotas(m map[string]int) *CapacityDriftCreate {
+	cdc.mutation.SetRegistryTargetSubQuotas(m)
+	return cdc
+}
+
+// SetPublishedRegular sets the "published_regular" field.
+func (cdc *CapacityDriftCreate) SetPublishedRegular(i int) *CapacityDriftCreate {
+	cdc.mutation.SetPublishedRegular(i)
+	return cdc
+}
+
+// SetPublishedTargetQuota sets the "published_target_quota" field.
+func (cdc *CapacityDriftCreate) SetPublishedTargetQuota(i int) *CapacityDriftCreate {
+	cdc.mutation.SetPublishedTargetQuota(i)
+	return cdc
+}
+
+// SetPublishedDedicatedQuota sets the "published_dedicated_quota" field.
+func (cdc *CapacityDriftCreate) SetPublishedDedicatedQuota(i int) *CapacityDriftCreate {
+	cdc.mutation.SetPublishedDedicatedQuota(i)
+	return cdc
+}
+
+// SetPublishedSpecialQuota sets the "published_special_quota" field.
+func (cdc *CapacityDriftCreate) SetPublishedSpecialQuota(i int) *CapacityDriftCreate {
+	cdc.mutation.SetPublishedSpecialQuota(i)
+	return cdc
+}
+
+// SetPublishedTargetSubQuotas sets the "published_target_sub_quotas" field.
+func (cdc *CapacityDriftCreate) SetPublishedTargetSubQuotas(m map[string]int) *CapacityDriftCreate {
+	cdc.mutation.SetPublishedTargetSubQuotas(m)
+	return cdc
+}
+
+// SetTotalOnly sets the "total_only" field.
+func (cdc *CapacityDriftCreate) SetTotalOnly(b bool) *CapacityDriftCreate {
+	cdc.mutation.SetTotalOnly(b)
+	return cdc
+}
+
+// SetNillableTotalOnly sets the "total_only" field if the given value is not nil.
+func (cdc *CapacityDriftCreate) SetNillableTotalOnly(b *bool) *CapacityDriftCreate {
+	if b != nil {
+		cdc.SetTotalOnly(*b)
+	}
+	return cdc
+}
+
+// SetDrifted sets the "drifted" field.
+func (cdc *CapacityDriftCreate) SetDrifted(b bool) *CapacityDriftCreate {
+	cdc.mutation.SetDrifted(b)
+	return cdc
+}
+
+// SetApplied sets the "applied" field.
+func (cdc *CapacityDriftCreate) SetApplied(b bool) *CapacityDriftCreate {
+	cdc.mutation.SetApplied(b)
+	return cdc
+}
+
+// SetRunID sets the "run_id" field.
+func (cdc *CapacityDriftCreate) SetRunID(i int) *CapacityDriftCreate {
+	cdc.mutation.SetRunID(i)
+	return cdc
+}
+
+// SetRun sets the "run" edge to the Run entity.
+func (cdc *CapacityDriftCreate) SetRun(r *Run) *CapacityDriftCreate {
+	return cdc.SetRunID(r.ID)
+}
+
+// Mutation returns the CapacityDriftMutation object of the builder.
+func (cdc *CapacityDriftCreate) Mutation() *CapacityDriftMutation {
+	return cdc.mutation
+}
+
+// Save creates the CapacityDrift in the database.
+func (cdc *CapacityDriftCreate) Save(ctx context.Context) (*CapacityDrift, error) {
+	cdc.defaults()
+	return withHooks(ctx, cdc.sqlSave, cdc.mutation, cdc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cdc *CapacityDriftCreate) SaveX(ctx context.Context) *CapacityDrift {
+	v, err := cdc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cdc *CapacityDriftCreate) Exec(ctx context.Context) error {
+	_, err := cdc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cdc *CapacityDriftCreate) ExecX(ctx context.Context) {
+	if err := cdc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cdc *CapacityDriftCreate) defaults() {
+	if _, ok := cdc.mutation.HeadingCode(); !ok {
+		v := capacitydrift.DefaultHeadingCode
+		cdc.mutation.SetHeadingCode(v)
+	}
+	if _, ok := cdc.mutation.TotalOnly(); !ok {
+		v := capacitydrift.DefaultTotalOnly
+		cdc.mutation.SetTotalOnly(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cdc *CapacityDriftCreate) check() error {
+	if _, ok := cdc.mutation.VarsityCode(); !ok {
+		return &ValidationError{Name: "varsity_code", err: errors.New(`ent: missing required field "CapacityDrift.varsity_code"`)}
+	}
+	if _, ok := cdc.mutation.HeadingCode(); !ok {
+		return &ValidationError{Name: "heading_code", err: errors.New(`ent: missing required field "CapacityDrift.heading_code"`)}
+	}
+	if _, ok := cdc.mutation.RegistryRegular(); !ok {
+		return &ValidationError{Name: "registry_regular", err: errors.New(`ent: missing required field "CapacityDrift.registry_regular"`)}
+	}
+	if _, ok := cdc.mutation.RegistryTargetQuota(); !ok {
+		return &ValidationError{Name: "registry_target_quota", err: errors.New(`ent: missing required field "CapacityDrift.registry_target_quota"`)}
+	}
+	if _, ok := cdc.mutation.RegistryDedicatedQuota(); !ok {
+		return &ValidationError{Name: "registry_dedicated_quota", err: errors.New(`ent: missing required field "CapacityDrift.registry_dedicated_quota"`)}
+	}
+	if _, ok := cdc.mutation.RegistrySpecialQuota(); !ok {
+		return &ValidationError{Name: "registry_special_quota", err: errors.New(`ent: missing required field "CapacityDrift.registry_special_quota"`)}
+	}
+	if _, ok := cdc.mutation.PublishedRegular(); !ok {
+		return &ValidationError{Name: "published_regular", err: errors.New(`ent: missing required field "CapacityDrift.published_regular"`)}
+	}
+	if _, ok := cdc.mutation.PublishedTargetQuota(); !ok {
+		return &ValidationError{Name: "published_target_quota", err: errors.New(`ent: missing required field "CapacityDrift.published_target_quota"`)}
+	}
+	if _, ok := cdc.mutation.PublishedDedicatedQuota(); !ok {
+		return &ValidationError{Name: "published_dedicated_quota", err: errors.New(`ent: missing required field "CapacityDrift.published_dedicated_quota"`)}
+	}
+	if _, ok := cdc.mutation.PublishedSpecialQuota(); !ok {
+		return &ValidationError{Name: "published_special_quota", err: errors.New(`ent: missing required field "CapacityDrift.published_special_quota"`)}
+	}
+	if _, ok := cdc.mutation.TotalOnly(); !ok {
+		return &ValidationError{Name: "total_only", err: errors.New(`ent: missing required field "CapacityDrift.total_only"`)}
+	}
+	if _, ok := cdc.mutation.Drifted(); !ok {
+		return &ValidationError{Name: "drifted", err: errors.New(`ent: missing required field "CapacityDrift.drifted"`)}
+	}
+	if _, ok := cdc.mutation.Applied(); !ok {
+		return &ValidationError{Name: "applied", err: errors.New(`ent: missing required field "CapacityDrift.applied"`)}
+	}
+	if _, ok := cdc.mutation.RunID(); !ok {
+		return &ValidationError{Name: "run_id", err: errors.New(`ent: missing required field "CapacityDrift.run_id"`)}
+	}
+	if len(cdc.mutation.RunIDs()) == 0 {
+		return &ValidationError{Name: "run", err: errors.New(`ent: missing required edge "CapacityDrift.run"`)}
+	}
+	return nil
+}
+
+func (cdc *CapacityDriftCreate) sqlSave(ctx context.Context) (*CapacityDrift, error) {
+	if err := cdc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cdc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cdc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	cdc.mutation.id = &_node.ID
+	cdc.mutation.done = true
+	return _node, nil
+}
+
+func (cdc *CapacityDriftCreate) createSpec() (*CapacityDrift, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CapacityDrift{config: cdc.config}
+		_spec = sqlgraph.NewCreateSpec(capacitydrift.Table, sqlgraph.NewFieldSpec(capacitydrift.FieldID, field.TypeInt))
+	)
+	if value, ok := cdc.mutation.VarsityCode(); ok {
+		_spec.SetField(capacitydrift.FieldVarsityCode, field.TypeString, value)
+		_node.VarsityCode = value
+	}
+	if value, ok := cdc.mutation.HeadingCode(); ok {
+		_spec.SetField(capacitydrift.FieldHeadingCode, field.TypeString, value)
+		_node.HeadingCode = value
+	}
+	if value, ok := cdc.mutation.Name(); ok {
+		_spec.SetField(capacitydrift.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := cdc.mutation.RegistryRegular(); ok {
+		_spec.SetField(capacitydrift.FieldRegistryRegular, field.TypeInt, value)
+		_node.RegistryRegular = value
+	}
+	if value, ok := cdc.mutation.RegistryTargetQuota(); ok {
+		_spec.SetField(capacitydrift.FieldRegistryTargetQuota, field.TypeInt, value)
+		_node.RegistryTargetQuota = value
+	}
+	if value, ok := cdc.mutation.RegistryDedicatedQuota(); ok {
+		_spec.SetField(capacitydrift.FieldRegistryDedicatedQuota, field.TypeInt, value)
+		_node.RegistryDedicatedQuota = value
+	}
+	if value, ok := cdc.mutation.RegistrySpecialQuota(); ok {
+		_spec.SetField(capacitydrift.FieldRegistrySpecialQuota, field.TypeInt, value)
+		_node.RegistrySpecialQuota = value
+	}
+	if value, ok := cdc.mutation.RegistryTargetSubQuotas(); ok {
+		_spec.SetField(capacitydrift.FieldRegistryTargetSubQuotas, field.TypeJSON, value)
+		_node.RegistryTargetSubQuotas = value
+	}
+	if value, ok := cdc.mutation.PublishedRegular(); ok {
+		_spec.SetField(capacitydrift.FieldPublishedRegular, field.TypeInt, value)
+		_node.PublishedRegular = value
+	}
+	if value, ok := cdc.mutation.PublishedTargetQuota(); ok {
+		_spec.SetField(capacitydrift.FieldPublishedTargetQuota, field.TypeInt, value)
+		_node.PublishedTargetQuota = value
+	}
+	if value, ok := cdc.mutation.PublishedDedicatedQuota(); ok {
+		_spec.SetField(capacitydrift.FieldPublishedDedicatedQuota, field.TypeInt, value)
+		_node.PublishedDedicatedQuota = value
+	}
+	if value, ok := cdc.mutation.PublishedSpecialQuota(); ok {
+		_spec.SetField(capacitydrift.FieldPublishedSpecialQuota, field.TypeInt, value)
+		_node.PublishedSpecialQuota = value
+	}
+	if value, ok := cdc.mutation.PublishedTargetSubQuotas(); ok {
+		_spec.SetField(capacitydrift.FieldPublishedTargetSubQuotas, field.TypeJSON, value)
+		_node.PublishedTargetSubQuotas = value
+	}
+	if value, ok := cdc.mutation.TotalOnly(); ok {
+		_spec.SetField(capacitydrift.FieldTotalOnly, field.TypeBool, value)
+		_node.TotalOnly = value
+	}
+	if value, ok := cdc.mutation.Drifted(); ok {
+		_spec.SetField(capacitydrift.FieldDrifted, field.TypeBool, value)
+		_node.Drifted = value
+	}
+	if value, ok := cdc.mutation.Applied(); ok {
+		_spec.SetField(capacitydrift.FieldApplied, field.TypeBool, value)
+		_node.Applied = value
+	}
+	if nodes := cdc.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   capacitydrift.RunTable,
+			Columns: []string{capacitydrift.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(run.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RunID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CapacityDriftCreateBulk is the builder for creating many CapacityDrift entities in bulk.
+type CapacityDriftCreateBulk struct {
+	config
+	err      error
+	builders []*CapacityDriftCreate
+}
+
+// Save creates the CapacityDrift entities in the database.
+func (cdcb *CapacityDriftCreateBulk) Save(ctx context.Context) ([]*CapacityDrift, error) {
+	if cdcb.err != nil {
+		return nil, cdcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cdcb.builders))
+	nodes := make([]*CapacityDrift, len(cdcb.builders))
+	mutators := make([]Mutator, len(cdcb.builders))
+	for i := range cdcb.builders {
+		func(i int, root context.Context) {
+			builder := cdcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CapacityDriftMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cdcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cdcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cdcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cdcb *CapacityDriftCreateBulk) SaveX(ctx context.Context) []*CapacityDrift {
+	v, err := cdcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cdcb *CapacityDriftCreateBulk) Exec(ctx context.Context) error {
+	_, err := cdcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cdcb *CapacityDriftCreateBulk) ExecX(ctx context.Context) {
+	if err := cdcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/trueegorletov/analabit/core/ent/capacitydrift"
+	"github.com/trueegorletov/analabit/core/ent/predicate"
+)
+
+// CapacityDriftDelete is the builder for deleting a CapacityDrift entity.
+type CapacityDriftDelete struct {
+	config
+	hooks    []Hook
+	mutation *CapacityDriftMutation
+}
+
+// Where appends a list predicates to the CapacityDriftDelete builder.
+func (cdd *CapacityDriftDelete) Where(ps ...predicate.CapacityDrift) *CapacityDriftDelete {
+	cdd.mutation.Where(ps...)
+	return cdd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cdd *CapacityDriftDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, cdd.sqlExec, cdd.mutation, cdd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cdd *CapacityDriftDelete) ExecX(ctx context.Context) int {
+	n, err := cdd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cdd *CapacityDriftDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(capacitydrift.Table, sqlgraph.NewFieldSpec(capacitydrift.FieldID, field.TypeInt))
+	if ps := cdd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, cdd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	cdd.mutation.done = true
+	return affected, err
+}
+
+// CapacityDriftDeleteOne is the builder for deleting a single CapacityDrift entity.
+type CapacityDriftDeleteOne struct {
+	cdd *CapacityDriftDelete
+}
+
+// Where appends a list predicates to the CapacityDriftDelete builder.
+func (cddo *CapacityDriftDeleteOne) Where(ps ...predicate.CapacityDrift) *CapacityDriftDeleteOne {
+	cddo.cdd.mutation.Where(ps...)
+	return cddo
+}
+
+// Exec executes the deletion query.
+func (cddo *CapacityDriftDeleteOne) Exec(ctx context.Context) error {
+	n, err := cddo.cdd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{capacitydrift.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cddo *CapacityDriftDeleteOne) ExecX(ctx context.Context) {
+	if err := cddo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
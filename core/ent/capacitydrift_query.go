@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/trueegorletov/analabit/core/ent/capacitydrift"
+	"github.com/trueegorletov/analabit/core/ent/predicate"
+	"github.com/trueegorletov/analabit/core/ent/run"
+)
+
+// CapacityDriftQuery is the builder for querying CapacityDrift entities.
+type CapacityDriftQuery struct {
+	config
+	ctx        *QueryContext
+	order      []capacitydrift.OrderOption
+	inters     []Interceptor
+	predicates []predicate.CapacityDrift
+	withRun    *RunQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CapacityDriftQuery builder.
+func (cdq *CapacityDriftQuery) Where(ps ...predicate.CapacityDrift) *CapacityDriftQuery {
+	cdq.predicates = append(cdq.predicates, ps...)
+	return cdq
+}
+
+// Limit the number of records to be returned by this query.
+func (cdq *CapacityDriftQuery) Limit(limit int) *CapacityDriftQuery {
+	cdq.ctx.Limit = &limit
+	return cdq
+}
+
+// Offset to start from.
+func (cdq *CapacityDriftQuery) Offset(offset int) *CapacityDriftQuery {
+	cdq.ctx.Offset = &offset
+	return cdq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (cdq *CapacityDriftQuery) Unique(unique bool) *CapacityDriftQuery {
+	cdq.ctx.Unique = &unique
+	return cdq
+}
+
+// Order specifies how the records should be ordered.
+func (cdq *CapacityDriftQuery) Order(o ...capacitydrift.OrderOption) *CapacityDriftQuery {
+	cdq.order = append(cdq.order, o...)
+	return cdq
+}
+
+// QueryRun chains the current query on the "run" edge.
+func (cdq *CapacityDriftQuery) QueryRun() *RunQuery {
+	query := (&RunClient{config: cdq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cdq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cdq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(capacitydrift.Table, capacitydrift.FieldID, selector),
+			sqlgraph.To(run.Table, run.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, capacitydrift.RunTable, capacitydrift.RunColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cdq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first CapacityDrift entity from the query.
+// Returns a *NotFoundError when no CapacityDrift was found.
+func (cdq *CapacityDriftQuery) First(ctx context.Context) (*CapacityDrift, error) {
+	nodes, err := cdq.Limit(1).All(setContextOp(ctx, cdq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{capacitydrift.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (cdq *CapacityDriftQuery) FirstX(ctx context.Context) *CapacityDrift {
+	node, err := cdq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first CapacityDrift ID from the query.
+// Returns a *NotFoundError when no CapacityDrift ID was found.
+func (cdq *CapacityDriftQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = cdq.Limit(1).IDs(setContextOp(ctx, cdq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{capacitydrift.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (cdq *CapacityDriftQuery) FirstIDX(ctx context.Context) int {
+	id, err := cdq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single CapacityDrift entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one CapacityDrift entity is found.
+// Returns a *NotFoundError when no CapacityDrift entities are found.
+func (cdq *CapacityDriftQuery) Only(ctx context.Context) (*CapacityDrift, error) {
+	nodes, err := cdq.Limit(2).All(setContextOp(ctx, cdq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{capacitydrift.Label}
+	default:
+		return nil, &NotSingularError{capacitydrift.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (cdq *CapacityDriftQuery) OnlyX(ctx context.Context) *CapacityDrift {
+	node, err := cdq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only CapacityDrift ID in the query.
+// Returns a *NotSingularError when more than one CapacityDrift ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (cdq *CapacityDriftQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = cdq.Limit(2).IDs(setContextOp(ctx, cdq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{capacitydrift.Label}
+	default:
+		err = &NotSingularError{capacitydrift.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (cdq *CapacityDriftQuery) OnlyIDX(ctx context.Context) int {
+	id, err := cdq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of CapacityDrifts.
+func (cdq *CapacityDriftQuery) All(ctx context.Context) ([]*CapacityDrift, error) {
+	ctx = setContextOp(ctx, cdq.ctx, ent.OpQueryAll)
+	if err := cdq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*CapacityDrift, *CapacityDriftQuery]()
+	return withInterceptors[[]*CapacityDrift](ctx, cdq, qr, cdq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cdq *CapacityDriftQuery) AllX(ctx context.Context) []*CapacityDrift {
+	nodes, err := cdq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of CapacityDrift IDs.
+func (cdq *CapacityDriftQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if cdq.ctx.Unique == nil && cdq.path != nil {
+		cdq.Unique(true)
+	}
+	ctx = setContextOp(ctx, cdq.ctx, ent.OpQueryIDs)
+	if err = cdq.Select(capacitydrift.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (cdq *CapacityDriftQuery) IDsX(ctx context.Context) []int {
+	ids, err := cdq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (cdq *CapacityDriftQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, cdq.ctx, ent.OpQueryCount)
+	if err := cdq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, cdq, querierCount[*CapacityDriftQuery](), cdq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (cdq *CapacityDriftQuery) CountX(ctx context.Context) int {
+	count, err := cdq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (cdq *CapacityDriftQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, cdq.ctx, ent.OpQueryExist)
+	switch _, err := cdq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (cdq *CapacityDriftQuery) ExistX(ctx context.Context) bool {
+	exist, err := cdq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CapacityDriftQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (cdq *CapacityDriftQuery) Clone() *CapacityDriftQuery {
+	if cdq == nil {
+		return nil
+	}
+	return &CapacityDriftQuery{
+		config:     cdq.config,
+		ctx:        cdq.ctx.Clone(),
+		order:      append([]capacitydrift.OrderOption{}, cdq.order...),
+		inters:     append([]Interceptor{}, cdq.inters...),
+		predicates: append([]predicate.CapacityDrift{}, cdq.predicates...),
+		withRun:    cdq.withRun.Clone(),
+		// clone intermediate query.
+		sql:  cdq.sql.Clone(),
+		path: cdq.path,
+	}
+}
+
+// WithRun tells the query-builder to eager-load the nodes that are connected to
+// the "run" edge. The optional arguments are used to configure the query builder of the edge.
+func (cdq *CapacityDriftQuery) WithRun(opts ...func(*RunQuery)) *CapacityDriftQuery {
+	query := (&RunClient{config: cdq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cdq.withRun = query
+	return cdq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		VarsityCode string `json:"varsity_code,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.CapacityDrift.Query().
+//		GroupBy(capacitydrift.FieldVarsityCode).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (cdq *CapacityDriftQuery) GroupBy(field string, fields ...string) *CapacityDriftGroupBy {
+	cdq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &CapacityDriftGroupBy{build: cdq}
+	grbuild.flds = &cdq.ctx.Fields
+	grbuild.label = capacitydrift.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		VarsityCode string `json:"varsity_code,omitempty"`
+//	}
+//
+//	client.CapacityDrift.Query().
+//		Select(capacitydrift.FieldVarsityCode).
+//		Scan(ctx, &v)
+func (cdq *CapacityDriftQuery) Select(fields ...string) *CapacityDriftSelect {
+	cdq.ctx.Fields = append(cdq.ctx.Fields, fields...)
+	sbuild := &CapacityDriftSelect{CapacityDriftQuery: cdq}
+	sbuild.label = capacitydrift.Label
+	sbuild.flds, sbuild.scan = &cdq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a CapacityDriftSelect configured with the given aggregations.
+func (cdq *CapacityDriftQuery) Aggregate(fns ...AggregateFunc) *CapacityDriftSelect {
+	return cdq.Select().Aggregate(fns...)
+}
+
+func (cdq *CapacityDriftQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range cdq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, cdq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range cdq.ctx.Fields {
+		if !capacitydrift.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if cdq.path != nil {
+		prev, err := cdq.path(ctx)
+		if err != nil {
+			return err
+		}
+		cdq.sql = prev
+	}
+	return nil
+}
+
+func (cdq *CapacityDriftQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*CapacityDrift, error) {
+	var (
+		nodes       = []*CapacityDrift{}
+		_spec       = cdq.querySpec()
+		loadedTypes = [1]bool{
+			cdq.withRun != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*CapacityDrift).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &CapacityDrift{config: cdq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, cdq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := cdq.withRun; query != nil {
+		if err := cdq.loadRun(ctx, query, nodes, nil,
+			func(n *CapacityDrift, e *Run) { n.Edges.Run = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (cdq *CapacityDriftQuery) loadRun(ctx context.Context, query *RunQuery, nodes []*CapacityDrift, init func(*CapacityDrift), assign func(*CapacityDrift, *Run)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*CapacityDrift)
+	for i := range nodes {
+		fk := nodes[i].RunID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(run.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "run_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (cdq *CapacityDriftQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := cdq.querySpec()
+	_spec.Node.Columns = cdq.ctx.Fields
+	if len(cdq.ctx.Fields) > 0 {
+		_spec.Unique = cdq.ctx.Unique != nil && *cdq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, cdq.driver, _spec)
+}
+
+func (cdq *CapacityDriftQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(capacitydrift.Table, capacitydrift.Columns, sqlgraph.NewFieldSpec(capacitydrift.FieldID, field.TypeInt))
+	_spec.From = cdq.sql
+	if unique := cdq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if cdq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := cdq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, capacitydrift.FieldID)
+		for i := range fields {
+			if fields[i] != capacitydrift.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if cdq.withRun != nil {
+			_spec.Node.AddColumnOnce(capacitydrift.FieldRunID)
+		}
+	}
+	if ps := cdq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := cdq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := cdq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := cdq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (cdq *CapacityDriftQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(cdq.driver.Dialect())
+	t1 := builder.Table(capacitydrift.Table)
+	columns := cdq.ctx.Fields
+	if len(columns) == 0 {
+		columns = capacitydrift.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if cdq.sql != nil {
+		selector = cdq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if cdq.ctx.Unique != nil && *cdq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range cdq.predicates {
+		p(selector)
+	}
+	for _, p := range cdq.order {
+		p(selector)
+	}
+	if offset := cdq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := cdq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// CapacityDriftGroupBy is the group-by builder for CapacityDrift entities.
+type CapacityDriftGroupBy struct {
+	selector
+	build *CapacityDriftQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cdgb *CapacityDriftGroupBy) Aggregate(fns ...AggregateFunc) *CapacityDriftGroupBy {
+	cdgb.fns = append(cdgb.fns, fns...)
+	return cdgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cdgb *CapacityDriftGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cdgb.build.ctx, ent.OpQueryGroupBy)
+	if err := cdgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CapacityDriftQuery, *CapacityDriftGroupBy](ctx, cdgb.build, cdgb, cdgb.build.inters, v)
+}
+
+func (cdgb *CapacityDriftGroupBy) sqlScan(ctx context.Context, root *CapacityDriftQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(cdgb.fns))
+	for _, fn := range cdgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*cdgb.flds)+len(cdgb.fns))
+		for _, f := range *cdgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*cdgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cdgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// CapacityDriftSelect is the builder for selecting fields of CapacityDrift entities.
+type CapacityDriftSelect struct {
+	*CapacityDriftQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (cds *CapacityDriftSelect) Aggregate(fns ...AggregateFunc) *CapacityDriftSelect {
+	cds.fns = append(cds.fns, fns...)
+	return cds
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cds *CapacityDriftSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cds.ctx, ent.OpQuerySelect)
+	if err := cds.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CapacityDriftQuery, *CapacityDriftSelect](ctx, cds.CapacityDriftQuery, cds, cds.inters, v)
+}
+
+func (cds *CapacityDriftSelect) sqlScan(ctx context.Context, root *CapacityDriftQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(cds.fns))
+	for _, fn := range cds.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*cds.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cds.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,1032 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/trueegorletov/analabit/core/ent/capacitydrift"
+	"github.com/trueegorletov/analabit/core/ent/predicate"
+	"github.com/trueegorletov/analabit/core/ent/run"
+)
+
+// CapacityDriftUpdate is the builder for updating CapacityDrift entities.
+type CapacityDriftUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CapacityDriftMutation
+}
+
+// Where appends a list predicates to the CapacityDriftUpdate builder.
+func (cdu *CapacityDriftUpdate) Where(ps ...predicate.CapacityDrift) *CapacityDriftUpdate {
+	cdu.mutation.Where(ps...)
+	return cdu
+}
+
+// SetVarsityCode sets the "varsity_code" field.
+func (cdu *CapacityDriftUpdate) SetVarsityCode(s string) *CapacityDriftUpdate {
+	cdu.mutation.SetVarsityCode(s)
+	return cdu
+}
+
+// SetNillableVarsityCode sets the "varsity_code" field if the given value is not nil.
+func (cdu *CapacityDriftUpdate) SetNillableVarsityCode(s *string) *CapacityDriftUpdate {
+	if s != nil {
+		cdu.SetVarsityCode(*s)
+	}
+	return cdu
+}
+
+// SetHeadingCode sets the "heading_code" field.
+func (cdu *CapacityDriftUpdate) SetHeadingCode(s string) *CapacityDriftUpdate {
+	cdu.mutation.SetHeadingCode(s)
+	return cdu
+}
+
+// SetNillableHeadingCode sets the "heading_code" field if the given value is not nil.
+func (cdu *CapacityDriftUpdate) SetNillableHeadingCode(s *string) *CapacityDriftUpdate {
+	if s != nil {
+		cdu.SetHeadingCode(*s)
+	}
+	return cdu
+}
+
+// SetName sets the "name" field.
+func (cdu *CapacityDriftUpdate) SetName(s string) *CapacityDriftUpdate {
+	cdu.mutation.SetName(s)
+	return cdu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cdu *CapacityDriftUpdate) SetNillableName(s *string) *CapacityDriftUpdate {
+	if s != nil {
+		cdu.SetName(*s)
+	}
+	return cdu
+}
+
+// ClearName clears the value of the "name" field.
+func (cdu *CapacityDriftUpdate) ClearName() *CapacityDriftUpdate {
+	cdu.mutation.ClearName()
+	return cdu
+}
+
+// SetRegistryRegular sets the "registry_regular" field.
+func (cdu *CapacityDriftUpdate) SetRegistryRegular(i int) *CapacityDriftUpdate {
+	cdu.mutation.ResetRegistryRegular()
+	cdu.mutation.SetRegistryRegular(i)
+	return cdu
+}
+
+// SetNillableRegistryRegular sets the "registry_regular" field if the given value is not nil.
+func (cdu *CapacityDriftUpdate) SetNillableRegistryRegular(i *int) *CapacityDriftUpdate {
+	if i != nil {
+		cdu.SetRegistryRegular(*i)
+	}
+	return cdu
+}
+
+// AddRegistryRegular adds i to the "registry_regular" field.
+func (cdu *CapacityDriftUpdate) AddRegistryRegular(i int) *CapacityDriftUpdate {
+	cdu.mutation.AddRegistryRegular(i)
+	return cdu
+}
+
+// SetRegistryTargetQuota sets the "registry_target_quota" field.
+func (cdu *CapacityDriftUpdate) SetRegistryTargetQuota(i int) *CapacityDriftUpdate {
+	cdu.mutation.ResetRegistryTargetQuota()
+	cdu.mutation.SetRegistryTargetQuota(i)
+	return cdu
+}
+
+// SetNillableRegistryTargetQuota sets the "registry_target_quota" field if the given value is not nil.
+func (cdu *CapacityDriftUpdate) SetNillableRegistryTargetQuota(i *int) *CapacityDriftUpdate {
+	if i != nil {
+		cdu.SetRegistryTargetQuota(*i)
+	}
+	return cdu
+}
+
+// AddRegistryTargetQuota adds i to the "registry_target_quota" field.
+func (cdu *CapacityDriftUpdate) AddRegistryTargetQuota(i int) *CapacityDriftUpdate {
+	cdu.mutation.AddRegistryTargetQuota(i)
+	return cdu
+}
+
+// SetRegistryDedicatedQuota sets the "registry_dedicated_quota" field.
+func (cdu *CapacityDriftUpdate) SetRegistryDedicatedQuota(i int) *CapacityDriftUpdate {
+	cdu.mutation.ResetRegistryDedicatedQuota()
+	cdu.mutation.SetRegistryDedicatedQuota(i)
+	return cdu
+}
+
+// SetNillableRegistryDedicatedQuota sets the "registry_dedicated_quota" field if the given value is not nil.
+func (cdu *CapacityDriftUpdate) SetNillableRegistryDedicatedQuota(i *int) *CapacityDriftUpdate {
+	if i != nil {
+		cdu.SetRegistryDedicatedQuota(*i)
+	}
+	return cdu
+}
+
+// AddRegistryDedicatedQuota adds i to the "registry_dedicated_quota" field.
+func (cdu *CapacityDriftUpdate) AddRegistryDedicatedQuota(i int) *CapacityDriftUpdate {
+	cdu.mutation.AddRegistryDedicatedQuota(i)
+	return cdu
+}
+
+// SetRegistrySpecialQuota sets the "registry_special_quota" field.
+func (cdu *CapacityDriftUpdate) SetRegistrySpecialQuota(i int) *CapacityDriftUpdate {
+	cdu.mutation.ResetRegistrySpecialQuota()
+	cdu.mutation.SetRegistrySpecialQuota(i)
+	return cdu
+}
+
+// SetNillableRegistrySpecialQuota sets the "registry_special_quota" field if the given value is not nil.
+func (cdu *CapacityDriftUpdate) SetNillableRegistrySpecialQuota(i *int) *CapacityDriftUpdate {
+	if i != nil {
+		cdu.SetRegistrySpecialQuota(*i)
+	}
+	return cdu
+}
+
+// AddRegistrySpecialQuota adds i to the "registry_special_quota" field.
+func (cdu *CapacityDriftUpdate) AddRegistrySpecialQuota(i int) *CapacityDriftUpdate {
+	cdu.mutation.AddRegistrySpecialQuota(i)
+	return cdu
+}
+
+// SetRegistryTargetSubQuotas sets the "registry_target_sub_quotas" field.
+func (cdu *CapacityDriftUpdate) SetRegistryTargetSubQuotas(m map[string]int) *CapacityDriftUpdate {
+	cdu.mutation.SetRegistryTargetSubQuotas(m)
+	return cdu
+}
+
+// ClearRegistryTargetSubQuotas clears the value of the "registry_target_sub_quotas" field.
+func (cdu *CapacityDriftUpdate) ClearRegistryTargetSubQuotas() *CapacityDriftUpdate {
+	cdu.mutation.ClearRegistryTargetSubQuotas()
+	return cdu
+}
+
+// SetPublishedRegular sets the "published_regular" field.
+func (cdu *CapacityDriftUpdate) SetPublishedRegular(i int) *CapacityDriftUpdate {
+	cdu.mutation.ResetPublishedRegular()
+	cdu.mutation.SetPublishedRegular(i)
+	return cdu
+}
+
+// SetNillablePublishedRegular sets the "published_regular" field if the given value is not nil.
+func (cdu *CapacityDriftUpdate) SetNillablePublishedRegular(i *int) *CapacityDriftUpdate {
+	if i != nil {
+		cdu.SetPublishedRegular(*i)
+	}
+	return cdu
+}
+
+// AddPublishedRegular adds i to the "published_regular" field.
+func (cdu *CapacityDriftUpdate) AddPublishedRegular(i int) *CapacityDriftUpdate {
+	cdu.mutation.AddPublishedRegular(i)
+	return cdu
+}
+
+// SetPublishedTargetQuota sets the "published_target_quota" field.
+func (cdu *CapacityDriftUpdate) SetPublishedTargetQuota(i int) *CapacityDriftUpdate {
+	cdu.mutation.ResetPublishedTargetQuota()
+	cdu.mutation.SetPublishedTargetQuota(i)
+	return cdu
+}
+
+// SetNillablePublishedTargetQuota sets the "published_target_quota" field if the given value is not nil.
+func (cdu *CapacityDriftUpdate) SetNillablePublishedTargetQuota(i *int) *CapacityDriftUpdate {
+	if i != nil {
+		cdu.SetPublishedTargetQuota(*i)
+	}
+	return cdu
+}
+
+// AddPublishedTargetQuota adds i to the "published_target_quota" field.
+func (cdu *CapacityDriftUpdate) AddPublishedTargetQuota(i int) *CapacityDriftUpdate {
+	cdu.mutation.AddPublishedTargetQuota(i)
+	return cdu
+}
+
+// SetPublishedDedicatedQuota sets the "published_dedicated_quota" field.
+func (cdu *CapacityDriftUpdate) SetPublishedDedicatedQuota(i int) *CapacityDriftUpdate {
+	cdu.mutation.ResetPublishedDedicatedQuota()
+	cdu.mutation.SetPublishedDedicatedQuota(i)
+	return cdu
+}
+
+// SetNillablePublishedDedicatedQuota sets the "published_dedicated_quota" field if the given value is not nil.
+func (cdu *CapacityDriftUpdate) SetNillablePublishedDedicatedQuota(i *int) *CapacityDriftUpdate {
+	if i != nil {
+		cdu.SetPublishedDedicatedQuota(*i)
+	}
+	return cdu
+}
+
+// AddPublishedDedicatedQuota adds i to the "published_dedicated_quota" field.
+func (cdu *CapacityDriftUpdate) AddPublishedDedicatedQuota(i int) *CapacityDriftUpdate {
+	cdu.mutation.AddPublishedDedicatedQuota(i)
+	return cdu
+}
+
+// SetPublishedSpecialQuota sets the "published_special_quota" field.
+func (cdu *CapacityDriftUpdate) SetPublishedSpecialQuota(i int) *CapacityDriftUpdate {
+	cdu.mutation.ResetPublishedSpecialQuota()
+	cdu.mutation.SetPublishedSpecialQuota(i)
+	return cdu
+}
+
+// SetNillablePublishedSpecialQuota sets the "published_special_quota" field if the given value is not nil.
+func (cdu *CapacityDriftUpdate) SetNillablePublishedSpecialQuota(i *int) *CapacityDriftUpdate {
+	if i != nil {
+		cdu.SetPublishedSpecialQuota(*i)
+	}
+	return cdu
+}
+
+// AddPublishedSpecialQuota adds i to the "published_special_quota" field.
+func (cdu *CapacityDriftUpdate) AddPublishedSpecialQuota(i int) *CapacityDriftUpdate {
+	cdu.mutation.AddPublishedSpecialQuota(i)
+	return cdu
+}
+
+// SetPublishedTargetSubQuotas sets the "published_target_sub_quotas" field.
+func (cdu *CapacityDriftUpdate) SetPublishedTargetSubQuotas(m map[string]int) *CapacityDriftUpdate {
+	cdu.mutation.SetPublishedTargetSubQuotas(m)
+	return cdu
+}
+
+// ClearPublishedTargetSubQuotas clears the value of the "published_target_sub_quotas" field.
+func (cdu *CapacityDriftUpdate) ClearPublishedTargetSubQuotas() *CapacityDriftUpdate {
+	cdu.mutation.ClearPublishedTargetSubQuotas()
+	return cdu
+}
+
+// SetTotalOnly sets the "total_only" field.
+func (cdu *CapacityDriftUpdate) SetTotalOnly(b bool) *CapacityDriftUpdate {
+	cdu.mutation.SetTotalOnly(b)
+	return cdu
+}
+
+// SetNillableTotalOnly sets the "total_only" field if the given value is not nil.
+func (cdu *CapacityDriftUpdate) SetNillableTotalOnly(b *bool) *CapacityDriftUpdate {
+	if b != nil {
+		cdu.SetTotalOnly(*b)
+	}
+	return cdu
+}
+
+// SetDrifted sets the "drifted" field.
+func (cdu *CapacityDriftUpdate) SetDrifted(b bool) *CapacityDriftUpdate {
+	cdu.mutation.SetDrifted(b)
+	return cdu
+}
+
+// SetNillableDrifted sets the "drifted" field if the given value is not nil.
+func (cdu *CapacityDriftUpdate) SetNillableDrifted(b *bool) *CapacityDriftUpdate {
+	if b != nil {
+		cdu.SetDrifted(*b)
+	}
+	return cdu
+}
+
+// SetApplied sets the "applied" field.
+func (cdu *CapacityDriftUpdate) SetApplied(b bool) *CapacityDriftUpdate {
+	cdu.mutation.SetApplied(b)
+	return cdu
+}
+
+// SetNillableApplied sets the "applied" field if the given value is not nil.
+func (cdu *CapacityDriftUpdate) SetNillableApplied(b *bool) *CapacityDriftUpdate {
+	if b != nil {
+		cdu.SetApplied(*b)
+	}
+	return cdu
+}
+
+// SetRunID sets the "run_id" field.
+func (cdu *CapacityDriftUpdate) SetRunID(i int) *CapacityDriftUpdate {
+	cdu.mutation.SetRunID(i)
+	return cdu
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (cdu *CapacityDriftUpdate) SetNillableRunID(i *int) *CapacityDriftUpdate {
+	if i != nil {
+		cdu.SetRunID(*i)
+	}
+	return cdu
+}
+
+// SetRun sets the "run" edge to the Run entity.
+func (cdu *CapacityDriftUpdate) SetRun(r *Run) *CapacityDriftUpdate {
+	return cdu.SetRunID(r.ID)
+}
+
+// Mutation returns the CapacityDriftMutation object of the builder.
+func (cdu *CapacityDriftUpdate) Mutation() *CapacityDriftMutation {
+	return cdu.mutation
+}
+
+// ClearRun clears the "run" edge to the Run entity.
+func (cdu *CapacityDriftUpdate) ClearRun() *CapacityDriftUpdate {
+	cdu.mutation.ClearRun()
+	return cdu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cdu *CapacityDriftUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, cdu.sqlSave, cdu.mutation, cdu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cdu *CapacityDriftUpdate) SaveX(ctx context.Context) int {
+	affected, err := cdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cdu *CapacityDriftUpdate) Exec(ctx context.Context) error {
+	_, err := cdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cdu *CapacityDriftUpdate) ExecX(ctx context.Context) {
+	if err := cdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cdu *CapacityDriftUpdate) check() error {
+	if cdu.mutation.RunCleared() && len(cdu.mutation.RunIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CapacityDrift.run"`)
+	}
+	return nil
+}
+
+func (cdu *CapacityDriftUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cdu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(capacitydrift.Table, capacitydrift.Columns, sqlgraph.NewFieldSpec(capacitydrift.FieldID, field.TypeInt))
+	if ps := cdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cdu.mutation.VarsityCode(); ok {
+		_spec.SetField(capacitydrift.FieldVarsityCode, field.TypeString, value)
+	}
+	if value, ok := cdu.mutation.HeadingCode(); ok {
+		_spec.SetField(capacitydrift.FieldHeadingCode, field.TypeString, value)
+	}
+	if value, ok := cdu.mutation.Name(); ok {
+		_spec.SetField(capacitydrift.FieldName, field.TypeString, value)
+	}
+	if cdu.mutation.NameCleared() {
+		_spec.ClearField(capacitydrift.FieldName, field.TypeString)
+	}
+	if value, ok := cdu.mutation.RegistryRegular(); ok {
+		_spec.SetField(capacitydrift.FieldRegistryRegular, field.TypeInt, value)
+	}
+	if value, ok := cdu.mutation.AddedRegistryRegular(); ok {
+		_spec.AddField(capacitydrift.FieldRegistryRegular, field.TypeInt, value)
+	}
+	if value, ok := cdu.mutation.RegistryTargetQuota(); ok {
+		_spec.SetField(capacitydrift.FieldRegistryTargetQuota, field.TypeInt, value)
+	}
+	if value, ok := cdu.mutation.AddedRegistryTargetQuota(); ok {
+		_spec.AddField(capacitydrift.FieldRegistryTargetQuota, field.TypeInt, value)
+	}
+	if value, ok := cdu.mutation.RegistryDedicatedQuota(); ok {
+		_spec.SetField(capacitydrift.FieldRegistryDedicatedQuota, field.TypeInt, value)
+	}
+	if value, ok := cdu.mutation.AddedRegistryDedicatedQuota(); ok {
+		_spec.AddField(capacitydrift.FieldRegistryDedicatedQuota, field.TypeInt, value)
+	}
+	if value, ok := cdu.mutation.RegistrySpecialQuota(); ok {
+		_spec.SetField(capacitydrift.FieldRegistrySpecialQuota, field.TypeInt, value)
+	}
+	if value, ok := cdu.mutation.AddedRegistrySpecialQuota(); ok {
+		_spec.AddField(capacitydrift.FieldRegistrySpecialQuota, field.TypeInt, value)
+	}
+	if value, ok := cdu.mutation.RegistryTargetSubQuotas(); ok {
+		_spec.SetField(capacitydrift.FieldRegistryTargetSubQuotas, field.TypeJSON, value)
+	}
+	if cdu.mutation.RegistryTargetSubQuotasCleared() {
+		_spec.ClearField(capacitydrift.FieldRegistryTargetSubQuotas, field.TypeJSON)
+	}
+	if value, ok := cdu.mutation.PublishedRegular(); ok {
+		_spec.SetField(capacitydrift.FieldPublishedRegular, field.TypeInt, value)
+	}
+	if value, ok := cdu.mutation.AddedPublishedRegular(); ok {
+		_spec.AddField(capacitydrift.FieldPublishedRegular, field.TypeInt, value)
+	}
+	if value, ok := cdu.mutation.PublishedTargetQuota(); ok {
+		_spec.SetField(capacitydrift.FieldPublishedTargetQuota, field.TypeInt, value)
+	}
+	if value, ok := cdu.mutation.AddedPublishedTargetQuota(); ok {
+		_spec.AddField(capacitydrift.FieldPublishedTargetQuota, field.TypeInt, value)
+	}
+	if value, ok := cdu.mutation.PublishedDedicatedQuota(); ok {
+		_spec.SetField(capacitydrift.FieldPublishedDedicatedQuota, field.TypeInt, value)
+	}
+	if value, ok := cdu.mutation.AddedPublishedDedicatedQuota(); ok {
+		_spec.AddField(capacitydrift.FieldPublishedDedicatedQuota, field.TypeInt, value)
+	}
+	if value, ok := cdu.mutation.PublishedSpecialQuota(); ok {
+		_spec.SetField(capacitydrift.FieldPublishedSpecialQuota, field.TypeInt, value)
+	}
+	if value, ok := cdu.mutation.AddedPublishedSpecialQuota(); ok {
+		_spec.AddField(capacitydrift.FieldPublishedSpecialQuota, field.TypeInt, value)
+	}
+	if value, ok := cdu.mutation.PublishedTargetSubQuotas(); ok {
+		_spec.SetField(capacitydrift.FieldPublishedTargetSubQuotas, field.TypeJSON, value)
+	}
+	if cdu.mutation.PublishedTargetSubQuotasCleared() {
+		_spec.ClearField(capacitydrift.FieldPublishedTargetSubQuotas, field.TypeJSON)
+	}
+	if value, ok := cdu.mutation.TotalOnly(); ok {
+		_spec.SetField(capacitydrift.FieldTotalOnly, field.TypeBool, value)
+	}
+	if value, ok := cdu.mutation.Drifted(); ok {
+		_spec.SetField(capacitydrift.FieldDrifted, field.TypeBool, value)
+	}
+	if value, ok := cdu.mutation.Applied(); ok {
+		_spec.SetField(capacitydrift.FieldApplied, field.TypeBool, value)
+	}
+	if cdu.mutation.RunCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   capacitydrift.RunTable,
+			Columns: []string{capacitydrift.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(run.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cdu.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   capacitydrift.RunTable,
+			Columns: []string{capacitydrift.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(run.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{capacitydrift.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cdu.mutation.done = true
+	return n, nil
+}
+
+// CapacityDriftUpdateOne is the builder for updating a single CapacityDrift entity.
+type CapacityDriftUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CapacityDriftMutation
+}
+
+// SetVarsityCode sets the "varsity_code" field.
+func (cduo *CapacityDriftUpdateOne) SetVarsityCode(s string) *CapacityDriftUpdateOne {
+	cduo.mutation.SetVarsityCode(s)
+	return cduo
+}
+
+// SetNillableVarsityCode sets the "varsity_code" field if the given value is not nil.
+func (cduo *CapacityDriftUpdateOne) SetNillableVarsityCode(s *string) *CapacityDriftUpdateOne {
+	if s != nil {
+		cduo.SetVarsityCode(*s)
+	}
+	return cduo
+}
+
+// SetHeadingCode sets the "heading_code" field.
+func (cduo *CapacityDriftUpdateOne) SetHeadingCode(s string) *CapacityDriftUpdateOne {
+	cduo.mutation.SetHeadingCode(s)
+	return cduo
+}
+
+// SetNillableHeadingCode sets the "heading_code" field if the given value is not nil.
+func (cduo *CapacityDriftUpdateOne) SetNillableHeadingCode(s *string) *CapacityDriftUpdateOne {
+	if s != nil {
+		cduo.SetHeadingCode(*s)
+	}
+	return cduo
+}
+
+// SetName sets the "name" field.
+func (cduo *CapacityDriftUpdateOne) SetName(s string) *CapacityDriftUpdateOne {
+	cduo.mutation.SetName(s)
+	return cduo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cduo *CapacityDriftUpdateOne) SetNillableName(s *string) *CapacityDriftUpdateOne {
+	if s != nil {
+		cduo.SetName(*s)
+	}
+	return cduo
+}
+
+// ClearName clears the value of the "name" field.
+func (cduo *CapacityDriftUpdateOne) ClearName() *CapacityDriftUpdateOne {
+	cduo.mutation.ClearName()
+	return cduo
+}
+
+// SetRegistryRegular sets the "registry_regular" field.
+func (cduo *CapacityDriftUpdateOne) SetRegistryRegular(i int) *CapacityDriftUpdateOne {
+	cduo.mutation.ResetRegistryRegular()
+	cduo.mutation.SetRegistryRegular(i)
+	return cduo
+}
+
+// SetNillableRegistryRegular sets the "registry_regular" field if the given value is not nil.
+func (cduo *CapacityDriftUpdateOne) SetNillableRegistryRegular(i *int) *CapacityDriftUpdateOne {
+	if i != nil {
+		cduo.SetRegistryRegular(*i)
+	}
+	return cduo
+}
+
+// AddRegistryRegular adds i to the "registry_regular" field.
+func (cduo *CapacityDriftUpdateOne) AddRegistryRegular(i int) *CapacityDriftUpdateOne {
+	cduo.mutation.AddRegistryRegular(i)
+	return cduo
+}
+
+// SetRegistryTargetQuota sets the "registry_target_quota" field.
+func (cduo *CapacityDriftUpdateOne) SetRegistryTargetQuota(i int) *CapacityDriftUpdateOne {
+	cduo.mutation.ResetRegistryTargetQuota()
+	cduo.mutation.SetRegistryTargetQuota(i)
+	return cduo
+}
+
+// SetNillableRegistryTargetQuota sets the "registry_target_quota" field if the given value is not nil.
+func (cduo *CapacityDriftUpdateOne) SetNillableRegistryTargetQuota(i *int) *CapacityDriftUpdateOne {
+	if i != nil {
+		cduo.SetRegistryTargetQuota(*i)
+	}
+	return cduo
+}
+
+// AddRegistryTargetQuota adds i to the "registry_target_quota" field.
+func (cduo *CapacityDriftUpdateOne) AddRegistryTargetQuota(i int) *CapacityDriftUpdateOne {
+	cduo.mutation.AddRegistryTargetQuota(i)
+	return cduo
+}
+
+// SetRegistryDedicatedQuota sets the "registry_dedicated_quota" field.
+func (cduo *CapacityDriftUpdateOne) SetRegistryDedicatedQuota(i int) *CapacityDriftUpdateOne {
+	cduo.mutation.ResetRegistryDedicatedQuota()
+	cduo.mutation.SetRegistryDedicatedQuota(i)
+	return cduo
+}
+
+// SetNillableRegistryDedicatedQuota sets the "registry_dedicated_quota" field if the given value is not nil.
+func (cduo *CapacityDriftUpdateOne) SetNillableRegistryDedicatedQuota(i *int) *CapacityDriftUpdateOne {
+	if i != nil {
+		cduo.SetRegistryDedicatedQuota(*i)
+	}
+	return cduo
+}
+
+// AddRegistryDedicatedQuota adds i to the "registry_dedicated_quota" field.
+func (cduo *CapacityDriftUpdateOne) AddRegistryDedicatedQuota(i int) *CapacityDriftUpdateOne {
+	cduo.mutation.AddRegistryDedicatedQuota(i)
+	return cduo
+}
+
+// SetRegistrySpecialQuota sets the "registry_special_quota" field.
+func (cduo *CapacityDriftUpdateOne) SetRegistrySpecialQuota(i int) *CapacityDriftUpdateOne {
+	cduo.mutation.ResetRegistrySpecialQuota()
+	cduo.mutation.SetRegistrySpecialQuota(i)
+	return cduo
+}
+
+// SetNillableRegistrySpecialQuota sets the "registry_special_quota" field if the given value is not nil.
+func (cduo *CapacityDriftUpdateOne) SetNillableRegistrySpecialQuota(i *int) *CapacityDriftUpdateOne {
+	if i != nil {
+		cduo.SetRegistrySpecialQuota(*i)
+	}
+	return cduo
+}
+
+// AddRegistrySpecialQuota adds i to the "registry_special_quota" field.
+func (cduo *CapacityDriftUpdateOne) AddRegistrySpecialQuota(i int) *CapacityDriftUpdateOne {
+	cduo.mutation.AddRegistrySpecialQuota(i)
+	return cduo
+}
+
+// SetRegistryTargetSubQuotas sets the "registry_target_sub_quotas" field.
+func (cduo *CapacityDriftUpdateOne) SetRegistryTargetSubQuotas(m map[string]int) *CapacityDriftUpdateOne {
+	cduo.mutation.SetRegistryTargetSubQuotas(m)
+	return cduo
+}
+
+// ClearRegistryTargetSubQuotas clears the value of the "registry_target_sub_quotas" field.
+func (cduo *CapacityDriftUpdateOne) ClearRegistryTargetSubQuotas() *CapacityDriftUpdateOne {
+	cduo.mutation.ClearRegistryTargetSubQuotas()
+	return cduo
+}
+
+// SetPublishedRegular sets the "published_regular" field.
+func (cduo *CapacityDriftUpdateOne) SetPublishedRegular(i int) *CapacityDriftUpdateOne {
+	cduo.mutation.ResetPublishedRegular()
+	cduo.mutation.SetPublishedRegular(i)
+	return cduo
+}
+
+// SetNillablePublishedRegular sets the "published_regular" field if the given value is not nil.
+func (cduo *CapacityDriftUpdateOne) SetNillablePublishedRegular(i *int) *CapacityDriftUpdateOne {
+	if i != nil {
+		cduo.SetPublishedRegular(*i)
+	}
+	return cduo
+}
+
+// AddPublishedRegular adds i to the "published_regular" field.
+func (cduo *CapacityDriftUpdateOne) AddPublishedRegular(i int) *CapacityDriftUpdateOne {
+	cduo.mutation.AddPublishedRegular(i)
+	return cduo
+}
+
+// SetPublishedTargetQuota sets the "published_target_quota" field.
+func (cduo *CapacityDriftUpdateOne) SetPublishedTargetQuota(i int) *CapacityDriftUpdateOne {
+	cduo.mutation.ResetPublishedTargetQuota()
+	cduo.mutation.SetPublishedTargetQuota(i)
+	return cduo
+}
+
+// SetNillablePublishedTargetQuota sets the "published_target_quota" field if the given value is not nil.
+func (cduo *CapacityDriftUpdateOne) SetNillablePublishedTargetQuota(i *int) *CapacityDriftUpdateOne {
+	if i != nil {
+		cduo.SetPublishedTargetQuota(*i)
+	}
+	return cduo
+}
+
+// AddPublishedTargetQuota adds i to the "published_target_quota" field.
+func (cduo *CapacityDriftUpdateOne) AddPublishedTargetQuota(i int) *CapacityDriftUpdateOne {
+	cduo.mutation.AddPublishedTargetQuota(i)
+	return cduo
+}
+
+// SetPublishedDedicatedQuota sets the "published_dedicated_quota" field.
+func (cduo *CapacityDriftUpdateOne) SetPublishedDedicatedQuota(i int) *CapacityDriftUpdateOne {
+	cduo.mutation.ResetPublishedDedicatedQuota()
+	cduo.mutation.SetPublishedDedicatedQuota(i)
+	return cduo
+}
+
+// SetNillablePublishedDedicatedQuota sets the "published_dedicated_quota" field if the given value is not nil.
+func (cduo *CapacityDriftUpdateOne) SetNillablePublishedDedicatedQuota(i *int) *CapacityDriftUpdateOne {
+	if i != nil {
+		cduo.SetPublishedDedicatedQuota(*i)
+	}
+	return cduo
+}
+
+// AddPublishedDedicatedQuota adds i to the "published_dedicated_quota" field.
+func (cduo *CapacityDriftUpdateOne) AddPublishedDedicatedQuota(i int) *CapacityDriftUpdateOne {
+	cduo.mutation.AddPublishedDedicatedQuota(i)
+	return cduo
+}
+
+// SetPublishedSpecialQuota sets the "published_special_quota" field.
+func (cduo *CapacityDriftUpdateOne) SetPublishedSpecialQuota(i int) *CapacityDriftUpdateOne {
+	cduo.mutation.ResetPublishedSpecialQuota()
+	cduo.mutation.SetPublishedSpecialQuota(i)
+	return cduo
+}
+
+// SetNillablePublishedSpecialQuota sets the "published_special_quota" field if the given value is not nil.
+func (cduo *CapacityDriftUpdateOne) SetNillablePublishedSpecialQuota(i *int) *CapacityDriftUpdateOne {
+	if i != nil {
+		cduo.SetPublishedSpecialQuota(*i)
+	}
+	return cduo
+}
+
+// AddPublishedSpecialQuota adds i to the "published_special_quota" field.
+func (cduo *CapacityDriftUpdateOne) AddPublishedSpecialQuota(i int) *CapacityDriftUpdateOne {
+	cduo.mutation.AddPublishedSpecialQuota(i)
+	return cduo
+}
+
+// SetPublishedTargetSubQuotas sets the "published_target_sub_quotas" field.
+func (cduo *CapacityDriftUpdateOne) SetPublishedTargetSubQuotas(m map[string]int) *CapacityDriftUpdateOne {
+	cduo.mutation.SetPublishedTargetSubQuotas(m)
+	return cduo
+}
+
+// ClearPublishedTargetSubQuotas clears the value of the "published_target_sub_quotas" field.
+func (cduo *CapacityDriftUpdateOne) ClearPublishedTargetSubQuotas() *CapacityDriftUpdateOne {
+	cduo.mutation.ClearPublishedTargetSubQuotas()
+	return cduo
+}
+
+// SetTotalOnly sets the "total_only" field.
+func (cduo *CapacityDriftUpdateOne) SetTotalOnly(b bool) *CapacityDriftUpdateOne {
+	cduo.mutation.SetTotalOnly(b)
+	return cduo
+}
+
+// SetNillableTotalOnly sets the "total_only" field if the given value is not nil.
+func (cduo *CapacityDriftUpdateOne) SetNillableTotalOnly(b *bool) *CapacityDriftUpdateOne {
+	if b != nil {
+		cduo.SetTotalOnly(*b)
+	}
+	return cduo
+}
+
+// SetDrifted sets the "drifted" field.
+func (cduo *CapacityDriftUpdateOne) SetDrifted(b bool) *CapacityDriftUpdateOne {
+	cduo.mutation.SetDrifted(b)
+	return cduo
+}
+
+// SetNillableDrifted sets the "drifted" field if the given value is not nil.
+func (cduo *CapacityDriftUpdateOne) SetNillableDrifted(b *bool) *CapacityDriftUpdateOne {
+	if b != nil {
+		cduo.SetDrifted(*b)
+	}
+	return cduo
+}
+
+// SetApplied sets the "applied" field.
+func (cduo *CapacityDriftUpdateOne) SetApplied(b bool) *CapacityDriftUpdateOne {
+	cduo.mutation.SetApplied(b)
+	return cduo
+}
+
+// SetNillableApplied sets the "applied" field if the given value is not nil.
+func (cduo *CapacityDriftUpdateOne) SetNillableApplied(b *bool) *CapacityDriftUpdateOne {
+	if b != nil {
+		cduo.SetApplied(*b)
+	}
+	return cduo
+}
+
+// SetRunID sets the "run_id" field.
+func (cduo *CapacityDriftUpdateOne) SetRunID(i int) *CapacityDriftUpdateOne {
+	cduo.mutation.SetRunID(i)
+	return cduo
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (cduo *CapacityDriftUpdateOne) SetNillableRunID(i *int) *CapacityDriftUpdateOne {
+	if i != nil {
+		cduo.SetRunID(*i)
+	}
+	return cduo
+}
+
+// SetRun sets the "run" edge to the Run entity.
+func (cduo *CapacityDriftUpdateOne) SetRun(r *Run) *CapacityDriftUpdateOne {
+	return cduo.SetRunID(r.ID)
+}
+
+// Mutation returns the CapacityDriftMutation object of the builder.
+func (cduo *CapacityDriftUpdateOne) Mutation() *CapacityDriftMutation {
+	return cduo.mutation
+}
+
+// ClearRun clears the "run" edge to the Run entity.
+func (cduo *CapacityDriftUpdateOne) ClearRun() *CapacityDriftUpdateOne {
+	cduo.mutation.ClearRun()
+	return cduo
+}
+
+// Where appends a list predicates to the CapacityDriftUpdate builder.
+func (cduo *CapacityDriftUpdateOne) Where(ps ...predicate.CapacityDrift) *CapacityDriftUpdateOne {
+	cduo.mutation.Where(ps...)
+	return cduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cduo *CapacityDriftUpdateOne) Select(field string, fields ...string) *CapacityDriftUpdateOne {
+	cduo.fields = append([]string{field}, fields...)
+	return cduo
+}
+
+// Save executes the query and returns the updated CapacityDrift entity.
+func (cduo *CapacityDriftUpdateOne) Save(ctx context.Context) (*CapacityDrift, error) {
+	return withHooks(ctx, cduo.sqlSave, cduo.mutation, cduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cduo *CapacityDriftUpdateOne) SaveX(ctx context.Context) *CapacityDrift {
+	node, err := cduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cduo *CapacityDriftUpdateOne) Exec(ctx context.Context) error {
+	_, err := cduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cduo *CapacityDriftUpdateOne) ExecX(ctx context.Context) {
+	if err := cduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cduo *CapacityDriftUpdateOne) check() error {
+	if cduo.mutation.RunCleared() && len(cduo.mutation.RunIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CapacityDrift.run"`)
+	}
+	return nil
+}
+
+func (cduo *CapacityDriftUpdateOne) sqlSave(ctx context.Context) (_node *CapacityDrift, err error) {
+	if err := cduo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(capacitydrift.Table, capacitydrift.Columns, sqlgraph.NewFieldSpec(capacitydrift.FieldID, field.TypeInt))
+	id, ok := cduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CapacityDrift.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, capacitydrift.FieldID)
+		for _, f := range fields {
+			if !capacitydrift.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != capacitydrift.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cduo.mutation.VarsityCode(); ok {
+		_spec.SetField(capacitydrift.FieldVarsityCode, field.TypeString, value)
+	}
+	if value, ok := cduo.mutation.HeadingCode(); ok {
+		_spec.SetField(capacitydrift.FieldHeadingCode, field.TypeString, value)
+	}
+	if value, ok := cduo.mutation.Name(); ok {
+		_spec.SetField(capacitydrift.FieldName, field.TypeString, value)
+	}
+	if cduo.mutation.NameCleared() {
+		_spec.ClearField(capacitydrift.FieldName, field.TypeString)
+	}
+	if value, ok := cduo.mutation.RegistryRegular(); ok {
+		_spec.SetField(capacitydrift.FieldRegistryRegular, field.TypeInt, value)
+	}
+	if value, ok := cduo.mutation.AddedRegistryRegular(); ok {
+		_spec.AddField(capacitydrift.FieldRegistryRegular, field.TypeInt, value)
+	}
+	if value, ok := cduo.mutation.RegistryTargetQuota(); ok {
+		_spec.SetField(capacitydrift.FieldRegistryTargetQuota, field.TypeInt, value)
+	}
+	if value, ok := cduo.mutation.AddedRegistryTargetQuota(); ok {
+		_spec.AddField(capacitydrift.FieldRegistryTargetQuota, field.TypeInt, value)
+	}
+	if value, ok := cduo.mutation.RegistryDedicatedQuota(); ok {
+		_spec.SetField(capacitydrift.FieldRegistryDedicatedQuota, field.TypeInt, value)
+	}
+	if value, ok := cduo.mutation.AddedRegistryDedicatedQuota(); ok {
+		_spec.AddField(capacitydrift.FieldRegistryDedicatedQuota, field.TypeInt, value)
+	}
+	if value, ok := cduo.mutation.RegistrySpecialQuota(); ok {
+		_spec.SetField(capacitydrift.FieldRegistrySpecialQuota, field.TypeInt, value)
+	}
+	if value, ok := cduo.mutation.AddedRegistrySpecialQuota(); ok {
+		_spec.AddField(capacitydrift.FieldRegistrySpecialQuota, field.TypeInt, value)
+	}
+	if value, ok := cduo.mutation.RegistryTargetSubQuotas(); ok {
+		_spec.SetField(capacitydrift.FieldRegistryTargetSubQuotas, field.TypeJSON, value)
+	}
+	if cduo.mutation.RegistryTargetSubQuotasCleared() {
+		_spec.ClearField(capacitydrift.FieldRegistryTargetSubQuotas, field.TypeJSON)
+	}
+	if value, ok := cduo.mutation.PublishedRegular(); ok {
+		_spec.SetField(capacitydrift.FieldPublishedRegular, field.TypeInt, value)
+	}
+	if value, ok := cduo.mutation.AddedPublishedRegular(); ok {
+		_spec.AddField(capacitydrift.FieldPublishedRegular, field.TypeInt, value)
+	}
+	if value, ok := cduo.mutation.PublishedTargetQuota(); ok {
+		_spec.SetField(capacitydrift.FieldPublishedTargetQuota, field.TypeInt, value)
+	}
+	if value, ok := cduo.mutation.AddedPublishedTargetQuota(); ok {
+		_spec.AddField(capacitydrift.FieldPublishedTargetQuota, field.TypeInt, value)
+	}
+	if value, ok := cduo.mutation.PublishedDedicatedQuota(); ok {
+		_spec.SetField(capacitydrift.FieldPublishedDedicatedQuota, field.TypeInt, value)
+	}
+	if value, ok := cduo.mutation.AddedPublishedDedicatedQuota(); ok {
+		_spec.AddField(capacitydrift.FieldPublishedDedicatedQuota, field.TypeInt, value)
+	}
+	if value, ok := cduo.mutation.PublishedSpecialQuota(); ok {
+		_spec.SetField(capacitydrift.FieldPublishedSpecialQuota, field.TypeInt, value)
+	}
+	if value, ok := cduo.mutation.AddedPublishedSpecialQuota(); ok {
+		_spec.AddField(capacitydrift.FieldPublishedSpecialQuota, field.TypeInt, value)
+	}
+	if value, ok := cduo.mutation.PublishedTargetSubQuotas(); ok {
+		_spec.SetField(capacitydrift.FieldPublishedTargetSubQuotas, field.TypeJSON, value)
+	}
+	if cduo.mutation.PublishedTargetSubQuotasCleared() {
+		_spec.ClearField(capacitydrift.FieldPublishedTargetSubQuotas, field.TypeJSON)
+	}
+	if value, ok := cduo.mutation.TotalOnly(); ok {
+		_spec.SetField(capacitydrift.FieldTotalOnly, field.TypeBool, value)
+	}
+	if value, ok := cduo.mutation.Drifted(); ok {
+		_spec.SetField(capacitydrift.FieldDrifted, field.TypeBool, value)
+	}
+	if value, ok := cduo.mutation.Applied(); ok {
+		_spec.SetField(capacitydrift.FieldApplied, field.TypeBool, value)
+	}
+	if cduo.mutation.RunCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   capacitydrift.RunTable,
+			Columns: []string{capacitydrift.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(run.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cduo.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   capacitydrift.RunTable,
+			Columns: []string{capacitydrift.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(run.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &CapacityDrift{config: cduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{capacitydrift.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cduo.mutation.done = true
+	return _node, nil
+}
@@ -15,11 +15,15 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/trueegorletov/analabit/core/ent/admissionchance"
+	"github.com/trueegorletov/analabit/core/ent/admissionevent"
 	"github.com/trueegorletov/analabit/core/ent/application"
 	"github.com/trueegorletov/analabit/core/ent/calculation"
+	"github.com/trueegorletov/analabit/core/ent/capacitydrift"
 	"github.com/trueegorletov/analabit/core/ent/drainedresult"
 	"github.com/trueegorletov/analabit/core/ent/heading"
 	"github.com/trueegorletov/analabit/core/ent/run"
+	"github.com/trueegorletov/analabit/core/ent/sourcereport"
 	"github.com/trueegorletov/analabit/core/ent/varsity"
 
 	stdsql "database/sql"
@@ -30,16 +34,24 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// AdmissionChance is the client for interacting with the AdmissionChance builders.
+	AdmissionChance *AdmissionChanceClient
+	// AdmissionEvent is the client for interacting with the AdmissionEvent builders.
+	AdmissionEvent *AdmissionEventClient
 	// Application is the client for interacting with the Application builders.
 	Application *ApplicationClient
 	// Calculation is the client for interacting with the Calculation builders.
 	Calculation *CalculationClient
+	// CapacityDrift is the client for interacting with the CapacityDrift builders.
+	CapacityDrift *CapacityDriftClient
 	// DrainedResult is the client for interacting with the DrainedResult builders.
 	DrainedResult *DrainedResultClient
 	// Heading is the client for interacting with the Heading builders.
 	Heading *HeadingClient
 	// Run is the client for interacting with the Run builders.
 	Run *RunClient
+	// SourceReport is the client for interacting with the SourceReport builders.
+	SourceReport *SourceReportClient
 	// Varsity is the client for interacting with the Varsity builders.
 	Varsity *VarsityClient
 }
@@ -53,11 +65,15 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.AdmissionChance = NewAdmissionChanceClient(c.config)
+	c.AdmissionEvent = NewAdmissionEventClient(c.config)
 	c.Application = NewApplicationClient(c.config)
 	c.Calculation = NewCalculationClient(c.config)
+	c.CapacityDrift = NewCapacityDriftClient(c.config)
 	c.DrainedResult = NewDrainedResultClient(c.config)
 	c.Heading = NewHeadingClient(c.config)
 	c.Run = NewRunClient(c.config)
+	c.SourceReport = NewSourceReportClient(c.config)
 	c.Varsity = NewVarsityClient(c.config)
 }
 
@@ -149,14 +165,18 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:           ctx,
-		config:        cfg,
-		Application:   NewApplicationClient(cfg),
-		Calculation:   NewCalculationClient(cfg),
-		DrainedResult: NewDrainedResultClient(cfg),
-		Heading:       NewHeadingClient(cfg),
-		Run:           NewRunClient(cfg),
-		Varsity:       NewVarsityClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		AdmissionChance: NewAdmissionChanceClient(cfg),
+		AdmissionEvent:  NewAdmissionEventClient(cfg),
+		Application:     NewApplicationClient(cfg),
+		Calculation:     NewCalculationClient(cfg),
+		CapacityDrift:   NewCapacityDriftClient(cfg),
+		DrainedResult:   NewDrainedResultClient(cfg),
+		Heading:         NewHeadingClient(cfg),
+		Run:             NewRunClient(cfg),
+		SourceReport:    NewSourceReportClient(cfg),
+		Varsity:         NewVarsityClient(cfg),
 	}, nil
 }
 
@@ -174,21 +194,25 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:           ctx,
-		config:        cfg,
-		Application:   NewApplicationClient(cfg),
-		Calculation:   NewCalculationClient(cfg),
-		DrainedResult: NewDrainedResultClient(cfg),
-		Heading:       NewHeadingClient(cfg),
-		Run:           NewRunClient(cfg),
-		Varsity:       NewVarsityClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		AdmissionChance: NewAdmissionChanceClient(cfg),
+		AdmissionEvent:  NewAdmissionEventClient(cfg),
+		Application:     NewApplicationClient(cfg),
+		Calculation:     NewCalculationClient(cfg),
+		CapacityDrift:   NewCapacityDriftClient(cfg),
+		DrainedResult:   NewDrainedResultClient(cfg),
+		Heading:         NewHeadingClient(cfg),
+		Run:             NewRunClient(cfg),
+		SourceReport:    NewSourceReportClient(cfg),
+		Varsity:         NewVarsityClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Application.
+//		AdmissionChance.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -211,7 +235,8 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Application, c.Calculation, c.DrainedResult, c.Heading, c.Run, c.Varsity,
+		c.AdmissionChance, c.AdmissionEvent, c.Application, c.Calculation,
+		c.CapacityDrift, c.DrainedResult, c.Heading, c.Run, c.SourceReport, c.Varsity,
 	} {
 		n.Use(hooks...)
 	}
@@ -221,7 +246,8 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Application, c.Calculation, c.DrainedResult, c.Heading, c.Run, c.Varsity,
+		c.AdmissionChance, c.AdmissionEvent, c.Application, c.Calculation,
+		c.CapacityDrift, c.DrainedResult, c.Heading, c.Run, c.SourceReport, c.Varsity,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -230,16 +256,24 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AdmissionChanceMutation:
+		return c.AdmissionChance.mutate(ctx, m)
+	case *AdmissionEventMutation:
+		return c.AdmissionEvent.mutate(ctx, m)
 	case *ApplicationMutation:
 		return c.Application.mutate(ctx, m)
 	case *CalculationMutation:
 		return c.Calculation.mutate(ctx, m)
+	case *CapacityDriftMutation:
+		return c.CapacityDrift.mutate(ctx, m)
 	case *DrainedResultMutation:
 		return c.DrainedResult.mutate(ctx, m)
 	case *HeadingMutation:
 		return c.Heading.mutate(ctx, m)
 	case *RunMutation:
 		return c.Run.mutate(ctx, m)
+	case *SourceReportMutation:
+		return c.SourceReport.mutate(ctx, m)
 	case *VarsityMutation:
 		return c.Varsity.mutate(ctx, m)
 	default:
@@ -247,6 +281,336 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
+// AdmissionChanceClient is a client for the AdmissionChance schema.
+type AdmissionChanceClient struct {
+	config
+}
+
+// NewAdmissionChanceClient returns a client for the AdmissionChance from the given config.
+func NewAdmissionChanceClient(c config) *AdmissionChanceClient {
+	return &AdmissionChanceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `admissionchance.Hooks(f(g(h())))`.
+func (c *AdmissionChanceClient) Use(hooks ...Hook) {
+	c.hooks.AdmissionChance = append(c.hooks.AdmissionChance, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `admissionchance.Intercept(f(g(h())))`.
+func (c *AdmissionChanceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AdmissionChance = append(c.inters.AdmissionChance, interceptors...)
+}
+
+// Create returns a builder for creating a AdmissionChance entity.
+func (c *AdmissionChanceClient) Create() *AdmissionChanceCreate {
+	mutation := newAdmissionChanceMutation(c.config, OpCreate)
+	return &AdmissionChanceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AdmissionChance entities.
+func (c *AdmissionChanceClient) CreateBulk(builders ...*AdmissionChanceCreate) *AdmissionChanceCreateBulk {
+	return &AdmissionChanceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AdmissionChanceClient) MapCreateBulk(slice any, setFunc func(*AdmissionChanceCreate, int)) *AdmissionChanceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AdmissionChanceCreateBulk{err: fmt.Errorf("calling to AdmissionChanceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AdmissionChanceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AdmissionChanceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AdmissionChance.
+func (c *AdmissionChanceClient) Update() *AdmissionChanceUpdate {
+	mutation := newAdmissionChanceMutation(c.config, OpUpdate)
+	return &AdmissionChanceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AdmissionChanceClient) UpdateOne(ac *AdmissionChance) *AdmissionChanceUpdateOne {
+	mutation := newAdmissionChanceMutation(c.config, OpUpdateOne, withAdmissionChance(ac))
+	return &AdmissionChanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AdmissionChanceClient) UpdateOneID(id int) *AdmissionChanceUpdateOne {
+	mutation := newAdmissionChanceMutation(c.config, OpUpdateOne, withAdmissionChanceID(id))
+	return &AdmissionChanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AdmissionChance.
+func (c *AdmissionChanceClient) Delete() *AdmissionChanceDelete {
+	mutation := newAdmissionChanceMutation(c.config, OpDelete)
+	return &AdmissionChanceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AdmissionChanceClient) DeleteOne(ac *AdmissionChance) *AdmissionChanceDeleteOne {
+	return c.DeleteOneID(ac.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AdmissionChanceClient) DeleteOneID(id int) *AdmissionChanceDeleteOne {
+	builder := c.Delete().Where(admissionchance.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AdmissionChanceDeleteOne{builder}
+}
+
+// Query returns a query builder for AdmissionChance.
+func (c *AdmissionChanceClient) Query() *AdmissionChanceQuery {
+	return &AdmissionChanceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAdmissionChance},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AdmissionChance entity by its id.
+func (c *AdmissionChanceClient) Get(ctx context.Context, id int) (*AdmissionChance, error) {
+	return c.Query().Where(admissionchance.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AdmissionChanceClient) GetX(ctx context.Context, id int) *AdmissionChance {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryHeading queries the heading edge of a AdmissionChance.
+func (c *AdmissionChanceClient) QueryHeading(ac *AdmissionChance) *HeadingQuery {
+	query := (&HeadingClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ac.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(admissionchance.Table, admissionchance.FieldID, id),
+			sqlgraph.To(heading.Table, heading.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, admissionchance.HeadingTable, admissionchance.HeadingColumn),
+		)
+		fromV = sqlgraph.Neighbors(ac.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRun queries the run edge of a AdmissionChance.
+func (c *AdmissionChanceClient) QueryRun(ac *AdmissionChance) *RunQuery {
+	query := (&RunClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ac.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(admissionchance.Table, admissionchance.FieldID, id),
+			sqlgraph.To(run.Table, run.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, admissionchance.RunTable, admissionchance.RunColumn),
+		)
+		fromV = sqlgraph.Neighbors(ac.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AdmissionChanceClient) Hooks() []Hook {
+	return c.hooks.AdmissionChance
+}
+
+// Interceptors returns the client interceptors.
+func (c *AdmissionChanceClient) Interceptors() []Interceptor {
+	return c.inters.AdmissionChance
+}
+
+func (c *AdmissionChanceClient) mutate(ctx context.Context, m *AdmissionChanceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AdmissionChanceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AdmissionChanceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AdmissionChanceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AdmissionChanceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AdmissionChance mutation op: %q", m.Op())
+	}
+}
+
+// AdmissionEventClient is a client for the AdmissionEvent schema.
+type AdmissionEventClient struct {
+	config
+}
+
+// NewAdmissionEventClient returns a client for the AdmissionEvent from the given config.
+func NewAdmissionEventClient(c config) *AdmissionEventClient {
+	return &AdmissionEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `admissionevent.Hooks(f(g(h())))`.
+func (c *AdmissionEventClient) Use(hooks ...Hook) {
+	c.hooks.AdmissionEvent = append(c.hooks.AdmissionEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `admissionevent.Intercept(f(g(h())))`.
+func (c *AdmissionEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AdmissionEvent = append(c.inters.AdmissionEvent, interceptors...)
+}
+
+// Create returns a builder for creating a AdmissionEvent entity.
+func (c *AdmissionEventClient) Create() *AdmissionEventCreate {
+	mutation := newAdmissionEventMutation(c.config, OpCreate)
+	return &AdmissionEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AdmissionEvent entities.
+func (c *AdmissionEventClient) CreateBulk(builders ...*AdmissionEventCreate) *AdmissionEventCreateBulk {
+	return &AdmissionEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AdmissionEventClient) MapCreateBulk(slice any, setFunc func(*AdmissionEventCreate, int)) *AdmissionEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AdmissionEventCreateBulk{err: fmt.Errorf("calling to AdmissionEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AdmissionEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AdmissionEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AdmissionEvent.
+func (c *AdmissionEventClient) Update() *AdmissionEventUpdate {
+	mutation := newAdmissionEventMutation(c.config, OpUpdate)
+	return &AdmissionEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AdmissionEventClient) UpdateOne(ae *AdmissionEvent) *AdmissionEventUpdateOne {
+	mutation := newAdmissionEventMutation(c.config, OpUpdateOne, withAdmissionEvent(ae))
+	return &AdmissionEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AdmissionEventClient) UpdateOneID(id int) *AdmissionEventUpdateOne {
+	mutation := newAdmissionEventMutation(c.config, OpUpdateOne, withAdmissionEventID(id))
+	return &AdmissionEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AdmissionEvent.
+func (c *AdmissionEventClient) Delete() *AdmissionEventDelete {
+	mutation := newAdmissionEventMutation(c.config, OpDelete)
+	return &AdmissionEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AdmissionEventClient) DeleteOne(ae *AdmissionEvent) *AdmissionEventDeleteOne {
+	return c.DeleteOneID(ae.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AdmissionEventClient) DeleteOneID(id int) *AdmissionEventDeleteOne {
+	builder := c.Delete().Where(admissionevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AdmissionEventDeleteOne{builder}
+}
+
+// Query returns a query builder for AdmissionEvent.
+func (c *AdmissionEventClient) Query() *AdmissionEventQuery {
+	return &AdmissionEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAdmissionEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AdmissionEvent entity by its id.
+func (c *AdmissionEventClient) Get(ctx context.Context, id int) (*AdmissionEvent, error) {
+	return c.Query().Where(admissionevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AdmissionEventClient) GetX(ctx context.Context, id int) *AdmissionEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryHeading queries the heading edge of a AdmissionEvent.
+func (c *AdmissionEventClient) QueryHeading(ae *AdmissionEvent) *HeadingQuery {
+	query := (&HeadingClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ae.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(admissionevent.Table, admissionevent.FieldID, id),
+			sqlgraph.To(heading.Table, heading.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, admissionevent.HeadingTable, admissionevent.HeadingColumn),
+		)
+		fromV = sqlgraph.Neighbors(ae.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRun queries the run edge of a AdmissionEvent.
+func (c *AdmissionEventClient) QueryRun(ae *AdmissionEvent) *RunQuery {
+	query := (&RunClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ae.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(admissionevent.Table, admissionevent.FieldID, id),
+			sqlgraph.To(run.Table, run.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, admissionevent.RunTable, admissionevent.RunColumn),
+		)
+		fromV = sqlgraph.Neighbors(ae.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AdmissionEventClient) Hooks() []Hook {
+	return c.hooks.AdmissionEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *AdmissionEventClient) Interceptors() []Interceptor {
+	return c.inters.AdmissionEvent
+}
+
+func (c *AdmissionEventClient) mutate(ctx context.Context, m *AdmissionEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AdmissionEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AdmissionEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AdmissionEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AdmissionEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AdmissionEvent mutation op: %q", m.Op())
+	}
+}
+
 // ApplicationClient is a client for the Application schema.
 type ApplicationClient struct {
 	config
@@ -577,6 +941,155 @@ func (c *CalculationClient) mutate(ctx context.Context, m *CalculationMutation)
 	}
 }
 
+// CapacityDriftClient is a client for the CapacityDrift schema.
+type CapacityDriftClient struct {
+	config
+}
+
+// NewCapacityDriftClient returns a client for the CapacityDrift from the given config.
+func NewCapacityDriftClient(c config) *CapacityDriftClient {
+	return &CapacityDriftClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `capacitydrift.Hooks(f(g(h())))`.
+func (c *CapacityDriftClient) Use(hooks ...Hook) {
+	c.hooks.CapacityDrift = append(c.hooks.CapacityDrift, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `capacitydrift.Intercept(f(g(h())))`.
+func (c *CapacityDriftClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CapacityDrift = append(c.inters.CapacityDrift, interceptors...)
+}
+
+// Create returns a builder for creating a CapacityDrift entity.
+func (c *CapacityDriftClient) Create() *CapacityDriftCreate {
+	mutation := newCapacityDriftMutation(c.config, OpCreate)
+	return &CapacityDriftCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CapacityDrift entities.
+func (c *CapacityDriftClient) CreateBulk(builders ...*CapacityDriftCreate) *CapacityDriftCreateBulk {
+	return &CapacityDriftCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CapacityDriftClient) MapCreateBulk(slice any, setFunc func(*CapacityDriftCreate, int)) *CapacityDriftCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CapacityDriftCreateBulk{err: fmt.Errorf("calling to CapacityDriftClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CapacityDriftCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CapacityDriftCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CapacityDrift.
+func (c *CapacityDriftClient) Update() *CapacityDriftUpdate {
+	mutation := newCapacityDriftMutation(c.config, OpUpdate)
+	return &CapacityDriftUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CapacityDriftClient) UpdateOne(cd *CapacityDrift) *CapacityDriftUpdateOne {
+	mutation := newCapacityDriftMutation(c.config, OpUpdateOne, withCapacityDrift(cd))
+	return &CapacityDriftUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CapacityDriftClient) UpdateOneID(id int) *CapacityDriftUpdateOne {
+	mutation := newCapacityDriftMutation(c.config, OpUpdateOne, withCapacityDriftID(id))
+	return &CapacityDriftUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CapacityDrift.
+func (c *CapacityDriftClient) Delete() *CapacityDriftDelete {
+	mutation := newCapacityDriftMutation(c.config, OpDelete)
+	return &CapacityDriftDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CapacityDriftClient) DeleteOne(cd *CapacityDrift) *CapacityDriftDeleteOne {
+	return c.DeleteOneID(cd.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CapacityDriftClient) DeleteOneID(id int) *CapacityDriftDeleteOne {
+	builder := c.Delete().Where(capacitydrift.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CapacityDriftDeleteOne{builder}
+}
+
+// Query returns a query builder for CapacityDrift.
+func (c *CapacityDriftClient) Query() *CapacityDriftQuery {
+	return &CapacityDriftQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCapacityDrift},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CapacityDrift entity by its id.
+func (c *CapacityDriftClient) Get(ctx context.Context, id int) (*CapacityDrift, error) {
+	return c.Query().Where(capacitydrift.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CapacityDriftClient) GetX(ctx context.Context, id int) *CapacityDrift {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRun queries the run edge of a CapacityDrift.
+func (c *CapacityDriftClient) QueryRun(cd *CapacityDrift) *RunQuery {
+	query := (&RunClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cd.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(capacitydrift.Table, capacitydrift.FieldID, id),
+			sqlgraph.To(run.Table, run.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, capacitydrift.RunTable, capacitydrift.RunColumn),
+		)
+		fromV = sqlgraph.Neighbors(cd.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CapacityDriftClient) Hooks() []Hook {
+	return c.hooks.CapacityDrift
+}
+
+// Interceptors returns the client interceptors.
+func (c *CapacityDriftClient) Interceptors() []Interceptor {
+	return c.inters.CapacityDrift
+}
+
+func (c *CapacityDriftClient) mutate(ctx context.Context, m *CapacityDriftMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CapacityDriftCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CapacityDriftUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CapacityDriftUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CapacityDriftDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CapacityDrift mutation op: %q", m.Op())
+	}
+}
+
 // DrainedResultClient is a client for the DrainedResult schema.
 type DrainedResultClient struct {
 	config
@@ -914,6 +1427,38 @@ func (c *HeadingClient) QueryDrainedResults(h *Heading) *DrainedResultQuery {
 	return query
 }
 
+// QueryAdmissionChances queries the admission_chances edge of a Heading.
+func (c *HeadingClient) QueryAdmissionChances(h *Heading) *AdmissionChanceQuery {
+	query := (&AdmissionChanceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := h.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(heading.Table, heading.FieldID, id),
+			sqlgraph.To(admissionchance.Table, admissionchance.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, heading.AdmissionChancesTable, heading.AdmissionChancesColumn),
+		)
+		fromV = sqlgraph.Neighbors(h.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAdmissionEvents queries the admission_events edge of a Heading.
+func (c *HeadingClient) QueryAdmissionEvents(h *Heading) *AdmissionEventQuery {
+	query := (&AdmissionEventClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := h.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(heading.Table, heading.FieldID, id),
+			sqlgraph.To(admissionevent.Table, admissionevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, heading.AdmissionEventsTable, heading.AdmissionEventsColumn),
+		)
+		fromV = sqlgraph.Neighbors(h.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *HeadingClient) Hooks() []Hook {
 	return c.hooks.Heading
@@ -1072,6 +1617,155 @@ func (c *RunClient) mutate(ctx context.Context, m *RunMutation) (Value, error) {
 	}
 }
 
+// SourceReportClient is a client for the SourceReport schema.
+type SourceReportClient struct {
+	config
+}
+
+// NewSourceReportClient returns a client for the SourceReport from the given config.
+func NewSourceReportClient(c config) *SourceReportClient {
+	return &SourceReportClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `sourcereport.Hooks(f(g(h())))`.
+func (c *SourceReportClient) Use(hooks ...Hook) {
+	c.hooks.SourceReport = append(c.hooks.SourceReport, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `sourcereport.Intercept(f(g(h())))`.
+func (c *SourceReportClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SourceReport = append(c.inters.SourceReport, interceptors...)
+}
+
+// Create returns a builder for creating a SourceReport entity.
+func (c *SourceReportClient) Create() *SourceReportCreate {
+	mutation := newSourceReportMutation(c.config, OpCreate)
+	return &SourceReportCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SourceReport entities.
+func (c *SourceReportClient) CreateBulk(builders ...*SourceReportCreate) *SourceReportCreateBulk {
+	return &SourceReportCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SourceReportClient) MapCreateBulk(slice any, setFunc func(*SourceReportCreate, int)) *SourceReportCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SourceReportCreateBulk{err: fmt.Errorf("calling to SourceReportClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SourceReportCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SourceReportCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SourceReport.
+func (c *SourceReportClient) Update() *SourceReportUpdate {
+	mutation := newSourceReportMutation(c.config, OpUpdate)
+	return &SourceReportUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SourceReportClient) UpdateOne(sr *SourceReport) *SourceReportUpdateOne {
+	mutation := newSourceReportMutation(c.config, OpUpdateOne, withSourceReport(sr))
+	return &SourceReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SourceReportClient) UpdateOneID(id int) *SourceReportUpdateOne {
+	mutation := newSourceReportMutation(c.config, OpUpdateOne, withSourceReportID(id))
+	return &SourceReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SourceReport.
+func (c *SourceReportClient) Delete() *SourceReportDelete {
+	mutation := newSourceReportMutation(c.config, OpDelete)
+	return &SourceReportDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SourceReportClient) DeleteOne(sr *SourceReport) *SourceReportDeleteOne {
+	return c.DeleteOneID(sr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SourceReportClient) DeleteOneID(id int) *SourceReportDeleteOne {
+	builder := c.Delete().Where(sourcereport.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SourceReportDeleteOne{builder}
+}
+
+// Query returns a query builder for SourceReport.
+func (c *SourceReportClient) Query() *SourceReportQuery {
+	return &SourceReportQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSourceReport},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SourceReport entity by its id.
+func (c *SourceReportClient) Get(ctx context.Context, id int) (*SourceReport, error) {
+	return c.Query().Where(sourcereport.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SourceReportClient) GetX(ctx context.Context, id int) *SourceReport {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRun queries the run edge of a SourceReport.
+func (c *SourceReportClient) QueryRun(sr *SourceReport) *RunQuery {
+	query := (&RunClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sourcereport.Table, sourcereport.FieldID, id),
+			sqlgraph.To(run.Table, run.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, sourcereport.RunTable, sourcereport.RunColumn),
+		)
+		fromV = sqlgraph.Neighbors(sr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SourceReportClient) Hooks() []Hook {
+	return c.hooks.SourceReport
+}
+
+// Interceptors returns the client interceptors.
+func (c *SourceReportClient) Interceptors() []Interceptor {
+	return c.inters.SourceReport
+}
+
+func (c *SourceReportClient) mutate(ctx context.Context, m *SourceReportMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SourceReportCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SourceReportUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SourceReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SourceReportDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SourceReport mutation op: %q", m.Op())
+	}
+}
+
 // VarsityClient is a client for the Varsity schema.
 type VarsityClient struct {
 	config
@@ -1224,10 +1918,12 @@ func (c *VarsityClient) mutate(ctx context.Context, m *VarsityMutation) (Value,
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Application, Calculation, DrainedResult, Heading, Run, Varsity []ent.Hook
+		AdmissionChance, AdmissionEvent, Application, Calculation, CapacityDrift,
+		DrainedResult, Heading, Run, SourceReport, Varsity []ent.Hook
 	}
 	inters struct {
-		Application, Calculation, DrainedResult, Heading, Run, Varsity []ent.Interceptor
+		AdmissionChance, AdmissionEvent, Application, Calculation, CapacityDrift,
+		DrainedResult, Heading, Run, SourceReport, Varsity []ent.Interceptor
 	}
 )
 
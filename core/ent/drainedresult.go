@@ -3,11 +3,13 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/trueegorletov/analabit/core"
 	"github.com/trueegorletov/analabit/core/ent/drainedresult"
 	"github.com/trueegorletov/analabit/core/ent/heading"
 	"github.com/trueegorletov/analabit/core/ent/run"
@@ -42,6 +44,42 @@ type DrainedResult struct {
 	RegularsAdmitted bool `json:"regulars_admitted,omitempty"`
 	// IsVirtual holds the value of the "is_virtual" field.
 	IsVirtual bool `json:"is_virtual,omitempty"`
+	// Seed holds the value of the "seed" field.
+	Seed int64 `json:"seed,omitempty"`
+	// DrainModel holds the value of the "drain_model" field.
+	DrainModel string `json:"drain_model,omitempty"`
+	// AvgPriorityStageAdmitted holds the value of the "avg_priority_stage_admitted" field.
+	AvgPriorityStageAdmitted int `json:"avg_priority_stage_admitted,omitempty"`
+	// AvgMainStageAdmitted holds the value of the "avg_main_stage_admitted" field.
+	AvgMainStageAdmitted int `json:"avg_main_stage_admitted,omitempty"`
+	// SubQuotas holds the value of the "sub_quotas" field.
+	SubQuotas []core.SubQuotaResultDTO `json:"sub_quotas,omitempty"`
+	// AvgPaidPassingScore holds the value of the "avg_paid_passing_score" field.
+	AvgPaidPassingScore int `json:"avg_paid_passing_score,omitempty"`
+	// AvgPaidLastAdmittedRatingPlace holds the value of the "avg_paid_last_admitted_rating_place" field.
+	AvgPaidLastAdmittedRatingPlace int `json:"avg_paid_last_admitted_rating_place,omitempty"`
+	// P10PassingScore holds the value of the "p10_passing_score" field.
+	P10PassingScore int `json:"p10_passing_score,omitempty"`
+	// P25PassingScore holds the value of the "p25_passing_score" field.
+	P25PassingScore int `json:"p25_passing_score,omitempty"`
+	// P75PassingScore holds the value of the "p75_passing_score" field.
+	P75PassingScore int `json:"p75_passing_score,omitempty"`
+	// P90PassingScore holds the value of the "p90_passing_score" field.
+	P90PassingScore int `json:"p90_passing_score,omitempty"`
+	// P10LastAdmittedRatingPlace holds the value of the "p10_last_admitted_rating_place" field.
+	P10LastAdmittedRatingPlace int `json:"p10_last_admitted_rating_place,omitempty"`
+	// P25LastAdmittedRatingPlace holds the value of the "p25_last_admitted_rating_place" field.
+	P25LastAdmittedRatingPlace int `json:"p25_last_admitted_rating_place,omitempty"`
+	// P75LastAdmittedRatingPlace holds the value of the "p75_last_admitted_rating_place" field.
+	P75LastAdmittedRatingPlace int `json:"p75_last_admitted_rating_place,omitempty"`
+	// P90LastAdmittedRatingPlace holds the value of the "p90_last_admitted_rating_place" field.
+	P90LastAdmittedRatingPlace int `json:"p90_last_admitted_rating_place,omitempty"`
+	// PassingScoreHistogram holds the value of the "passing_score_histogram" field.
+	PassingScoreHistogram *core.HistogramDTO `json:"passing_score_histogram,omitempty"`
+	// LastAdmittedRatingPlaceHistogram holds the value of the "last_admitted_rating_place_histogram" field.
+	LastAdmittedRatingPlaceHistogram *core.HistogramDTO `json:"last_admitted_rating_place_histogram,omitempty"`
+	// Iterations holds the value of the "iterations" field.
+	Iterations int `json:"iterations,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the DrainedResultQuery when eager-loading is set.
 	Edges                   DrainedResultEdges `json:"edges"`
@@ -87,10 +125,14 @@ func (*DrainedResult) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case drainedresult.FieldSubQuotas, drainedresult.FieldPassingScoreHistogram, drainedresult.FieldLastAdmittedRatingPlaceHistogram:
+			values[i] = new([]byte)
 		case drainedresult.FieldRegularsAdmitted, drainedresult.FieldIsVirtual:
 			values[i] = new(sql.NullBool)
-		case drainedresult.FieldID, drainedresult.FieldDrainedPercent, drainedresult.FieldAvgPassingScore, drainedresult.FieldMinPassingScore, drainedresult.FieldMaxPassingScore, drainedresult.FieldMedPassingScore, drainedresult.FieldAvgLastAdmittedRatingPlace, drainedresult.FieldMinLastAdmittedRatingPlace, drainedresult.FieldMaxLastAdmittedRatingPlace, drainedresult.FieldMedLastAdmittedRatingPlace, drainedresult.FieldRunID:
+		case drainedresult.FieldID, drainedresult.FieldDrainedPercent, drainedresult.FieldAvgPassingScore, drainedresult.FieldMinPassingScore, drainedresult.FieldMaxPassingScore, drainedresult.FieldMedPassingScore, drainedresult.FieldAvgLastAdmittedRatingPlace, drainedresult.FieldMinLastAdmittedRatingPlace, drainedresult.FieldMaxLastAdmittedRatingPlace, drainedresult.FieldMedLastAdmittedRatingPlace, drainedresult.FieldRunID, drainedresult.FieldSeed, drainedresult.FieldAvgPriorityStageAdmitted, drainedresult.FieldAvgMainStageAdmitted, drainedresult.FieldAvgPaidPassingScore, drainedresult.FieldAvgPaidLastAdmittedRatingPlace, drainedresult.FieldP10PassingScore, drainedresult.FieldP25PassingScore, drainedresult.FieldP75PassingScore, drainedresult.FieldP90PassingScore, drainedresult.FieldP10LastAdmittedRatingPlace, drainedresult.FieldP25LastAdmittedRatingPlace, drainedresult.FieldP75LastAdmittedRatingPlace, drainedresult.FieldP90LastAdmittedRatingPlace, drainedresult.FieldIterations:
 			values[i] = new(sql.NullInt64)
+		case drainedresult.FieldDrainModel:
+			values[i] = new(sql.NullString)
 		case drainedresult.ForeignKeys[0]: // heading_drained_results
 			values[i] = new(sql.NullInt64)
 		default:
@@ -186,6 +228,120 @@ func (dr *DrainedResult) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				dr.IsVirtual = value.Bool
 			}
+		case drainedresult.FieldSeed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field seed", values[i])
+			} else if value.Valid {
+				dr.Seed = value.Int64
+			}
+		case drainedresult.FieldDrainModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field drain_model", values[i])
+			} else if value.Valid {
+				dr.DrainModel = value.String
+			}
+		case drainedresult.FieldAvgPriorityStageAdmitted:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field avg_priority_stage_admitted", values[i])
+			} else if value.Valid {
+				dr.AvgPriorityStageAdmitted = int(value.Int64)
+			}
+		case drainedresult.FieldAvgMainStageAdmitted:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field avg_main_stage_admitted", values[i])
+			} else if value.Valid {
+				dr.AvgMainStageAdmitted = int(value.Int64)
+			}
+		case drainedresult.FieldSubQuotas:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field sub_quotas", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &dr.SubQuotas); err != nil {
+					return fmt.Errorf("unmarshal field sub_quotas: %w", err)
+				}
+			}
+		case drainedresult.FieldAvgPaidPassingScore:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field avg_paid_passing_score", values[i])
+			} else if value.Valid {
+				dr.AvgPaidPassingScore = int(value.Int64)
+			}
+		case drainedresult.FieldAvgPaidLastAdmittedRatingPlace:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field avg_paid_last_admitted_rating_place", values[i])
+			} else if value.Valid {
+				dr.AvgPaidLastAdmittedRatingPlace = int(value.Int64)
+			}
+		case drainedresult.FieldP10PassingScore:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field p10_passing_score", values[i])
+			} else if value.Valid {
+				dr.P10PassingScore = int(value.Int64)
+			}
+		case drainedresult.FieldP25PassingScore:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field p25_passing_score", values[i])
+			} else if value.Valid {
+				dr.P25PassingScore = int(value.Int64)
+			}
+		case drainedresult.FieldP75PassingScore:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field p75_passing_score", values[i])
+			} else if value.Valid {
+				dr.P75PassingScore = int(value.Int64)
+			}
+		case drainedresult.FieldP90PassingScore:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field p90_passing_score", values[i])
+			} else if value.Valid {
+				dr.P90PassingScore = int(value.Int64)
+			}
+		case drainedresult.FieldP10LastAdmittedRatingPlace:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field p10_last_admitted_rating_place", values[i])
+			} else if value.Valid {
+				dr.P10LastAdmittedRatingPlace = int(value.Int64)
+			}
+		case drainedresult.FieldP25LastAdmittedRatingPlace:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field p25_last_admitted_rating_place", values[i])
+			} else if value.Valid {
+				dr.P25LastAdmittedRatingPlace = int(value.Int64)
+			}
+		case drainedresult.FieldP75LastAdmittedRatingPlace:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field p75_last_admitted_rating_place", values[i])
+			} else if value.Valid {
+				dr.P75LastAdmittedRatingPlace = int(value.Int64)
+			}
+		case drainedresult.FieldP90LastAdmittedRatingPlace:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field p90_last_admitted_rating_place", values[i])
+			} else if value.Valid {
+				dr.P90LastAdmittedRatingPlace = int(value.Int64)
+			}
+		case drainedresult.FieldPassingScoreHistogram:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field passing_score_histogram", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &dr.PassingScoreHistogram); err != nil {
+					return fmt.Errorf("unmarshal field passing_score_histogram: %w", err)
+				}
+			}
+		case drainedresult.FieldLastAdmittedRatingPlaceHistogram:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field last_admitted_rating_place_histogram", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &dr.LastAdmittedRatingPlaceHistogram); err != nil {
+					return fmt.Errorf("unmarshal field last_admitted_rating_place_histogram: %w", err)
+				}
+			}
+		case drainedresult.FieldIterations:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field iterations", values[i])
+			} else if value.Valid {
+				dr.Iterations = int(value.Int64)
+			}
 		case drainedresult.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field heading_drained_results", value)
@@ -274,6 +430,60 @@ func (dr *DrainedResult) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("is_virtual=")
 	builder.WriteString(fmt.Sprintf("%v", dr.IsVirtual))
+	builder.WriteString(", ")
+	builder.WriteString("seed=")
+	builder.WriteString(fmt.Sprintf("%v", dr.Seed))
+	builder.WriteString(", ")
+	builder.WriteString("drain_model=")
+	builder.WriteString(dr.DrainModel)
+	builder.WriteString(", ")
+	builder.WriteString("avg_priority_stage_admitted=")
+	builder.WriteString(fmt.Sprintf("%v", dr.AvgPriorityStageAdmitted))
+	builder.WriteString(", ")
+	builder.WriteString("avg_main_stage_admitted=")
+	builder.WriteString(fmt.Sprintf("%v", dr.AvgMainStageAdmitted))
+	builder.WriteString(", ")
+	builder.WriteString("sub_quotas=")
+	builder.WriteString(fmt.Sprintf("%v", dr.SubQuotas))
+	builder.WriteString(", ")
+	builder.WriteString("avg_paid_passing_score=")
+	builder.WriteString(fmt.Sprintf("%v", dr.AvgPaidPassingScore))
+	builder.WriteString(", ")
+	builder.WriteString("avg_paid_last_admitted_rating_place=")
+	builder.WriteString(fmt.Sprintf("%v", dr.AvgPaidLastAdmittedRatingPlace))
+	builder.WriteString(", ")
+	builder.WriteString("p10_passing_score=")
+	builder.WriteString(fmt.Sprintf("%v", dr.P10PassingScore))
+	builder.WriteString(", ")
+	builder.WriteString("p25_passing_score=")
+	builder.WriteString(fmt.Sprintf("%v", dr.P25PassingScore))
+	builder.WriteString(", ")
+	builder.WriteString("p75_passing_score=")
+	builder.WriteString(fmt.Sprintf("%v", dr.P75PassingScore))
+	builder.WriteString(", ")
+	builder.WriteString("p90_passing_score=")
+	builder.WriteString(fmt.Sprintf("%v", dr.P90PassingScore))
+	builder.WriteString(", ")
+	builder.WriteString("p10_last_admitted_rating_place=")
+	builder.WriteString(fmt.Sprintf("%v", dr.P10LastAdmittedRatingPlace))
+	builder.WriteString(", ")
+	builder.WriteString("p25_last_admitted_rating_place=")
+	builder.WriteString(fmt.Sprintf("%v", dr.P25LastAdmittedRatingPlace))
+	builder.WriteString(", ")
+	builder.WriteString("p75_last_admitted_rating_place=")
+	builder.WriteString(fmt.Sprintf("%v", dr.P75LastAdmittedRatingPlace))
+	builder.WriteString(", ")
+	builder.WriteString("p90_last_admitted_rating_place=")
+	builder.WriteString(fmt.Sprintf("%v", dr.P90LastAdmittedRatingPlace))
+	builder.WriteString(", ")
+	builder.WriteString("passing_score_histogram=")
+	builder.WriteString(fmt.Sprintf("%v", dr.PassingScoreHistogram))
+	builder.WriteString(", ")
+	builder.WriteString("last_admitted_rating_place_histogram=")
+	builder.WriteString(fmt.Sprintf("%v", dr.LastAdmittedRatingPlaceHistogram))
+	builder.WriteString(", ")
+	builder.WriteString("iterations=")
+	builder.WriteString(fmt.Sprintf("%v", dr.Iterations))
 	builder.WriteByte(')')
 	return builder.String()
 }
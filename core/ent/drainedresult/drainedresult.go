@@ -36,6 +36,42 @@ const (
 	FieldRegularsAdmitted = "regulars_admitted"
 	// FieldIsVirtual holds the string denoting the is_virtual field in the database.
 	FieldIsVirtual = "is_virtual"
+	// FieldSeed holds the string denoting the seed field in the database.
+	FieldSeed = "seed"
+	// FieldDrainModel holds the string denoting the drain_model field in the database.
+	FieldDrainModel = "drain_model"
+	// FieldAvgPriorityStageAdmitted holds the string denoting the avg_priority_stage_admitted field in the database.
+	FieldAvgPriorityStageAdmitted = "avg_priority_stage_admitted"
+	// FieldAvgMainStageAdmitted holds the string denoting the avg_main_stage_admitted field in the database.
+	FieldAvgMainStageAdmitted = "avg_main_stage_admitted"
+	// FieldSubQuotas holds the string denoting the sub_quotas field in the database.
+	FieldSubQuotas = "sub_quotas"
+	// FieldAvgPaidPassingScore holds the string denoting the avg_paid_passing_score field in the database.
+	FieldAvgPaidPassingScore = "avg_paid_passing_score"
+	// FieldAvgPaidLastAdmittedRatingPlace holds the string denoting the avg_paid_last_admitted_rating_place field in the database.
+	FieldAvgPaidLastAdmittedRatingPlace = "avg_paid_last_admitted_rating_place"
+	// FieldP10PassingScore holds the string denoting the p10_passing_score field in the database.
+	FieldP10PassingScore = "p10_passing_score"
+	// FieldP25PassingScore holds the string denoting the p25_passing_score field in the database.
+	FieldP25PassingScore = "p25_passing_score"
+	// FieldP75PassingScore holds the string denoting the p75_passing_score field in the database.
+	FieldP75PassingScore = "p75_passing_score"
+	// FieldP90PassingScore holds the string denoting the p90_passing_score field in the database.
+	FieldP90PassingScore = "p90_passing_score"
+	// FieldP10LastAdmittedRatingPlace holds the string denoting the p10_last_admitted_rating_place field in the database.
+	FieldP10LastAdmittedRatingPlace = "p10_last_admitted_rating_place"
+	// FieldP25LastAdmittedRatingPlace holds the string denoting the p25_last_admitted_rating_place field in the database.
+	FieldP25LastAdmittedRatingPlace = "p25_last_admitted_rating_place"
+	// FieldP75LastAdmittedRatingPlace holds the string denoting the p75_last_admitted_rating_place field in the database.
+	FieldP75LastAdmittedRatingPlace = "p75_last_admitted_rating_place"
+	// FieldP90LastAdmittedRatingPlace holds the string denoting the p90_last_admitted_rating_place field in the database.
+	FieldP90LastAdmittedRatingPlace = "p90_last_admitted_rating_place"
+	// FieldPassingScoreHistogram holds the string denoting the passing_score_histogram field in the database.
+	FieldPassingScoreHistogram = "passing_score_histogram"
+	// FieldLastAdmittedRatingPlaceHistogram holds the string denoting the last_admitted_rating_place_histogram field in the database.
+	FieldLastAdmittedRatingPlaceHistogram = "last_admitted_rating_place_histogram"
+	// FieldIterations holds the string denoting the iterations field in the database.
+	FieldIterations = "iterations"
 	// EdgeHeading holds the string denoting the heading edge name in mutations.
 	EdgeHeading = "heading"
 	// EdgeRun holds the string denoting the run edge name in mutations.
@@ -73,6 +109,24 @@ var Columns = []string{
 	FieldRunID,
 	FieldRegularsAdmitted,
 	FieldIsVirtual,
+	FieldSeed,
+	FieldDrainModel,
+	FieldAvgPriorityStageAdmitted,
+	FieldAvgMainStageAdmitted,
+	FieldSubQuotas,
+	FieldAvgPaidPassingScore,
+	FieldAvgPaidLastAdmittedRatingPlace,
+	FieldP10PassingScore,
+	FieldP25PassingScore,
+	FieldP75PassingScore,
+	FieldP90PassingScore,
+	FieldP10LastAdmittedRatingPlace,
+	FieldP25LastAdmittedRatingPlace,
+	FieldP75LastAdmittedRatingPlace,
+	FieldP90LastAdmittedRatingPlace,
+	FieldPassingScoreHistogram,
+	FieldLastAdmittedRatingPlaceHistogram,
+	FieldIterations,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "drained_results"
@@ -171,6 +225,81 @@ func ByIsVirtual(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsVirtual, opts...).ToFunc()
 }
 
+// BySeed orders the results by the seed field.
+func BySeed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeed, opts...).ToFunc()
+}
+
+// ByDrainModel orders the results by the drain_model field.
+func ByDrainModel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDrainModel, opts...).ToFunc()
+}
+
+// ByAvgPriorityStageAdmitted orders the results by the avg_priority_stage_admitted field.
+func ByAvgPriorityStageAdmitted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAvgPriorityStageAdmitted, opts...).ToFunc()
+}
+
+// ByAvgMainStageAdmitted orders the results by the avg_main_stage_admitted field.
+func ByAvgMainStageAdmitted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAvgMainStageAdmitted, opts...).ToFunc()
+}
+
+// ByAvgPaidPassingScore orders the results by the avg_paid_passing_score field.
+func ByAvgPaidPassingScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAvgPaidPassingScore, opts...).ToFunc()
+}
+
+// ByAvgPaidLastAdmittedRatingPlace orders the results by the avg_paid_last_admitted_rating_place field.
+func ByAvgPaidLastAdmittedRatingPlace(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAvgPaidLastAdmittedRatingPlace, opts...).ToFunc()
+}
+
+// ByP10PassingScore orders the results by the p10_passing_score field.
+func ByP10PassingScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldP10PassingScore, opts...).ToFunc()
+}
+
+// ByP25PassingScore orders the results by the p25_passing_score field.
+func ByP25PassingScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldP25PassingScore, opts...).ToFunc()
+}
+
+// ByP75PassingScore orders the results by the p75_passing_score field.
+func ByP75PassingScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldP75PassingScore, opts...).ToFunc()
+}
+
+// ByP90PassingScore orders the results by the p90_passing_score field.
+func ByP90PassingScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldP90PassingScore, opts...).ToFunc()
+}
+
+// ByP10LastAdmittedRatingPlace orders the results by the p10_last_admitted_rating_place field.
+func ByP10LastAdmittedRatingPlace(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldP10LastAdmittedRatingPlace, opts...).ToFunc()
+}
+
+// ByP25LastAdmittedRatingPlace orders the results by the p25_last_admitted_rating_place field.
+func ByP25LastAdmittedRatingPlace(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldP25LastAdmittedRatingPlace, opts...).ToFunc()
+}
+
+// ByP75LastAdmittedRatingPlace orders the results by the p75_last_admitted_rating_place field.
+func ByP75LastAdmittedRatingPlace(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldP75LastAdmittedRatingPlace, opts...).ToFunc()
+}
+
+// ByP90LastAdmittedRatingPlace orders the results by the p90_last_admitted_rating_place field.
+func ByP90LastAdmittedRatingPlace(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldP90LastAdmittedRatingPlace, opts...).ToFunc()
+}
+
+// ByIterations orders the results by the iterations field.
+func ByIterations(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIterations, opts...).ToFunc()
+}
+
 // ByHeadingField orders the results by heading field.
 func ByHeadingField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -113,6 +113,81 @@ func IsVirtual(v bool) predicate.DrainedResult {
 	return predicate.DrainedResult(sql.FieldEQ(FieldIsVirtual, v))
 }
 
+// Seed applies equality check predicate on the "seed" field. It's identical to SeedEQ.
+func Seed(v int64) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldEQ(FieldSeed, v))
+}
+
+// DrainModel applies equality check predicate on the "drain_model" field. It's identical to DrainModelEQ.
+func DrainModel(v string) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldEQ(FieldDrainModel, v))
+}
+
+// AvgPriorityStageAdmitted applies equality check predicate on the "avg_priority_stage_admitted" field. It's identical to AvgPriorityStageAdmittedEQ.
+func AvgPriorityStageAdmitted(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldEQ(FieldAvgPriorityStageAdmitted, v))
+}
+
+// AvgMainStageAdmitted applies equality check predicate on the "avg_main_stage_admitted" field. It's identical to AvgMainStageAdmittedEQ.
+func AvgMainStageAdmitted(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldEQ(FieldAvgMainStageAdmitted, v))
+}
+
+// AvgPaidPassingScore applies equality check predicate on the "avg_paid_passing_score" field. It's identical to AvgPaidPassingScoreEQ.
+func AvgPaidPassingScore(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldEQ(FieldAvgPaidPassingScore, v))
+}
+
+// AvgPaidLastAdmittedRatingPlace applies equality check predicate on the "avg_paid_last_admitted_rating_place" field. It's identical to AvgPaidLastAdmittedRatingPlaceEQ.
+func AvgPaidLastAdmittedRatingPlace(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldEQ(FieldAvgPaidLastAdmittedRatingPlace, v))
+}
+
+// P10PassingScore applies equality check predicate on the "p10_passing_score" field. It's identical to P10PassingScoreEQ.
+func P10PassingScore(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldEQ(FieldP10PassingScore, v))
+}
+
+// P25PassingScore applies equality check predicate on the "p25_passing_score" field. It's identical to P25PassingScoreEQ.
+func P25PassingScore(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldEQ(FieldP25PassingScore, v))
+}
+
+// P75PassingScore applies equality check predicate on the "p75_passing_score" field. It's identical to P75PassingScoreEQ.
+func P75PassingScore(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldEQ(FieldP75PassingScore, v))
+}
+
+// P90PassingScore applies equality check predicate on the "p90_passing_score" field. It's identical to P90PassingScoreEQ.
+func P90PassingScore(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldEQ(FieldP90PassingScore, v))
+}
+
+// P10LastAdmittedRatingPlace applies equality check predicate on the "p10_last_admitted_rating_place" field. It's identical to P10LastAdmittedRatingPlaceEQ.
+func P10LastAdmittedRatingPlace(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldEQ(FieldP10LastAdmittedRatingPlace, v))
+}
+
+// P25LastAdmittedRatingPlace applies equality check predicate on the "p25_last_admitted_rating_place" field. It's identical to P25LastAdmittedRatingPlaceEQ.
+func P25LastAdmittedRatingPlace(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldEQ(FieldP25LastAdmittedRatingPlace, v))
+}
+
+// P75LastAdmittedRatingPlace applies equality check predicate on the "p75_last_admitted_rating_place" field. It's identical to P75LastAdmittedRatingPlaceEQ.
+func P75LastAdmittedRatingPlace(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldEQ(FieldP75LastAdmittedRatingPlace, v))
+}
+
+// P90LastAdmittedRatingPlace applies equality check predicate on the "p90_last_admitted_rating_place" field. It's identical to P90LastAdmittedRatingPlaceEQ.
+func P90LastAdmittedRatingPlace(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldEQ(FieldP90LastAdmittedRatingPlace, v))
+}
+
+// Iterations applies equality check predicate on the "iterations" field. It's identical to IterationsEQ.
+func Iterations(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldEQ(FieldIterations, v))
+}
+
 // DrainedPercentEQ applies the EQ predicate on the "drained_percent" field.
 func DrainedPercentEQ(v int) predicate.DrainedResult {
 	return predicate.DrainedResult(sql.FieldEQ(FieldDrainedPercent, v))
@@ -513,6 +588,811 @@ func IsVirtualNEQ(v bool) predicate.DrainedResult {
 	return predicate.DrainedResult(sql.FieldNEQ(FieldIsVirtual, v))
 }
 
+// SeedEQ applies the EQ predicate on the "seed" field.
+func SeedEQ(v int64) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldEQ(FieldSeed, v))
+}
+
+// SeedNEQ applies the NEQ predicate on the "seed" field.
+func SeedNEQ(v int64) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNEQ(FieldSeed, v))
+}
+
+// SeedIn applies the In predicate on the "seed" field.
+func SeedIn(vs ...int64) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldIn(FieldSeed, vs...))
+}
+
+// SeedNotIn applies the NotIn predicate on the "seed" field.
+func SeedNotIn(vs ...int64) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNotIn(FieldSeed, vs...))
+}
+
+// SeedGT applies the GT predicate on the "seed" field.
+func SeedGT(v int64) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldGT(FieldSeed, v))
+}
+
+// SeedGTE applies the GTE predicate on the "seed" field.
+func SeedGTE(v int64) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldGTE(FieldSeed, v))
+}
+
+// SeedLT applies the LT predicate on the "seed" field.
+func SeedLT(v int64) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldLT(FieldSeed, v))
+}
+
+// SeedLTE applies the LTE predicate on the "seed" field.
+func SeedLTE(v int64) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldLTE(FieldSeed, v))
+}
+
+// SeedIsNil applies the IsNil predicate on the "seed" field.
+func SeedIsNil() predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldIsNull(FieldSeed))
+}
+
+// SeedNotNil applies the NotNil predicate on the "seed" field.
+func SeedNotNil() predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNotNull(FieldSeed))
+}
+
+// DrainModelEQ applies the EQ predicate on the "drain_model" field.
+func DrainModelEQ(v string) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldEQ(FieldDrainModel, v))
+}
+
+// DrainModelNEQ applies the NEQ predicate on the "drain_model" field.
+func DrainModelNEQ(v string) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNEQ(FieldDrainModel, v))
+}
+
+// DrainModelIn applies the In predicate on the "drain_model" field.
+func DrainModelIn(vs ...string) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldIn(FieldDrainModel, vs...))
+}
+
+// DrainModelNotIn applies the NotIn predicate on the "drain_model" field.
+func DrainModelNotIn(vs ...string) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNotIn(FieldDrainModel, vs...))
+}
+
+// DrainModelGT applies the GT predicate on the "drain_model" field.
+func DrainModelGT(v string) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldGT(FieldDrainModel, v))
+}
+
+// DrainModelGTE applies the GTE predicate on the "drain_model" field.
+func DrainModelGTE(v string) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldGTE(FieldDrainModel, v))
+}
+
+// DrainModelLT applies the LT predicate on the "drain_model" field.
+func DrainModelLT(v string) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldLT(FieldDrainModel, v))
+}
+
+// DrainModelLTE applies the LTE predicate on the "drain_model" field.
+func DrainModelLTE(v string) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldLTE(FieldDrainModel, v))
+}
+
+// DrainModelContains applies the Contains predicate on the "drain_model" field.
+func DrainModelContains(v string) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldContains(FieldDrainModel, v))
+}
+
+// DrainModelHasPrefix applies the HasPrefix predicate on the "drain_model" field.
+func DrainModelHasPrefix(v string) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldHasPrefix(FieldDrainModel, v))
+}
+
+// DrainModelHasSuffix applies the HasSuffix predicate on the "drain_model" field.
+func DrainModelHasSuffix(v string) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldHasSuffix(FieldDrainModel, v))
+}
+
+// DrainModelIsNil applies the IsNil predicate on the "drain_model" field.
+func DrainModelIsNil() predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldIsNull(FieldDrainModel))
+}
+
+// DrainModelNotNil applies the NotNil predicate on the "drain_model" field.
+func DrainModelNotNil() predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNotNull(FieldDrainModel))
+}
+
+// DrainModelEqualFold applies the EqualFold predicate on the "drain_model" field.
+func DrainModelEqualFold(v string) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldEqualFold(FieldDrainModel, v))
+}
+
+// DrainModelContainsFold applies the ContainsFold predicate on the "drain_model" field.
+func DrainModelContainsFold(v string) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldContainsFold(FieldDrainModel, v))
+}
+
+// AvgPriorityStageAdmittedEQ applies the EQ predicate on the "avg_priority_stage_admitted" field.
+func AvgPriorityStageAdmittedEQ(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldEQ(FieldAvgPriorityStageAdmitted, v))
+}
+
+// AvgPriorityStageAdmittedNEQ applies the NEQ predicate on the "avg_priority_stage_admitted" field.
+func AvgPriorityStageAdmittedNEQ(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNEQ(FieldAvgPriorityStageAdmitted, v))
+}
+
+// AvgPriorityStageAdmittedIn applies the In predicate on the "avg_priority_stage_admitted" field.
+func AvgPriorityStageAdmittedIn(vs ...int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldIn(FieldAvgPriorityStageAdmitted, vs...))
+}
+
+// AvgPriorityStageAdmittedNotIn applies the NotIn predicate on the "avg_priority_stage_admitted" field.
+func AvgPriorityStageAdmittedNotIn(vs ...int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNotIn(FieldAvgPriorityStageAdmitted, vs...))
+}
+
+// AvgPriorityStageAdmittedGT applies the GT predicate on the "avg_priority_stage_admitted" field.
+func AvgPriorityStageAdmittedGT(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldGT(FieldAvgPriorityStageAdmitted, v))
+}
+
+// AvgPriorityStageAdmittedGTE applies the GTE predicate on the "avg_priority_stage_admitted" field.
+func AvgPriorityStageAdmittedGTE(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldGTE(FieldAvgPriorityStageAdmitted, v))
+}
+
+// AvgPriorityStageAdmittedLT applies the LT predicate on the "avg_priority_stage_admitted" field.
+func AvgPriorityStageAdmittedLT(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldLT(FieldAvgPriorityStageAdmitted, v))
+}
+
+// AvgPriorityStageAdmittedLTE applies the LTE predicate on the "avg_priority_stage_admitted" field.
+func AvgPriorityStageAdmittedLTE(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldLTE(FieldAvgPriorityStageAdmitted, v))
+}
+
+// AvgPriorityStageAdmittedIsNil applies the IsNil predicate on the "avg_priority_stage_admitted" field.
+func AvgPriorityStageAdmittedIsNil() predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldIsNull(FieldAvgPriorityStageAdmitted))
+}
+
+// AvgPriorityStageAdmittedNotNil applies the NotNil predicate on the "avg_priority_stage_admitted" field.
+func AvgPriorityStageAdmittedNotNil() predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNotNull(FieldAvgPriorityStageAdmitted))
+}
+
+// AvgMainStageAdmittedEQ applies the EQ predicate on the "avg_main_stage_admitted" field.
+func AvgMainStageAdmittedEQ(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldEQ(FieldAvgMainStageAdmitted, v))
+}
+
+// AvgMainStageAdmittedNEQ applies the NEQ predicate on the "avg_main_stage_admitted" field.
+func AvgMainStageAdmittedNEQ(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNEQ(FieldAvgMainStageAdmitted, v))
+}
+
+// AvgMainStageAdmittedIn applies the In predicate on the "avg_main_stage_admitted" field.
+func AvgMainStageAdmittedIn(vs ...int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldIn(FieldAvgMainStageAdmitted, vs...))
+}
+
+// AvgMainStageAdmittedNotIn applies the NotIn predicate on the "avg_main_stage_admitted" field.
+func AvgMainStageAdmittedNotIn(vs ...int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNotIn(FieldAvgMainStageAdmitted, vs...))
+}
+
+// AvgMainStageAdmittedGT applies the GT predicate on the "avg_main_stage_admitted" field.
+func AvgMainStageAdmittedGT(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldGT(FieldAvgMainStageAdmitted, v))
+}
+
+// AvgMainStageAdmittedGTE applies the GTE predicate on the "avg_main_stage_admitted" field.
+func AvgMainStageAdmittedGTE(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldGTE(FieldAvgMainStageAdmitted, v))
+}
+
+// AvgMainStageAdmittedLT applies the LT predicate on the "avg_main_stage_admitted" field.
+func AvgMainStageAdmittedLT(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldLT(FieldAvgMainStageAdmitted, v))
+}
+
+// AvgMainStageAdmittedLTE applies the LTE predicate on the "avg_main_stage_admitted" field.
+func AvgMainStageAdmittedLTE(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldLTE(FieldAvgMainStageAdmitted, v))
+}
+
+// AvgMainStageAdmittedIsNil applies the IsNil predicate on the "avg_main_stage_admitted" field.
+func AvgMainStageAdmittedIsNil() predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldIsNull(FieldAvgMainStageAdmitted))
+}
+
+// AvgMainStageAdmittedNotNil applies the NotNil predicate on the "avg_main_stage_admitted" field.
+func AvgMainStageAdmittedNotNil() predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNotNull(FieldAvgMainStageAdmitted))
+}
+
+// SubQuotasIsNil applies the IsNil predicate on the "sub_quotas" field.
+func SubQuotasIsNil() predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldIsNull(FieldSubQuotas))
+}
+
+// SubQuotasNotNil applies the NotNil predicate on the "sub_quotas" field.
+func SubQuotasNotNil() predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNotNull(FieldSubQuotas))
+}
+
+// AvgPaidPassingScoreEQ applies the EQ predicate on the "avg_paid_passing_score" field.
+func AvgPaidPassingScoreEQ(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldEQ(FieldAvgPaidPassingScore, v))
+}
+
+// AvgPaidPassingScoreNEQ applies the NEQ predicate on the "avg_paid_passing_score" field.
+func AvgPaidPassingScoreNEQ(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNEQ(FieldAvgPaidPassingScore, v))
+}
+
+// AvgPaidPassingScoreIn applies the In predicate on the "avg_paid_passing_score" field.
+func AvgPaidPassingScoreIn(vs ...int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldIn(FieldAvgPaidPassingScore, vs...))
+}
+
+// AvgPaidPassingScoreNotIn applies the NotIn predicate on the "avg_paid_passing_score" field.
+func AvgPaidPassingScoreNotIn(vs ...int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNotIn(FieldAvgPaidPassingScore, vs...))
+}
+
+// AvgPaidPassingScoreGT applies the GT predicate on the "avg_paid_passing_score" field.
+func AvgPaidPassingScoreGT(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldGT(FieldAvgPaidPassingScore, v))
+}
+
+// AvgPaidPassingScoreGTE applies the GTE predicate on the "avg_paid_passing_score" field.
+func AvgPaidPassingScoreGTE(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldGTE(FieldAvgPaidPassingScore, v))
+}
+
+// AvgPaidPassingScoreLT applies the LT predicate on the "avg_paid_passing_score" field.
+func AvgPaidPassingScoreLT(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldLT(FieldAvgPaidPassingScore, v))
+}
+
+// AvgPaidPassingScoreLTE applies the LTE predicate on the "avg_paid_passing_score" field.
+func AvgPaidPassingScoreLTE(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldLTE(FieldAvgPaidPassingScore, v))
+}
+
+// AvgPaidPassingScoreIsNil applies the IsNil predicate on the "avg_paid_passing_score" field.
+func AvgPaidPassingScoreIsNil() predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldIsNull(FieldAvgPaidPassingScore))
+}
+
+// AvgPaidPassingScoreNotNil applies the NotNil predicate on the "avg_paid_passing_score" field.
+func AvgPaidPassingScoreNotNil() predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNotNull(FieldAvgPaidPassingScore))
+}
+
+// AvgPaidLastAdmittedRatingPlaceEQ applies the EQ predicate on the "avg_paid_last_admitted_rating_place" field.
+func AvgPaidLastAdmittedRatingPlaceEQ(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldEQ(FieldAvgPaidLastAdmittedRatingPlace, v))
+}
+
+// AvgPaidLastAdmittedRatingPlaceNEQ applies the NEQ predicate on the "avg_paid_last_admitted_rating_place" field.
+func AvgPaidLastAdmittedRatingPlaceNEQ(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNEQ(FieldAvgPaidLastAdmittedRatingPlace, v))
+}
+
+// AvgPaidLastAdmittedRatingPlaceIn applies the In predicate on the "avg_paid_last_admitted_rating_place" field.
+func AvgPaidLastAdmittedRatingPlaceIn(vs ...int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldIn(FieldAvgPaidLastAdmittedRatingPlace, vs...))
+}
+
+// AvgPaidLastAdmittedRatingPlaceNotIn applies the NotIn predicate on the "avg_paid_last_admitted_rating_place" field.
+func AvgPaidLastAdmittedRatingPlaceNotIn(vs ...int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNotIn(FieldAvgPaidLastAdmittedRatingPlace, vs...))
+}
+
+// AvgPaidLastAdmittedRatingPlaceGT applies the GT predicate on the "avg_paid_last_admitted_rating_place" field.
+func AvgPaidLastAdmittedRatingPlaceGT(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldGT(FieldAvgPaidLastAdmittedRatingPlace, v))
+}
+
+// AvgPaidLastAdmittedRatingPlaceGTE applies the GTE predicate on the "avg_paid_last_admitted_rating_place" field.
+func AvgPaidLastAdmittedRatingPlaceGTE(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldGTE(FieldAvgPaidLastAdmittedRatingPlace, v))
+}
+
+// AvgPaidLastAdmittedRatingPlaceLT applies the LT predicate on the "avg_paid_last_admitted_rating_place" field.
+func AvgPaidLastAdmittedRatingPlaceLT(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldLT(FieldAvgPaidLastAdmittedRatingPlace, v))
+}
+
+// AvgPaidLastAdmittedRatingPlaceLTE applies the LTE predicate on the "avg_paid_last_admitted_rating_place" field.
+func AvgPaidLastAdmittedRatingPlaceLTE(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldLTE(FieldAvgPaidLastAdmittedRatingPlace, v))
+}
+
+// AvgPaidLastAdmittedRatingPlaceIsNil applies the IsNil predicate on the "avg_paid_last_admitted_rating_place" field.
+func AvgPaidLastAdmittedRatingPlaceIsNil() predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldIsNull(FieldAvgPaidLastAdmittedRatingPlace))
+}
+
+// AvgPaidLastAdmittedRatingPlaceNotNil applies the NotNil predicate on the "avg_paid_last_admitted_rating_place" field.
+func AvgPaidLastAdmittedRatingPlaceNotNil() predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNotNull(FieldAvgPaidLastAdmittedRatingPlace))
+}
+
+// P10PassingScoreEQ applies the EQ predicate on the "p10_passing_score" field.
+func P10PassingScoreEQ(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldEQ(FieldP10PassingScore, v))
+}
+
+// P10PassingScoreNEQ applies the NEQ predicate on the "p10_passing_score" field.
+func P10PassingScoreNEQ(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNEQ(FieldP10PassingScore, v))
+}
+
+// P10PassingScoreIn applies the In predicate on the "p10_passing_score" field.
+func P10PassingScoreIn(vs ...int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldIn(FieldP10PassingScore, vs...))
+}
+
+// P10PassingScoreNotIn applies the NotIn predicate on the "p10_passing_score" field.
+func P10PassingScoreNotIn(vs ...int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNotIn(FieldP10PassingScore, vs...))
+}
+
+// P10PassingScoreGT applies the GT predicate on the "p10_passing_score" field.
+func P10PassingScoreGT(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldGT(FieldP10PassingScore, v))
+}
+
+// P10PassingScoreGTE applies the GTE predicate on the "p10_passing_score" field.
+func P10PassingScoreGTE(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldGTE(FieldP10PassingScore, v))
+}
+
+// P10PassingScoreLT applies the LT predicate on the "p10_passing_score" field.
+func P10PassingScoreLT(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldLT(FieldP10PassingScore, v))
+}
+
+// P10PassingScoreLTE applies the LTE predicate on the "p10_passing_score" field.
+func P10PassingScoreLTE(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldLTE(FieldP10PassingScore, v))
+}
+
+// P10PassingScoreIsNil applies the IsNil predicate on the "p10_passing_score" field.
+func P10PassingScoreIsNil() predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldIsNull(FieldP10PassingScore))
+}
+
+// P10PassingScoreNotNil applies the NotNil predicate on the "p10_passing_score" field.
+func P10PassingScoreNotNil() predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNotNull(FieldP10PassingScore))
+}
+
+// P25PassingScoreEQ applies the EQ predicate on the "p25_passing_score" field.
+func P25PassingScoreEQ(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldEQ(FieldP25PassingScore, v))
+}
+
+// P25PassingScoreNEQ applies the NEQ predicate on the "p25_passing_score" field.
+func P25PassingScoreNEQ(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNEQ(FieldP25PassingScore, v))
+}
+
+// P25PassingScoreIn applies the In predicate on the "p25_passing_score" field.
+func P25PassingScoreIn(vs ...int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldIn(FieldP25PassingScore, vs...))
+}
+
+// P25PassingScoreNotIn applies the NotIn predicate on the "p25_passing_score" field.
+func P25PassingScoreNotIn(vs ...int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNotIn(FieldP25PassingScore, vs...))
+}
+
+// P25PassingScoreGT applies the GT predicate on the "p25_passing_score" field.
+func P25PassingScoreGT(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldGT(FieldP25PassingScore, v))
+}
+
+// P25PassingScoreGTE applies the GTE predicate on the "p25_passing_score" field.
+func P25PassingScoreGTE(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldGTE(FieldP25PassingScore, v))
+}
+
+// P25PassingScoreLT applies the LT predicate on the "p25_passing_score" field.
+func P25PassingScoreLT(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldLT(FieldP25PassingScore, v))
+}
+
+// P25PassingScoreLTE applies the LTE predicate on the "p25_passing_score" field.
+func P25PassingScoreLTE(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldLTE(FieldP25PassingScore, v))
+}
+
+// P25PassingScoreIsNil applies the IsNil predicate on the "p25_passing_score" field.
+func P25PassingScoreIsNil() predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldIsNull(FieldP25PassingScore))
+}
+
+// P25PassingScoreNotNil applies the NotNil predicate on the "p25_passing_score" field.
+func P25PassingScoreNotNil() predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNotNull(FieldP25PassingScore))
+}
+
+// P75PassingScoreEQ applies the EQ predicate on the "p75_passing_score" field.
+func P75PassingScoreEQ(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldEQ(FieldP75PassingScore, v))
+}
+
+// P75PassingScoreNEQ applies the NEQ predicate on the "p75_passing_score" field.
+func P75PassingScoreNEQ(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNEQ(FieldP75PassingScore, v))
+}
+
+// P75PassingScoreIn applies the In predicate on the "p75_passing_score" field.
+func P75PassingScoreIn(vs ...int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldIn(FieldP75PassingScore, vs...))
+}
+
+// P75PassingScoreNotIn applies the NotIn predicate on the "p75_passing_score" field.
+func P75PassingScoreNotIn(vs ...int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNotIn(FieldP75PassingScore, vs...))
+}
+
+// P75PassingScoreGT applies the GT predicate on the "p75_passing_score" field.
+func P75PassingScoreGT(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldGT(FieldP75PassingScore, v))
+}
+
+// P75PassingScoreGTE applies the GTE predicate on the "p75_passing_score" field.
+func P75PassingScoreGTE(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldGTE(FieldP75PassingScore, v))
+}
+
+// P75PassingScoreLT applies the LT predicate on the "p75_passing_score" field.
+func P75PassingScoreLT(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldLT(FieldP75PassingScore, v))
+}
+
+// P75PassingScoreLTE applies the LTE predicate on the "p75_passing_score" field.
+func P75PassingScoreLTE(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldLTE(FieldP75PassingScore, v))
+}
+
+// P75PassingScoreIsNil applies the IsNil predicate on the "p75_passing_score" field.
+func P75PassingScoreIsNil() predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldIsNull(FieldP75PassingScore))
+}
+
+// P75PassingScoreNotNil applies the NotNil predicate on the "p75_passing_score" field.
+func P75PassingScoreNotNil() predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNotNull(FieldP75PassingScore))
+}
+
+// P90PassingScoreEQ applies the EQ predicate on the "p90_passing_score" field.
+func P90PassingScoreEQ(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldEQ(FieldP90PassingScore, v))
+}
+
+// P90PassingScoreNEQ applies the NEQ predicate on the "p90_passing_score" field.
+func P90PassingScoreNEQ(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNEQ(FieldP90PassingScore, v))
+}
+
+// P90PassingScoreIn applies the In predicate on the "p90_passing_score" field.
+func P90PassingScoreIn(vs ...int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldIn(FieldP90PassingScore, vs...))
+}
+
+// P90PassingScoreNotIn applies the NotIn predicate on the "p90_passing_score" field.
+func P90PassingScoreNotIn(vs ...int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNotIn(FieldP90PassingScore, vs...))
+}
+
+// P90PassingScoreGT applies the GT predicate on the "p90_passing_score" field.
+func P90PassingScoreGT(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldGT(FieldP90PassingScore, v))
+}
+
+// P90PassingScoreGTE applies the GTE predicate on the "p90_passing_score" field.
+func P90PassingScoreGTE(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldGTE(FieldP90PassingScore, v))
+}
+
+// P90PassingScoreLT applies the LT predicate on the "p90_passing_score" field.
+func P90PassingScoreLT(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldLT(FieldP90PassingScore, v))
+}
+
+// P90PassingScoreLTE applies the LTE predicate on the "p90_passing_score" field.
+func P90PassingScoreLTE(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldLTE(FieldP90PassingScore, v))
+}
+
+// P90PassingScoreIsNil applies the IsNil predicate on the "p90_passing_score" field.
+func P90PassingScoreIsNil() predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldIsNull(FieldP90PassingScore))
+}
+
+// P90PassingScoreNotNil applies the NotNil predicate on the "p90_passing_score" field.
+func P90PassingScoreNotNil() predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNotNull(FieldP90PassingScore))
+}
+
+// P10LastAdmittedRatingPlaceEQ applies the EQ predicate on the "p10_last_admitted_rating_place" field.
+func P10LastAdmittedRatingPlaceEQ(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldEQ(FieldP10LastAdmittedRatingPlace, v))
+}
+
+// P10LastAdmittedRatingPlaceNEQ applies the NEQ predicate on the "p10_last_admitted_rating_place" field.
+func P10LastAdmittedRatingPlaceNEQ(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNEQ(FieldP10LastAdmittedRatingPlace, v))
+}
+
+// P10LastAdmittedRatingPlaceIn applies the In predicate on the "p10_last_admitted_rating_place" field.
+func P10LastAdmittedRatingPlaceIn(vs ...int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldIn(FieldP10LastAdmittedRatingPlace, vs...))
+}
+
+// P10LastAdmittedRatingPlaceNotIn applies the NotIn predicate on the "p10_last_admitted_rating_place" field.
+func P10LastAdmittedRatingPlaceNotIn(vs ...int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNotIn(FieldP10LastAdmittedRatingPlace, vs...))
+}
+
+// P10LastAdmittedRatingPlaceGT applies the GT predicate on the "p10_last_admitted_rating_place" field.
+func P10LastAdmittedRatingPlaceGT(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldGT(FieldP10LastAdmittedRatingPlace, v))
+}
+
+// P10LastAdmittedRatingPlaceGTE applies the GTE predicate on the "p10_last_admitted_rating_place" field.
+func P10LastAdmittedRatingPlaceGTE(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldGTE(FieldP10LastAdmittedRatingPlace, v))
+}
+
+// P10LastAdmittedRatingPlaceLT applies the LT predicate on the "p10_last_admitted_rating_place" field.
+func P10LastAdmittedRatingPlaceLT(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldLT(FieldP10LastAdmittedRatingPlace, v))
+}
+
+// P10LastAdmittedRatingPlaceLTE applies the LTE predicate on the "p10_last_admitted_rating_place" field.
+func P10LastAdmittedRatingPlaceLTE(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldLTE(FieldP10LastAdmittedRatingPlace, v))
+}
+
+// P10LastAdmittedRatingPlaceIsNil applies the IsNil predicate on the "p10_last_admitted_rating_place" field.
+func P10LastAdmittedRatingPlaceIsNil() predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldIsNull(FieldP10LastAdmittedRatingPlace))
+}
+
+// P10LastAdmittedRatingPlaceNotNil applies the NotNil predicate on the "p10_last_admitted_rating_place" field.
+func P10LastAdmittedRatingPlaceNotNil() predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNotNull(FieldP10LastAdmittedRatingPlace))
+}
+
+// P25LastAdmittedRatingPlaceEQ applies the EQ predicate on the "p25_last_admitted_rating_place" field.
+func P25LastAdmittedRatingPlaceEQ(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldEQ(FieldP25LastAdmittedRatingPlace, v))
+}
+
+// P25LastAdmittedRatingPlaceNEQ applies the NEQ predicate on the "p25_last_admitted_rating_place" field.
+func P25LastAdmittedRatingPlaceNEQ(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNEQ(FieldP25LastAdmittedRatingPlace, v))
+}
+
+// P25LastAdmittedRatingPlaceIn applies the In predicate on the "p25_last_admitted_rating_place" field.
+func P25LastAdmittedRatingPlaceIn(vs ...int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldIn(FieldP25LastAdmittedRatingPlace, vs...))
+}
+
+// P25LastAdmittedRatingPlaceNotIn applies the NotIn predicate on the "p25_last_admitted_rating_place" field.
+func P25LastAdmittedRatingPlaceNotIn(vs ...int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNotIn(FieldP25LastAdmittedRatingPlace, vs...))
+}
+
+// P25LastAdmittedRatingPlaceGT applies the GT predicate on the "p25_last_admitted_rating_place" field.
+func P25LastAdmittedRatingPlaceGT(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldGT(FieldP25LastAdmittedRatingPlace, v))
+}
+
+// P25LastAdmittedRatingPlaceGTE applies the GTE predicate on the "p25_last_admitted_rating_place" field.
+func P25LastAdmittedRatingPlaceGTE(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldGTE(FieldP25LastAdmittedRatingPlace, v))
+}
+
+// P25LastAdmittedRatingPlaceLT applies the LT predicate on the "p25_last_admitted_rating_place" field.
+func P25LastAdmittedRatingPlaceLT(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldLT(FieldP25LastAdmittedRatingPlace, v))
+}
+
+// P25LastAdmittedRatingPlaceLTE applies the LTE predicate on the "p25_last_admitted_rating_place" field.
+func P25LastAdmittedRatingPlaceLTE(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldLTE(FieldP25LastAdmittedRatingPlace, v))
+}
+
+// P25LastAdmittedRatingPlaceIsNil applies the IsNil predicate on the "p25_last_admitted_rating_place" field.
+func P25LastAdmittedRatingPlaceIsNil() predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldIsNull(FieldP25LastAdmittedRatingPlace))
+}
+
+// P25LastAdmittedRatingPlaceNotNil applies the NotNil predicate on the "p25_last_admitted_rating_place" field.
+func P25LastAdmittedRatingPlaceNotNil() predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNotNull(FieldP25LastAdmittedRatingPlace))
+}
+
+// P75LastAdmittedRatingPlaceEQ applies the EQ predicate on the "p75_last_admitted_rating_place" field.
+func P75LastAdmittedRatingPlaceEQ(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldEQ(FieldP75LastAdmittedRatingPlace, v))
+}
+
+// P75LastAdmittedRatingPlaceNEQ applies the NEQ predicate on the "p75_last_admitted_rating_place" field.
+func P75LastAdmittedRatingPlaceNEQ(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNEQ(FieldP75LastAdmittedRatingPlace, v))
+}
+
+// P75LastAdmittedRatingPlaceIn applies the In predicate on the "p75_last_admitted_rating_place" field.
+func P75LastAdmittedRatingPlaceIn(vs ...int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldIn(FieldP75LastAdmittedRatingPlace, vs...))
+}
+
+// P75LastAdmittedRatingPlaceNotIn applies the NotIn predicate on the "p75_last_admitted_rating_place" field.
+func P75LastAdmittedRatingPlaceNotIn(vs ...int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNotIn(FieldP75LastAdmittedRatingPlace, vs...))
+}
+
+// P75LastAdmittedRatingPlaceGT applies the GT predicate on the "p75_last_admitted_rating_place" field.
+func P75LastAdmittedRatingPlaceGT(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldGT(FieldP75LastAdmittedRatingPlace, v))
+}
+
+// P75LastAdmittedRatingPlaceGTE applies the GTE predicate on the "p75_last_admitted_rating_place" field.
+func P75LastAdmittedRatingPlaceGTE(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldGTE(FieldP75LastAdmittedRatingPlace, v))
+}
+
+// P75LastAdmittedRatingPlaceLT applies the LT predicate on the "p75_last_admitted_rating_place" field.
+func P75LastAdmittedRatingPlaceLT(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldLT(FieldP75LastAdmittedRatingPlace, v))
+}
+
+// P75LastAdmittedRatingPlaceLTE applies the LTE predicate on the "p75_last_admitted_rating_place" field.
+func P75LastAdmittedRatingPlaceLTE(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldLTE(FieldP75LastAdmittedRatingPlace, v))
+}
+
+// P75LastAdmittedRatingPlaceIsNil applies the IsNil predicate on the "p75_last_admitted_rating_place" field.
+func P75LastAdmittedRatingPlaceIsNil() predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldIsNull(FieldP75LastAdmittedRatingPlace))
+}
+
+// P75LastAdmittedRatingPlaceNotNil applies the NotNil predicate on the "p75_last_admitted_rating_place" field.
+func P75LastAdmittedRatingPlaceNotNil() predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNotNull(FieldP75LastAdmittedRatingPlace))
+}
+
+// P90LastAdmittedRatingPlaceEQ applies the EQ predicate on the "p90_last_admitted_rating_place" field.
+func P90LastAdmittedRatingPlaceEQ(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldEQ(FieldP90LastAdmittedRatingPlace, v))
+}
+
+// P90LastAdmittedRatingPlaceNEQ applies the NEQ predicate on the "p90_last_admitted_rating_place" field.
+func P90LastAdmittedRatingPlaceNEQ(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNEQ(FieldP90LastAdmittedRatingPlace, v))
+}
+
+// P90LastAdmittedRatingPlaceIn applies the In predicate on the "p90_last_admitted_rating_place" field.
+func P90LastAdmittedRatingPlaceIn(vs ...int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldIn(FieldP90LastAdmittedRatingPlace, vs...))
+}
+
+// P90LastAdmittedRatingPlaceNotIn applies the NotIn predicate on the "p90_last_admitted_rating_place" field.
+func P90LastAdmittedRatingPlaceNotIn(vs ...int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNotIn(FieldP90LastAdmittedRatingPlace, vs...))
+}
+
+// P90LastAdmittedRatingPlaceGT applies the GT predicate on the "p90_last_admitted_rating_place" field.
+func P90LastAdmittedRatingPlaceGT(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldGT(FieldP90LastAdmittedRatingPlace, v))
+}
+
+// P90LastAdmittedRatingPlaceGTE applies the GTE predicate on the "p90_last_admitted_rating_place" field.
+func P90LastAdmittedRatingPlaceGTE(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldGTE(FieldP90LastAdmittedRatingPlace, v))
+}
+
+// P90LastAdmittedRatingPlaceLT applies the LT predicate on the "p90_last_admitted_rating_place" field.
+func P90LastAdmittedRatingPlaceLT(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldLT(FieldP90LastAdmittedRatingPlace, v))
+}
+
+// P90LastAdmittedRatingPlaceLTE applies the LTE predicate on the "p90_last_admitted_rating_place" field.
+func P90LastAdmittedRatingPlaceLTE(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldLTE(FieldP90LastAdmittedRatingPlace, v))
+}
+
+// P90LastAdmittedRatingPlaceIsNil applies the IsNil predicate on the "p90_last_admitted_rating_place" field.
+func P90LastAdmittedRatingPlaceIsNil() predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldIsNull(FieldP90LastAdmittedRatingPlace))
+}
+
+// P90LastAdmittedRatingPlaceNotNil applies the NotNil predicate on the "p90_last_admitted_rating_place" field.
+func P90LastAdmittedRatingPlaceNotNil() predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNotNull(FieldP90LastAdmittedRatingPlace))
+}
+
+// PassingScoreHistogramIsNil applies the IsNil predicate on the "passing_score_histogram" field.
+func PassingScoreHistogramIsNil() predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldIsNull(FieldPassingScoreHistogram))
+}
+
+// PassingScoreHistogramNotNil applies the NotNil predicate on the "passing_score_histogram" field.
+func PassingScoreHistogramNotNil() predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNotNull(FieldPassingScoreHistogram))
+}
+
+// LastAdmittedRatingPlaceHistogramIsNil applies the IsNil predicate on the "last_admitted_rating_place_histogram" field.
+func LastAdmittedRatingPlaceHistogramIsNil() predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldIsNull(FieldLastAdmittedRatingPlaceHistogram))
+}
+
+// LastAdmittedRatingPlaceHistogramNotNil applies the NotNil predicate on the "last_admitted_rating_place_histogram" field.
+func LastAdmittedRatingPlaceHistogramNotNil() predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNotNull(FieldLastAdmittedRatingPlaceHistogram))
+}
+
+// IterationsEQ applies the EQ predicate on the "iterations" field.
+func IterationsEQ(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldEQ(FieldIterations, v))
+}
+
+// IterationsNEQ applies the NEQ predicate on the "iterations" field.
+func IterationsNEQ(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNEQ(FieldIterations, v))
+}
+
+// IterationsIn applies the In predicate on the "iterations" field.
+func IterationsIn(vs ...int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldIn(FieldIterations, vs...))
+}
+
+// IterationsNotIn applies the NotIn predicate on the "iterations" field.
+func IterationsNotIn(vs ...int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNotIn(FieldIterations, vs...))
+}
+
+// IterationsGT applies the GT predicate on the "iterations" field.
+func IterationsGT(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldGT(FieldIterations, v))
+}
+
+// IterationsGTE applies the GTE predicate on the "iterations" field.
+func IterationsGTE(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldGTE(FieldIterations, v))
+}
+
+// IterationsLT applies the LT predicate on the "iterations" field.
+func IterationsLT(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldLT(FieldIterations, v))
+}
+
+// IterationsLTE applies the LTE predicate on the "iterations" field.
+func IterationsLTE(v int) predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldLTE(FieldIterations, v))
+}
+
+// IterationsIsNil applies the IsNil predicate on the "iterations" field.
+func IterationsIsNil() predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldIsNull(FieldIterations))
+}
+
+// IterationsNotNil applies the NotNil predicate on the "iterations" field.
+func IterationsNotNil() predicate.DrainedResult {
+	return predicate.DrainedResult(sql.FieldNotNull(FieldIterations))
+}
+
 // HasHeading applies the HasEdge predicate on the "heading" edge.
 func HasHeading() predicate.DrainedResult {
 	return predicate.DrainedResult(func(s *sql.Selector) {
@@ -9,6 +9,7 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/trueegorletov/analabit/core"
 	"github.com/trueegorletov/analabit/core/ent/drainedresult"
 	"github.com/trueegorletov/analabit/core/ent/heading"
 	"github.com/trueegorletov/analabit/core/ent/run"
@@ -109,6 +110,234 @@ func (drc *DrainedResultCreate) SetNillableIsVirtual(b *bool) *DrainedResultCrea
 	return drc
 }
 
+// SetSeed sets the "seed" field.
+func (drc *DrainedResultCreate) SetSeed(i int64) *DrainedResultCreate {
+	drc.mutation.SetSeed(i)
+	return drc
+}
+
+// SetNillableSeed sets the "seed" field if the given value is not nil.
+func (drc *DrainedResultCreate) SetNillableSeed(i *int64) *DrainedResultCreate {
+	if i != nil {
+		drc.SetSeed(*i)
+	}
+	return drc
+}
+
+// SetDrainModel sets the "drain_model" field.
+func (drc *DrainedResultCreate) SetDrainModel(s string) *DrainedResultCreate {
+	drc.mutation.SetDrainModel(s)
+	return drc
+}
+
+// SetNillableDrainModel sets the "drain_model" field if the given value is not nil.
+func (drc *DrainedResultCreate) SetNillableDrainModel(s *string) *DrainedResultCreate {
+	if s != nil {
+		drc.SetDrainModel(*s)
+	}
+	return drc
+}
+
+// SetAvgPriorityStageAdmitted sets the "avg_priority_stage_admitted" field.
+func (drc *DrainedResultCreate) SetAvgPriorityStageAdmitted(i int) *DrainedResultCreate {
+	drc.mutation.SetAvgPriorityStageAdmitted(i)
+	return drc
+}
+
+// SetNillableAvgPriorityStageAdmitted sets the "avg_priority_stage_admitted" field if the given value is not nil.
+func (drc *DrainedResultCreate) SetNillableAvgPriorityStageAdmitted(i *int) *DrainedResultCreate {
+	if i != nil {
+		drc.SetAvgPriorityStageAdmitted(*i)
+	}
+	return drc
+}
+
+// SetAvgMainStageAdmitted sets the "avg_main_stage_admitted" field.
+func (drc *DrainedResultCreate) SetAvgMainStageAdmitted(i int) *DrainedResultCreate {
+	drc.mutation.SetAvgMainStageAdmitted(i)
+	return drc
+}
+
+// SetNillableAvgMainStageAdmitted sets the "avg_main_stage_admitted" field if the given value is not nil.
+func (drc *DrainedResultCreate) SetNillableAvgMainStageAdmitted(i *int) *DrainedResultCreate {
+	if i != nil {
+		drc.SetAvgMainStageAdmitted(*i)
+	}
+	return drc
+}
+
+// SetSubQuotas sets the "sub_quotas" field.
+func (drc *DrainedResultCreate) SetSubQuotas(cqrd []core.SubQuotaResultDTO) *DrainedResultCreate {
+	drc.mutation.SetSubQuotas(cqrd)
+	return drc
+}
+
+// SetAvgPaidPassingScore sets the "avg_paid_passing_score" field.
+func (drc *DrainedResultCreate) SetAvgPaidPassingScore(i int) *DrainedResultCreate {
+	drc.mutation.SetAvgPaidPassingScore(i)
+	return drc
+}
+
+// SetNillableAvgPaidPassingScore sets the "avg_paid_passing_score" field if the given value is not nil.
+func (drc *DrainedResultCreate) SetNillableAvgPaidPassingScore(i *int) *DrainedResultCreate {
+	if i != nil {
+		drc.SetAvgPaidPassingScore(*i)
+	}
+	return drc
+}
+
+// SetAvgPaidLastAdmittedRatingPlace sets the "avg_paid_last_admitted_rating_place" field.
+func (drc *DrainedResultCreate) SetAvgPaidLastAdmittedRatingPlace(i int) *DrainedResultCreate {
+	drc.mutation.SetAvgPaidLastAdmittedRatingPlace(i)
+	return drc
+}
+
+// SetNillableAvgPaidLastAdmittedRatingPlace sets the "avg_paid_last_admitted_rating_place" field if the given value is not nil.
+func (drc *DrainedResultCreate) SetNillableAvgPaidLastAdmittedRatingPlace(i *int) *DrainedResultCreate {
+	if i != nil {
+		drc.SetAvgPaidLastAdmittedRatingPlace(*i)
+	}
+	return drc
+}
+
+// SetP10PassingScore sets the "p10_passing_score" field.
+func (drc *DrainedResultCreate) SetP10PassingScore(i int) *DrainedResultCreate {
+	drc.mutation.SetP10PassingScore(i)
+	return drc
+}
+
+// SetNillableP10PassingScore sets the "p10_passing_score" field if the given value is not nil.
+func (drc *DrainedResultCreate) SetNillableP10PassingScore(i *int) *DrainedResultCreate {
+	if i != nil {
+		drc.SetP10PassingScore(*i)
+	}
+	return drc
+}
+
+// SetP25PassingScore sets the "p25_passing_score" field.
+func (drc *DrainedResultCreate) SetP25PassingScore(i int) *DrainedResultCreate {
+	drc.mutation.SetP25PassingScore(i)
+	return drc
+}
+
+// SetNillableP25PassingScore sets the "p25_passing_score" field if the given value is not nil.
+func (drc *DrainedResultCreate) SetNillableP25PassingScore(i *int) *DrainedResultCreate {
+	if i != nil {
+		drc.SetP25PassingScore(*i)
+	}
+	return drc
+}
+
+// SetP75PassingScore sets the "p75_passing_score" field.
+func (drc *DrainedResultCreate) SetP75PassingScore(i int) *DrainedResultCreate {
+	drc.mutation.SetP75PassingScore(i)
+	return drc
+}
+
+// SetNillableP75PassingScore sets the "p75_passing_score" field if the given value is not nil.
+func (drc *DrainedResultCreate) SetNillableP75PassingScore(i *int) *DrainedResultCreate {
+	if i != nil {
+		drc.SetP75PassingScore(*i)
+	}
+	return drc
+}
+
+// SetP90PassingScore sets the "p90_passing_score" field.
+func (drc *DrainedResultCreate) SetP90PassingScore(i int) *DrainedResultCreate {
+	drc.mutation.SetP90PassingScore(i)
+	return drc
+}
+
+// SetNillableP90PassingScore sets the "p90_passing_score" field if the given value is not nil.
+func (drc *DrainedResultCreate) SetNillableP90PassingScore(i *int) *DrainedResultCreate {
+	if i != nil {
+		drc.SetP90PassingScore(*i)
+	}
+	return drc
+}
+
+// SetP10LastAdmittedRatingPlace sets the "p10_last_admitted_rating_place" field.
+func (drc *DrainedResultCreate) SetP10LastAdmittedRatingPlace(i int) *DrainedResultCreate {
+	drc.mutation.SetP10LastAdmittedRatingPlace(i)
+	return drc
+}
+
+// SetNillableP10LastAdmittedRatingPlace sets the "p10_last_admitted_rating_place" field if the given value is not nil.
+func (drc *DrainedResultCreate) SetNillableP10LastAdmittedRatingPlace(i *int) *DrainedResultCreate {
+	if i != nil {
+		drc.SetP10LastAdmittedRatingPlace(*i)
+	}
+	return drc
+}
+
+// SetP25LastAdmittedRatingPlace sets the "p25_last_admitted_rating_place" field.
+func (drc *DrainedResultCreate) SetP25LastAdmittedRatingPlace(i int) *DrainedResultCreate {
+	drc.mutation.SetP25LastAdmittedRatingPlace(i)
+	return drc
+}
+
+// SetNillableP25LastAdmittedRatingPlace sets the "p25_last_admitted_rating_place" field if the given value is not nil.
+func (drc *DrainedResultCreate) SetNillableP25LastAdmittedRatingPlace(i *int) *DrainedResultCreate {
+	if i != nil {
+		drc.SetP25LastAdmittedRatingPlace(*i)
+	}
+	return drc
+}
+
+// SetP75LastAdmittedRatingPlace sets the "p75_last_admitted_rating_place" field.
+func (drc *DrainedResultCreate) SetP75LastAdmittedRatingPlace(i int) *DrainedResultCreate {
+	drc.mutation.SetP75LastAdmittedRatingPlace(i)
+	return drc
+}
+
+// SetNillableP75LastAdmittedRatingPlace sets the "p75_last_admitted_rating_place" field if the given value is not nil.
+func (drc *DrainedResultCreate) SetNillableP75LastAdmittedRatingPlace(i *int) *DrainedResultCreate {
+	if i != nil {
+		drc.SetP75LastAdmittedRatingPlace(*i)
+	}
+	return drc
+}
+
+// SetP90LastAdmittedRatingPlace sets the "p90_last_admitted_rating_place" field.
+func (drc *DrainedResultCreate) SetP90LastAdmittedRatingPlace(i int) *DrainedResultCreate {
+	drc.mutation.SetP90LastAdmittedRatingPlace(i)
+	return drc
+}
+
+// SetNillableP90LastAdmittedRatingPlace sets the "p90_last_admitted_rating_place" field if the given value is not nil.
+func (drc *DrainedResultCreate) SetNillableP90LastAdmittedRatingPlace(i *int) *DrainedResultCreate {
+	if i != nil {
+		drc.SetP90LastAdmittedRatingPlace(*i)
+	}
+	return drc
+}
+
+// SetPassingScoreHistogram sets the "passing_score_histogram" field.
+func (drc *DrainedResultCreate) SetPassingScoreHistogram(cd *core.HistogramDTO) *DrainedResultCreate {
+	drc.mutation.SetPassingScoreHistogram(cd)
+	return drc
+}
+
+// SetLastAdmittedRatingPlaceHistogram sets the "last_admitted_rating_place_histogram" field.
+func (drc *DrainedResultCreate) SetLastAdmittedRatingPlaceHistogram(cd *core.HistogramDTO) *DrainedResultCreate {
+	drc.mutation.SetLastAdmittedRatingPlaceHistogram(cd)
+	return drc
+}
+
+// SetIterations sets the "iterations" field.
+func (drc *DrainedResultCreate) SetIterations(i int) *DrainedResultCreate {
+	drc.mutation.SetIterations(i)
+	return drc
+}
+
+// SetNillableIterations sets the "iterations" field if the given value is not nil.
+func (drc *DrainedResultCreate) SetNillableIterations(i *int) *DrainedResultCreate {
+	if i != nil {
+		drc.SetIterations(*i)
+	}
+	return drc
+}
+
 // SetHeadingID sets the "heading" edge to the Heading entity by ID.
 func (drc *DrainedResultCreate) SetHeadingID(id int) *DrainedResultCreate {
 	drc.mutation.SetHeadingID(id)
@@ -284,6 +513,78 @@ func (drc *DrainedResultCreate) createSpec() (*DrainedResult, *sqlgraph.CreateSp
 		_spec.SetField(drainedresult.FieldIsVirtual, field.TypeBool, value)
 		_node.IsVirtual = value
 	}
+	if value, ok := drc.mutation.Seed(); ok {
+		_spec.SetField(drainedresult.FieldSeed, field.TypeInt64, value)
+		_node.Seed = value
+	}
+	if value, ok := drc.mutation.DrainModel(); ok {
+		_spec.SetField(drainedresult.FieldDrainModel, field.TypeString, value)
+		_node.DrainModel = value
+	}
+	if value, ok := drc.mutation.AvgPriorityStageAdmitted(); ok {
+		_spec.SetField(drainedresult.FieldAvgPriorityStageAdmitted, field.TypeInt, value)
+		_node.AvgPriorityStageAdmitted = value
+	}
+	if value, ok := drc.mutation.AvgMainStageAdmitted(); ok {
+		_spec.SetField(drainedresult.FieldAvgMainStageAdmitted, field.TypeInt, value)
+		_node.AvgMainStageAdmitted = value
+	}
+	if value, ok := drc.mutation.SubQuotas(); ok {
+		_spec.SetField(drainedresult.FieldSubQuotas, field.TypeJSON, value)
+		_node.SubQuotas = value
+	}
+	if value, ok := drc.mutation.AvgPaidPassingScore(); ok {
+		_spec.SetField(drainedresult.FieldAvgPaidPassingScore, field.TypeInt, value)
+		_node.AvgPaidPassingScore = value
+	}
+	if value, ok := drc.mutation.AvgPaidLastAdmittedRatingPlace(); ok {
+		_spec.SetField(drainedresult.FieldAvgPaidLastAdmittedRatingPlace, field.TypeInt, value)
+		_node.AvgPaidLastAdmittedRatingPlace = value
+	}
+	if value, ok := drc.mutation.P10PassingScore(); ok {
+		_spec.SetField(drainedresult.FieldP10PassingScore, field.TypeInt, value)
+		_node.P10PassingScore = value
+	}
+	if value, ok := drc.mutation.P25PassingScore(); ok {
+		_spec.SetField(drainedresult.FieldP25PassingScore, field.TypeInt, value)
+		_node.P25PassingScore = value
+	}
+	if value, ok := drc.mutation.P75PassingScore(); ok {
+		_spec.SetField(drainedresult.FieldP75PassingScore, field.TypeInt, value)
+		_node.P75PassingScore = value
+	}
+	if value, ok := drc.mutation.P90PassingScore(); ok {
+		_spec.SetField(drainedresult.FieldP90PassingScore, field.TypeInt, value)
+		_node.P90PassingScore = value
+	}
+	if value, ok := drc.mutation.P10LastAdmittedRatingPlace(); ok {
+		_spec.SetField(drainedresult.FieldP10LastAdmittedRatingPlace, field.TypeInt, value)
+		_node.P10LastAdmittedRatingPlace = value
+	}
+	if value, ok := drc.mutation.P25LastAdmittedRatingPlace(); ok {
+		_spec.SetField(drainedresult.FieldP25LastAdmittedRatingPlace, field.TypeInt, value)
+		_node.P25LastAdmittedRatingPlace = value
+	}
+	if value, ok := drc.mutation.P75LastAdmittedRatingPlace(); ok {
+		_spec.SetField(drainedresult.FieldP75LastAdmittedRatingPlace, field.TypeInt, value)
+		_node.P75LastAdmittedRatingPlace = value
+	}
+	if value, ok := drc.mutation.P90LastAdmittedRatingPlace(); ok {
+		_spec.SetField(drainedresult.FieldP90LastAdmittedRatingPlace, field.TypeInt, value)
+		_node.P90LastAdmittedRatingPlace = value
+	}
+	if value, ok := drc.mutation.PassingScoreHistogram(); ok {
+		_spec.SetField(drainedresult.FieldPassingScoreHistogram, field.TypeJSON, value)
+		_node.PassingScoreHistogram = value
+	}
+	if value, ok := drc.mutation.LastAdmittedRatingPlaceHistogram(); ok {
+		_spec.SetField(drainedresult.FieldLastAdmittedRatingPlaceHistogram, field.TypeJSON, value)
+		_node.LastAdmittedRatingPlaceHistogram = value
+	}
+	if value, ok := drc.mutation.Iterations(); ok {
+		_spec.SetField(drainedresult.FieldIterations, field.TypeInt, value)
+		_node.Iterations = value
+	}
 	if nodes := drc.mutation.HeadingIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -9,7 +9,9 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
+	"github.com/trueegorletov/analabit/core"
 	"github.com/trueegorletov/analabit/core/ent/drainedresult"
 	"github.com/trueegorletov/analabit/core/ent/heading"
 	"github.com/trueegorletov/analabit/core/ent/predicate"
@@ -260,6 +262,446 @@ func (dru *DrainedResultUpdate) SetNillableIsVirtual(b *bool) *DrainedResultUpda
 	return dru
 }
 
+// SetSeed sets the "seed" field.
+func (dru *DrainedResultUpdate) SetSeed(i int64) *DrainedResultUpdate {
+	dru.mutation.ResetSeed()
+	dru.mutation.SetSeed(i)
+	return dru
+}
+
+// SetNillableSeed sets the "seed" field if the given value is not nil.
+func (dru *DrainedResultUpdate) SetNillableSeed(i *int64) *DrainedResultUpdate {
+	if i != nil {
+		dru.SetSeed(*i)
+	}
+	return dru
+}
+
+// AddSeed adds i to the "seed" field.
+func (dru *DrainedResultUpdate) AddSeed(i int64) *DrainedResultUpdate {
+	dru.mutation.AddSeed(i)
+	return dru
+}
+
+// ClearSeed clears the value of the "seed" field.
+func (dru *DrainedResultUpdate) ClearSeed() *DrainedResultUpdate {
+	dru.mutation.ClearSeed()
+	return dru
+}
+
+// SetDrainModel sets the "drain_model" field.
+func (dru *DrainedResultUpdate) SetDrainModel(s string) *DrainedResultUpdate {
+	dru.mutation.SetDrainModel(s)
+	return dru
+}
+
+// SetNillableDrainModel sets the "drain_model" field if the given value is not nil.
+func (dru *DrainedResultUpdate) SetNillableDrainModel(s *string) *DrainedResultUpdate {
+	if s != nil {
+		dru.SetDrainModel(*s)
+	}
+	return dru
+}
+
+// ClearDrainModel clears the value of the "drain_model" field.
+func (dru *DrainedResultUpdate) ClearDrainModel() *DrainedResultUpdate {
+	dru.mutation.ClearDrainModel()
+	return dru
+}
+
+// SetAvgPriorityStageAdmitted sets the "avg_priority_stage_admitted" field.
+func (dru *DrainedResultUpdate) SetAvgPriorityStageAdmitted(i int) *DrainedResultUpdate {
+	dru.mutation.ResetAvgPriorityStageAdmitted()
+	dru.mutation.SetAvgPriorityStageAdmitted(i)
+	return dru
+}
+
+// SetNillableAvgPriorityStageAdmitted sets the "avg_priority_stage_admitted" field if the given value is not nil.
+func (dru *DrainedResultUpdate) SetNillableAvgPriorityStageAdmitted(i *int) *DrainedResultUpdate {
+	if i != nil {
+		dru.SetAvgPriorityStageAdmitted(*i)
+	}
+	return dru
+}
+
+// AddAvgPriorityStageAdmitted adds i to the "avg_priority_stage_admitted" field.
+func (dru *DrainedResultUpdate) AddAvgPriorityStageAdmitted(i int) *DrainedResultUpdate {
+	dru.mutation.AddAvgPriorityStageAdmitted(i)
+	return dru
+}
+
+// ClearAvgPriorityStageAdmitted clears the value of the "avg_priority_stage_admitted" field.
+func (dru *DrainedResultUpdate) ClearAvgPriorityStageAdmitted() *DrainedResultUpdate {
+	dru.mutation.ClearAvgPriorityStageAdmitted()
+	return dru
+}
+
+// SetAvgMainStageAdmitted sets the "avg_main_stage_admitted" field.
+func (dru *DrainedResultUpdate) SetAvgMainStageAdmitted(i int) *DrainedResultUpdate {
+	dru.mutation.ResetAvgMainStageAdmitted()
+	dru.mutation.SetAvgMainStageAdmitted(i)
+	return dru
+}
+
+// SetNillableAvgMainStageAdmitted sets the "avg_main_stage_admitted" field if the given value is not nil.
+func (dru *DrainedResultUpdate) SetNillableAvgMainStageAdmitted(i *int) *DrainedResultUpdate {
+	if i != nil {
+		dru.SetAvgMainStageAdmitted(*i)
+	}
+	return dru
+}
+
+// AddAvgMainStageAdmitted adds i to the "avg_main_stage_admitted" field.
+func (dru *DrainedResultUpdate) AddAvgMainStageAdmitted(i int) *DrainedResultUpdate {
+	dru.mutation.AddAvgMainStageAdmitted(i)
+	return dru
+}
+
+// ClearAvgMainStageAdmitted clears the value of the "avg_main_stage_admitted" field.
+func (dru *DrainedResultUpdate) ClearAvgMainStageAdmitted() *DrainedResultUpdate {
+	dru.mutation.ClearAvgMainStageAdmitted()
+	return dru
+}
+
+// SetSubQuotas sets the "sub_quotas" field.
+func (dru *DrainedResultUpdate) SetSubQuotas(cqrd []core.SubQuotaResultDTO) *DrainedResultUpdate {
+	dru.mutation.SetSubQuotas(cqrd)
+	return dru
+}
+
+// AppendSubQuotas appends cqrd to the "sub_quotas" field.
+func (dru *DrainedResultUpdate) AppendSubQuotas(cqrd []core.SubQuotaResultDTO) *DrainedResultUpdate {
+	dru.mutation.AppendSubQuotas(cqrd)
+	return dru
+}
+
+// ClearSubQuotas clears the value of the "sub_quotas" field.
+func (dru *DrainedResultUpdate) ClearSubQuotas() *DrainedResultUpdate {
+	dru.mutation.ClearSubQuotas()
+	return dru
+}
+
+// SetAvgPaidPassingScore sets the "avg_paid_passing_score" field.
+func (dru *DrainedResultUpdate) SetAvgPaidPassingScore(i int) *DrainedResultUpdate {
+	dru.mutation.ResetAvgPaidPassingScore()
+	dru.mutation.SetAvgPaidPassingScore(i)
+	return dru
+}
+
+// SetNillableAvgPaidPassingScore sets the "avg_paid_passing_score" field if the given value is not nil.
+func (dru *DrainedResultUpdate) SetNillableAvgPaidPassingScore(i *int) *DrainedResultUpdate {
+	if i != nil {
+		dru.SetAvgPaidPassingScore(*i)
+	}
+	return dru
+}
+
+// AddAvgPaidPassingScore adds i to the "avg_paid_passing_score" field.
+func (dru *DrainedResultUpdate) AddAvgPaidPassingScore(i int) *DrainedResultUpdate {
+	dru.mutation.AddAvgPaidPassingScore(i)
+	return dru
+}
+
+// ClearAvgPaidPassingScore clears the value of the "avg_paid_passing_score" field.
+func (dru *DrainedResultUpdate) ClearAvgPaidPassingScore() *DrainedResultUpdate {
+	dru.mutation.ClearAvgPaidPassingScore()
+	return dru
+}
+
+// SetAvgPaidLastAdmittedRatingPlace sets the "avg_paid_last_admitted_rating_place" field.
+func (dru *DrainedResultUpdate) SetAvgPaidLastAdmittedRatingPlace(i int) *DrainedResultUpdate {
+	dru.mutation.ResetAvgPaidLastAdmittedRatingPlace()
+	dru.mutation.SetAvgPaidLastAdmittedRatingPlace(i)
+	return dru
+}
+
+// SetNillableAvgPaidLastAdmittedRatingPlace sets the "avg_paid_last_admitted_rating_place" field if the given value is not nil.
+func (dru *DrainedResultUpdate) SetNillableAvgPaidLastAdmittedRatingPlace(i *int) *DrainedResultUpdate {
+	if i != nil {
+		dru.SetAvgPaidLastAdmittedRatingPlace(*i)
+	}
+	return dru
+}
+
+// AddAvgPaidLastAdmittedRatingPlace adds i to the "avg_paid_last_admitted_rating_place" field.
+func (dru *DrainedResultUpdate) AddAvgPaidLastAdmittedRatingPlace(i int) *DrainedResultUpdate {
+	dru.mutation.AddAvgPaidLastAdmittedRatingPlace(i)
+	return dru
+}
+
+// ClearAvgPaidLastAdmittedRatingPlace clears the value of the "avg_paid_last_admitted_rating_place" field.
+func (dru *DrainedResultUpdate) ClearAvgPaidLastAdmittedRatingPlace() *DrainedResultUpdate {
+	dru.mutation.ClearAvgPaidLastAdmittedRatingPlace()
+	return dru
+}
+
+// SetP10PassingScore sets the "p10_passing_score" field.
+func (dru *DrainedResultUpdate) SetP10PassingScore(i int) *DrainedResultUpdate {
+	dru.mutation.ResetP10PassingScore()
+	dru.mutation.SetP10PassingScore(i)
+	return dru
+}
+
+// SetNillableP10PassingScore sets the "p10_passing_score" field if the given value is not nil.
+func (dru *DrainedResultUpdate) SetNillableP10PassingScore(i *int) *DrainedResultUpdate {
+	if i != nil {
+		dru.SetP10PassingScore(*i)
+	}
+	return dru
+}
+
+// AddP10PassingScore adds i to the "p10_passing_score" field.
+func (dru *DrainedResultUpdate) AddP10PassingScore(i int) *DrainedResultUpdate {
+	dru.mutation.AddP10PassingScore(i)
+	return dru
+}
+
+// ClearP10PassingScore clears the value of the "p10_passing_score" field.
+func (dru *DrainedResultUpdate) ClearP10PassingScore() *DrainedResultUpdate {
+	dru.mutation.ClearP10PassingScore()
+	return dru
+}
+
+// SetP25PassingScore sets the "p25_passing_score" field.
+func (dru *DrainedResultUpdate) SetP25PassingScore(i int) *DrainedResultUpdate {
+	dru.mutation.ResetP25PassingScore()
+	dru.mutation.SetP25PassingScore(i)
+	return dru
+}
+
+// SetNillableP25PassingScore sets the "p25_passing_score" field if the given value is not nil.
+func (dru *DrainedResultUpdate) SetNillableP25PassingScore(i *int) *DrainedResultUpdate {
+	if i != nil {
+		dru.SetP25PassingScore(*i)
+	}
+	return dru
+}
+
+// AddP25PassingScore adds i to the "p25_passing_score" field.
+func (dru *DrainedResultUpdate) AddP25PassingScore(i int) *DrainedResultUpdate {
+	dru.mutation.AddP25PassingScore(i)
+	return dru
+}
+
+// ClearP25PassingScore clears the value of the "p25_passing_score" field.
+func (dru *DrainedResultUpdate) ClearP25PassingScore() *DrainedResultUpdate {
+	dru.mutation.ClearP25PassingScore()
+	return dru
+}
+
+// SetP75PassingScore sets the "p75_passing_score" field.
+func (dru *DrainedResultUpdate) SetP75PassingScore(i int) *DrainedResultUpdate {
+	dru.mutation.ResetP75PassingScore()
+	dru.mutation.SetP75PassingScore(i)
+	return dru
+}
+
+// SetNillableP75PassingScore sets the "p75_passing_score" field if the given value is not nil.
+func (dru *DrainedResultUpdate) SetNillableP75PassingScore(i *int) *DrainedResultUpdate {
+	if i != nil {
+		dru.SetP75PassingScore(*i)
+	}
+	return dru
+}
+
+// AddP75PassingScore adds i to the "p75_passing_score" field.
+func (dru *DrainedResultUpdate) AddP75PassingScore(i int) *DrainedResultUpdate {
+	dru.mutation.AddP75PassingScore(i)
+	return dru
+}
+
+// ClearP75PassingScore clears the value of the "p75_passing_score" field.
+func (dru *DrainedResultUpdate) ClearP75PassingScore() *DrainedResultUpdate {
+	dru.mutation.ClearP75PassingScore()
+	return dru
+}
+
+// SetP90PassingScore sets the "p90_passing_score" field.
+func (dru *DrainedResultUpdate) SetP90PassingScore(i int) *DrainedResultUpdate {
+	dru.mutation.ResetP90PassingScore()
+	dru.mutation.SetP90PassingScore(i)
+	return dru
+}
+
+// SetNillableP90PassingScore sets the "p90_passing_score" field if the given value is not nil.
+func (dru *DrainedResultUpdate) SetNillableP90PassingScore(i *int) *DrainedResultUpdate {
+	if i != nil {
+		dru.SetP90PassingScore(*i)
+	}
+	return dru
+}
+
+// AddP90PassingScore adds i to the "p90_passing_score" field.
+func (dru *DrainedResultUpdate) AddP90PassingScore(i int) *DrainedResultUpdate {
+	dru.mutation.AddP90PassingScore(i)
+	return dru
+}
+
+// ClearP90PassingScore clears the value of the "p90_passing_score" field.
+func (dru *DrainedResultUpdate) ClearP90PassingScore() *DrainedResultUpdate {
+	dru.mutation.ClearP90PassingScore()
+	return dru
+}
+
+// SetP10LastAdmittedRatingPlace sets the "p10_last_admitted_rating_place" field.
+func (dru *DrainedResultUpdate) SetP10LastAdmittedRatingPlace(i int) *DrainedResultUpdate {
+	dru.mutation.ResetP10LastAdmittedRatingPlace()
+	dru.mutation.SetP10LastAdmittedRatingPlace(i)
+	return dru
+}
+
+// SetNillableP10LastAdmittedRatingPlace sets the "p10_last_admitted_rating_place" field if the given value is not nil.
+func (dru *DrainedResultUpdate) SetNillableP10LastAdmittedRatingPlace(i *int) *DrainedResultUpdate {
+	if i != nil {
+		dru.SetP10LastAdmittedRatingPlace(*i)
+	}
+	return dru
+}
+
+// AddP10LastAdmittedRatingPlace adds i to the "p10_last_admitted_rating_place" field.
+func (dru *DrainedResultUpdate) AddP10LastAdmittedRatingPlace(i int) *DrainedResultUpdate {
+	dru.mutation.AddP10LastAdmittedRatingPlace(i)
+	return dru
+}
+
+// ClearP10LastAdmittedRatingPlace clears the value of the "p10_last_admitted_rating_place" field.
+func (dru *DrainedResultUpdate) ClearP10LastAdmittedRatingPlace() *DrainedResultUpdate {
+	dru.mutation.ClearP10LastAdmittedRatingPlace()
+	return dru
+}
+
+// SetP25LastAdmittedRatingPlace sets the "p25_last_admitted_rating_place" field.
+func (dru *DrainedResultUpdate) SetP25LastAdmittedRatingPlace(i int) *DrainedResultUpdate {
+	dru.mutation.ResetP25LastAdmittedRatingPlace()
+	dru.mutation.SetP25LastAdmittedRatingPlace(i)
+	return dru
+}
+
+// SetNillableP25LastAdmittedRatingPlace sets the "p25_last_admitted_rating_place" field if the given value is not nil.
+func (dru *DrainedResultUpdate) SetNillableP25LastAdmittedRatingPlace(i *int) *DrainedResultUpdate {
+	if i != nil {
+		dru.SetP25LastAdmittedRatingPlace(*i)
+	}
+	return dru
+}
+
+// AddP25LastAdmittedRatingPlace adds i to the "p25_last_admitted_rating_place" field.
+func (dru *DrainedResultUpdate) AddP25LastAdmittedRatingPlace(i int) *DrainedResultUpdate {
+	dru.mutation.AddP25LastAdmittedRatingPlace(i)
+	return dru
+}
+
+// ClearP25LastAdmittedRatingPlace clears the value of the "p25_last_admitted_rating_place" field.
+func (dru *DrainedResultUpdate) ClearP25LastAdmittedRatingPlace() *DrainedResultUpdate {
+	dru.mutation.ClearP25LastAdmittedRatingPlace()
+	return dru
+}
+
+// SetP75LastAdmittedRatingPlace sets the "p75_last_admitted_rating_place" field.
+func (dru *DrainedResultUpdate) SetP75LastAdmittedRatingPlace(i int) *DrainedResultUpdate {
+	dru.mutation.ResetP75LastAdmittedRatingPlace()
+	dru.mutation.SetP75LastAdmittedRatingPlace(i)
+	return dru
+}
+
+// SetNillableP75LastAdmittedRatingPlace sets the "p75_last_admitted_rating_place" field if the given value is not nil.
+func (dru *DrainedResultUpdate) SetNillableP75LastAdmittedRatingPlace(i *int) *DrainedResultUpdate {
+	if i != nil {
+		dru.SetP75LastAdmittedRatingPlace(*i)
+	}
+	return dru
+}
+
+// AddP75LastAdmittedRatingPlace adds i to the "p75_last_admitted_rating_place" field.
+func (dru *DrainedResultUpdate) AddP75LastAdmittedRatingPlace(i int) *DrainedResultUpdate {
+	dru.mutation.AddP75LastAdmittedRatingPlace(i)
+	return dru
+}
+
+// ClearP75LastAdmittedRatingPlace clears the value of the "p75_last_admitted_rating_place" field.
+func (dru *DrainedResultUpdate) ClearP75LastAdmittedRatingPlace() *DrainedResultUpdate {
+	dru.mutation.ClearP75LastAdmittedRatingPlace()
+	return dru
+}
+
+// SetP90LastAdmittedRatingPlace sets the "p90_last_admitted_rating_place" field.
+func (dru *DrainedResultUpdate) SetP90LastAdmittedRatingPlace(i int) *DrainedResultUpdate {
+	dru.mutation.ResetP90LastAdmittedRatingPlace()
+	dru.mutation.SetP90LastAdmittedRatingPlace(i)
+	return dru
+}
+
+// SetNillableP90LastAdmittedRatingPlace sets the "p90_last_admitted_rating_place" field if the given value is not nil.
+func (dru *DrainedResultUpdate) SetNillableP90LastAdmittedRatingPlace(i *int) *DrainedResultUpdate {
+	if i != nil {
+		dru.SetP90LastAdmittedRatingPlace(*i)
+	}
+	return dru
+}
+
+// AddP90LastAdmittedRatingPlace adds i to the "p90_last_admitted_rating_place" field.
+func (dru *DrainedResultUpdate) AddP90LastAdmittedRatingPlace(i int) *DrainedResultUpdate {
+	dru.mutation.AddP90LastAdmittedRatingPlace(i)
+	return dru
+}
+
+// ClearP90LastAdmittedRatingPlace clears the value of the "p90_last_admitted_rating_place" field.
+func (dru *DrainedResultUpdate) ClearP90LastAdmittedRatingPlace() *DrainedResultUpdate {
+	dru.mutation.ClearP90LastAdmittedRatingPlace()
+	return dru
+}
+
+// SetPassingScoreHistogram sets the "passing_score_histogram" field.
+func (dru *DrainedResultUpdate) SetPassingScoreHistogram(cd *core.HistogramDTO) *DrainedResultUpdate {
+	dru.mutation.SetPassingScoreHistogram(cd)
+	return dru
+}
+
+// ClearPassingScoreHistogram clears the value of the "passing_score_histogram" field.
+func (dru *DrainedResultUpdate) ClearPassingScoreHistogram() *DrainedResultUpdate {
+	dru.mutation.ClearPassingScoreHistogram()
+	return dru
+}
+
+// SetLastAdmittedRatingPlaceHistogram sets the "last_admitted_rating_place_histogram" field.
+func (dru *DrainedResultUpdate) SetLastAdmittedRatingPlaceHistogram(cd *core.HistogramDTO) *DrainedResultUpdate {
+	dru.mutation.SetLastAdmittedRatingPlaceHistogram(cd)
+	return dru
+}
+
+// ClearLastAdmittedRatingPlaceHistogram clears the value of the "last_admitted_rating_place_histogram" field.
+func (dru *DrainedResultUpdate) ClearLastAdmittedRatingPlaceHistogram() *DrainedResultUpdate {
+	dru.mutation.ClearLastAdmittedRatingPlaceHistogram()
+	return dru
+}
+
+// SetIterations sets the "iterations" field.
+func (dru *DrainedResultUpdate) SetIterations(i int) *DrainedResultUpdate {
+	dru.mutation.ResetIterations()
+	dru.mutation.SetIterations(i)
+	return dru
+}
+
+// SetNillableIterations sets the "iterations" field if the given value is not nil.
+func (dru *DrainedResultUpdate) SetNillableIterations(i *int) *DrainedResultUpdate {
+	if i != nil {
+		dru.SetIterations(*i)
+	}
+	return dru
+}
+
+// AddIterations adds i to the "iterations" field.
+func (dru *DrainedResultUpdate) AddIterations(i int) *DrainedResultUpdate {
+	dru.mutation.AddIterations(i)
+	return dru
+}
+
+// ClearIterations clears the value of the "iterations" field.
+func (dru *DrainedResultUpdate) ClearIterations() *DrainedResultUpdate {
+	dru.mutation.ClearIterations()
+	return dru
+}
+
 // SetHeadingID sets the "heading" edge to the Heading entity by ID.
 func (dru *DrainedResultUpdate) SetHeadingID(id int) *DrainedResultUpdate {
 	dru.mutation.SetHeadingID(id)
@@ -403,37 +845,192 @@ func (dru *DrainedResultUpdate) sqlSave(ctx context.Context) (n int, err error)
 	if value, ok := dru.mutation.IsVirtual(); ok {
 		_spec.SetField(drainedresult.FieldIsVirtual, field.TypeBool, value)
 	}
-	if dru.mutation.HeadingCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   drainedresult.HeadingTable,
-			Columns: []string{drainedresult.HeadingColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(heading.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	if value, ok := dru.mutation.Seed(); ok {
+		_spec.SetField(drainedresult.FieldSeed, field.TypeInt64, value)
 	}
-	if nodes := dru.mutation.HeadingIDs(); len(nodes) > 0 {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.M2O,
-			Inverse: true,
-			Table:   drainedresult.HeadingTable,
-			Columns: []string{drainedresult.HeadingColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(heading.FieldID, field.TypeInt),
-			},
-		}
-		for _, k := range nodes {
-			edge.Target.Nodes = append(edge.Target.Nodes, k)
-		}
-		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	if value, ok := dru.mutation.AddedSeed(); ok {
+		_spec.AddField(drainedresult.FieldSeed, field.TypeInt64, value)
 	}
-	if dru.mutation.RunCleared() {
-		edge := &sqlgraph.EdgeSpec{
+	if dru.mutation.SeedCleared() {
+		_spec.ClearField(drainedresult.FieldSeed, field.TypeInt64)
+	}
+	if value, ok := dru.mutation.DrainModel(); ok {
+		_spec.SetField(drainedresult.FieldDrainModel, field.TypeString, value)
+	}
+	if dru.mutation.DrainModelCleared() {
+		_spec.ClearField(drainedresult.FieldDrainModel, field.TypeString)
+	}
+	if value, ok := dru.mutation.AvgPriorityStageAdmitted(); ok {
+		_spec.SetField(drainedresult.FieldAvgPriorityStageAdmitted, field.TypeInt, value)
+	}
+	if value, ok := dru.mutation.AddedAvgPriorityStageAdmitted(); ok {
+		_spec.AddField(drainedresult.FieldAvgPriorityStageAdmitted, field.TypeInt, value)
+	}
+	if dru.mutation.AvgPriorityStageAdmittedCleared() {
+		_spec.ClearField(drainedresult.FieldAvgPriorityStageAdmitted, field.TypeInt)
+	}
+	if value, ok := dru.mutation.AvgMainStageAdmitted(); ok {
+		_spec.SetField(drainedresult.FieldAvgMainStageAdmitted, field.TypeInt, value)
+	}
+	if value, ok := dru.mutation.AddedAvgMainStageAdmitted(); ok {
+		_spec.AddField(drainedresult.FieldAvgMainStageAdmitted, field.TypeInt, value)
+	}
+	if dru.mutation.AvgMainStageAdmittedCleared() {
+		_spec.ClearField(drainedresult.FieldAvgMainStageAdmitted, field.TypeInt)
+	}
+	if value, ok := dru.mutation.SubQuotas(); ok {
+		_spec.SetField(drainedresult.FieldSubQuotas, field.TypeJSON, value)
+	}
+	if value, ok := dru.mutation.AppendedSubQuotas(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, drainedresult.FieldSubQuotas, value)
+		})
+	}
+	if dru.mutation.SubQuotasCleared() {
+		_spec.ClearField(drainedresult.FieldSubQuotas, field.TypeJSON)
+	}
+	if value, ok := dru.mutation.AvgPaidPassingScore(); ok {
+		_spec.SetField(drainedresult.FieldAvgPaidPassingScore, field.TypeInt, value)
+	}
+	if value, ok := dru.mutation.AddedAvgPaidPassingScore(); ok {
+		_spec.AddField(drainedresult.FieldAvgPaidPassingScore, field.TypeInt, value)
+	}
+	if dru.mutation.AvgPaidPassingScoreCleared() {
+		_spec.ClearField(drainedresult.FieldAvgPaidPassingScore, field.TypeInt)
+	}
+	if value, ok := dru.mutation.AvgPaidLastAdmittedRatingPlace(); ok {
+		_spec.SetField(drainedresult.FieldAvgPaidLastAdmittedRatingPlace, field.TypeInt, value)
+	}
+	if value, ok := dru.mutation.AddedAvgPaidLastAdmittedRatingPlace(); ok {
+		_spec.AddField(drainedresult.FieldAvgPaidLastAdmittedRatingPlace, field.TypeInt, value)
+	}
+	if dru.mutation.AvgPaidLastAdmittedRatingPlaceCleared() {
+		_spec.ClearField(drainedresult.FieldAvgPaidLastAdmittedRatingPlace, field.TypeInt)
+	}
+	if value, ok := dru.mutation.P10PassingScore(); ok {
+		_spec.SetField(drainedresult.FieldP10PassingScore, field.TypeInt, value)
+	}
+	if value, ok := dru.mutation.AddedP10PassingScore(); ok {
+		_spec.AddField(drainedresult.FieldP10PassingScore, field.TypeInt, value)
+	}
+	if dru.mutation.P10PassingScoreCleared() {
+		_spec.ClearField(drainedresult.FieldP10PassingScore, field.TypeInt)
+	}
+	if value, ok := dru.mutation.P25PassingScore(); ok {
+		_spec.SetField(drainedresult.FieldP25PassingScore, field.TypeInt, value)
+	}
+	if value, ok := dru.mutation.AddedP25PassingScore(); ok {
+		_spec.AddField(drainedresult.FieldP25PassingScore, field.TypeInt, value)
+	}
+	if dru.mutation.P25PassingScoreCleared() {
+		_spec.ClearField(drainedresult.FieldP25PassingScore, field.TypeInt)
+	}
+	if value, ok := dru.mutation.P75PassingScore(); ok {
+		_spec.SetField(drainedresult.FieldP75PassingScore, field.TypeInt, value)
+	}
+	if value, ok := dru.mutation.AddedP75PassingScore(); ok {
+		_spec.AddField(drainedresult.FieldP75PassingScore, field.TypeInt, value)
+	}
+	if dru.mutation.P75PassingScoreCleared() {
+		_spec.ClearField(drainedresult.FieldP75PassingScore, field.TypeInt)
+	}
+	if value, ok := dru.mutation.P90PassingScore(); ok {
+		_spec.SetField(drainedresult.FieldP90PassingScore, field.TypeInt, value)
+	}
+	if value, ok := dru.mutation.AddedP90PassingScore(); ok {
+		_spec.AddField(drainedresult.FieldP90PassingScore, field.TypeInt, value)
+	}
+	if dru.mutation.P90PassingScoreCleared() {
+		_spec.ClearField(drainedresult.FieldP90PassingScore, field.TypeInt)
+	}
+	if value, ok := dru.mutation.P10LastAdmittedRatingPlace(); ok {
+		_spec.SetField(drainedresult.FieldP10LastAdmittedRatingPlace, field.TypeInt, value)
+	}
+	if value, ok := dru.mutation.AddedP10LastAdmittedRatingPlace(); ok {
+		_spec.AddField(drainedresult.FieldP10LastAdmittedRatingPlace, field.TypeInt, value)
+	}
+	if dru.mutation.P10LastAdmittedRatingPlaceCleared() {
+		_spec.ClearField(drainedresult.FieldP10LastAdmittedRatingPlace, field.TypeInt)
+	}
+	if value, ok := dru.mutation.P25LastAdmittedRatingPlace(); ok {
+		_spec.SetField(drainedresult.FieldP25LastAdmittedRatingPlace, field.TypeInt, value)
+	}
+	if value, ok := dru.mutation.AddedP25LastAdmittedRatingPlace(); ok {
+		_spec.AddField(drainedresult.FieldP25LastAdmittedRatingPlace, field.TypeInt, value)
+	}
+	if dru.mutation.P25LastAdmittedRatingPlaceCleared() {
+		_spec.ClearField(drainedresult.FieldP25LastAdmittedRatingPlace, field.TypeInt)
+	}
+	if value, ok := dru.mutation.P75LastAdmittedRatingPlace(); ok {
+		_spec.SetField(drainedresult.FieldP75LastAdmittedRatingPlace, field.TypeInt, value)
+	}
+	if value, ok := dru.mutation.AddedP75LastAdmittedRatingPlace(); ok {
+		_spec.AddField(drainedresult.FieldP75LastAdmittedRatingPlace, field.TypeInt, value)
+	}
+	if dru.mutation.P75LastAdmittedRatingPlaceCleared() {
+		_spec.ClearField(drainedresult.FieldP75LastAdmittedRatingPlace, field.TypeInt)
+	}
+	if value, ok := dru.mutation.P90LastAdmittedRatingPlace(); ok {
+		_spec.SetField(drainedresult.FieldP90LastAdmittedRatingPlace, field.TypeInt, value)
+	}
+	if value, ok := dru.mutation.AddedP90LastAdmittedRatingPlace(); ok {
+		_spec.AddField(drainedresult.FieldP90LastAdmittedRatingPlace, field.TypeInt, value)
+	}
+	if dru.mutation.P90LastAdmittedRatingPlaceCleared() {
+		_spec.ClearField(drainedresult.FieldP90LastAdmittedRatingPlace, field.TypeInt)
+	}
+	if value, ok := dru.mutation.PassingScoreHistogram(); ok {
+		_spec.SetField(drainedresult.FieldPassingScoreHistogram, field.TypeJSON, value)
+	}
+	if dru.mutation.PassingScoreHistogramCleared() {
+		_spec.ClearField(drainedresult.FieldPassingScoreHistogram, field.TypeJSON)
+	}
+	if value, ok := dru.mutation.LastAdmittedRatingPlaceHistogram(); ok {
+		_spec.SetField(drainedresult.FieldLastAdmittedRatingPlaceHistogram, field.TypeJSON, value)
+	}
+	if dru.mutation.LastAdmittedRatingPlaceHistogramCleared() {
+		_spec.ClearField(drainedresult.FieldLastAdmittedRatingPlaceHistogram, field.TypeJSON)
+	}
+	if value, ok := dru.mutation.Iterations(); ok {
+		_spec.SetField(drainedresult.FieldIterations, field.TypeInt, value)
+	}
+	if value, ok := dru.mutation.AddedIterations(); ok {
+		_spec.AddField(drainedresult.FieldIterations, field.TypeInt, value)
+	}
+	if dru.mutation.IterationsCleared() {
+		_spec.ClearField(drainedresult.FieldIterations, field.TypeInt)
+	}
+	if dru.mutation.HeadingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   drainedresult.HeadingTable,
+			Columns: []string{drainedresult.HeadingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(heading.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := dru.mutation.HeadingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   drainedresult.HeadingTable,
+			Columns: []string{drainedresult.HeadingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(heading.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if dru.mutation.RunCleared() {
+		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
 			Inverse: false,
 			Table:   drainedresult.RunTable,
@@ -469,249 +1066,689 @@ func (dru *DrainedResultUpdate) sqlSave(ctx context.Context) (n int, err error)
 		}
 		return 0, err
 	}
-	dru.mutation.done = true
-	return n, nil
+	dru.mutation.done = true
+	return n, nil
+}
+
+// DrainedResultUpdateOne is the builder for updating a single DrainedResult entity.
+type DrainedResultUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DrainedResultMutation
+}
+
+// SetDrainedPercent sets the "drained_percent" field.
+func (druo *DrainedResultUpdateOne) SetDrainedPercent(i int) *DrainedResultUpdateOne {
+	druo.mutation.ResetDrainedPercent()
+	druo.mutation.SetDrainedPercent(i)
+	return druo
+}
+
+// SetNillableDrainedPercent sets the "drained_percent" field if the given value is not nil.
+func (druo *DrainedResultUpdateOne) SetNillableDrainedPercent(i *int) *DrainedResultUpdateOne {
+	if i != nil {
+		druo.SetDrainedPercent(*i)
+	}
+	return druo
+}
+
+// AddDrainedPercent adds i to the "drained_percent" field.
+func (druo *DrainedResultUpdateOne) AddDrainedPercent(i int) *DrainedResultUpdateOne {
+	druo.mutation.AddDrainedPercent(i)
+	return druo
+}
+
+// SetAvgPassingScore sets the "avg_passing_score" field.
+func (druo *DrainedResultUpdateOne) SetAvgPassingScore(i int) *DrainedResultUpdateOne {
+	druo.mutation.ResetAvgPassingScore()
+	druo.mutation.SetAvgPassingScore(i)
+	return druo
+}
+
+// SetNillableAvgPassingScore sets the "avg_passing_score" field if the given value is not nil.
+func (druo *DrainedResultUpdateOne) SetNillableAvgPassingScore(i *int) *DrainedResultUpdateOne {
+	if i != nil {
+		druo.SetAvgPassingScore(*i)
+	}
+	return druo
+}
+
+// AddAvgPassingScore adds i to the "avg_passing_score" field.
+func (druo *DrainedResultUpdateOne) AddAvgPassingScore(i int) *DrainedResultUpdateOne {
+	druo.mutation.AddAvgPassingScore(i)
+	return druo
+}
+
+// SetMinPassingScore sets the "min_passing_score" field.
+func (druo *DrainedResultUpdateOne) SetMinPassingScore(i int) *DrainedResultUpdateOne {
+	druo.mutation.ResetMinPassingScore()
+	druo.mutation.SetMinPassingScore(i)
+	return druo
+}
+
+// SetNillableMinPassingScore sets the "min_passing_score" field if the given value is not nil.
+func (druo *DrainedResultUpdateOne) SetNillableMinPassingScore(i *int) *DrainedResultUpdateOne {
+	if i != nil {
+		druo.SetMinPassingScore(*i)
+	}
+	return druo
+}
+
+// AddMinPassingScore adds i to the "min_passing_score" field.
+func (druo *DrainedResultUpdateOne) AddMinPassingScore(i int) *DrainedResultUpdateOne {
+	druo.mutation.AddMinPassingScore(i)
+	return druo
+}
+
+// SetMaxPassingScore sets the "max_passing_score" field.
+func (druo *DrainedResultUpdateOne) SetMaxPassingScore(i int) *DrainedResultUpdateOne {
+	druo.mutation.ResetMaxPassingScore()
+	druo.mutation.SetMaxPassingScore(i)
+	return druo
+}
+
+// SetNillableMaxPassingScore sets the "max_passing_score" field if the given value is not nil.
+func (druo *DrainedResultUpdateOne) SetNillableMaxPassingScore(i *int) *DrainedResultUpdateOne {
+	if i != nil {
+		druo.SetMaxPassingScore(*i)
+	}
+	return druo
+}
+
+// AddMaxPassingScore adds i to the "max_passing_score" field.
+func (druo *DrainedResultUpdateOne) AddMaxPassingScore(i int) *DrainedResultUpdateOne {
+	druo.mutation.AddMaxPassingScore(i)
+	return druo
+}
+
+// SetMedPassingScore sets the "med_passing_score" field.
+func (druo *DrainedResultUpdateOne) SetMedPassingScore(i int) *DrainedResultUpdateOne {
+	druo.mutation.ResetMedPassingScore()
+	druo.mutation.SetMedPassingScore(i)
+	return druo
+}
+
+// SetNillableMedPassingScore sets the "med_passing_score" field if the given value is not nil.
+func (druo *DrainedResultUpdateOne) SetNillableMedPassingScore(i *int) *DrainedResultUpdateOne {
+	if i != nil {
+		druo.SetMedPassingScore(*i)
+	}
+	return druo
+}
+
+// AddMedPassingScore adds i to the "med_passing_score" field.
+func (druo *DrainedResultUpdateOne) AddMedPassingScore(i int) *DrainedResultUpdateOne {
+	druo.mutation.AddMedPassingScore(i)
+	return druo
+}
+
+// SetAvgLastAdmittedRatingPlace sets the "avg_last_admitted_rating_place" field.
+func (druo *DrainedResultUpdateOne) SetAvgLastAdmittedRatingPlace(i int) *DrainedResultUpdateOne {
+	druo.mutation.ResetAvgLastAdmittedRatingPlace()
+	druo.mutation.SetAvgLastAdmittedRatingPlace(i)
+	return druo
+}
+
+// SetNillableAvgLastAdmittedRatingPlace sets the "avg_last_admitted_rating_place" field if the given value is not nil.
+func (druo *DrainedResultUpdateOne) SetNillableAvgLastAdmittedRatingPlace(i *int) *DrainedResultUpdateOne {
+	if i != nil {
+		druo.SetAvgLastAdmittedRatingPlace(*i)
+	}
+	return druo
+}
+
+// AddAvgLastAdmittedRatingPlace adds i to the "avg_last_admitted_rating_place" field.
+func (druo *DrainedResultUpdateOne) AddAvgLastAdmittedRatingPlace(i int) *DrainedResultUpdateOne {
+	druo.mutation.AddAvgLastAdmittedRatingPlace(i)
+	return druo
+}
+
+// SetMinLastAdmittedRatingPlace sets the "min_last_admitted_rating_place" field.
+func (druo *DrainedResultUpdateOne) SetMinLastAdmittedRatingPlace(i int) *DrainedResultUpdateOne {
+	druo.mutation.ResetMinLastAdmittedRatingPlace()
+	druo.mutation.SetMinLastAdmittedRatingPlace(i)
+	return druo
+}
+
+// SetNillableMinLastAdmittedRatingPlace sets the "min_last_admitted_rating_place" field if the given value is not nil.
+func (druo *DrainedResultUpdateOne) SetNillableMinLastAdmittedRatingPlace(i *int) *DrainedResultUpdateOne {
+	if i != nil {
+		druo.SetMinLastAdmittedRatingPlace(*i)
+	}
+	return druo
+}
+
+// AddMinLastAdmittedRatingPlace adds i to the "min_last_admitted_rating_place" field.
+func (druo *DrainedResultUpdateOne) AddMinLastAdmittedRatingPlace(i int) *DrainedResultUpdateOne {
+	druo.mutation.AddMinLastAdmittedRatingPlace(i)
+	return druo
+}
+
+// SetMaxLastAdmittedRatingPlace sets the "max_last_admitted_rating_place" field.
+func (druo *DrainedResultUpdateOne) SetMaxLastAdmittedRatingPlace(i int) *DrainedResultUpdateOne {
+	druo.mutation.ResetMaxLastAdmittedRatingPlace()
+	druo.mutation.SetMaxLastAdmittedRatingPlace(i)
+	return druo
+}
+
+// SetNillableMaxLastAdmittedRatingPlace sets the "max_last_admitted_rating_place" field if the given value is not nil.
+func (druo *DrainedResultUpdateOne) SetNillableMaxLastAdmittedRatingPlace(i *int) *DrainedResultUpdateOne {
+	if i != nil {
+		druo.SetMaxLastAdmittedRatingPlace(*i)
+	}
+	return druo
+}
+
+// AddMaxLastAdmittedRatingPlace adds i to the "max_last_admitted_rating_place" field.
+func (druo *DrainedResultUpdateOne) AddMaxLastAdmittedRatingPlace(i int) *DrainedResultUpdateOne {
+	druo.mutation.AddMaxLastAdmittedRatingPlace(i)
+	return druo
+}
+
+// SetMedLastAdmittedRatingPlace sets the "med_last_admitted_rating_place" field.
+func (druo *DrainedResultUpdateOne) SetMedLastAdmittedRatingPlace(i int) *DrainedResultUpdateOne {
+	druo.mutation.ResetMedLastAdmittedRatingPlace()
+	druo.mutation.SetMedLastAdmittedRatingPlace(i)
+	return druo
+}
+
+// SetNillableMedLastAdmittedRatingPlace sets the "med_last_admitted_rating_place" field if the given value is not nil.
+func (druo *DrainedResultUpdateOne) SetNillableMedLastAdmittedRatingPlace(i *int) *DrainedResultUpdateOne {
+	if i != nil {
+		druo.SetMedLastAdmittedRatingPlace(*i)
+	}
+	return druo
+}
+
+// AddMedLastAdmittedRatingPlace adds i to the "med_last_admitted_rating_place" field.
+func (druo *DrainedResultUpdateOne) AddMedLastAdmittedRatingPlace(i int) *DrainedResultUpdateOne {
+	druo.mutation.AddMedLastAdmittedRatingPlace(i)
+	return druo
+}
+
+// SetRunID sets the "run_id" field.
+func (druo *DrainedResultUpdateOne) SetRunID(i int) *DrainedResultUpdateOne {
+	druo.mutation.SetRunID(i)
+	return druo
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (druo *DrainedResultUpdateOne) SetNillableRunID(i *int) *DrainedResultUpdateOne {
+	if i != nil {
+		druo.SetRunID(*i)
+	}
+	return druo
+}
+
+// SetRegularsAdmitted sets the "regulars_admitted" field.
+func (druo *DrainedResultUpdateOne) SetRegularsAdmitted(b bool) *DrainedResultUpdateOne {
+	druo.mutation.SetRegularsAdmitted(b)
+	return druo
+}
+
+// SetNillableRegularsAdmitted sets the "regulars_admitted" field if the given value is not nil.
+func (druo *DrainedResultUpdateOne) SetNillableRegularsAdmitted(b *bool) *DrainedResultUpdateOne {
+	if b != nil {
+		druo.SetRegularsAdmitted(*b)
+	}
+	return druo
+}
+
+// SetIsVirtual sets the "is_virtual" field.
+func (druo *DrainedResultUpdateOne) SetIsVirtual(b bool) *DrainedResultUpdateOne {
+	druo.mutation.SetIsVirtual(b)
+	return druo
+}
+
+// SetNillableIsVirtual sets the "is_virtual" field if the given value is not nil.
+func (druo *DrainedResultUpdateOne) SetNillableIsVirtual(b *bool) *DrainedResultUpdateOne {
+	if b != nil {
+		druo.SetIsVirtual(*b)
+	}
+	return druo
+}
+
+// SetSeed sets the "seed" field.
+func (druo *DrainedResultUpdateOne) SetSeed(i int64) *DrainedResultUpdateOne {
+	druo.mutation.ResetSeed()
+	druo.mutation.SetSeed(i)
+	return druo
+}
+
+// SetNillableSeed sets the "seed" field if the given value is not nil.
+func (druo *DrainedResultUpdateOne) SetNillableSeed(i *int64) *DrainedResultUpdateOne {
+	if i != nil {
+		druo.SetSeed(*i)
+	}
+	return druo
+}
+
+// AddSeed adds i to the "seed" field.
+func (druo *DrainedResultUpdateOne) AddSeed(i int64) *DrainedResultUpdateOne {
+	druo.mutation.AddSeed(i)
+	return druo
+}
+
+// ClearSeed clears the value of the "seed" field.
+func (druo *DrainedResultUpdateOne) ClearSeed() *DrainedResultUpdateOne {
+	druo.mutation.ClearSeed()
+	return druo
+}
+
+// SetDrainModel sets the "drain_model" field.
+func (druo *DrainedResultUpdateOne) SetDrainModel(s string) *DrainedResultUpdateOne {
+	druo.mutation.SetDrainModel(s)
+	return druo
+}
+
+// SetNillableDrainModel sets the "drain_model" field if the given value is not nil.
+func (druo *DrainedResultUpdateOne) SetNillableDrainModel(s *string) *DrainedResultUpdateOne {
+	if s != nil {
+		druo.SetDrainModel(*s)
+	}
+	return druo
 }
 
-// DrainedResultUpdateOne is the builder for updating a single DrainedResult entity.
-type DrainedResultUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *DrainedResultMutation
+// ClearDrainModel clears the value of the "drain_model" field.
+func (druo *DrainedResultUpdateOne) ClearDrainModel() *DrainedResultUpdateOne {
+	druo.mutation.ClearDrainModel()
+	return druo
 }
 
-// SetDrainedPercent sets the "drained_percent" field.
-func (druo *DrainedResultUpdateOne) SetDrainedPercent(i int) *DrainedResultUpdateOne {
-	druo.mutation.ResetDrainedPercent()
-	druo.mutation.SetDrainedPercent(i)
+// SetAvgPriorityStageAdmitted sets the "avg_priority_stage_admitted" field.
+func (druo *DrainedResultUpdateOne) SetAvgPriorityStageAdmitted(i int) *DrainedResultUpdateOne {
+	druo.mutation.ResetAvgPriorityStageAdmitted()
+	druo.mutation.SetAvgPriorityStageAdmitted(i)
 	return druo
 }
 
-// SetNillableDrainedPercent sets the "drained_percent" field if the given value is not nil.
-func (druo *DrainedResultUpdateOne) SetNillableDrainedPercent(i *int) *DrainedResultUpdateOne {
+// SetNillableAvgPriorityStageAdmitted sets the "avg_priority_stage_admitted" field if the given value is not nil.
+func (druo *DrainedResultUpdateOne) SetNillableAvgPriorityStageAdmitted(i *int) *DrainedResultUpdateOne {
 	if i != nil {
-		druo.SetDrainedPercent(*i)
+		druo.SetAvgPriorityStageAdmitted(*i)
 	}
 	return druo
 }
 
-// AddDrainedPercent adds i to the "drained_percent" field.
-func (druo *DrainedResultUpdateOne) AddDrainedPercent(i int) *DrainedResultUpdateOne {
-	druo.mutation.AddDrainedPercent(i)
+// AddAvgPriorityStageAdmitted adds i to the "avg_priority_stage_admitted" field.
+func (druo *DrainedResultUpdateOne) AddAvgPriorityStageAdmitted(i int) *DrainedResultUpdateOne {
+	druo.mutation.AddAvgPriorityStageAdmitted(i)
 	return druo
 }
 
-// SetAvgPassingScore sets the "avg_passing_score" field.
-func (druo *DrainedResultUpdateOne) SetAvgPassingScore(i int) *DrainedResultUpdateOne {
-	druo.mutation.ResetAvgPassingScore()
-	druo.mutation.SetAvgPassingScore(i)
+// ClearAvgPriorityStageAdmitted clears the value of the "avg_priority_stage_admitted" field.
+func (druo *DrainedResultUpdateOne) ClearAvgPriorityStageAdmitted() *DrainedResultUpdateOne {
+	druo.mutation.ClearAvgPriorityStageAdmitted()
 	return druo
 }
 
-// SetNillableAvgPassingScore sets the "avg_passing_score" field if the given value is not nil.
-func (druo *DrainedResultUpdateOne) SetNillableAvgPassingScore(i *int) *DrainedResultUpdateOne {
+// SetAvgMainStageAdmitted sets the "avg_main_stage_admitted" field.
+func (druo *DrainedResultUpdateOne) SetAvgMainStageAdmitted(i int) *DrainedResultUpdateOne {
+	druo.mutation.ResetAvgMainStageAdmitted()
+	druo.mutation.SetAvgMainStageAdmitted(i)
+	return druo
+}
+
+// SetNillableAvgMainStageAdmitted sets the "avg_main_stage_admitted" field if the given value is not nil.
+func (druo *DrainedResultUpdateOne) SetNillableAvgMainStageAdmitted(i *int) *DrainedResultUpdateOne {
 	if i != nil {
-		druo.SetAvgPassingScore(*i)
+		druo.SetAvgMainStageAdmitted(*i)
 	}
 	return druo
 }
 
-// AddAvgPassingScore adds i to the "avg_passing_score" field.
-func (druo *DrainedResultUpdateOne) AddAvgPassingScore(i int) *DrainedResultUpdateOne {
-	druo.mutation.AddAvgPassingScore(i)
+// AddAvgMainStageAdmitted adds i to the "avg_main_stage_admitted" field.
+func (druo *DrainedResultUpdateOne) AddAvgMainStageAdmitted(i int) *DrainedResultUpdateOne {
+	druo.mutation.AddAvgMainStageAdmitted(i)
 	return druo
 }
 
-// SetMinPassingScore sets the "min_passing_score" field.
-func (druo *DrainedResultUpdateOne) SetMinPassingScore(i int) *DrainedResultUpdateOne {
-	druo.mutation.ResetMinPassingScore()
-	druo.mutation.SetMinPassingScore(i)
+// ClearAvgMainStageAdmitted clears the value of the "avg_main_stage_admitted" field.
+func (druo *DrainedResultUpdateOne) ClearAvgMainStageAdmitted() *DrainedResultUpdateOne {
+	druo.mutation.ClearAvgMainStageAdmitted()
 	return druo
 }
 
-// SetNillableMinPassingScore sets the "min_passing_score" field if the given value is not nil.
-func (druo *DrainedResultUpdateOne) SetNillableMinPassingScore(i *int) *DrainedResultUpdateOne {
+// SetSubQuotas sets the "sub_quotas" field.
+func (druo *DrainedResultUpdateOne) SetSubQuotas(cqrd []core.SubQuotaResultDTO) *DrainedResultUpdateOne {
+	druo.mutation.SetSubQuotas(cqrd)
+	return druo
+}
+
+// AppendSubQuotas appends cqrd to the "sub_quotas" field.
+func (druo *DrainedResultUpdateOne) AppendSubQuotas(cqrd []core.SubQuotaResultDTO) *DrainedResultUpdateOne {
+	druo.mutation.AppendSubQuotas(cqrd)
+	return druo
+}
+
+// ClearSubQuotas clears the value of the "sub_quotas" field.
+func (druo *DrainedResultUpdateOne) ClearSubQuotas() *DrainedResultUpdateOne {
+	druo.mutation.ClearSubQuotas()
+	return druo
+}
+
+// SetAvgPaidPassingScore sets the "avg_paid_passing_score" field.
+func (druo *DrainedResultUpdateOne) SetAvgPaidPassingScore(i int) *DrainedResultUpdateOne {
+	druo.mutation.ResetAvgPaidPassingScore()
+	druo.mutation.SetAvgPaidPassingScore(i)
+	return druo
+}
+
+// SetNillableAvgPaidPassingScore sets the "avg_paid_passing_score" field if the given value is not nil.
+func (druo *DrainedResultUpdateOne) SetNillableAvgPaidPassingScore(i *int) *DrainedResultUpdateOne {
 	if i != nil {
-		druo.SetMinPassingScore(*i)
+		druo.SetAvgPaidPassingScore(*i)
 	}
 	return druo
 }
 
-// AddMinPassingScore adds i to the "min_passing_score" field.
-func (druo *DrainedResultUpdateOne) AddMinPassingScore(i int) *DrainedResultUpdateOne {
-	druo.mutation.AddMinPassingScore(i)
+// AddAvgPaidPassingScore adds i to the "avg_paid_passing_score" field.
+func (druo *DrainedResultUpdateOne) AddAvgPaidPassingScore(i int) *DrainedResultUpdateOne {
+	druo.mutation.AddAvgPaidPassingScore(i)
 	return druo
 }
 
-// SetMaxPassingScore sets the "max_passing_score" field.
-func (druo *DrainedResultUpdateOne) SetMaxPassingScore(i int) *DrainedResultUpdateOne {
-	druo.mutation.ResetMaxPassingScore()
-	druo.mutation.SetMaxPassingScore(i)
+// ClearAvgPaidPassingScore clears the value of the "avg_paid_passing_score" field.
+func (druo *DrainedResultUpdateOne) ClearAvgPaidPassingScore() *DrainedResultUpdateOne {
+	druo.mutation.ClearAvgPaidPassingScore()
 	return druo
 }
 
-// SetNillableMaxPassingScore sets the "max_passing_score" field if the given value is not nil.
-func (druo *DrainedResultUpdateOne) SetNillableMaxPassingScore(i *int) *DrainedResultUpdateOne {
+// SetAvgPaidLastAdmittedRatingPlace sets the "avg_paid_last_admitted_rating_place" field.
+func (druo *DrainedResultUpdateOne) SetAvgPaidLastAdmittedRatingPlace(i int) *DrainedResultUpdateOne {
+	druo.mutation.ResetAvgPaidLastAdmittedRatingPlace()
+	druo.mutation.SetAvgPaidLastAdmittedRatingPlace(i)
+	return druo
+}
+
+// SetNillableAvgPaidLastAdmittedRatingPlace sets the "avg_paid_last_admitted_rating_place" field if the given value is not nil.
+func (druo *DrainedResultUpdateOne) SetNillableAvgPaidLastAdmittedRatingPlace(i *int) *DrainedResultUpdateOne {
 	if i != nil {
-		druo.SetMaxPassingScore(*i)
+		druo.SetAvgPaidLastAdmittedRatingPlace(*i)
 	}
 	return druo
 }
 
-// AddMaxPassingScore adds i to the "max_passing_score" field.
-func (druo *DrainedResultUpdateOne) AddMaxPassingScore(i int) *DrainedResultUpdateOne {
-	druo.mutation.AddMaxPassingScore(i)
+// AddAvgPaidLastAdmittedRatingPlace adds i to the "avg_paid_last_admitted_rating_place" field.
+func (druo *DrainedResultUpdateOne) AddAvgPaidLastAdmittedRatingPlace(i int) *DrainedResultUpdateOne {
+	druo.mutation.AddAvgPaidLastAdmittedRatingPlace(i)
 	return druo
 }
 
-// SetMedPassingScore sets the "med_passing_score" field.
-func (druo *DrainedResultUpdateOne) SetMedPassingScore(i int) *DrainedResultUpdateOne {
-	druo.mutation.ResetMedPassingScore()
-	druo.mutation.SetMedPassingScore(i)
+// ClearAvgPaidLastAdmittedRatingPlace clears the value of the "avg_paid_last_admitted_rating_place" field.
+func (druo *DrainedResultUpdateOne) ClearAvgPaidLastAdmittedRatingPlace() *DrainedResultUpdateOne {
+	druo.mutation.ClearAvgPaidLastAdmittedRatingPlace()
 	return druo
 }
 
-// SetNillableMedPassingScore sets the "med_passing_score" field if the given value is not nil.
-func (druo *DrainedResultUpdateOne) SetNillableMedPassingScore(i *int) *DrainedResultUpdateOne {
+// SetP10PassingScore sets the "p10_passing_score" field.
+func (druo *DrainedResultUpdateOne) SetP10PassingScore(i int) *DrainedResultUpdateOne {
+	druo.mutation.ResetP10PassingScore()
+	druo.mutation.SetP10PassingScore(i)
+	return druo
+}
+
+// SetNillableP10PassingScore sets the "p10_passing_score" field if the given value is not nil.
+func (druo *DrainedResultUpdateOne) SetNillableP10PassingScore(i *int) *DrainedResultUpdateOne {
 	if i != nil {
-		druo.SetMedPassingScore(*i)
+		druo.SetP10PassingScore(*i)
 	}
 	return druo
 }
 
-// AddMedPassingScore adds i to the "med_passing_score" field.
-func (druo *DrainedResultUpdateOne) AddMedPassingScore(i int) *DrainedResultUpdateOne {
-	druo.mutation.AddMedPassingScore(i)
+// AddP10PassingScore adds i to the "p10_passing_score" field.
+func (druo *DrainedResultUpdateOne) AddP10PassingScore(i int) *DrainedResultUpdateOne {
+	druo.mutation.AddP10PassingScore(i)
 	return druo
 }
 
-// SetAvgLastAdmittedRatingPlace sets the "avg_last_admitted_rating_place" field.
-func (druo *DrainedResultUpdateOne) SetAvgLastAdmittedRatingPlace(i int) *DrainedResultUpdateOne {
-	druo.mutation.ResetAvgLastAdmittedRatingPlace()
-	druo.mutation.SetAvgLastAdmittedRatingPlace(i)
+// ClearP10PassingScore clears the value of the "p10_passing_score" field.
+func (druo *DrainedResultUpdateOne) ClearP10PassingScore() *DrainedResultUpdateOne {
+	druo.mutation.ClearP10PassingScore()
 	return druo
 }
 
-// SetNillableAvgLastAdmittedRatingPlace sets the "avg_last_admitted_rating_place" field if the given value is not nil.
-func (druo *DrainedResultUpdateOne) SetNillableAvgLastAdmittedRatingPlace(i *int) *DrainedResultUpdateOne {
+// SetP25PassingScore sets the "p25_passing_score" field.
+func (druo *DrainedResultUpdateOne) SetP25PassingScore(i int) *DrainedResultUpdateOne {
+	druo.mutation.ResetP25PassingScore()
+	druo.mutation.SetP25PassingScore(i)
+	return druo
+}
+
+// SetNillableP25PassingScore sets the "p25_passing_score" field if the given value is not nil.
+func (druo *DrainedResultUpdateOne) SetNillableP25PassingScore(i *int) *DrainedResultUpdateOne {
 	if i != nil {
-		druo.SetAvgLastAdmittedRatingPlace(*i)
+		druo.SetP25PassingScore(*i)
 	}
 	return druo
 }
 
-// AddAvgLastAdmittedRatingPlace adds i to the "avg_last_admitted_rating_place" field.
-func (druo *DrainedResultUpdateOne) AddAvgLastAdmittedRatingPlace(i int) *DrainedResultUpdateOne {
-	druo.mutation.AddAvgLastAdmittedRatingPlace(i)
+// AddP25PassingScore adds i to the "p25_passing_score" field.
+func (druo *DrainedResultUpdateOne) AddP25PassingScore(i int) *DrainedResultUpdateOne {
+	druo.mutation.AddP25PassingScore(i)
 	return druo
 }
 
-// SetMinLastAdmittedRatingPlace sets the "min_last_admitted_rating_place" field.
-func (druo *DrainedResultUpdateOne) SetMinLastAdmittedRatingPlace(i int) *DrainedResultUpdateOne {
-	druo.mutation.ResetMinLastAdmittedRatingPlace()
-	druo.mutation.SetMinLastAdmittedRatingPlace(i)
+// ClearP25PassingScore clears the value of the "p25_passing_score" field.
+func (druo *DrainedResultUpdateOne) ClearP25PassingScore() *DrainedResultUpdateOne {
+	druo.mutation.ClearP25PassingScore()
 	return druo
 }
 
-// SetNillableMinLastAdmittedRatingPlace sets the "min_last_admitted_rating_place" field if the given value is not nil.
-func (druo *DrainedResultUpdateOne) SetNillableMinLastAdmittedRatingPlace(i *int) *DrainedResultUpdateOne {
+// SetP75PassingScore sets the "p75_passing_score" field.
+func (druo *DrainedResultUpdateOne) SetP75PassingScore(i int) *DrainedResultUpdateOne {
+	druo.mutation.ResetP75PassingScore()
+	druo.mutation.SetP75PassingScore(i)
+	return druo
+}
+
+// SetNillableP75PassingScore sets the "p75_passing_score" field if the given value is not nil.
+func (druo *DrainedResultUpdateOne) SetNillableP75PassingScore(i *int) *DrainedResultUpdateOne {
 	if i != nil {
-		druo.SetMinLastAdmittedRatingPlace(*i)
+		druo.SetP75PassingScore(*i)
 	}
 	return druo
 }
 
-// AddMinLastAdmittedRatingPlace adds i to the "min_last_admitted_rating_place" field.
-func (druo *DrainedResultUpdateOne) AddMinLastAdmittedRatingPlace(i int) *DrainedResultUpdateOne {
-	druo.mutation.AddMinLastAdmittedRatingPlace(i)
+// AddP75PassingScore adds i to the "p75_passing_score" field.
+func (druo *DrainedResultUpdateOne) AddP75PassingScore(i int) *DrainedResultUpdateOne {
+	druo.mutation.AddP75PassingScore(i)
 	return druo
 }
 
-// SetMaxLastAdmittedRatingPlace sets the "max_last_admitted_rating_place" field.
-func (druo *DrainedResultUpdateOne) SetMaxLastAdmittedRatingPlace(i int) *DrainedResultUpdateOne {
-	druo.mutation.ResetMaxLastAdmittedRatingPlace()
-	druo.mutation.SetMaxLastAdmittedRatingPlace(i)
+// ClearP75PassingScore clears the value of the "p75_passing_score" field.
+func (druo *DrainedResultUpdateOne) ClearP75PassingScore() *DrainedResultUpdateOne {
+	druo.mutation.ClearP75PassingScore()
 	return druo
 }
 
-// SetNillableMaxLastAdmittedRatingPlace sets the "max_last_admitted_rating_place" field if the given value is not nil.
-func (druo *DrainedResultUpdateOne) SetNillableMaxLastAdmittedRatingPlace(i *int) *DrainedResultUpdateOne {
+// SetP90PassingScore sets the "p90_passing_score" field.
+func (druo *DrainedResultUpdateOne) SetP90PassingScore(i int) *DrainedResultUpdateOne {
+	druo.mutation.ResetP90PassingScore()
+	druo.mutation.SetP90PassingScore(i)
+	return druo
+}
+
+// SetNillableP90PassingScore sets the "p90_passing_score" field if the given value is not nil.
+func (druo *DrainedResultUpdateOne) SetNillableP90PassingScore(i *int) *DrainedResultUpdateOne {
 	if i != nil {
-		druo.SetMaxLastAdmittedRatingPlace(*i)
+		druo.SetP90PassingScore(*i)
 	}
 	return druo
 }
 
-// AddMaxLastAdmittedRatingPlace adds i to the "max_last_admitted_rating_place" field.
-func (druo *DrainedResultUpdateOne) AddMaxLastAdmittedRatingPlace(i int) *DrainedResultUpdateOne {
-	druo.mutation.AddMaxLastAdmittedRatingPlace(i)
+// AddP90PassingScore adds i to the "p90_passing_score" field.
+func (druo *DrainedResultUpdateOne) AddP90PassingScore(i int) *DrainedResultUpdateOne {
+	druo.mutation.AddP90PassingScore(i)
 	return druo
 }
 
-// SetMedLastAdmittedRatingPlace sets the "med_last_admitted_rating_place" field.
-func (druo *DrainedResultUpdateOne) SetMedLastAdmittedRatingPlace(i int) *DrainedResultUpdateOne {
-	druo.mutation.ResetMedLastAdmittedRatingPlace()
-	druo.mutation.SetMedLastAdmittedRatingPlace(i)
+// ClearP90PassingScore clears the value of the "p90_passing_score" field.
+func (druo *DrainedResultUpdateOne) ClearP90PassingScore() *DrainedResultUpdateOne {
+	druo.mutation.ClearP90PassingScore()
 	return druo
 }
 
-// SetNillableMedLastAdmittedRatingPlace sets the "med_last_admitted_rating_place" field if the given value is not nil.
-func (druo *DrainedResultUpdateOne) SetNillableMedLastAdmittedRatingPlace(i *int) *DrainedResultUpdateOne {
+// SetP10LastAdmittedRatingPlace sets the "p10_last_admitted_rating_place" field.
+func (druo *DrainedResultUpdateOne) SetP10LastAdmittedRatingPlace(i int) *DrainedResultUpdateOne {
+	druo.mutation.ResetP10LastAdmittedRatingPlace()
+	druo.mutation.SetP10LastAdmittedRatingPlace(i)
+	return druo
+}
+
+// SetNillableP10LastAdmittedRatingPlace sets the "p10_last_admitted_rating_place" field if the given value is not nil.
+func (druo *DrainedResultUpdateOne) SetNillableP10LastAdmittedRatingPlace(i *int) *DrainedResultUpdateOne {
 	if i != nil {
-		druo.SetMedLastAdmittedRatingPlace(*i)
+		druo.SetP10LastAdmittedRatingPlace(*i)
 	}
 	return druo
 }
 
-// AddMedLastAdmittedRatingPlace adds i to the "med_last_admitted_rating_place" field.
-func (druo *DrainedResultUpdateOne) AddMedLastAdmittedRatingPlace(i int) *DrainedResultUpdateOne {
-	druo.mutation.AddMedLastAdmittedRatingPlace(i)
+// AddP10LastAdmittedRatingPlace adds i to the "p10_last_admitted_rating_place" field.
+func (druo *DrainedResultUpdateOne) AddP10LastAdmittedRatingPlace(i int) *DrainedResultUpdateOne {
+	druo.mutation.AddP10LastAdmittedRatingPlace(i)
 	return druo
 }
 
-// SetRunID sets the "run_id" field.
-func (druo *DrainedResultUpdateOne) SetRunID(i int) *DrainedResultUpdateOne {
-	druo.mutation.SetRunID(i)
+// ClearP10LastAdmittedRatingPlace clears the value of the "p10_last_admitted_rating_place" field.
+func (druo *DrainedResultUpdateOne) ClearP10LastAdmittedRatingPlace() *DrainedResultUpdateOne {
+	druo.mutation.ClearP10LastAdmittedRatingPlace()
 	return druo
 }
 
-// SetNillableRunID sets the "run_id" field if the given value is not nil.
-func (druo *DrainedResultUpdateOne) SetNillableRunID(i *int) *DrainedResultUpdateOne {
+// SetP25LastAdmittedRatingPlace sets the "p25_last_admitted_rating_place" field.
+func (druo *DrainedResultUpdateOne) SetP25LastAdmittedRatingPlace(i int) *DrainedResultUpdateOne {
+	druo.mutation.ResetP25LastAdmittedRatingPlace()
+	druo.mutation.SetP25LastAdmittedRatingPlace(i)
+	return druo
+}
+
+// SetNillableP25LastAdmittedRatingPlace sets the "p25_last_admitted_rating_place" field if the given value is not nil.
+func (druo *DrainedResultUpdateOne) SetNillableP25LastAdmittedRatingPlace(i *int) *DrainedResultUpdateOne {
 	if i != nil {
-		druo.SetRunID(*i)
+		druo.SetP25LastAdmittedRatingPlace(*i)
 	}
 	return druo
 }
 
-// SetRegularsAdmitted sets the "regulars_admitted" field.
-func (druo *DrainedResultUpdateOne) SetRegularsAdmitted(b bool) *DrainedResultUpdateOne {
-	druo.mutation.SetRegularsAdmitted(b)
+// AddP25LastAdmittedRatingPlace adds i to the "p25_last_admitted_rating_place" field.
+func (druo *DrainedResultUpdateOne) AddP25LastAdmittedRatingPlace(i int) *DrainedResultUpdateOne {
+	druo.mutation.AddP25LastAdmittedRatingPlace(i)
 	return druo
 }
 
-// SetNillableRegularsAdmitted sets the "regulars_admitted" field if the given value is not nil.
-func (druo *DrainedResultUpdateOne) SetNillableRegularsAdmitted(b *bool) *DrainedResultUpdateOne {
-	if b != nil {
-		druo.SetRegularsAdmitted(*b)
+// ClearP25LastAdmittedRatingPlace clears the value of the "p25_last_admitted_rating_place" field.
+func (druo *DrainedResultUpdateOne) ClearP25LastAdmittedRatingPlace() *DrainedResultUpdateOne {
+	druo.mutation.ClearP25LastAdmittedRatingPlace()
+	return druo
+}
+
+// SetP75LastAdmittedRatingPlace sets the "p75_last_admitted_rating_place" field.
+func (druo *DrainedResultUpdateOne) SetP75LastAdmittedRatingPlace(i int) *DrainedResultUpdateOne {
+	druo.mutation.ResetP75LastAdmittedRatingPlace()
+	druo.mutation.SetP75LastAdmittedRatingPlace(i)
+	return druo
+}
+
+// SetNillableP75LastAdmittedRatingPlace sets the "p75_last_admitted_rating_place" field if the given value is not nil.
+func (druo *DrainedResultUpdateOne) SetNillableP75LastAdmittedRatingPlace(i *int) *DrainedResultUpdateOne {
+	if i != nil {
+		druo.SetP75LastAdmittedRatingPlace(*i)
 	}
 	return druo
 }
 
-// SetIsVirtual sets the "is_virtual" field.
-func (druo *DrainedResultUpdateOne) SetIsVirtual(b bool) *DrainedResultUpdateOne {
-	druo.mutation.SetIsVirtual(b)
+// AddP75LastAdmittedRatingPlace adds i to the "p75_last_admitted_rating_place" field.
+func (druo *DrainedResultUpdateOne) AddP75LastAdmittedRatingPlace(i int) *DrainedResultUpdateOne {
+	druo.mutation.AddP75LastAdmittedRatingPlace(i)
 	return druo
 }
 
-// SetNillableIsVirtual sets the "is_virtual" field if the given value is not nil.
-func (druo *DrainedResultUpdateOne) SetNillableIsVirtual(b *bool) *DrainedResultUpdateOne {
-	if b != nil {
-		druo.SetIsVirtual(*b)
+// ClearP75LastAdmittedRatingPlace clears the value of the "p75_last_admitted_rating_place" field.
+func (druo *DrainedResultUpdateOne) ClearP75LastAdmittedRatingPlace() *DrainedResultUpdateOne {
+	druo.mutation.ClearP75LastAdmittedRatingPlace()
+	return druo
+}
+
+// SetP90LastAdmittedRatingPlace sets the "p90_last_admitted_rating_place" field.
+func (druo *DrainedResultUpdateOne) SetP90LastAdmittedRatingPlace(i int) *DrainedResultUpdateOne {
+	druo.mutation.ResetP90LastAdmittedRatingPlace()
+	druo.mutation.SetP90LastAdmittedRatingPlace(i)
+	return druo
+}
+
+// SetNillableP90LastAdmittedRatingPlace sets the "p90_last_admitted_rating_place" field if the given value is not nil.
+func (druo *DrainedResultUpdateOne) SetNillableP90LastAdmittedRatingPlace(i *int) *DrainedResultUpdateOne {
+	if i != nil {
+		druo.SetP90LastAdmittedRatingPlace(*i)
+	}
+	return druo
+}
+
+// AddP90LastAdmittedRatingPlace adds i to the "p90_last_admitted_rating_place" field.
+func (druo *DrainedResultUpdateOne) AddP90LastAdmittedRatingPlace(i int) *DrainedResultUpdateOne {
+	druo.mutation.AddP90LastAdmittedRatingPlace(i)
+	return druo
+}
+
+// ClearP90LastAdmittedRatingPlace clears the value of the "p90_last_admitted_rating_place" field.
+func (druo *DrainedResultUpdateOne) ClearP90LastAdmittedRatingPlace() *DrainedResultUpdateOne {
+	druo.mutation.ClearP90LastAdmittedRatingPlace()
+	return druo
+}
+
+// SetPassingScoreHistogram sets the "passing_score_histogram" field.
+func (druo *DrainedResultUpdateOne) SetPassingScoreHistogram(cd *core.HistogramDTO) *DrainedResultUpdateOne {
+	druo.mutation.SetPassingScoreHistogram(cd)
+	return druo
+}
+
+// ClearPassingScoreHistogram clears the value of the "passing_score_histogram" field.
+func (druo *DrainedResultUpdateOne) ClearPassingScoreHistogram() *DrainedResultUpdateOne {
+	druo.mutation.ClearPassingScoreHistogram()
+	return druo
+}
+
+// SetLastAdmittedRatingPlaceHistogram sets the "last_admitted_rating_place_histogram" field.
+func (druo *DrainedResultUpdateOne) SetLastAdmittedRatingPlaceHistogram(cd *core.HistogramDTO) *DrainedResultUpdateOne {
+	druo.mutation.SetLastAdmittedRatingPlaceHistogram(cd)
+	return druo
+}
+
+// ClearLastAdmittedRatingPlaceHistogram clears the value of the "last_admitted_rating_place_histogram" field.
+func (druo *DrainedResultUpdateOne) ClearLastAdmittedRatingPlaceHistogram() *DrainedResultUpdateOne {
+	druo.mutation.ClearLastAdmittedRatingPlaceHistogram()
+	return druo
+}
+
+// SetIterations sets the "iterations" field.
+func (druo *DrainedResultUpdateOne) SetIterations(i int) *DrainedResultUpdateOne {
+	druo.mutation.ResetIterations()
+	druo.mutation.SetIterations(i)
+	return druo
+}
+
+// SetNillableIterations sets the "iterations" field if the given value is not nil.
+func (druo *DrainedResultUpdateOne) SetNillableIterations(i *int) *DrainedResultUpdateOne {
+	if i != nil {
+		druo.SetIterations(*i)
 	}
 	return druo
 }
 
+// AddIterations adds i to the "iterations" field.
+func (druo *DrainedResultUpdateOne) AddIterations(i int) *DrainedResultUpdateOne {
+	druo.mutation.AddIterations(i)
+	return druo
+}
+
+// ClearIterations clears the value of the "iterations" field.
+func (druo *DrainedResultUpdateOne) ClearIterations() *DrainedResultUpdateOne {
+	druo.mutation.ClearIterations()
+	return druo
+}
+
 // SetHeadingID sets the "heading" edge to the Heading entity by ID.
 func (druo *DrainedResultUpdateOne) SetHeadingID(id int) *DrainedResultUpdateOne {
 	druo.mutation.SetHeadingID(id)
@@ -885,6 +1922,161 @@ func (druo *DrainedResultUpdateOne) sqlSave(ctx context.Context) (_node *Drained
 	if value, ok := druo.mutation.IsVirtual(); ok {
 		_spec.SetField(drainedresult.FieldIsVirtual, field.TypeBool, value)
 	}
+	if value, ok := druo.mutation.Seed(); ok {
+		_spec.SetField(drainedresult.FieldSeed, field.TypeInt64, value)
+	}
+	if value, ok := druo.mutation.AddedSeed(); ok {
+		_spec.AddField(drainedresult.FieldSeed, field.TypeInt64, value)
+	}
+	if druo.mutation.SeedCleared() {
+		_spec.ClearField(drainedresult.FieldSeed, field.TypeInt64)
+	}
+	if value, ok := druo.mutation.DrainModel(); ok {
+		_spec.SetField(drainedresult.FieldDrainModel, field.TypeString, value)
+	}
+	if druo.mutation.DrainModelCleared() {
+		_spec.ClearField(drainedresult.FieldDrainModel, field.TypeString)
+	}
+	if value, ok := druo.mutation.AvgPriorityStageAdmitted(); ok {
+		_spec.SetField(drainedresult.FieldAvgPriorityStageAdmitted, field.TypeInt, value)
+	}
+	if value, ok := druo.mutation.AddedAvgPriorityStageAdmitted(); ok {
+		_spec.AddField(drainedresult.FieldAvgPriorityStageAdmitted, field.TypeInt, value)
+	}
+	if druo.mutation.AvgPriorityStageAdmittedCleared() {
+		_spec.ClearField(drainedresult.FieldAvgPriorityStageAdmitted, field.TypeInt)
+	}
+	if value, ok := druo.mutation.AvgMainStageAdmitted(); ok {
+		_spec.SetField(drainedresult.FieldAvgMainStageAdmitted, field.TypeInt, value)
+	}
+	if value, ok := druo.mutation.AddedAvgMainStageAdmitted(); ok {
+		_spec.AddField(drainedresult.FieldAvgMainStageAdmitted, field.TypeInt, value)
+	}
+	if druo.mutation.AvgMainStageAdmittedCleared() {
+		_spec.ClearField(drainedresult.FieldAvgMainStageAdmitted, field.TypeInt)
+	}
+	if value, ok := druo.mutation.SubQuotas(); ok {
+		_spec.SetField(drainedresult.FieldSubQuotas, field.TypeJSON, value)
+	}
+	if value, ok := druo.mutation.AppendedSubQuotas(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, drainedresult.FieldSubQuotas, value)
+		})
+	}
+	if druo.mutation.SubQuotasCleared() {
+		_spec.ClearField(drainedresult.FieldSubQuotas, field.TypeJSON)
+	}
+	if value, ok := druo.mutation.AvgPaidPassingScore(); ok {
+		_spec.SetField(drainedresult.FieldAvgPaidPassingScore, field.TypeInt, value)
+	}
+	if value, ok := druo.mutation.AddedAvgPaidPassingScore(); ok {
+		_spec.AddField(drainedresult.FieldAvgPaidPassingScore, field.TypeInt, value)
+	}
+	if druo.mutation.AvgPaidPassingScoreCleared() {
+		_spec.ClearField(drainedresult.FieldAvgPaidPassingScore, field.TypeInt)
+	}
+	if value, ok := druo.mutation.AvgPaidLastAdmittedRatingPlace(); ok {
+		_spec.SetField(drainedresult.FieldAvgPaidLastAdmittedRatingPlace, field.TypeInt, value)
+	}
+	if value, ok := druo.mutation.AddedAvgPaidLastAdmittedRatingPlace(); ok {
+		_spec.AddField(drainedresult.FieldAvgPaidLastAdmittedRatingPlace, field.TypeInt, value)
+	}
+	if druo.mutation.AvgPaidLastAdmittedRatingPlaceCleared() {
+		_spec.ClearField(drainedresult.FieldAvgPaidLastAdmittedRatingPlace, field.TypeInt)
+	}
+	if value, ok := druo.mutation.P10PassingScore(); ok {
+		_spec.SetField(drainedresult.FieldP10PassingScore, field.TypeInt, value)
+	}
+	if value, ok := druo.mutation.AddedP10PassingScore(); ok {
+		_spec.AddField(drainedresult.FieldP10PassingScore, field.TypeInt, value)
+	}
+	if druo.mutation.P10PassingScoreCleared() {
+		_spec.ClearField(drainedresult.FieldP10PassingScore, field.TypeInt)
+	}
+	if value, ok := druo.mutation.P25PassingScore(); ok {
+		_spec.SetField(drainedresult.FieldP25PassingScore, field.TypeInt, value)
+	}
+	if value, ok := druo.mutation.AddedP25PassingScore(); ok {
+		_spec.AddField(drainedresult.FieldP25PassingScore, field.TypeInt, value)
+	}
+	if druo.mutation.P25PassingScoreCleared() {
+		_spec.ClearField(drainedresult.FieldP25PassingScore, field.TypeInt)
+	}
+	if value, ok := druo.mutation.P75PassingScore(); ok {
+		_spec.SetField(drainedresult.FieldP75PassingScore, field.TypeInt, value)
+	}
+	if value, ok := druo.mutation.AddedP75PassingScore(); ok {
+		_spec.AddField(drainedresult.FieldP75PassingScore, field.TypeInt, value)
+	}
+	if druo.mutation.P75PassingScoreCleared() {
+		_spec.ClearField(drainedresult.FieldP75PassingScore, field.TypeInt)
+	}
+	if value, ok := druo.mutation.P90PassingScore(); ok {
+		_spec.SetField(drainedresult.FieldP90PassingScore, field.TypeInt, value)
+	}
+	if value, ok := druo.mutation.AddedP90PassingScore(); ok {
+		_spec.AddField(drainedresult.FieldP90PassingScore, field.TypeInt, value)
+	}
+	if druo.mutation.P90PassingScoreCleared() {
+		_spec.ClearField(drainedresult.FieldP90PassingScore, field.TypeInt)
+	}
+	if value, ok := druo.mutation.P10LastAdmittedRatingPlace(); ok {
+		_spec.SetField(drainedresult.FieldP10LastAdmittedRatingPlace, field.TypeInt, value)
+	}
+	if value, ok := druo.mutation.AddedP10LastAdmittedRatingPlace(); ok {
+		_spec.AddField(drainedresult.FieldP10LastAdmittedRatingPlace, field.TypeInt, value)
+	}
+	if druo.mutation.P10LastAdmittedRatingPlaceCleared() {
+		_spec.ClearField(drainedresult.FieldP10LastAdmittedRatingPlace, field.TypeInt)
+	}
+	if value, ok := druo.mutation.P25LastAdmittedRatingPlace(); ok {
+		_spec.SetField(drainedresult.FieldP25LastAdmittedRatingPlace, field.TypeInt, value)
+	}
+	if value, ok := druo.mutation.AddedP25LastAdmittedRatingPlace(); ok {
+		_spec.AddField(drainedresult.FieldP25LastAdmittedRatingPlace, field.TypeInt, value)
+	}
+	if druo.mutation.P25LastAdmittedRatingPlaceCleared() {
+		_spec.ClearField(drainedresult.FieldP25LastAdmittedRatingPlace, field.TypeInt)
+	}
+	if value, ok := druo.mutation.P75LastAdmittedRatingPlace(); ok {
+		_spec.SetField(drainedresult.FieldP75LastAdmittedRatingPlace, field.TypeInt, value)
+	}
+	if value, ok := druo.mutation.AddedP75LastAdmittedRatingPlace(); ok {
+		_spec.AddField(drainedresult.FieldP75LastAdmittedRatingPlace, field.TypeInt, value)
+	}
+	if druo.mutation.P75LastAdmittedRatingPlaceCleared() {
+		_spec.ClearField(drainedresult.FieldP75LastAdmittedRatingPlace, field.TypeInt)
+	}
+	if value, ok := druo.mutation.P90LastAdmittedRatingPlace(); ok {
+		_spec.SetField(drainedresult.FieldP90LastAdmittedRatingPlace, field.TypeInt, value)
+	}
+	if value, ok := druo.mutation.AddedP90LastAdmittedRatingPlace(); ok {
+		_spec.AddField(drainedresult.FieldP90LastAdmittedRatingPlace, field.TypeInt, value)
+	}
+	if druo.mutation.P90LastAdmittedRatingPlaceCleared() {
+		_spec.ClearField(drainedresult.FieldP90LastAdmittedRatingPlace, field.TypeInt)
+	}
+	if value, ok := druo.mutation.PassingScoreHistogram(); ok {
+		_spec.SetField(drainedresult.FieldPassingScoreHistogram, field.TypeJSON, value)
+	}
+	if druo.mutation.PassingScoreHistogramCleared() {
+		_spec.ClearField(drainedresult.FieldPassingScoreHistogram, field.TypeJSON)
+	}
+	if value, ok := druo.mutation.LastAdmittedRatingPlaceHistogram(); ok {
+		_spec.SetField(drainedresult.FieldLastAdmittedRatingPlaceHistogram, field.TypeJSON, value)
+	}
+	if druo.mutation.LastAdmittedRatingPlaceHistogramCleared() {
+		_spec.ClearField(drainedresult.FieldLastAdmittedRatingPlaceHistogram, field.TypeJSON)
+	}
+	if value, ok := druo.mutation.Iterations(); ok {
+		_spec.SetField(drainedresult.FieldIterations, field.TypeInt, value)
+	}
+	if value, ok := druo.mutation.AddedIterations(); ok {
+		_spec.AddField(drainedresult.FieldIterations, field.TypeInt, value)
+	}
+	if druo.mutation.IterationsCleared() {
+		_spec.ClearField(drainedresult.FieldIterations, field.TypeInt)
+	}
 	if druo.mutation.HeadingCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -12,11 +12,15 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/trueegorletov/analabit/core/ent/admissionchance"
+	"github.com/trueegorletov/analabit/core/ent/admissionevent"
 	"github.com/trueegorletov/analabit/core/ent/application"
 	"github.com/trueegorletov/analabit/core/ent/calculation"
+	"github.com/trueegorletov/analabit/core/ent/capacitydrift"
 	"github.com/trueegorletov/analabit/core/ent/drainedresult"
 	"github.com/trueegorletov/analabit/core/ent/heading"
 	"github.com/trueegorletov/analabit/core/ent/run"
+	"github.com/trueegorletov/analabit/core/ent/sourcereport"
 	"github.com/trueegorletov/analabit/core/ent/varsity"
 )
 
@@ -78,12 +82,16 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			application.Table:   application.ValidColumn,
-			calculation.Table:   calculation.ValidColumn,
-			drainedresult.Table: drainedresult.ValidColumn,
-			heading.Table:       heading.ValidColumn,
-			run.Table:           run.ValidColumn,
-			varsity.Table:       varsity.ValidColumn,
+			admissionchance.Table: admissionchance.ValidColumn,
+			admissionevent.Table:  admissionevent.ValidColumn,
+			application.Table:     application.ValidColumn,
+			calculation.Table:     calculation.ValidColumn,
+			capacitydrift.Table:   capacitydrift.ValidColumn,
+			drainedresult.Table:   drainedresult.ValidColumn,
+			heading.Table:         heading.ValidColumn,
+			run.Table:             run.ValidColumn,
+			sourcereport.Table:    sourcereport.ValidColumn,
+			varsity.Table:         varsity.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -3,11 +3,13 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/trueegorletov/analabit/core"
 	"github.com/trueegorletov/analabit/core/ent/heading"
 	"github.com/trueegorletov/analabit/core/ent/varsity"
 )
@@ -29,6 +31,20 @@ type Heading struct {
 	Code string `json:"code,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
+	// Subjects holds the value of the "subjects" field.
+	Subjects []string `json:"subjects,omitempty"`
+	// TargetSubQuotas holds the value of the "target_sub_quotas" field.
+	TargetSubQuotas map[string]int `json:"target_sub_quotas,omitempty"`
+	// PaidCapacity holds the value of the "paid_capacity" field.
+	PaidCapacity int `json:"paid_capacity,omitempty"`
+	// DirectionCode holds the value of the "direction_code" field.
+	DirectionCode string `json:"direction_code,omitempty"`
+	// Level holds the value of the "level" field.
+	Level string `json:"level,omitempty"`
+	// StudyForm holds the value of the "study_form" field.
+	StudyForm string `json:"study_form,omitempty"`
+	// Exams holds the value of the "exams" field.
+	Exams []core.EntranceExam `json:"exams,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the HeadingQuery when eager-loading is set.
 	Edges            HeadingEdges `json:"edges"`
@@ -46,9 +62,13 @@ type HeadingEdges struct {
 	Calculations []*Calculation `json:"calculations,omitempty"`
 	// DrainedResults holds the value of the drained_results edge.
 	DrainedResults []*DrainedResult `json:"drained_results,omitempty"`
+	// AdmissionChances holds the value of the admission_chances edge.
+	AdmissionChances []*AdmissionChance `json:"admission_chances,omitempty"`
+	// AdmissionEvents holds the value of the admission_events edge.
+	AdmissionEvents []*AdmissionEvent `json:"admission_events,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [6]bool
 }
 
 // VarsityOrErr returns the Varsity value or an error if the edge
@@ -89,14 +109,34 @@ func (e HeadingEdges) DrainedResultsOrErr() ([]*DrainedResult, error) {
 	return nil, &NotLoadedError{edge: "drained_results"}
 }
 
+// AdmissionChancesOrErr returns the AdmissionChances value or an error if the edge
+// was not loaded in eager-loading.
+func (e HeadingEdges) AdmissionChancesOrErr() ([]*AdmissionChance, error) {
+	if e.loadedTypes[4] {
+		return e.AdmissionChances, nil
+	}
+	return nil, &NotLoadedError{edge: "admission_chances"}
+}
+
+// AdmissionEventsOrErr returns the AdmissionEvents value or an error if the edge
+// was not loaded in eager-loading.
+func (e HeadingEdges) AdmissionEventsOrErr() ([]*AdmissionEvent, error) {
+	if e.loadedTypes[5] {
+		return e.AdmissionEvents, nil
+	}
+	return nil, &NotLoadedError{edge: "admission_events"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Heading) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case heading.FieldID, heading.FieldRegularCapacity, heading.FieldTargetQuotaCapacity, heading.FieldDedicatedQuotaCapacity, heading.FieldSpecialQuotaCapacity:
+		case heading.FieldSubjects, heading.FieldTargetSubQuotas, heading.FieldExams:
+			values[i] = new([]byte)
+		case heading.FieldID, heading.FieldRegularCapacity, heading.FieldTargetQuotaCapacity, heading.FieldDedicatedQuotaCapacity, heading.FieldSpecialQuotaCapacity, heading.FieldPaidCapacity:
 			values[i] = new(sql.NullInt64)
-		case heading.FieldCode, heading.FieldName:
+		case heading.FieldCode, heading.FieldName, heading.FieldDirectionCode, heading.FieldLevel, heading.FieldStudyForm:
 			values[i] = new(sql.NullString)
 		case heading.ForeignKeys[0]: // varsity_headings
 			values[i] = new(sql.NullInt64)
@@ -157,6 +197,54 @@ func (h *Heading) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				h.Name = value.String
 			}
+		case heading.FieldSubjects:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field subjects", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &h.Subjects); err != nil {
+					return fmt.Errorf("unmarshal field subjects: %w", err)
+				}
+			}
+		case heading.FieldTargetSubQuotas:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field target_sub_quotas", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &h.TargetSubQuotas); err != nil {
+					return fmt.Errorf("unmarshal field target_sub_quotas: %w", err)
+				}
+			}
+		case heading.FieldPaidCapacity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field paid_capacity", values[i])
+			} else if value.Valid {
+				h.PaidCapacity = int(value.Int64)
+			}
+		case heading.FieldDirectionCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field direction_code", values[i])
+			} else if value.Valid {
+				h.DirectionCode = value.String
+			}
+		case heading.FieldLevel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field level", values[i])
+			} else if value.Valid {
+				h.Level = value.String
+			}
+		case heading.FieldStudyForm:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field study_form", values[i])
+			} else if value.Valid {
+				h.StudyForm = value.String
+			}
+		case heading.FieldExams:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field exams", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &h.Exams); err != nil {
+					return fmt.Errorf("unmarshal field exams: %w", err)
+				}
+			}
 		case heading.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field varsity_headings", value)
@@ -197,6 +285,16 @@ func (h *Heading) QueryDrainedResults() *DrainedResultQuery {
 	return NewHeadingClient(h.config).QueryDrainedResults(h)
 }
 
+// QueryAdmissionChances queries the "admission_chances" edge of the Heading entity.
+func (h *Heading) QueryAdmissionChances() *AdmissionChanceQuery {
+	return NewHeadingClient(h.config).QueryAdmissionChances(h)
+}
+
+// QueryAdmissionEvents queries the "admission_events" edge of the Heading entity.
+func (h *Heading) QueryAdmissionEvents() *AdmissionEventQuery {
+	return NewHeadingClient(h.config).QueryAdmissionEvents(h)
+}
+
 // Update returns a builder for updating this Heading.
 // Note that you need to call Heading.Unwrap() before calling this method if this Heading
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -237,6 +335,27 @@ func (h *Heading) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(h.Name)
+	builder.WriteString(", ")
+	builder.WriteString("subjects=")
+	builder.WriteString(fmt.Sprintf("%v", h.Subjects))
+	builder.WriteString(", ")
+	builder.WriteString("target_sub_quotas=")
+	builder.WriteString(fmt.Sprintf("%v", h.TargetSubQuotas))
+	builder.WriteString(", ")
+	builder.WriteString("paid_capacity=")
+	builder.WriteString(fmt.Sprintf("%v", h.PaidCapacity))
+	builder.WriteString(", ")
+	builder.WriteString("direction_code=")
+	builder.WriteString(h.DirectionCode)
+	builder.WriteString(", ")
+	builder.WriteString("level=")
+	builder.WriteString(h.Level)
+	builder.WriteString(", ")
+	builder.WriteString("study_form=")
+	builder.WriteString(h.StudyForm)
+	builder.WriteString(", ")
+	builder.WriteString("exams=")
+	builder.WriteString(fmt.Sprintf("%v", h.Exams))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -24,6 +24,20 @@ const (
 	FieldCode = "code"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
+	// FieldSubjects holds the string denoting the subjects field in the database.
+	FieldSubjects = "subjects"
+	// FieldTargetSubQuotas holds the string denoting the target_sub_quotas field in the database.
+	FieldTargetSubQuotas = "target_sub_quotas"
+	// FieldPaidCapacity holds the string denoting the paid_capacity field in the database.
+	FieldPaidCapacity = "paid_capacity"
+	// FieldDirectionCode holds the string denoting the direction_code field in the database.
+	FieldDirectionCode = "direction_code"
+	// FieldLevel holds the string denoting the level field in the database.
+	FieldLevel = "level"
+	// FieldStudyForm holds the string denoting the study_form field in the database.
+	FieldStudyForm = "study_form"
+	// FieldExams holds the string denoting the exams field in the database.
+	FieldExams = "exams"
 	// EdgeVarsity holds the string denoting the varsity edge name in mutations.
 	EdgeVarsity = "varsity"
 	// EdgeApplications holds the string denoting the applications edge name in mutations.
@@ -32,6 +46,10 @@ const (
 	EdgeCalculations = "calculations"
 	// EdgeDrainedResults holds the string denoting the drained_results edge name in mutations.
 	EdgeDrainedResults = "drained_results"
+	// EdgeAdmissionChances holds the string denoting the admission_chances edge name in mutations.
+	EdgeAdmissionChances = "admission_chances"
+	// EdgeAdmissionEvents holds the string denoting the admission_events edge name in mutations.
+	EdgeAdmissionEvents = "admission_events"
 	// Table holds the table name of the heading in the database.
 	Table = "headings"
 	// VarsityTable is the table that holds the varsity relation/edge.
@@ -62,6 +80,20 @@ const (
 	DrainedResultsInverseTable = "drained_results"
 	// DrainedResultsColumn is the table column denoting the drained_results relation/edge.
 	DrainedResultsColumn = "heading_drained_results"
+	// AdmissionChancesTable is the table that holds the admission_chances relation/edge.
+	AdmissionChancesTable = "admission_chances"
+	// AdmissionChancesInverseTable is the table name for the AdmissionChance entity.
+	// It exists in this package in order to avoid circular dependency with the "admissionchance" package.
+	AdmissionChancesInverseTable = "admission_chances"
+	// AdmissionChancesColumn is the table column denoting the admission_chances relation/edge.
+	AdmissionChancesColumn = "heading_admission_chances"
+	// AdmissionEventsTable is the table that holds the admission_events relation/edge.
+	AdmissionEventsTable = "admission_events"
+	// AdmissionEventsInverseTable is the table name for the AdmissionEvent entity.
+	// It exists in this package in order to avoid circular dependency with the "admissionevent" package.
+	AdmissionEventsInverseTable = "admission_events"
+	// AdmissionEventsColumn is the table column denoting the admission_events relation/edge.
+	AdmissionEventsColumn = "heading_admission_events"
 )
 
 // Columns holds all SQL columns for heading fields.
@@ -73,6 +105,13 @@ var Columns = []string{
 	FieldSpecialQuotaCapacity,
 	FieldCode,
 	FieldName,
+	FieldSubjects,
+	FieldTargetSubQuotas,
+	FieldPaidCapacity,
+	FieldDirectionCode,
+	FieldLevel,
+	FieldStudyForm,
+	FieldExams,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "headings"
@@ -96,6 +135,17 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+var (
+	// DefaultPaidCapacity holds the default value on creation for the "paid_capacity" field.
+	DefaultPaidCapacity int
+	// DefaultDirectionCode holds the default value on creation for the "direction_code" field.
+	DefaultDirectionCode string
+	// DefaultLevel holds the default value on creation for the "level" field.
+	DefaultLevel string
+	// DefaultStudyForm holds the default value on creation for the "study_form" field.
+	DefaultStudyForm string
+)
+
 // OrderOption defines the ordering options for the Heading queries.
 type OrderOption func(*sql.Selector)
 
@@ -134,6 +184,26 @@ func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
 }
 
+// ByPaidCapacity orders the results by the paid_capacity field.
+func ByPaidCapacity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaidCapacity, opts...).ToFunc()
+}
+
+// ByDirectionCode orders the results by the direction_code field.
+func ByDirectionCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDirectionCode, opts...).ToFunc()
+}
+
+// ByLevel orders the results by the level field.
+func ByLevel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLevel, opts...).ToFunc()
+}
+
+// ByStudyForm orders the results by the study_form field.
+func ByStudyForm(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStudyForm, opts...).ToFunc()
+}
+
 // ByVarsityField orders the results by varsity field.
 func ByVarsityField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -182,6 +252,34 @@ func ByDrainedResults(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newDrainedResultsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByAdmissionChancesCount orders the results by admission_chances count.
+func ByAdmissionChancesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAdmissionChancesStep(), opts...)
+	}
+}
+
+// ByAdmissionChances orders the results by admission_chances terms.
+func ByAdmissionChances(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAdmissionChancesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAdmissionEventsCount orders the results by admission_events count.
+func ByAdmissionEventsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAdmissionEventsStep(), opts...)
+	}
+}
+
+// ByAdmissionEvents orders the results by admission_events terms.
+func ByAdmissionEvents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAdmissionEventsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newVarsityStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -210,3 +308,17 @@ func newDrainedResultsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, DrainedResultsTable, DrainedResultsColumn),
 	)
 }
+func newAdmissionChancesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AdmissionChancesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AdmissionChancesTable, AdmissionChancesColumn),
+	)
+}
+func newAdmissionEventsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AdmissionEventsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AdmissionEventsTable, AdmissionEventsColumn),
+	)
+}
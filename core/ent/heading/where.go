@@ -83,6 +83,26 @@ func Name(v string) predicate.Heading {
 	return predicate.Heading(sql.FieldEQ(FieldName, v))
 }
 
+// PaidCapacity applies equality check predicate on the "paid_capacity" field. It's identical to PaidCapacityEQ.
+func PaidCapacity(v int) predicate.Heading {
+	return predicate.Heading(sql.FieldEQ(FieldPaidCapacity, v))
+}
+
+// DirectionCode applies equality check predicate on the "direction_code" field. It's identical to DirectionCodeEQ.
+func DirectionCode(v string) predicate.Heading {
+	return predicate.Heading(sql.FieldEQ(FieldDirectionCode, v))
+}
+
+// Level applies equality check predicate on the "level" field. It's identical to LevelEQ.
+func Level(v string) predicate.Heading {
+	return predicate.Heading(sql.FieldEQ(FieldLevel, v))
+}
+
+// StudyForm applies equality check predicate on the "study_form" field. It's identical to StudyFormEQ.
+func StudyForm(v string) predicate.Heading {
+	return predicate.Heading(sql.FieldEQ(FieldStudyForm, v))
+}
+
 // RegularCapacityEQ applies the EQ predicate on the "regular_capacity" field.
 func RegularCapacityEQ(v int) predicate.Heading {
 	return predicate.Heading(sql.FieldEQ(FieldRegularCapacity, v))
@@ -373,6 +393,271 @@ func NameContainsFold(v string) predicate.Heading {
 	return predicate.Heading(sql.FieldContainsFold(FieldName, v))
 }
 
+// SubjectsIsNil applies the IsNil predicate on the "subjects" field.
+func SubjectsIsNil() predicate.Heading {
+	return predicate.Heading(sql.FieldIsNull(FieldSubjects))
+}
+
+// SubjectsNotNil applies the NotNil predicate on the "subjects" field.
+func SubjectsNotNil() predicate.Heading {
+	return predicate.Heading(sql.FieldNotNull(FieldSubjects))
+}
+
+// TargetSubQuotasIsNil applies the IsNil predicate on the "target_sub_quotas" field.
+func TargetSubQuotasIsNil() predicate.Heading {
+	return predicate.Heading(sql.FieldIsNull(FieldTargetSubQuotas))
+}
+
+// TargetSubQuotasNotNil applies the NotNil predicate on the "target_sub_quotas" field.
+func TargetSubQuotasNotNil() predicate.Heading {
+	return predicate.Heading(sql.FieldNotNull(FieldTargetSubQuotas))
+}
+
+// PaidCapacityEQ applies the EQ predicate on the "paid_capacity" field.
+func PaidCapacityEQ(v int) predicate.Heading {
+	return predicate.Heading(sql.FieldEQ(FieldPaidCapacity, v))
+}
+
+// PaidCapacityNEQ applies the NEQ predicate on the "paid_capacity" field.
+func PaidCapacityNEQ(v int) predicate.Heading {
+	return predicate.Heading(sql.FieldNEQ(FieldPaidCapacity, v))
+}
+
+// PaidCapacityIn applies the In predicate on the "paid_capacity" field.
+func PaidCapacityIn(vs ...int) predicate.Heading {
+	return predicate.Heading(sql.FieldIn(FieldPaidCapacity, vs...))
+}
+
+// PaidCapacityNotIn applies the NotIn predicate on the "paid_capacity" field.
+func PaidCapacityNotIn(vs ...int) predicate.Heading {
+	return predicate.Heading(sql.FieldNotIn(FieldPaidCapacity, vs...))
+}
+
+// PaidCapacityGT applies the GT predicate on the "paid_capacity" field.
+func PaidCapacityGT(v int) predicate.Heading {
+	return predicate.Heading(sql.FieldGT(FieldPaidCapacity, v))
+}
+
+// PaidCapacityGTE applies the GTE predicate on the "paid_capacity" field.
+func PaidCapacityGTE(v int) predicate.Heading {
+	return predicate.Heading(sql.FieldGTE(FieldPaidCapacity, v))
+}
+
+// PaidCapacityLT applies the LT predicate on the "paid_capacity" field.
+func PaidCapacityLT(v int) predicate.Heading {
+	return predicate.Heading(sql.FieldLT(FieldPaidCapacity, v))
+}
+
+// PaidCapacityLTE applies the LTE predicate on the "paid_capacity" field.
+func PaidCapacityLTE(v int) predicate.Heading {
+	return predicate.Heading(sql.FieldLTE(FieldPaidCapacity, v))
+}
+
+// DirectionCodeEQ applies the EQ predicate on the "direction_code" field.
+func DirectionCodeEQ(v string) predicate.Heading {
+	return predicate.Heading(sql.FieldEQ(FieldDirectionCode, v))
+}
+
+// DirectionCodeNEQ applies the NEQ predicate on the "direction_code" field.
+func DirectionCodeNEQ(v string) predicate.Heading {
+	return predicate.Heading(sql.FieldNEQ(FieldDirectionCode, v))
+}
+
+// DirectionCodeIn applies the In predicate on the "direction_code" field.
+func DirectionCodeIn(vs ...string) predicate.Heading {
+	return predicate.Heading(sql.FieldIn(FieldDirectionCode, vs...))
+}
+
+// DirectionCodeNotIn applies the NotIn predicate on the "direction_code" field.
+func DirectionCodeNotIn(vs ...string) predicate.Heading {
+	return predicate.Heading(sql.FieldNotIn(FieldDirectionCode, vs...))
+}
+
+// DirectionCodeGT applies the GT predicate on the "direction_code" field.
+func DirectionCodeGT(v string) predicate.Heading {
+	return predicate.Heading(sql.FieldGT(FieldDirectionCode, v))
+}
+
+// DirectionCodeGTE applies the GTE predicate on the "direction_code" field.
+func DirectionCodeGTE(v string) predicate.Heading {
+	return predicate.Heading(sql.FieldGTE(FieldDirectionCode, v))
+}
+
+// DirectionCodeLT applies the LT predicate on the "direction_code" field.
+func DirectionCodeLT(v string) predicate.Heading {
+	return predicate.Heading(sql.FieldLT(FieldDirectionCode, v))
+}
+
+// DirectionCodeLTE applies the LTE predicate on the "direction_code" field.
+func DirectionCodeLTE(v string) predicate.Heading {
+	return predicate.Heading(sql.FieldLTE(FieldDirectionCode, v))
+}
+
+// DirectionCodeContains applies the Contains predicate on the "direction_code" field.
+func DirectionCodeContains(v string) predicate.Heading {
+	return predicate.Heading(sql.FieldContains(FieldDirectionCode, v))
+}
+
+// DirectionCodeHasPrefix applies the HasPrefix predicate on the "direction_code" field.
+func DirectionCodeHasPrefix(v string) predicate.Heading {
+	return predicate.Heading(sql.FieldHasPrefix(FieldDirectionCode, v))
+}
+
+// DirectionCodeHasSuffix applies the HasSuffix predicate on the "direction_code" field.
+func DirectionCodeHasSuffix(v string) predicate.Heading {
+	return predicate.Heading(sql.FieldHasSuffix(FieldDirectionCode, v))
+}
+
+// DirectionCodeEqualFold applies the EqualFold predicate on the "direction_code" field.
+func DirectionCodeEqualFold(v string) predicate.Heading {
+	return predicate.Heading(sql.FieldEqualFold(FieldDirectionCode, v))
+}
+
+// DirectionCodeContainsFold applies the ContainsFold predicate on the "direction_code" field.
+func DirectionCodeContainsFold(v string) predicate.Heading {
+	return predicate.Heading(sql.FieldContainsFold(FieldDirectionCode, v))
+}
+
+// LevelEQ applies the EQ predicate on the "level" field.
+func LevelEQ(v string) predicate.Heading {
+	return predicate.Heading(sql.FieldEQ(FieldLevel, v))
+}
+
+// LevelNEQ applies the NEQ predicate on the "level" field.
+func LevelNEQ(v string) predicate.Heading {
+	return predicate.Heading(sql.FieldNEQ(FieldLevel, v))
+}
+
+// LevelIn applies the In predicate on the "level" field.
+func LevelIn(vs ...string) predicate.Heading {
+	return predicate.Heading(sql.FieldIn(FieldLevel, vs...))
+}
+
+// LevelNotIn applies the NotIn predicate on the "level" field.
+func LevelNotIn(vs ...string) predicate.Heading {
+	return predicate.Heading(sql.FieldNotIn(FieldLevel, vs...))
+}
+
+// LevelGT applies the GT predicate on the "level" field.
+func LevelGT(v string) predicate.Heading {
+	return predicate.Heading(sql.FieldGT(FieldLevel, v))
+}
+
+// LevelGTE applies the GTE predicate on the "level" field.
+func LevelGTE(v string) predicate.Heading {
+	return predicate.Heading(sql.FieldGTE(FieldLevel, v))
+}
+
+// LevelLT applies the LT predicate on the "level" field.
+func LevelLT(v string) predicate.Heading {
+	return predicate.Heading(sql.FieldLT(FieldLevel, v))
+}
+
+// LevelLTE applies the LTE predicate on the "level" field.
+func LevelLTE(v string) predicate.Heading {
+	return predicate.Heading(sql.FieldLTE(FieldLevel, v))
+}
+
+// LevelContains applies the Contains predicate on the "level" field.
+func LevelContains(v string) predicate.Heading {
+	return predicate.Heading(sql.FieldContains(FieldLevel, v))
+}
+
+// LevelHasPrefix applies the HasPrefix predicate on the "level" field.
+func LevelHasPrefix(v string) predicate.Heading {
+	return predicate.Heading(sql.FieldHasPrefix(FieldLevel, v))
+}
+
+// LevelHasSuffix applies the HasSuffix predicate on the "level" field.
+func LevelHasSuffix(v string) predicate.Heading {
+	return predicate.Heading(sql.FieldHasSuffix(FieldLevel, v))
+}
+
+// LevelEqualFold applies the EqualFold predicate on the "level" field.
+func LevelEqualFold(v string) predicate.Heading {
+	return predicate.Heading(sql.FieldEqualFold(FieldLevel, v))
+}
+
+// LevelContainsFold applies the ContainsFold predicate on the "level" field.
+func LevelContainsFold(v string) predicate.Heading {
+	return predicate.Heading(sql.FieldContainsFold(FieldLevel, v))
+}
+
+// StudyFormEQ applies the EQ predicate on the "study_form" field.
+func StudyFormEQ(v string) predicate.Heading {
+	return predicate.Heading(sql.FieldEQ(FieldStudyForm, v))
+}
+
+// StudyFormNEQ applies the NEQ predicate on the "study_form" field.
+func StudyFormNEQ(v string) predicate.Heading {
+	return predicate.Heading(sql.FieldNEQ(FieldStudyForm, v))
+}
+
+// StudyFormIn applies the In predicate on the "study_form" field.
+func StudyFormIn(vs ...string) predicate.Heading {
+	return predicate.Heading(sql.FieldIn(FieldStudyForm, vs...))
+}
+
+// StudyFormNotIn applies the NotIn predicate on the "study_form" field.
+func StudyFormNotIn(vs ...string) predicate.Heading {
+	return predicate.Heading(sql.FieldNotIn(FieldStudyForm, vs...))
+}
+
+// StudyFormGT applies the GT predicate on the "study_form" field.
+func StudyFormGT(v string) predicate.Heading {
+	return predicate.Heading(sql.FieldGT(FieldStudyForm, v))
+}
+
+// StudyFormGTE applies the GTE predicate on the "study_form" field.
+func StudyFormGTE(v string) predicate.Heading {
+	return predicate.Heading(sql.FieldGTE(FieldStudyForm, v))
+}
+
+// StudyFormLT applies the LT predicate on the "study_form" field.
+func StudyFormLT(v string) predicate.Heading {
+	return predicate.Heading(sql.FieldLT(FieldStudyForm, v))
+}
+
+// StudyFormLTE applies the LTE predicate on the "study_form" field.
+func StudyFormLTE(v string) predicate.Heading {
+	return predicate.Heading(sql.FieldLTE(FieldStudyForm, v))
+}
+
+// StudyFormContains applies the Contains predicate on the "study_form" field.
+func StudyFormContains(v string) predicate.Heading {
+	return predicate.Heading(sql.FieldContains(FieldStudyForm, v))
+}
+
+// StudyFormHasPrefix applies the HasPrefix predicate on the "study_form" field.
+func StudyFormHasPrefix(v string) predicate.Heading {
+	return predicate.Heading(sql.FieldHasPrefix(FieldStudyForm, v))
+}
+
+// StudyFormHasSuffix applies the HasSuffix predicate on the "study_form" field.
+func StudyFormHasSuffix(v string) predicate.Heading {
+	return predicate.Heading(sql.FieldHasSuffix(FieldStudyForm, v))
+}
+
+// StudyFormEqualFold applies the EqualFold predicate on the "study_form" field.
+func StudyFormEqualFold(v string) predicate.Heading {
+	return predicate.Heading(sql.FieldEqualFold(FieldStudyForm, v))
+}
+
+// StudyFormContainsFold applies the ContainsFold predicate on the "study_form" field.
+func StudyFormContainsFold(v string) predicate.Heading {
+	return predicate.Heading(sql.FieldContainsFold(FieldStudyForm, v))
+}
+
+// ExamsIsNil applies the IsNil predicate on the "exams" field.
+func ExamsIsNil() predicate.Heading {
+	return predicate.Heading(sql.FieldIsNull(FieldExams))
+}
+
+// ExamsNotNil applies the NotNil predicate on the "exams" field.
+func ExamsNotNil() predicate.Heading {
+	return predicate.Heading(sql.FieldNotNull(FieldExams))
+}
+
 // HasVarsity applies the HasEdge predicate on the "varsity" edge.
 func HasVarsity() predicate.Heading {
 	return predicate.Heading(func(s *sql.Selector) {
@@ -465,6 +750,52 @@ func HasDrainedResultsWith(preds ...predicate.DrainedResult) predicate.Heading {
 	})
 }
 
+// HasAdmissionChances applies the HasEdge predicate on the "admission_chances" edge.
+func HasAdmissionChances() predicate.Heading {
+	return predicate.Heading(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AdmissionChancesTable, AdmissionChancesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAdmissionChancesWith applies the HasEdge predicate on the "admission_chances" edge with a given conditions (other predicates).
+func HasAdmissionChancesWith(preds ...predicate.AdmissionChance) predicate.Heading {
+	return predicate.Heading(func(s *sql.Selector) {
+		step := newAdmissionChancesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAdmissionEvents applies the HasEdge predicate on the "admission_events" edge.
+func HasAdmissionEvents() predicate.Heading {
+	return predicate.Heading(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AdmissionEventsTable, AdmissionEventsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAdmissionEventsWith applies the HasEdge predicate on the "admission_events" edge with a given conditions (other predicates).
+func HasAdmissionEventsWith(preds ...predicate.AdmissionEvent) predicate.Heading {
+	return predicate.Heading(func(s *sql.Selector) {
+		step := newAdmissionEventsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Heading) predicate.Heading {
 	return predicate.Heading(sql.AndPredicates(predicates...))
@@ -9,6 +9,9 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/ent/admissionchance"
+	"github.com/trueegorletov/analabit/core/ent/admissionevent"
 	"github.com/trueegorletov/analabit/core/ent/application"
 	"github.com/trueegorletov/analabit/core/ent/calculation"
 	"github.com/trueegorletov/analabit/core/ent/drainedresult"
@@ -59,6 +62,80 @@ func (hc *HeadingCreate) SetName(s string) *HeadingCreate {
 	return hc
 }
 
+// SetSubjects sets the "subjects" field.
+func (hc *HeadingCreate) SetSubjects(s []string) *HeadingCreate {
+	hc.mutation.SetSubjects(s)
+	return hc
+}
+
+// SetTargetSubQuotas sets the "target_sub_quotas" field.
+func (hc *HeadingCreate) SetTargetSubQuotas(m map[string]int) *HeadingCreate {
+	hc.mutation.SetTargetSubQuotas(m)
+	return hc
+}
+
+// SetPaidCapacity sets the "paid_capacity" field.
+func (hc *HeadingCreate) SetPaidCapacity(i int) *HeadingCreate {
+	hc.mutation.SetPaidCapacity(i)
+	return hc
+}
+
+// SetNillablePaidCapacity sets the "paid_capacity" field if the given value is not nil.
+func (hc *HeadingCreate) SetNillablePaidCapacity(i *int) *HeadingCreate {
+	if i != nil {
+		hc.SetPaidCapacity(*i)
+	}
+	return hc
+}
+
+// SetDirectionCode sets the "direction_code" field.
+func (hc *HeadingCreate) SetDirectionCode(s string) *HeadingCreate {
+	hc.mutation.SetDirectionCode(s)
+	return hc
+}
+
+// SetNillableDirectionCode sets the "direction_code" field if the given value is not nil.
+func (hc *HeadingCreate) SetNillableDirectionCode(s *string) *HeadingCreate {
+	if s != nil {
+		hc.SetDirectionCode(*s)
+	}
+	return hc
+}
+
+// SetLevel sets the "level" field.
+func (hc *HeadingCreate) SetLevel(s string) *HeadingCreate {
+	hc.mutation.SetLevel(s)
+	return hc
+}
+
+// SetNillableLevel sets the "level" field if the given value is not nil.
+func (hc *HeadingCreate) SetNillableLevel(s *string) *HeadingCreate {
+	if s != nil {
+		hc.SetLevel(*s)
+	}
+	return hc
+}
+
+// SetStudyForm sets the "study_form" field.
+func (hc *HeadingCreate) SetStudyForm(s string) *HeadingCreate {
+	hc.mutation.SetStudyForm(s)
+	return hc
+}
+
+// SetNillableStudyForm sets the "study_form" field if the given value is not nil.
+func (hc *HeadingCreate) SetNillableStudyForm(s *string) *HeadingCreate {
+	if s != nil {
+		hc.SetStudyForm(*s)
+	}
+	return hc
+}
+
+// SetExams sets the "exams" field.
+func (hc *HeadingCreate) SetExams(ce []core.EntranceExam) *HeadingCreate {
+	hc.mutation.SetExams(ce)
+	return hc
+}
+
 // SetVarsityID sets the "varsity" edge to the Varsity entity by ID.
 func (hc *HeadingCreate) SetVarsityID(id int) *HeadingCreate {
 	hc.mutation.SetVarsityID(id)
@@ -115,6 +192,36 @@ func (hc *HeadingCreate) AddDrainedResults(d ...*DrainedResult) *HeadingCreate {
 	return hc.AddDrainedResultIDs(ids...)
 }
 
+// AddAdmissionChanceIDs adds the "admission_chances" edge to the AdmissionChance entity by IDs.
+func (hc *HeadingCreate) AddAdmissionChanceIDs(ids ...int) *HeadingCreate {
+	hc.mutation.AddAdmissionChanceIDs(ids...)
+	return hc
+}
+
+// AddAdmissionChances adds the "admission_chances" edges to the AdmissionChance entity.
+func (hc *HeadingCreate) AddAdmissionChances(a ...*AdmissionChance) *HeadingCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return hc.AddAdmissionChanceIDs(ids...)
+}
+
+// AddAdmissionEventIDs adds the "admission_events" edge to the AdmissionEvent entity by IDs.
+func (hc *HeadingCreate) AddAdmissionEventIDs(ids ...int) *HeadingCreate {
+	hc.mutation.AddAdmissionEventIDs(ids...)
+	return hc
+}
+
+// AddAdmissionEvents adds the "admission_events" edges to the AdmissionEvent entity.
+func (hc *HeadingCreate) AddAdmissionEvents(a ...*AdmissionEvent) *HeadingCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return hc.AddAdmissionEventIDs(ids...)
+}
+
 // Mutation returns the HeadingMutation object of the builder.
 func (hc *HeadingCreate) Mutation() *HeadingMutation {
 	return hc.mutation
@@ -122,6 +229,7 @@ func (hc *HeadingCreate) Mutation() *HeadingMutation {
 
 // Save creates the Heading in the database.
 func (hc *HeadingCreate) Save(ctx context.Context) (*Heading, error) {
+	hc.defaults()
 	return withHooks(ctx, hc.sqlSave, hc.mutation, hc.hooks)
 }
 
@@ -147,6 +255,26 @@ func (hc *HeadingCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (hc *HeadingCreate) defaults() {
+	if _, ok := hc.mutation.PaidCapacity(); !ok {
+		v := heading.DefaultPaidCapacity
+		hc.mutation.SetPaidCapacity(v)
+	}
+	if _, ok := hc.mutation.DirectionCode(); !ok {
+		v := heading.DefaultDirectionCode
+		hc.mutation.SetDirectionCode(v)
+	}
+	if _, ok := hc.mutation.Level(); !ok {
+		v := heading.DefaultLevel
+		hc.mutation.SetLevel(v)
+	}
+	if _, ok := hc.mutation.StudyForm(); !ok {
+		v := heading.DefaultStudyForm
+		hc.mutation.SetStudyForm(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (hc *HeadingCreate) check() error {
 	if _, ok := hc.mutation.RegularCapacity(); !ok {
@@ -167,6 +295,18 @@ func (hc *HeadingCreate) check() error {
 	if _, ok := hc.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Heading.name"`)}
 	}
+	if _, ok := hc.mutation.PaidCapacity(); !ok {
+		return &ValidationError{Name: "paid_capacity", err: errors.New(`ent: missing required field "Heading.paid_capacity"`)}
+	}
+	if _, ok := hc.mutation.DirectionCode(); !ok {
+		return &ValidationError{Name: "direction_code", err: errors.New(`ent: missing required field "Heading.direction_code"`)}
+	}
+	if _, ok := hc.mutation.Level(); !ok {
+		return &ValidationError{Name: "level", err: errors.New(`ent: missing required field "Heading.level"`)}
+	}
+	if _, ok := hc.mutation.StudyForm(); !ok {
+		return &ValidationError{Name: "study_form", err: errors.New(`ent: missing required field "Heading.study_form"`)}
+	}
 	if len(hc.mutation.VarsityIDs()) == 0 {
 		return &ValidationError{Name: "varsity", err: errors.New(`ent: missing required edge "Heading.varsity"`)}
 	}
@@ -220,6 +360,34 @@ func (hc *HeadingCreate) createSpec() (*Heading, *sqlgraph.CreateSpec) {
 		_spec.SetField(heading.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
+	if value, ok := hc.mutation.Subjects(); ok {
+		_spec.SetField(heading.FieldSubjects, field.TypeJSON, value)
+		_node.Subjects = value
+	}
+	if value, ok := hc.mutation.TargetSubQuotas(); ok {
+		_spec.SetField(heading.FieldTargetSubQuotas, field.TypeJSON, value)
+		_node.TargetSubQuotas = value
+	}
+	if value, ok := hc.mutation.PaidCapacity(); ok {
+		_spec.SetField(heading.FieldPaidCapacity, field.TypeInt, value)
+		_node.PaidCapacity = value
+	}
+	if value, ok := hc.mutation.DirectionCode(); ok {
+		_spec.SetField(heading.FieldDirectionCode, field.TypeString, value)
+		_node.DirectionCode = value
+	}
+	if value, ok := hc.mutation.Level(); ok {
+		_spec.SetField(heading.FieldLevel, field.TypeString, value)
+		_node.Level = value
+	}
+	if value, ok := hc.mutation.StudyForm(); ok {
+		_spec.SetField(heading.FieldStudyForm, field.TypeString, value)
+		_node.StudyForm = value
+	}
+	if value, ok := hc.mutation.Exams(); ok {
+		_spec.SetField(heading.FieldExams, field.TypeJSON, value)
+		_node.Exams = value
+	}
 	if nodes := hc.mutation.VarsityIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -285,6 +453,38 @@ func (hc *HeadingCreate) createSpec() (*Heading, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := hc.mutation.AdmissionChancesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   heading.AdmissionChancesTable,
+			Columns: []string{heading.AdmissionChancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(admissionchance.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := hc.mutation.AdmissionEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   heading.AdmissionEventsTable,
+			Columns: []string{heading.AdmissionEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(admissionevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -306,6 +506,7 @@ func (hcb *HeadingCreateBulk) Save(ctx context.Context) ([]*Heading, error) {
 	for i := range hcb.builders {
 		func(i int, root context.Context) {
 			builder := hcb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*HeadingMutation)
 				if !ok {
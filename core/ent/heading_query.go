@@ -12,6 +12,8 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/trueegorletov/analabit/core/ent/admissionchance"
+	"github.com/trueegorletov/analabit/core/ent/admissionevent"
 	"github.com/trueegorletov/analabit/core/ent/application"
 	"github.com/trueegorletov/analabit/core/ent/calculation"
 	"github.com/trueegorletov/analabit/core/ent/drainedresult"
@@ -23,15 +25,17 @@ import (
 // HeadingQuery is the builder for querying Heading entities.
 type HeadingQuery struct {
 	config
-	ctx                *QueryContext
-	order              []heading.OrderOption
-	inters             []Interceptor
-	predicates         []predicate.Heading
-	withVarsity        *VarsityQuery
-	withApplications   *ApplicationQuery
-	withCalculations   *CalculationQuery
-	withDrainedResults *DrainedResultQuery
-	withFKs            bool
+	ctx                  *QueryContext
+	order                []heading.OrderOption
+	inters               []Interceptor
+	predicates           []predicate.Heading
+	withVarsity          *VarsityQuery
+	withApplications     *ApplicationQuery
+	withCalculations     *CalculationQuery
+	withDrainedResults   *DrainedResultQuery
+	withAdmissionChances *AdmissionChanceQuery
+	withAdmissionEvents  *AdmissionEventQuery
+	withFKs              bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -156,6 +160,50 @@ func (hq *HeadingQuery) QueryDrainedResults() *DrainedResultQuery {
 	return query
 }
 
+// QueryAdmissionChances chains the current query on the "admission_chances" edge.
+func (hq *HeadingQuery) QueryAdmissionChances() *AdmissionChanceQuery {
+	query := (&AdmissionChanceClient{config: hq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := hq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := hq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(heading.Table, heading.FieldID, selector),
+			sqlgraph.To(admissionchance.Table, admissionchance.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, heading.AdmissionChancesTable, heading.AdmissionChancesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(hq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAdmissionEvents chains the current query on the "admission_events" edge.
+func (hq *HeadingQuery) QueryAdmissionEvents() *AdmissionEventQuery {
+	query := (&AdmissionEventClient{config: hq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := hq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := hq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(heading.Table, heading.FieldID, selector),
+			sqlgraph.To(admissionevent.Table, admissionevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, heading.AdmissionEventsTable, heading.AdmissionEventsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(hq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Heading entity from the query.
 // Returns a *NotFoundError when no Heading was found.
 func (hq *HeadingQuery) First(ctx context.Context) (*Heading, error) {
@@ -343,15 +391,17 @@ func (hq *HeadingQuery) Clone() *HeadingQuery {
 		return nil
 	}
 	return &HeadingQuery{
-		config:             hq.config,
-		ctx:                hq.ctx.Clone(),
-		order:              append([]heading.OrderOption{}, hq.order...),
-		inters:             append([]Interceptor{}, hq.inters...),
-		predicates:         append([]predicate.Heading{}, hq.predicates...),
-		withVarsity:        hq.withVarsity.Clone(),
-		withApplications:   hq.withApplications.Clone(),
-		withCalculations:   hq.withCalculations.Clone(),
-		withDrainedResults: hq.withDrainedResults.Clone(),
+		config:               hq.config,
+		ctx:                  hq.ctx.Clone(),
+		order:                append([]heading.OrderOption{}, hq.order...),
+		inters:               append([]Interceptor{}, hq.inters...),
+		predicates:           append([]predicate.Heading{}, hq.predicates...),
+		withVarsity:          hq.withVarsity.Clone(),
+		withApplications:     hq.withApplications.Clone(),
+		withCalculations:     hq.withCalculations.Clone(),
+		withDrainedResults:   hq.withDrainedResults.Clone(),
+		withAdmissionChances: hq.withAdmissionChances.Clone(),
+		withAdmissionEvents:  hq.withAdmissionEvents.Clone(),
 		// clone intermediate query.
 		sql:  hq.sql.Clone(),
 		path: hq.path,
@@ -402,6 +452,28 @@ func (hq *HeadingQuery) WithDrainedResults(opts ...func(*DrainedResultQuery)) *H
 	return hq
 }
 
+// WithAdmissionChances tells the query-builder to eager-load the nodes that are connected to
+// the "admission_chances" edge. The optional arguments are used to configure the query builder of the edge.
+func (hq *HeadingQuery) WithAdmissionChances(opts ...func(*AdmissionChanceQuery)) *HeadingQuery {
+	query := (&AdmissionChanceClient{config: hq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	hq.withAdmissionChances = query
+	return hq
+}
+
+// WithAdmissionEvents tells the query-builder to eager-load the nodes that are connected to
+// the "admission_events" edge. The optional arguments are used to configure the query builder of the edge.
+func (hq *HeadingQuery) WithAdmissionEvents(opts ...func(*AdmissionEventQuery)) *HeadingQuery {
+	query := (&AdmissionEventClient{config: hq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	hq.withAdmissionEvents = query
+	return hq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -481,11 +553,13 @@ func (hq *HeadingQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Head
 		nodes       = []*Heading{}
 		withFKs     = hq.withFKs
 		_spec       = hq.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [6]bool{
 			hq.withVarsity != nil,
 			hq.withApplications != nil,
 			hq.withCalculations != nil,
 			hq.withDrainedResults != nil,
+			hq.withAdmissionChances != nil,
+			hq.withAdmissionEvents != nil,
 		}
 	)
 	if hq.withVarsity != nil {
@@ -539,6 +613,20 @@ func (hq *HeadingQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Head
 			return nil, err
 		}
 	}
+	if query := hq.withAdmissionChances; query != nil {
+		if err := hq.loadAdmissionChances(ctx, query, nodes,
+			func(n *Heading) { n.Edges.AdmissionChances = []*AdmissionChance{} },
+			func(n *Heading, e *AdmissionChance) { n.Edges.AdmissionChances = append(n.Edges.AdmissionChances, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := hq.withAdmissionEvents; query != nil {
+		if err := hq.loadAdmissionEvents(ctx, query, nodes,
+			func(n *Heading) { n.Edges.AdmissionEvents = []*AdmissionEvent{} },
+			func(n *Heading, e *AdmissionEvent) { n.Edges.AdmissionEvents = append(n.Edges.AdmissionEvents, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -667,6 +755,68 @@ func (hq *HeadingQuery) loadDrainedResults(ctx context.Context, query *DrainedRe
 	}
 	return nil
 }
+func (hq *HeadingQuery) loadAdmissionChances(ctx context.Context, query *AdmissionChanceQuery, nodes []*Heading, init func(*Heading), assign func(*Heading, *AdmissionChance)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Heading)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.AdmissionChance(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(heading.AdmissionChancesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.heading_admission_chances
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "heading_admission_chances" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "heading_admission_chances" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (hq *HeadingQuery) loadAdmissionEvents(ctx context.Context, query *AdmissionEventQuery, nodes []*Heading, init func(*Heading), assign func(*Heading, *AdmissionEvent)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Heading)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.AdmissionEvent(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(heading.AdmissionEventsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.heading_admission_events
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "heading_admission_events" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "heading_admission_events" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (hq *HeadingQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := hq.querySpec()
@@ -9,7 +9,11 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/ent/admissionchance"
+	"github.com/trueegorletov/analabit/core/ent/admissionevent"
 	"github.com/trueegorletov/analabit/core/ent/application"
 	"github.com/trueegorletov/analabit/core/ent/calculation"
 	"github.com/trueegorletov/analabit/core/ent/drainedresult"
@@ -143,6 +147,117 @@ func (hu *HeadingUpdate) SetNillableName(s *string) *HeadingUpdate {
 	return hu
 }
 
+// SetSubjects sets the "subjects" field.
+func (hu *HeadingUpdate) SetSubjects(s []string) *HeadingUpdate {
+	hu.mutation.SetSubjects(s)
+	return hu
+}
+
+// AppendSubjects appends s to the "subjects" field.
+func (hu *HeadingUpdate) AppendSubjects(s []string) *HeadingUpdate {
+	hu.mutation.AppendSubjects(s)
+	return hu
+}
+
+// ClearSubjects clears the value of the "subjects" field.
+func (hu *HeadingUpdate) ClearSubjects() *HeadingUpdate {
+	hu.mutation.ClearSubjects()
+	return hu
+}
+
+// SetTargetSubQuotas sets the "target_sub_quotas" field.
+func (hu *HeadingUpdate) SetTargetSubQuotas(m map[string]int) *HeadingUpdate {
+	hu.mutation.SetTargetSubQuotas(m)
+	return hu
+}
+
+// ClearTargetSubQuotas clears the value of the "target_sub_quotas" field.
+func (hu *HeadingUpdate) ClearTargetSubQuotas() *HeadingUpdate {
+	hu.mutation.ClearTargetSubQuotas()
+	return hu
+}
+
+// SetPaidCapacity sets the "paid_capacity" field.
+func (hu *HeadingUpdate) SetPaidCapacity(i int) *HeadingUpdate {
+	hu.mutation.ResetPaidCapacity()
+	hu.mutation.SetPaidCapacity(i)
+	return hu
+}
+
+// SetNillablePaidCapacity sets the "paid_capacity" field if the given value is not nil.
+func (hu *HeadingUpdate) SetNillablePaidCapacity(i *int) *HeadingUpdate {
+	if i != nil {
+		hu.SetPaidCapacity(*i)
+	}
+	return hu
+}
+
+// AddPaidCapacity adds i to the "paid_capacity" field.
+func (hu *HeadingUpdate) AddPaidCapacity(i int) *HeadingUpdate {
+	hu.mutation.AddPaidCapacity(i)
+	return hu
+}
+
+// SetDirectionCode sets the "direction_code" field.
+func (hu *HeadingUpdate) SetDirectionCode(s string) *HeadingUpdate {
+	hu.mutation.SetDirectionCode(s)
+	return hu
+}
+
+// SetNillableDirectionCode sets the "direction_code" field if the given value is not nil.
+func (hu *HeadingUpdate) SetNillableDirectionCode(s *string) *HeadingUpdate {
+	if s != nil {
+		hu.SetDirectionCode(*s)
+	}
+	return hu
+}
+
+// SetLevel sets the "level" field.
+func (hu *HeadingUpdate) SetLevel(s string) *HeadingUpdate {
+	hu.mutation.SetLevel(s)
+	return hu
+}
+
+// SetNillableLevel sets the "level" field if the given value is not nil.
+func (hu *HeadingUpdate) SetNillableLevel(s *string) *HeadingUpdate {
+	if s != nil {
+		hu.SetLevel(*s)
+	}
+	return hu
+}
+
+// SetStudyForm sets the "study_form" field.
+func (hu *HeadingUpdate) SetStudyForm(s string) *HeadingUpdate {
+	hu.mutation.SetStudyForm(s)
+	return hu
+}
+
+// SetNillableStudyForm sets the "study_form" field if the given value is not nil.
+func (hu *HeadingUpdate) SetNillableStudyForm(s *string) *HeadingUpdate {
+	if s != nil {
+		hu.SetStudyForm(*s)
+	}
+	return hu
+}
+
+// SetExams sets the "exams" field.
+func (hu *HeadingUpdate) SetExams(ce []core.EntranceExam) *HeadingUpdate {
+	hu.mutation.SetExams(ce)
+	return hu
+}
+
+// AppendExams appends ce to the "exams" field.
+func (hu *HeadingUpdate) AppendExams(ce []core.EntranceExam) *HeadingUpdate {
+	hu.mutation.AppendExams(ce)
+	return hu
+}
+
+// ClearExams clears the value of the "exams" field.
+func (hu *HeadingUpdate) ClearExams() *HeadingUpdate {
+	hu.mutation.ClearExams()
+	return hu
+}
+
 // SetVarsityID sets the "varsity" edge to the Varsity entity by ID.
 func (hu *HeadingUpdate) SetVarsityID(id int) *HeadingUpdate {
 	hu.mutation.SetVarsityID(id)
@@ -199,6 +314,36 @@ func (hu *HeadingUpdate) AddDrainedResults(d ...*DrainedResult) *HeadingUpdate {
 	return hu.AddDrainedResultIDs(ids...)
 }
 
+// AddAdmissionChanceIDs adds the "admission_chances" edge to the AdmissionChance entity by IDs.
+func (hu *HeadingUpdate) AddAdmissionChanceIDs(ids ...int) *HeadingUpdate {
+	hu.mutation.AddAdmissionChanceIDs(ids...)
+	return hu
+}
+
+// AddAdmissionChances adds the "admission_chances" edges to the AdmissionChance entity.
+func (hu *HeadingUpdate) AddAdmissionChances(a ...*AdmissionChance) *HeadingUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return hu.AddAdmissionChanceIDs(ids...)
+}
+
+// AddAdmissionEventIDs adds the "admission_events" edge to the AdmissionEvent entity by IDs.
+func (hu *HeadingUpdate) AddAdmissionEventIDs(ids ...int) *HeadingUpdate {
+	hu.mutation.AddAdmissionEventIDs(ids...)
+	return hu
+}
+
+// AddAdmissionEvents adds the "admission_events" edges to the AdmissionEvent entity.
+func (hu *HeadingUpdate) AddAdmissionEvents(a ...*AdmissionEvent) *HeadingUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return hu.AddAdmissionEventIDs(ids...)
+}
+
 // Mutation returns the HeadingMutation object of the builder.
 func (hu *HeadingUpdate) Mutation() *HeadingMutation {
 	return hu.mutation
@@ -273,6 +418,48 @@ func (hu *HeadingUpdate) RemoveDrainedResults(d ...*DrainedResult) *HeadingUpdat
 	return hu.RemoveDrainedResultIDs(ids...)
 }
 
+// ClearAdmissionChances clears all "admission_chances" edges to the AdmissionChance entity.
+func (hu *HeadingUpdate) ClearAdmissionChances() *HeadingUpdate {
+	hu.mutation.ClearAdmissionChances()
+	return hu
+}
+
+// RemoveAdmissionChanceIDs removes the "admission_chances" edge to AdmissionChance entities by IDs.
+func (hu *HeadingUpdate) RemoveAdmissionChanceIDs(ids ...int) *HeadingUpdate {
+	hu.mutation.RemoveAdmissionChanceIDs(ids...)
+	return hu
+}
+
+// RemoveAdmissionChances removes "admission_chances" edges to AdmissionChance entities.
+func (hu *HeadingUpdate) RemoveAdmissionChances(a ...*AdmissionChance) *HeadingUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return hu.RemoveAdmissionChanceIDs(ids...)
+}
+
+// ClearAdmissionEvents clears all "admission_events" edges to the AdmissionEvent entity.
+func (hu *HeadingUpdate) ClearAdmissionEvents() *HeadingUpdate {
+	hu.mutation.ClearAdmissionEvents()
+	return hu
+}
+
+// RemoveAdmissionEventIDs removes the "admission_events" edge to AdmissionEvent entities by IDs.
+func (hu *HeadingUpdate) RemoveAdmissionEventIDs(ids ...int) *HeadingUpdate {
+	hu.mutation.RemoveAdmissionEventIDs(ids...)
+	return hu
+}
+
+// RemoveAdmissionEvents removes "admission_events" edges to AdmissionEvent entities.
+func (hu *HeadingUpdate) RemoveAdmissionEvents(a ...*AdmissionEvent) *HeadingUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return hu.RemoveAdmissionEventIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (hu *HeadingUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, hu.sqlSave, hu.mutation, hu.hooks)
@@ -350,6 +537,49 @@ func (hu *HeadingUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := hu.mutation.Name(); ok {
 		_spec.SetField(heading.FieldName, field.TypeString, value)
 	}
+	if value, ok := hu.mutation.Subjects(); ok {
+		_spec.SetField(heading.FieldSubjects, field.TypeJSON, value)
+	}
+	if value, ok := hu.mutation.AppendedSubjects(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, heading.FieldSubjects, value)
+		})
+	}
+	if hu.mutation.SubjectsCleared() {
+		_spec.ClearField(heading.FieldSubjects, field.TypeJSON)
+	}
+	if value, ok := hu.mutation.TargetSubQuotas(); ok {
+		_spec.SetField(heading.FieldTargetSubQuotas, field.TypeJSON, value)
+	}
+	if hu.mutation.TargetSubQuotasCleared() {
+		_spec.ClearField(heading.FieldTargetSubQuotas, field.TypeJSON)
+	}
+	if value, ok := hu.mutation.PaidCapacity(); ok {
+		_spec.SetField(heading.FieldPaidCapacity, field.TypeInt, value)
+	}
+	if value, ok := hu.mutation.AddedPaidCapacity(); ok {
+		_spec.AddField(heading.FieldPaidCapacity, field.TypeInt, value)
+	}
+	if value, ok := hu.mutation.DirectionCode(); ok {
+		_spec.SetField(heading.FieldDirectionCode, field.TypeString, value)
+	}
+	if value, ok := hu.mutation.Level(); ok {
+		_spec.SetField(heading.FieldLevel, field.TypeString, value)
+	}
+	if value, ok := hu.mutation.StudyForm(); ok {
+		_spec.SetField(heading.FieldStudyForm, field.TypeString, value)
+	}
+	if value, ok := hu.mutation.Exams(); ok {
+		_spec.SetField(heading.FieldExams, field.TypeJSON, value)
+	}
+	if value, ok := hu.mutation.AppendedExams(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, heading.FieldExams, value)
+		})
+	}
+	if hu.mutation.ExamsCleared() {
+		_spec.ClearField(heading.FieldExams, field.TypeJSON)
+	}
 	if hu.mutation.VarsityCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -514,6 +744,96 @@ func (hu *HeadingUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if hu.mutation.AdmissionChancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   heading.AdmissionChancesTable,
+			Columns: []string{heading.AdmissionChancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(admissionchance.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := hu.mutation.RemovedAdmissionChancesIDs(); len(nodes) > 0 && !hu.mutation.AdmissionChancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   heading.AdmissionChancesTable,
+			Columns: []string{heading.AdmissionChancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(admissionchance.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := hu.mutation.AdmissionChancesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   heading.AdmissionChancesTable,
+			Columns: []string{heading.AdmissionChancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(admissionchance.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if hu.mutation.AdmissionEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   heading.AdmissionEventsTable,
+			Columns: []string{heading.AdmissionEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(admissionevent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := hu.mutation.RemovedAdmissionEventsIDs(); len(nodes) > 0 && !hu.mutation.AdmissionEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   heading.AdmissionEventsTable,
+			Columns: []string{heading.AdmissionEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(admissionevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := hu.mutation.AdmissionEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   heading.AdmissionEventsTable,
+			Columns: []string{heading.AdmissionEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(admissionevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, hu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{heading.Label}
@@ -646,6 +966,117 @@ func (huo *HeadingUpdateOne) SetNillableName(s *string) *HeadingUpdateOne {
 	return huo
 }
 
+// SetSubjects sets the "subjects" field.
+func (huo *HeadingUpdateOne) SetSubjects(s []string) *HeadingUpdateOne {
+	huo.mutation.SetSubjects(s)
+	return huo
+}
+
+// AppendSubjects appends s to the "subjects" field.
+func (huo *HeadingUpdateOne) AppendSubjects(s []string) *HeadingUpdateOne {
+	huo.mutation.AppendSubjects(s)
+	return huo
+}
+
+// ClearSubjects clears the value of the "subjects" field.
+func (huo *HeadingUpdateOne) ClearSubjects() *HeadingUpdateOne {
+	huo.mutation.ClearSubjects()
+	return huo
+}
+
+// SetTargetSubQuotas sets the "target_sub_quotas" field.
+func (huo *HeadingUpdateOne) SetTargetSubQuotas(m map[string]int) *HeadingUpdateOne {
+	huo.mutation.SetTargetSubQuotas(m)
+	return huo
+}
+
+// ClearTargetSubQuotas clears the value of the "target_sub_quotas" field.
+func (huo *HeadingUpdateOne) ClearTargetSubQuotas() *HeadingUpdateOne {
+	huo.mutation.ClearTargetSubQuotas()
+	return huo
+}
+
+// SetPaidCapacity sets the "paid_capacity" field.
+func (huo *HeadingUpdateOne) SetPaidCapacity(i int) *HeadingUpdateOne {
+	huo.mutation.ResetPaidCapacity()
+	huo.mutation.SetPaidCapacity(i)
+	return huo
+}
+
+// SetNillablePaidCapacity sets the "paid_capacity" field if the given value is not nil.
+func (huo *HeadingUpdateOne) SetNillablePaidCapacity(i *int) *HeadingUpdateOne {
+	if i != nil {
+		huo.SetPaidCapacity(*i)
+	}
+	return huo
+}
+
+// AddPaidCapacity adds i to the "paid_capacity" field.
+func (huo *HeadingUpdateOne) AddPaidCapacity(i int) *HeadingUpdateOne {
+	huo.mutation.AddPaidCapacity(i)
+	return huo
+}
+
+// SetDirectionCode sets the "direction_code" field.
+func (huo *HeadingUpdateOne) SetDirectionCode(s string) *HeadingUpdateOne {
+	huo.mutation.SetDirectionCode(s)
+	return huo
+}
+
+// SetNillableDirectionCode sets the "direction_code" field if the given value is not nil.
+func (huo *HeadingUpdateOne) SetNillableDirectionCode(s *string) *HeadingUpdateOne {
+	if s != nil {
+		huo.SetDirectionCode(*s)
+	}
+	return huo
+}
+
+// SetLevel sets the "level" field.
+func (huo *HeadingUpdateOne) SetLevel(s string) *HeadingUpdateOne {
+	huo.mutation.SetLevel(s)
+	return huo
+}
+
+// SetNillableLevel sets the "level" field if the given value is not nil.
+func (huo *HeadingUpdateOne) SetNillableLevel(s *string) *HeadingUpdateOne {
+	if s != nil {
+		huo.SetLevel(*s)
+	}
+	return huo
+}
+
+// SetStudyForm sets the "study_form" field.
+func (huo *HeadingUpdateOne) SetStudyForm(s string) *HeadingUpdateOne {
+	huo.mutation.SetStudyForm(s)
+	return huo
+}
+
+// SetNillableStudyForm sets the "study_form" field if the given value is not nil.
+func (huo *HeadingUpdateOne) SetNillableStudyForm(s *string) *HeadingUpdateOne {
+	if s != nil {
+		huo.SetStudyForm(*s)
+	}
+	return huo
+}
+
+// SetExams sets the "exams" field.
+func (huo *HeadingUpdateOne) SetExams(ce []core.EntranceExam) *HeadingUpdateOne {
+	huo.mutation.SetExams(ce)
+	return huo
+}
+
+// AppendExams appends ce to the "exams" field.
+func (huo *HeadingUpdateOne) AppendExams(ce []core.EntranceExam) *HeadingUpdateOne {
+	huo.mutation.AppendExams(ce)
+	return huo
+}
+
+// ClearExams clears the value of the "exams" field.
+func (huo *HeadingUpdateOne) ClearExams() *HeadingUpdateOne {
+	huo.mutation.ClearExams()
+	return huo
+}
+
 // SetVarsityID sets the "varsity" edge to the Varsity entity by ID.
 func (huo *HeadingUpdateOne) SetVarsityID(id int) *HeadingUpdateOne {
 	huo.mutation.SetVarsityID(id)
@@ -702,6 +1133,36 @@ func (huo *HeadingUpdateOne) AddDrainedResults(d ...*DrainedResult) *HeadingUpda
 	return huo.AddDrainedResultIDs(ids...)
 }
 
+// AddAdmissionChanceIDs adds the "admission_chances" edge to the AdmissionChance entity by IDs.
+func (huo *HeadingUpdateOne) AddAdmissionChanceIDs(ids ...int) *HeadingUpdateOne {
+	huo.mutation.AddAdmissionChanceIDs(ids...)
+	return huo
+}
+
+// AddAdmissionChances adds the "admission_chances" edges to the AdmissionChance entity.
+func (huo *HeadingUpdateOne) AddAdmissionChances(a ...*AdmissionChance) *HeadingUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return huo.AddAdmissionChanceIDs(ids...)
+}
+
+// AddAdmissionEventIDs adds the "admission_events" edge to the AdmissionEvent entity by IDs.
+func (huo *HeadingUpdateOne) AddAdmissionEventIDs(ids ...int) *HeadingUpdateOne {
+	huo.mutation.AddAdmissionEventIDs(ids...)
+	return huo
+}
+
+// AddAdmissionEvents adds the "admission_events" edges to the AdmissionEvent entity.
+func (huo *HeadingUpdateOne) AddAdmissionEvents(a ...*AdmissionEvent) *HeadingUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return huo.AddAdmissionEventIDs(ids...)
+}
+
 // Mutation returns the HeadingMutation object of the builder.
 func (huo *HeadingUpdateOne) Mutation() *HeadingMutation {
 	return huo.mutation
@@ -776,6 +1237,48 @@ func (huo *HeadingUpdateOne) RemoveDrainedResults(d ...*DrainedResult) *HeadingU
 	return huo.RemoveDrainedResultIDs(ids...)
 }
 
+// ClearAdmissionChances clears all "admission_chances" edges to the AdmissionChance entity.
+func (huo *HeadingUpdateOne) ClearAdmissionChances() *HeadingUpdateOne {
+	huo.mutation.ClearAdmissionChances()
+	return huo
+}
+
+// RemoveAdmissionChanceIDs removes the "admission_chances" edge to AdmissionChance entities by IDs.
+func (huo *HeadingUpdateOne) RemoveAdmissionChanceIDs(ids ...int) *HeadingUpdateOne {
+	huo.mutation.RemoveAdmissionChanceIDs(ids...)
+	return huo
+}
+
+// RemoveAdmissionChances removes "admission_chances" edges to AdmissionChance entities.
+func (huo *HeadingUpdateOne) RemoveAdmissionChances(a ...*AdmissionChance) *HeadingUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return huo.RemoveAdmissionChanceIDs(ids...)
+}
+
+// ClearAdmissionEvents clears all "admission_events" edges to the AdmissionEvent entity.
+func (huo *HeadingUpdateOne) ClearAdmissionEvents() *HeadingUpdateOne {
+	huo.mutation.ClearAdmissionEvents()
+	return huo
+}
+
+// RemoveAdmissionEventIDs removes the "admission_events" edge to AdmissionEvent entities by IDs.
+func (huo *HeadingUpdateOne) RemoveAdmissionEventIDs(ids ...int) *HeadingUpdateOne {
+	huo.mutation.RemoveAdmissionEventIDs(ids...)
+	return huo
+}
+
+// RemoveAdmissionEvents removes "admission_events" edges to AdmissionEvent entities.
+func (huo *HeadingUpdateOne) RemoveAdmissionEvents(a ...*AdmissionEvent) *HeadingUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return huo.RemoveAdmissionEventIDs(ids...)
+}
+
 // Where appends a list predicates to the HeadingUpdate builder.
 func (huo *HeadingUpdateOne) Where(ps ...predicate.Heading) *HeadingUpdateOne {
 	huo.mutation.Where(ps...)
@@ -883,6 +1386,49 @@ func (huo *HeadingUpdateOne) sqlSave(ctx context.Context) (_node *Heading, err e
 	if value, ok := huo.mutation.Name(); ok {
 		_spec.SetField(heading.FieldName, field.TypeString, value)
 	}
+	if value, ok := huo.mutation.Subjects(); ok {
+		_spec.SetField(heading.FieldSubjects, field.TypeJSON, value)
+	}
+	if value, ok := huo.mutation.AppendedSubjects(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, heading.FieldSubjects, value)
+		})
+	}
+	if huo.mutation.SubjectsCleared() {
+		_spec.ClearField(heading.FieldSubjects, field.TypeJSON)
+	}
+	if value, ok := huo.mutation.TargetSubQuotas(); ok {
+		_spec.SetField(heading.FieldTargetSubQuotas, field.TypeJSON, value)
+	}
+	if huo.mutation.TargetSubQuotasCleared() {
+		_spec.ClearField(heading.FieldTargetSubQuotas, field.TypeJSON)
+	}
+	if value, ok := huo.mutation.PaidCapacity(); ok {
+		_spec.SetField(heading.FieldPaidCapacity, field.TypeInt, value)
+	}
+	if value, ok := huo.mutation.AddedPaidCapacity(); ok {
+		_spec.AddField(heading.FieldPaidCapacity, field.TypeInt, value)
+	}
+	if value, ok := huo.mutation.DirectionCode(); ok {
+		_spec.SetField(heading.FieldDirectionCode, field.TypeString, value)
+	}
+	if value, ok := huo.mutation.Level(); ok {
+		_spec.SetField(heading.FieldLevel, field.TypeString, value)
+	}
+	if value, ok := huo.mutation.StudyForm(); ok {
+		_spec.SetField(heading.FieldStudyForm, field.TypeString, value)
+	}
+	if value, ok := huo.mutation.Exams(); ok {
+		_spec.SetField(heading.FieldExams, field.TypeJSON, value)
+	}
+	if value, ok := huo.mutation.AppendedExams(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, heading.FieldExams, value)
+		})
+	}
+	if huo.mutation.ExamsCleared() {
+		_spec.ClearField(heading.FieldExams, field.TypeJSON)
+	}
 	if huo.mutation.VarsityCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1047,6 +1593,96 @@ func (huo *HeadingUpdateOne) sqlSave(ctx context.Context) (_node *Heading, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if huo.mutation.AdmissionChancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   heading.AdmissionChancesTable,
+			Columns: []string{heading.AdmissionChancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(admissionchance.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := huo.mutation.RemovedAdmissionChancesIDs(); len(nodes) > 0 && !huo.mutation.AdmissionChancesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   heading.AdmissionChancesTable,
+			Columns: []string{heading.AdmissionChancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(admissionchance.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := huo.mutation.AdmissionChancesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   heading.AdmissionChancesTable,
+			Columns: []string{heading.AdmissionChancesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(admissionchance.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if huo.mutation.AdmissionEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   heading.AdmissionEventsTable,
+			Columns: []string{heading.AdmissionEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(admissionevent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := huo.mutation.RemovedAdmissionEventsIDs(); len(nodes) > 0 && !huo.mutation.AdmissionEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   heading.AdmissionEventsTable,
+			Columns: []string{heading.AdmissionEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(admissionevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := huo.mutation.AdmissionEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   heading.AdmissionEventsTable,
+			Columns: []string{heading.AdmissionEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(admissionevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Heading{config: huo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
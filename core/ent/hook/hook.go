@@ -9,6 +9,30 @@ import (
 	"github.com/trueegorletov/analabit/core/ent"
 )
 
+// The AdmissionChanceFunc type is an adapter to allow the use of ordinary
+// function as AdmissionChance mutator.
+type AdmissionChanceFunc func(context.Context, *ent.AdmissionChanceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AdmissionChanceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AdmissionChanceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AdmissionChanceMutation", m)
+}
+
+// The AdmissionEventFunc type is an adapter to allow the use of ordinary
+// function as AdmissionEvent mutator.
+type AdmissionEventFunc func(context.Context, *ent.AdmissionEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AdmissionEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AdmissionEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AdmissionEventMutation", m)
+}
+
 // The ApplicationFunc type is an adapter to allow the use of ordinary
 // function as Application mutator.
 type ApplicationFunc func(context.Context, *ent.ApplicationMutation) (ent.Value, error)
@@ -33,6 +57,18 @@ func (f CalculationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CalculationMutation", m)
 }
 
+// The CapacityDriftFunc type is an adapter to allow the use of ordinary
+// function as CapacityDrift mutator.
+type CapacityDriftFunc func(context.Context, *ent.CapacityDriftMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CapacityDriftFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CapacityDriftMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CapacityDriftMutation", m)
+}
+
 // The DrainedResultFunc type is an adapter to allow the use of ordinary
 // function as DrainedResult mutator.
 type DrainedResultFunc func(context.Context, *ent.DrainedResultMutation) (ent.Value, error)
@@ -69,6 +105,18 @@ func (f RunFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RunMutation", m)
 }
 
+// The SourceReportFunc type is an adapter to allow the use of ordinary
+// function as SourceReport mutator.
+type SourceReportFunc func(context.Context, *ent.SourceReportMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SourceReportFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SourceReportMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SourceReportMutation", m)
+}
+
 // The VarsityFunc type is an adapter to allow the use of ordinary
 // function as Varsity mutator.
 type VarsityFunc func(context.Context, *ent.VarsityMutation) (ent.Value, error)
@@ -8,6 +8,94 @@ import (
 )
 
 var (
+	// AdmissionChancesColumns holds the columns for the "admission_chances" table.
+	AdmissionChancesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "student_id", Type: field.TypeString},
+		{Name: "drained_percent", Type: field.TypeInt},
+		{Name: "admitted_count", Type: field.TypeInt},
+		{Name: "iterations", Type: field.TypeInt},
+		{Name: "probability", Type: field.TypeFloat64},
+		{Name: "run_id", Type: field.TypeInt},
+		{Name: "heading_admission_chances", Type: field.TypeInt},
+	}
+	// AdmissionChancesTable holds the schema information for the "admission_chances" table.
+	AdmissionChancesTable = &schema.Table{
+		Name:       "admission_chances",
+		Columns:    AdmissionChancesColumns,
+		PrimaryKey: []*schema.Column{AdmissionChancesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "admission_chances_runs_run",
+				Columns:    []*schema.Column{AdmissionChancesColumns[6]},
+				RefColumns: []*schema.Column{RunsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "admission_chances_headings_admission_chances",
+				Columns:    []*schema.Column{AdmissionChancesColumns[7]},
+				RefColumns: []*schema.Column{HeadingsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "admissionchance_run_id",
+				Unique:  false,
+				Columns: []*schema.Column{AdmissionChancesColumns[6]},
+			},
+			{
+				Name:    "admissionchance_run_id_student_id",
+				Unique:  false,
+				Columns: []*schema.Column{AdmissionChancesColumns[6], AdmissionChancesColumns[1]},
+			},
+		},
+	}
+	// AdmissionEventsColumns holds the columns for the "admission_events" table.
+	AdmissionEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "seq", Type: field.TypeInt},
+		{Name: "kind", Type: field.TypeInt},
+		{Name: "stage", Type: field.TypeInt, Nullable: true},
+		{Name: "student_id", Type: field.TypeString},
+		{Name: "priority", Type: field.TypeInt},
+		{Name: "competition_type", Type: field.TypeInt},
+		{Name: "other_student_id", Type: field.TypeString, Nullable: true},
+		{Name: "run_id", Type: field.TypeInt},
+		{Name: "heading_admission_events", Type: field.TypeInt},
+	}
+	// AdmissionEventsTable holds the schema information for the "admission_events" table.
+	AdmissionEventsTable = &schema.Table{
+		Name:       "admission_events",
+		Columns:    AdmissionEventsColumns,
+		PrimaryKey: []*schema.Column{AdmissionEventsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "admission_events_runs_run",
+				Columns:    []*schema.Column{AdmissionEventsColumns[8]},
+				RefColumns: []*schema.Column{RunsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "admission_events_headings_admission_events",
+				Columns:    []*schema.Column{AdmissionEventsColumns[9]},
+				RefColumns: []*schema.Column{HeadingsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "admissionevent_run_id",
+				Unique:  false,
+				Columns: []*schema.Column{AdmissionEventsColumns[8]},
+			},
+			{
+				Name:    "admissionevent_run_id_student_id",
+				Unique:  false,
+				Columns: []*schema.Column{AdmissionEventsColumns[8], AdmissionEventsColumns[4]},
+			},
+		},
+	}
 	// ApplicationsColumns holds the columns for the "applications" table.
 	ApplicationsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -16,6 +104,9 @@ var (
 		{Name: "competition_type", Type: field.TypeInt},
 		{Name: "rating_place", Type: field.TypeInt},
 		{Name: "score", Type: field.TypeInt},
+		{Name: "subject_scores", Type: field.TypeJSON, Nullable: true},
+		{Name: "achievement_score", Type: field.TypeInt, Default: 0},
+		{Name: "sub_quota", Type: field.TypeString, Nullable: true},
 		{Name: "original_submitted", Type: field.TypeBool, Default: false},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "msu_internal_id", Type: field.TypeString, Nullable: true},
@@ -30,13 +121,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "applications_runs_run",
-				Columns:    []*schema.Column{ApplicationsColumns[9]},
+				Columns:    []*schema.Column{ApplicationsColumns[12]},
 				RefColumns: []*schema.Column{RunsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "applications_headings_applications",
-				Columns:    []*schema.Column{ApplicationsColumns[10]},
+				Columns:    []*schema.Column{ApplicationsColumns[13]},
 				RefColumns: []*schema.Column{HeadingsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -45,27 +136,27 @@ var (
 			{
 				Name:    "application_run_id",
 				Unique:  false,
-				Columns: []*schema.Column{ApplicationsColumns[9]},
+				Columns: []*schema.Column{ApplicationsColumns[12]},
 			},
 			{
 				Name:    "application_run_id_student_id",
 				Unique:  false,
-				Columns: []*schema.Column{ApplicationsColumns[9], ApplicationsColumns[1]},
+				Columns: []*schema.Column{ApplicationsColumns[12], ApplicationsColumns[1]},
 			},
 			{
 				Name:    "application_original_submitted",
 				Unique:  false,
-				Columns: []*schema.Column{ApplicationsColumns[6]},
+				Columns: []*schema.Column{ApplicationsColumns[9]},
 			},
 			{
 				Name:    "application_run_id_rating_place",
 				Unique:  false,
-				Columns: []*schema.Column{ApplicationsColumns[9], ApplicationsColumns[4]},
+				Columns: []*schema.Column{ApplicationsColumns[12], ApplicationsColumns[4]},
 			},
 			{
 				Name:    "application_run_id_student_id_heading_applications",
 				Unique:  false,
-				Columns: []*schema.Column{ApplicationsColumns[9], ApplicationsColumns[1], ApplicationsColumns[10]},
+				Columns: []*schema.Column{ApplicationsColumns[12], ApplicationsColumns[1], ApplicationsColumns[13]},
 			},
 		},
 	}
@@ -74,6 +165,8 @@ var (
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "student_id", Type: field.TypeString},
 		{Name: "admitted_place", Type: field.TypeInt},
+		{Name: "stage", Type: field.TypeInt, Nullable: true},
+		{Name: "paid", Type: field.TypeBool, Default: false},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "run_id", Type: field.TypeInt},
 		{Name: "heading_calculations", Type: field.TypeInt},
@@ -86,13 +179,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "calculations_runs_run",
-				Columns:    []*schema.Column{CalculationsColumns[4]},
+				Columns:    []*schema.Column{CalculationsColumns[6]},
 				RefColumns: []*schema.Column{RunsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "calculations_headings_calculations",
-				Columns:    []*schema.Column{CalculationsColumns[5]},
+				Columns:    []*schema.Column{CalculationsColumns[7]},
 				RefColumns: []*schema.Column{HeadingsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -101,12 +194,12 @@ var (
 			{
 				Name:    "calculation_run_id",
 				Unique:  false,
-				Columns: []*schema.Column{CalculationsColumns[4]},
+				Columns: []*schema.Column{CalculationsColumns[6]},
 			},
 			{
 				Name:    "calculation_run_id_student_id",
 				Unique:  false,
-				Columns: []*schema.Column{CalculationsColumns[4], CalculationsColumns[1]},
+				Columns: []*schema.Column{CalculationsColumns[6], CalculationsColumns[1]},
 			},
 			{
 				Name:    "calculation_admitted_place",
@@ -115,6 +208,48 @@ var (
 			},
 		},
 	}
+	// CapacityDriftsColumns holds the columns for the "capacity_drifts" table.
+	CapacityDriftsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "varsity_code", Type: field.TypeString},
+		{Name: "heading_code", Type: field.TypeString, Default: ""},
+		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "registry_regular", Type: field.TypeInt},
+		{Name: "registry_target_quota", Type: field.TypeInt},
+		{Name: "registry_dedicated_quota", Type: field.TypeInt},
+		{Name: "registry_special_quota", Type: field.TypeInt},
+		{Name: "registry_target_sub_quotas", Type: field.TypeJSON, Nullable: true},
+		{Name: "published_regular", Type: field.TypeInt},
+		{Name: "published_target_quota", Type: field.TypeInt},
+		{Name: "published_dedicated_quota", Type: field.TypeInt},
+		{Name: "published_special_quota", Type: field.TypeInt},
+		{Name: "published_target_sub_quotas", Type: field.TypeJSON, Nullable: true},
+		{Name: "total_only", Type: field.TypeBool, Default: false},
+		{Name: "drifted", Type: field.TypeBool},
+		{Name: "applied", Type: field.TypeBool},
+		{Name: "run_id", Type: field.TypeInt},
+	}
+	// CapacityDriftsTable holds the schema information for the "capacity_drifts" table.
+	CapacityDriftsTable = &schema.Table{
+		Name:       "capacity_drifts",
+		Columns:    CapacityDriftsColumns,
+		PrimaryKey: []*schema.Column{CapacityDriftsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "capacity_drifts_runs_run",
+				Columns:    []*schema.Column{CapacityDriftsColumns[17]},
+				RefColumns: []*schema.Column{RunsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "capacitydrift_varsity_code_heading_code_run_id",
+				Unique:  false,
+				Columns: []*schema.Column{CapacityDriftsColumns[1], CapacityDriftsColumns[2], CapacityDriftsColumns[17]},
+			},
+		},
+	}
 	// DrainedResultsColumns holds the columns for the "drained_results" table.
 	DrainedResultsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -129,6 +264,24 @@ var (
 		{Name: "med_last_admitted_rating_place", Type: field.TypeInt},
 		{Name: "regulars_admitted", Type: field.TypeBool, Default: false},
 		{Name: "is_virtual", Type: field.TypeBool, Default: false},
+		{Name: "seed", Type: field.TypeInt64, Nullable: true},
+		{Name: "drain_model", Type: field.TypeString, Nullable: true},
+		{Name: "avg_priority_stage_admitted", Type: field.TypeInt, Nullable: true},
+		{Name: "avg_main_stage_admitted", Type: field.TypeInt, Nullable: true},
+		{Name: "sub_quotas", Type: field.TypeJSON, Nullable: true},
+		{Name: "avg_paid_passing_score", Type: field.TypeInt, Nullable: true},
+		{Name: "avg_paid_last_admitted_rating_place", Type: field.TypeInt, Nullable: true},
+		{Name: "p10_passing_score", Type: field.TypeInt, Nullable: true},
+		{Name: "p25_passing_score", Type: field.TypeInt, Nullable: true},
+		{Name: "p75_passing_score", Type: field.TypeInt, Nullable: true},
+		{Name: "p90_passing_score", Type: field.TypeInt, Nullable: true},
+		{Name: "p10_last_admitted_rating_place", Type: field.TypeInt, Nullable: true},
+		{Name: "p25_last_admitted_rating_place", Type: field.TypeInt, Nullable: true},
+		{Name: "p75_last_admitted_rating_place", Type: field.TypeInt, Nullable: true},
+		{Name: "p90_last_admitted_rating_place", Type: field.TypeInt, Nullable: true},
+		{Name: "passing_score_histogram", Type: field.TypeJSON, Nullable: true},
+		{Name: "last_admitted_rating_place_histogram", Type: field.TypeJSON, Nullable: true},
+		{Name: "iterations", Type: field.TypeInt, Nullable: true},
 		{Name: "run_id", Type: field.TypeInt},
 		{Name: "heading_drained_results", Type: field.TypeInt},
 	}
@@ -140,13 +293,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "drained_results_runs_run",
-				Columns:    []*schema.Column{DrainedResultsColumns[12]},
+				Columns:    []*schema.Column{DrainedResultsColumns[30]},
 				RefColumns: []*schema.Column{RunsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "drained_results_headings_drained_results",
-				Columns:    []*schema.Column{DrainedResultsColumns[13]},
+				Columns:    []*schema.Column{DrainedResultsColumns[31]},
 				RefColumns: []*schema.Column{HeadingsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -155,12 +308,12 @@ var (
 			{
 				Name:    "drainedresult_run_id",
 				Unique:  false,
-				Columns: []*schema.Column{DrainedResultsColumns[12]},
+				Columns: []*schema.Column{DrainedResultsColumns[30]},
 			},
 			{
 				Name:    "drainedresult_run_id_drained_percent",
 				Unique:  false,
-				Columns: []*schema.Column{DrainedResultsColumns[12], DrainedResultsColumns[1]},
+				Columns: []*schema.Column{DrainedResultsColumns[30], DrainedResultsColumns[1]},
 			},
 			{
 				Name:    "drainedresult_drained_percent",
@@ -178,6 +331,13 @@ var (
 		{Name: "special_quota_capacity", Type: field.TypeInt},
 		{Name: "code", Type: field.TypeString, Unique: true},
 		{Name: "name", Type: field.TypeString},
+		{Name: "subjects", Type: field.TypeJSON, Nullable: true},
+		{Name: "target_sub_quotas", Type: field.TypeJSON, Nullable: true},
+		{Name: "paid_capacity", Type: field.TypeInt, Default: 0},
+		{Name: "direction_code", Type: field.TypeString, Default: ""},
+		{Name: "level", Type: field.TypeString, Default: ""},
+		{Name: "study_form", Type: field.TypeString, Default: ""},
+		{Name: "exams", Type: field.TypeJSON, Nullable: true},
 		{Name: "varsity_headings", Type: field.TypeInt},
 	}
 	// HeadingsTable holds the schema information for the "headings" table.
@@ -188,7 +348,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "headings_varsities_headings",
-				Columns:    []*schema.Column{HeadingsColumns[7]},
+				Columns:    []*schema.Column{HeadingsColumns[14]},
 				RefColumns: []*schema.Column{VarsitiesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -208,11 +368,55 @@ var (
 		Columns:    RunsColumns,
 		PrimaryKey: []*schema.Column{RunsColumns[0]},
 	}
+	// SourceReportsColumns holds the columns for the "source_reports" table.
+	SourceReportsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "varsity_code", Type: field.TypeString},
+		{Name: "source", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "url", Type: field.TypeString, Nullable: true},
+		{Name: "heading_codes", Type: field.TypeJSON, Nullable: true},
+		{Name: "status", Type: field.TypeString},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "duration_ms", Type: field.TypeInt64},
+		{Name: "retries", Type: field.TypeInt},
+		{Name: "headings", Type: field.TypeInt},
+		{Name: "applications", Type: field.TypeInt},
+		{Name: "skipped_rows", Type: field.TypeInt},
+		{Name: "failed_lists", Type: field.TypeInt, Default: 0},
+		{Name: "run_id", Type: field.TypeInt},
+	}
+	// SourceReportsTable holds the schema information for the "source_reports" table.
+	SourceReportsTable = &schema.Table{
+		Name:       "source_reports",
+		Columns:    SourceReportsColumns,
+		PrimaryKey: []*schema.Column{SourceReportsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "source_reports_runs_run",
+				Columns:    []*schema.Column{SourceReportsColumns[15]},
+				RefColumns: []*schema.Column{RunsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "sourcereport_run_id_varsity_code",
+				Unique:  false,
+				Columns: []*schema.Column{SourceReportsColumns[15], SourceReportsColumns[1]},
+			},
+		},
+	}
 	// VarsitiesColumns holds the columns for the "varsities" table.
 	VarsitiesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "code", Type: field.TypeString, Unique: true},
 		{Name: "name", Type: field.TypeString},
+		{Name: "city", Type: field.TypeString, Default: ""},
+		{Name: "region", Type: field.TypeString, Default: ""},
+		{Name: "parent", Type: field.TypeString, Default: ""},
+		{Name: "site", Type: field.TypeString, Default: ""},
 	}
 	// VarsitiesTable holds the schema information for the "varsities" table.
 	VarsitiesTable = &schema.Table{
@@ -222,21 +426,31 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AdmissionChancesTable,
+		AdmissionEventsTable,
 		ApplicationsTable,
 		CalculationsTable,
+		CapacityDriftsTable,
 		DrainedResultsTable,
 		HeadingsTable,
 		RunsTable,
+		SourceReportsTable,
 		VarsitiesTable,
 	}
 )
 
 func init() {
+	AdmissionChancesTable.ForeignKeys[0].RefTable = RunsTable
+	AdmissionChancesTable.ForeignKeys[1].RefTable = HeadingsTable
+	AdmissionEventsTable.ForeignKeys[0].RefTable = RunsTable
+	AdmissionEventsTable.ForeignKeys[1].RefTable = HeadingsTable
 	ApplicationsTable.ForeignKeys[0].RefTable = RunsTable
 	ApplicationsTable.ForeignKeys[1].RefTable = HeadingsTable
 	CalculationsTable.ForeignKeys[0].RefTable = RunsTable
 	CalculationsTable.ForeignKeys[1].RefTable = HeadingsTable
+	CapacityDriftsTable.ForeignKeys[0].RefTable = RunsTable
 	DrainedResultsTable.ForeignKeys[0].RefTable = RunsTable
 	DrainedResultsTable.ForeignKeys[1].RefTable = HeadingsTable
 	HeadingsTable.ForeignKeys[0].RefTable = VarsitiesTable
+	SourceReportsTable.ForeignKeys[0].RefTable = RunsTable
 }
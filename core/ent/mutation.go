@@ -12,12 +12,16 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/ent/admissionchance"
+	"github.com/trueegorletov/analabit/core/ent/admissionevent"
 	"github.com/trueegorletov/analabit/core/ent/application"
 	"github.com/trueegorletov/analabit/core/ent/calculation"
+	"github.com/trueegorletov/analabit/core/ent/capacitydrift"
 	"github.com/trueegorletov/analabit/core/ent/drainedresult"
 	"github.com/trueegorletov/analabit/core/ent/heading"
 	"github.com/trueegorletov/analabit/core/ent/predicate"
 	"github.com/trueegorletov/analabit/core/ent/run"
+	"github.com/trueegorletov/analabit/core/ent/sourcereport"
 	"github.com/trueegorletov/analabit/core/ent/varsity"
 )
 
@@ -30,53 +34,54 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeApplication   = "Application"
-	TypeCalculation   = "Calculation"
-	TypeDrainedResult = "DrainedResult"
-	TypeHeading       = "Heading"
-	TypeRun           = "Run"
-	TypeVarsity       = "Varsity"
+	TypeAdmissionChance = "AdmissionChance"
+	TypeAdmissionEvent  = "AdmissionEvent"
+	TypeApplication     = "Application"
+	TypeCalculation     = "Calculation"
+	TypeCapacityDrift   = "CapacityDrift"
+	TypeDrainedResult   = "DrainedResult"
+	TypeHeading         = "Heading"
+	TypeRun             = "Run"
+	TypeSourceReport    = "SourceReport"
+	TypeVarsity         = "Varsity"
 )
 
-// ApplicationMutation represents an operation that mutates the Application nodes in the graph.
-type ApplicationMutation struct {
+// AdmissionChanceMutation represents an operation that mutates the AdmissionChance nodes in the graph.
+type AdmissionChanceMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *int
-	student_id          *string
-	priority            *int
-	addpriority         *int
-	competition_type    *core.Competition
-	addcompetition_type *core.Competition
-	rating_place        *int
-	addrating_place     *int
-	score               *int
-	addscore            *int
-	original_submitted  *bool
-	updated_at          *time.Time
-	msu_internal_id     *string
-	clearedFields       map[string]struct{}
-	heading             *int
-	clearedheading      bool
-	run                 *int
-	clearedrun          bool
-	done                bool
-	oldValue            func(context.Context) (*Application, error)
-	predicates          []predicate.Application
-}
-
-var _ ent.Mutation = (*ApplicationMutation)(nil)
-
-// applicationOption allows management of the mutation configuration using functional options.
-type applicationOption func(*ApplicationMutation)
-
-// newApplicationMutation creates new mutation for the Application entity.
-func newApplicationMutation(c config, op Op, opts ...applicationOption) *ApplicationMutation {
-	m := &ApplicationMutation{
+	op                 Op
+	typ                string
+	id                 *int
+	student_id         *string
+	drained_percent    *int
+	adddrained_percent *int
+	admitted_count     *int
+	addadmitted_count  *int
+	iterations         *int
+	additerations      *int
+	probability        *float64
+	addprobability     *float64
+	clearedFields      map[string]struct{}
+	heading            *int
+	clearedheading     bool
+	run                *int
+	clearedrun         bool
+	done               bool
+	oldValue           func(context.Context) (*AdmissionChance, error)
+	predicates         []predicate.AdmissionChance
+}
+
+var _ ent.Mutation = (*AdmissionChanceMutation)(nil)
+
+// admissionchanceOption allows management of the mutation configuration using functional options.
+type admissionchanceOption func(*AdmissionChanceMutation)
+
+// newAdmissionChanceMutation creates new mutation for the AdmissionChance entity.
+func newAdmissionChanceMutation(c config, op Op, opts ...admissionchanceOption) *AdmissionChanceMutation {
+	m := &AdmissionChanceMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeApplication,
+		typ:           TypeAdmissionChance,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -85,20 +90,20 @@ func newApplicationMutation(c config, op Op, opts ...applicationOption) *Applica
 	return m
 }
 
-// withApplicationID sets the ID field of the mutation.
-func withApplicationID(id int) applicationOption {
-	return func(m *ApplicationMutation) {
+// withAdmissionChanceID sets the ID field of the mutation.
+func withAdmissionChanceID(id int) admissionchanceOption {
+	return func(m *AdmissionChanceMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Application
+			value *AdmissionChance
 		)
-		m.oldValue = func(ctx context.Context) (*Application, error) {
+		m.oldValue = func(ctx context.Context) (*AdmissionChance, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Application.Get(ctx, id)
+					value, err = m.Client().AdmissionChance.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -107,10 +112,10 @@ func withApplicationID(id int) applicationOption {
 	}
 }
 
-// withApplication sets the old Application of the mutation.
-func withApplication(node *Application) applicationOption {
-	return func(m *ApplicationMutation) {
-		m.oldValue = func(context.Context) (*Application, error) {
+// withAdmissionChance sets the old AdmissionChance of the mutation.
+func withAdmissionChance(node *AdmissionChance) admissionchanceOption {
+	return func(m *AdmissionChanceMutation) {
+		m.oldValue = func(context.Context) (*AdmissionChance, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -119,7 +124,7 @@ func withApplication(node *Application) applicationOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ApplicationMutation) Client() *Client {
+func (m AdmissionChanceMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -127,7 +132,7 @@ func (m ApplicationMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ApplicationMutation) Tx() (*Tx, error) {
+func (m AdmissionChanceMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -138,7 +143,7 @@ func (m ApplicationMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ApplicationMutation) ID() (id int, exists bool) {
+func (m *AdmissionChanceMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -149,7 +154,7 @@ func (m *ApplicationMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ApplicationMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AdmissionChanceMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -158,19 +163,19 @@ func (m *ApplicationMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Application.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AdmissionChance.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetStudentID sets the "student_id" field.
-func (m *ApplicationMutation) SetStudentID(s string) {
+func (m *AdmissionChanceMutation) SetStudentID(s string) {
 	m.student_id = &s
 }
 
 // StudentID returns the value of the "student_id" field in the mutation.
-func (m *ApplicationMutation) StudentID() (r string, exists bool) {
+func (m *AdmissionChanceMutation) StudentID() (r string, exists bool) {
 	v := m.student_id
 	if v == nil {
 		return
@@ -178,10 +183,10 @@ func (m *ApplicationMutation) StudentID() (r string, exists bool) {
 	return *v, true
 }
 
-// OldStudentID returns the old "student_id" field's value of the Application entity.
-// If the Application object wasn't provided to the builder, the object is fetched from the database.
+// OldStudentID returns the old "student_id" field's value of the AdmissionChance entity.
+// If the AdmissionChance object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ApplicationMutation) OldStudentID(ctx context.Context) (v string, err error) {
+func (m *AdmissionChanceMutation) OldStudentID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldStudentID is only allowed on UpdateOne operations")
 	}
@@ -196,241 +201,241 @@ func (m *ApplicationMutation) OldStudentID(ctx context.Context) (v string, err e
 }
 
 // ResetStudentID resets all changes to the "student_id" field.
-func (m *ApplicationMutation) ResetStudentID() {
+func (m *AdmissionChanceMutation) ResetStudentID() {
 	m.student_id = nil
 }
 
-// SetPriority sets the "priority" field.
-func (m *ApplicationMutation) SetPriority(i int) {
-	m.priority = &i
-	m.addpriority = nil
+// SetDrainedPercent sets the "drained_percent" field.
+func (m *AdmissionChanceMutation) SetDrainedPercent(i int) {
+	m.drained_percent = &i
+	m.adddrained_percent = nil
 }
 
-// Priority returns the value of the "priority" field in the mutation.
-func (m *ApplicationMutation) Priority() (r int, exists bool) {
-	v := m.priority
+// DrainedPercent returns the value of the "drained_percent" field in the mutation.
+func (m *AdmissionChanceMutation) DrainedPercent() (r int, exists bool) {
+	v := m.drained_percent
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPriority returns the old "priority" field's value of the Application entity.
-// If the Application object wasn't provided to the builder, the object is fetched from the database.
+// OldDrainedPercent returns the old "drained_percent" field's value of the AdmissionChance entity.
+// If the AdmissionChance object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ApplicationMutation) OldPriority(ctx context.Context) (v int, err error) {
+func (m *AdmissionChanceMutation) OldDrainedPercent(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
+		return v, errors.New("OldDrainedPercent is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPriority requires an ID field in the mutation")
+		return v, errors.New("OldDrainedPercent requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
+		return v, fmt.Errorf("querying old value for OldDrainedPercent: %w", err)
 	}
-	return oldValue.Priority, nil
+	return oldValue.DrainedPercent, nil
 }
 
-// AddPriority adds i to the "priority" field.
-func (m *ApplicationMutation) AddPriority(i int) {
-	if m.addpriority != nil {
-		*m.addpriority += i
+// AddDrainedPercent adds i to the "drained_percent" field.
+func (m *AdmissionChanceMutation) AddDrainedPercent(i int) {
+	if m.adddrained_percent != nil {
+		*m.adddrained_percent += i
 	} else {
-		m.addpriority = &i
+		m.adddrained_percent = &i
 	}
 }
 
-// AddedPriority returns the value that was added to the "priority" field in this mutation.
-func (m *ApplicationMutation) AddedPriority() (r int, exists bool) {
-	v := m.addpriority
+// AddedDrainedPercent returns the value that was added to the "drained_percent" field in this mutation.
+func (m *AdmissionChanceMutation) AddedDrainedPercent() (r int, exists bool) {
+	v := m.adddrained_percent
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetPriority resets all changes to the "priority" field.
-func (m *ApplicationMutation) ResetPriority() {
-	m.priority = nil
-	m.addpriority = nil
+// ResetDrainedPercent resets all changes to the "drained_percent" field.
+func (m *AdmissionChanceMutation) ResetDrainedPercent() {
+	m.drained_percent = nil
+	m.adddrained_percent = nil
 }
 
-// SetCompetitionType sets the "competition_type" field.
-func (m *ApplicationMutation) SetCompetitionType(c core.Competition) {
-	m.competition_type = &c
-	m.addcompetition_type = nil
+// SetAdmittedCount sets the "admitted_count" field.
+func (m *AdmissionChanceMutation) SetAdmittedCount(i int) {
+	m.admitted_count = &i
+	m.addadmitted_count = nil
 }
 
-// CompetitionType returns the value of the "competition_type" field in the mutation.
-func (m *ApplicationMutation) CompetitionType() (r core.Competition, exists bool) {
-	v := m.competition_type
+// AdmittedCount returns the value of the "admitted_count" field in the mutation.
+func (m *AdmissionChanceMutation) AdmittedCount() (r int, exists bool) {
+	v := m.admitted_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCompetitionType returns the old "competition_type" field's value of the Application entity.
-// If the Application object wasn't provided to the builder, the object is fetched from the database.
+// OldAdmittedCount returns the old "admitted_count" field's value of the AdmissionChance entity.
+// If the AdmissionChance object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ApplicationMutation) OldCompetitionType(ctx context.Context) (v core.Competition, err error) {
+func (m *AdmissionChanceMutation) OldAdmittedCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCompetitionType is only allowed on UpdateOne operations")
+		return v, errors.New("OldAdmittedCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCompetitionType requires an ID field in the mutation")
+		return v, errors.New("OldAdmittedCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCompetitionType: %w", err)
+		return v, fmt.Errorf("querying old value for OldAdmittedCount: %w", err)
 	}
-	return oldValue.CompetitionType, nil
+	return oldValue.AdmittedCount, nil
 }
 
-// AddCompetitionType adds c to the "competition_type" field.
-func (m *ApplicationMutation) AddCompetitionType(c core.Competition) {
-	if m.addcompetition_type != nil {
-		*m.addcompetition_type += c
+// AddAdmittedCount adds i to the "admitted_count" field.
+func (m *AdmissionChanceMutation) AddAdmittedCount(i int) {
+	if m.addadmitted_count != nil {
+		*m.addadmitted_count += i
 	} else {
-		m.addcompetition_type = &c
+		m.addadmitted_count = &i
 	}
 }
 
-// AddedCompetitionType returns the value that was added to the "competition_type" field in this mutation.
-func (m *ApplicationMutation) AddedCompetitionType() (r core.Competition, exists bool) {
-	v := m.addcompetition_type
+// AddedAdmittedCount returns the value that was added to the "admitted_count" field in this mutation.
+func (m *AdmissionChanceMutation) AddedAdmittedCount() (r int, exists bool) {
+	v := m.addadmitted_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetCompetitionType resets all changes to the "competition_type" field.
-func (m *ApplicationMutation) ResetCompetitionType() {
-	m.competition_type = nil
-	m.addcompetition_type = nil
+// ResetAdmittedCount resets all changes to the "admitted_count" field.
+func (m *AdmissionChanceMutation) ResetAdmittedCount() {
+	m.admitted_count = nil
+	m.addadmitted_count = nil
 }
 
-// SetRatingPlace sets the "rating_place" field.
-func (m *ApplicationMutation) SetRatingPlace(i int) {
-	m.rating_place = &i
-	m.addrating_place = nil
+// SetIterations sets the "iterations" field.
+func (m *AdmissionChanceMutation) SetIterations(i int) {
+	m.iterations = &i
+	m.additerations = nil
 }
 
-// RatingPlace returns the value of the "rating_place" field in the mutation.
-func (m *ApplicationMutation) RatingPlace() (r int, exists bool) {
-	v := m.rating_place
+// Iterations returns the value of the "iterations" field in the mutation.
+func (m *AdmissionChanceMutation) Iterations() (r int, exists bool) {
+	v := m.iterations
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRatingPlace returns the old "rating_place" field's value of the Application entity.
-// If the Application object wasn't provided to the builder, the object is fetched from the database.
+// OldIterations returns the old "iterations" field's value of the AdmissionChance entity.
+// If the AdmissionChance object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ApplicationMutation) OldRatingPlace(ctx context.Context) (v int, err error) {
+func (m *AdmissionChanceMutation) OldIterations(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRatingPlace is only allowed on UpdateOne operations")
+		return v, errors.New("OldIterations is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRatingPlace requires an ID field in the mutation")
+		return v, errors.New("OldIterations requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRatingPlace: %w", err)
+		return v, fmt.Errorf("querying old value for OldIterations: %w", err)
 	}
-	return oldValue.RatingPlace, nil
+	return oldValue.Iterations, nil
 }
 
-// AddRatingPlace adds i to the "rating_place" field.
-func (m *ApplicationMutation) AddRatingPlace(i int) {
-	if m.addrating_place != nil {
-		*m.addrating_place += i
+// AddIterations adds i to the "iterations" field.
+func (m *AdmissionChanceMutation) AddIterations(i int) {
+	if m.additerations != nil {
+		*m.additerations += i
 	} else {
-		m.addrating_place = &i
+		m.additerations = &i
 	}
 }
 
-// AddedRatingPlace returns the value that was added to the "rating_place" field in this mutation.
-func (m *ApplicationMutation) AddedRatingPlace() (r int, exists bool) {
-	v := m.addrating_place
+// AddedIterations returns the value that was added to the "iterations" field in this mutation.
+func (m *AdmissionChanceMutation) AddedIterations() (r int, exists bool) {
+	v := m.additerations
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetRatingPlace resets all changes to the "rating_place" field.
-func (m *ApplicationMutation) ResetRatingPlace() {
-	m.rating_place = nil
-	m.addrating_place = nil
+// ResetIterations resets all changes to the "iterations" field.
+func (m *AdmissionChanceMutation) ResetIterations() {
+	m.iterations = nil
+	m.additerations = nil
 }
 
-// SetScore sets the "score" field.
-func (m *ApplicationMutation) SetScore(i int) {
-	m.score = &i
-	m.addscore = nil
+// SetProbability sets the "probability" field.
+func (m *AdmissionChanceMutation) SetProbability(f float64) {
+	m.probability = &f
+	m.addprobability = nil
 }
 
-// Score returns the value of the "score" field in the mutation.
-func (m *ApplicationMutation) Score() (r int, exists bool) {
-	v := m.score
+// Probability returns the value of the "probability" field in the mutation.
+func (m *AdmissionChanceMutation) Probability() (r float64, exists bool) {
+	v := m.probability
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldScore returns the old "score" field's value of the Application entity.
-// If the Application object wasn't provided to the builder, the object is fetched from the database.
+// OldProbability returns the old "probability" field's value of the AdmissionChance entity.
+// If the AdmissionChance object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ApplicationMutation) OldScore(ctx context.Context) (v int, err error) {
+func (m *AdmissionChanceMutation) OldProbability(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldScore is only allowed on UpdateOne operations")
+		return v, errors.New("OldProbability is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldScore requires an ID field in the mutation")
+		return v, errors.New("OldProbability requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldScore: %w", err)
+		return v, fmt.Errorf("querying old value for OldProbability: %w", err)
 	}
-	return oldValue.Score, nil
+	return oldValue.Probability, nil
 }
 
-// AddScore adds i to the "score" field.
-func (m *ApplicationMutation) AddScore(i int) {
-	if m.addscore != nil {
-		*m.addscore += i
+// AddProbability adds f to the "probability" field.
+func (m *AdmissionChanceMutation) AddProbability(f float64) {
+	if m.addprobability != nil {
+		*m.addprobability += f
 	} else {
-		m.addscore = &i
+		m.addprobability = &f
 	}
 }
 
-// AddedScore returns the value that was added to the "score" field in this mutation.
-func (m *ApplicationMutation) AddedScore() (r int, exists bool) {
-	v := m.addscore
+// AddedProbability returns the value that was added to the "probability" field in this mutation.
+func (m *AdmissionChanceMutation) AddedProbability() (r float64, exists bool) {
+	v := m.addprobability
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetScore resets all changes to the "score" field.
-func (m *ApplicationMutation) ResetScore() {
-	m.score = nil
-	m.addscore = nil
+// ResetProbability resets all changes to the "probability" field.
+func (m *AdmissionChanceMutation) ResetProbability() {
+	m.probability = nil
+	m.addprobability = nil
 }
 
 // SetRunID sets the "run_id" field.
-func (m *ApplicationMutation) SetRunID(i int) {
+func (m *AdmissionChanceMutation) SetRunID(i int) {
 	m.run = &i
 }
 
 // RunID returns the value of the "run_id" field in the mutation.
-func (m *ApplicationMutation) RunID() (r int, exists bool) {
+func (m *AdmissionChanceMutation) RunID() (r int, exists bool) {
 	v := m.run
 	if v == nil {
 		return
@@ -438,10 +443,10 @@ func (m *ApplicationMutation) RunID() (r int, exists bool) {
 	return *v, true
 }
 
-// OldRunID returns the old "run_id" field's value of the Application entity.
-// If the Application object wasn't provided to the builder, the object is fetched from the database.
+// OldRunID returns the old "run_id" field's value of the AdmissionChance entity.
+// If the AdmissionChance object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ApplicationMutation) OldRunID(ctx context.Context) (v int, err error) {
+func (m *AdmissionChanceMutation) OldRunID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldRunID is only allowed on UpdateOne operations")
 	}
@@ -456,185 +461,64 @@ func (m *ApplicationMutation) OldRunID(ctx context.Context) (v int, err error) {
 }
 
 // ResetRunID resets all changes to the "run_id" field.
-func (m *ApplicationMutation) ResetRunID() {
+func (m *AdmissionChanceMutation) ResetRunID() {
 	m.run = nil
 }
 
-// SetOriginalSubmitted sets the "original_submitted" field.
-func (m *ApplicationMutation) SetOriginalSubmitted(b bool) {
-	m.original_submitted = &b
-}
-
-// OriginalSubmitted returns the value of the "original_submitted" field in the mutation.
-func (m *ApplicationMutation) OriginalSubmitted() (r bool, exists bool) {
-	v := m.original_submitted
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldOriginalSubmitted returns the old "original_submitted" field's value of the Application entity.
-// If the Application object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ApplicationMutation) OldOriginalSubmitted(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOriginalSubmitted is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOriginalSubmitted requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOriginalSubmitted: %w", err)
-	}
-	return oldValue.OriginalSubmitted, nil
+// SetHeadingID sets the "heading" edge to the Heading entity by id.
+func (m *AdmissionChanceMutation) SetHeadingID(id int) {
+	m.heading = &id
 }
 
-// ResetOriginalSubmitted resets all changes to the "original_submitted" field.
-func (m *ApplicationMutation) ResetOriginalSubmitted() {
-	m.original_submitted = nil
+// ClearHeading clears the "heading" edge to the Heading entity.
+func (m *AdmissionChanceMutation) ClearHeading() {
+	m.clearedheading = true
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *ApplicationMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// HeadingCleared reports if the "heading" edge to the Heading entity was cleared.
+func (m *AdmissionChanceMutation) HeadingCleared() bool {
+	return m.clearedheading
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ApplicationMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
+// HeadingID returns the "heading" edge ID in the mutation.
+func (m *AdmissionChanceMutation) HeadingID() (id int, exists bool) {
+	if m.heading != nil {
+		return *m.heading, true
 	}
-	return *v, true
+	return
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Application entity.
-// If the Application object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ApplicationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+// HeadingIDs returns the "heading" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// HeadingID instead. It exists only for internal usage by the builders.
+func (m *AdmissionChanceMutation) HeadingIDs() (ids []int) {
+	if id := m.heading; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.UpdatedAt, nil
+	return
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ApplicationMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetHeading resets all changes to the "heading" edge.
+func (m *AdmissionChanceMutation) ResetHeading() {
+	m.heading = nil
+	m.clearedheading = false
 }
 
-// SetMsuInternalID sets the "msu_internal_id" field.
-func (m *ApplicationMutation) SetMsuInternalID(s string) {
-	m.msu_internal_id = &s
-}
-
-// MsuInternalID returns the value of the "msu_internal_id" field in the mutation.
-func (m *ApplicationMutation) MsuInternalID() (r string, exists bool) {
-	v := m.msu_internal_id
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldMsuInternalID returns the old "msu_internal_id" field's value of the Application entity.
-// If the Application object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ApplicationMutation) OldMsuInternalID(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMsuInternalID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMsuInternalID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMsuInternalID: %w", err)
-	}
-	return oldValue.MsuInternalID, nil
-}
-
-// ClearMsuInternalID clears the value of the "msu_internal_id" field.
-func (m *ApplicationMutation) ClearMsuInternalID() {
-	m.msu_internal_id = nil
-	m.clearedFields[application.FieldMsuInternalID] = struct{}{}
-}
-
-// MsuInternalIDCleared returns if the "msu_internal_id" field was cleared in this mutation.
-func (m *ApplicationMutation) MsuInternalIDCleared() bool {
-	_, ok := m.clearedFields[application.FieldMsuInternalID]
-	return ok
-}
-
-// ResetMsuInternalID resets all changes to the "msu_internal_id" field.
-func (m *ApplicationMutation) ResetMsuInternalID() {
-	m.msu_internal_id = nil
-	delete(m.clearedFields, application.FieldMsuInternalID)
-}
-
-// SetHeadingID sets the "heading" edge to the Heading entity by id.
-func (m *ApplicationMutation) SetHeadingID(id int) {
-	m.heading = &id
-}
-
-// ClearHeading clears the "heading" edge to the Heading entity.
-func (m *ApplicationMutation) ClearHeading() {
-	m.clearedheading = true
-}
-
-// HeadingCleared reports if the "heading" edge to the Heading entity was cleared.
-func (m *ApplicationMutation) HeadingCleared() bool {
-	return m.clearedheading
-}
-
-// HeadingID returns the "heading" edge ID in the mutation.
-func (m *ApplicationMutation) HeadingID() (id int, exists bool) {
-	if m.heading != nil {
-		return *m.heading, true
-	}
-	return
-}
-
-// HeadingIDs returns the "heading" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// HeadingID instead. It exists only for internal usage by the builders.
-func (m *ApplicationMutation) HeadingIDs() (ids []int) {
-	if id := m.heading; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetHeading resets all changes to the "heading" edge.
-func (m *ApplicationMutation) ResetHeading() {
-	m.heading = nil
-	m.clearedheading = false
-}
-
-// ClearRun clears the "run" edge to the Run entity.
-func (m *ApplicationMutation) ClearRun() {
-	m.clearedrun = true
-	m.clearedFields[application.FieldRunID] = struct{}{}
+// ClearRun clears the "run" edge to the Run entity.
+func (m *AdmissionChanceMutation) ClearRun() {
+	m.clearedrun = true
+	m.clearedFields[admissionchance.FieldRunID] = struct{}{}
 }
 
 // RunCleared reports if the "run" edge to the Run entity was cleared.
-func (m *ApplicationMutation) RunCleared() bool {
+func (m *AdmissionChanceMutation) RunCleared() bool {
 	return m.clearedrun
 }
 
 // RunIDs returns the "run" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // RunID instead. It exists only for internal usage by the builders.
-func (m *ApplicationMutation) RunIDs() (ids []int) {
+func (m *AdmissionChanceMutation) RunIDs() (ids []int) {
 	if id := m.run; id != nil {
 		ids = append(ids, *id)
 	}
@@ -642,20 +526,20 @@ func (m *ApplicationMutation) RunIDs() (ids []int) {
 }
 
 // ResetRun resets all changes to the "run" edge.
-func (m *ApplicationMutation) ResetRun() {
+func (m *AdmissionChanceMutation) ResetRun() {
 	m.run = nil
 	m.clearedrun = false
 }
 
-// Where appends a list predicates to the ApplicationMutation builder.
-func (m *ApplicationMutation) Where(ps ...predicate.Application) {
+// Where appends a list predicates to the AdmissionChanceMutation builder.
+func (m *AdmissionChanceMutation) Where(ps ...predicate.AdmissionChance) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ApplicationMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AdmissionChanceMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ApplicationMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Application, len(ps))
+func (m *AdmissionChanceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AdmissionChance, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -663,51 +547,42 @@ func (m *ApplicationMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ApplicationMutation) Op() Op {
+func (m *AdmissionChanceMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ApplicationMutation) SetOp(op Op) {
+func (m *AdmissionChanceMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Application).
-func (m *ApplicationMutation) Type() string {
+// Type returns the node type of this mutation (AdmissionChance).
+func (m *AdmissionChanceMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ApplicationMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+func (m *AdmissionChanceMutation) Fields() []string {
+	fields := make([]string, 0, 6)
 	if m.student_id != nil {
-		fields = append(fields, application.FieldStudentID)
+		fields = append(fields, admissionchance.FieldStudentID)
 	}
-	if m.priority != nil {
-		fields = append(fields, application.FieldPriority)
+	if m.drained_percent != nil {
+		fields = append(fields, admissionchance.FieldDrainedPercent)
 	}
-	if m.competition_type != nil {
-		fields = append(fields, application.FieldCompetitionType)
+	if m.admitted_count != nil {
+		fields = append(fields, admissionchance.FieldAdmittedCount)
 	}
-	if m.rating_place != nil {
-		fields = append(fields, application.FieldRatingPlace)
+	if m.iterations != nil {
+		fields = append(fields, admissionchance.FieldIterations)
 	}
-	if m.score != nil {
-		fields = append(fields, application.FieldScore)
+	if m.probability != nil {
+		fields = append(fields, admissionchance.FieldProbability)
 	}
 	if m.run != nil {
-		fields = append(fields, application.FieldRunID)
-	}
-	if m.original_submitted != nil {
-		fields = append(fields, application.FieldOriginalSubmitted)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, application.FieldUpdatedAt)
-	}
-	if m.msu_internal_id != nil {
-		fields = append(fields, application.FieldMsuInternalID)
+		fields = append(fields, admissionchance.FieldRunID)
 	}
 	return fields
 }
@@ -715,26 +590,20 @@ func (m *ApplicationMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ApplicationMutation) Field(name string) (ent.Value, bool) {
+func (m *AdmissionChanceMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case application.FieldStudentID:
+	case admissionchance.FieldStudentID:
 		return m.StudentID()
-	case application.FieldPriority:
-		return m.Priority()
-	case application.FieldCompetitionType:
-		return m.CompetitionType()
-	case application.FieldRatingPlace:
-		return m.RatingPlace()
-	case application.FieldScore:
-		return m.Score()
-	case application.FieldRunID:
+	case admissionchance.FieldDrainedPercent:
+		return m.DrainedPercent()
+	case admissionchance.FieldAdmittedCount:
+		return m.AdmittedCount()
+	case admissionchance.FieldIterations:
+		return m.Iterations()
+	case admissionchance.FieldProbability:
+		return m.Probability()
+	case admissionchance.FieldRunID:
 		return m.RunID()
-	case application.FieldOriginalSubmitted:
-		return m.OriginalSubmitted()
-	case application.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case application.FieldMsuInternalID:
-		return m.MsuInternalID()
 	}
 	return nil, false
 }
@@ -742,117 +611,90 @@ func (m *ApplicationMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ApplicationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AdmissionChanceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case application.FieldStudentID:
+	case admissionchance.FieldStudentID:
 		return m.OldStudentID(ctx)
-	case application.FieldPriority:
-		return m.OldPriority(ctx)
-	case application.FieldCompetitionType:
-		return m.OldCompetitionType(ctx)
-	case application.FieldRatingPlace:
-		return m.OldRatingPlace(ctx)
-	case application.FieldScore:
-		return m.OldScore(ctx)
-	case application.FieldRunID:
+	case admissionchance.FieldDrainedPercent:
+		return m.OldDrainedPercent(ctx)
+	case admissionchance.FieldAdmittedCount:
+		return m.OldAdmittedCount(ctx)
+	case admissionchance.FieldIterations:
+		return m.OldIterations(ctx)
+	case admissionchance.FieldProbability:
+		return m.OldProbability(ctx)
+	case admissionchance.FieldRunID:
 		return m.OldRunID(ctx)
-	case application.FieldOriginalSubmitted:
-		return m.OldOriginalSubmitted(ctx)
-	case application.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case application.FieldMsuInternalID:
-		return m.OldMsuInternalID(ctx)
 	}
-	return nil, fmt.Errorf("unknown Application field %s", name)
+	return nil, fmt.Errorf("unknown AdmissionChance field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ApplicationMutation) SetField(name string, value ent.Value) error {
+func (m *AdmissionChanceMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case application.FieldStudentID:
+	case admissionchance.FieldStudentID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetStudentID(v)
 		return nil
-	case application.FieldPriority:
+	case admissionchance.FieldDrainedPercent:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPriority(v)
+		m.SetDrainedPercent(v)
 		return nil
-	case application.FieldCompetitionType:
-		v, ok := value.(core.Competition)
+	case admissionchance.FieldAdmittedCount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCompetitionType(v)
+		m.SetAdmittedCount(v)
 		return nil
-	case application.FieldRatingPlace:
+	case admissionchance.FieldIterations:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRatingPlace(v)
+		m.SetIterations(v)
 		return nil
-	case application.FieldScore:
-		v, ok := value.(int)
+	case admissionchance.FieldProbability:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetScore(v)
+		m.SetProbability(v)
 		return nil
-	case application.FieldRunID:
+	case admissionchance.FieldRunID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetRunID(v)
 		return nil
-	case application.FieldOriginalSubmitted:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOriginalSubmitted(v)
-		return nil
-	case application.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case application.FieldMsuInternalID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMsuInternalID(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Application field %s", name)
+	return fmt.Errorf("unknown AdmissionChance field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ApplicationMutation) AddedFields() []string {
+func (m *AdmissionChanceMutation) AddedFields() []string {
 	var fields []string
-	if m.addpriority != nil {
-		fields = append(fields, application.FieldPriority)
+	if m.adddrained_percent != nil {
+		fields = append(fields, admissionchance.FieldDrainedPercent)
 	}
-	if m.addcompetition_type != nil {
-		fields = append(fields, application.FieldCompetitionType)
+	if m.addadmitted_count != nil {
+		fields = append(fields, admissionchance.FieldAdmittedCount)
 	}
-	if m.addrating_place != nil {
-		fields = append(fields, application.FieldRatingPlace)
+	if m.additerations != nil {
+		fields = append(fields, admissionchance.FieldIterations)
 	}
-	if m.addscore != nil {
-		fields = append(fields, application.FieldScore)
+	if m.addprobability != nil {
+		fields = append(fields, admissionchance.FieldProbability)
 	}
 	return fields
 }
@@ -860,16 +702,16 @@ func (m *ApplicationMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ApplicationMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AdmissionChanceMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case application.FieldPriority:
-		return m.AddedPriority()
-	case application.FieldCompetitionType:
-		return m.AddedCompetitionType()
-	case application.FieldRatingPlace:
-		return m.AddedRatingPlace()
-	case application.FieldScore:
-		return m.AddedScore()
+	case admissionchance.FieldDrainedPercent:
+		return m.AddedDrainedPercent()
+	case admissionchance.FieldAdmittedCount:
+		return m.AddedAdmittedCount()
+	case admissionchance.FieldIterations:
+		return m.AddedIterations()
+	case admissionchance.FieldProbability:
+		return m.AddedProbability()
 	}
 	return nil, false
 }
@@ -877,124 +719,106 @@ func (m *ApplicationMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ApplicationMutation) AddField(name string, value ent.Value) error {
+func (m *AdmissionChanceMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case application.FieldPriority:
+	case admissionchance.FieldDrainedPercent:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddPriority(v)
+		m.AddDrainedPercent(v)
 		return nil
-	case application.FieldCompetitionType:
-		v, ok := value.(core.Competition)
+	case admissionchance.FieldAdmittedCount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddCompetitionType(v)
+		m.AddAdmittedCount(v)
 		return nil
-	case application.FieldRatingPlace:
+	case admissionchance.FieldIterations:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddRatingPlace(v)
+		m.AddIterations(v)
 		return nil
-	case application.FieldScore:
-		v, ok := value.(int)
+	case admissionchance.FieldProbability:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddScore(v)
+		m.AddProbability(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Application numeric field %s", name)
+	return fmt.Errorf("unknown AdmissionChance numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ApplicationMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(application.FieldMsuInternalID) {
-		fields = append(fields, application.FieldMsuInternalID)
-	}
-	return fields
+func (m *AdmissionChanceMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ApplicationMutation) FieldCleared(name string) bool {
+func (m *AdmissionChanceMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ApplicationMutation) ClearField(name string) error {
-	switch name {
-	case application.FieldMsuInternalID:
-		m.ClearMsuInternalID()
-		return nil
-	}
-	return fmt.Errorf("unknown Application nullable field %s", name)
+func (m *AdmissionChanceMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown AdmissionChance nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ApplicationMutation) ResetField(name string) error {
+func (m *AdmissionChanceMutation) ResetField(name string) error {
 	switch name {
-	case application.FieldStudentID:
+	case admissionchance.FieldStudentID:
 		m.ResetStudentID()
 		return nil
-	case application.FieldPriority:
-		m.ResetPriority()
+	case admissionchance.FieldDrainedPercent:
+		m.ResetDrainedPercent()
 		return nil
-	case application.FieldCompetitionType:
-		m.ResetCompetitionType()
+	case admissionchance.FieldAdmittedCount:
+		m.ResetAdmittedCount()
 		return nil
-	case application.FieldRatingPlace:
-		m.ResetRatingPlace()
+	case admissionchance.FieldIterations:
+		m.ResetIterations()
 		return nil
-	case application.FieldScore:
-		m.ResetScore()
+	case admissionchance.FieldProbability:
+		m.ResetProbability()
 		return nil
-	case application.FieldRunID:
+	case admissionchance.FieldRunID:
 		m.ResetRunID()
 		return nil
-	case application.FieldOriginalSubmitted:
-		m.ResetOriginalSubmitted()
-		return nil
-	case application.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case application.FieldMsuInternalID:
-		m.ResetMsuInternalID()
-		return nil
 	}
-	return fmt.Errorf("unknown Application field %s", name)
+	return fmt.Errorf("unknown AdmissionChance field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ApplicationMutation) AddedEdges() []string {
+func (m *AdmissionChanceMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
 	if m.heading != nil {
-		edges = append(edges, application.EdgeHeading)
+		edges = append(edges, admissionchance.EdgeHeading)
 	}
 	if m.run != nil {
-		edges = append(edges, application.EdgeRun)
+		edges = append(edges, admissionchance.EdgeRun)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ApplicationMutation) AddedIDs(name string) []ent.Value {
+func (m *AdmissionChanceMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case application.EdgeHeading:
+	case admissionchance.EdgeHeading:
 		if id := m.heading; id != nil {
 			return []ent.Value{*id}
 		}
-	case application.EdgeRun:
+	case admissionchance.EdgeRun:
 		if id := m.run; id != nil {
 			return []ent.Value{*id}
 		}
@@ -1003,36 +827,36 @@ func (m *ApplicationMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ApplicationMutation) RemovedEdges() []string {
+func (m *AdmissionChanceMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ApplicationMutation) RemovedIDs(name string) []ent.Value {
+func (m *AdmissionChanceMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ApplicationMutation) ClearedEdges() []string {
+func (m *AdmissionChanceMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
 	if m.clearedheading {
-		edges = append(edges, application.EdgeHeading)
+		edges = append(edges, admissionchance.EdgeHeading)
 	}
 	if m.clearedrun {
-		edges = append(edges, application.EdgeRun)
+		edges = append(edges, admissionchance.EdgeRun)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ApplicationMutation) EdgeCleared(name string) bool {
+func (m *AdmissionChanceMutation) EdgeCleared(name string) bool {
 	switch name {
-	case application.EdgeHeading:
+	case admissionchance.EdgeHeading:
 		return m.clearedheading
-	case application.EdgeRun:
+	case admissionchance.EdgeRun:
 		return m.clearedrun
 	}
 	return false
@@ -1040,63 +864,71 @@ func (m *ApplicationMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ApplicationMutation) ClearEdge(name string) error {
+func (m *AdmissionChanceMutation) ClearEdge(name string) error {
 	switch name {
-	case application.EdgeHeading:
+	case admissionchance.EdgeHeading:
 		m.ClearHeading()
 		return nil
-	case application.EdgeRun:
+	case admissionchance.EdgeRun:
 		m.ClearRun()
 		return nil
 	}
-	return fmt.Errorf("unknown Application unique edge %s", name)
+	return fmt.Errorf("unknown AdmissionChance unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ApplicationMutation) ResetEdge(name string) error {
+func (m *AdmissionChanceMutation) ResetEdge(name string) error {
 	switch name {
-	case application.EdgeHeading:
+	case admissionchance.EdgeHeading:
 		m.ResetHeading()
 		return nil
-	case application.EdgeRun:
+	case admissionchance.EdgeRun:
 		m.ResetRun()
 		return nil
 	}
-	return fmt.Errorf("unknown Application edge %s", name)
+	return fmt.Errorf("unknown AdmissionChance edge %s", name)
 }
 
-// CalculationMutation represents an operation that mutates the Calculation nodes in the graph.
-type CalculationMutation struct {
+// AdmissionEventMutation represents an operation that mutates the AdmissionEvent nodes in the graph.
+type AdmissionEventMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *int
-	student_id        *string
-	admitted_place    *int
-	addadmitted_place *int
-	updated_at        *time.Time
-	clearedFields     map[string]struct{}
-	heading           *int
-	clearedheading    bool
-	run               *int
-	clearedrun        bool
-	done              bool
-	oldValue          func(context.Context) (*Calculation, error)
-	predicates        []predicate.Calculation
+	op                  Op
+	typ                 string
+	id                  *int
+	seq                 *int
+	addseq              *int
+	kind                *core.TraceEventKind
+	addkind             *core.TraceEventKind
+	stage               *core.AdmissionStage
+	addstage            *core.AdmissionStage
+	student_id          *string
+	priority            *int
+	addpriority         *int
+	competition_type    *core.Competition
+	addcompetition_type *core.Competition
+	other_student_id    *string
+	clearedFields       map[string]struct{}
+	heading             *int
+	clearedheading      bool
+	run                 *int
+	clearedrun          bool
+	done                bool
+	oldValue            func(context.Context) (*AdmissionEvent, error)
+	predicates          []predicate.AdmissionEvent
 }
 
-var _ ent.Mutation = (*CalculationMutation)(nil)
+var _ ent.Mutation = (*AdmissionEventMutation)(nil)
 
-// calculationOption allows management of the mutation configuration using functional options.
-type calculationOption func(*CalculationMutation)
+// admissioneventOption allows management of the mutation configuration using functional options.
+type admissioneventOption func(*AdmissionEventMutation)
 
-// newCalculationMutation creates new mutation for the Calculation entity.
-func newCalculationMutation(c config, op Op, opts ...calculationOption) *CalculationMutation {
-	m := &CalculationMutation{
+// newAdmissionEventMutation creates new mutation for the AdmissionEvent entity.
+func newAdmissionEventMutation(c config, op Op, opts ...admissioneventOption) *AdmissionEventMutation {
+	m := &AdmissionEventMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeCalculation,
+		typ:           TypeAdmissionEvent,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1105,20 +937,20 @@ func newCalculationMutation(c config, op Op, opts ...calculationOption) *Calcula
 	return m
 }
 
-// withCalculationID sets the ID field of the mutation.
-func withCalculationID(id int) calculationOption {
-	return func(m *CalculationMutation) {
+// withAdmissionEventID sets the ID field of the mutation.
+func withAdmissionEventID(id int) admissioneventOption {
+	return func(m *AdmissionEventMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Calculation
+			value *AdmissionEvent
 		)
-		m.oldValue = func(ctx context.Context) (*Calculation, error) {
+		m.oldValue = func(ctx context.Context) (*AdmissionEvent, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Calculation.Get(ctx, id)
+					value, err = m.Client().AdmissionEvent.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1127,10 +959,10 @@ func withCalculationID(id int) calculationOption {
 	}
 }
 
-// withCalculation sets the old Calculation of the mutation.
-func withCalculation(node *Calculation) calculationOption {
-	return func(m *CalculationMutation) {
-		m.oldValue = func(context.Context) (*Calculation, error) {
+// withAdmissionEvent sets the old AdmissionEvent of the mutation.
+func withAdmissionEvent(node *AdmissionEvent) admissioneventOption {
+	return func(m *AdmissionEventMutation) {
+		m.oldValue = func(context.Context) (*AdmissionEvent, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1139,7 +971,7 @@ func withCalculation(node *Calculation) calculationOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CalculationMutation) Client() *Client {
+func (m AdmissionEventMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1147,7 +979,7 @@ func (m CalculationMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m CalculationMutation) Tx() (*Tx, error) {
+func (m AdmissionEventMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1158,7 +990,7 @@ func (m CalculationMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *CalculationMutation) ID() (id int, exists bool) {
+func (m *AdmissionEventMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1169,7 +1001,7 @@ func (m *CalculationMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *CalculationMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AdmissionEventMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1178,251 +1010,502 @@ func (m *CalculationMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Calculation.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AdmissionEvent.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetStudentID sets the "student_id" field.
-func (m *CalculationMutation) SetStudentID(s string) {
-	m.student_id = &s
+// SetSeq sets the "seq" field.
+func (m *AdmissionEventMutation) SetSeq(i int) {
+	m.seq = &i
+	m.addseq = nil
 }
 
-// StudentID returns the value of the "student_id" field in the mutation.
-func (m *CalculationMutation) StudentID() (r string, exists bool) {
-	v := m.student_id
+// Seq returns the value of the "seq" field in the mutation.
+func (m *AdmissionEventMutation) Seq() (r int, exists bool) {
+	v := m.seq
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStudentID returns the old "student_id" field's value of the Calculation entity.
-// If the Calculation object wasn't provided to the builder, the object is fetched from the database.
+// OldSeq returns the old "seq" field's value of the AdmissionEvent entity.
+// If the AdmissionEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CalculationMutation) OldStudentID(ctx context.Context) (v string, err error) {
+func (m *AdmissionEventMutation) OldSeq(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStudentID is only allowed on UpdateOne operations")
+		return v, errors.New("OldSeq is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStudentID requires an ID field in the mutation")
+		return v, errors.New("OldSeq requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStudentID: %w", err)
+		return v, fmt.Errorf("querying old value for OldSeq: %w", err)
 	}
-	return oldValue.StudentID, nil
+	return oldValue.Seq, nil
 }
 
-// ResetStudentID resets all changes to the "student_id" field.
-func (m *CalculationMutation) ResetStudentID() {
-	m.student_id = nil
+// AddSeq adds i to the "seq" field.
+func (m *AdmissionEventMutation) AddSeq(i int) {
+	if m.addseq != nil {
+		*m.addseq += i
+	} else {
+		m.addseq = &i
+	}
 }
 
-// SetAdmittedPlace sets the "admitted_place" field.
-func (m *CalculationMutation) SetAdmittedPlace(i int) {
-	m.admitted_place = &i
-	m.addadmitted_place = nil
+// AddedSeq returns the value that was added to the "seq" field in this mutation.
+func (m *AdmissionEventMutation) AddedSeq() (r int, exists bool) {
+	v := m.addseq
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AdmittedPlace returns the value of the "admitted_place" field in the mutation.
-func (m *CalculationMutation) AdmittedPlace() (r int, exists bool) {
-	v := m.admitted_place
+// ResetSeq resets all changes to the "seq" field.
+func (m *AdmissionEventMutation) ResetSeq() {
+	m.seq = nil
+	m.addseq = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *AdmissionEventMutation) SetKind(cek core.TraceEventKind) {
+	m.kind = &cek
+	m.addkind = nil
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *AdmissionEventMutation) Kind() (r core.TraceEventKind, exists bool) {
+	v := m.kind
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAdmittedPlace returns the old "admitted_place" field's value of the Calculation entity.
-// If the Calculation object wasn't provided to the builder, the object is fetched from the database.
+// OldKind returns the old "kind" field's value of the AdmissionEvent entity.
+// If the AdmissionEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CalculationMutation) OldAdmittedPlace(ctx context.Context) (v int, err error) {
+func (m *AdmissionEventMutation) OldKind(ctx context.Context) (v core.TraceEventKind, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAdmittedPlace is only allowed on UpdateOne operations")
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAdmittedPlace requires an ID field in the mutation")
+		return v, errors.New("OldKind requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAdmittedPlace: %w", err)
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
 	}
-	return oldValue.AdmittedPlace, nil
+	return oldValue.Kind, nil
 }
 
-// AddAdmittedPlace adds i to the "admitted_place" field.
-func (m *CalculationMutation) AddAdmittedPlace(i int) {
-	if m.addadmitted_place != nil {
-		*m.addadmitted_place += i
+// AddKind adds cek to the "kind" field.
+func (m *AdmissionEventMutation) AddKind(cek core.TraceEventKind) {
+	if m.addkind != nil {
+		*m.addkind += cek
 	} else {
-		m.addadmitted_place = &i
+		m.addkind = &cek
 	}
 }
 
-// AddedAdmittedPlace returns the value that was added to the "admitted_place" field in this mutation.
-func (m *CalculationMutation) AddedAdmittedPlace() (r int, exists bool) {
-	v := m.addadmitted_place
+// AddedKind returns the value that was added to the "kind" field in this mutation.
+func (m *AdmissionEventMutation) AddedKind() (r core.TraceEventKind, exists bool) {
+	v := m.addkind
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetAdmittedPlace resets all changes to the "admitted_place" field.
-func (m *CalculationMutation) ResetAdmittedPlace() {
-	m.admitted_place = nil
-	m.addadmitted_place = nil
+// ResetKind resets all changes to the "kind" field.
+func (m *AdmissionEventMutation) ResetKind() {
+	m.kind = nil
+	m.addkind = nil
 }
 
-// SetRunID sets the "run_id" field.
-func (m *CalculationMutation) SetRunID(i int) {
-	m.run = &i
+// SetStage sets the "stage" field.
+func (m *AdmissionEventMutation) SetStage(cs core.AdmissionStage) {
+	m.stage = &cs
+	m.addstage = nil
 }
 
-// RunID returns the value of the "run_id" field in the mutation.
-func (m *CalculationMutation) RunID() (r int, exists bool) {
-	v := m.run
+// Stage returns the value of the "stage" field in the mutation.
+func (m *AdmissionEventMutation) Stage() (r core.AdmissionStage, exists bool) {
+	v := m.stage
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRunID returns the old "run_id" field's value of the Calculation entity.
-// If the Calculation object wasn't provided to the builder, the object is fetched from the database.
+// OldStage returns the old "stage" field's value of the AdmissionEvent entity.
+// If the AdmissionEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CalculationMutation) OldRunID(ctx context.Context) (v int, err error) {
+func (m *AdmissionEventMutation) OldStage(ctx context.Context) (v core.AdmissionStage, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRunID is only allowed on UpdateOne operations")
+		return v, errors.New("OldStage is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRunID requires an ID field in the mutation")
+		return v, errors.New("OldStage requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRunID: %w", err)
+		return v, fmt.Errorf("querying old value for OldStage: %w", err)
 	}
-	return oldValue.RunID, nil
+	return oldValue.Stage, nil
 }
 
-// ResetRunID resets all changes to the "run_id" field.
-func (m *CalculationMutation) ResetRunID() {
-	m.run = nil
+// AddStage adds cs to the "stage" field.
+func (m *AdmissionEventMutation) AddStage(cs core.AdmissionStage) {
+	if m.addstage != nil {
+		*m.addstage += cs
+	} else {
+		m.addstage = &cs
+	}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *CalculationMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// AddedStage returns the value that was added to the "stage" field in this mutation.
+func (m *AdmissionEventMutation) AddedStage() (r core.AdmissionStage, exists bool) {
+	v := m.addstage
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *CalculationMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ClearStage clears the value of the "stage" field.
+func (m *AdmissionEventMutation) ClearStage() {
+	m.stage = nil
+	m.addstage = nil
+	m.clearedFields[admissionevent.FieldStage] = struct{}{}
+}
+
+// StageCleared returns if the "stage" field was cleared in this mutation.
+func (m *AdmissionEventMutation) StageCleared() bool {
+	_, ok := m.clearedFields[admissionevent.FieldStage]
+	return ok
+}
+
+// ResetStage resets all changes to the "stage" field.
+func (m *AdmissionEventMutation) ResetStage() {
+	m.stage = nil
+	m.addstage = nil
+	delete(m.clearedFields, admissionevent.FieldStage)
+}
+
+// SetStudentID sets the "student_id" field.
+func (m *AdmissionEventMutation) SetStudentID(s string) {
+	m.student_id = &s
+}
+
+// StudentID returns the value of the "student_id" field in the mutation.
+func (m *AdmissionEventMutation) StudentID() (r string, exists bool) {
+	v := m.student_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Calculation entity.
-// If the Calculation object wasn't provided to the builder, the object is fetched from the database.
+// OldStudentID returns the old "student_id" field's value of the AdmissionEvent entity.
+// If the AdmissionEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CalculationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AdmissionEventMutation) OldStudentID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldStudentID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldStudentID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldStudentID: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.StudentID, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *CalculationMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetStudentID resets all changes to the "student_id" field.
+func (m *AdmissionEventMutation) ResetStudentID() {
+	m.student_id = nil
 }
 
-// SetHeadingID sets the "heading" edge to the Heading entity by id.
-func (m *CalculationMutation) SetHeadingID(id int) {
-	m.heading = &id
+// SetPriority sets the "priority" field.
+func (m *AdmissionEventMutation) SetPriority(i int) {
+	m.priority = &i
+	m.addpriority = nil
 }
 
-// ClearHeading clears the "heading" edge to the Heading entity.
-func (m *CalculationMutation) ClearHeading() {
-	m.clearedheading = true
+// Priority returns the value of the "priority" field in the mutation.
+func (m *AdmissionEventMutation) Priority() (r int, exists bool) {
+	v := m.priority
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// HeadingCleared reports if the "heading" edge to the Heading entity was cleared.
-func (m *CalculationMutation) HeadingCleared() bool {
-	return m.clearedheading
+// OldPriority returns the old "priority" field's value of the AdmissionEvent entity.
+// If the AdmissionEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AdmissionEventMutation) OldPriority(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriority requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
+	}
+	return oldValue.Priority, nil
 }
 
-// HeadingID returns the "heading" edge ID in the mutation.
-func (m *CalculationMutation) HeadingID() (id int, exists bool) {
-	if m.heading != nil {
-		return *m.heading, true
+// AddPriority adds i to the "priority" field.
+func (m *AdmissionEventMutation) AddPriority(i int) {
+	if m.addpriority != nil {
+		*m.addpriority += i
+	} else {
+		m.addpriority = &i
 	}
-	return
 }
 
-// HeadingIDs returns the "heading" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// HeadingID instead. It exists only for internal usage by the builders.
-func (m *CalculationMutation) HeadingIDs() (ids []int) {
-	if id := m.heading; id != nil {
-		ids = append(ids, *id)
+// AddedPriority returns the value that was added to the "priority" field in this mutation.
+func (m *AdmissionEventMutation) AddedPriority() (r int, exists bool) {
+	v := m.addpriority
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetHeading resets all changes to the "heading" edge.
-func (m *CalculationMutation) ResetHeading() {
-	m.heading = nil
-	m.clearedheading = false
+// ResetPriority resets all changes to the "priority" field.
+func (m *AdmissionEventMutation) ResetPriority() {
+	m.priority = nil
+	m.addpriority = nil
 }
 
-// ClearRun clears the "run" edge to the Run entity.
-func (m *CalculationMutation) ClearRun() {
-	m.clearedrun = true
-	m.clearedFields[calculation.FieldRunID] = struct{}{}
+// SetCompetitionType sets the "competition_type" field.
+func (m *AdmissionEventMutation) SetCompetitionType(c core.Competition) {
+	m.competition_type = &c
+	m.addcompetition_type = nil
 }
 
-// RunCleared reports if the "run" edge to the Run entity was cleared.
-func (m *CalculationMutation) RunCleared() bool {
-	return m.clearedrun
+// CompetitionType returns the value of the "competition_type" field in the mutation.
+func (m *AdmissionEventMutation) CompetitionType() (r core.Competition, exists bool) {
+	v := m.competition_type
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RunIDs returns the "run" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// RunID instead. It exists only for internal usage by the builders.
-func (m *CalculationMutation) RunIDs() (ids []int) {
-	if id := m.run; id != nil {
-		ids = append(ids, *id)
+// OldCompetitionType returns the old "competition_type" field's value of the AdmissionEvent entity.
+// If the AdmissionEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AdmissionEventMutation) OldCompetitionType(ctx context.Context) (v core.Competition, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompetitionType is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompetitionType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompetitionType: %w", err)
+	}
+	return oldValue.CompetitionType, nil
 }
 
-// ResetRun resets all changes to the "run" edge.
-func (m *CalculationMutation) ResetRun() {
+// AddCompetitionType adds c to the "competition_type" field.
+func (m *AdmissionEventMutation) AddCompetitionType(c core.Competition) {
+	if m.addcompetition_type != nil {
+		*m.addcompetition_type += c
+	} else {
+		m.addcompetition_type = &c
+	}
+}
+
+// AddedCompetitionType returns the value that was added to the "competition_type" field in this mutation.
+func (m *AdmissionEventMutation) AddedCompetitionType() (r core.Competition, exists bool) {
+	v := m.addcompetition_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCompetitionType resets all changes to the "competition_type" field.
+func (m *AdmissionEventMutation) ResetCompetitionType() {
+	m.competition_type = nil
+	m.addcompetition_type = nil
+}
+
+// SetOtherStudentID sets the "other_student_id" field.
+func (m *AdmissionEventMutation) SetOtherStudentID(s string) {
+	m.other_student_id = &s
+}
+
+// OtherStudentID returns the value of the "other_student_id" field in the mutation.
+func (m *AdmissionEventMutation) OtherStudentID() (r string, exists bool) {
+	v := m.other_student_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOtherStudentID returns the old "other_student_id" field's value of the AdmissionEvent entity.
+// If the AdmissionEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AdmissionEventMutation) OldOtherStudentID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOtherStudentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOtherStudentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOtherStudentID: %w", err)
+	}
+	return oldValue.OtherStudentID, nil
+}
+
+// ClearOtherStudentID clears the value of the "other_student_id" field.
+func (m *AdmissionEventMutation) ClearOtherStudentID() {
+	m.other_student_id = nil
+	m.clearedFields[admissionevent.FieldOtherStudentID] = struct{}{}
+}
+
+// OtherStudentIDCleared returns if the "other_student_id" field was cleared in this mutation.
+func (m *AdmissionEventMutation) OtherStudentIDCleared() bool {
+	_, ok := m.clearedFields[admissionevent.FieldOtherStudentID]
+	return ok
+}
+
+// ResetOtherStudentID resets all changes to the "other_student_id" field.
+func (m *AdmissionEventMutation) ResetOtherStudentID() {
+	m.other_student_id = nil
+	delete(m.clearedFields, admissionevent.FieldOtherStudentID)
+}
+
+// SetRunID sets the "run_id" field.
+func (m *AdmissionEventMutation) SetRunID(i int) {
+	m.run = &i
+}
+
+// RunID returns the value of the "run_id" field in the mutation.
+func (m *AdmissionEventMutation) RunID() (r int, exists bool) {
+	v := m.run
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRunID returns the old "run_id" field's value of the AdmissionEvent entity.
+// If the AdmissionEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AdmissionEventMutation) OldRunID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRunID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRunID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRunID: %w", err)
+	}
+	return oldValue.RunID, nil
+}
+
+// ResetRunID resets all changes to the "run_id" field.
+func (m *AdmissionEventMutation) ResetRunID() {
+	m.run = nil
+}
+
+// SetHeadingID sets the "heading" edge to the Heading entity by id.
+func (m *AdmissionEventMutation) SetHeadingID(id int) {
+	m.heading = &id
+}
+
+// ClearHeading clears the "heading" edge to the Heading entity.
+func (m *AdmissionEventMutation) ClearHeading() {
+	m.clearedheading = true
+}
+
+// HeadingCleared reports if the "heading" edge to the Heading entity was cleared.
+func (m *AdmissionEventMutation) HeadingCleared() bool {
+	return m.clearedheading
+}
+
+// HeadingID returns the "heading" edge ID in the mutation.
+func (m *AdmissionEventMutation) HeadingID() (id int, exists bool) {
+	if m.heading != nil {
+		return *m.heading, true
+	}
+	return
+}
+
+// HeadingIDs returns the "heading" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// HeadingID instead. It exists only for internal usage by the builders.
+func (m *AdmissionEventMutation) HeadingIDs() (ids []int) {
+	if id := m.heading; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetHeading resets all changes to the "heading" edge.
+func (m *AdmissionEventMutation) ResetHeading() {
+	m.heading = nil
+	m.clearedheading = false
+}
+
+// ClearRun clears the "run" edge to the Run entity.
+func (m *AdmissionEventMutation) ClearRun() {
+	m.clearedrun = true
+	m.clearedFields[admissionevent.FieldRunID] = struct{}{}
+}
+
+// RunCleared reports if the "run" edge to the Run entity was cleared.
+func (m *AdmissionEventMutation) RunCleared() bool {
+	return m.clearedrun
+}
+
+// RunIDs returns the "run" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RunID instead. It exists only for internal usage by the builders.
+func (m *AdmissionEventMutation) RunIDs() (ids []int) {
+	if id := m.run; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRun resets all changes to the "run" edge.
+func (m *AdmissionEventMutation) ResetRun() {
 	m.run = nil
 	m.clearedrun = false
 }
 
-// Where appends a list predicates to the CalculationMutation builder.
-func (m *CalculationMutation) Where(ps ...predicate.Calculation) {
+// Where appends a list predicates to the AdmissionEventMutation builder.
+func (m *AdmissionEventMutation) Where(ps ...predicate.AdmissionEvent) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the CalculationMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AdmissionEventMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *CalculationMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Calculation, len(ps))
+func (m *AdmissionEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AdmissionEvent, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1430,36 +1513,48 @@ func (m *CalculationMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *CalculationMutation) Op() Op {
+func (m *AdmissionEventMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *CalculationMutation) SetOp(op Op) {
+func (m *AdmissionEventMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Calculation).
-func (m *CalculationMutation) Type() string {
+// Type returns the node type of this mutation (AdmissionEvent).
+func (m *AdmissionEventMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *CalculationMutation) Fields() []string {
-	fields := make([]string, 0, 4)
+func (m *AdmissionEventMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.seq != nil {
+		fields = append(fields, admissionevent.FieldSeq)
+	}
+	if m.kind != nil {
+		fields = append(fields, admissionevent.FieldKind)
+	}
+	if m.stage != nil {
+		fields = append(fields, admissionevent.FieldStage)
+	}
 	if m.student_id != nil {
-		fields = append(fields, calculation.FieldStudentID)
+		fields = append(fields, admissionevent.FieldStudentID)
 	}
-	if m.admitted_place != nil {
-		fields = append(fields, calculation.FieldAdmittedPlace)
+	if m.priority != nil {
+		fields = append(fields, admissionevent.FieldPriority)
 	}
-	if m.run != nil {
-		fields = append(fields, calculation.FieldRunID)
+	if m.competition_type != nil {
+		fields = append(fields, admissionevent.FieldCompetitionType)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, calculation.FieldUpdatedAt)
+	if m.other_student_id != nil {
+		fields = append(fields, admissionevent.FieldOtherStudentID)
+	}
+	if m.run != nil {
+		fields = append(fields, admissionevent.FieldRunID)
 	}
 	return fields
 }
@@ -1467,16 +1562,24 @@ func (m *CalculationMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *CalculationMutation) Field(name string) (ent.Value, bool) {
+func (m *AdmissionEventMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case calculation.FieldStudentID:
+	case admissionevent.FieldSeq:
+		return m.Seq()
+	case admissionevent.FieldKind:
+		return m.Kind()
+	case admissionevent.FieldStage:
+		return m.Stage()
+	case admissionevent.FieldStudentID:
 		return m.StudentID()
-	case calculation.FieldAdmittedPlace:
-		return m.AdmittedPlace()
-	case calculation.FieldRunID:
+	case admissionevent.FieldPriority:
+		return m.Priority()
+	case admissionevent.FieldCompetitionType:
+		return m.CompetitionType()
+	case admissionevent.FieldOtherStudentID:
+		return m.OtherStudentID()
+	case admissionevent.FieldRunID:
 		return m.RunID()
-	case calculation.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -1484,63 +1587,111 @@ func (m *CalculationMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *CalculationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AdmissionEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case calculation.FieldStudentID:
+	case admissionevent.FieldSeq:
+		return m.OldSeq(ctx)
+	case admissionevent.FieldKind:
+		return m.OldKind(ctx)
+	case admissionevent.FieldStage:
+		return m.OldStage(ctx)
+	case admissionevent.FieldStudentID:
 		return m.OldStudentID(ctx)
-	case calculation.FieldAdmittedPlace:
-		return m.OldAdmittedPlace(ctx)
-	case calculation.FieldRunID:
+	case admissionevent.FieldPriority:
+		return m.OldPriority(ctx)
+	case admissionevent.FieldCompetitionType:
+		return m.OldCompetitionType(ctx)
+	case admissionevent.FieldOtherStudentID:
+		return m.OldOtherStudentID(ctx)
+	case admissionevent.FieldRunID:
 		return m.OldRunID(ctx)
-	case calculation.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Calculation field %s", name)
+	return nil, fmt.Errorf("unknown AdmissionEvent field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CalculationMutation) SetField(name string, value ent.Value) error {
+func (m *AdmissionEventMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case calculation.FieldStudentID:
+	case admissionevent.FieldSeq:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeq(v)
+		return nil
+	case admissionevent.FieldKind:
+		v, ok := value.(core.TraceEventKind)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case admissionevent.FieldStage:
+		v, ok := value.(core.AdmissionStage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStage(v)
+		return nil
+	case admissionevent.FieldStudentID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetStudentID(v)
 		return nil
-	case calculation.FieldAdmittedPlace:
+	case admissionevent.FieldPriority:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAdmittedPlace(v)
+		m.SetPriority(v)
 		return nil
-	case calculation.FieldRunID:
-		v, ok := value.(int)
+	case admissionevent.FieldCompetitionType:
+		v, ok := value.(core.Competition)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRunID(v)
+		m.SetCompetitionType(v)
 		return nil
-	case calculation.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case admissionevent.FieldOtherStudentID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetOtherStudentID(v)
+		return nil
+	case admissionevent.FieldRunID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRunID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Calculation field %s", name)
+	return fmt.Errorf("unknown AdmissionEvent field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *CalculationMutation) AddedFields() []string {
+func (m *AdmissionEventMutation) AddedFields() []string {
 	var fields []string
-	if m.addadmitted_place != nil {
-		fields = append(fields, calculation.FieldAdmittedPlace)
+	if m.addseq != nil {
+		fields = append(fields, admissionevent.FieldSeq)
+	}
+	if m.addkind != nil {
+		fields = append(fields, admissionevent.FieldKind)
+	}
+	if m.addstage != nil {
+		fields = append(fields, admissionevent.FieldStage)
+	}
+	if m.addpriority != nil {
+		fields = append(fields, admissionevent.FieldPriority)
+	}
+	if m.addcompetition_type != nil {
+		fields = append(fields, admissionevent.FieldCompetitionType)
 	}
 	return fields
 }
@@ -1548,10 +1699,18 @@ func (m *CalculationMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *CalculationMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AdmissionEventMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case calculation.FieldAdmittedPlace:
-		return m.AddedAdmittedPlace()
+	case admissionevent.FieldSeq:
+		return m.AddedSeq()
+	case admissionevent.FieldKind:
+		return m.AddedKind()
+	case admissionevent.FieldStage:
+		return m.AddedStage()
+	case admissionevent.FieldPriority:
+		return m.AddedPriority()
+	case admissionevent.FieldCompetitionType:
+		return m.AddedCompetitionType()
 	}
 	return nil, false
 }
@@ -1559,79 +1718,134 @@ func (m *CalculationMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CalculationMutation) AddField(name string, value ent.Value) error {
+func (m *AdmissionEventMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case calculation.FieldAdmittedPlace:
+	case admissionevent.FieldSeq:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddAdmittedPlace(v)
+		m.AddSeq(v)
+		return nil
+	case admissionevent.FieldKind:
+		v, ok := value.(core.TraceEventKind)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddKind(v)
+		return nil
+	case admissionevent.FieldStage:
+		v, ok := value.(core.AdmissionStage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStage(v)
+		return nil
+	case admissionevent.FieldPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPriority(v)
+		return nil
+	case admissionevent.FieldCompetitionType:
+		v, ok := value.(core.Competition)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCompetitionType(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Calculation numeric field %s", name)
+	return fmt.Errorf("unknown AdmissionEvent numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *CalculationMutation) ClearedFields() []string {
-	return nil
+func (m *AdmissionEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(admissionevent.FieldStage) {
+		fields = append(fields, admissionevent.FieldStage)
+	}
+	if m.FieldCleared(admissionevent.FieldOtherStudentID) {
+		fields = append(fields, admissionevent.FieldOtherStudentID)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *CalculationMutation) FieldCleared(name string) bool {
+func (m *AdmissionEventMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *CalculationMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Calculation nullable field %s", name)
+func (m *AdmissionEventMutation) ClearField(name string) error {
+	switch name {
+	case admissionevent.FieldStage:
+		m.ClearStage()
+		return nil
+	case admissionevent.FieldOtherStudentID:
+		m.ClearOtherStudentID()
+		return nil
+	}
+	return fmt.Errorf("unknown AdmissionEvent nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *CalculationMutation) ResetField(name string) error {
+func (m *AdmissionEventMutation) ResetField(name string) error {
 	switch name {
-	case calculation.FieldStudentID:
+	case admissionevent.FieldSeq:
+		m.ResetSeq()
+		return nil
+	case admissionevent.FieldKind:
+		m.ResetKind()
+		return nil
+	case admissionevent.FieldStage:
+		m.ResetStage()
+		return nil
+	case admissionevent.FieldStudentID:
 		m.ResetStudentID()
 		return nil
-	case calculation.FieldAdmittedPlace:
-		m.ResetAdmittedPlace()
+	case admissionevent.FieldPriority:
+		m.ResetPriority()
 		return nil
-	case calculation.FieldRunID:
-		m.ResetRunID()
+	case admissionevent.FieldCompetitionType:
+		m.ResetCompetitionType()
 		return nil
-	case calculation.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case admissionevent.FieldOtherStudentID:
+		m.ResetOtherStudentID()
+		return nil
+	case admissionevent.FieldRunID:
+		m.ResetRunID()
 		return nil
 	}
-	return fmt.Errorf("unknown Calculation field %s", name)
+	return fmt.Errorf("unknown AdmissionEvent field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CalculationMutation) AddedEdges() []string {
+func (m *AdmissionEventMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
 	if m.heading != nil {
-		edges = append(edges, calculation.EdgeHeading)
+		edges = append(edges, admissionevent.EdgeHeading)
 	}
 	if m.run != nil {
-		edges = append(edges, calculation.EdgeRun)
+		edges = append(edges, admissionevent.EdgeRun)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *CalculationMutation) AddedIDs(name string) []ent.Value {
+func (m *AdmissionEventMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case calculation.EdgeHeading:
+	case admissionevent.EdgeHeading:
 		if id := m.heading; id != nil {
 			return []ent.Value{*id}
 		}
-	case calculation.EdgeRun:
+	case admissionevent.EdgeRun:
 		if id := m.run; id != nil {
 			return []ent.Value{*id}
 		}
@@ -1640,36 +1854,36 @@ func (m *CalculationMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CalculationMutation) RemovedEdges() []string {
+func (m *AdmissionEventMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *CalculationMutation) RemovedIDs(name string) []ent.Value {
+func (m *AdmissionEventMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CalculationMutation) ClearedEdges() []string {
+func (m *AdmissionEventMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
 	if m.clearedheading {
-		edges = append(edges, calculation.EdgeHeading)
+		edges = append(edges, admissionevent.EdgeHeading)
 	}
 	if m.clearedrun {
-		edges = append(edges, calculation.EdgeRun)
+		edges = append(edges, admissionevent.EdgeRun)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *CalculationMutation) EdgeCleared(name string) bool {
+func (m *AdmissionEventMutation) EdgeCleared(name string) bool {
 	switch name {
-	case calculation.EdgeHeading:
+	case admissionevent.EdgeHeading:
 		return m.clearedheading
-	case calculation.EdgeRun:
+	case admissionevent.EdgeRun:
 		return m.clearedrun
 	}
 	return false
@@ -1677,79 +1891,76 @@ func (m *CalculationMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *CalculationMutation) ClearEdge(name string) error {
+func (m *AdmissionEventMutation) ClearEdge(name string) error {
 	switch name {
-	case calculation.EdgeHeading:
+	case admissionevent.EdgeHeading:
 		m.ClearHeading()
 		return nil
-	case calculation.EdgeRun:
+	case admissionevent.EdgeRun:
 		m.ClearRun()
 		return nil
 	}
-	return fmt.Errorf("unknown Calculation unique edge %s", name)
+	return fmt.Errorf("unknown AdmissionEvent unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *CalculationMutation) ResetEdge(name string) error {
+func (m *AdmissionEventMutation) ResetEdge(name string) error {
 	switch name {
-	case calculation.EdgeHeading:
+	case admissionevent.EdgeHeading:
 		m.ResetHeading()
 		return nil
-	case calculation.EdgeRun:
+	case admissionevent.EdgeRun:
 		m.ResetRun()
 		return nil
 	}
-	return fmt.Errorf("unknown Calculation edge %s", name)
+	return fmt.Errorf("unknown AdmissionEvent edge %s", name)
 }
 
-// DrainedResultMutation represents an operation that mutates the DrainedResult nodes in the graph.
-type DrainedResultMutation struct {
+// ApplicationMutation represents an operation that mutates the Application nodes in the graph.
+type ApplicationMutation struct {
 	config
-	op                                Op
-	typ                               string
-	id                                *int
-	drained_percent                   *int
-	adddrained_percent                *int
-	avg_passing_score                 *int
-	addavg_passing_score              *int
-	min_passing_score                 *int
-	addmin_passing_score              *int
-	max_passing_score                 *int
-	addmax_passing_score              *int
-	med_passing_score                 *int
-	addmed_passing_score              *int
-	avg_last_admitted_rating_place    *int
-	addavg_last_admitted_rating_place *int
-	min_last_admitted_rating_place    *int
-	addmin_last_admitted_rating_place *int
-	max_last_admitted_rating_place    *int
-	addmax_last_admitted_rating_place *int
-	med_last_admitted_rating_place    *int
-	addmed_last_admitted_rating_place *int
-	regulars_admitted                 *bool
-	is_virtual                        *bool
-	clearedFields                     map[string]struct{}
-	heading                           *int
-	clearedheading                    bool
-	run                               *int
-	clearedrun                        bool
-	done                              bool
-	oldValue                          func(context.Context) (*DrainedResult, error)
-	predicates                        []predicate.DrainedResult
+	op                   Op
+	typ                  string
+	id                   *int
+	student_id           *string
+	priority             *int
+	addpriority          *int
+	competition_type     *core.Competition
+	addcompetition_type  *core.Competition
+	rating_place         *int
+	addrating_place      *int
+	score                *int
+	addscore             *int
+	subject_scores       *[]int
+	appendsubject_scores []int
+	achievement_score    *int
+	addachievement_score *int
+	sub_quota            *string
+	original_submitted   *bool
+	updated_at           *time.Time
+	internal_id          *string
+	clearedFields        map[string]struct{}
+	heading              *int
+	clearedheading       bool
+	run                  *int
+	clearedrun           bool
+	done                 bool
+	oldValue             func(context.Context) (*Application, error)
+	predicates           []predicate.Application
 }
 
-var _ ent.Mutation = (*DrainedResultMutation)(nil)
+var _ ent.Mutation = (*ApplicationMutation)(nil)
 
-// drainedresultOption allows management of the mutation configuration using functional options.
-type drainedresultOption func(*DrainedResultMutation)
+// applicationOption allows management of the mutation configuration using functional options.
+type applicationOption func(*ApplicationMutation)
 
-// newDrainedResultMutation creates new mutation for the DrainedResult entity.
-func newDrainedResultMutation(c config, op Op, opts ...drainedresultOption) *DrainedResultMutation {
-	m := &DrainedResultMutation{
+// newApplicationMutation creates new mutation for the Application entity.
+func newApplicationMutation(c config, op Op, opts ...applicationOption) *ApplicationMutation {
+	m := &ApplicationMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDrainedResult,
+		typ:           TypeApplication,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1758,20 +1969,20 @@ func newDrainedResultMutation(c config, op Op, opts ...drainedresultOption) *Dra
 	return m
 }
 
-// withDrainedResultID sets the ID field of the mutation.
-func withDrainedResultID(id int) drainedresultOption {
-	return func(m *DrainedResultMutation) {
+// withApplicationID sets the ID field of the mutation.
+func withApplicationID(id int) applicationOption {
+	return func(m *ApplicationMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *DrainedResult
+			value *Application
 		)
-		m.oldValue = func(ctx context.Context) (*DrainedResult, error) {
+		m.oldValue = func(ctx context.Context) (*Application, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().DrainedResult.Get(ctx, id)
+					value, err = m.Client().Application.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1780,10 +1991,10 @@ func withDrainedResultID(id int) drainedresultOption {
 	}
 }
 
-// withDrainedResult sets the old DrainedResult of the mutation.
-func withDrainedResult(node *DrainedResult) drainedresultOption {
-	return func(m *DrainedResultMutation) {
-		m.oldValue = func(context.Context) (*DrainedResult, error) {
+// withApplication sets the old Application of the mutation.
+func withApplication(node *Application) applicationOption {
+	return func(m *ApplicationMutation) {
+		m.oldValue = func(context.Context) (*Application, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1792,7 +2003,7 @@ func withDrainedResult(node *DrainedResult) drainedresultOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DrainedResultMutation) Client() *Client {
+func (m ApplicationMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1800,7 +2011,7 @@ func (m DrainedResultMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DrainedResultMutation) Tx() (*Tx, error) {
+func (m ApplicationMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1811,7 +2022,7 @@ func (m DrainedResultMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DrainedResultMutation) ID() (id int, exists bool) {
+func (m *ApplicationMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1822,7 +2033,7 @@ func (m *DrainedResultMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DrainedResultMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ApplicationMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1831,523 +2042,449 @@ func (m *DrainedResultMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DrainedResult.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Application.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetDrainedPercent sets the "drained_percent" field.
-func (m *DrainedResultMutation) SetDrainedPercent(i int) {
-	m.drained_percent = &i
-	m.adddrained_percent = nil
+// SetStudentID sets the "student_id" field.
+func (m *ApplicationMutation) SetStudentID(s string) {
+	m.student_id = &s
 }
 
-// DrainedPercent returns the value of the "drained_percent" field in the mutation.
-func (m *DrainedResultMutation) DrainedPercent() (r int, exists bool) {
-	v := m.drained_percent
+// StudentID returns the value of the "student_id" field in the mutation.
+func (m *ApplicationMutation) StudentID() (r string, exists bool) {
+	v := m.student_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDrainedPercent returns the old "drained_percent" field's value of the DrainedResult entity.
-// If the DrainedResult object wasn't provided to the builder, the object is fetched from the database.
+// OldStudentID returns the old "student_id" field's value of the Application entity.
+// If the Application object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DrainedResultMutation) OldDrainedPercent(ctx context.Context) (v int, err error) {
+func (m *ApplicationMutation) OldStudentID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDrainedPercent is only allowed on UpdateOne operations")
+		return v, errors.New("OldStudentID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDrainedPercent requires an ID field in the mutation")
+		return v, errors.New("OldStudentID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDrainedPercent: %w", err)
-	}
-	return oldValue.DrainedPercent, nil
-}
-
-// AddDrainedPercent adds i to the "drained_percent" field.
-func (m *DrainedResultMutation) AddDrainedPercent(i int) {
-	if m.adddrained_percent != nil {
-		*m.adddrained_percent += i
-	} else {
-		m.adddrained_percent = &i
-	}
-}
-
-// AddedDrainedPercent returns the value that was added to the "drained_percent" field in this mutation.
-func (m *DrainedResultMutation) AddedDrainedPercent() (r int, exists bool) {
-	v := m.adddrained_percent
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldStudentID: %w", err)
 	}
-	return *v, true
+	return oldValue.StudentID, nil
 }
 
-// ResetDrainedPercent resets all changes to the "drained_percent" field.
-func (m *DrainedResultMutation) ResetDrainedPercent() {
-	m.drained_percent = nil
-	m.adddrained_percent = nil
+// ResetStudentID resets all changes to the "student_id" field.
+func (m *ApplicationMutation) ResetStudentID() {
+	m.student_id = nil
 }
 
-// SetAvgPassingScore sets the "avg_passing_score" field.
-func (m *DrainedResultMutation) SetAvgPassingScore(i int) {
-	m.avg_passing_score = &i
-	m.addavg_passing_score = nil
+// SetPriority sets the "priority" field.
+func (m *ApplicationMutation) SetPriority(i int) {
+	m.priority = &i
+	m.addpriority = nil
 }
 
-// AvgPassingScore returns the value of the "avg_passing_score" field in the mutation.
-func (m *DrainedResultMutation) AvgPassingScore() (r int, exists bool) {
-	v := m.avg_passing_score
+// Priority returns the value of the "priority" field in the mutation.
+func (m *ApplicationMutation) Priority() (r int, exists bool) {
+	v := m.priority
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAvgPassingScore returns the old "avg_passing_score" field's value of the DrainedResult entity.
-// If the DrainedResult object wasn't provided to the builder, the object is fetched from the database.
+// OldPriority returns the old "priority" field's value of the Application entity.
+// If the Application object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DrainedResultMutation) OldAvgPassingScore(ctx context.Context) (v int, err error) {
+func (m *ApplicationMutation) OldPriority(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAvgPassingScore is only allowed on UpdateOne operations")
+		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAvgPassingScore requires an ID field in the mutation")
+		return v, errors.New("OldPriority requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAvgPassingScore: %w", err)
+		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
 	}
-	return oldValue.AvgPassingScore, nil
+	return oldValue.Priority, nil
 }
 
-// AddAvgPassingScore adds i to the "avg_passing_score" field.
-func (m *DrainedResultMutation) AddAvgPassingScore(i int) {
-	if m.addavg_passing_score != nil {
-		*m.addavg_passing_score += i
+// AddPriority adds i to the "priority" field.
+func (m *ApplicationMutation) AddPriority(i int) {
+	if m.addpriority != nil {
+		*m.addpriority += i
 	} else {
-		m.addavg_passing_score = &i
+		m.addpriority = &i
 	}
 }
 
-// AddedAvgPassingScore returns the value that was added to the "avg_passing_score" field in this mutation.
-func (m *DrainedResultMutation) AddedAvgPassingScore() (r int, exists bool) {
-	v := m.addavg_passing_score
+// AddedPriority returns the value that was added to the "priority" field in this mutation.
+func (m *ApplicationMutation) AddedPriority() (r int, exists bool) {
+	v := m.addpriority
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetAvgPassingScore resets all changes to the "avg_passing_score" field.
-func (m *DrainedResultMutation) ResetAvgPassingScore() {
-	m.avg_passing_score = nil
-	m.addavg_passing_score = nil
+// ResetPriority resets all changes to the "priority" field.
+func (m *ApplicationMutation) ResetPriority() {
+	m.priority = nil
+	m.addpriority = nil
 }
 
-// SetMinPassingScore sets the "min_passing_score" field.
-func (m *DrainedResultMutation) SetMinPassingScore(i int) {
-	m.min_passing_score = &i
-	m.addmin_passing_score = nil
+// SetCompetitionType sets the "competition_type" field.
+func (m *ApplicationMutation) SetCompetitionType(c core.Competition) {
+	m.competition_type = &c
+	m.addcompetition_type = nil
 }
 
-// MinPassingScore returns the value of the "min_passing_score" field in the mutation.
-func (m *DrainedResultMutation) MinPassingScore() (r int, exists bool) {
-	v := m.min_passing_score
+// CompetitionType returns the value of the "competition_type" field in the mutation.
+func (m *ApplicationMutation) CompetitionType() (r core.Competition, exists bool) {
+	v := m.competition_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMinPassingScore returns the old "min_passing_score" field's value of the DrainedResult entity.
-// If the DrainedResult object wasn't provided to the builder, the object is fetched from the database.
+// OldCompetitionType returns the old "competition_type" field's value of the Application entity.
+// If the Application object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DrainedResultMutation) OldMinPassingScore(ctx context.Context) (v int, err error) {
+func (m *ApplicationMutation) OldCompetitionType(ctx context.Context) (v core.Competition, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMinPassingScore is only allowed on UpdateOne operations")
+		return v, errors.New("OldCompetitionType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMinPassingScore requires an ID field in the mutation")
+		return v, errors.New("OldCompetitionType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMinPassingScore: %w", err)
+		return v, fmt.Errorf("querying old value for OldCompetitionType: %w", err)
 	}
-	return oldValue.MinPassingScore, nil
+	return oldValue.CompetitionType, nil
 }
 
-// AddMinPassingScore adds i to the "min_passing_score" field.
-func (m *DrainedResultMutation) AddMinPassingScore(i int) {
-	if m.addmin_passing_score != nil {
-		*m.addmin_passing_score += i
+// AddCompetitionType adds c to the "competition_type" field.
+func (m *ApplicationMutation) AddCompetitionType(c core.Competition) {
+	if m.addcompetition_type != nil {
+		*m.addcompetition_type += c
 	} else {
-		m.addmin_passing_score = &i
+		m.addcompetition_type = &c
 	}
 }
 
-// AddedMinPassingScore returns the value that was added to the "min_passing_score" field in this mutation.
-func (m *DrainedResultMutation) AddedMinPassingScore() (r int, exists bool) {
-	v := m.addmin_passing_score
+// AddedCompetitionType returns the value that was added to the "competition_type" field in this mutation.
+func (m *ApplicationMutation) AddedCompetitionType() (r core.Competition, exists bool) {
+	v := m.addcompetition_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetMinPassingScore resets all changes to the "min_passing_score" field.
-func (m *DrainedResultMutation) ResetMinPassingScore() {
-	m.min_passing_score = nil
-	m.addmin_passing_score = nil
+// ResetCompetitionType resets all changes to the "competition_type" field.
+func (m *ApplicationMutation) ResetCompetitionType() {
+	m.competition_type = nil
+	m.addcompetition_type = nil
 }
 
-// SetMaxPassingScore sets the "max_passing_score" field.
-func (m *DrainedResultMutation) SetMaxPassingScore(i int) {
-	m.max_passing_score = &i
-	m.addmax_passing_score = nil
+// SetRatingPlace sets the "rating_place" field.
+func (m *ApplicationMutation) SetRatingPlace(i int) {
+	m.rating_place = &i
+	m.addrating_place = nil
 }
 
-// MaxPassingScore returns the value of the "max_passing_score" field in the mutation.
-func (m *DrainedResultMutation) MaxPassingScore() (r int, exists bool) {
-	v := m.max_passing_score
+// RatingPlace returns the value of the "rating_place" field in the mutation.
+func (m *ApplicationMutation) RatingPlace() (r int, exists bool) {
+	v := m.rating_place
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMaxPassingScore returns the old "max_passing_score" field's value of the DrainedResult entity.
-// If the DrainedResult object wasn't provided to the builder, the object is fetched from the database.
+// OldRatingPlace returns the old "rating_place" field's value of the Application entity.
+// If the Application object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DrainedResultMutation) OldMaxPassingScore(ctx context.Context) (v int, err error) {
+func (m *ApplicationMutation) OldRatingPlace(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMaxPassingScore is only allowed on UpdateOne operations")
+		return v, errors.New("OldRatingPlace is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMaxPassingScore requires an ID field in the mutation")
+		return v, errors.New("OldRatingPlace requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMaxPassingScore: %w", err)
+		return v, fmt.Errorf("querying old value for OldRatingPlace: %w", err)
 	}
-	return oldValue.MaxPassingScore, nil
+	return oldValue.RatingPlace, nil
 }
 
-// AddMaxPassingScore adds i to the "max_passing_score" field.
-func (m *DrainedResultMutation) AddMaxPassingScore(i int) {
-	if m.addmax_passing_score != nil {
-		*m.addmax_passing_score += i
+// AddRatingPlace adds i to the "rating_place" field.
+func (m *ApplicationMutation) AddRatingPlace(i int) {
+	if m.addrating_place != nil {
+		*m.addrating_place += i
 	} else {
-		m.addmax_passing_score = &i
+		m.addrating_place = &i
 	}
 }
 
-// AddedMaxPassingScore returns the value that was added to the "max_passing_score" field in this mutation.
-func (m *DrainedResultMutation) AddedMaxPassingScore() (r int, exists bool) {
-	v := m.addmax_passing_score
+// AddedRatingPlace returns the value that was added to the "rating_place" field in this mutation.
+func (m *ApplicationMutation) AddedRatingPlace() (r int, exists bool) {
+	v := m.addrating_place
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetMaxPassingScore resets all changes to the "max_passing_score" field.
-func (m *DrainedResultMutation) ResetMaxPassingScore() {
-	m.max_passing_score = nil
-	m.addmax_passing_score = nil
+// ResetRatingPlace resets all changes to the "rating_place" field.
+func (m *ApplicationMutation) ResetRatingPlace() {
+	m.rating_place = nil
+	m.addrating_place = nil
 }
 
-// SetMedPassingScore sets the "med_passing_score" field.
-func (m *DrainedResultMutation) SetMedPassingScore(i int) {
-	m.med_passing_score = &i
-	m.addmed_passing_score = nil
+// SetScore sets the "score" field.
+func (m *ApplicationMutation) SetScore(i int) {
+	m.score = &i
+	m.addscore = nil
 }
 
-// MedPassingScore returns the value of the "med_passing_score" field in the mutation.
-func (m *DrainedResultMutation) MedPassingScore() (r int, exists bool) {
-	v := m.med_passing_score
+// Score returns the value of the "score" field in the mutation.
+func (m *ApplicationMutation) Score() (r int, exists bool) {
+	v := m.score
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMedPassingScore returns the old "med_passing_score" field's value of the DrainedResult entity.
-// If the DrainedResult object wasn't provided to the builder, the object is fetched from the database.
+// OldScore returns the old "score" field's value of the Application entity.
+// If the Application object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DrainedResultMutation) OldMedPassingScore(ctx context.Context) (v int, err error) {
+func (m *ApplicationMutation) OldScore(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMedPassingScore is only allowed on UpdateOne operations")
+		return v, errors.New("OldScore is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMedPassingScore requires an ID field in the mutation")
+		return v, errors.New("OldScore requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMedPassingScore: %w", err)
+		return v, fmt.Errorf("querying old value for OldScore: %w", err)
 	}
-	return oldValue.MedPassingScore, nil
+	return oldValue.Score, nil
 }
 
-// AddMedPassingScore adds i to the "med_passing_score" field.
-func (m *DrainedResultMutation) AddMedPassingScore(i int) {
-	if m.addmed_passing_score != nil {
-		*m.addmed_passing_score += i
+// AddScore adds i to the "score" field.
+func (m *ApplicationMutation) AddScore(i int) {
+	if m.addscore != nil {
+		*m.addscore += i
 	} else {
-		m.addmed_passing_score = &i
+		m.addscore = &i
 	}
 }
 
-// AddedMedPassingScore returns the value that was added to the "med_passing_score" field in this mutation.
-func (m *DrainedResultMutation) AddedMedPassingScore() (r int, exists bool) {
-	v := m.addmed_passing_score
+// AddedScore returns the value that was added to the "score" field in this mutation.
+func (m *ApplicationMutation) AddedScore() (r int, exists bool) {
+	v := m.addscore
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetMedPassingScore resets all changes to the "med_passing_score" field.
-func (m *DrainedResultMutation) ResetMedPassingScore() {
-	m.med_passing_score = nil
-	m.addmed_passing_score = nil
+// ResetScore resets all changes to the "score" field.
+func (m *ApplicationMutation) ResetScore() {
+	m.score = nil
+	m.addscore = nil
 }
 
-// SetAvgLastAdmittedRatingPlace sets the "avg_last_admitted_rating_place" field.
-func (m *DrainedResultMutation) SetAvgLastAdmittedRatingPlace(i int) {
-	m.avg_last_admitted_rating_place = &i
-	m.addavg_last_admitted_rating_place = nil
+// SetSubjectScores sets the "subject_scores" field.
+func (m *ApplicationMutation) SetSubjectScores(i []int) {
+	m.subject_scores = &i
+	m.appendsubject_scores = nil
 }
 
-// AvgLastAdmittedRatingPlace returns the value of the "avg_last_admitted_rating_place" field in the mutation.
-func (m *DrainedResultMutation) AvgLastAdmittedRatingPlace() (r int, exists bool) {
-	v := m.avg_last_admitted_rating_place
+// SubjectScores returns the value of the "subject_scores" field in the mutation.
+func (m *ApplicationMutation) SubjectScores() (r []int, exists bool) {
+	v := m.subject_scores
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAvgLastAdmittedRatingPlace returns the old "avg_last_admitted_rating_place" field's value of the DrainedResult entity.
-// If the DrainedResult object wasn't provided to the builder, the object is fetched from the database.
+// OldSubjectScores returns the old "subject_scores" field's value of the Application entity.
+// If the Application object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DrainedResultMutation) OldAvgLastAdmittedRatingPlace(ctx context.Context) (v int, err error) {
+func (m *ApplicationMutation) OldSubjectScores(ctx context.Context) (v []int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAvgLastAdmittedRatingPlace is only allowed on UpdateOne operations")
+		return v, errors.New("OldSubjectScores is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAvgLastAdmittedRatingPlace requires an ID field in the mutation")
+		return v, errors.New("OldSubjectScores requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAvgLastAdmittedRatingPlace: %w", err)
-	}
-	return oldValue.AvgLastAdmittedRatingPlace, nil
-}
-
-// AddAvgLastAdmittedRatingPlace adds i to the "avg_last_admitted_rating_place" field.
-func (m *DrainedResultMutation) AddAvgLastAdmittedRatingPlace(i int) {
-	if m.addavg_last_admitted_rating_place != nil {
-		*m.addavg_last_admitted_rating_place += i
-	} else {
-		m.addavg_last_admitted_rating_place = &i
-	}
-}
-
-// AddedAvgLastAdmittedRatingPlace returns the value that was added to the "avg_last_admitted_rating_place" field in this mutation.
-func (m *DrainedResultMutation) AddedAvgLastAdmittedRatingPlace() (r int, exists bool) {
-	v := m.addavg_last_admitted_rating_place
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldSubjectScores: %w", err)
 	}
-	return *v, true
-}
-
-// ResetAvgLastAdmittedRatingPlace resets all changes to the "avg_last_admitted_rating_place" field.
-func (m *DrainedResultMutation) ResetAvgLastAdmittedRatingPlace() {
-	m.avg_last_admitted_rating_place = nil
-	m.addavg_last_admitted_rating_place = nil
-}
-
-// SetMinLastAdmittedRatingPlace sets the "min_last_admitted_rating_place" field.
-func (m *DrainedResultMutation) SetMinLastAdmittedRatingPlace(i int) {
-	m.min_last_admitted_rating_place = &i
-	m.addmin_last_admitted_rating_place = nil
+	return oldValue.SubjectScores, nil
 }
 
-// MinLastAdmittedRatingPlace returns the value of the "min_last_admitted_rating_place" field in the mutation.
-func (m *DrainedResultMutation) MinLastAdmittedRatingPlace() (r int, exists bool) {
-	v := m.min_last_admitted_rating_place
-	if v == nil {
-		return
-	}
-	return *v, true
+// AppendSubjectScores adds i to the "subject_scores" field.
+func (m *ApplicationMutation) AppendSubjectScores(i []int) {
+	m.appendsubject_scores = append(m.appendsubject_scores, i...)
 }
 
-// OldMinLastAdmittedRatingPlace returns the old "min_last_admitted_rating_place" field's value of the DrainedResult entity.
-// If the DrainedResult object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DrainedResultMutation) OldMinLastAdmittedRatingPlace(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMinLastAdmittedRatingPlace is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMinLastAdmittedRatingPlace requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMinLastAdmittedRatingPlace: %w", err)
+// AppendedSubjectScores returns the list of values that were appended to the "subject_scores" field in this mutation.
+func (m *ApplicationMutation) AppendedSubjectScores() ([]int, bool) {
+	if len(m.appendsubject_scores) == 0 {
+		return nil, false
 	}
-	return oldValue.MinLastAdmittedRatingPlace, nil
+	return m.appendsubject_scores, true
 }
 
-// AddMinLastAdmittedRatingPlace adds i to the "min_last_admitted_rating_place" field.
-func (m *DrainedResultMutation) AddMinLastAdmittedRatingPlace(i int) {
-	if m.addmin_last_admitted_rating_place != nil {
-		*m.addmin_last_admitted_rating_place += i
-	} else {
-		m.addmin_last_admitted_rating_place = &i
-	}
+// ClearSubjectScores clears the value of the "subject_scores" field.
+func (m *ApplicationMutation) ClearSubjectScores() {
+	m.subject_scores = nil
+	m.appendsubject_scores = nil
+	m.clearedFields[application.FieldSubjectScores] = struct{}{}
 }
 
-// AddedMinLastAdmittedRatingPlace returns the value that was added to the "min_last_admitted_rating_place" field in this mutation.
-func (m *DrainedResultMutation) AddedMinLastAdmittedRatingPlace() (r int, exists bool) {
-	v := m.addmin_last_admitted_rating_place
-	if v == nil {
-		return
-	}
-	return *v, true
+// SubjectScoresCleared returns if the "subject_scores" field was cleared in this mutation.
+func (m *ApplicationMutation) SubjectScoresCleared() bool {
+	_, ok := m.clearedFields[application.FieldSubjectScores]
+	return ok
 }
 
-// ResetMinLastAdmittedRatingPlace resets all changes to the "min_last_admitted_rating_place" field.
-func (m *DrainedResultMutation) ResetMinLastAdmittedRatingPlace() {
-	m.min_last_admitted_rating_place = nil
-	m.addmin_last_admitted_rating_place = nil
+// ResetSubjectScores resets all changes to the "subject_scores" field.
+func (m *ApplicationMutation) ResetSubjectScores() {
+	m.subject_scores = nil
+	m.appendsubject_scores = nil
+	delete(m.clearedFields, application.FieldSubjectScores)
 }
 
-// SetMaxLastAdmittedRatingPlace sets the "max_last_admitted_rating_place" field.
-func (m *DrainedResultMutation) SetMaxLastAdmittedRatingPlace(i int) {
-	m.max_last_admitted_rating_place = &i
-	m.addmax_last_admitted_rating_place = nil
+// SetAchievementScore sets the "achievement_score" field.
+func (m *ApplicationMutation) SetAchievementScore(i int) {
+	m.achievement_score = &i
+	m.addachievement_score = nil
 }
 
-// MaxLastAdmittedRatingPlace returns the value of the "max_last_admitted_rating_place" field in the mutation.
-func (m *DrainedResultMutation) MaxLastAdmittedRatingPlace() (r int, exists bool) {
-	v := m.max_last_admitted_rating_place
+// AchievementScore returns the value of the "achievement_score" field in the mutation.
+func (m *ApplicationMutation) AchievementScore() (r int, exists bool) {
+	v := m.achievement_score
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMaxLastAdmittedRatingPlace returns the old "max_last_admitted_rating_place" field's value of the DrainedResult entity.
-// If the DrainedResult object wasn't provided to the builder, the object is fetched from the database.
+// OldAchievementScore returns the old "achievement_score" field's value of the Application entity.
+// If the Application object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DrainedResultMutation) OldMaxLastAdmittedRatingPlace(ctx context.Context) (v int, err error) {
+func (m *ApplicationMutation) OldAchievementScore(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMaxLastAdmittedRatingPlace is only allowed on UpdateOne operations")
+		return v, errors.New("OldAchievementScore is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMaxLastAdmittedRatingPlace requires an ID field in the mutation")
+		return v, errors.New("OldAchievementScore requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMaxLastAdmittedRatingPlace: %w", err)
+		return v, fmt.Errorf("querying old value for OldAchievementScore: %w", err)
 	}
-	return oldValue.MaxLastAdmittedRatingPlace, nil
+	return oldValue.AchievementScore, nil
 }
 
-// AddMaxLastAdmittedRatingPlace adds i to the "max_last_admitted_rating_place" field.
-func (m *DrainedResultMutation) AddMaxLastAdmittedRatingPlace(i int) {
-	if m.addmax_last_admitted_rating_place != nil {
-		*m.addmax_last_admitted_rating_place += i
+// AddAchievementScore adds i to the "achievement_score" field.
+func (m *ApplicationMutation) AddAchievementScore(i int) {
+	if m.addachievement_score != nil {
+		*m.addachievement_score += i
 	} else {
-		m.addmax_last_admitted_rating_place = &i
+		m.addachievement_score = &i
 	}
 }
 
-// AddedMaxLastAdmittedRatingPlace returns the value that was added to the "max_last_admitted_rating_place" field in this mutation.
-func (m *DrainedResultMutation) AddedMaxLastAdmittedRatingPlace() (r int, exists bool) {
-	v := m.addmax_last_admitted_rating_place
+// AddedAchievementScore returns the value that was added to the "achievement_score" field in this mutation.
+func (m *ApplicationMutation) AddedAchievementScore() (r int, exists bool) {
+	v := m.addachievement_score
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetMaxLastAdmittedRatingPlace resets all changes to the "max_last_admitted_rating_place" field.
-func (m *DrainedResultMutation) ResetMaxLastAdmittedRatingPlace() {
-	m.max_last_admitted_rating_place = nil
-	m.addmax_last_admitted_rating_place = nil
+// ResetAchievementScore resets all changes to the "achievement_score" field.
+func (m *ApplicationMutation) ResetAchievementScore() {
+	m.achievement_score = nil
+	m.addachievement_score = nil
 }
 
-// SetMedLastAdmittedRatingPlace sets the "med_last_admitted_rating_place" field.
-func (m *DrainedResultMutation) SetMedLastAdmittedRatingPlace(i int) {
-	m.med_last_admitted_rating_place = &i
-	m.addmed_last_admitted_rating_place = nil
+// SetSubQuota sets the "sub_quota" field.
+func (m *ApplicationMutation) SetSubQuota(s string) {
+	m.sub_quota = &s
 }
 
-// MedLastAdmittedRatingPlace returns the value of the "med_last_admitted_rating_place" field in the mutation.
-func (m *DrainedResultMutation) MedLastAdmittedRatingPlace() (r int, exists bool) {
-	v := m.med_last_admitted_rating_place
+// SubQuota returns the value of the "sub_quota" field in the mutation.
+func (m *ApplicationMutation) SubQuota() (r string, exists bool) {
+	v := m.sub_quota
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMedLastAdmittedRatingPlace returns the old "med_last_admitted_rating_place" field's value of the DrainedResult entity.
-// If the DrainedResult object wasn't provided to the builder, the object is fetched from the database.
+// OldSubQuota returns the old "sub_quota" field's value of the Application entity.
+// If the Application object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DrainedResultMutation) OldMedLastAdmittedRatingPlace(ctx context.Context) (v int, err error) {
+func (m *ApplicationMutation) OldSubQuota(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMedLastAdmittedRatingPlace is only allowed on UpdateOne operations")
+		return v, errors.New("OldSubQuota is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMedLastAdmittedRatingPlace requires an ID field in the mutation")
+		return v, errors.New("OldSubQuota requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMedLastAdmittedRatingPlace: %w", err)
+		return v, fmt.Errorf("querying old value for OldSubQuota: %w", err)
 	}
-	return oldValue.MedLastAdmittedRatingPlace, nil
+	return oldValue.SubQuota, nil
 }
 
-// AddMedLastAdmittedRatingPlace adds i to the "med_last_admitted_rating_place" field.
-func (m *DrainedResultMutation) AddMedLastAdmittedRatingPlace(i int) {
-	if m.addmed_last_admitted_rating_place != nil {
-		*m.addmed_last_admitted_rating_place += i
-	} else {
-		m.addmed_last_admitted_rating_place = &i
-	}
+// ClearSubQuota clears the value of the "sub_quota" field.
+func (m *ApplicationMutation) ClearSubQuota() {
+	m.sub_quota = nil
+	m.clearedFields[application.FieldSubQuota] = struct{}{}
 }
 
-// AddedMedLastAdmittedRatingPlace returns the value that was added to the "med_last_admitted_rating_place" field in this mutation.
-func (m *DrainedResultMutation) AddedMedLastAdmittedRatingPlace() (r int, exists bool) {
-	v := m.addmed_last_admitted_rating_place
-	if v == nil {
-		return
-	}
-	return *v, true
+// SubQuotaCleared returns if the "sub_quota" field was cleared in this mutation.
+func (m *ApplicationMutation) SubQuotaCleared() bool {
+	_, ok := m.clearedFields[application.FieldSubQuota]
+	return ok
 }
 
-// ResetMedLastAdmittedRatingPlace resets all changes to the "med_last_admitted_rating_place" field.
-func (m *DrainedResultMutation) ResetMedLastAdmittedRatingPlace() {
-	m.med_last_admitted_rating_place = nil
-	m.addmed_last_admitted_rating_place = nil
+// ResetSubQuota resets all changes to the "sub_quota" field.
+func (m *ApplicationMutation) ResetSubQuota() {
+	m.sub_quota = nil
+	delete(m.clearedFields, application.FieldSubQuota)
 }
 
 // SetRunID sets the "run_id" field.
-func (m *DrainedResultMutation) SetRunID(i int) {
+func (m *ApplicationMutation) SetRunID(i int) {
 	m.run = &i
 }
 
 // RunID returns the value of the "run_id" field in the mutation.
-func (m *DrainedResultMutation) RunID() (r int, exists bool) {
+func (m *ApplicationMutation) RunID() (r int, exists bool) {
 	v := m.run
 	if v == nil {
 		return
@@ -2355,10 +2492,10 @@ func (m *DrainedResultMutation) RunID() (r int, exists bool) {
 	return *v, true
 }
 
-// OldRunID returns the old "run_id" field's value of the DrainedResult entity.
-// If the DrainedResult object wasn't provided to the builder, the object is fetched from the database.
+// OldRunID returns the old "run_id" field's value of the Application entity.
+// If the Application object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DrainedResultMutation) OldRunID(ctx context.Context) (v int, err error) {
+func (m *ApplicationMutation) OldRunID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldRunID is only allowed on UpdateOne operations")
 	}
@@ -2373,99 +2510,148 @@ func (m *DrainedResultMutation) OldRunID(ctx context.Context) (v int, err error)
 }
 
 // ResetRunID resets all changes to the "run_id" field.
-func (m *DrainedResultMutation) ResetRunID() {
+func (m *ApplicationMutation) ResetRunID() {
 	m.run = nil
 }
 
-// SetRegularsAdmitted sets the "regulars_admitted" field.
-func (m *DrainedResultMutation) SetRegularsAdmitted(b bool) {
-	m.regulars_admitted = &b
+// SetOriginalSubmitted sets the "original_submitted" field.
+func (m *ApplicationMutation) SetOriginalSubmitted(b bool) {
+	m.original_submitted = &b
 }
 
-// RegularsAdmitted returns the value of the "regulars_admitted" field in the mutation.
-func (m *DrainedResultMutation) RegularsAdmitted() (r bool, exists bool) {
-	v := m.regulars_admitted
+// OriginalSubmitted returns the value of the "original_submitted" field in the mutation.
+func (m *ApplicationMutation) OriginalSubmitted() (r bool, exists bool) {
+	v := m.original_submitted
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRegularsAdmitted returns the old "regulars_admitted" field's value of the DrainedResult entity.
-// If the DrainedResult object wasn't provided to the builder, the object is fetched from the database.
+// OldOriginalSubmitted returns the old "original_submitted" field's value of the Application entity.
+// If the Application object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DrainedResultMutation) OldRegularsAdmitted(ctx context.Context) (v bool, err error) {
+func (m *ApplicationMutation) OldOriginalSubmitted(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRegularsAdmitted is only allowed on UpdateOne operations")
+		return v, errors.New("OldOriginalSubmitted is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRegularsAdmitted requires an ID field in the mutation")
+		return v, errors.New("OldOriginalSubmitted requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRegularsAdmitted: %w", err)
+		return v, fmt.Errorf("querying old value for OldOriginalSubmitted: %w", err)
 	}
-	return oldValue.RegularsAdmitted, nil
+	return oldValue.OriginalSubmitted, nil
 }
 
-// ResetRegularsAdmitted resets all changes to the "regulars_admitted" field.
-func (m *DrainedResultMutation) ResetRegularsAdmitted() {
-	m.regulars_admitted = nil
+// ResetOriginalSubmitted resets all changes to the "original_submitted" field.
+func (m *ApplicationMutation) ResetOriginalSubmitted() {
+	m.original_submitted = nil
 }
 
-// SetIsVirtual sets the "is_virtual" field.
-func (m *DrainedResultMutation) SetIsVirtual(b bool) {
-	m.is_virtual = &b
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ApplicationMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// IsVirtual returns the value of the "is_virtual" field in the mutation.
-func (m *DrainedResultMutation) IsVirtual() (r bool, exists bool) {
-	v := m.is_virtual
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ApplicationMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsVirtual returns the old "is_virtual" field's value of the DrainedResult entity.
-// If the DrainedResult object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Application entity.
+// If the Application object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DrainedResultMutation) OldIsVirtual(ctx context.Context) (v bool, err error) {
+func (m *ApplicationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsVirtual is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsVirtual requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsVirtual: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.IsVirtual, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetIsVirtual resets all changes to the "is_virtual" field.
-func (m *DrainedResultMutation) ResetIsVirtual() {
-	m.is_virtual = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ApplicationMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetInternalID sets the "internal_id" field.
+func (m *ApplicationMutation) SetInternalID(s string) {
+	m.internal_id = &s
+}
+
+// InternalID returns the value of the "internal_id" field in the mutation.
+func (m *ApplicationMutation) InternalID() (r string, exists bool) {
+	v := m.internal_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInternalID returns the old "internal_id" field's value of the Application entity.
+// If the Application object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApplicationMutation) OldInternalID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInternalID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInternalID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInternalID: %w", err)
+	}
+	return oldValue.InternalID, nil
+}
+
+// ClearInternalID clears the value of the "internal_id" field.
+func (m *ApplicationMutation) ClearInternalID() {
+	m.internal_id = nil
+	m.clearedFields[application.FieldInternalID] = struct{}{}
+}
+
+// InternalIDCleared returns if the "internal_id" field was cleared in this mutation.
+func (m *ApplicationMutation) InternalIDCleared() bool {
+	_, ok := m.clearedFields[application.FieldInternalID]
+	return ok
+}
+
+// ResetInternalID resets all changes to the "internal_id" field.
+func (m *ApplicationMutation) ResetInternalID() {
+	m.internal_id = nil
+	delete(m.clearedFields, application.FieldInternalID)
 }
 
 // SetHeadingID sets the "heading" edge to the Heading entity by id.
-func (m *DrainedResultMutation) SetHeadingID(id int) {
+func (m *ApplicationMutation) SetHeadingID(id int) {
 	m.heading = &id
 }
 
 // ClearHeading clears the "heading" edge to the Heading entity.
-func (m *DrainedResultMutation) ClearHeading() {
+func (m *ApplicationMutation) ClearHeading() {
 	m.clearedheading = true
 }
 
 // HeadingCleared reports if the "heading" edge to the Heading entity was cleared.
-func (m *DrainedResultMutation) HeadingCleared() bool {
+func (m *ApplicationMutation) HeadingCleared() bool {
 	return m.clearedheading
 }
 
 // HeadingID returns the "heading" edge ID in the mutation.
-func (m *DrainedResultMutation) HeadingID() (id int, exists bool) {
+func (m *ApplicationMutation) HeadingID() (id int, exists bool) {
 	if m.heading != nil {
 		return *m.heading, true
 	}
@@ -2475,7 +2661,7 @@ func (m *DrainedResultMutation) HeadingID() (id int, exists bool) {
 // HeadingIDs returns the "heading" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // HeadingID instead. It exists only for internal usage by the builders.
-func (m *DrainedResultMutation) HeadingIDs() (ids []int) {
+func (m *ApplicationMutation) HeadingIDs() (ids []int) {
 	if id := m.heading; id != nil {
 		ids = append(ids, *id)
 	}
@@ -2483,26 +2669,26 @@ func (m *DrainedResultMutation) HeadingIDs() (ids []int) {
 }
 
 // ResetHeading resets all changes to the "heading" edge.
-func (m *DrainedResultMutation) ResetHeading() {
+func (m *ApplicationMutation) ResetHeading() {
 	m.heading = nil
 	m.clearedheading = false
 }
 
 // ClearRun clears the "run" edge to the Run entity.
-func (m *DrainedResultMutation) ClearRun() {
+func (m *ApplicationMutation) ClearRun() {
 	m.clearedrun = true
-	m.clearedFields[drainedresult.FieldRunID] = struct{}{}
+	m.clearedFields[application.FieldRunID] = struct{}{}
 }
 
 // RunCleared reports if the "run" edge to the Run entity was cleared.
-func (m *DrainedResultMutation) RunCleared() bool {
+func (m *ApplicationMutation) RunCleared() bool {
 	return m.clearedrun
 }
 
 // RunIDs returns the "run" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // RunID instead. It exists only for internal usage by the builders.
-func (m *DrainedResultMutation) RunIDs() (ids []int) {
+func (m *ApplicationMutation) RunIDs() (ids []int) {
 	if id := m.run; id != nil {
 		ids = append(ids, *id)
 	}
@@ -2510,20 +2696,20 @@ func (m *DrainedResultMutation) RunIDs() (ids []int) {
 }
 
 // ResetRun resets all changes to the "run" edge.
-func (m *DrainedResultMutation) ResetRun() {
+func (m *ApplicationMutation) ResetRun() {
 	m.run = nil
 	m.clearedrun = false
 }
 
-// Where appends a list predicates to the DrainedResultMutation builder.
-func (m *DrainedResultMutation) Where(ps ...predicate.DrainedResult) {
+// Where appends a list predicates to the ApplicationMutation builder.
+func (m *ApplicationMutation) Where(ps ...predicate.Application) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the DrainedResultMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ApplicationMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DrainedResultMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.DrainedResult, len(ps))
+func (m *ApplicationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Application, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2531,60 +2717,60 @@ func (m *DrainedResultMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DrainedResultMutation) Op() Op {
+func (m *ApplicationMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DrainedResultMutation) SetOp(op Op) {
+func (m *ApplicationMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (DrainedResult).
-func (m *DrainedResultMutation) Type() string {
+// Type returns the node type of this mutation (Application).
+func (m *ApplicationMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DrainedResultMutation) Fields() []string {
+func (m *ApplicationMutation) Fields() []string {
 	fields := make([]string, 0, 12)
-	if m.drained_percent != nil {
-		fields = append(fields, drainedresult.FieldDrainedPercent)
-	}
-	if m.avg_passing_score != nil {
-		fields = append(fields, drainedresult.FieldAvgPassingScore)
+	if m.student_id != nil {
+		fields = append(fields, application.FieldStudentID)
 	}
-	if m.min_passing_score != nil {
-		fields = append(fields, drainedresult.FieldMinPassingScore)
+	if m.priority != nil {
+		fields = append(fields, application.FieldPriority)
 	}
-	if m.max_passing_score != nil {
-		fields = append(fields, drainedresult.FieldMaxPassingScore)
+	if m.competition_type != nil {
+		fields = append(fields, application.FieldCompetitionType)
 	}
-	if m.med_passing_score != nil {
-		fields = append(fields, drainedresult.FieldMedPassingScore)
+	if m.rating_place != nil {
+		fields = append(fields, application.FieldRatingPlace)
 	}
-	if m.avg_last_admitted_rating_place != nil {
-		fields = append(fields, drainedresult.FieldAvgLastAdmittedRatingPlace)
+	if m.score != nil {
+		fields = append(fields, application.FieldScore)
 	}
-	if m.min_last_admitted_rating_place != nil {
-		fields = append(fields, drainedresult.FieldMinLastAdmittedRatingPlace)
+	if m.subject_scores != nil {
+		fields = append(fields, application.FieldSubjectScores)
 	}
-	if m.max_last_admitted_rating_place != nil {
-		fields = append(fields, drainedresult.FieldMaxLastAdmittedRatingPlace)
+	if m.achievement_score != nil {
+		fields = append(fields, application.FieldAchievementScore)
 	}
-	if m.med_last_admitted_rating_place != nil {
-		fields = append(fields, drainedresult.FieldMedLastAdmittedRatingPlace)
+	if m.sub_quota != nil {
+		fields = append(fields, application.FieldSubQuota)
 	}
 	if m.run != nil {
-		fields = append(fields, drainedresult.FieldRunID)
+		fields = append(fields, application.FieldRunID)
 	}
-	if m.regulars_admitted != nil {
-		fields = append(fields, drainedresult.FieldRegularsAdmitted)
+	if m.original_submitted != nil {
+		fields = append(fields, application.FieldOriginalSubmitted)
 	}
-	if m.is_virtual != nil {
-		fields = append(fields, drainedresult.FieldIsVirtual)
+	if m.updated_at != nil {
+		fields = append(fields, application.FieldUpdatedAt)
+	}
+	if m.internal_id != nil {
+		fields = append(fields, application.FieldInternalID)
 	}
 	return fields
 }
@@ -2592,32 +2778,32 @@ func (m *DrainedResultMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DrainedResultMutation) Field(name string) (ent.Value, bool) {
+func (m *ApplicationMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case drainedresult.FieldDrainedPercent:
-		return m.DrainedPercent()
-	case drainedresult.FieldAvgPassingScore:
-		return m.AvgPassingScore()
-	case drainedresult.FieldMinPassingScore:
-		return m.MinPassingScore()
-	case drainedresult.FieldMaxPassingScore:
-		return m.MaxPassingScore()
-	case drainedresult.FieldMedPassingScore:
-		return m.MedPassingScore()
-	case drainedresult.FieldAvgLastAdmittedRatingPlace:
-		return m.AvgLastAdmittedRatingPlace()
-	case drainedresult.FieldMinLastAdmittedRatingPlace:
-		return m.MinLastAdmittedRatingPlace()
-	case drainedresult.FieldMaxLastAdmittedRatingPlace:
-		return m.MaxLastAdmittedRatingPlace()
-	case drainedresult.FieldMedLastAdmittedRatingPlace:
-		return m.MedLastAdmittedRatingPlace()
-	case drainedresult.FieldRunID:
+	case application.FieldStudentID:
+		return m.StudentID()
+	case application.FieldPriority:
+		return m.Priority()
+	case application.FieldCompetitionType:
+		return m.CompetitionType()
+	case application.FieldRatingPlace:
+		return m.RatingPlace()
+	case application.FieldScore:
+		return m.Score()
+	case application.FieldSubjectScores:
+		return m.SubjectScores()
+	case application.FieldAchievementScore:
+		return m.AchievementScore()
+	case application.FieldSubQuota:
+		return m.SubQuota()
+	case application.FieldRunID:
 		return m.RunID()
-	case drainedresult.FieldRegularsAdmitted:
-		return m.RegularsAdmitted()
-	case drainedresult.FieldIsVirtual:
-		return m.IsVirtual()
+	case application.FieldOriginalSubmitted:
+		return m.OriginalSubmitted()
+	case application.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case application.FieldInternalID:
+		return m.InternalID()
 	}
 	return nil, false
 }
@@ -2625,159 +2811,147 @@ func (m *DrainedResultMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DrainedResultMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ApplicationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case drainedresult.FieldDrainedPercent:
-		return m.OldDrainedPercent(ctx)
-	case drainedresult.FieldAvgPassingScore:
-		return m.OldAvgPassingScore(ctx)
-	case drainedresult.FieldMinPassingScore:
-		return m.OldMinPassingScore(ctx)
-	case drainedresult.FieldMaxPassingScore:
-		return m.OldMaxPassingScore(ctx)
-	case drainedresult.FieldMedPassingScore:
-		return m.OldMedPassingScore(ctx)
-	case drainedresult.FieldAvgLastAdmittedRatingPlace:
-		return m.OldAvgLastAdmittedRatingPlace(ctx)
-	case drainedresult.FieldMinLastAdmittedRatingPlace:
-		return m.OldMinLastAdmittedRatingPlace(ctx)
-	case drainedresult.FieldMaxLastAdmittedRatingPlace:
-		return m.OldMaxLastAdmittedRatingPlace(ctx)
-	case drainedresult.FieldMedLastAdmittedRatingPlace:
-		return m.OldMedLastAdmittedRatingPlace(ctx)
-	case drainedresult.FieldRunID:
+	case application.FieldStudentID:
+		return m.OldStudentID(ctx)
+	case application.FieldPriority:
+		return m.OldPriority(ctx)
+	case application.FieldCompetitionType:
+		return m.OldCompetitionType(ctx)
+	case application.FieldRatingPlace:
+		return m.OldRatingPlace(ctx)
+	case application.FieldScore:
+		return m.OldScore(ctx)
+	case application.FieldSubjectScores:
+		return m.OldSubjectScores(ctx)
+	case application.FieldAchievementScore:
+		return m.OldAchievementScore(ctx)
+	case application.FieldSubQuota:
+		return m.OldSubQuota(ctx)
+	case application.FieldRunID:
 		return m.OldRunID(ctx)
-	case drainedresult.FieldRegularsAdmitted:
-		return m.OldRegularsAdmitted(ctx)
-	case drainedresult.FieldIsVirtual:
-		return m.OldIsVirtual(ctx)
+	case application.FieldOriginalSubmitted:
+		return m.OldOriginalSubmitted(ctx)
+	case application.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case application.FieldInternalID:
+		return m.OldInternalID(ctx)
 	}
-	return nil, fmt.Errorf("unknown DrainedResult field %s", name)
+	return nil, fmt.Errorf("unknown Application field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DrainedResultMutation) SetField(name string, value ent.Value) error {
+func (m *ApplicationMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case drainedresult.FieldDrainedPercent:
-		v, ok := value.(int)
+	case application.FieldStudentID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDrainedPercent(v)
+		m.SetStudentID(v)
 		return nil
-	case drainedresult.FieldAvgPassingScore:
+	case application.FieldPriority:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAvgPassingScore(v)
+		m.SetPriority(v)
 		return nil
-	case drainedresult.FieldMinPassingScore:
-		v, ok := value.(int)
+	case application.FieldCompetitionType:
+		v, ok := value.(core.Competition)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMinPassingScore(v)
+		m.SetCompetitionType(v)
 		return nil
-	case drainedresult.FieldMaxPassingScore:
+	case application.FieldRatingPlace:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMaxPassingScore(v)
+		m.SetRatingPlace(v)
 		return nil
-	case drainedresult.FieldMedPassingScore:
+	case application.FieldScore:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMedPassingScore(v)
+		m.SetScore(v)
 		return nil
-	case drainedresult.FieldAvgLastAdmittedRatingPlace:
-		v, ok := value.(int)
+	case application.FieldSubjectScores:
+		v, ok := value.([]int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAvgLastAdmittedRatingPlace(v)
+		m.SetSubjectScores(v)
 		return nil
-	case drainedresult.FieldMinLastAdmittedRatingPlace:
+	case application.FieldAchievementScore:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMinLastAdmittedRatingPlace(v)
+		m.SetAchievementScore(v)
 		return nil
-	case drainedresult.FieldMaxLastAdmittedRatingPlace:
-		v, ok := value.(int)
+	case application.FieldSubQuota:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMaxLastAdmittedRatingPlace(v)
+		m.SetSubQuota(v)
 		return nil
-	case drainedresult.FieldMedLastAdmittedRatingPlace:
+	case application.FieldRunID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMedLastAdmittedRatingPlace(v)
+		m.SetRunID(v)
 		return nil
-	case drainedresult.FieldRunID:
-		v, ok := value.(int)
+	case application.FieldOriginalSubmitted:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRunID(v)
+		m.SetOriginalSubmitted(v)
 		return nil
-	case drainedresult.FieldRegularsAdmitted:
-		v, ok := value.(bool)
+	case application.FieldUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRegularsAdmitted(v)
+		m.SetUpdatedAt(v)
 		return nil
-	case drainedresult.FieldIsVirtual:
-		v, ok := value.(bool)
+	case application.FieldInternalID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsVirtual(v)
+		m.SetInternalID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DrainedResult field %s", name)
+	return fmt.Errorf("unknown Application field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DrainedResultMutation) AddedFields() []string {
+func (m *ApplicationMutation) AddedFields() []string {
 	var fields []string
-	if m.adddrained_percent != nil {
-		fields = append(fields, drainedresult.FieldDrainedPercent)
-	}
-	if m.addavg_passing_score != nil {
-		fields = append(fields, drainedresult.FieldAvgPassingScore)
-	}
-	if m.addmin_passing_score != nil {
-		fields = append(fields, drainedresult.FieldMinPassingScore)
-	}
-	if m.addmax_passing_score != nil {
-		fields = append(fields, drainedresult.FieldMaxPassingScore)
-	}
-	if m.addmed_passing_score != nil {
-		fields = append(fields, drainedresult.FieldMedPassingScore)
+	if m.addpriority != nil {
+		fields = append(fields, application.FieldPriority)
 	}
-	if m.addavg_last_admitted_rating_place != nil {
-		fields = append(fields, drainedresult.FieldAvgLastAdmittedRatingPlace)
+	if m.addcompetition_type != nil {
+		fields = append(fields, application.FieldCompetitionType)
 	}
-	if m.addmin_last_admitted_rating_place != nil {
-		fields = append(fields, drainedresult.FieldMinLastAdmittedRatingPlace)
+	if m.addrating_place != nil {
+		fields = append(fields, application.FieldRatingPlace)
 	}
-	if m.addmax_last_admitted_rating_place != nil {
-		fields = append(fields, drainedresult.FieldMaxLastAdmittedRatingPlace)
+	if m.addscore != nil {
+		fields = append(fields, application.FieldScore)
 	}
-	if m.addmed_last_admitted_rating_place != nil {
-		fields = append(fields, drainedresult.FieldMedLastAdmittedRatingPlace)
+	if m.addachievement_score != nil {
+		fields = append(fields, application.FieldAchievementScore)
 	}
 	return fields
 }
@@ -2785,26 +2959,18 @@ func (m *DrainedResultMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DrainedResultMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ApplicationMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case drainedresult.FieldDrainedPercent:
-		return m.AddedDrainedPercent()
-	case drainedresult.FieldAvgPassingScore:
-		return m.AddedAvgPassingScore()
-	case drainedresult.FieldMinPassingScore:
-		return m.AddedMinPassingScore()
-	case drainedresult.FieldMaxPassingScore:
-		return m.AddedMaxPassingScore()
-	case drainedresult.FieldMedPassingScore:
-		return m.AddedMedPassingScore()
-	case drainedresult.FieldAvgLastAdmittedRatingPlace:
-		return m.AddedAvgLastAdmittedRatingPlace()
-	case drainedresult.FieldMinLastAdmittedRatingPlace:
-		return m.AddedMinLastAdmittedRatingPlace()
-	case drainedresult.FieldMaxLastAdmittedRatingPlace:
-		return m.AddedMaxLastAdmittedRatingPlace()
-	case drainedresult.FieldMedLastAdmittedRatingPlace:
-		return m.AddedMedLastAdmittedRatingPlace()
+	case application.FieldPriority:
+		return m.AddedPriority()
+	case application.FieldCompetitionType:
+		return m.AddedCompetitionType()
+	case application.FieldRatingPlace:
+		return m.AddedRatingPlace()
+	case application.FieldScore:
+		return m.AddedScore()
+	case application.FieldAchievementScore:
+		return m.AddedAchievementScore()
 	}
 	return nil, false
 }
@@ -2812,159 +2978,152 @@ func (m *DrainedResultMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DrainedResultMutation) AddField(name string, value ent.Value) error {
+func (m *ApplicationMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case drainedresult.FieldDrainedPercent:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddDrainedPercent(v)
-		return nil
-	case drainedresult.FieldAvgPassingScore:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddAvgPassingScore(v)
-		return nil
-	case drainedresult.FieldMinPassingScore:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddMinPassingScore(v)
-		return nil
-	case drainedresult.FieldMaxPassingScore:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddMaxPassingScore(v)
-		return nil
-	case drainedresult.FieldMedPassingScore:
+	case application.FieldPriority:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddMedPassingScore(v)
+		m.AddPriority(v)
 		return nil
-	case drainedresult.FieldAvgLastAdmittedRatingPlace:
-		v, ok := value.(int)
+	case application.FieldCompetitionType:
+		v, ok := value.(core.Competition)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddAvgLastAdmittedRatingPlace(v)
+		m.AddCompetitionType(v)
 		return nil
-	case drainedresult.FieldMinLastAdmittedRatingPlace:
+	case application.FieldRatingPlace:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddMinLastAdmittedRatingPlace(v)
+		m.AddRatingPlace(v)
 		return nil
-	case drainedresult.FieldMaxLastAdmittedRatingPlace:
+	case application.FieldScore:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddMaxLastAdmittedRatingPlace(v)
+		m.AddScore(v)
 		return nil
-	case drainedresult.FieldMedLastAdmittedRatingPlace:
+	case application.FieldAchievementScore:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddMedLastAdmittedRatingPlace(v)
+		m.AddAchievementScore(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DrainedResult numeric field %s", name)
+	return fmt.Errorf("unknown Application numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DrainedResultMutation) ClearedFields() []string {
-	return nil
+func (m *ApplicationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(application.FieldSubjectScores) {
+		fields = append(fields, application.FieldSubjectScores)
+	}
+	if m.FieldCleared(application.FieldSubQuota) {
+		fields = append(fields, application.FieldSubQuota)
+	}
+	if m.FieldCleared(application.FieldInternalID) {
+		fields = append(fields, application.FieldInternalID)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DrainedResultMutation) FieldCleared(name string) bool {
+func (m *ApplicationMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DrainedResultMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown DrainedResult nullable field %s", name)
+func (m *ApplicationMutation) ClearField(name string) error {
+	switch name {
+	case application.FieldSubjectScores:
+		m.ClearSubjectScores()
+		return nil
+	case application.FieldSubQuota:
+		m.ClearSubQuota()
+		return nil
+	case application.FieldInternalID:
+		m.ClearInternalID()
+		return nil
+	}
+	return fmt.Errorf("unknown Application nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DrainedResultMutation) ResetField(name string) error {
+func (m *ApplicationMutation) ResetField(name string) error {
 	switch name {
-	case drainedresult.FieldDrainedPercent:
-		m.ResetDrainedPercent()
-		return nil
-	case drainedresult.FieldAvgPassingScore:
-		m.ResetAvgPassingScore()
+	case application.FieldStudentID:
+		m.ResetStudentID()
 		return nil
-	case drainedresult.FieldMinPassingScore:
-		m.ResetMinPassingScore()
+	case application.FieldPriority:
+		m.ResetPriority()
 		return nil
-	case drainedresult.FieldMaxPassingScore:
-		m.ResetMaxPassingScore()
+	case application.FieldCompetitionType:
+		m.ResetCompetitionType()
 		return nil
-	case drainedresult.FieldMedPassingScore:
-		m.ResetMedPassingScore()
+	case application.FieldRatingPlace:
+		m.ResetRatingPlace()
 		return nil
-	case drainedresult.FieldAvgLastAdmittedRatingPlace:
-		m.ResetAvgLastAdmittedRatingPlace()
+	case application.FieldScore:
+		m.ResetScore()
 		return nil
-	case drainedresult.FieldMinLastAdmittedRatingPlace:
-		m.ResetMinLastAdmittedRatingPlace()
+	case application.FieldSubjectScores:
+		m.ResetSubjectScores()
 		return nil
-	case drainedresult.FieldMaxLastAdmittedRatingPlace:
-		m.ResetMaxLastAdmittedRatingPlace()
+	case application.FieldAchievementScore:
+		m.ResetAchievementScore()
 		return nil
-	case drainedresult.FieldMedLastAdmittedRatingPlace:
-		m.ResetMedLastAdmittedRatingPlace()
+	case application.FieldSubQuota:
+		m.ResetSubQuota()
 		return nil
-	case drainedresult.FieldRunID:
+	case application.FieldRunID:
 		m.ResetRunID()
 		return nil
-	case drainedresult.FieldRegularsAdmitted:
-		m.ResetRegularsAdmitted()
+	case application.FieldOriginalSubmitted:
+		m.ResetOriginalSubmitted()
 		return nil
-	case drainedresult.FieldIsVirtual:
-		m.ResetIsVirtual()
+	case application.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case application.FieldInternalID:
+		m.ResetInternalID()
 		return nil
 	}
-	return fmt.Errorf("unknown DrainedResult field %s", name)
+	return fmt.Errorf("unknown Application field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DrainedResultMutation) AddedEdges() []string {
+func (m *ApplicationMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
 	if m.heading != nil {
-		edges = append(edges, drainedresult.EdgeHeading)
+		edges = append(edges, application.EdgeHeading)
 	}
 	if m.run != nil {
-		edges = append(edges, drainedresult.EdgeRun)
+		edges = append(edges, application.EdgeRun)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DrainedResultMutation) AddedIDs(name string) []ent.Value {
+func (m *ApplicationMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case drainedresult.EdgeHeading:
+	case application.EdgeHeading:
 		if id := m.heading; id != nil {
 			return []ent.Value{*id}
 		}
-	case drainedresult.EdgeRun:
+	case application.EdgeRun:
 		if id := m.run; id != nil {
 			return []ent.Value{*id}
 		}
@@ -2973,36 +3132,36 @@ func (m *DrainedResultMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DrainedResultMutation) RemovedEdges() []string {
+func (m *ApplicationMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DrainedResultMutation) RemovedIDs(name string) []ent.Value {
+func (m *ApplicationMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DrainedResultMutation) ClearedEdges() []string {
+func (m *ApplicationMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
 	if m.clearedheading {
-		edges = append(edges, drainedresult.EdgeHeading)
+		edges = append(edges, application.EdgeHeading)
 	}
 	if m.clearedrun {
-		edges = append(edges, drainedresult.EdgeRun)
+		edges = append(edges, application.EdgeRun)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DrainedResultMutation) EdgeCleared(name string) bool {
+func (m *ApplicationMutation) EdgeCleared(name string) bool {
 	switch name {
-	case drainedresult.EdgeHeading:
+	case application.EdgeHeading:
 		return m.clearedheading
-	case drainedresult.EdgeRun:
+	case application.EdgeRun:
 		return m.clearedrun
 	}
 	return false
@@ -3010,76 +3169,66 @@ func (m *DrainedResultMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DrainedResultMutation) ClearEdge(name string) error {
+func (m *ApplicationMutation) ClearEdge(name string) error {
 	switch name {
-	case drainedresult.EdgeHeading:
+	case application.EdgeHeading:
 		m.ClearHeading()
 		return nil
-	case drainedresult.EdgeRun:
+	case application.EdgeRun:
 		m.ClearRun()
 		return nil
 	}
-	return fmt.Errorf("unknown DrainedResult unique edge %s", name)
+	return fmt.Errorf("unknown Application unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DrainedResultMutation) ResetEdge(name string) error {
+func (m *ApplicationMutation) ResetEdge(name string) error {
 	switch name {
-	case drainedresult.EdgeHeading:
+	case application.EdgeHeading:
 		m.ResetHeading()
 		return nil
-	case drainedresult.EdgeRun:
+	case application.EdgeRun:
 		m.ResetRun()
 		return nil
 	}
-	return fmt.Errorf("unknown DrainedResult edge %s", name)
+	return fmt.Errorf("unknown Application edge %s", name)
 }
 
-// HeadingMutation represents an operation that mutates the Heading nodes in the graph.
-type HeadingMutation struct {
+// CalculationMutation represents an operation that mutates the Calculation nodes in the graph.
+type CalculationMutation struct {
 	config
-	op                          Op
-	typ                         string
-	id                          *int
-	regular_capacity            *int
-	addregular_capacity         *int
-	target_quota_capacity       *int
-	addtarget_quota_capacity    *int
-	dedicated_quota_capacity    *int
-	adddedicated_quota_capacity *int
-	special_quota_capacity      *int
-	addspecial_quota_capacity   *int
-	code                        *string
-	name                        *string
-	clearedFields               map[string]struct{}
-	varsity                     *int
-	clearedvarsity              bool
-	applications                map[int]struct{}
-	removedapplications         map[int]struct{}
-	clearedapplications         bool
-	calculations                map[int]struct{}
-	removedcalculations         map[int]struct{}
-	clearedcalculations         bool
-	drained_results             map[int]struct{}
-	removeddrained_results      map[int]struct{}
-	cleareddrained_results      bool
-	done                        bool
-	oldValue                    func(context.Context) (*Heading, error)
-	predicates                  []predicate.Heading
+	op                Op
+	typ               string
+	id                *int
+	student_id        *string
+	admitted_place    *int
+	addadmitted_place *int
+	stage             *core.AdmissionStage
+	addstage          *core.AdmissionStage
+	paid              *bool
+	updated_at        *time.Time
+	clearedFields     map[string]struct{}
+	heading           *int
+	clearedheading    bool
+	run               *int
+	clearedrun        bool
+	done              bool
+	oldValue          func(context.Context) (*Calculation, error)
+	predicates        []predicate.Calculation
 }
 
-var _ ent.Mutation = (*HeadingMutation)(nil)
+var _ ent.Mutation = (*CalculationMutation)(nil)
 
-// headingOption allows management of the mutation configuration using functional options.
-type headingOption func(*HeadingMutation)
+// calculationOption allows management of the mutation configuration using functional options.
+type calculationOption func(*CalculationMutation)
 
-// newHeadingMutation creates new mutation for the Heading entity.
-func newHeadingMutation(c config, op Op, opts ...headingOption) *HeadingMutation {
-	m := &HeadingMutation{
+// newCalculationMutation creates new mutation for the Calculation entity.
+func newCalculationMutation(c config, op Op, opts ...calculationOption) *CalculationMutation {
+	m := &CalculationMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeHeading,
+		typ:           TypeCalculation,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3088,20 +3237,20 @@ func newHeadingMutation(c config, op Op, opts ...headingOption) *HeadingMutation
 	return m
 }
 
-// withHeadingID sets the ID field of the mutation.
-func withHeadingID(id int) headingOption {
-	return func(m *HeadingMutation) {
+// withCalculationID sets the ID field of the mutation.
+func withCalculationID(id int) calculationOption {
+	return func(m *CalculationMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Heading
+			value *Calculation
 		)
-		m.oldValue = func(ctx context.Context) (*Heading, error) {
+		m.oldValue = func(ctx context.Context) (*Calculation, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Heading.Get(ctx, id)
+					value, err = m.Client().Calculation.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3110,10 +3259,10 @@ func withHeadingID(id int) headingOption {
 	}
 }
 
-// withHeading sets the old Heading of the mutation.
-func withHeading(node *Heading) headingOption {
-	return func(m *HeadingMutation) {
-		m.oldValue = func(context.Context) (*Heading, error) {
+// withCalculation sets the old Calculation of the mutation.
+func withCalculation(node *Calculation) calculationOption {
+	return func(m *CalculationMutation) {
+		m.oldValue = func(context.Context) (*Calculation, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3122,7 +3271,7 @@ func withHeading(node *Heading) headingOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m HeadingMutation) Client() *Client {
+func (m CalculationMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3130,7 +3279,7 @@ func (m HeadingMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m HeadingMutation) Tx() (*Tx, error) {
+func (m CalculationMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3141,7 +3290,7 @@ func (m HeadingMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *HeadingMutation) ID() (id int, exists bool) {
+func (m *CalculationMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3152,7 +3301,7 @@ func (m *HeadingMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *HeadingMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *CalculationMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3161,582 +3310,421 @@ func (m *HeadingMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Heading.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Calculation.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetRegularCapacity sets the "regular_capacity" field.
-func (m *HeadingMutation) SetRegularCapacity(i int) {
-	m.regular_capacity = &i
-	m.addregular_capacity = nil
+// SetStudentID sets the "student_id" field.
+func (m *CalculationMutation) SetStudentID(s string) {
+	m.student_id = &s
 }
 
-// RegularCapacity returns the value of the "regular_capacity" field in the mutation.
-func (m *HeadingMutation) RegularCapacity() (r int, exists bool) {
-	v := m.regular_capacity
+// StudentID returns the value of the "student_id" field in the mutation.
+func (m *CalculationMutation) StudentID() (r string, exists bool) {
+	v := m.student_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRegularCapacity returns the old "regular_capacity" field's value of the Heading entity.
-// If the Heading object wasn't provided to the builder, the object is fetched from the database.
+// OldStudentID returns the old "student_id" field's value of the Calculation entity.
+// If the Calculation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HeadingMutation) OldRegularCapacity(ctx context.Context) (v int, err error) {
+func (m *CalculationMutation) OldStudentID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRegularCapacity is only allowed on UpdateOne operations")
+		return v, errors.New("OldStudentID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRegularCapacity requires an ID field in the mutation")
+		return v, errors.New("OldStudentID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRegularCapacity: %w", err)
-	}
-	return oldValue.RegularCapacity, nil
-}
-
-// AddRegularCapacity adds i to the "regular_capacity" field.
-func (m *HeadingMutation) AddRegularCapacity(i int) {
-	if m.addregular_capacity != nil {
-		*m.addregular_capacity += i
-	} else {
-		m.addregular_capacity = &i
-	}
-}
-
-// AddedRegularCapacity returns the value that was added to the "regular_capacity" field in this mutation.
-func (m *HeadingMutation) AddedRegularCapacity() (r int, exists bool) {
-	v := m.addregular_capacity
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldStudentID: %w", err)
 	}
-	return *v, true
+	return oldValue.StudentID, nil
 }
 
-// ResetRegularCapacity resets all changes to the "regular_capacity" field.
-func (m *HeadingMutation) ResetRegularCapacity() {
-	m.regular_capacity = nil
-	m.addregular_capacity = nil
+// ResetStudentID resets all changes to the "student_id" field.
+func (m *CalculationMutation) ResetStudentID() {
+	m.student_id = nil
 }
 
-// SetTargetQuotaCapacity sets the "target_quota_capacity" field.
-func (m *HeadingMutation) SetTargetQuotaCapacity(i int) {
-	m.target_quota_capacity = &i
-	m.addtarget_quota_capacity = nil
+// SetAdmittedPlace sets the "admitted_place" field.
+func (m *CalculationMutation) SetAdmittedPlace(i int) {
+	m.admitted_place = &i
+	m.addadmitted_place = nil
 }
 
-// TargetQuotaCapacity returns the value of the "target_quota_capacity" field in the mutation.
-func (m *HeadingMutation) TargetQuotaCapacity() (r int, exists bool) {
-	v := m.target_quota_capacity
+// AdmittedPlace returns the value of the "admitted_place" field in the mutation.
+func (m *CalculationMutation) AdmittedPlace() (r int, exists bool) {
+	v := m.admitted_place
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTargetQuotaCapacity returns the old "target_quota_capacity" field's value of the Heading entity.
-// If the Heading object wasn't provided to the builder, the object is fetched from the database.
+// OldAdmittedPlace returns the old "admitted_place" field's value of the Calculation entity.
+// If the Calculation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HeadingMutation) OldTargetQuotaCapacity(ctx context.Context) (v int, err error) {
+func (m *CalculationMutation) OldAdmittedPlace(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTargetQuotaCapacity is only allowed on UpdateOne operations")
+		return v, errors.New("OldAdmittedPlace is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTargetQuotaCapacity requires an ID field in the mutation")
+		return v, errors.New("OldAdmittedPlace requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTargetQuotaCapacity: %w", err)
+		return v, fmt.Errorf("querying old value for OldAdmittedPlace: %w", err)
 	}
-	return oldValue.TargetQuotaCapacity, nil
+	return oldValue.AdmittedPlace, nil
 }
 
-// AddTargetQuotaCapacity adds i to the "target_quota_capacity" field.
-func (m *HeadingMutation) AddTargetQuotaCapacity(i int) {
-	if m.addtarget_quota_capacity != nil {
-		*m.addtarget_quota_capacity += i
+// AddAdmittedPlace adds i to the "admitted_place" field.
+func (m *CalculationMutation) AddAdmittedPlace(i int) {
+	if m.addadmitted_place != nil {
+		*m.addadmitted_place += i
 	} else {
-		m.addtarget_quota_capacity = &i
+		m.addadmitted_place = &i
 	}
 }
 
-// AddedTargetQuotaCapacity returns the value that was added to the "target_quota_capacity" field in this mutation.
-func (m *HeadingMutation) AddedTargetQuotaCapacity() (r int, exists bool) {
-	v := m.addtarget_quota_capacity
+// AddedAdmittedPlace returns the value that was added to the "admitted_place" field in this mutation.
+func (m *CalculationMutation) AddedAdmittedPlace() (r int, exists bool) {
+	v := m.addadmitted_place
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetTargetQuotaCapacity resets all changes to the "target_quota_capacity" field.
-func (m *HeadingMutation) ResetTargetQuotaCapacity() {
-	m.target_quota_capacity = nil
-	m.addtarget_quota_capacity = nil
+// ResetAdmittedPlace resets all changes to the "admitted_place" field.
+func (m *CalculationMutation) ResetAdmittedPlace() {
+	m.admitted_place = nil
+	m.addadmitted_place = nil
 }
 
-// SetDedicatedQuotaCapacity sets the "dedicated_quota_capacity" field.
-func (m *HeadingMutation) SetDedicatedQuotaCapacity(i int) {
-	m.dedicated_quota_capacity = &i
-	m.adddedicated_quota_capacity = nil
+// SetStage sets the "stage" field.
+func (m *CalculationMutation) SetStage(cs core.AdmissionStage) {
+	m.stage = &cs
+	m.addstage = nil
 }
 
-// DedicatedQuotaCapacity returns the value of the "dedicated_quota_capacity" field in the mutation.
-func (m *HeadingMutation) DedicatedQuotaCapacity() (r int, exists bool) {
-	v := m.dedicated_quota_capacity
+// Stage returns the value of the "stage" field in the mutation.
+func (m *CalculationMutation) Stage() (r core.AdmissionStage, exists bool) {
+	v := m.stage
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDedicatedQuotaCapacity returns the old "dedicated_quota_capacity" field's value of the Heading entity.
-// If the Heading object wasn't provided to the builder, the object is fetched from the database.
+// OldStage returns the old "stage" field's value of the Calculation entity.
+// If the Calculation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HeadingMutation) OldDedicatedQuotaCapacity(ctx context.Context) (v int, err error) {
+func (m *CalculationMutation) OldStage(ctx context.Context) (v core.AdmissionStage, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDedicatedQuotaCapacity is only allowed on UpdateOne operations")
+		return v, errors.New("OldStage is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDedicatedQuotaCapacity requires an ID field in the mutation")
+		return v, errors.New("OldStage requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDedicatedQuotaCapacity: %w", err)
+		return v, fmt.Errorf("querying old value for OldStage: %w", err)
 	}
-	return oldValue.DedicatedQuotaCapacity, nil
+	return oldValue.Stage, nil
 }
 
-// AddDedicatedQuotaCapacity adds i to the "dedicated_quota_capacity" field.
-func (m *HeadingMutation) AddDedicatedQuotaCapacity(i int) {
-	if m.adddedicated_quota_capacity != nil {
-		*m.adddedicated_quota_capacity += i
+// AddStage adds cs to the "stage" field.
+func (m *CalculationMutation) AddStage(cs core.AdmissionStage) {
+	if m.addstage != nil {
+		*m.addstage += cs
 	} else {
-		m.adddedicated_quota_capacity = &i
+		m.addstage = &cs
 	}
 }
 
-// AddedDedicatedQuotaCapacity returns the value that was added to the "dedicated_quota_capacity" field in this mutation.
-func (m *HeadingMutation) AddedDedicatedQuotaCapacity() (r int, exists bool) {
-	v := m.adddedicated_quota_capacity
+// AddedStage returns the value that was added to the "stage" field in this mutation.
+func (m *CalculationMutation) AddedStage() (r core.AdmissionStage, exists bool) {
+	v := m.addstage
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetDedicatedQuotaCapacity resets all changes to the "dedicated_quota_capacity" field.
-func (m *HeadingMutation) ResetDedicatedQuotaCapacity() {
-	m.dedicated_quota_capacity = nil
-	m.adddedicated_quota_capacity = nil
+// ClearStage clears the value of the "stage" field.
+func (m *CalculationMutation) ClearStage() {
+	m.stage = nil
+	m.addstage = nil
+	m.clearedFields[calculation.FieldStage] = struct{}{}
 }
 
-// SetSpecialQuotaCapacity sets the "special_quota_capacity" field.
-func (m *HeadingMutation) SetSpecialQuotaCapacity(i int) {
-	m.special_quota_capacity = &i
-	m.addspecial_quota_capacity = nil
+// StageCleared returns if the "stage" field was cleared in this mutation.
+func (m *CalculationMutation) StageCleared() bool {
+	_, ok := m.clearedFields[calculation.FieldStage]
+	return ok
 }
 
-// SpecialQuotaCapacity returns the value of the "special_quota_capacity" field in the mutation.
-func (m *HeadingMutation) SpecialQuotaCapacity() (r int, exists bool) {
-	v := m.special_quota_capacity
+// ResetStage resets all changes to the "stage" field.
+func (m *CalculationMutation) ResetStage() {
+	m.stage = nil
+	m.addstage = nil
+	delete(m.clearedFields, calculation.FieldStage)
+}
+
+// SetPaid sets the "paid" field.
+func (m *CalculationMutation) SetPaid(b bool) {
+	m.paid = &b
+}
+
+// Paid returns the value of the "paid" field in the mutation.
+func (m *CalculationMutation) Paid() (r bool, exists bool) {
+	v := m.paid
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSpecialQuotaCapacity returns the old "special_quota_capacity" field's value of the Heading entity.
-// If the Heading object wasn't provided to the builder, the object is fetched from the database.
+// OldPaid returns the old "paid" field's value of the Calculation entity.
+// If the Calculation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HeadingMutation) OldSpecialQuotaCapacity(ctx context.Context) (v int, err error) {
+func (m *CalculationMutation) OldPaid(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSpecialQuotaCapacity is only allowed on UpdateOne operations")
+		return v, errors.New("OldPaid is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSpecialQuotaCapacity requires an ID field in the mutation")
+		return v, errors.New("OldPaid requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSpecialQuotaCapacity: %w", err)
+		return v, fmt.Errorf("querying old value for OldPaid: %w", err)
 	}
-	return oldValue.SpecialQuotaCapacity, nil
+	return oldValue.Paid, nil
 }
 
-// AddSpecialQuotaCapacity adds i to the "special_quota_capacity" field.
-func (m *HeadingMutation) AddSpecialQuotaCapacity(i int) {
-	if m.addspecial_quota_capacity != nil {
-		*m.addspecial_quota_capacity += i
-	} else {
-		m.addspecial_quota_capacity = &i
-	}
-}
-
-// AddedSpecialQuotaCapacity returns the value that was added to the "special_quota_capacity" field in this mutation.
-func (m *HeadingMutation) AddedSpecialQuotaCapacity() (r int, exists bool) {
-	v := m.addspecial_quota_capacity
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetSpecialQuotaCapacity resets all changes to the "special_quota_capacity" field.
-func (m *HeadingMutation) ResetSpecialQuotaCapacity() {
-	m.special_quota_capacity = nil
-	m.addspecial_quota_capacity = nil
+// ResetPaid resets all changes to the "paid" field.
+func (m *CalculationMutation) ResetPaid() {
+	m.paid = nil
 }
 
-// SetCode sets the "code" field.
-func (m *HeadingMutation) SetCode(s string) {
-	m.code = &s
+// SetRunID sets the "run_id" field.
+func (m *CalculationMutation) SetRunID(i int) {
+	m.run = &i
 }
 
-// Code returns the value of the "code" field in the mutation.
-func (m *HeadingMutation) Code() (r string, exists bool) {
-	v := m.code
+// RunID returns the value of the "run_id" field in the mutation.
+func (m *CalculationMutation) RunID() (r int, exists bool) {
+	v := m.run
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCode returns the old "code" field's value of the Heading entity.
-// If the Heading object wasn't provided to the builder, the object is fetched from the database.
+// OldRunID returns the old "run_id" field's value of the Calculation entity.
+// If the Calculation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HeadingMutation) OldCode(ctx context.Context) (v string, err error) {
+func (m *CalculationMutation) OldRunID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCode is only allowed on UpdateOne operations")
+		return v, errors.New("OldRunID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCode requires an ID field in the mutation")
+		return v, errors.New("OldRunID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCode: %w", err)
+		return v, fmt.Errorf("querying old value for OldRunID: %w", err)
 	}
-	return oldValue.Code, nil
+	return oldValue.RunID, nil
 }
 
-// ResetCode resets all changes to the "code" field.
-func (m *HeadingMutation) ResetCode() {
-	m.code = nil
+// ResetRunID resets all changes to the "run_id" field.
+func (m *CalculationMutation) ResetRunID() {
+	m.run = nil
 }
 
-// SetName sets the "name" field.
-func (m *HeadingMutation) SetName(s string) {
-	m.name = &s
+// SetUpdatedAt sets the "updated_at" field.
+func (m *CalculationMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *HeadingMutation) Name() (r string, exists bool) {
-	v := m.name
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *CalculationMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Heading entity.
-// If the Heading object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Calculation entity.
+// If the Calculation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *HeadingMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *CalculationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *HeadingMutation) ResetName() {
-	m.name = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *CalculationMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetVarsityID sets the "varsity" edge to the Varsity entity by id.
-func (m *HeadingMutation) SetVarsityID(id int) {
-	m.varsity = &id
+// SetHeadingID sets the "heading" edge to the Heading entity by id.
+func (m *CalculationMutation) SetHeadingID(id int) {
+	m.heading = &id
 }
 
-// ClearVarsity clears the "varsity" edge to the Varsity entity.
-func (m *HeadingMutation) ClearVarsity() {
-	m.clearedvarsity = true
+// ClearHeading clears the "heading" edge to the Heading entity.
+func (m *CalculationMutation) ClearHeading() {
+	m.clearedheading = true
 }
 
-// VarsityCleared reports if the "varsity" edge to the Varsity entity was cleared.
-func (m *HeadingMutation) VarsityCleared() bool {
-	return m.clearedvarsity
+// HeadingCleared reports if the "heading" edge to the Heading entity was cleared.
+func (m *CalculationMutation) HeadingCleared() bool {
+	return m.clearedheading
 }
 
-// VarsityID returns the "varsity" edge ID in the mutation.
-func (m *HeadingMutation) VarsityID() (id int, exists bool) {
-	if m.varsity != nil {
-		return *m.varsity, true
+// HeadingID returns the "heading" edge ID in the mutation.
+func (m *CalculationMutation) HeadingID() (id int, exists bool) {
+	if m.heading != nil {
+		return *m.heading, true
 	}
 	return
 }
 
-// VarsityIDs returns the "varsity" edge IDs in the mutation.
+// HeadingIDs returns the "heading" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// VarsityID instead. It exists only for internal usage by the builders.
-func (m *HeadingMutation) VarsityIDs() (ids []int) {
-	if id := m.varsity; id != nil {
+// HeadingID instead. It exists only for internal usage by the builders.
+func (m *CalculationMutation) HeadingIDs() (ids []int) {
+	if id := m.heading; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetVarsity resets all changes to the "varsity" edge.
-func (m *HeadingMutation) ResetVarsity() {
-	m.varsity = nil
-	m.clearedvarsity = false
-}
-
-// AddApplicationIDs adds the "applications" edge to the Application entity by ids.
-func (m *HeadingMutation) AddApplicationIDs(ids ...int) {
-	if m.applications == nil {
-		m.applications = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.applications[ids[i]] = struct{}{}
-	}
-}
-
-// ClearApplications clears the "applications" edge to the Application entity.
-func (m *HeadingMutation) ClearApplications() {
-	m.clearedapplications = true
+// ResetHeading resets all changes to the "heading" edge.
+func (m *CalculationMutation) ResetHeading() {
+	m.heading = nil
+	m.clearedheading = false
 }
 
-// ApplicationsCleared reports if the "applications" edge to the Application entity was cleared.
-func (m *HeadingMutation) ApplicationsCleared() bool {
-	return m.clearedapplications
+// ClearRun clears the "run" edge to the Run entity.
+func (m *CalculationMutation) ClearRun() {
+	m.clearedrun = true
+	m.clearedFields[calculation.FieldRunID] = struct{}{}
 }
 
-// RemoveApplicationIDs removes the "applications" edge to the Application entity by IDs.
-func (m *HeadingMutation) RemoveApplicationIDs(ids ...int) {
-	if m.removedapplications == nil {
-		m.removedapplications = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.applications, ids[i])
-		m.removedapplications[ids[i]] = struct{}{}
-	}
+// RunCleared reports if the "run" edge to the Run entity was cleared.
+func (m *CalculationMutation) RunCleared() bool {
+	return m.clearedrun
 }
 
-// RemovedApplications returns the removed IDs of the "applications" edge to the Application entity.
-func (m *HeadingMutation) RemovedApplicationsIDs() (ids []int) {
-	for id := range m.removedapplications {
-		ids = append(ids, id)
+// RunIDs returns the "run" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RunID instead. It exists only for internal usage by the builders.
+func (m *CalculationMutation) RunIDs() (ids []int) {
+	if id := m.run; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ApplicationsIDs returns the "applications" edge IDs in the mutation.
-func (m *HeadingMutation) ApplicationsIDs() (ids []int) {
-	for id := range m.applications {
-		ids = append(ids, id)
-	}
-	return
+// ResetRun resets all changes to the "run" edge.
+func (m *CalculationMutation) ResetRun() {
+	m.run = nil
+	m.clearedrun = false
 }
 
-// ResetApplications resets all changes to the "applications" edge.
-func (m *HeadingMutation) ResetApplications() {
-	m.applications = nil
-	m.clearedapplications = false
-	m.removedapplications = nil
+// Where appends a list predicates to the CalculationMutation builder.
+func (m *CalculationMutation) Where(ps ...predicate.Calculation) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// AddCalculationIDs adds the "calculations" edge to the Calculation entity by ids.
-func (m *HeadingMutation) AddCalculationIDs(ids ...int) {
-	if m.calculations == nil {
-		m.calculations = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.calculations[ids[i]] = struct{}{}
+// WhereP appends storage-level predicates to the CalculationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CalculationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Calculation, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
+	m.Where(p...)
 }
 
-// ClearCalculations clears the "calculations" edge to the Calculation entity.
-func (m *HeadingMutation) ClearCalculations() {
-	m.clearedcalculations = true
+// Op returns the operation name.
+func (m *CalculationMutation) Op() Op {
+	return m.op
 }
 
-// CalculationsCleared reports if the "calculations" edge to the Calculation entity was cleared.
-func (m *HeadingMutation) CalculationsCleared() bool {
-	return m.clearedcalculations
+// SetOp allows setting the mutation operation.
+func (m *CalculationMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// RemoveCalculationIDs removes the "calculations" edge to the Calculation entity by IDs.
-func (m *HeadingMutation) RemoveCalculationIDs(ids ...int) {
-	if m.removedcalculations == nil {
-		m.removedcalculations = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.calculations, ids[i])
-		m.removedcalculations[ids[i]] = struct{}{}
-	}
+// Type returns the node type of this mutation (Calculation).
+func (m *CalculationMutation) Type() string {
+	return m.typ
 }
 
-// RemovedCalculations returns the removed IDs of the "calculations" edge to the Calculation entity.
-func (m *HeadingMutation) RemovedCalculationsIDs() (ids []int) {
-	for id := range m.removedcalculations {
-		ids = append(ids, id)
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CalculationMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.student_id != nil {
+		fields = append(fields, calculation.FieldStudentID)
 	}
-	return
-}
-
-// CalculationsIDs returns the "calculations" edge IDs in the mutation.
-func (m *HeadingMutation) CalculationsIDs() (ids []int) {
-	for id := range m.calculations {
-		ids = append(ids, id)
+	if m.admitted_place != nil {
+		fields = append(fields, calculation.FieldAdmittedPlace)
 	}
-	return
-}
-
-// ResetCalculations resets all changes to the "calculations" edge.
-func (m *HeadingMutation) ResetCalculations() {
-	m.calculations = nil
-	m.clearedcalculations = false
-	m.removedcalculations = nil
-}
-
-// AddDrainedResultIDs adds the "drained_results" edge to the DrainedResult entity by ids.
-func (m *HeadingMutation) AddDrainedResultIDs(ids ...int) {
-	if m.drained_results == nil {
-		m.drained_results = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.drained_results[ids[i]] = struct{}{}
-	}
-}
-
-// ClearDrainedResults clears the "drained_results" edge to the DrainedResult entity.
-func (m *HeadingMutation) ClearDrainedResults() {
-	m.cleareddrained_results = true
-}
-
-// DrainedResultsCleared reports if the "drained_results" edge to the DrainedResult entity was cleared.
-func (m *HeadingMutation) DrainedResultsCleared() bool {
-	return m.cleareddrained_results
-}
-
-// RemoveDrainedResultIDs removes the "drained_results" edge to the DrainedResult entity by IDs.
-func (m *HeadingMutation) RemoveDrainedResultIDs(ids ...int) {
-	if m.removeddrained_results == nil {
-		m.removeddrained_results = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.drained_results, ids[i])
-		m.removeddrained_results[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedDrainedResults returns the removed IDs of the "drained_results" edge to the DrainedResult entity.
-func (m *HeadingMutation) RemovedDrainedResultsIDs() (ids []int) {
-	for id := range m.removeddrained_results {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// DrainedResultsIDs returns the "drained_results" edge IDs in the mutation.
-func (m *HeadingMutation) DrainedResultsIDs() (ids []int) {
-	for id := range m.drained_results {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetDrainedResults resets all changes to the "drained_results" edge.
-func (m *HeadingMutation) ResetDrainedResults() {
-	m.drained_results = nil
-	m.cleareddrained_results = false
-	m.removeddrained_results = nil
-}
-
-// Where appends a list predicates to the HeadingMutation builder.
-func (m *HeadingMutation) Where(ps ...predicate.Heading) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the HeadingMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *HeadingMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Heading, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *HeadingMutation) Op() Op {
-	return m.op
-}
-
-// SetOp allows setting the mutation operation.
-func (m *HeadingMutation) SetOp(op Op) {
-	m.op = op
-}
-
-// Type returns the node type of this mutation (Heading).
-func (m *HeadingMutation) Type() string {
-	return m.typ
-}
-
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *HeadingMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.regular_capacity != nil {
-		fields = append(fields, heading.FieldRegularCapacity)
-	}
-	if m.target_quota_capacity != nil {
-		fields = append(fields, heading.FieldTargetQuotaCapacity)
-	}
-	if m.dedicated_quota_capacity != nil {
-		fields = append(fields, heading.FieldDedicatedQuotaCapacity)
-	}
-	if m.special_quota_capacity != nil {
-		fields = append(fields, heading.FieldSpecialQuotaCapacity)
-	}
-	if m.code != nil {
-		fields = append(fields, heading.FieldCode)
-	}
-	if m.name != nil {
-		fields = append(fields, heading.FieldName)
-	}
-	return fields
+	if m.stage != nil {
+		fields = append(fields, calculation.FieldStage)
+	}
+	if m.paid != nil {
+		fields = append(fields, calculation.FieldPaid)
+	}
+	if m.run != nil {
+		fields = append(fields, calculation.FieldRunID)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, calculation.FieldUpdatedAt)
+	}
+	return fields
 }
 
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *HeadingMutation) Field(name string) (ent.Value, bool) {
+func (m *CalculationMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case heading.FieldRegularCapacity:
-		return m.RegularCapacity()
-	case heading.FieldTargetQuotaCapacity:
-		return m.TargetQuotaCapacity()
-	case heading.FieldDedicatedQuotaCapacity:
-		return m.DedicatedQuotaCapacity()
-	case heading.FieldSpecialQuotaCapacity:
-		return m.SpecialQuotaCapacity()
-	case heading.FieldCode:
-		return m.Code()
-	case heading.FieldName:
-		return m.Name()
+	case calculation.FieldStudentID:
+		return m.StudentID()
+	case calculation.FieldAdmittedPlace:
+		return m.AdmittedPlace()
+	case calculation.FieldStage:
+		return m.Stage()
+	case calculation.FieldPaid:
+		return m.Paid()
+	case calculation.FieldRunID:
+		return m.RunID()
+	case calculation.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -3744,90 +3732,84 @@ func (m *HeadingMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *HeadingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *CalculationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case heading.FieldRegularCapacity:
-		return m.OldRegularCapacity(ctx)
-	case heading.FieldTargetQuotaCapacity:
-		return m.OldTargetQuotaCapacity(ctx)
-	case heading.FieldDedicatedQuotaCapacity:
-		return m.OldDedicatedQuotaCapacity(ctx)
-	case heading.FieldSpecialQuotaCapacity:
-		return m.OldSpecialQuotaCapacity(ctx)
-	case heading.FieldCode:
-		return m.OldCode(ctx)
-	case heading.FieldName:
-		return m.OldName(ctx)
+	case calculation.FieldStudentID:
+		return m.OldStudentID(ctx)
+	case calculation.FieldAdmittedPlace:
+		return m.OldAdmittedPlace(ctx)
+	case calculation.FieldStage:
+		return m.OldStage(ctx)
+	case calculation.FieldPaid:
+		return m.OldPaid(ctx)
+	case calculation.FieldRunID:
+		return m.OldRunID(ctx)
+	case calculation.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Heading field %s", name)
+	return nil, fmt.Errorf("unknown Calculation field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *HeadingMutation) SetField(name string, value ent.Value) error {
+func (m *CalculationMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case heading.FieldRegularCapacity:
-		v, ok := value.(int)
+	case calculation.FieldStudentID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRegularCapacity(v)
+		m.SetStudentID(v)
 		return nil
-	case heading.FieldTargetQuotaCapacity:
+	case calculation.FieldAdmittedPlace:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTargetQuotaCapacity(v)
+		m.SetAdmittedPlace(v)
 		return nil
-	case heading.FieldDedicatedQuotaCapacity:
-		v, ok := value.(int)
+	case calculation.FieldStage:
+		v, ok := value.(core.AdmissionStage)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDedicatedQuotaCapacity(v)
+		m.SetStage(v)
 		return nil
-	case heading.FieldSpecialQuotaCapacity:
-		v, ok := value.(int)
+	case calculation.FieldPaid:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSpecialQuotaCapacity(v)
+		m.SetPaid(v)
 		return nil
-	case heading.FieldCode:
-		v, ok := value.(string)
+	case calculation.FieldRunID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCode(v)
+		m.SetRunID(v)
 		return nil
-	case heading.FieldName:
-		v, ok := value.(string)
+	case calculation.FieldUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Heading field %s", name)
+	return fmt.Errorf("unknown Calculation field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *HeadingMutation) AddedFields() []string {
+func (m *CalculationMutation) AddedFields() []string {
 	var fields []string
-	if m.addregular_capacity != nil {
-		fields = append(fields, heading.FieldRegularCapacity)
-	}
-	if m.addtarget_quota_capacity != nil {
-		fields = append(fields, heading.FieldTargetQuotaCapacity)
-	}
-	if m.adddedicated_quota_capacity != nil {
-		fields = append(fields, heading.FieldDedicatedQuotaCapacity)
+	if m.addadmitted_place != nil {
+		fields = append(fields, calculation.FieldAdmittedPlace)
 	}
-	if m.addspecial_quota_capacity != nil {
-		fields = append(fields, heading.FieldSpecialQuotaCapacity)
+	if m.addstage != nil {
+		fields = append(fields, calculation.FieldStage)
 	}
 	return fields
 }
@@ -3835,16 +3817,12 @@ func (m *HeadingMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *HeadingMutation) AddedField(name string) (ent.Value, bool) {
+func (m *CalculationMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case heading.FieldRegularCapacity:
-		return m.AddedRegularCapacity()
-	case heading.FieldTargetQuotaCapacity:
-		return m.AddedTargetQuotaCapacity()
-	case heading.FieldDedicatedQuotaCapacity:
-		return m.AddedDedicatedQuotaCapacity()
-	case heading.FieldSpecialQuotaCapacity:
-		return m.AddedSpecialQuotaCapacity()
+	case calculation.FieldAdmittedPlace:
+		return m.AddedAdmittedPlace()
+	case calculation.FieldStage:
+		return m.AddedStage()
 	}
 	return nil, false
 }
@@ -3852,266 +3830,221 @@ func (m *HeadingMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *HeadingMutation) AddField(name string, value ent.Value) error {
+func (m *CalculationMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case heading.FieldRegularCapacity:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddRegularCapacity(v)
-		return nil
-	case heading.FieldTargetQuotaCapacity:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddTargetQuotaCapacity(v)
-		return nil
-	case heading.FieldDedicatedQuotaCapacity:
+	case calculation.FieldAdmittedPlace:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddDedicatedQuotaCapacity(v)
+		m.AddAdmittedPlace(v)
 		return nil
-	case heading.FieldSpecialQuotaCapacity:
-		v, ok := value.(int)
+	case calculation.FieldStage:
+		v, ok := value.(core.AdmissionStage)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddSpecialQuotaCapacity(v)
+		m.AddStage(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Heading numeric field %s", name)
+	return fmt.Errorf("unknown Calculation numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *HeadingMutation) ClearedFields() []string {
-	return nil
+func (m *CalculationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(calculation.FieldStage) {
+		fields = append(fields, calculation.FieldStage)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *HeadingMutation) FieldCleared(name string) bool {
+func (m *CalculationMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *HeadingMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Heading nullable field %s", name)
-}
+func (m *CalculationMutation) ClearField(name string) error {
+	switch name {
+	case calculation.FieldStage:
+		m.ClearStage()
+		return nil
+	}
+	return fmt.Errorf("unknown Calculation nullable field %s", name)
+}
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *HeadingMutation) ResetField(name string) error {
+func (m *CalculationMutation) ResetField(name string) error {
 	switch name {
-	case heading.FieldRegularCapacity:
-		m.ResetRegularCapacity()
+	case calculation.FieldStudentID:
+		m.ResetStudentID()
 		return nil
-	case heading.FieldTargetQuotaCapacity:
-		m.ResetTargetQuotaCapacity()
+	case calculation.FieldAdmittedPlace:
+		m.ResetAdmittedPlace()
 		return nil
-	case heading.FieldDedicatedQuotaCapacity:
-		m.ResetDedicatedQuotaCapacity()
+	case calculation.FieldStage:
+		m.ResetStage()
 		return nil
-	case heading.FieldSpecialQuotaCapacity:
-		m.ResetSpecialQuotaCapacity()
+	case calculation.FieldPaid:
+		m.ResetPaid()
 		return nil
-	case heading.FieldCode:
-		m.ResetCode()
+	case calculation.FieldRunID:
+		m.ResetRunID()
 		return nil
-	case heading.FieldName:
-		m.ResetName()
+	case calculation.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Heading field %s", name)
+	return fmt.Errorf("unknown Calculation field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *HeadingMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.varsity != nil {
-		edges = append(edges, heading.EdgeVarsity)
-	}
-	if m.applications != nil {
-		edges = append(edges, heading.EdgeApplications)
-	}
-	if m.calculations != nil {
-		edges = append(edges, heading.EdgeCalculations)
+func (m *CalculationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.heading != nil {
+		edges = append(edges, calculation.EdgeHeading)
 	}
-	if m.drained_results != nil {
-		edges = append(edges, heading.EdgeDrainedResults)
+	if m.run != nil {
+		edges = append(edges, calculation.EdgeRun)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *HeadingMutation) AddedIDs(name string) []ent.Value {
+func (m *CalculationMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case heading.EdgeVarsity:
-		if id := m.varsity; id != nil {
+	case calculation.EdgeHeading:
+		if id := m.heading; id != nil {
 			return []ent.Value{*id}
 		}
-	case heading.EdgeApplications:
-		ids := make([]ent.Value, 0, len(m.applications))
-		for id := range m.applications {
-			ids = append(ids, id)
-		}
-		return ids
-	case heading.EdgeCalculations:
-		ids := make([]ent.Value, 0, len(m.calculations))
-		for id := range m.calculations {
-			ids = append(ids, id)
-		}
-		return ids
-	case heading.EdgeDrainedResults:
-		ids := make([]ent.Value, 0, len(m.drained_results))
-		for id := range m.drained_results {
-			ids = append(ids, id)
+	case calculation.EdgeRun:
+		if id := m.run; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *HeadingMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.removedapplications != nil {
-		edges = append(edges, heading.EdgeApplications)
-	}
-	if m.removedcalculations != nil {
-		edges = append(edges, heading.EdgeCalculations)
-	}
-	if m.removeddrained_results != nil {
-		edges = append(edges, heading.EdgeDrainedResults)
-	}
+func (m *CalculationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *HeadingMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case heading.EdgeApplications:
-		ids := make([]ent.Value, 0, len(m.removedapplications))
-		for id := range m.removedapplications {
-			ids = append(ids, id)
-		}
-		return ids
-	case heading.EdgeCalculations:
-		ids := make([]ent.Value, 0, len(m.removedcalculations))
-		for id := range m.removedcalculations {
-			ids = append(ids, id)
-		}
-		return ids
-	case heading.EdgeDrainedResults:
-		ids := make([]ent.Value, 0, len(m.removeddrained_results))
-		for id := range m.removeddrained_results {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *CalculationMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *HeadingMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.clearedvarsity {
-		edges = append(edges, heading.EdgeVarsity)
-	}
-	if m.clearedapplications {
-		edges = append(edges, heading.EdgeApplications)
-	}
-	if m.clearedcalculations {
-		edges = append(edges, heading.EdgeCalculations)
+func (m *CalculationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedheading {
+		edges = append(edges, calculation.EdgeHeading)
 	}
-	if m.cleareddrained_results {
-		edges = append(edges, heading.EdgeDrainedResults)
+	if m.clearedrun {
+		edges = append(edges, calculation.EdgeRun)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *HeadingMutation) EdgeCleared(name string) bool {
+func (m *CalculationMutation) EdgeCleared(name string) bool {
 	switch name {
-	case heading.EdgeVarsity:
-		return m.clearedvarsity
-	case heading.EdgeApplications:
-		return m.clearedapplications
-	case heading.EdgeCalculations:
-		return m.clearedcalculations
-	case heading.EdgeDrainedResults:
-		return m.cleareddrained_results
+	case calculation.EdgeHeading:
+		return m.clearedheading
+	case calculation.EdgeRun:
+		return m.clearedrun
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *HeadingMutation) ClearEdge(name string) error {
+func (m *CalculationMutation) ClearEdge(name string) error {
 	switch name {
-	case heading.EdgeVarsity:
-		m.ClearVarsity()
+	case calculation.EdgeHeading:
+		m.ClearHeading()
+		return nil
+	case calculation.EdgeRun:
+		m.ClearRun()
 		return nil
 	}
-	return fmt.Errorf("unknown Heading unique edge %s", name)
+	return fmt.Errorf("unknown Calculation unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *HeadingMutation) ResetEdge(name string) error {
+func (m *CalculationMutation) ResetEdge(name string) error {
 	switch name {
-	case heading.EdgeVarsity:
-		m.ResetVarsity()
-		return nil
-	case heading.EdgeApplications:
-		m.ResetApplications()
-		return nil
-	case heading.EdgeCalculations:
-		m.ResetCalculations()
+	case calculation.EdgeHeading:
+		m.ResetHeading()
 		return nil
-	case heading.EdgeDrainedResults:
-		m.ResetDrainedResults()
+	case calculation.EdgeRun:
+		m.ResetRun()
 		return nil
 	}
-	return fmt.Errorf("unknown Heading edge %s", name)
+	return fmt.Errorf("unknown Calculation edge %s", name)
 }
 
-// RunMutation represents an operation that mutates the Run nodes in the graph.
-type RunMutation struct {
+// CapacityDriftMutation represents an operation that mutates the CapacityDrift nodes in the graph.
+type CapacityDriftMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	triggered_at  *time.Time
-	payload_meta  *map[string]interface{}
-	finished      *bool
-	finished_at   *time.Time
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*Run, error)
-	predicates    []predicate.Run
-}
-
-var _ ent.Mutation = (*RunMutation)(nil)
-
-// runOption allows management of the mutation configuration using functional options.
-type runOption func(*RunMutation)
-
-// newRunMutation creates new mutation for the Run entity.
-func newRunMutation(c config, op Op, opts ...runOption) *RunMutation {
-	m := &RunMutation{
+	op                           Op
+	typ                          string
+	id                           *int
+	varsity_code                 *string
+	heading_code                 *string
+	name                         *string
+	registry_regular             *int
+	addregistry_regular          *int
+	registry_target_quota        *int
+	addregistry_target_quota     *int
+	registry_dedicated_quota     *int
+	addregistry_dedicated_quota  *int
+	registry_special_quota       *int
+	addregistry_special_quota    *int
+	registry_target_sub_quotas   *map[string]int
+	published_regular            *int
+	addpublished_regular         *int
+	published_target_quota       *int
+	addpublished_target_quota    *int
+	published_dedicated_quota    *int
+	addpublished_dedicated_quota *int
+	published_special_quota      *int
+	addpublished_special_quota   *int
+	published_target_sub_quotas  *map[string]int
+	total_only                   *bool
+	drifted                      *bool
+	applied                      *bool
+	clearedFields                map[string]struct{}
+	run                          *int
+	clearedrun                   bool
+	done                         bool
+	oldValue                     func(context.Context) (*CapacityDrift, error)
+	predicates                   []predicate.CapacityDrift
+}
+
+var _ ent.Mutation = (*CapacityDriftMutation)(nil)
+
+// capacitydriftOption allows management of the mutation configuration using functional options.
+type capacitydriftOption func(*CapacityDriftMutation)
+
+// newCapacityDriftMutation creates new mutation for the CapacityDrift entity.
+func newCapacityDriftMutation(c config, op Op, opts ...capacitydriftOption) *CapacityDriftMutation {
+	m := &CapacityDriftMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeRun,
+		typ:           TypeCapacityDrift,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4120,20 +4053,20 @@ func newRunMutation(c config, op Op, opts ...runOption) *RunMutation {
 	return m
 }
 
-// withRunID sets the ID field of the mutation.
-func withRunID(id int) runOption {
-	return func(m *RunMutation) {
+// withCapacityDriftID sets the ID field of the mutation.
+func withCapacityDriftID(id int) capacitydriftOption {
+	return func(m *CapacityDriftMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Run
+			value *CapacityDrift
 		)
-		m.oldValue = func(ctx context.Context) (*Run, error) {
+		m.oldValue = func(ctx context.Context) (*CapacityDrift, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Run.Get(ctx, id)
+					value, err = m.Client().CapacityDrift.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4142,10 +4075,10 @@ func withRunID(id int) runOption {
 	}
 }
 
-// withRun sets the old Run of the mutation.
-func withRun(node *Run) runOption {
-	return func(m *RunMutation) {
-		m.oldValue = func(context.Context) (*Run, error) {
+// withCapacityDrift sets the old CapacityDrift of the mutation.
+func withCapacityDrift(node *CapacityDrift) capacitydriftOption {
+	return func(m *CapacityDriftMutation) {
+		m.oldValue = func(context.Context) (*CapacityDrift, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4154,7 +4087,7 @@ func withRun(node *Run) runOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m RunMutation) Client() *Client {
+func (m CapacityDriftMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4162,7 +4095,7 @@ func (m RunMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m RunMutation) Tx() (*Tx, error) {
+func (m CapacityDriftMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4173,7 +4106,7 @@ func (m RunMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *RunMutation) ID() (id int, exists bool) {
+func (m *CapacityDriftMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4184,7 +4117,7 @@ func (m *RunMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *RunMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *CapacityDriftMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4193,191 +4126,7736 @@ func (m *RunMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Run.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().CapacityDrift.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetTriggeredAt sets the "triggered_at" field.
-func (m *RunMutation) SetTriggeredAt(t time.Time) {
-	m.triggered_at = &t
+// SetVarsityCode sets the "varsity_code" field.
+func (m *CapacityDriftMutation) SetVarsityCode(s string) {
+	m.varsity_code = &s
+}
+
+// VarsityCode returns the value of the "varsity_code" field in the mutation.
+func (m *CapacityDriftMutation) VarsityCode() (r string, exists bool) {
+	v := m.varsity_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVarsityCode returns the old "varsity_code" field's value of the CapacityDrift entity.
+// If the CapacityDrift object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CapacityDriftMutation) OldVarsityCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVarsityCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVarsityCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVarsityCode: %w", err)
+	}
+	return oldValue.VarsityCode, nil
+}
+
+// ResetVarsityCode resets all changes to the "varsity_code" field.
+func (m *CapacityDriftMutation) ResetVarsityCode() {
+	m.varsity_code = nil
+}
+
+// SetHeadingCode sets the "heading_code" field.
+func (m *CapacityDriftMutation) SetHeadingCode(s string) {
+	m.heading_code = &s
+}
+
+// HeadingCode returns the value of the "heading_code" field in the mutation.
+func (m *CapacityDriftMutation) HeadingCode() (r string, exists bool) {
+	v := m.heading_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHeadingCode returns the old "heading_code" field's value of the CapacityDrift entity.
+// If the CapacityDrift object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CapacityDriftMutation) OldHeadingCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeadingCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeadingCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeadingCode: %w", err)
+	}
+	return oldValue.HeadingCode, nil
+}
+
+// ResetHeadingCode resets all changes to the "heading_code" field.
+func (m *CapacityDriftMutation) ResetHeadingCode() {
+	m.heading_code = nil
+}
+
+// SetName sets the "name" field.
+func (m *CapacityDriftMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *CapacityDriftMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the CapacityDrift entity.
+// If the CapacityDrift object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CapacityDriftMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ClearName clears the value of the "name" field.
+func (m *CapacityDriftMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[capacitydrift.FieldName] = struct{}{}
+}
+
+// NameCleared returns if the "name" field was cleared in this mutation.
+func (m *CapacityDriftMutation) NameCleared() bool {
+	_, ok := m.clearedFields[capacitydrift.FieldName]
+	return ok
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *CapacityDriftMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, capacitydrift.FieldName)
+}
+
+// SetRegistryRegular sets the "registry_regular" field.
+func (m *CapacityDriftMutation) SetRegistryRegular(i int) {
+	m.registry_regular = &i
+	m.addregistry_regular = nil
+}
+
+// RegistryRegular returns the value of the "registry_regular" field in the mutation.
+func (m *CapacityDriftMutation) RegistryRegular() (r int, exists bool) {
+	v := m.registry_regular
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRegistryRegular returns the old "registry_regular" field's value of the CapacityDrift entity.
+// If the CapacityDrift object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CapacityDriftMutation) OldRegistryRegular(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRegistryRegular is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRegistryRegular requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRegistryRegular: %w", err)
+	}
+	return oldValue.RegistryRegular, nil
+}
+
+// AddRegistryRegular adds i to the "registry_regular" field.
+func (m *CapacityDriftMutation) AddRegistryRegular(i int) {
+	if m.addregistry_regular != nil {
+		*m.addregistry_regular += i
+	} else {
+		m.addregistry_regular = &i
+	}
+}
+
+// AddedRegistryRegular returns the value that was added to the "registry_regular" field in this mutation.
+func (m *CapacityDriftMutation) AddedRegistryRegular() (r int, exists bool) {
+	v := m.addregistry_regular
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRegistryRegular resets all changes to the "registry_regular" field.
+func (m *CapacityDriftMutation) ResetRegistryRegular() {
+	m.registry_regular = nil
+	m.addregistry_regular = nil
+}
+
+// SetRegistryTargetQuota sets the "registry_target_quota" field.
+func (m *CapacityDriftMutation) SetRegistryTargetQuota(i int) {
+	m.registry_target_quota = &i
+	m.addregistry_target_quota = nil
+}
+
+// RegistryTargetQuota returns the value of the "registry_target_quota" field in the mutation.
+func (m *CapacityDriftMutation) RegistryTargetQuota() (r int, exists bool) {
+	v := m.registry_target_quota
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRegistryTargetQuota returns the old "registry_target_quota" field's value of the CapacityDrift entity.
+// If the CapacityDrift object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CapacityDriftMutation) OldRegistryTargetQuota(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRegistryTargetQuota is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRegistryTargetQuota requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRegistryTargetQuota: %w", err)
+	}
+	return oldValue.RegistryTargetQuota, nil
+}
+
+// AddRegistryTargetQuota adds i to the "registry_target_quota" field.
+func (m *CapacityDriftMutation) AddRegistryTargetQuota(i int) {
+	if m.addregistry_target_quota != nil {
+		*m.addregistry_target_quota += i
+	} else {
+		m.addregistry_target_quota = &i
+	}
+}
+
+// AddedRegistryTargetQuota returns the value that was added to the "registry_target_quota" field in this mutation.
+func (m *CapacityDriftMutation) AddedRegistryTargetQuota() (r int, exists bool) {
+	v := m.addregistry_target_quota
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRegistryTargetQuota resets all changes to the "registry_target_quota" field.
+func (m *CapacityDriftMutation) ResetRegistryTargetQuota() {
+	m.registry_target_quota = nil
+	m.addregistry_target_quota = nil
+}
+
+// SetRegistryDedicatedQuota sets the "registry_dedicated_quota" field.
+func (m *CapacityDriftMutation) SetRegistryDedicatedQuota(i int) {
+	m.registry_dedicated_quota = &i
+	m.addregistry_dedicated_quota = nil
+}
+
+// RegistryDedicatedQuota returns the value of the "registry_dedicated_quota" field in the mutation.
+func (m *CapacityDriftMutation) RegistryDedicatedQuota() (r int, exists bool) {
+	v := m.registry_dedicated_quota
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRegistryDedicatedQuota returns the old "registry_dedicated_quota" field's value of the CapacityDrift entity.
+// If the CapacityDrift object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CapacityDriftMutation) OldRegistryDedicatedQuota(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRegistryDedicatedQuota is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRegistryDedicatedQuota requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRegistryDedicatedQuota: %w", err)
+	}
+	return oldValue.RegistryDedicatedQuota, nil
+}
+
+// AddRegistryDedicatedQuota adds i to the "registry_dedicated_quota" field.
+func (m *CapacityDriftMutation) AddRegistryDedicatedQuota(i int) {
+	if m.addregistry_dedicated_quota != nil {
+		*m.addregistry_dedicated_quota += i
+	} else {
+		m.addregistry_dedicated_quota = &i
+	}
+}
+
+// AddedRegistryDedicatedQuota returns the value that was added to the "registry_dedicated_quota" field in this mutation.
+func (m *CapacityDriftMutation) AddedRegistryDedicatedQuota() (r int, exists bool) {
+	v := m.addregistry_dedicated_quota
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRegistryDedicatedQuota resets all changes to the "registry_dedicated_quota" field.
+func (m *CapacityDriftMutation) ResetRegistryDedicatedQuota() {
+	m.registry_dedicated_quota = nil
+	m.addregistry_dedicated_quota = nil
+}
+
+// SetRegistrySpecialQuota sets the "registry_special_quota" field.
+func (m *CapacityDriftMutation) SetRegistrySpecialQuota(i int) {
+	m.registry_special_quota = &i
+	m.addregistry_special_quota = nil
+}
+
+// RegistrySpecialQuota returns the value of the "registry_special_quota" field in the mutation.
+func (m *CapacityDriftMutation) RegistrySpecialQuota() (r int, exists bool) {
+	v := m.registry_special_quota
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRegistrySpecialQuota returns the old "registry_special_quota" field's value of the CapacityDrift entity.
+// If the CapacityDrift object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CapacityDriftMutation) OldRegistrySpecialQuota(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRegistrySpecialQuota is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRegistrySpecialQuota requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRegistrySpecialQuota: %w", err)
+	}
+	return oldValue.RegistrySpecialQuota, nil
+}
+
+// AddRegistrySpecialQuota adds i to the "registry_special_quota" field.
+func (m *CapacityDriftMutation) AddRegistrySpecialQuota(i int) {
+	if m.addregistry_special_quota != nil {
+		*m.addregistry_special_quota += i
+	} else {
+		m.addregistry_special_quota = &i
+	}
+}
+
+// AddedRegistrySpecialQuota returns the value that was added to the "registry_special_quota" field in this mutation.
+func (m *CapacityDriftMutation) AddedRegistrySpecialQuota() (r int, exists bool) {
+	v := m.addregistry_special_quota
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRegistrySpecialQuota resets all changes to the "registry_special_quota" field.
+func (m *CapacityDriftMutation) ResetRegistrySpecialQuota() {
+	m.registry_special_quota = nil
+	m.addregistry_special_quota = nil
+}
+
+// SetRegistryTargetSubQuotas sets the "registry_target_sub_quotas" field.
+func (m *CapacityDriftMutation) SetRegistryTargetSubQuotas(value map[string]int) {
+	m.registry_target_sub_quotas = &value
+}
+
+// RegistryTargetSubQuotas returns the value of the "registry_target_sub_quotas" field in the mutation.
+func (m *CapacityDriftMutation) RegistryTargetSubQuotas() (r map[string]int, exists bool) {
+	v := m.registry_target_sub_quotas
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRegistryTargetSubQuotas returns the old "registry_target_sub_quotas" field's value of the CapacityDrift entity.
+// If the CapacityDrift object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CapacityDriftMutation) OldRegistryTargetSubQuotas(ctx context.Context) (v map[string]int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRegistryTargetSubQuotas is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRegistryTargetSubQuotas requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRegistryTargetSubQuotas: %w", err)
+	}
+	return oldValue.RegistryTargetSubQuotas, nil
+}
+
+// ClearRegistryTargetSubQuotas clears the value of the "registry_target_sub_quotas" field.
+func (m *CapacityDriftMutation) ClearRegistryTargetSubQuotas() {
+	m.registry_target_sub_quotas = nil
+	m.clearedFields[capacitydrift.FieldRegistryTargetSubQuotas] = struct{}{}
+}
+
+// RegistryTargetSubQuotasCleared returns if the "registry_target_sub_quotas" field was cleared in this mutation.
+func (m *CapacityDriftMutation) RegistryTargetSubQuotasCleared() bool {
+	_, ok := m.clearedFields[capacitydrift.FieldRegistryTargetSubQuotas]
+	return ok
+}
+
+// ResetRegistryTargetSubQuotas resets all changes to the "registry_target_sub_quotas" field.
+func (m *CapacityDriftMutation) ResetRegistryTargetSubQuotas() {
+	m.registry_target_sub_quotas = nil
+	delete(m.clearedFields, capacitydrift.FieldRegistryTargetSubQuotas)
+}
+
+// SetPublishedRegular sets the "published_regular" field.
+func (m *CapacityDriftMutation) SetPublishedRegular(i int) {
+	m.published_regular = &i
+	m.addpublished_regular = nil
+}
+
+// PublishedRegular returns the value of the "published_regular" field in the mutation.
+func (m *CapacityDriftMutation) PublishedRegular() (r int, exists bool) {
+	v := m.published_regular
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublishedRegular returns the old "published_regular" field's value of the CapacityDrift entity.
+// If the CapacityDrift object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CapacityDriftMutation) OldPublishedRegular(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublishedRegular is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublishedRegular requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublishedRegular: %w", err)
+	}
+	return oldValue.PublishedRegular, nil
+}
+
+// AddPublishedRegular adds i to the "published_regular" field.
+func (m *CapacityDriftMutation) AddPublishedRegular(i int) {
+	if m.addpublished_regular != nil {
+		*m.addpublished_regular += i
+	} else {
+		m.addpublished_regular = &i
+	}
+}
+
+// AddedPublishedRegular returns the value that was added to the "published_regular" field in this mutation.
+func (m *CapacityDriftMutation) AddedPublishedRegular() (r int, exists bool) {
+	v := m.addpublished_regular
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPublishedRegular resets all changes to the "published_regular" field.
+func (m *CapacityDriftMutation) ResetPublishedRegular() {
+	m.published_regular = nil
+	m.addpublished_regular = nil
+}
+
+// SetPublishedTargetQuota sets the "published_target_quota" field.
+func (m *CapacityDriftMutation) SetPublishedTargetQuota(i int) {
+	m.published_target_quota = &i
+	m.addpublished_target_quota = nil
+}
+
+// PublishedTargetQuota returns the value of the "published_target_quota" field in the mutation.
+func (m *CapacityDriftMutation) PublishedTargetQuota() (r int, exists bool) {
+	v := m.published_target_quota
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublishedTargetQuota returns the old "published_target_quota" field's value of the CapacityDrift entity.
+// If the CapacityDrift object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CapacityDriftMutation) OldPublishedTargetQuota(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublishedTargetQuota is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublishedTargetQuota requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublishedTargetQuota: %w", err)
+	}
+	return oldValue.PublishedTargetQuota, nil
+}
+
+// AddPublishedTargetQuota adds i to the "published_target_quota" field.
+func (m *CapacityDriftMutation) AddPublishedTargetQuota(i int) {
+	if m.addpublished_target_quota != nil {
+		*m.addpublished_target_quota += i
+	} else {
+		m.addpublished_target_quota = &i
+	}
+}
+
+// AddedPublishedTargetQuota returns the value that was added to the "published_target_quota" field in this mutation.
+func (m *CapacityDriftMutation) AddedPublishedTargetQuota() (r int, exists bool) {
+	v := m.addpublished_target_quota
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPublishedTargetQuota resets all changes to the "published_target_quota" field.
+func (m *CapacityDriftMutation) ResetPublishedTargetQuota() {
+	m.published_target_quota = nil
+	m.addpublished_target_quota = nil
+}
+
+// SetPublishedDedicatedQuota sets the "published_dedicated_quota" field.
+func (m *CapacityDriftMutation) SetPublishedDedicatedQuota(i int) {
+	m.published_dedicated_quota = &i
+	m.addpublished_dedicated_quota = nil
+}
+
+// PublishedDedicatedQuota returns the value of the "published_dedicated_quota" field in the mutation.
+func (m *CapacityDriftMutation) PublishedDedicatedQuota() (r int, exists bool) {
+	v := m.published_dedicated_quota
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublishedDedicatedQuota returns the old "published_dedicated_quota" field's value of the CapacityDrift entity.
+// If the CapacityDrift object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CapacityDriftMutation) OldPublishedDedicatedQuota(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublishedDedicatedQuota is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublishedDedicatedQuota requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublishedDedicatedQuota: %w", err)
+	}
+	return oldValue.PublishedDedicatedQuota, nil
+}
+
+// AddPublishedDedicatedQuota adds i to the "published_dedicated_quota" field.
+func (m *CapacityDriftMutation) AddPublishedDedicatedQuota(i int) {
+	if m.addpublished_dedicated_quota != nil {
+		*m.addpublished_dedicated_quota += i
+	} else {
+		m.addpublished_dedicated_quota = &i
+	}
+}
+
+// AddedPublishedDedicatedQuota returns the value that was added to the "published_dedicated_quota" field in this mutation.
+func (m *CapacityDriftMutation) AddedPublishedDedicatedQuota() (r int, exists bool) {
+	v := m.addpublished_dedicated_quota
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPublishedDedicatedQuota resets all changes to the "published_dedicated_quota" field.
+func (m *CapacityDriftMutation) ResetPublishedDedicatedQuota() {
+	m.published_dedicated_quota = nil
+	m.addpublished_dedicated_quota = nil
+}
+
+// SetPublishedSpecialQuota sets the "published_special_quota" field.
+func (m *CapacityDriftMutation) SetPublishedSpecialQuota(i int) {
+	m.published_special_quota = &i
+	m.addpublished_special_quota = nil
+}
+
+// PublishedSpecialQuota returns the value of the "published_special_quota" field in the mutation.
+func (m *CapacityDriftMutation) PublishedSpecialQuota() (r int, exists bool) {
+	v := m.published_special_quota
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublishedSpecialQuota returns the old "published_special_quota" field's value of the CapacityDrift entity.
+// If the CapacityDrift object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CapacityDriftMutation) OldPublishedSpecialQuota(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublishedSpecialQuota is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublishedSpecialQuota requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublishedSpecialQuota: %w", err)
+	}
+	return oldValue.PublishedSpecialQuota, nil
+}
+
+// AddPublishedSpecialQuota adds i to the "published_special_quota" field.
+func (m *CapacityDriftMutation) AddPublishedSpecialQuota(i int) {
+	if m.addpublished_special_quota != nil {
+		*m.addpublished_special_quota += i
+	} else {
+		m.addpublished_special_quota = &i
+	}
+}
+
+// AddedPublishedSpecialQuota returns the value that was added to the "published_special_quota" field in this mutation.
+func (m *CapacityDriftMutation) AddedPublishedSpecialQuota() (r int, exists bool) {
+	v := m.addpublished_special_quota
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPublishedSpecialQuota resets all changes to the "published_special_quota" field.
+func (m *CapacityDriftMutation) ResetPublishedSpecialQuota() {
+	m.published_special_quota = nil
+	m.addpublished_special_quota = nil
+}
+
+// SetPublishedTargetSubQuotas sets the "published_target_sub_quotas" field.
+func (m *CapacityDriftMutation) SetPublishedTargetSubQuotas(value map[string]int) {
+	m.published_target_sub_quotas = &value
+}
+
+// PublishedTargetSubQuotas returns the value of the "published_target_sub_quotas" field in the mutation.
+func (m *CapacityDriftMutation) PublishedTargetSubQuotas() (r map[string]int, exists bool) {
+	v := m.published_target_sub_quotas
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublishedTargetSubQuotas returns the old "published_target_sub_quotas" field's value of the CapacityDrift entity.
+// If the CapacityDrift object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CapacityDriftMutation) OldPublishedTargetSubQuotas(ctx context.Context) (v map[string]int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublishedTargetSubQuotas is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublishedTargetSubQuotas requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublishedTargetSubQuotas: %w", err)
+	}
+	return oldValue.PublishedTargetSubQuotas, nil
+}
+
+// ClearPublishedTargetSubQuotas clears the value of the "published_target_sub_quotas" field.
+func (m *CapacityDriftMutation) ClearPublishedTargetSubQuotas() {
+	m.published_target_sub_quotas = nil
+	m.clearedFields[capacitydrift.FieldPublishedTargetSubQuotas] = struct{}{}
+}
+
+// PublishedTargetSubQuotasCleared returns if the "published_target_sub_quotas" field was cleared in this mutation.
+func (m *CapacityDriftMutation) PublishedTargetSubQuotasCleared() bool {
+	_, ok := m.clearedFields[capacitydrift.FieldPublishedTargetSubQuotas]
+	return ok
+}
+
+// ResetPublishedTargetSubQuotas resets all changes to the "published_target_sub_quotas" field.
+func (m *CapacityDriftMutation) ResetPublishedTargetSubQuotas() {
+	m.published_target_sub_quotas = nil
+	delete(m.clearedFields, capacitydrift.FieldPublishedTargetSubQuotas)
+}
+
+// SetTotalOnly sets the "total_only" field.
+func (m *CapacityDriftMutation) SetTotalOnly(b bool) {
+	m.total_only = &b
+}
+
+// TotalOnly returns the value of the "total_only" field in the mutation.
+func (m *CapacityDriftMutation) TotalOnly() (r bool, exists bool) {
+	v := m.total_only
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalOnly returns the old "total_only" field's value of the CapacityDrift entity.
+// If the CapacityDrift object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CapacityDriftMutation) OldTotalOnly(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalOnly is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalOnly requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalOnly: %w", err)
+	}
+	return oldValue.TotalOnly, nil
+}
+
+// ResetTotalOnly resets all changes to the "total_only" field.
+func (m *CapacityDriftMutation) ResetTotalOnly() {
+	m.total_only = nil
+}
+
+// SetDrifted sets the "drifted" field.
+func (m *CapacityDriftMutation) SetDrifted(b bool) {
+	m.drifted = &b
+}
+
+// Drifted returns the value of the "drifted" field in the mutation.
+func (m *CapacityDriftMutation) Drifted() (r bool, exists bool) {
+	v := m.drifted
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDrifted returns the old "drifted" field's value of the CapacityDrift entity.
+// If the CapacityDrift object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CapacityDriftMutation) OldDrifted(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDrifted is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDrifted requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDrifted: %w", err)
+	}
+	return oldValue.Drifted, nil
+}
+
+// ResetDrifted resets all changes to the "drifted" field.
+func (m *CapacityDriftMutation) ResetDrifted() {
+	m.drifted = nil
+}
+
+// SetApplied sets the "applied" field.
+func (m *CapacityDriftMutation) SetApplied(b bool) {
+	m.applied = &b
+}
+
+// Applied returns the value of the "applied" field in the mutation.
+func (m *CapacityDriftMutation) Applied() (r bool, exists bool) {
+	v := m.applied
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldApplied returns the old "applied" field's value of the CapacityDrift entity.
+// If the CapacityDrift object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CapacityDriftMutation) OldApplied(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldApplied is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldApplied requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldApplied: %w", err)
+	}
+	return oldValue.Applied, nil
+}
+
+// ResetApplied resets all changes to the "applied" field.
+func (m *CapacityDriftMutation) ResetApplied() {
+	m.applied = nil
+}
+
+// SetRunID sets the "run_id" field.
+func (m *CapacityDriftMutation) SetRunID(i int) {
+	m.run = &i
+}
+
+// RunID returns the value of the "run_id" field in the mutation.
+func (m *CapacityDriftMutation) RunID() (r int, exists bool) {
+	v := m.run
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRunID returns the old "run_id" field's value of the CapacityDrift entity.
+// If the CapacityDrift object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CapacityDriftMutation) OldRunID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRunID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRunID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRunID: %w", err)
+	}
+	return oldValue.RunID, nil
+}
+
+// ResetRunID resets all changes to the "run_id" field.
+func (m *CapacityDriftMutation) ResetRunID() {
+	m.run = nil
+}
+
+// ClearRun clears the "run" edge to the Run entity.
+func (m *CapacityDriftMutation) ClearRun() {
+	m.clearedrun = true
+	m.clearedFields[capacitydrift.FieldRunID] = struct{}{}
+}
+
+// RunCleared reports if the "run" edge to the Run entity was cleared.
+func (m *CapacityDriftMutation) RunCleared() bool {
+	return m.clearedrun
+}
+
+// RunIDs returns the "run" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RunID instead. It exists only for internal usage by the builders.
+func (m *CapacityDriftMutation) RunIDs() (ids []int) {
+	if id := m.run; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRun resets all changes to the "run" edge.
+func (m *CapacityDriftMutation) ResetRun() {
+	m.run = nil
+	m.clearedrun = false
+}
+
+// Where appends a list predicates to the CapacityDriftMutation builder.
+func (m *CapacityDriftMutation) Where(ps ...predicate.CapacityDrift) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CapacityDriftMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CapacityDriftMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CapacityDrift, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CapacityDriftMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CapacityDriftMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (CapacityDrift).
+func (m *CapacityDriftMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CapacityDriftMutation) Fields() []string {
+	fields := make([]string, 0, 17)
+	if m.varsity_code != nil {
+		fields = append(fields, capacitydrift.FieldVarsityCode)
+	}
+	if m.heading_code != nil {
+		fields = append(fields, capacitydrift.FieldHeadingCode)
+	}
+	if m.name != nil {
+		fields = append(fields, capacitydrift.FieldName)
+	}
+	if m.registry_regular != nil {
+		fields = append(fields, capacitydrift.FieldRegistryRegular)
+	}
+	if m.registry_target_quota != nil {
+		fields = append(fields, capacitydrift.FieldRegistryTargetQuota)
+	}
+	if m.registry_dedicated_quota != nil {
+		fields = append(fields, capacitydrift.FieldRegistryDedicatedQuota)
+	}
+	if m.registry_special_quota != nil {
+		fields = append(fields, capacitydrift.FieldRegistrySpecialQuota)
+	}
+	if m.registry_target_sub_quotas != nil {
+		fields = append(fields, capacitydrift.FieldRegistryTargetSubQuotas)
+	}
+	if m.published_regular != nil {
+		fields = append(fields, capacitydrift.FieldPublishedRegular)
+	}
+	if m.published_target_quota != nil {
+		fields = append(fields, capacitydrift.FieldPublishedTargetQuota)
+	}
+	if m.published_dedicated_quota != nil {
+		fields = append(fields, capacitydrift.FieldPublishedDedicatedQuota)
+	}
+	if m.published_special_quota != nil {
+		fields = append(fields, capacitydrift.FieldPublishedSpecialQuota)
+	}
+	if m.published_target_sub_quotas != nil {
+		fields = append(fields, capacitydrift.FieldPublishedTargetSubQuotas)
+	}
+	if m.total_only != nil {
+		fields = append(fields, capacitydrift.FieldTotalOnly)
+	}
+	if m.drifted != nil {
+		fields = append(fields, capacitydrift.FieldDrifted)
+	}
+	if m.applied != nil {
+		fields = append(fields, capacitydrift.FieldApplied)
+	}
+	if m.run != nil {
+		fields = append(fields, capacitydrift.FieldRunID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CapacityDriftMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case capacitydrift.FieldVarsityCode:
+		return m.VarsityCode()
+	case capacitydrift.FieldHeadingCode:
+		return m.HeadingCode()
+	case capacitydrift.FieldName:
+		return m.Name()
+	case capacitydrift.FieldRegistryRegular:
+		return m.RegistryRegular()
+	case capacitydrift.FieldRegistryTargetQuota:
+		return m.RegistryTargetQuota()
+	case capacitydrift.FieldRegistryDedicatedQuota:
+		return m.RegistryDedicatedQuota()
+	case capacitydrift.FieldRegistrySpecialQuota:
+		return m.RegistrySpecialQuota()
+	case capacitydrift.FieldRegistryTargetSubQuotas:
+		return m.RegistryTargetSubQuotas()
+	case capacitydrift.FieldPublishedRegular:
+		return m.PublishedRegular()
+	case capacitydrift.FieldPublishedTargetQuota:
+		return m.PublishedTargetQuota()
+	case capacitydrift.FieldPublishedDedicatedQuota:
+		return m.PublishedDedicatedQuota()
+	case capacitydrift.FieldPublishedSpecialQuota:
+		return m.PublishedSpecialQuota()
+	case capacitydrift.FieldPublishedTargetSubQuotas:
+		return m.PublishedTargetSubQuotas()
+	case capacitydrift.FieldTotalOnly:
+		return m.TotalOnly()
+	case capacitydrift.FieldDrifted:
+		return m.Drifted()
+	case capacitydrift.FieldApplied:
+		return m.Applied()
+	case capacitydrift.FieldRunID:
+		return m.RunID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CapacityDriftMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case capacitydrift.FieldVarsityCode:
+		return m.OldVarsityCode(ctx)
+	case capacitydrift.FieldHeadingCode:
+		return m.OldHeadingCode(ctx)
+	case capacitydrift.FieldName:
+		return m.OldName(ctx)
+	case capacitydrift.FieldRegistryRegular:
+		return m.OldRegistryRegular(ctx)
+	case capacitydrift.FieldRegistryTargetQuota:
+		return m.OldRegistryTargetQuota(ctx)
+	case capacitydrift.FieldRegistryDedicatedQuota:
+		return m.OldRegistryDedicatedQuota(ctx)
+	case capacitydrift.FieldRegistrySpecialQuota:
+		return m.OldRegistrySpecialQuota(ctx)
+	case capacitydrift.FieldRegistryTargetSubQuotas:
+		return m.OldRegistryTargetSubQuotas(ctx)
+	case capacitydrift.FieldPublishedRegular:
+		return m.OldPublishedRegular(ctx)
+	case capacitydrift.FieldPublishedTargetQuota:
+		return m.OldPublishedTargetQuota(ctx)
+	case capacitydrift.FieldPublishedDedicatedQuota:
+		return m.OldPublishedDedicatedQuota(ctx)
+	case capacitydrift.FieldPublishedSpecialQuota:
+		return m.OldPublishedSpecialQuota(ctx)
+	case capacitydrift.FieldPublishedTargetSubQuotas:
+		return m.OldPublishedTargetSubQuotas(ctx)
+	case capacitydrift.FieldTotalOnly:
+		return m.OldTotalOnly(ctx)
+	case capacitydrift.FieldDrifted:
+		return m.OldDrifted(ctx)
+	case capacitydrift.FieldApplied:
+		return m.OldApplied(ctx)
+	case capacitydrift.FieldRunID:
+		return m.OldRunID(ctx)
+	}
+	return nil, fmt.Errorf("unknown CapacityDrift field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CapacityDriftMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case capacitydrift.FieldVarsityCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVarsityCode(v)
+		return nil
+	case capacitydrift.FieldHeadingCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeadingCode(v)
+		return nil
+	case capacitydrift.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case capacitydrift.FieldRegistryRegular:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRegistryRegular(v)
+		return nil
+	case capacitydrift.FieldRegistryTargetQuota:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRegistryTargetQuota(v)
+		return nil
+	case capacitydrift.FieldRegistryDedicatedQuota:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRegistryDedicatedQuota(v)
+		return nil
+	case capacitydrift.FieldRegistrySpecialQuota:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRegistrySpecialQuota(v)
+		return nil
+	case capacitydrift.FieldRegistryTargetSubQuotas:
+		v, ok := value.(map[string]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRegistryTargetSubQuotas(v)
+		return nil
+	case capacitydrift.FieldPublishedRegular:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublishedRegular(v)
+		return nil
+	case capacitydrift.FieldPublishedTargetQuota:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublishedTargetQuota(v)
+		return nil
+	case capacitydrift.FieldPublishedDedicatedQuota:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublishedDedicatedQuota(v)
+		return nil
+	case capacitydrift.FieldPublishedSpecialQuota:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublishedSpecialQuota(v)
+		return nil
+	case capacitydrift.FieldPublishedTargetSubQuotas:
+		v, ok := value.(map[string]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublishedTargetSubQuotas(v)
+		return nil
+	case capacitydrift.FieldTotalOnly:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalOnly(v)
+		return nil
+	case capacitydrift.FieldDrifted:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDrifted(v)
+		return nil
+	case capacitydrift.FieldApplied:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetApplied(v)
+		return nil
+	case capacitydrift.FieldRunID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRunID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CapacityDrift field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CapacityDriftMutation) AddedFields() []string {
+	var fields []string
+	if m.addregistry_regular != nil {
+		fields = append(fields, capacitydrift.FieldRegistryRegular)
+	}
+	if m.addregistry_target_quota != nil {
+		fields = append(fields, capacitydrift.FieldRegistryTargetQuota)
+	}
+	if m.addregistry_dedicated_quota != nil {
+		fields = append(fields, capacitydrift.FieldRegistryDedicatedQuota)
+	}
+	if m.addregistry_special_quota != nil {
+		fields = append(fields, capacitydrift.FieldRegistrySpecialQuota)
+	}
+	if m.addpublished_regular != nil {
+		fields = append(fields, capacitydrift.FieldPublishedRegular)
+	}
+	if m.addpublished_target_quota != nil {
+		fields = append(fields, capacitydrift.FieldPublishedTargetQuota)
+	}
+	if m.addpublished_dedicated_quota != nil {
+		fields = append(fields, capacitydrift.FieldPublishedDedicatedQuota)
+	}
+	if m.addpublished_special_quota != nil {
+		fields = append(fields, capacitydrift.FieldPublishedSpecialQuota)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CapacityDriftMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case capacitydrift.FieldRegistryRegular:
+		return m.AddedRegistryRegular()
+	case capacitydrift.FieldRegistryTargetQuota:
+		return m.AddedRegistryTargetQuota()
+	case capacitydrift.FieldRegistryDedicatedQuota:
+		return m.AddedRegistryDedicatedQuota()
+	case capacitydrift.FieldRegistrySpecialQuota:
+		return m.AddedRegistrySpecialQuota()
+	case capacitydrift.FieldPublishedRegular:
+		return m.AddedPublishedRegular()
+	case capacitydrift.FieldPublishedTargetQuota:
+		return m.AddedPublishedTargetQuota()
+	case capacitydrift.FieldPublishedDedicatedQuota:
+		return m.AddedPublishedDedicatedQuota()
+	case capacitydrift.FieldPublishedSpecialQuota:
+		return m.AddedPublishedSpecialQuota()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CapacityDriftMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case capacitydrift.FieldRegistryRegular:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRegistryRegular(v)
+		return nil
+	case capacitydrift.FieldRegistryTargetQuota:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRegistryTargetQuota(v)
+		return nil
+	case capacitydrift.FieldRegistryDedicatedQuota:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRegistryDedicatedQuota(v)
+		return nil
+	case capacitydrift.FieldRegistrySpecialQuota:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRegistrySpecialQuota(v)
+		return nil
+	case capacitydrift.FieldPublishedRegular:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPublishedRegular(v)
+		return nil
+	case capacitydrift.FieldPublishedTargetQuota:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPublishedTargetQuota(v)
+		return nil
+	case capacitydrift.FieldPublishedDedicatedQuota:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPublishedDedicatedQuota(v)
+		return nil
+	case capacitydrift.FieldPublishedSpecialQuota:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPublishedSpecialQuota(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CapacityDrift numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CapacityDriftMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(capacitydrift.FieldName) {
+		fields = append(fields, capacitydrift.FieldName)
+	}
+	if m.FieldCleared(capacitydrift.FieldRegistryTargetSubQuotas) {
+		fields = append(fields, capacitydrift.FieldRegistryTargetSubQuotas)
+	}
+	if m.FieldCleared(capacitydrift.FieldPublishedTargetSubQuotas) {
+		fields = append(fields, capacitydrift.FieldPublishedTargetSubQuotas)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CapacityDriftMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CapacityDriftMutation) ClearField(name string) error {
+	switch name {
+	case capacitydrift.FieldName:
+		m.ClearName()
+		return nil
+	case capacitydrift.FieldRegistryTargetSubQuotas:
+		m.ClearRegistryTargetSubQuotas()
+		return nil
+	case capacitydrift.FieldPublishedTargetSubQuotas:
+		m.ClearPublishedTargetSubQuotas()
+		return nil
+	}
+	return fmt.Errorf("unknown CapacityDrift nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CapacityDriftMutation) ResetField(name string) error {
+	switch name {
+	case capacitydrift.FieldVarsityCode:
+		m.ResetVarsityCode()
+		return nil
+	case capacitydrift.FieldHeadingCode:
+		m.ResetHeadingCode()
+		return nil
+	case capacitydrift.FieldName:
+		m.ResetName()
+		return nil
+	case capacitydrift.FieldRegistryRegular:
+		m.ResetRegistryRegular()
+		return nil
+	case capacitydrift.FieldRegistryTargetQuota:
+		m.ResetRegistryTargetQuota()
+		return nil
+	case capacitydrift.FieldRegistryDedicatedQuota:
+		m.ResetRegistryDedicatedQuota()
+		return nil
+	case capacitydrift.FieldRegistrySpecialQuota:
+		m.ResetRegistrySpecialQuota()
+		return nil
+	case capacitydrift.FieldRegistryTargetSubQuotas:
+		m.ResetRegistryTargetSubQuotas()
+		return nil
+	case capacitydrift.FieldPublishedRegular:
+		m.ResetPublishedRegular()
+		return nil
+	case capacitydrift.FieldPublishedTargetQuota:
+		m.ResetPublishedTargetQuota()
+		return nil
+	case capacitydrift.FieldPublishedDedicatedQuota:
+		m.ResetPublishedDedicatedQuota()
+		return nil
+	case capacitydrift.FieldPublishedSpecialQuota:
+		m.ResetPublishedSpecialQuota()
+		return nil
+	case capacitydrift.FieldPublishedTargetSubQuotas:
+		m.ResetPublishedTargetSubQuotas()
+		return nil
+	case capacitydrift.FieldTotalOnly:
+		m.ResetTotalOnly()
+		return nil
+	case capacitydrift.FieldDrifted:
+		m.ResetDrifted()
+		return nil
+	case capacitydrift.FieldApplied:
+		m.ResetApplied()
+		return nil
+	case capacitydrift.FieldRunID:
+		m.ResetRunID()
+		return nil
+	}
+	return fmt.Errorf("unknown CapacityDrift field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CapacityDriftMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.run != nil {
+		edges = append(edges, capacitydrift.EdgeRun)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CapacityDriftMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case capacitydrift.EdgeRun:
+		if id := m.run; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CapacityDriftMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CapacityDriftMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CapacityDriftMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedrun {
+		edges = append(edges, capacitydrift.EdgeRun)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CapacityDriftMutation) EdgeCleared(name string) bool {
+	switch name {
+	case capacitydrift.EdgeRun:
+		return m.clearedrun
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CapacityDriftMutation) ClearEdge(name string) error {
+	switch name {
+	case capacitydrift.EdgeRun:
+		m.ClearRun()
+		return nil
+	}
+	return fmt.Errorf("unknown CapacityDrift unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CapacityDriftMutation) ResetEdge(name string) error {
+	switch name {
+	case capacitydrift.EdgeRun:
+		m.ResetRun()
+		return nil
+	}
+	return fmt.Errorf("unknown CapacityDrift edge %s", name)
+}
+
+// DrainedResultMutation represents an operation that mutates the DrainedResult nodes in the graph.
+type DrainedResultMutation struct {
+	config
+	op                                     Op
+	typ                                    string
+	id                                     *int
+	drained_percent                        *int
+	adddrained_percent                     *int
+	avg_passing_score                      *int
+	addavg_passing_score                   *int
+	min_passing_score                      *int
+	addmin_passing_score                   *int
+	max_passing_score                      *int
+	addmax_passing_score                   *int
+	med_passing_score                      *int
+	addmed_passing_score                   *int
+	avg_last_admitted_rating_place         *int
+	addavg_last_admitted_rating_place      *int
+	min_last_admitted_rating_place         *int
+	addmin_last_admitted_rating_place      *int
+	max_last_admitted_rating_place         *int
+	addmax_last_admitted_rating_place      *int
+	med_last_admitted_rating_place         *int
+	addmed_last_admitted_rating_place      *int
+	regulars_admitted                      *bool
+	is_virtual                             *bool
+	seed                                   *int64
+	addseed                                *int64
+	drain_model                            *string
+	avg_priority_stage_admitted            *int
+	addavg_priority_stage_admitted         *int
+	avg_main_stage_admitted                *int
+	addavg_main_stage_admitted             *int
+	sub_quotas                             *[]core.SubQuotaResultDTO
+	appendsub_quotas                       []core.SubQuotaResultDTO
+	avg_paid_passing_score                 *int
+	addavg_paid_passing_score              *int
+	avg_paid_last_admitted_rating_place    *int
+	addavg_paid_last_admitted_rating_place *int
+	p10_passing_score                      *int
+	addp10_passing_score                   *int
+	p25_passing_score                      *int
+	addp25_passing_score                   *int
+	p75_passing_score                      *int
+	addp75_passing_score                   *int
+	p90_passing_score                      *int
+	addp90_passing_score                   *int
+	p10_last_admitted_rating_place         *int
+	addp10_last_admitted_rating_place      *int
+	p25_last_admitted_rating_place         *int
+	addp25_last_admitted_rating_place      *int
+	p75_last_admitted_rating_place         *int
+	addp75_last_admitted_rating_place      *int
+	p90_last_admitted_rating_place         *int
+	addp90_last_admitted_rating_place      *int
+	passing_score_histogram                **core.HistogramDTO
+	last_admitted_rating_place_histogram   **core.HistogramDTO
+	iterations                             *int
+	additerations                          *int
+	clearedFields                          map[string]struct{}
+	heading                                *int
+	clearedheading                         bool
+	run                                    *int
+	clearedrun                             bool
+	done                                   bool
+	oldValue                               func(context.Context) (*DrainedResult, error)
+	predicates                             []predicate.DrainedResult
+}
+
+var _ ent.Mutation = (*DrainedResultMutation)(nil)
+
+// drainedresultOption allows management of the mutation configuration using functional options.
+type drainedresultOption func(*DrainedResultMutation)
+
+// newDrainedResultMutation creates new mutation for the DrainedResult entity.
+func newDrainedResultMutation(c config, op Op, opts ...drainedresultOption) *DrainedResultMutation {
+	m := &DrainedResultMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDrainedResult,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDrainedResultID sets the ID field of the mutation.
+func withDrainedResultID(id int) drainedresultOption {
+	return func(m *DrainedResultMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DrainedResult
+		)
+		m.oldValue = func(ctx context.Context) (*DrainedResult, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DrainedResult.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDrainedResult sets the old DrainedResult of the mutation.
+func withDrainedResult(node *DrainedResult) drainedresultOption {
+	return func(m *DrainedResultMutation) {
+		m.oldValue = func(context.Context) (*DrainedResult, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DrainedResultMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DrainedResultMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DrainedResultMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DrainedResultMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DrainedResult.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetDrainedPercent sets the "drained_percent" field.
+func (m *DrainedResultMutation) SetDrainedPercent(i int) {
+	m.drained_percent = &i
+	m.adddrained_percent = nil
+}
+
+// DrainedPercent returns the value of the "drained_percent" field in the mutation.
+func (m *DrainedResultMutation) DrainedPercent() (r int, exists bool) {
+	v := m.drained_percent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDrainedPercent returns the old "drained_percent" field's value of the DrainedResult entity.
+// If the DrainedResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DrainedResultMutation) OldDrainedPercent(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDrainedPercent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDrainedPercent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDrainedPercent: %w", err)
+	}
+	return oldValue.DrainedPercent, nil
+}
+
+// AddDrainedPercent adds i to the "drained_percent" field.
+func (m *DrainedResultMutation) AddDrainedPercent(i int) {
+	if m.adddrained_percent != nil {
+		*m.adddrained_percent += i
+	} else {
+		m.adddrained_percent = &i
+	}
+}
+
+// AddedDrainedPercent returns the value that was added to the "drained_percent" field in this mutation.
+func (m *DrainedResultMutation) AddedDrainedPercent() (r int, exists bool) {
+	v := m.adddrained_percent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDrainedPercent resets all changes to the "drained_percent" field.
+func (m *DrainedResultMutation) ResetDrainedPercent() {
+	m.drained_percent = nil
+	m.adddrained_percent = nil
+}
+
+// SetAvgPassingScore sets the "avg_passing_score" field.
+func (m *DrainedResultMutation) SetAvgPassingScore(i int) {
+	m.avg_passing_score = &i
+	m.addavg_passing_score = nil
+}
+
+// AvgPassingScore returns the value of the "avg_passing_score" field in the mutation.
+func (m *DrainedResultMutation) AvgPassingScore() (r int, exists bool) {
+	v := m.avg_passing_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAvgPassingScore returns the old "avg_passing_score" field's value of the DrainedResult entity.
+// If the DrainedResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DrainedResultMutation) OldAvgPassingScore(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAvgPassingScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAvgPassingScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAvgPassingScore: %w", err)
+	}
+	return oldValue.AvgPassingScore, nil
+}
+
+// AddAvgPassingScore adds i to the "avg_passing_score" field.
+func (m *DrainedResultMutation) AddAvgPassingScore(i int) {
+	if m.addavg_passing_score != nil {
+		*m.addavg_passing_score += i
+	} else {
+		m.addavg_passing_score = &i
+	}
+}
+
+// AddedAvgPassingScore returns the value that was added to the "avg_passing_score" field in this mutation.
+func (m *DrainedResultMutation) AddedAvgPassingScore() (r int, exists bool) {
+	v := m.addavg_passing_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAvgPassingScore resets all changes to the "avg_passing_score" field.
+func (m *DrainedResultMutation) ResetAvgPassingScore() {
+	m.avg_passing_score = nil
+	m.addavg_passing_score = nil
+}
+
+// SetMinPassingScore sets the "min_passing_score" field.
+func (m *DrainedResultMutation) SetMinPassingScore(i int) {
+	m.min_passing_score = &i
+	m.addmin_passing_score = nil
+}
+
+// MinPassingScore returns the value of the "min_passing_score" field in the mutation.
+func (m *DrainedResultMutation) MinPassingScore() (r int, exists bool) {
+	v := m.min_passing_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMinPassingScore returns the old "min_passing_score" field's value of the DrainedResult entity.
+// If the DrainedResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DrainedResultMutation) OldMinPassingScore(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMinPassingScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMinPassingScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMinPassingScore: %w", err)
+	}
+	return oldValue.MinPassingScore, nil
+}
+
+// AddMinPassingScore adds i to the "min_passing_score" field.
+func (m *DrainedResultMutation) AddMinPassingScore(i int) {
+	if m.addmin_passing_score != nil {
+		*m.addmin_passing_score += i
+	} else {
+		m.addmin_passing_score = &i
+	}
+}
+
+// AddedMinPassingScore returns the value that was added to the "min_passing_score" field in this mutation.
+func (m *DrainedResultMutation) AddedMinPassingScore() (r int, exists bool) {
+	v := m.addmin_passing_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMinPassingScore resets all changes to the "min_passing_score" field.
+func (m *DrainedResultMutation) ResetMinPassingScore() {
+	m.min_passing_score = nil
+	m.addmin_passing_score = nil
+}
+
+// SetMaxPassingScore sets the "max_passing_score" field.
+func (m *DrainedResultMutation) SetMaxPassingScore(i int) {
+	m.max_passing_score = &i
+	m.addmax_passing_score = nil
+}
+
+// MaxPassingScore returns the value of the "max_passing_score" field in the mutation.
+func (m *DrainedResultMutation) MaxPassingScore() (r int, exists bool) {
+	v := m.max_passing_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxPassingScore returns the old "max_passing_score" field's value of the DrainedResult entity.
+// If the DrainedResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DrainedResultMutation) OldMaxPassingScore(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxPassingScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxPassingScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxPassingScore: %w", err)
+	}
+	return oldValue.MaxPassingScore, nil
+}
+
+// AddMaxPassingScore adds i to the "max_passing_score" field.
+func (m *DrainedResultMutation) AddMaxPassingScore(i int) {
+	if m.addmax_passing_score != nil {
+		*m.addmax_passing_score += i
+	} else {
+		m.addmax_passing_score = &i
+	}
+}
+
+// AddedMaxPassingScore returns the value that was added to the "max_passing_score" field in this mutation.
+func (m *DrainedResultMutation) AddedMaxPassingScore() (r int, exists bool) {
+	v := m.addmax_passing_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxPassingScore resets all changes to the "max_passing_score" field.
+func (m *DrainedResultMutation) ResetMaxPassingScore() {
+	m.max_passing_score = nil
+	m.addmax_passing_score = nil
+}
+
+// SetMedPassingScore sets the "med_passing_score" field.
+func (m *DrainedResultMutation) SetMedPassingScore(i int) {
+	m.med_passing_score = &i
+	m.addmed_passing_score = nil
+}
+
+// MedPassingScore returns the value of the "med_passing_score" field in the mutation.
+func (m *DrainedResultMutation) MedPassingScore() (r int, exists bool) {
+	v := m.med_passing_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMedPassingScore returns the old "med_passing_score" field's value of the DrainedResult entity.
+// If the DrainedResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DrainedResultMutation) OldMedPassingScore(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMedPassingScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMedPassingScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMedPassingScore: %w", err)
+	}
+	return oldValue.MedPassingScore, nil
+}
+
+// AddMedPassingScore adds i to the "med_passing_score" field.
+func (m *DrainedResultMutation) AddMedPassingScore(i int) {
+	if m.addmed_passing_score != nil {
+		*m.addmed_passing_score += i
+	} else {
+		m.addmed_passing_score = &i
+	}
+}
+
+// AddedMedPassingScore returns the value that was added to the "med_passing_score" field in this mutation.
+func (m *DrainedResultMutation) AddedMedPassingScore() (r int, exists bool) {
+	v := m.addmed_passing_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMedPassingScore resets all changes to the "med_passing_score" field.
+func (m *DrainedResultMutation) ResetMedPassingScore() {
+	m.med_passing_score = nil
+	m.addmed_passing_score = nil
+}
+
+// SetAvgLastAdmittedRatingPlace sets the "avg_last_admitted_rating_place" field.
+func (m *DrainedResultMutation) SetAvgLastAdmittedRatingPlace(i int) {
+	m.avg_last_admitted_rating_place = &i
+	m.addavg_last_admitted_rating_place = nil
+}
+
+// AvgLastAdmittedRatingPlace returns the value of the "avg_last_admitted_rating_place" field in the mutation.
+func (m *DrainedResultMutation) AvgLastAdmittedRatingPlace() (r int, exists bool) {
+	v := m.avg_last_admitted_rating_place
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAvgLastAdmittedRatingPlace returns the old "avg_last_admitted_rating_place" field's value of the DrainedResult entity.
+// If the DrainedResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DrainedResultMutation) OldAvgLastAdmittedRatingPlace(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAvgLastAdmittedRatingPlace is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAvgLastAdmittedRatingPlace requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAvgLastAdmittedRatingPlace: %w", err)
+	}
+	return oldValue.AvgLastAdmittedRatingPlace, nil
+}
+
+// AddAvgLastAdmittedRatingPlace adds i to the "avg_last_admitted_rating_place" field.
+func (m *DrainedResultMutation) AddAvgLastAdmittedRatingPlace(i int) {
+	if m.addavg_last_admitted_rating_place != nil {
+		*m.addavg_last_admitted_rating_place += i
+	} else {
+		m.addavg_last_admitted_rating_place = &i
+	}
+}
+
+// AddedAvgLastAdmittedRatingPlace returns the value that was added to the "avg_last_admitted_rating_place" field in this mutation.
+func (m *DrainedResultMutation) AddedAvgLastAdmittedRatingPlace() (r int, exists bool) {
+	v := m.addavg_last_admitted_rating_place
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAvgLastAdmittedRatingPlace resets all changes to the "avg_last_admitted_rating_place" field.
+func (m *DrainedResultMutation) ResetAvgLastAdmittedRatingPlace() {
+	m.avg_last_admitted_rating_place = nil
+	m.addavg_last_admitted_rating_place = nil
+}
+
+// SetMinLastAdmittedRatingPlace sets the "min_last_admitted_rating_place" field.
+func (m *DrainedResultMutation) SetMinLastAdmittedRatingPlace(i int) {
+	m.min_last_admitted_rating_place = &i
+	m.addmin_last_admitted_rating_place = nil
+}
+
+// MinLastAdmittedRatingPlace returns the value of the "min_last_admitted_rating_place" field in the mutation.
+func (m *DrainedResultMutation) MinLastAdmittedRatingPlace() (r int, exists bool) {
+	v := m.min_last_admitted_rating_place
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMinLastAdmittedRatingPlace returns the old "min_last_admitted_rating_place" field's value of the DrainedResult entity.
+// If the DrainedResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DrainedResultMutation) OldMinLastAdmittedRatingPlace(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMinLastAdmittedRatingPlace is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMinLastAdmittedRatingPlace requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMinLastAdmittedRatingPlace: %w", err)
+	}
+	return oldValue.MinLastAdmittedRatingPlace, nil
+}
+
+// AddMinLastAdmittedRatingPlace adds i to the "min_last_admitted_rating_place" field.
+func (m *DrainedResultMutation) AddMinLastAdmittedRatingPlace(i int) {
+	if m.addmin_last_admitted_rating_place != nil {
+		*m.addmin_last_admitted_rating_place += i
+	} else {
+		m.addmin_last_admitted_rating_place = &i
+	}
+}
+
+// AddedMinLastAdmittedRatingPlace returns the value that was added to the "min_last_admitted_rating_place" field in this mutation.
+func (m *DrainedResultMutation) AddedMinLastAdmittedRatingPlace() (r int, exists bool) {
+	v := m.addmin_last_admitted_rating_place
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMinLastAdmittedRatingPlace resets all changes to the "min_last_admitted_rating_place" field.
+func (m *DrainedResultMutation) ResetMinLastAdmittedRatingPlace() {
+	m.min_last_admitted_rating_place = nil
+	m.addmin_last_admitted_rating_place = nil
+}
+
+// SetMaxLastAdmittedRatingPlace sets the "max_last_admitted_rating_place" field.
+func (m *DrainedResultMutation) SetMaxLastAdmittedRatingPlace(i int) {
+	m.max_last_admitted_rating_place = &i
+	m.addmax_last_admitted_rating_place = nil
+}
+
+// MaxLastAdmittedRatingPlace returns the value of the "max_last_admitted_rating_place" field in the mutation.
+func (m *DrainedResultMutation) MaxLastAdmittedRatingPlace() (r int, exists bool) {
+	v := m.max_last_admitted_rating_place
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxLastAdmittedRatingPlace returns the old "max_last_admitted_rating_place" field's value of the DrainedResult entity.
+// If the DrainedResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DrainedResultMutation) OldMaxLastAdmittedRatingPlace(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxLastAdmittedRatingPlace is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxLastAdmittedRatingPlace requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxLastAdmittedRatingPlace: %w", err)
+	}
+	return oldValue.MaxLastAdmittedRatingPlace, nil
+}
+
+// AddMaxLastAdmittedRatingPlace adds i to the "max_last_admitted_rating_place" field.
+func (m *DrainedResultMutation) AddMaxLastAdmittedRatingPlace(i int) {
+	if m.addmax_last_admitted_rating_place != nil {
+		*m.addmax_last_admitted_rating_place += i
+	} else {
+		m.addmax_last_admitted_rating_place = &i
+	}
+}
+
+// AddedMaxLastAdmittedRatingPlace returns the value that was added to the "max_last_admitted_rating_place" field in this mutation.
+func (m *DrainedResultMutation) AddedMaxLastAdmittedRatingPlace() (r int, exists bool) {
+	v := m.addmax_last_admitted_rating_place
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxLastAdmittedRatingPlace resets all changes to the "max_last_admitted_rating_place" field.
+func (m *DrainedResultMutation) ResetMaxLastAdmittedRatingPlace() {
+	m.max_last_admitted_rating_place = nil
+	m.addmax_last_admitted_rating_place = nil
+}
+
+// SetMedLastAdmittedRatingPlace sets the "med_last_admitted_rating_place" field.
+func (m *DrainedResultMutation) SetMedLastAdmittedRatingPlace(i int) {
+	m.med_last_admitted_rating_place = &i
+	m.addmed_last_admitted_rating_place = nil
+}
+
+// MedLastAdmittedRatingPlace returns the value of the "med_last_admitted_rating_place" field in the mutation.
+func (m *DrainedResultMutation) MedLastAdmittedRatingPlace() (r int, exists bool) {
+	v := m.med_last_admitted_rating_place
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMedLastAdmittedRatingPlace returns the old "med_last_admitted_rating_place" field's value of the DrainedResult entity.
+// If the DrainedResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DrainedResultMutation) OldMedLastAdmittedRatingPlace(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMedLastAdmittedRatingPlace is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMedLastAdmittedRatingPlace requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMedLastAdmittedRatingPlace: %w", err)
+	}
+	return oldValue.MedLastAdmittedRatingPlace, nil
+}
+
+// AddMedLastAdmittedRatingPlace adds i to the "med_last_admitted_rating_place" field.
+func (m *DrainedResultMutation) AddMedLastAdmittedRatingPlace(i int) {
+	if m.addmed_last_admitted_rating_place != nil {
+		*m.addmed_last_admitted_rating_place += i
+	} else {
+		m.addmed_last_admitted_rating_place = &i
+	}
+}
+
+// AddedMedLastAdmittedRatingPlace returns the value that was added to the "med_last_admitted_rating_place" field in this mutation.
+func (m *DrainedResultMutation) AddedMedLastAdmittedRatingPlace() (r int, exists bool) {
+	v := m.addmed_last_admitted_rating_place
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMedLastAdmittedRatingPlace resets all changes to the "med_last_admitted_rating_place" field.
+func (m *DrainedResultMutation) ResetMedLastAdmittedRatingPlace() {
+	m.med_last_admitted_rating_place = nil
+	m.addmed_last_admitted_rating_place = nil
+}
+
+// SetRunID sets the "run_id" field.
+func (m *DrainedResultMutation) SetRunID(i int) {
+	m.run = &i
+}
+
+// RunID returns the value of the "run_id" field in the mutation.
+func (m *DrainedResultMutation) RunID() (r int, exists bool) {
+	v := m.run
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRunID returns the old "run_id" field's value of the DrainedResult entity.
+// If the DrainedResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DrainedResultMutation) OldRunID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRunID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRunID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRunID: %w", err)
+	}
+	return oldValue.RunID, nil
+}
+
+// ResetRunID resets all changes to the "run_id" field.
+func (m *DrainedResultMutation) ResetRunID() {
+	m.run = nil
+}
+
+// SetRegularsAdmitted sets the "regulars_admitted" field.
+func (m *DrainedResultMutation) SetRegularsAdmitted(b bool) {
+	m.regulars_admitted = &b
+}
+
+// RegularsAdmitted returns the value of the "regulars_admitted" field in the mutation.
+func (m *DrainedResultMutation) RegularsAdmitted() (r bool, exists bool) {
+	v := m.regulars_admitted
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRegularsAdmitted returns the old "regulars_admitted" field's value of the DrainedResult entity.
+// If the DrainedResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DrainedResultMutation) OldRegularsAdmitted(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRegularsAdmitted is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRegularsAdmitted requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRegularsAdmitted: %w", err)
+	}
+	return oldValue.RegularsAdmitted, nil
+}
+
+// ResetRegularsAdmitted resets all changes to the "regulars_admitted" field.
+func (m *DrainedResultMutation) ResetRegularsAdmitted() {
+	m.regulars_admitted = nil
+}
+
+// SetIsVirtual sets the "is_virtual" field.
+func (m *DrainedResultMutation) SetIsVirtual(b bool) {
+	m.is_virtual = &b
+}
+
+// IsVirtual returns the value of the "is_virtual" field in the mutation.
+func (m *DrainedResultMutation) IsVirtual() (r bool, exists bool) {
+	v := m.is_virtual
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsVirtual returns the old "is_virtual" field's value of the DrainedResult entity.
+// If the DrainedResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DrainedResultMutation) OldIsVirtual(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsVirtual is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsVirtual requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsVirtual: %w", err)
+	}
+	return oldValue.IsVirtual, nil
+}
+
+// ResetIsVirtual resets all changes to the "is_virtual" field.
+func (m *DrainedResultMutation) ResetIsVirtual() {
+	m.is_virtual = nil
+}
+
+// SetSeed sets the "seed" field.
+func (m *DrainedResultMutation) SetSeed(i int64) {
+	m.seed = &i
+	m.addseed = nil
+}
+
+// Seed returns the value of the "seed" field in the mutation.
+func (m *DrainedResultMutation) Seed() (r int64, exists bool) {
+	v := m.seed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeed returns the old "seed" field's value of the DrainedResult entity.
+// If the DrainedResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DrainedResultMutation) OldSeed(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeed: %w", err)
+	}
+	return oldValue.Seed, nil
+}
+
+// AddSeed adds i to the "seed" field.
+func (m *DrainedResultMutation) AddSeed(i int64) {
+	if m.addseed != nil {
+		*m.addseed += i
+	} else {
+		m.addseed = &i
+	}
+}
+
+// AddedSeed returns the value that was added to the "seed" field in this mutation.
+func (m *DrainedResultMutation) AddedSeed() (r int64, exists bool) {
+	v := m.addseed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSeed clears the value of the "seed" field.
+func (m *DrainedResultMutation) ClearSeed() {
+	m.seed = nil
+	m.addseed = nil
+	m.clearedFields[drainedresult.FieldSeed] = struct{}{}
+}
+
+// SeedCleared returns if the "seed" field was cleared in this mutation.
+func (m *DrainedResultMutation) SeedCleared() bool {
+	_, ok := m.clearedFields[drainedresult.FieldSeed]
+	return ok
+}
+
+// ResetSeed resets all changes to the "seed" field.
+func (m *DrainedResultMutation) ResetSeed() {
+	m.seed = nil
+	m.addseed = nil
+	delete(m.clearedFields, drainedresult.FieldSeed)
+}
+
+// SetDrainModel sets the "drain_model" field.
+func (m *DrainedResultMutation) SetDrainModel(s string) {
+	m.drain_model = &s
+}
+
+// DrainModel returns the value of the "drain_model" field in the mutation.
+func (m *DrainedResultMutation) DrainModel() (r string, exists bool) {
+	v := m.drain_model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDrainModel returns the old "drain_model" field's value of the DrainedResult entity.
+// If the DrainedResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DrainedResultMutation) OldDrainModel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDrainModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDrainModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDrainModel: %w", err)
+	}
+	return oldValue.DrainModel, nil
+}
+
+// ClearDrainModel clears the value of the "drain_model" field.
+func (m *DrainedResultMutation) ClearDrainModel() {
+	m.drain_model = nil
+	m.clearedFields[drainedresult.FieldDrainModel] = struct{}{}
+}
+
+// DrainModelCleared returns if the "drain_model" field was cleared in this mutation.
+func (m *DrainedResultMutation) DrainModelCleared() bool {
+	_, ok := m.clearedFields[drainedresult.FieldDrainModel]
+	return ok
+}
+
+// ResetDrainModel resets all changes to the "drain_model" field.
+func (m *DrainedResultMutation) ResetDrainModel() {
+	m.drain_model = nil
+	delete(m.clearedFields, drainedresult.FieldDrainModel)
+}
+
+// SetAvgPriorityStageAdmitted sets the "avg_priority_stage_admitted" field.
+func (m *DrainedResultMutation) SetAvgPriorityStageAdmitted(i int) {
+	m.avg_priority_stage_admitted = &i
+	m.addavg_priority_stage_admitted = nil
+}
+
+// AvgPriorityStageAdmitted returns the value of the "avg_priority_stage_admitted" field in the mutation.
+func (m *DrainedResultMutation) AvgPriorityStageAdmitted() (r int, exists bool) {
+	v := m.avg_priority_stage_admitted
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAvgPriorityStageAdmitted returns the old "avg_priority_stage_admitted" field's value of the DrainedResult entity.
+// If the DrainedResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DrainedResultMutation) OldAvgPriorityStageAdmitted(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAvgPriorityStageAdmitted is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAvgPriorityStageAdmitted requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAvgPriorityStageAdmitted: %w", err)
+	}
+	return oldValue.AvgPriorityStageAdmitted, nil
+}
+
+// AddAvgPriorityStageAdmitted adds i to the "avg_priority_stage_admitted" field.
+func (m *DrainedResultMutation) AddAvgPriorityStageAdmitted(i int) {
+	if m.addavg_priority_stage_admitted != nil {
+		*m.addavg_priority_stage_admitted += i
+	} else {
+		m.addavg_priority_stage_admitted = &i
+	}
+}
+
+// AddedAvgPriorityStageAdmitted returns the value that was added to the "avg_priority_stage_admitted" field in this mutation.
+func (m *DrainedResultMutation) AddedAvgPriorityStageAdmitted() (r int, exists bool) {
+	v := m.addavg_priority_stage_admitted
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearAvgPriorityStageAdmitted clears the value of the "avg_priority_stage_admitted" field.
+func (m *DrainedResultMutation) ClearAvgPriorityStageAdmitted() {
+	m.avg_priority_stage_admitted = nil
+	m.addavg_priority_stage_admitted = nil
+	m.clearedFields[drainedresult.FieldAvgPriorityStageAdmitted] = struct{}{}
+}
+
+// AvgPriorityStageAdmittedCleared returns if the "avg_priority_stage_admitted" field was cleared in this mutation.
+func (m *DrainedResultMutation) AvgPriorityStageAdmittedCleared() bool {
+	_, ok := m.clearedFields[drainedresult.FieldAvgPriorityStageAdmitted]
+	return ok
+}
+
+// ResetAvgPriorityStageAdmitted resets all changes to the "avg_priority_stage_admitted" field.
+func (m *DrainedResultMutation) ResetAvgPriorityStageAdmitted() {
+	m.avg_priority_stage_admitted = nil
+	m.addavg_priority_stage_admitted = nil
+	delete(m.clearedFields, drainedresult.FieldAvgPriorityStageAdmitted)
+}
+
+// SetAvgMainStageAdmitted sets the "avg_main_stage_admitted" field.
+func (m *DrainedResultMutation) SetAvgMainStageAdmitted(i int) {
+	m.avg_main_stage_admitted = &i
+	m.addavg_main_stage_admitted = nil
+}
+
+// AvgMainStageAdmitted returns the value of the "avg_main_stage_admitted" field in the mutation.
+func (m *DrainedResultMutation) AvgMainStageAdmitted() (r int, exists bool) {
+	v := m.avg_main_stage_admitted
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAvgMainStageAdmitted returns the old "avg_main_stage_admitted" field's value of the DrainedResult entity.
+// If the DrainedResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DrainedResultMutation) OldAvgMainStageAdmitted(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAvgMainStageAdmitted is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAvgMainStageAdmitted requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAvgMainStageAdmitted: %w", err)
+	}
+	return oldValue.AvgMainStageAdmitted, nil
+}
+
+// AddAvgMainStageAdmitted adds i to the "avg_main_stage_admitted" field.
+func (m *DrainedResultMutation) AddAvgMainStageAdmitted(i int) {
+	if m.addavg_main_stage_admitted != nil {
+		*m.addavg_main_stage_admitted += i
+	} else {
+		m.addavg_main_stage_admitted = &i
+	}
+}
+
+// AddedAvgMainStageAdmitted returns the value that was added to the "avg_main_stage_admitted" field in this mutation.
+func (m *DrainedResultMutation) AddedAvgMainStageAdmitted() (r int, exists bool) {
+	v := m.addavg_main_stage_admitted
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearAvgMainStageAdmitted clears the value of the "avg_main_stage_admitted" field.
+func (m *DrainedResultMutation) ClearAvgMainStageAdmitted() {
+	m.avg_main_stage_admitted = nil
+	m.addavg_main_stage_admitted = nil
+	m.clearedFields[drainedresult.FieldAvgMainStageAdmitted] = struct{}{}
+}
+
+// AvgMainStageAdmittedCleared returns if the "avg_main_stage_admitted" field was cleared in this mutation.
+func (m *DrainedResultMutation) AvgMainStageAdmittedCleared() bool {
+	_, ok := m.clearedFields[drainedresult.FieldAvgMainStageAdmitted]
+	return ok
+}
+
+// ResetAvgMainStageAdmitted resets all changes to the "avg_main_stage_admitted" field.
+func (m *DrainedResultMutation) ResetAvgMainStageAdmitted() {
+	m.avg_main_stage_admitted = nil
+	m.addavg_main_stage_admitted = nil
+	delete(m.clearedFields, drainedresult.FieldAvgMainStageAdmitted)
+}
+
+// SetSubQuotas sets the "sub_quotas" field.
+func (m *DrainedResultMutation) SetSubQuotas(cqrd []core.SubQuotaResultDTO) {
+	m.sub_quotas = &cqrd
+	m.appendsub_quotas = nil
+}
+
+// SubQuotas returns the value of the "sub_quotas" field in the mutation.
+func (m *DrainedResultMutation) SubQuotas() (r []core.SubQuotaResultDTO, exists bool) {
+	v := m.sub_quotas
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubQuotas returns the old "sub_quotas" field's value of the DrainedResult entity.
+// If the DrainedResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DrainedResultMutation) OldSubQuotas(ctx context.Context) (v []core.SubQuotaResultDTO, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubQuotas is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubQuotas requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubQuotas: %w", err)
+	}
+	return oldValue.SubQuotas, nil
+}
+
+// AppendSubQuotas adds cqrd to the "sub_quotas" field.
+func (m *DrainedResultMutation) AppendSubQuotas(cqrd []core.SubQuotaResultDTO) {
+	m.appendsub_quotas = append(m.appendsub_quotas, cqrd...)
+}
+
+// AppendedSubQuotas returns the list of values that were appended to the "sub_quotas" field in this mutation.
+func (m *DrainedResultMutation) AppendedSubQuotas() ([]core.SubQuotaResultDTO, bool) {
+	if len(m.appendsub_quotas) == 0 {
+		return nil, false
+	}
+	return m.appendsub_quotas, true
+}
+
+// ClearSubQuotas clears the value of the "sub_quotas" field.
+func (m *DrainedResultMutation) ClearSubQuotas() {
+	m.sub_quotas = nil
+	m.appendsub_quotas = nil
+	m.clearedFields[drainedresult.FieldSubQuotas] = struct{}{}
+}
+
+// SubQuotasCleared returns if the "sub_quotas" field was cleared in this mutation.
+func (m *DrainedResultMutation) SubQuotasCleared() bool {
+	_, ok := m.clearedFields[drainedresult.FieldSubQuotas]
+	return ok
+}
+
+// ResetSubQuotas resets all changes to the "sub_quotas" field.
+func (m *DrainedResultMutation) ResetSubQuotas() {
+	m.sub_quotas = nil
+	m.appendsub_quotas = nil
+	delete(m.clearedFields, drainedresult.FieldSubQuotas)
+}
+
+// SetAvgPaidPassingScore sets the "avg_paid_passing_score" field.
+func (m *DrainedResultMutation) SetAvgPaidPassingScore(i int) {
+	m.avg_paid_passing_score = &i
+	m.addavg_paid_passing_score = nil
+}
+
+// AvgPaidPassingScore returns the value of the "avg_paid_passing_score" field in the mutation.
+func (m *DrainedResultMutation) AvgPaidPassingScore() (r int, exists bool) {
+	v := m.avg_paid_passing_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAvgPaidPassingScore returns the old "avg_paid_passing_score" field's value of the DrainedResult entity.
+// If the DrainedResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DrainedResultMutation) OldAvgPaidPassingScore(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAvgPaidPassingScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAvgPaidPassingScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAvgPaidPassingScore: %w", err)
+	}
+	return oldValue.AvgPaidPassingScore, nil
+}
+
+// AddAvgPaidPassingScore adds i to the "avg_paid_passing_score" field.
+func (m *DrainedResultMutation) AddAvgPaidPassingScore(i int) {
+	if m.addavg_paid_passing_score != nil {
+		*m.addavg_paid_passing_score += i
+	} else {
+		m.addavg_paid_passing_score = &i
+	}
+}
+
+// AddedAvgPaidPassingScore returns the value that was added to the "avg_paid_passing_score" field in this mutation.
+func (m *DrainedResultMutation) AddedAvgPaidPassingScore() (r int, exists bool) {
+	v := m.addavg_paid_passing_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearAvgPaidPassingScore clears the value of the "avg_paid_passing_score" field.
+func (m *DrainedResultMutation) ClearAvgPaidPassingScore() {
+	m.avg_paid_passing_score = nil
+	m.addavg_paid_passing_score = nil
+	m.clearedFields[drainedresult.FieldAvgPaidPassingScore] = struct{}{}
+}
+
+// AvgPaidPassingScoreCleared returns if the "avg_paid_passing_score" field was cleared in this mutation.
+func (m *DrainedResultMutation) AvgPaidPassingScoreCleared() bool {
+	_, ok := m.clearedFields[drainedresult.FieldAvgPaidPassingScore]
+	return ok
+}
+
+// ResetAvgPaidPassingScore resets all changes to the "avg_paid_passing_score" field.
+func (m *DrainedResultMutation) ResetAvgPaidPassingScore() {
+	m.avg_paid_passing_score = nil
+	m.addavg_paid_passing_score = nil
+	delete(m.clearedFields, drainedresult.FieldAvgPaidPassingScore)
+}
+
+// SetAvgPaidLastAdmittedRatingPlace sets the "avg_paid_last_admitted_rating_place" field.
+func (m *DrainedResultMutation) SetAvgPaidLastAdmittedRatingPlace(i int) {
+	m.avg_paid_last_admitted_rating_place = &i
+	m.addavg_paid_last_admitted_rating_place = nil
+}
+
+// AvgPaidLastAdmittedRatingPlace returns the value of the "avg_paid_last_admitted_rating_place" field in the mutation.
+func (m *DrainedResultMutation) AvgPaidLastAdmittedRatingPlace() (r int, exists bool) {
+	v := m.avg_paid_last_admitted_rating_place
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAvgPaidLastAdmittedRatingPlace returns the old "avg_paid_last_admitted_rating_place" field's value of the DrainedResult entity.
+// If the DrainedResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DrainedResultMutation) OldAvgPaidLastAdmittedRatingPlace(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAvgPaidLastAdmittedRatingPlace is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAvgPaidLastAdmittedRatingPlace requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAvgPaidLastAdmittedRatingPlace: %w", err)
+	}
+	return oldValue.AvgPaidLastAdmittedRatingPlace, nil
+}
+
+// AddAvgPaidLastAdmittedRatingPlace adds i to the "avg_paid_last_admitted_rating_place" field.
+func (m *DrainedResultMutation) AddAvgPaidLastAdmittedRatingPlace(i int) {
+	if m.addavg_paid_last_admitted_rating_place != nil {
+		*m.addavg_paid_last_admitted_rating_place += i
+	} else {
+		m.addavg_paid_last_admitted_rating_place = &i
+	}
+}
+
+// AddedAvgPaidLastAdmittedRatingPlace returns the value that was added to the "avg_paid_last_admitted_rating_place" field in this mutation.
+func (m *DrainedResultMutation) AddedAvgPaidLastAdmittedRatingPlace() (r int, exists bool) {
+	v := m.addavg_paid_last_admitted_rating_place
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearAvgPaidLastAdmittedRatingPlace clears the value of the "avg_paid_last_admitted_rating_place" field.
+func (m *DrainedResultMutation) ClearAvgPaidLastAdmittedRatingPlace() {
+	m.avg_paid_last_admitted_rating_place = nil
+	m.addavg_paid_last_admitted_rating_place = nil
+	m.clearedFields[drainedresult.FieldAvgPaidLastAdmittedRatingPlace] = struct{}{}
+}
+
+// AvgPaidLastAdmittedRatingPlaceCleared returns if the "avg_paid_last_admitted_rating_place" field was cleared in this mutation.
+func (m *DrainedResultMutation) AvgPaidLastAdmittedRatingPlaceCleared() bool {
+	_, ok := m.clearedFields[drainedresult.FieldAvgPaidLastAdmittedRatingPlace]
+	return ok
+}
+
+// ResetAvgPaidLastAdmittedRatingPlace resets all changes to the "avg_paid_last_admitted_rating_place" field.
+func (m *DrainedResultMutation) ResetAvgPaidLastAdmittedRatingPlace() {
+	m.avg_paid_last_admitted_rating_place = nil
+	m.addavg_paid_last_admitted_rating_place = nil
+	delete(m.clearedFields, drainedresult.FieldAvgPaidLastAdmittedRatingPlace)
+}
+
+// SetP10PassingScore sets the "p10_passing_score" field.
+func (m *DrainedResultMutation) SetP10PassingScore(i int) {
+	m.p10_passing_score = &i
+	m.addp10_passing_score = nil
+}
+
+// P10PassingScore returns the value of the "p10_passing_score" field in the mutation.
+func (m *DrainedResultMutation) P10PassingScore() (r int, exists bool) {
+	v := m.p10_passing_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldP10PassingScore returns the old "p10_passing_score" field's value of the DrainedResult entity.
+// If the DrainedResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DrainedResultMutation) OldP10PassingScore(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldP10PassingScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldP10PassingScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldP10PassingScore: %w", err)
+	}
+	return oldValue.P10PassingScore, nil
+}
+
+// AddP10PassingScore adds i to the "p10_passing_score" field.
+func (m *DrainedResultMutation) AddP10PassingScore(i int) {
+	if m.addp10_passing_score != nil {
+		*m.addp10_passing_score += i
+	} else {
+		m.addp10_passing_score = &i
+	}
+}
+
+// AddedP10PassingScore returns the value that was added to the "p10_passing_score" field in this mutation.
+func (m *DrainedResultMutation) AddedP10PassingScore() (r int, exists bool) {
+	v := m.addp10_passing_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearP10PassingScore clears the value of the "p10_passing_score" field.
+func (m *DrainedResultMutation) ClearP10PassingScore() {
+	m.p10_passing_score = nil
+	m.addp10_passing_score = nil
+	m.clearedFields[drainedresult.FieldP10PassingScore] = struct{}{}
+}
+
+// P10PassingScoreCleared returns if the "p10_passing_score" field was cleared in this mutation.
+func (m *DrainedResultMutation) P10PassingScoreCleared() bool {
+	_, ok := m.clearedFields[drainedresult.FieldP10PassingScore]
+	return ok
+}
+
+// ResetP10PassingScore resets all changes to the "p10_passing_score" field.
+func (m *DrainedResultMutation) ResetP10PassingScore() {
+	m.p10_passing_score = nil
+	m.addp10_passing_score = nil
+	delete(m.clearedFields, drainedresult.FieldP10PassingScore)
+}
+
+// SetP25PassingScore sets the "p25_passing_score" field.
+func (m *DrainedResultMutation) SetP25PassingScore(i int) {
+	m.p25_passing_score = &i
+	m.addp25_passing_score = nil
+}
+
+// P25PassingScore returns the value of the "p25_passing_score" field in the mutation.
+func (m *DrainedResultMutation) P25PassingScore() (r int, exists bool) {
+	v := m.p25_passing_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldP25PassingScore returns the old "p25_passing_score" field's value of the DrainedResult entity.
+// If the DrainedResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DrainedResultMutation) OldP25PassingScore(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldP25PassingScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldP25PassingScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldP25PassingScore: %w", err)
+	}
+	return oldValue.P25PassingScore, nil
+}
+
+// AddP25PassingScore adds i to the "p25_passing_score" field.
+func (m *DrainedResultMutation) AddP25PassingScore(i int) {
+	if m.addp25_passing_score != nil {
+		*m.addp25_passing_score += i
+	} else {
+		m.addp25_passing_score = &i
+	}
+}
+
+// AddedP25PassingScore returns the value that was added to the "p25_passing_score" field in this mutation.
+func (m *DrainedResultMutation) AddedP25PassingScore() (r int, exists bool) {
+	v := m.addp25_passing_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearP25PassingScore clears the value of the "p25_passing_score" field.
+func (m *DrainedResultMutation) ClearP25PassingScore() {
+	m.p25_passing_score = nil
+	m.addp25_passing_score = nil
+	m.clearedFields[drainedresult.FieldP25PassingScore] = struct{}{}
+}
+
+// P25PassingScoreCleared returns if the "p25_passing_score" field was cleared in this mutation.
+func (m *DrainedResultMutation) P25PassingScoreCleared() bool {
+	_, ok := m.clearedFields[drainedresult.FieldP25PassingScore]
+	return ok
+}
+
+// ResetP25PassingScore resets all changes to the "p25_passing_score" field.
+func (m *DrainedResultMutation) ResetP25PassingScore() {
+	m.p25_passing_score = nil
+	m.addp25_passing_score = nil
+	delete(m.clearedFields, drainedresult.FieldP25PassingScore)
+}
+
+// SetP75PassingScore sets the "p75_passing_score" field.
+func (m *DrainedResultMutation) SetP75PassingScore(i int) {
+	m.p75_passing_score = &i
+	m.addp75_passing_score = nil
+}
+
+// P75PassingScore returns the value of the "p75_passing_score" field in the mutation.
+func (m *DrainedResultMutation) P75PassingScore() (r int, exists bool) {
+	v := m.p75_passing_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldP75PassingScore returns the old "p75_passing_score" field's value of the DrainedResult entity.
+// If the DrainedResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DrainedResultMutation) OldP75PassingScore(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldP75PassingScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldP75PassingScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldP75PassingScore: %w", err)
+	}
+	return oldValue.P75PassingScore, nil
+}
+
+// AddP75PassingScore adds i to the "p75_passing_score" field.
+func (m *DrainedResultMutation) AddP75PassingScore(i int) {
+	if m.addp75_passing_score != nil {
+		*m.addp75_passing_score += i
+	} else {
+		m.addp75_passing_score = &i
+	}
+}
+
+// AddedP75PassingScore returns the value that was added to the "p75_passing_score" field in this mutation.
+func (m *DrainedResultMutation) AddedP75PassingScore() (r int, exists bool) {
+	v := m.addp75_passing_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearP75PassingScore clears the value of the "p75_passing_score" field.
+func (m *DrainedResultMutation) ClearP75PassingScore() {
+	m.p75_passing_score = nil
+	m.addp75_passing_score = nil
+	m.clearedFields[drainedresult.FieldP75PassingScore] = struct{}{}
+}
+
+// P75PassingScoreCleared returns if the "p75_passing_score" field was cleared in this mutation.
+func (m *DrainedResultMutation) P75PassingScoreCleared() bool {
+	_, ok := m.clearedFields[drainedresult.FieldP75PassingScore]
+	return ok
+}
+
+// ResetP75PassingScore resets all changes to the "p75_passing_score" field.
+func (m *DrainedResultMutation) ResetP75PassingScore() {
+	m.p75_passing_score = nil
+	m.addp75_passing_score = nil
+	delete(m.clearedFields, drainedresult.FieldP75PassingScore)
+}
+
+// SetP90PassingScore sets the "p90_passing_score" field.
+func (m *DrainedResultMutation) SetP90PassingScore(i int) {
+	m.p90_passing_score = &i
+	m.addp90_passing_score = nil
+}
+
+// P90PassingScore returns the value of the "p90_passing_score" field in the mutation.
+func (m *DrainedResultMutation) P90PassingScore() (r int, exists bool) {
+	v := m.p90_passing_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldP90PassingScore returns the old "p90_passing_score" field's value of the DrainedResult entity.
+// If the DrainedResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DrainedResultMutation) OldP90PassingScore(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldP90PassingScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldP90PassingScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldP90PassingScore: %w", err)
+	}
+	return oldValue.P90PassingScore, nil
+}
+
+// AddP90PassingScore adds i to the "p90_passing_score" field.
+func (m *DrainedResultMutation) AddP90PassingScore(i int) {
+	if m.addp90_passing_score != nil {
+		*m.addp90_passing_score += i
+	} else {
+		m.addp90_passing_score = &i
+	}
+}
+
+// AddedP90PassingScore returns the value that was added to the "p90_passing_score" field in this mutation.
+func (m *DrainedResultMutation) AddedP90PassingScore() (r int, exists bool) {
+	v := m.addp90_passing_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearP90PassingScore clears the value of the "p90_passing_score" field.
+func (m *DrainedResultMutation) ClearP90PassingScore() {
+	m.p90_passing_score = nil
+	m.addp90_passing_score = nil
+	m.clearedFields[drainedresult.FieldP90PassingScore] = struct{}{}
+}
+
+// P90PassingScoreCleared returns if the "p90_passing_score" field was cleared in this mutation.
+func (m *DrainedResultMutation) P90PassingScoreCleared() bool {
+	_, ok := m.clearedFields[drainedresult.FieldP90PassingScore]
+	return ok
+}
+
+// ResetP90PassingScore resets all changes to the "p90_passing_score" field.
+func (m *DrainedResultMutation) ResetP90PassingScore() {
+	m.p90_passing_score = nil
+	m.addp90_passing_score = nil
+	delete(m.clearedFields, drainedresult.FieldP90PassingScore)
+}
+
+// SetP10LastAdmittedRatingPlace sets the "p10_last_admitted_rating_place" field.
+func (m *DrainedResultMutation) SetP10LastAdmittedRatingPlace(i int) {
+	m.p10_last_admitted_rating_place = &i
+	m.addp10_last_admitted_rating_place = nil
+}
+
+// P10LastAdmittedRatingPlace returns the value of the "p10_last_admitted_rating_place" field in the mutation.
+func (m *DrainedResultMutation) P10LastAdmittedRatingPlace() (r int, exists bool) {
+	v := m.p10_last_admitted_rating_place
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldP10LastAdmittedRatingPlace returns the old "p10_last_admitted_rating_place" field's value of the DrainedResult entity.
+// If the DrainedResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DrainedResultMutation) OldP10LastAdmittedRatingPlace(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldP10LastAdmittedRatingPlace is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldP10LastAdmittedRatingPlace requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldP10LastAdmittedRatingPlace: %w", err)
+	}
+	return oldValue.P10LastAdmittedRatingPlace, nil
+}
+
+// AddP10LastAdmittedRatingPlace adds i to the "p10_last_admitted_rating_place" field.
+func (m *DrainedResultMutation) AddP10LastAdmittedRatingPlace(i int) {
+	if m.addp10_last_admitted_rating_place != nil {
+		*m.addp10_last_admitted_rating_place += i
+	} else {
+		m.addp10_last_admitted_rating_place = &i
+	}
+}
+
+// AddedP10LastAdmittedRatingPlace returns the value that was added to the "p10_last_admitted_rating_place" field in this mutation.
+func (m *DrainedResultMutation) AddedP10LastAdmittedRatingPlace() (r int, exists bool) {
+	v := m.addp10_last_admitted_rating_place
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearP10LastAdmittedRatingPlace clears the value of the "p10_last_admitted_rating_place" field.
+func (m *DrainedResultMutation) ClearP10LastAdmittedRatingPlace() {
+	m.p10_last_admitted_rating_place = nil
+	m.addp10_last_admitted_rating_place = nil
+	m.clearedFields[drainedresult.FieldP10LastAdmittedRatingPlace] = struct{}{}
+}
+
+// P10LastAdmittedRatingPlaceCleared returns if the "p10_last_admitted_rating_place" field was cleared in this mutation.
+func (m *DrainedResultMutation) P10LastAdmittedRatingPlaceCleared() bool {
+	_, ok := m.clearedFields[drainedresult.FieldP10LastAdmittedRatingPlace]
+	return ok
+}
+
+// ResetP10LastAdmittedRatingPlace resets all changes to the "p10_last_admitted_rating_place" field.
+func (m *DrainedResultMutation) ResetP10LastAdmittedRatingPlace() {
+	m.p10_last_admitted_rating_place = nil
+	m.addp10_last_admitted_rating_place = nil
+	delete(m.clearedFields, drainedresult.FieldP10LastAdmittedRatingPlace)
+}
+
+// SetP25LastAdmittedRatingPlace sets the "p25_last_admitted_rating_place" field.
+func (m *DrainedResultMutation) SetP25LastAdmittedRatingPlace(i int) {
+	m.p25_last_admitted_rating_place = &i
+	m.addp25_last_admitted_rating_place = nil
+}
+
+// P25LastAdmittedRatingPlace returns the value of the "p25_last_admitted_rating_place" field in the mutation.
+func (m *DrainedResultMutation) P25LastAdmittedRatingPlace() (r int, exists bool) {
+	v := m.p25_last_admitted_rating_place
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldP25LastAdmittedRatingPlace returns the old "p25_last_admitted_rating_place" field's value of the DrainedResult entity.
+// If the DrainedResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DrainedResultMutation) OldP25LastAdmittedRatingPlace(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldP25LastAdmittedRatingPlace is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldP25LastAdmittedRatingPlace requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldP25LastAdmittedRatingPlace: %w", err)
+	}
+	return oldValue.P25LastAdmittedRatingPlace, nil
+}
+
+// AddP25LastAdmittedRatingPlace adds i to the "p25_last_admitted_rating_place" field.
+func (m *DrainedResultMutation) AddP25LastAdmittedRatingPlace(i int) {
+	if m.addp25_last_admitted_rating_place != nil {
+		*m.addp25_last_admitted_rating_place += i
+	} else {
+		m.addp25_last_admitted_rating_place = &i
+	}
+}
+
+// AddedP25LastAdmittedRatingPlace returns the value that was added to the "p25_last_admitted_rating_place" field in this mutation.
+func (m *DrainedResultMutation) AddedP25LastAdmittedRatingPlace() (r int, exists bool) {
+	v := m.addp25_last_admitted_rating_place
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearP25LastAdmittedRatingPlace clears the value of the "p25_last_admitted_rating_place" field.
+func (m *DrainedResultMutation) ClearP25LastAdmittedRatingPlace() {
+	m.p25_last_admitted_rating_place = nil
+	m.addp25_last_admitted_rating_place = nil
+	m.clearedFields[drainedresult.FieldP25LastAdmittedRatingPlace] = struct{}{}
+}
+
+// P25LastAdmittedRatingPlaceCleared returns if the "p25_last_admitted_rating_place" field was cleared in this mutation.
+func (m *DrainedResultMutation) P25LastAdmittedRatingPlaceCleared() bool {
+	_, ok := m.clearedFields[drainedresult.FieldP25LastAdmittedRatingPlace]
+	return ok
+}
+
+// ResetP25LastAdmittedRatingPlace resets all changes to the "p25_last_admitted_rating_place" field.
+func (m *DrainedResultMutation) ResetP25LastAdmittedRatingPlace() {
+	m.p25_last_admitted_rating_place = nil
+	m.addp25_last_admitted_rating_place = nil
+	delete(m.clearedFields, drainedresult.FieldP25LastAdmittedRatingPlace)
+}
+
+// SetP75LastAdmittedRatingPlace sets the "p75_last_admitted_rating_place" field.
+func (m *DrainedResultMutation) SetP75LastAdmittedRatingPlace(i int) {
+	m.p75_last_admitted_rating_place = &i
+	m.addp75_last_admitted_rating_place = nil
+}
+
+// P75LastAdmittedRatingPlace returns the value of the "p75_last_admitted_rating_place" field in the mutation.
+func (m *DrainedResultMutation) P75LastAdmittedRatingPlace() (r int, exists bool) {
+	v := m.p75_last_admitted_rating_place
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldP75LastAdmittedRatingPlace returns the old "p75_last_admitted_rating_place" field's value of the DrainedResult entity.
+// If the DrainedResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DrainedResultMutation) OldP75LastAdmittedRatingPlace(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldP75LastAdmittedRatingPlace is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldP75LastAdmittedRatingPlace requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldP75LastAdmittedRatingPlace: %w", err)
+	}
+	return oldValue.P75LastAdmittedRatingPlace, nil
+}
+
+// AddP75LastAdmittedRatingPlace adds i to the "p75_last_admitted_rating_place" field.
+func (m *DrainedResultMutation) AddP75LastAdmittedRatingPlace(i int) {
+	if m.addp75_last_admitted_rating_place != nil {
+		*m.addp75_last_admitted_rating_place += i
+	} else {
+		m.addp75_last_admitted_rating_place = &i
+	}
+}
+
+// AddedP75LastAdmittedRatingPlace returns the value that was added to the "p75_last_admitted_rating_place" field in this mutation.
+func (m *DrainedResultMutation) AddedP75LastAdmittedRatingPlace() (r int, exists bool) {
+	v := m.addp75_last_admitted_rating_place
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearP75LastAdmittedRatingPlace clears the value of the "p75_last_admitted_rating_place" field.
+func (m *DrainedResultMutation) ClearP75LastAdmittedRatingPlace() {
+	m.p75_last_admitted_rating_place = nil
+	m.addp75_last_admitted_rating_place = nil
+	m.clearedFields[drainedresult.FieldP75LastAdmittedRatingPlace] = struct{}{}
+}
+
+// P75LastAdmittedRatingPlaceCleared returns if the "p75_last_admitted_rating_place" field was cleared in this mutation.
+func (m *DrainedResultMutation) P75LastAdmittedRatingPlaceCleared() bool {
+	_, ok := m.clearedFields[drainedresult.FieldP75LastAdmittedRatingPlace]
+	return ok
+}
+
+// ResetP75LastAdmittedRatingPlace resets all changes to the "p75_last_admitted_rating_place" field.
+func (m *DrainedResultMutation) ResetP75LastAdmittedRatingPlace() {
+	m.p75_last_admitted_rating_place = nil
+	m.addp75_last_admitted_rating_place = nil
+	delete(m.clearedFields, drainedresult.FieldP75LastAdmittedRatingPlace)
+}
+
+// SetP90LastAdmittedRatingPlace sets the "p90_last_admitted_rating_place" field.
+func (m *DrainedResultMutation) SetP90LastAdmittedRatingPlace(i int) {
+	m.p90_last_admitted_rating_place = &i
+	m.addp90_last_admitted_rating_place = nil
+}
+
+// P90LastAdmittedRatingPlace returns the value of the "p90_last_admitted_rating_place" field in the mutation.
+func (m *DrainedResultMutation) P90LastAdmittedRatingPlace() (r int, exists bool) {
+	v := m.p90_last_admitted_rating_place
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldP90LastAdmittedRatingPlace returns the old "p90_last_admitted_rating_place" field's value of the DrainedResult entity.
+// If the DrainedResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DrainedResultMutation) OldP90LastAdmittedRatingPlace(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldP90LastAdmittedRatingPlace is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldP90LastAdmittedRatingPlace requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldP90LastAdmittedRatingPlace: %w", err)
+	}
+	return oldValue.P90LastAdmittedRatingPlace, nil
+}
+
+// AddP90LastAdmittedRatingPlace adds i to the "p90_last_admitted_rating_place" field.
+func (m *DrainedResultMutation) AddP90LastAdmittedRatingPlace(i int) {
+	if m.addp90_last_admitted_rating_place != nil {
+		*m.addp90_last_admitted_rating_place += i
+	} else {
+		m.addp90_last_admitted_rating_place = &i
+	}
+}
+
+// AddedP90LastAdmittedRatingPlace returns the value that was added to the "p90_last_admitted_rating_place" field in this mutation.
+func (m *DrainedResultMutation) AddedP90LastAdmittedRatingPlace() (r int, exists bool) {
+	v := m.addp90_last_admitted_rating_place
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearP90LastAdmittedRatingPlace clears the value of the "p90_last_admitted_rating_place" field.
+func (m *DrainedResultMutation) ClearP90LastAdmittedRatingPlace() {
+	m.p90_last_admitted_rating_place = nil
+	m.addp90_last_admitted_rating_place = nil
+	m.clearedFields[drainedresult.FieldP90LastAdmittedRatingPlace] = struct{}{}
+}
+
+// P90LastAdmittedRatingPlaceCleared returns if the "p90_last_admitted_rating_place" field was cleared in this mutation.
+func (m *DrainedResultMutation) P90LastAdmittedRatingPlaceCleared() bool {
+	_, ok := m.clearedFields[drainedresult.FieldP90LastAdmittedRatingPlace]
+	return ok
+}
+
+// ResetP90LastAdmittedRatingPlace resets all changes to the "p90_last_admitted_rating_place" field.
+func (m *DrainedResultMutation) ResetP90LastAdmittedRatingPlace() {
+	m.p90_last_admitted_rating_place = nil
+	m.addp90_last_admitted_rating_place = nil
+	delete(m.clearedFields, drainedresult.FieldP90LastAdmittedRatingPlace)
+}
+
+// SetPassingScoreHistogram sets the "passing_score_histogram" field.
+func (m *DrainedResultMutation) SetPassingScoreHistogram(cd *core.HistogramDTO) {
+	m.passing_score_histogram = &cd
+}
+
+// PassingScoreHistogram returns the value of the "passing_score_histogram" field in the mutation.
+func (m *DrainedResultMutation) PassingScoreHistogram() (r *core.HistogramDTO, exists bool) {
+	v := m.passing_score_histogram
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPassingScoreHistogram returns the old "passing_score_histogram" field's value of the DrainedResult entity.
+// If the DrainedResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DrainedResultMutation) OldPassingScoreHistogram(ctx context.Context) (v *core.HistogramDTO, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPassingScoreHistogram is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPassingScoreHistogram requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPassingScoreHistogram: %w", err)
+	}
+	return oldValue.PassingScoreHistogram, nil
+}
+
+// ClearPassingScoreHistogram clears the value of the "passing_score_histogram" field.
+func (m *DrainedResultMutation) ClearPassingScoreHistogram() {
+	m.passing_score_histogram = nil
+	m.clearedFields[drainedresult.FieldPassingScoreHistogram] = struct{}{}
+}
+
+// PassingScoreHistogramCleared returns if the "passing_score_histogram" field was cleared in this mutation.
+func (m *DrainedResultMutation) PassingScoreHistogramCleared() bool {
+	_, ok := m.clearedFields[drainedresult.FieldPassingScoreHistogram]
+	return ok
+}
+
+// ResetPassingScoreHistogram resets all changes to the "passing_score_histogram" field.
+func (m *DrainedResultMutation) ResetPassingScoreHistogram() {
+	m.passing_score_histogram = nil
+	delete(m.clearedFields, drainedresult.FieldPassingScoreHistogram)
+}
+
+// SetLastAdmittedRatingPlaceHistogram sets the "last_admitted_rating_place_histogram" field.
+func (m *DrainedResultMutation) SetLastAdmittedRatingPlaceHistogram(cd *core.HistogramDTO) {
+	m.last_admitted_rating_place_histogram = &cd
+}
+
+// LastAdmittedRatingPlaceHistogram returns the value of the "last_admitted_rating_place_histogram" field in the mutation.
+func (m *DrainedResultMutation) LastAdmittedRatingPlaceHistogram() (r *core.HistogramDTO, exists bool) {
+	v := m.last_admitted_rating_place_histogram
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastAdmittedRatingPlaceHistogram returns the old "last_admitted_rating_place_histogram" field's value of the DrainedResult entity.
+// If the DrainedResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DrainedResultMutation) OldLastAdmittedRatingPlaceHistogram(ctx context.Context) (v *core.HistogramDTO, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastAdmittedRatingPlaceHistogram is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastAdmittedRatingPlaceHistogram requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastAdmittedRatingPlaceHistogram: %w", err)
+	}
+	return oldValue.LastAdmittedRatingPlaceHistogram, nil
+}
+
+// ClearLastAdmittedRatingPlaceHistogram clears the value of the "last_admitted_rating_place_histogram" field.
+func (m *DrainedResultMutation) ClearLastAdmittedRatingPlaceHistogram() {
+	m.last_admitted_rating_place_histogram = nil
+	m.clearedFields[drainedresult.FieldLastAdmittedRatingPlaceHistogram] = struct{}{}
+}
+
+// LastAdmittedRatingPlaceHistogramCleared returns if the "last_admitted_rating_place_histogram" field was cleared in this mutation.
+func (m *DrainedResultMutation) LastAdmittedRatingPlaceHistogramCleared() bool {
+	_, ok := m.clearedFields[drainedresult.FieldLastAdmittedRatingPlaceHistogram]
+	return ok
+}
+
+// ResetLastAdmittedRatingPlaceHistogram resets all changes to the "last_admitted_rating_place_histogram" field.
+func (m *DrainedResultMutation) ResetLastAdmittedRatingPlaceHistogram() {
+	m.last_admitted_rating_place_histogram = nil
+	delete(m.clearedFields, drainedresult.FieldLastAdmittedRatingPlaceHistogram)
+}
+
+// SetIterations sets the "iterations" field.
+func (m *DrainedResultMutation) SetIterations(i int) {
+	m.iterations = &i
+	m.additerations = nil
+}
+
+// Iterations returns the value of the "iterations" field in the mutation.
+func (m *DrainedResultMutation) Iterations() (r int, exists bool) {
+	v := m.iterations
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIterations returns the old "iterations" field's value of the DrainedResult entity.
+// If the DrainedResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DrainedResultMutation) OldIterations(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIterations is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIterations requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIterations: %w", err)
+	}
+	return oldValue.Iterations, nil
+}
+
+// AddIterations adds i to the "iterations" field.
+func (m *DrainedResultMutation) AddIterations(i int) {
+	if m.additerations != nil {
+		*m.additerations += i
+	} else {
+		m.additerations = &i
+	}
+}
+
+// AddedIterations returns the value that was added to the "iterations" field in this mutation.
+func (m *DrainedResultMutation) AddedIterations() (r int, exists bool) {
+	v := m.additerations
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearIterations clears the value of the "iterations" field.
+func (m *DrainedResultMutation) ClearIterations() {
+	m.iterations = nil
+	m.additerations = nil
+	m.clearedFields[drainedresult.FieldIterations] = struct{}{}
+}
+
+// IterationsCleared returns if the "iterations" field was cleared in this mutation.
+func (m *DrainedResultMutation) IterationsCleared() bool {
+	_, ok := m.clearedFields[drainedresult.FieldIterations]
+	return ok
+}
+
+// ResetIterations resets all changes to the "iterations" field.
+func (m *DrainedResultMutation) ResetIterations() {
+	m.iterations = nil
+	m.additerations = nil
+	delete(m.clearedFields, drainedresult.FieldIterations)
+}
+
+// SetHeadingID sets the "heading" edge to the Heading entity by id.
+func (m *DrainedResultMutation) SetHeadingID(id int) {
+	m.heading = &id
+}
+
+// ClearHeading clears the "heading" edge to the Heading entity.
+func (m *DrainedResultMutation) ClearHeading() {
+	m.clearedheading = true
+}
+
+// HeadingCleared reports if the "heading" edge to the Heading entity was cleared.
+func (m *DrainedResultMutation) HeadingCleared() bool {
+	return m.clearedheading
+}
+
+// HeadingID returns the "heading" edge ID in the mutation.
+func (m *DrainedResultMutation) HeadingID() (id int, exists bool) {
+	if m.heading != nil {
+		return *m.heading, true
+	}
+	return
+}
+
+// HeadingIDs returns the "heading" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// HeadingID instead. It exists only for internal usage by the builders.
+func (m *DrainedResultMutation) HeadingIDs() (ids []int) {
+	if id := m.heading; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetHeading resets all changes to the "heading" edge.
+func (m *DrainedResultMutation) ResetHeading() {
+	m.heading = nil
+	m.clearedheading = false
+}
+
+// ClearRun clears the "run" edge to the Run entity.
+func (m *DrainedResultMutation) ClearRun() {
+	m.clearedrun = true
+	m.clearedFields[drainedresult.FieldRunID] = struct{}{}
+}
+
+// RunCleared reports if the "run" edge to the Run entity was cleared.
+func (m *DrainedResultMutation) RunCleared() bool {
+	return m.clearedrun
+}
+
+// RunIDs returns the "run" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RunID instead. It exists only for internal usage by the builders.
+func (m *DrainedResultMutation) RunIDs() (ids []int) {
+	if id := m.run; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRun resets all changes to the "run" edge.
+func (m *DrainedResultMutation) ResetRun() {
+	m.run = nil
+	m.clearedrun = false
+}
+
+// Where appends a list predicates to the DrainedResultMutation builder.
+func (m *DrainedResultMutation) Where(ps ...predicate.DrainedResult) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DrainedResultMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DrainedResultMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DrainedResult, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DrainedResultMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DrainedResultMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DrainedResult).
+func (m *DrainedResultMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DrainedResultMutation) Fields() []string {
+	fields := make([]string, 0, 30)
+	if m.drained_percent != nil {
+		fields = append(fields, drainedresult.FieldDrainedPercent)
+	}
+	if m.avg_passing_score != nil {
+		fields = append(fields, drainedresult.FieldAvgPassingScore)
+	}
+	if m.min_passing_score != nil {
+		fields = append(fields, drainedresult.FieldMinPassingScore)
+	}
+	if m.max_passing_score != nil {
+		fields = append(fields, drainedresult.FieldMaxPassingScore)
+	}
+	if m.med_passing_score != nil {
+		fields = append(fields, drainedresult.FieldMedPassingScore)
+	}
+	if m.avg_last_admitted_rating_place != nil {
+		fields = append(fields, drainedresult.FieldAvgLastAdmittedRatingPlace)
+	}
+	if m.min_last_admitted_rating_place != nil {
+		fields = append(fields, drainedresult.FieldMinLastAdmittedRatingPlace)
+	}
+	if m.max_last_admitted_rating_place != nil {
+		fields = append(fields, drainedresult.FieldMaxLastAdmittedRatingPlace)
+	}
+	if m.med_last_admitted_rating_place != nil {
+		fields = append(fields, drainedresult.FieldMedLastAdmittedRatingPlace)
+	}
+	if m.run != nil {
+		fields = append(fields, drainedresult.FieldRunID)
+	}
+	if m.regulars_admitted != nil {
+		fields = append(fields, drainedresult.FieldRegularsAdmitted)
+	}
+	if m.is_virtual != nil {
+		fields = append(fields, drainedresult.FieldIsVirtual)
+	}
+	if m.seed != nil {
+		fields = append(fields, drainedresult.FieldSeed)
+	}
+	if m.drain_model != nil {
+		fields = append(fields, drainedresult.FieldDrainModel)
+	}
+	if m.avg_priority_stage_admitted != nil {
+		fields = append(fields, drainedresult.FieldAvgPriorityStageAdmitted)
+	}
+	if m.avg_main_stage_admitted != nil {
+		fields = append(fields, drainedresult.FieldAvgMainStageAdmitted)
+	}
+	if m.sub_quotas != nil {
+		fields = append(fields, drainedresult.FieldSubQuotas)
+	}
+	if m.avg_paid_passing_score != nil {
+		fields = append(fields, drainedresult.FieldAvgPaidPassingScore)
+	}
+	if m.avg_paid_last_admitted_rating_place != nil {
+		fields = append(fields, drainedresult.FieldAvgPaidLastAdmittedRatingPlace)
+	}
+	if m.p10_passing_score != nil {
+		fields = append(fields, drainedresult.FieldP10PassingScore)
+	}
+	if m.p25_passing_score != nil {
+		fields = append(fields, drainedresult.FieldP25PassingScore)
+	}
+	if m.p75_passing_score != nil {
+		fields = append(fields, drainedresult.FieldP75PassingScore)
+	}
+	if m.p90_passing_score != nil {
+		fields = append(fields, drainedresult.FieldP90PassingScore)
+	}
+	if m.p10_last_admitted_rating_place != nil {
+		fields = append(fields, drainedresult.FieldP10LastAdmittedRatingPlace)
+	}
+	if m.p25_last_admitted_rating_place != nil {
+		fields = append(fields, drainedresult.FieldP25LastAdmittedRatingPlace)
+	}
+	if m.p75_last_admitted_rating_place != nil {
+		fields = append(fields, drainedresult.FieldP75LastAdmittedRatingPlace)
+	}
+	if m.p90_last_admitted_rating_place != nil {
+		fields = append(fields, drainedresult.FieldP90LastAdmittedRatingPlace)
+	}
+	if m.passing_score_histogram != nil {
+		fields = append(fields, drainedresult.FieldPassingScoreHistogram)
+	}
+	if m.last_admitted_rating_place_histogram != nil {
+		fields = append(fields, drainedresult.FieldLastAdmittedRatingPlaceHistogram)
+	}
+	if m.iterations != nil {
+		fields = append(fields, drainedresult.FieldIterations)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DrainedResultMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case drainedresult.FieldDrainedPercent:
+		return m.DrainedPercent()
+	case drainedresult.FieldAvgPassingScore:
+		return m.AvgPassingScore()
+	case drainedresult.FieldMinPassingScore:
+		return m.MinPassingScore()
+	case drainedresult.FieldMaxPassingScore:
+		return m.MaxPassingScore()
+	case drainedresult.FieldMedPassingScore:
+		return m.MedPassingScore()
+	case drainedresult.FieldAvgLastAdmittedRatingPlace:
+		return m.AvgLastAdmittedRatingPlace()
+	case drainedresult.FieldMinLastAdmittedRatingPlace:
+		return m.MinLastAdmittedRatingPlace()
+	case drainedresult.FieldMaxLastAdmittedRatingPlace:
+		return m.MaxLastAdmittedRatingPlace()
+	case drainedresult.FieldMedLastAdmittedRatingPlace:
+		return m.MedLastAdmittedRatingPlace()
+	case drainedresult.FieldRunID:
+		return m.RunID()
+	case drainedresult.FieldRegularsAdmitted:
+		return m.RegularsAdmitted()
+	case drainedresult.FieldIsVirtual:
+		return m.IsVirtual()
+	case drainedresult.FieldSeed:
+		return m.Seed()
+	case drainedresult.FieldDrainModel:
+		return m.DrainModel()
+	case drainedresult.FieldAvgPriorityStageAdmitted:
+		return m.AvgPriorityStageAdmitted()
+	case drainedresult.FieldAvgMainStageAdmitted:
+		return m.AvgMainStageAdmitted()
+	case drainedresult.FieldSubQuotas:
+		return m.SubQuotas()
+	case drainedresult.FieldAvgPaidPassingScore:
+		return m.AvgPaidPassingScore()
+	case drainedresult.FieldAvgPaidLastAdmittedRatingPlace:
+		return m.AvgPaidLastAdmittedRatingPlace()
+	case drainedresult.FieldP10PassingScore:
+		return m.P10PassingScore()
+	case drainedresult.FieldP25PassingScore:
+		return m.P25PassingScore()
+	case drainedresult.FieldP75PassingScore:
+		return m.P75PassingScore()
+	case drainedresult.FieldP90PassingScore:
+		return m.P90PassingScore()
+	case drainedresult.FieldP10LastAdmittedRatingPlace:
+		return m.P10LastAdmittedRatingPlace()
+	case drainedresult.FieldP25LastAdmittedRatingPlace:
+		return m.P25LastAdmittedRatingPlace()
+	case drainedresult.FieldP75LastAdmittedRatingPlace:
+		return m.P75LastAdmittedRatingPlace()
+	case drainedresult.FieldP90LastAdmittedRatingPlace:
+		return m.P90LastAdmittedRatingPlace()
+	case drainedresult.FieldPassingScoreHistogram:
+		return m.PassingScoreHistogram()
+	case drainedresult.FieldLastAdmittedRatingPlaceHistogram:
+		return m.LastAdmittedRatingPlaceHistogram()
+	case drainedresult.FieldIterations:
+		return m.Iterations()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DrainedResultMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case drainedresult.FieldDrainedPercent:
+		return m.OldDrainedPercent(ctx)
+	case drainedresult.FieldAvgPassingScore:
+		return m.OldAvgPassingScore(ctx)
+	case drainedresult.FieldMinPassingScore:
+		return m.OldMinPassingScore(ctx)
+	case drainedresult.FieldMaxPassingScore:
+		return m.OldMaxPassingScore(ctx)
+	case drainedresult.FieldMedPassingScore:
+		return m.OldMedPassingScore(ctx)
+	case drainedresult.FieldAvgLastAdmittedRatingPlace:
+		return m.OldAvgLastAdmittedRatingPlace(ctx)
+	case drainedresult.FieldMinLastAdmittedRatingPlace:
+		return m.OldMinLastAdmittedRatingPlace(ctx)
+	case drainedresult.FieldMaxLastAdmittedRatingPlace:
+		return m.OldMaxLastAdmittedRatingPlace(ctx)
+	case drainedresult.FieldMedLastAdmittedRatingPlace:
+		return m.OldMedLastAdmittedRatingPlace(ctx)
+	case drainedresult.FieldRunID:
+		return m.OldRunID(ctx)
+	case drainedresult.FieldRegularsAdmitted:
+		return m.OldRegularsAdmitted(ctx)
+	case drainedresult.FieldIsVirtual:
+		return m.OldIsVirtual(ctx)
+	case drainedresult.FieldSeed:
+		return m.OldSeed(ctx)
+	case drainedresult.FieldDrainModel:
+		return m.OldDrainModel(ctx)
+	case drainedresult.FieldAvgPriorityStageAdmitted:
+		return m.OldAvgPriorityStageAdmitted(ctx)
+	case drainedresult.FieldAvgMainStageAdmitted:
+		return m.OldAvgMainStageAdmitted(ctx)
+	case drainedresult.FieldSubQuotas:
+		return m.OldSubQuotas(ctx)
+	case drainedresult.FieldAvgPaidPassingScore:
+		return m.OldAvgPaidPassingScore(ctx)
+	case drainedresult.FieldAvgPaidLastAdmittedRatingPlace:
+		return m.OldAvgPaidLastAdmittedRatingPlace(ctx)
+	case drainedresult.FieldP10PassingScore:
+		return m.OldP10PassingScore(ctx)
+	case drainedresult.FieldP25PassingScore:
+		return m.OldP25PassingScore(ctx)
+	case drainedresult.FieldP75PassingScore:
+		return m.OldP75PassingScore(ctx)
+	case drainedresult.FieldP90PassingScore:
+		return m.OldP90PassingScore(ctx)
+	case drainedresult.FieldP10LastAdmittedRatingPlace:
+		return m.OldP10LastAdmittedRatingPlace(ctx)
+	case drainedresult.FieldP25LastAdmittedRatingPlace:
+		return m.OldP25LastAdmittedRatingPlace(ctx)
+	case drainedresult.FieldP75LastAdmittedRatingPlace:
+		return m.OldP75LastAdmittedRatingPlace(ctx)
+	case drainedresult.FieldP90LastAdmittedRatingPlace:
+		return m.OldP90LastAdmittedRatingPlace(ctx)
+	case drainedresult.FieldPassingScoreHistogram:
+		return m.OldPassingScoreHistogram(ctx)
+	case drainedresult.FieldLastAdmittedRatingPlaceHistogram:
+		return m.OldLastAdmittedRatingPlaceHistogram(ctx)
+	case drainedresult.FieldIterations:
+		return m.OldIterations(ctx)
+	}
+	return nil, fmt.Errorf("unknown DrainedResult field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DrainedResultMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case drainedresult.FieldDrainedPercent:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDrainedPercent(v)
+		return nil
+	case drainedresult.FieldAvgPassingScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAvgPassingScore(v)
+		return nil
+	case drainedresult.FieldMinPassingScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMinPassingScore(v)
+		return nil
+	case drainedresult.FieldMaxPassingScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxPassingScore(v)
+		return nil
+	case drainedresult.FieldMedPassingScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMedPassingScore(v)
+		return nil
+	case drainedresult.FieldAvgLastAdmittedRatingPlace:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAvgLastAdmittedRatingPlace(v)
+		return nil
+	case drainedresult.FieldMinLastAdmittedRatingPlace:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMinLastAdmittedRatingPlace(v)
+		return nil
+	case drainedresult.FieldMaxLastAdmittedRatingPlace:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxLastAdmittedRatingPlace(v)
+		return nil
+	case drainedresult.FieldMedLastAdmittedRatingPlace:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMedLastAdmittedRatingPlace(v)
+		return nil
+	case drainedresult.FieldRunID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRunID(v)
+		return nil
+	case drainedresult.FieldRegularsAdmitted:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRegularsAdmitted(v)
+		return nil
+	case drainedresult.FieldIsVirtual:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsVirtual(v)
+		return nil
+	case drainedresult.FieldSeed:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeed(v)
+		return nil
+	case drainedresult.FieldDrainModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDrainModel(v)
+		return nil
+	case drainedresult.FieldAvgPriorityStageAdmitted:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAvgPriorityStageAdmitted(v)
+		return nil
+	case drainedresult.FieldAvgMainStageAdmitted:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAvgMainStageAdmitted(v)
+		return nil
+	case drainedresult.FieldSubQuotas:
+		v, ok := value.([]core.SubQuotaResultDTO)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubQuotas(v)
+		return nil
+	case drainedresult.FieldAvgPaidPassingScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAvgPaidPassingScore(v)
+		return nil
+	case drainedresult.FieldAvgPaidLastAdmittedRatingPlace:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAvgPaidLastAdmittedRatingPlace(v)
+		return nil
+	case drainedresult.FieldP10PassingScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetP10PassingScore(v)
+		return nil
+	case drainedresult.FieldP25PassingScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetP25PassingScore(v)
+		return nil
+	case drainedresult.FieldP75PassingScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetP75PassingScore(v)
+		return nil
+	case drainedresult.FieldP90PassingScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetP90PassingScore(v)
+		return nil
+	case drainedresult.FieldP10LastAdmittedRatingPlace:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetP10LastAdmittedRatingPlace(v)
+		return nil
+	case drainedresult.FieldP25LastAdmittedRatingPlace:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetP25LastAdmittedRatingPlace(v)
+		return nil
+	case drainedresult.FieldP75LastAdmittedRatingPlace:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetP75LastAdmittedRatingPlace(v)
+		return nil
+	case drainedresult.FieldP90LastAdmittedRatingPlace:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetP90LastAdmittedRatingPlace(v)
+		return nil
+	case drainedresult.FieldPassingScoreHistogram:
+		v, ok := value.(*core.HistogramDTO)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPassingScoreHistogram(v)
+		return nil
+	case drainedresult.FieldLastAdmittedRatingPlaceHistogram:
+		v, ok := value.(*core.HistogramDTO)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastAdmittedRatingPlaceHistogram(v)
+		return nil
+	case drainedresult.FieldIterations:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIterations(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DrainedResult field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DrainedResultMutation) AddedFields() []string {
+	var fields []string
+	if m.adddrained_percent != nil {
+		fields = append(fields, drainedresult.FieldDrainedPercent)
+	}
+	if m.addavg_passing_score != nil {
+		fields = append(fields, drainedresult.FieldAvgPassingScore)
+	}
+	if m.addmin_passing_score != nil {
+		fields = append(fields, drainedresult.FieldMinPassingScore)
+	}
+	if m.addmax_passing_score != nil {
+		fields = append(fields, drainedresult.FieldMaxPassingScore)
+	}
+	if m.addmed_passing_score != nil {
+		fields = append(fields, drainedresult.FieldMedPassingScore)
+	}
+	if m.addavg_last_admitted_rating_place != nil {
+		fields = append(fields, drainedresult.FieldAvgLastAdmittedRatingPlace)
+	}
+	if m.addmin_last_admitted_rating_place != nil {
+		fields = append(fields, drainedresult.FieldMinLastAdmittedRatingPlace)
+	}
+	if m.addmax_last_admitted_rating_place != nil {
+		fields = append(fields, drainedresult.FieldMaxLastAdmittedRatingPlace)
+	}
+	if m.addmed_last_admitted_rating_place != nil {
+		fields = append(fields, drainedresult.FieldMedLastAdmittedRatingPlace)
+	}
+	if m.addseed != nil {
+		fields = append(fields, drainedresult.FieldSeed)
+	}
+	if m.addavg_priority_stage_admitted != nil {
+		fields = append(fields, drainedresult.FieldAvgPriorityStageAdmitted)
+	}
+	if m.addavg_main_stage_admitted != nil {
+		fields = append(fields, drainedresult.FieldAvgMainStageAdmitted)
+	}
+	if m.addavg_paid_passing_score != nil {
+		fields = append(fields, drainedresult.FieldAvgPaidPassingScore)
+	}
+	if m.addavg_paid_last_admitted_rating_place != nil {
+		fields = append(fields, drainedresult.FieldAvgPaidLastAdmittedRatingPlace)
+	}
+	if m.addp10_passing_score != nil {
+		fields = append(fields, drainedresult.FieldP10PassingScore)
+	}
+	if m.addp25_passing_score != nil {
+		fields = append(fields, drainedresult.FieldP25PassingScore)
+	}
+	if m.addp75_passing_score != nil {
+		fields = append(fields, drainedresult.FieldP75PassingScore)
+	}
+	if m.addp90_passing_score != nil {
+		fields = append(fields, drainedresult.FieldP90PassingScore)
+	}
+	if m.addp10_last_admitted_rating_place != nil {
+		fields = append(fields, drainedresult.FieldP10LastAdmittedRatingPlace)
+	}
+	if m.addp25_last_admitted_rating_place != nil {
+		fields = append(fields, drainedresult.FieldP25LastAdmittedRatingPlace)
+	}
+	if m.addp75_last_admitted_rating_place != nil {
+		fields = append(fields, drainedresult.FieldP75LastAdmittedRatingPlace)
+	}
+	if m.addp90_last_admitted_rating_place != nil {
+		fields = append(fields, drainedresult.FieldP90LastAdmittedRatingPlace)
+	}
+	if m.additerations != nil {
+		fields = append(fields, drainedresult.FieldIterations)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DrainedResultMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case drainedresult.FieldDrainedPercent:
+		return m.AddedDrainedPercent()
+	case drainedresult.FieldAvgPassingScore:
+		return m.AddedAvgPassingScore()
+	case drainedresult.FieldMinPassingScore:
+		return m.AddedMinPassingScore()
+	case drainedresult.FieldMaxPassingScore:
+		return m.AddedMaxPassingScore()
+	case drainedresult.FieldMedPassingScore:
+		return m.AddedMedPassingScore()
+	case drainedresult.FieldAvgLastAdmittedRatingPlace:
+		return m.AddedAvgLastAdmittedRatingPlace()
+	case drainedresult.FieldMinLastAdmittedRatingPlace:
+		return m.AddedMinLastAdmittedRatingPlace()
+	case drainedresult.FieldMaxLastAdmittedRatingPlace:
+		return m.AddedMaxLastAdmittedRatingPlace()
+	case drainedresult.FieldMedLastAdmittedRatingPlace:
+		return m.AddedMedLastAdmittedRatingPlace()
+	case drainedresult.FieldSeed:
+		return m.AddedSeed()
+	case drainedresult.FieldAvgPriorityStageAdmitted:
+		return m.AddedAvgPriorityStageAdmitted()
+	case drainedresult.FieldAvgMainStageAdmitted:
+		return m.AddedAvgMainStageAdmitted()
+	case drainedresult.FieldAvgPaidPassingScore:
+		return m.AddedAvgPaidPassingScore()
+	case drainedresult.FieldAvgPaidLastAdmittedRatingPlace:
+		return m.AddedAvgPaidLastAdmittedRatingPlace()
+	case drainedresult.FieldP10PassingScore:
+		return m.AddedP10PassingScore()
+	case drainedresult.FieldP25PassingScore:
+		return m.AddedP25PassingScore()
+	case drainedresult.FieldP75PassingScore:
+		return m.AddedP75PassingScore()
+	case drainedresult.FieldP90PassingScore:
+		return m.AddedP90PassingScore()
+	case drainedresult.FieldP10LastAdmittedRatingPlace:
+		return m.AddedP10LastAdmittedRatingPlace()
+	case drainedresult.FieldP25LastAdmittedRatingPlace:
+		return m.AddedP25LastAdmittedRatingPlace()
+	case drainedresult.FieldP75LastAdmittedRatingPlace:
+		return m.AddedP75LastAdmittedRatingPlace()
+	case drainedresult.FieldP90LastAdmittedRatingPlace:
+		return m.AddedP90LastAdmittedRatingPlace()
+	case drainedresult.FieldIterations:
+		return m.AddedIterations()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DrainedResultMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case drainedresult.FieldDrainedPercent:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDrainedPercent(v)
+		return nil
+	case drainedresult.FieldAvgPassingScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAvgPassingScore(v)
+		return nil
+	case drainedresult.FieldMinPassingScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMinPassingScore(v)
+		return nil
+	case drainedresult.FieldMaxPassingScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxPassingScore(v)
+		return nil
+	case drainedresult.FieldMedPassingScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMedPassingScore(v)
+		return nil
+	case drainedresult.FieldAvgLastAdmittedRatingPlace:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAvgLastAdmittedRatingPlace(v)
+		return nil
+	case drainedresult.FieldMinLastAdmittedRatingPlace:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMinLastAdmittedRatingPlace(v)
+		return nil
+	case drainedresult.FieldMaxLastAdmittedRatingPlace:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxLastAdmittedRatingPlace(v)
+		return nil
+	case drainedresult.FieldMedLastAdmittedRatingPlace:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMedLastAdmittedRatingPlace(v)
+		return nil
+	case drainedresult.FieldSeed:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSeed(v)
+		return nil
+	case drainedresult.FieldAvgPriorityStageAdmitted:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAvgPriorityStageAdmitted(v)
+		return nil
+	case drainedresult.FieldAvgMainStageAdmitted:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAvgMainStageAdmitted(v)
+		return nil
+	case drainedresult.FieldAvgPaidPassingScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAvgPaidPassingScore(v)
+		return nil
+	case drainedresult.FieldAvgPaidLastAdmittedRatingPlace:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAvgPaidLastAdmittedRatingPlace(v)
+		return nil
+	case drainedresult.FieldP10PassingScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddP10PassingScore(v)
+		return nil
+	case drainedresult.FieldP25PassingScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddP25PassingScore(v)
+		return nil
+	case drainedresult.FieldP75PassingScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddP75PassingScore(v)
+		return nil
+	case drainedresult.FieldP90PassingScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddP90PassingScore(v)
+		return nil
+	case drainedresult.FieldP10LastAdmittedRatingPlace:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddP10LastAdmittedRatingPlace(v)
+		return nil
+	case drainedresult.FieldP25LastAdmittedRatingPlace:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddP25LastAdmittedRatingPlace(v)
+		return nil
+	case drainedresult.FieldP75LastAdmittedRatingPlace:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddP75LastAdmittedRatingPlace(v)
+		return nil
+	case drainedresult.FieldP90LastAdmittedRatingPlace:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddP90LastAdmittedRatingPlace(v)
+		return nil
+	case drainedresult.FieldIterations:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIterations(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DrainedResult numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DrainedResultMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(drainedresult.FieldSeed) {
+		fields = append(fields, drainedresult.FieldSeed)
+	}
+	if m.FieldCleared(drainedresult.FieldDrainModel) {
+		fields = append(fields, drainedresult.FieldDrainModel)
+	}
+	if m.FieldCleared(drainedresult.FieldAvgPriorityStageAdmitted) {
+		fields = append(fields, drainedresult.FieldAvgPriorityStageAdmitted)
+	}
+	if m.FieldCleared(drainedresult.FieldAvgMainStageAdmitted) {
+		fields = append(fields, drainedresult.FieldAvgMainStageAdmitted)
+	}
+	if m.FieldCleared(drainedresult.FieldSubQuotas) {
+		fields = append(fields, drainedresult.FieldSubQuotas)
+	}
+	if m.FieldCleared(drainedresult.FieldAvgPaidPassingScore) {
+		fields = append(fields, drainedresult.FieldAvgPaidPassingScore)
+	}
+	if m.FieldCleared(drainedresult.FieldAvgPaidLastAdmittedRatingPlace) {
+		fields = append(fields, drainedresult.FieldAvgPaidLastAdmittedRatingPlace)
+	}
+	if m.FieldCleared(drainedresult.FieldP10PassingScore) {
+		fields = append(fields, drainedresult.FieldP10PassingScore)
+	}
+	if m.FieldCleared(drainedresult.FieldP25PassingScore) {
+		fields = append(fields, drainedresult.FieldP25PassingScore)
+	}
+	if m.FieldCleared(drainedresult.FieldP75PassingScore) {
+		fields = append(fields, drainedresult.FieldP75PassingScore)
+	}
+	if m.FieldCleared(drainedresult.FieldP90PassingScore) {
+		fields = append(fields, drainedresult.FieldP90PassingScore)
+	}
+	if m.FieldCleared(drainedresult.FieldP10LastAdmittedRatingPlace) {
+		fields = append(fields, drainedresult.FieldP10LastAdmittedRatingPlace)
+	}
+	if m.FieldCleared(drainedresult.FieldP25LastAdmittedRatingPlace) {
+		fields = append(fields, drainedresult.FieldP25LastAdmittedRatingPlace)
+	}
+	if m.FieldCleared(drainedresult.FieldP75LastAdmittedRatingPlace) {
+		fields = append(fields, drainedresult.FieldP75LastAdmittedRatingPlace)
+	}
+	if m.FieldCleared(drainedresult.FieldP90LastAdmittedRatingPlace) {
+		fields = append(fields, drainedresult.FieldP90LastAdmittedRatingPlace)
+	}
+	if m.FieldCleared(drainedresult.FieldPassingScoreHistogram) {
+		fields = append(fields, drainedresult.FieldPassingScoreHistogram)
+	}
+	if m.FieldCleared(drainedresult.FieldLastAdmittedRatingPlaceHistogram) {
+		fields = append(fields, drainedresult.FieldLastAdmittedRatingPlaceHistogram)
+	}
+	if m.FieldCleared(drainedresult.FieldIterations) {
+		fields = append(fields, drainedresult.FieldIterations)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DrainedResultMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DrainedResultMutation) ClearField(name string) error {
+	switch name {
+	case drainedresult.FieldSeed:
+		m.ClearSeed()
+		return nil
+	case drainedresult.FieldDrainModel:
+		m.ClearDrainModel()
+		return nil
+	case drainedresult.FieldAvgPriorityStageAdmitted:
+		m.ClearAvgPriorityStageAdmitted()
+		return nil
+	case drainedresult.FieldAvgMainStageAdmitted:
+		m.ClearAvgMainStageAdmitted()
+		return nil
+	case drainedresult.FieldSubQuotas:
+		m.ClearSubQuotas()
+		return nil
+	case drainedresult.FieldAvgPaidPassingScore:
+		m.ClearAvgPaidPassingScore()
+		return nil
+	case drainedresult.FieldAvgPaidLastAdmittedRatingPlace:
+		m.ClearAvgPaidLastAdmittedRatingPlace()
+		return nil
+	case drainedresult.FieldP10PassingScore:
+		m.ClearP10PassingScore()
+		return nil
+	case drainedresult.FieldP25PassingScore:
+		m.ClearP25PassingScore()
+		return nil
+	case drainedresult.FieldP75PassingScore:
+		m.ClearP75PassingScore()
+		return nil
+	case drainedresult.FieldP90PassingScore:
+		m.ClearP90PassingScore()
+		return nil
+	case drainedresult.FieldP10LastAdmittedRatingPlace:
+		m.ClearP10LastAdmittedRatingPlace()
+		return nil
+	case drainedresult.FieldP25LastAdmittedRatingPlace:
+		m.ClearP25LastAdmittedRatingPlace()
+		return nil
+	case drainedresult.FieldP75LastAdmittedRatingPlace:
+		m.ClearP75LastAdmittedRatingPlace()
+		return nil
+	case drainedresult.FieldP90LastAdmittedRatingPlace:
+		m.ClearP90LastAdmittedRatingPlace()
+		return nil
+	case drainedresult.FieldPassingScoreHistogram:
+		m.ClearPassingScoreHistogram()
+		return nil
+	case drainedresult.FieldLastAdmittedRatingPlaceHistogram:
+		m.ClearLastAdmittedRatingPlaceHistogram()
+		return nil
+	case drainedresult.FieldIterations:
+		m.ClearIterations()
+		return nil
+	}
+	return fmt.Errorf("unknown DrainedResult nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DrainedResultMutation) ResetField(name string) error {
+	switch name {
+	case drainedresult.FieldDrainedPercent:
+		m.ResetDrainedPercent()
+		return nil
+	case drainedresult.FieldAvgPassingScore:
+		m.ResetAvgPassingScore()
+		return nil
+	case drainedresult.FieldMinPassingScore:
+		m.ResetMinPassingScore()
+		return nil
+	case drainedresult.FieldMaxPassingScore:
+		m.ResetMaxPassingScore()
+		return nil
+	case drainedresult.FieldMedPassingScore:
+		m.ResetMedPassingScore()
+		return nil
+	case drainedresult.FieldAvgLastAdmittedRatingPlace:
+		m.ResetAvgLastAdmittedRatingPlace()
+		return nil
+	case drainedresult.FieldMinLastAdmittedRatingPlace:
+		m.ResetMinLastAdmittedRatingPlace()
+		return nil
+	case drainedresult.FieldMaxLastAdmittedRatingPlace:
+		m.ResetMaxLastAdmittedRatingPlace()
+		return nil
+	case drainedresult.FieldMedLastAdmittedRatingPlace:
+		m.ResetMedLastAdmittedRatingPlace()
+		return nil
+	case drainedresult.FieldRunID:
+		m.ResetRunID()
+		return nil
+	case drainedresult.FieldRegularsAdmitted:
+		m.ResetRegularsAdmitted()
+		return nil
+	case drainedresult.FieldIsVirtual:
+		m.ResetIsVirtual()
+		return nil
+	case drainedresult.FieldSeed:
+		m.ResetSeed()
+		return nil
+	case drainedresult.FieldDrainModel:
+		m.ResetDrainModel()
+		return nil
+	case drainedresult.FieldAvgPriorityStageAdmitted:
+		m.ResetAvgPriorityStageAdmitted()
+		return nil
+	case drainedresult.FieldAvgMainStageAdmitted:
+		m.ResetAvgMainStageAdmitted()
+		return nil
+	case drainedresult.FieldSubQuotas:
+		m.ResetSubQuotas()
+		return nil
+	case drainedresult.FieldAvgPaidPassingScore:
+		m.ResetAvgPaidPassingScore()
+		return nil
+	case drainedresult.FieldAvgPaidLastAdmittedRatingPlace:
+		m.ResetAvgPaidLastAdmittedRatingPlace()
+		return nil
+	case drainedresult.FieldP10PassingScore:
+		m.ResetP10PassingScore()
+		return nil
+	case drainedresult.FieldP25PassingScore:
+		m.ResetP25PassingScore()
+		return nil
+	case drainedresult.FieldP75PassingScore:
+		m.ResetP75PassingScore()
+		return nil
+	case drainedresult.FieldP90PassingScore:
+		m.ResetP90PassingScore()
+		return nil
+	case drainedresult.FieldP10LastAdmittedRatingPlace:
+		m.ResetP10LastAdmittedRatingPlace()
+		return nil
+	case drainedresult.FieldP25LastAdmittedRatingPlace:
+		m.ResetP25LastAdmittedRatingPlace()
+		return nil
+	case drainedresult.FieldP75LastAdmittedRatingPlace:
+		m.ResetP75LastAdmittedRatingPlace()
+		return nil
+	case drainedresult.FieldP90LastAdmittedRatingPlace:
+		m.ResetP90LastAdmittedRatingPlace()
+		return nil
+	case drainedresult.FieldPassingScoreHistogram:
+		m.ResetPassingScoreHistogram()
+		return nil
+	case drainedresult.FieldLastAdmittedRatingPlaceHistogram:
+		m.ResetLastAdmittedRatingPlaceHistogram()
+		return nil
+	case drainedresult.FieldIterations:
+		m.ResetIterations()
+		return nil
+	}
+	return fmt.Errorf("unknown DrainedResult field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DrainedResultMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.heading != nil {
+		edges = append(edges, drainedresult.EdgeHeading)
+	}
+	if m.run != nil {
+		edges = append(edges, drainedresult.EdgeRun)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DrainedResultMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case drainedresult.EdgeHeading:
+		if id := m.heading; id != nil {
+			return []ent.Value{*id}
+		}
+	case drainedresult.EdgeRun:
+		if id := m.run; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DrainedResultMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DrainedResultMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DrainedResultMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedheading {
+		edges = append(edges, drainedresult.EdgeHeading)
+	}
+	if m.clearedrun {
+		edges = append(edges, drainedresult.EdgeRun)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DrainedResultMutation) EdgeCleared(name string) bool {
+	switch name {
+	case drainedresult.EdgeHeading:
+		return m.clearedheading
+	case drainedresult.EdgeRun:
+		return m.clearedrun
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DrainedResultMutation) ClearEdge(name string) error {
+	switch name {
+	case drainedresult.EdgeHeading:
+		m.ClearHeading()
+		return nil
+	case drainedresult.EdgeRun:
+		m.ClearRun()
+		return nil
+	}
+	return fmt.Errorf("unknown DrainedResult unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DrainedResultMutation) ResetEdge(name string) error {
+	switch name {
+	case drainedresult.EdgeHeading:
+		m.ResetHeading()
+		return nil
+	case drainedresult.EdgeRun:
+		m.ResetRun()
+		return nil
+	}
+	return fmt.Errorf("unknown DrainedResult edge %s", name)
+}
+
+// HeadingMutation represents an operation that mutates the Heading nodes in the graph.
+type HeadingMutation struct {
+	config
+	op                          Op
+	typ                         string
+	id                          *int
+	regular_capacity            *int
+	addregular_capacity         *int
+	target_quota_capacity       *int
+	addtarget_quota_capacity    *int
+	dedicated_quota_capacity    *int
+	adddedicated_quota_capacity *int
+	special_quota_capacity      *int
+	addspecial_quota_capacity   *int
+	code                        *string
+	name                        *string
+	subjects                    *[]string
+	appendsubjects              []string
+	target_sub_quotas           *map[string]int
+	paid_capacity               *int
+	addpaid_capacity            *int
+	direction_code              *string
+	level                       *string
+	study_form                  *string
+	exams                       *[]core.EntranceExam
+	appendexams                 []core.EntranceExam
+	clearedFields               map[string]struct{}
+	varsity                     *int
+	clearedvarsity              bool
+	applications                map[int]struct{}
+	removedapplications         map[int]struct{}
+	clearedapplications         bool
+	calculations                map[int]struct{}
+	removedcalculations         map[int]struct{}
+	clearedcalculations         bool
+	drained_results             map[int]struct{}
+	removeddrained_results      map[int]struct{}
+	cleareddrained_results      bool
+	admission_chances           map[int]struct{}
+	removedadmission_chances    map[int]struct{}
+	clearedadmission_chances    bool
+	admission_events            map[int]struct{}
+	removedadmission_events     map[int]struct{}
+	clearedadmission_events     bool
+	done                        bool
+	oldValue                    func(context.Context) (*Heading, error)
+	predicates                  []predicate.Heading
+}
+
+var _ ent.Mutation = (*HeadingMutation)(nil)
+
+// headingOption allows management of the mutation configuration using functional options.
+type headingOption func(*HeadingMutation)
+
+// newHeadingMutation creates new mutation for the Heading entity.
+func newHeadingMutation(c config, op Op, opts ...headingOption) *HeadingMutation {
+	m := &HeadingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeHeading,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withHeadingID sets the ID field of the mutation.
+func withHeadingID(id int) headingOption {
+	return func(m *HeadingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Heading
+		)
+		m.oldValue = func(ctx context.Context) (*Heading, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Heading.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withHeading sets the old Heading of the mutation.
+func withHeading(node *Heading) headingOption {
+	return func(m *HeadingMutation) {
+		m.oldValue = func(context.Context) (*Heading, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m HeadingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m HeadingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *HeadingMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *HeadingMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Heading.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRegularCapacity sets the "regular_capacity" field.
+func (m *HeadingMutation) SetRegularCapacity(i int) {
+	m.regular_capacity = &i
+	m.addregular_capacity = nil
+}
+
+// RegularCapacity returns the value of the "regular_capacity" field in the mutation.
+func (m *HeadingMutation) RegularCapacity() (r int, exists bool) {
+	v := m.regular_capacity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRegularCapacity returns the old "regular_capacity" field's value of the Heading entity.
+// If the Heading object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HeadingMutation) OldRegularCapacity(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRegularCapacity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRegularCapacity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRegularCapacity: %w", err)
+	}
+	return oldValue.RegularCapacity, nil
+}
+
+// AddRegularCapacity adds i to the "regular_capacity" field.
+func (m *HeadingMutation) AddRegularCapacity(i int) {
+	if m.addregular_capacity != nil {
+		*m.addregular_capacity += i
+	} else {
+		m.addregular_capacity = &i
+	}
+}
+
+// AddedRegularCapacity returns the value that was added to the "regular_capacity" field in this mutation.
+func (m *HeadingMutation) AddedRegularCapacity() (r int, exists bool) {
+	v := m.addregular_capacity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRegularCapacity resets all changes to the "regular_capacity" field.
+func (m *HeadingMutation) ResetRegularCapacity() {
+	m.regular_capacity = nil
+	m.addregular_capacity = nil
+}
+
+// SetTargetQuotaCapacity sets the "target_quota_capacity" field.
+func (m *HeadingMutation) SetTargetQuotaCapacity(i int) {
+	m.target_quota_capacity = &i
+	m.addtarget_quota_capacity = nil
+}
+
+// TargetQuotaCapacity returns the value of the "target_quota_capacity" field in the mutation.
+func (m *HeadingMutation) TargetQuotaCapacity() (r int, exists bool) {
+	v := m.target_quota_capacity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetQuotaCapacity returns the old "target_quota_capacity" field's value of the Heading entity.
+// If the Heading object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HeadingMutation) OldTargetQuotaCapacity(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetQuotaCapacity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetQuotaCapacity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetQuotaCapacity: %w", err)
+	}
+	return oldValue.TargetQuotaCapacity, nil
+}
+
+// AddTargetQuotaCapacity adds i to the "target_quota_capacity" field.
+func (m *HeadingMutation) AddTargetQuotaCapacity(i int) {
+	if m.addtarget_quota_capacity != nil {
+		*m.addtarget_quota_capacity += i
+	} else {
+		m.addtarget_quota_capacity = &i
+	}
+}
+
+// AddedTargetQuotaCapacity returns the value that was added to the "target_quota_capacity" field in this mutation.
+func (m *HeadingMutation) AddedTargetQuotaCapacity() (r int, exists bool) {
+	v := m.addtarget_quota_capacity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTargetQuotaCapacity resets all changes to the "target_quota_capacity" field.
+func (m *HeadingMutation) ResetTargetQuotaCapacity() {
+	m.target_quota_capacity = nil
+	m.addtarget_quota_capacity = nil
+}
+
+// SetDedicatedQuotaCapacity sets the "dedicated_quota_capacity" field.
+func (m *HeadingMutation) SetDedicatedQuotaCapacity(i int) {
+	m.dedicated_quota_capacity = &i
+	m.adddedicated_quota_capacity = nil
+}
+
+// DedicatedQuotaCapacity returns the value of the "dedicated_quota_capacity" field in the mutation.
+func (m *HeadingMutation) DedicatedQuotaCapacity() (r int, exists bool) {
+	v := m.dedicated_quota_capacity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDedicatedQuotaCapacity returns the old "dedicated_quota_capacity" field's value of the Heading entity.
+// If the Heading object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HeadingMutation) OldDedicatedQuotaCapacity(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDedicatedQuotaCapacity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDedicatedQuotaCapacity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDedicatedQuotaCapacity: %w", err)
+	}
+	return oldValue.DedicatedQuotaCapacity, nil
+}
+
+// AddDedicatedQuotaCapacity adds i to the "dedicated_quota_capacity" field.
+func (m *HeadingMutation) AddDedicatedQuotaCapacity(i int) {
+	if m.adddedicated_quota_capacity != nil {
+		*m.adddedicated_quota_capacity += i
+	} else {
+		m.adddedicated_quota_capacity = &i
+	}
+}
+
+// AddedDedicatedQuotaCapacity returns the value that was added to the "dedicated_quota_capacity" field in this mutation.
+func (m *HeadingMutation) AddedDedicatedQuotaCapacity() (r int, exists bool) {
+	v := m.adddedicated_quota_capacity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDedicatedQuotaCapacity resets all changes to the "dedicated_quota_capacity" field.
+func (m *HeadingMutation) ResetDedicatedQuotaCapacity() {
+	m.dedicated_quota_capacity = nil
+	m.adddedicated_quota_capacity = nil
+}
+
+// SetSpecialQuotaCapacity sets the "special_quota_capacity" field.
+func (m *HeadingMutation) SetSpecialQuotaCapacity(i int) {
+	m.special_quota_capacity = &i
+	m.addspecial_quota_capacity = nil
+}
+
+// SpecialQuotaCapacity returns the value of the "special_quota_capacity" field in the mutation.
+func (m *HeadingMutation) SpecialQuotaCapacity() (r int, exists bool) {
+	v := m.special_quota_capacity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSpecialQuotaCapacity returns the old "special_quota_capacity" field's value of the Heading entity.
+// If the Heading object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HeadingMutation) OldSpecialQuotaCapacity(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSpecialQuotaCapacity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSpecialQuotaCapacity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSpecialQuotaCapacity: %w", err)
+	}
+	return oldValue.SpecialQuotaCapacity, nil
+}
+
+// AddSpecialQuotaCapacity adds i to the "special_quota_capacity" field.
+func (m *HeadingMutation) AddSpecialQuotaCapacity(i int) {
+	if m.addspecial_quota_capacity != nil {
+		*m.addspecial_quota_capacity += i
+	} else {
+		m.addspecial_quota_capacity = &i
+	}
+}
+
+// AddedSpecialQuotaCapacity returns the value that was added to the "special_quota_capacity" field in this mutation.
+func (m *HeadingMutation) AddedSpecialQuotaCapacity() (r int, exists bool) {
+	v := m.addspecial_quota_capacity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSpecialQuotaCapacity resets all changes to the "special_quota_capacity" field.
+func (m *HeadingMutation) ResetSpecialQuotaCapacity() {
+	m.special_quota_capacity = nil
+	m.addspecial_quota_capacity = nil
+}
+
+// SetCode sets the "code" field.
+func (m *HeadingMutation) SetCode(s string) {
+	m.code = &s
+}
+
+// Code returns the value of the "code" field in the mutation.
+func (m *HeadingMutation) Code() (r string, exists bool) {
+	v := m.code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCode returns the old "code" field's value of the Heading entity.
+// If the Heading object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HeadingMutation) OldCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCode: %w", err)
+	}
+	return oldValue.Code, nil
+}
+
+// ResetCode resets all changes to the "code" field.
+func (m *HeadingMutation) ResetCode() {
+	m.code = nil
+}
+
+// SetName sets the "name" field.
+func (m *HeadingMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *HeadingMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Heading entity.
+// If the Heading object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HeadingMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *HeadingMutation) ResetName() {
+	m.name = nil
+}
+
+// SetSubjects sets the "subjects" field.
+func (m *HeadingMutation) SetSubjects(s []string) {
+	m.subjects = &s
+	m.appendsubjects = nil
+}
+
+// Subjects returns the value of the "subjects" field in the mutation.
+func (m *HeadingMutation) Subjects() (r []string, exists bool) {
+	v := m.subjects
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubjects returns the old "subjects" field's value of the Heading entity.
+// If the Heading object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HeadingMutation) OldSubjects(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubjects is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubjects requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubjects: %w", err)
+	}
+	return oldValue.Subjects, nil
+}
+
+// AppendSubjects adds s to the "subjects" field.
+func (m *HeadingMutation) AppendSubjects(s []string) {
+	m.appendsubjects = append(m.appendsubjects, s...)
+}
+
+// AppendedSubjects returns the list of values that were appended to the "subjects" field in this mutation.
+func (m *HeadingMutation) AppendedSubjects() ([]string, bool) {
+	if len(m.appendsubjects) == 0 {
+		return nil, false
+	}
+	return m.appendsubjects, true
+}
+
+// ClearSubjects clears the value of the "subjects" field.
+func (m *HeadingMutation) ClearSubjects() {
+	m.subjects = nil
+	m.appendsubjects = nil
+	m.clearedFields[heading.FieldSubjects] = struct{}{}
+}
+
+// SubjectsCleared returns if the "subjects" field was cleared in this mutation.
+func (m *HeadingMutation) SubjectsCleared() bool {
+	_, ok := m.clearedFields[heading.FieldSubjects]
+	return ok
+}
+
+// ResetSubjects resets all changes to the "subjects" field.
+func (m *HeadingMutation) ResetSubjects() {
+	m.subjects = nil
+	m.appendsubjects = nil
+	delete(m.clearedFields, heading.FieldSubjects)
+}
+
+// SetTargetSubQuotas sets the "target_sub_quotas" field.
+func (m *HeadingMutation) SetTargetSubQuotas(value map[string]int) {
+	m.target_sub_quotas = &value
+}
+
+// TargetSubQuotas returns the value of the "target_sub_quotas" field in the mutation.
+func (m *HeadingMutation) TargetSubQuotas() (r map[string]int, exists bool) {
+	v := m.target_sub_quotas
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetSubQuotas returns the old "target_sub_quotas" field's value of the Heading entity.
+// If the Heading object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HeadingMutation) OldTargetSubQuotas(ctx context.Context) (v map[string]int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetSubQuotas is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetSubQuotas requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetSubQuotas: %w", err)
+	}
+	return oldValue.TargetSubQuotas, nil
+}
+
+// ClearTargetSubQuotas clears the value of the "target_sub_quotas" field.
+func (m *HeadingMutation) ClearTargetSubQuotas() {
+	m.target_sub_quotas = nil
+	m.clearedFields[heading.FieldTargetSubQuotas] = struct{}{}
+}
+
+// TargetSubQuotasCleared returns if the "target_sub_quotas" field was cleared in this mutation.
+func (m *HeadingMutation) TargetSubQuotasCleared() bool {
+	_, ok := m.clearedFields[heading.FieldTargetSubQuotas]
+	return ok
+}
+
+// ResetTargetSubQuotas resets all changes to the "target_sub_quotas" field.
+func (m *HeadingMutation) ResetTargetSubQuotas() {
+	m.target_sub_quotas = nil
+	delete(m.clearedFields, heading.FieldTargetSubQuotas)
+}
+
+// SetPaidCapacity sets the "paid_capacity" field.
+func (m *HeadingMutation) SetPaidCapacity(i int) {
+	m.paid_capacity = &i
+	m.addpaid_capacity = nil
+}
+
+// PaidCapacity returns the value of the "paid_capacity" field in the mutation.
+func (m *HeadingMutation) PaidCapacity() (r int, exists bool) {
+	v := m.paid_capacity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPaidCapacity returns the old "paid_capacity" field's value of the Heading entity.
+// If the Heading object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HeadingMutation) OldPaidCapacity(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPaidCapacity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPaidCapacity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPaidCapacity: %w", err)
+	}
+	return oldValue.PaidCapacity, nil
+}
+
+// AddPaidCapacity adds i to the "paid_capacity" field.
+func (m *HeadingMutation) AddPaidCapacity(i int) {
+	if m.addpaid_capacity != nil {
+		*m.addpaid_capacity += i
+	} else {
+		m.addpaid_capacity = &i
+	}
+}
+
+// AddedPaidCapacity returns the value that was added to the "paid_capacity" field in this mutation.
+func (m *HeadingMutation) AddedPaidCapacity() (r int, exists bool) {
+	v := m.addpaid_capacity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPaidCapacity resets all changes to the "paid_capacity" field.
+func (m *HeadingMutation) ResetPaidCapacity() {
+	m.paid_capacity = nil
+	m.addpaid_capacity = nil
+}
+
+// SetDirectionCode sets the "direction_code" field.
+func (m *HeadingMutation) SetDirectionCode(s string) {
+	m.direction_code = &s
+}
+
+// DirectionCode returns the value of the "direction_code" field in the mutation.
+func (m *HeadingMutation) DirectionCode() (r string, exists bool) {
+	v := m.direction_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDirectionCode returns the old "direction_code" field's value of the Heading entity.
+// If the Heading object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HeadingMutation) OldDirectionCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDirectionCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDirectionCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDirectionCode: %w", err)
+	}
+	return oldValue.DirectionCode, nil
+}
+
+// ResetDirectionCode resets all changes to the "direction_code" field.
+func (m *HeadingMutation) ResetDirectionCode() {
+	m.direction_code = nil
+}
+
+// SetLevel sets the "level" field.
+func (m *HeadingMutation) SetLevel(s string) {
+	m.level = &s
+}
+
+// Level returns the value of the "level" field in the mutation.
+func (m *HeadingMutation) Level() (r string, exists bool) {
+	v := m.level
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLevel returns the old "level" field's value of the Heading entity.
+// If the Heading object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HeadingMutation) OldLevel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLevel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLevel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLevel: %w", err)
+	}
+	return oldValue.Level, nil
+}
+
+// ResetLevel resets all changes to the "level" field.
+func (m *HeadingMutation) ResetLevel() {
+	m.level = nil
+}
+
+// SetStudyForm sets the "study_form" field.
+func (m *HeadingMutation) SetStudyForm(s string) {
+	m.study_form = &s
+}
+
+// StudyForm returns the value of the "study_form" field in the mutation.
+func (m *HeadingMutation) StudyForm() (r string, exists bool) {
+	v := m.study_form
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStudyForm returns the old "study_form" field's value of the Heading entity.
+// If the Heading object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HeadingMutation) OldStudyForm(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStudyForm is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStudyForm requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStudyForm: %w", err)
+	}
+	return oldValue.StudyForm, nil
+}
+
+// ResetStudyForm resets all changes to the "study_form" field.
+func (m *HeadingMutation) ResetStudyForm() {
+	m.study_form = nil
+}
+
+// SetExams sets the "exams" field.
+func (m *HeadingMutation) SetExams(ce []core.EntranceExam) {
+	m.exams = &ce
+	m.appendexams = nil
+}
+
+// Exams returns the value of the "exams" field in the mutation.
+func (m *HeadingMutation) Exams() (r []core.EntranceExam, exists bool) {
+	v := m.exams
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExams returns the old "exams" field's value of the Heading entity.
+// If the Heading object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *HeadingMutation) OldExams(ctx context.Context) (v []core.EntranceExam, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExams is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExams requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExams: %w", err)
+	}
+	return oldValue.Exams, nil
+}
+
+// AppendExams adds ce to the "exams" field.
+func (m *HeadingMutation) AppendExams(ce []core.EntranceExam) {
+	m.appendexams = append(m.appendexams, ce...)
+}
+
+// AppendedExams returns the list of values that were appended to the "exams" field in this mutation.
+func (m *HeadingMutation) AppendedExams() ([]core.EntranceExam, bool) {
+	if len(m.appendexams) == 0 {
+		return nil, false
+	}
+	return m.appendexams, true
+}
+
+// ClearExams clears the value of the "exams" field.
+func (m *HeadingMutation) ClearExams() {
+	m.exams = nil
+	m.appendexams = nil
+	m.clearedFields[heading.FieldExams] = struct{}{}
+}
+
+// ExamsCleared returns if the "exams" field was cleared in this mutation.
+func (m *HeadingMutation) ExamsCleared() bool {
+	_, ok := m.clearedFields[heading.FieldExams]
+	return ok
+}
+
+// ResetExams resets all changes to the "exams" field.
+func (m *HeadingMutation) ResetExams() {
+	m.exams = nil
+	m.appendexams = nil
+	delete(m.clearedFields, heading.FieldExams)
+}
+
+// SetVarsityID sets the "varsity" edge to the Varsity entity by id.
+func (m *HeadingMutation) SetVarsityID(id int) {
+	m.varsity = &id
+}
+
+// ClearVarsity clears the "varsity" edge to the Varsity entity.
+func (m *HeadingMutation) ClearVarsity() {
+	m.clearedvarsity = true
+}
+
+// VarsityCleared reports if the "varsity" edge to the Varsity entity was cleared.
+func (m *HeadingMutation) VarsityCleared() bool {
+	return m.clearedvarsity
+}
+
+// VarsityID returns the "varsity" edge ID in the mutation.
+func (m *HeadingMutation) VarsityID() (id int, exists bool) {
+	if m.varsity != nil {
+		return *m.varsity, true
+	}
+	return
+}
+
+// VarsityIDs returns the "varsity" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// VarsityID instead. It exists only for internal usage by the builders.
+func (m *HeadingMutation) VarsityIDs() (ids []int) {
+	if id := m.varsity; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetVarsity resets all changes to the "varsity" edge.
+func (m *HeadingMutation) ResetVarsity() {
+	m.varsity = nil
+	m.clearedvarsity = false
+}
+
+// AddApplicationIDs adds the "applications" edge to the Application entity by ids.
+func (m *HeadingMutation) AddApplicationIDs(ids ...int) {
+	if m.applications == nil {
+		m.applications = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.applications[ids[i]] = struct{}{}
+	}
+}
+
+// ClearApplications clears the "applications" edge to the Application entity.
+func (m *HeadingMutation) ClearApplications() {
+	m.clearedapplications = true
+}
+
+// ApplicationsCleared reports if the "applications" edge to the Application entity was cleared.
+func (m *HeadingMutation) ApplicationsCleared() bool {
+	return m.clearedapplications
+}
+
+// RemoveApplicationIDs removes the "applications" edge to the Application entity by IDs.
+func (m *HeadingMutation) RemoveApplicationIDs(ids ...int) {
+	if m.removedapplications == nil {
+		m.removedapplications = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.applications, ids[i])
+		m.removedapplications[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedApplications returns the removed IDs of the "applications" edge to the Application entity.
+func (m *HeadingMutation) RemovedApplicationsIDs() (ids []int) {
+	for id := range m.removedapplications {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ApplicationsIDs returns the "applications" edge IDs in the mutation.
+func (m *HeadingMutation) ApplicationsIDs() (ids []int) {
+	for id := range m.applications {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetApplications resets all changes to the "applications" edge.
+func (m *HeadingMutation) ResetApplications() {
+	m.applications = nil
+	m.clearedapplications = false
+	m.removedapplications = nil
+}
+
+// AddCalculationIDs adds the "calculations" edge to the Calculation entity by ids.
+func (m *HeadingMutation) AddCalculationIDs(ids ...int) {
+	if m.calculations == nil {
+		m.calculations = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.calculations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCalculations clears the "calculations" edge to the Calculation entity.
+func (m *HeadingMutation) ClearCalculations() {
+	m.clearedcalculations = true
+}
+
+// CalculationsCleared reports if the "calculations" edge to the Calculation entity was cleared.
+func (m *HeadingMutation) CalculationsCleared() bool {
+	return m.clearedcalculations
+}
+
+// RemoveCalculationIDs removes the "calculations" edge to the Calculation entity by IDs.
+func (m *HeadingMutation) RemoveCalculationIDs(ids ...int) {
+	if m.removedcalculations == nil {
+		m.removedcalculations = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.calculations, ids[i])
+		m.removedcalculations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCalculations returns the removed IDs of the "calculations" edge to the Calculation entity.
+func (m *HeadingMutation) RemovedCalculationsIDs() (ids []int) {
+	for id := range m.removedcalculations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CalculationsIDs returns the "calculations" edge IDs in the mutation.
+func (m *HeadingMutation) CalculationsIDs() (ids []int) {
+	for id := range m.calculations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCalculations resets all changes to the "calculations" edge.
+func (m *HeadingMutation) ResetCalculations() {
+	m.calculations = nil
+	m.clearedcalculations = false
+	m.removedcalculations = nil
+}
+
+// AddDrainedResultIDs adds the "drained_results" edge to the DrainedResult entity by ids.
+func (m *HeadingMutation) AddDrainedResultIDs(ids ...int) {
+	if m.drained_results == nil {
+		m.drained_results = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.drained_results[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDrainedResults clears the "drained_results" edge to the DrainedResult entity.
+func (m *HeadingMutation) ClearDrainedResults() {
+	m.cleareddrained_results = true
+}
+
+// DrainedResultsCleared reports if the "drained_results" edge to the DrainedResult entity was cleared.
+func (m *HeadingMutation) DrainedResultsCleared() bool {
+	return m.cleareddrained_results
+}
+
+// RemoveDrainedResultIDs removes the "drained_results" edge to the DrainedResult entity by IDs.
+func (m *HeadingMutation) RemoveDrainedResultIDs(ids ...int) {
+	if m.removeddrained_results == nil {
+		m.removeddrained_results = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.drained_results, ids[i])
+		m.removeddrained_results[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDrainedResults returns the removed IDs of the "drained_results" edge to the DrainedResult entity.
+func (m *HeadingMutation) RemovedDrainedResultsIDs() (ids []int) {
+	for id := range m.removeddrained_results {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DrainedResultsIDs returns the "drained_results" edge IDs in the mutation.
+func (m *HeadingMutation) DrainedResultsIDs() (ids []int) {
+	for id := range m.drained_results {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDrainedResults resets all changes to the "drained_results" edge.
+func (m *HeadingMutation) ResetDrainedResults() {
+	m.drained_results = nil
+	m.cleareddrained_results = false
+	m.removeddrained_results = nil
+}
+
+// AddAdmissionChanceIDs adds the "admission_chances" edge to the AdmissionChance entity by ids.
+func (m *HeadingMutation) AddAdmissionChanceIDs(ids ...int) {
+	if m.admission_chances == nil {
+		m.admission_chances = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.admission_chances[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAdmissionChances clears the "admission_chances" edge to the AdmissionChance entity.
+func (m *HeadingMutation) ClearAdmissionChances() {
+	m.clearedadmission_chances = true
+}
+
+// AdmissionChancesCleared reports if the "admission_chances" edge to the AdmissionChance entity was cleared.
+func (m *HeadingMutation) AdmissionChancesCleared() bool {
+	return m.clearedadmission_chances
+}
+
+// RemoveAdmissionChanceIDs removes the "admission_chances" edge to the AdmissionChance entity by IDs.
+func (m *HeadingMutation) RemoveAdmissionChanceIDs(ids ...int) {
+	if m.removedadmission_chances == nil {
+		m.removedadmission_chances = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.admission_chances, ids[i])
+		m.removedadmission_chances[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAdmissionChances returns the removed IDs of the "admission_chances" edge to the AdmissionChance entity.
+func (m *HeadingMutation) RemovedAdmissionChancesIDs() (ids []int) {
+	for id := range m.removedadmission_chances {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AdmissionChancesIDs returns the "admission_chances" edge IDs in the mutation.
+func (m *HeadingMutation) AdmissionChancesIDs() (ids []int) {
+	for id := range m.admission_chances {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAdmissionChances resets all changes to the "admission_chances" edge.
+func (m *HeadingMutation) ResetAdmissionChances() {
+	m.admission_chances = nil
+	m.clearedadmission_chances = false
+	m.removedadmission_chances = nil
+}
+
+// AddAdmissionEventIDs adds the "admission_events" edge to the AdmissionEvent entity by ids.
+func (m *HeadingMutation) AddAdmissionEventIDs(ids ...int) {
+	if m.admission_events == nil {
+		m.admission_events = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.admission_events[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAdmissionEvents clears the "admission_events" edge to the AdmissionEvent entity.
+func (m *HeadingMutation) ClearAdmissionEvents() {
+	m.clearedadmission_events = true
+}
+
+// AdmissionEventsCleared reports if the "admission_events" edge to the AdmissionEvent entity was cleared.
+func (m *HeadingMutation) AdmissionEventsCleared() bool {
+	return m.clearedadmission_events
+}
+
+// RemoveAdmissionEventIDs removes the "admission_events" edge to the AdmissionEvent entity by IDs.
+func (m *HeadingMutation) RemoveAdmissionEventIDs(ids ...int) {
+	if m.removedadmission_events == nil {
+		m.removedadmission_events = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.admission_events, ids[i])
+		m.removedadmission_events[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAdmissionEvents returns the removed IDs of the "admission_events" edge to the AdmissionEvent entity.
+func (m *HeadingMutation) RemovedAdmissionEventsIDs() (ids []int) {
+	for id := range m.removedadmission_events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AdmissionEventsIDs returns the "admission_events" edge IDs in the mutation.
+func (m *HeadingMutation) AdmissionEventsIDs() (ids []int) {
+	for id := range m.admission_events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAdmissionEvents resets all changes to the "admission_events" edge.
+func (m *HeadingMutation) ResetAdmissionEvents() {
+	m.admission_events = nil
+	m.clearedadmission_events = false
+	m.removedadmission_events = nil
+}
+
+// Where appends a list predicates to the HeadingMutation builder.
+func (m *HeadingMutation) Where(ps ...predicate.Heading) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the HeadingMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *HeadingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Heading, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *HeadingMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *HeadingMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Heading).
+func (m *HeadingMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *HeadingMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.regular_capacity != nil {
+		fields = append(fields, heading.FieldRegularCapacity)
+	}
+	if m.target_quota_capacity != nil {
+		fields = append(fields, heading.FieldTargetQuotaCapacity)
+	}
+	if m.dedicated_quota_capacity != nil {
+		fields = append(fields, heading.FieldDedicatedQuotaCapacity)
+	}
+	if m.special_quota_capacity != nil {
+		fields = append(fields, heading.FieldSpecialQuotaCapacity)
+	}
+	if m.code != nil {
+		fields = append(fields, heading.FieldCode)
+	}
+	if m.name != nil {
+		fields = append(fields, heading.FieldName)
+	}
+	if m.subjects != nil {
+		fields = append(fields, heading.FieldSubjects)
+	}
+	if m.target_sub_quotas != nil {
+		fields = append(fields, heading.FieldTargetSubQuotas)
+	}
+	if m.paid_capacity != nil {
+		fields = append(fields, heading.FieldPaidCapacity)
+	}
+	if m.direction_code != nil {
+		fields = append(fields, heading.FieldDirectionCode)
+	}
+	if m.level != nil {
+		fields = append(fields, heading.FieldLevel)
+	}
+	if m.study_form != nil {
+		fields = append(fields, heading.FieldStudyForm)
+	}
+	if m.exams != nil {
+		fields = append(fields, heading.FieldExams)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *HeadingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case heading.FieldRegularCapacity:
+		return m.RegularCapacity()
+	case heading.FieldTargetQuotaCapacity:
+		return m.TargetQuotaCapacity()
+	case heading.FieldDedicatedQuotaCapacity:
+		return m.DedicatedQuotaCapacity()
+	case heading.FieldSpecialQuotaCapacity:
+		return m.SpecialQuotaCapacity()
+	case heading.FieldCode:
+		return m.Code()
+	case heading.FieldName:
+		return m.Name()
+	case heading.FieldSubjects:
+		return m.Subjects()
+	case heading.FieldTargetSubQuotas:
+		return m.TargetSubQuotas()
+	case heading.FieldPaidCapacity:
+		return m.PaidCapacity()
+	case heading.FieldDirectionCode:
+		return m.DirectionCode()
+	case heading.FieldLevel:
+		return m.Level()
+	case heading.FieldStudyForm:
+		return m.StudyForm()
+	case heading.FieldExams:
+		return m.Exams()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *HeadingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case heading.FieldRegularCapacity:
+		return m.OldRegularCapacity(ctx)
+	case heading.FieldTargetQuotaCapacity:
+		return m.OldTargetQuotaCapacity(ctx)
+	case heading.FieldDedicatedQuotaCapacity:
+		return m.OldDedicatedQuotaCapacity(ctx)
+	case heading.FieldSpecialQuotaCapacity:
+		return m.OldSpecialQuotaCapacity(ctx)
+	case heading.FieldCode:
+		return m.OldCode(ctx)
+	case heading.FieldName:
+		return m.OldName(ctx)
+	case heading.FieldSubjects:
+		return m.OldSubjects(ctx)
+	case heading.FieldTargetSubQuotas:
+		return m.OldTargetSubQuotas(ctx)
+	case heading.FieldPaidCapacity:
+		return m.OldPaidCapacity(ctx)
+	case heading.FieldDirectionCode:
+		return m.OldDirectionCode(ctx)
+	case heading.FieldLevel:
+		return m.OldLevel(ctx)
+	case heading.FieldStudyForm:
+		return m.OldStudyForm(ctx)
+	case heading.FieldExams:
+		return m.OldExams(ctx)
+	}
+	return nil, fmt.Errorf("unknown Heading field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HeadingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case heading.FieldRegularCapacity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRegularCapacity(v)
+		return nil
+	case heading.FieldTargetQuotaCapacity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetQuotaCapacity(v)
+		return nil
+	case heading.FieldDedicatedQuotaCapacity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDedicatedQuotaCapacity(v)
+		return nil
+	case heading.FieldSpecialQuotaCapacity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpecialQuotaCapacity(v)
+		return nil
+	case heading.FieldCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCode(v)
+		return nil
+	case heading.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case heading.FieldSubjects:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubjects(v)
+		return nil
+	case heading.FieldTargetSubQuotas:
+		v, ok := value.(map[string]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetSubQuotas(v)
+		return nil
+	case heading.FieldPaidCapacity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPaidCapacity(v)
+		return nil
+	case heading.FieldDirectionCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDirectionCode(v)
+		return nil
+	case heading.FieldLevel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLevel(v)
+		return nil
+	case heading.FieldStudyForm:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStudyForm(v)
+		return nil
+	case heading.FieldExams:
+		v, ok := value.([]core.EntranceExam)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExams(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Heading field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *HeadingMutation) AddedFields() []string {
+	var fields []string
+	if m.addregular_capacity != nil {
+		fields = append(fields, heading.FieldRegularCapacity)
+	}
+	if m.addtarget_quota_capacity != nil {
+		fields = append(fields, heading.FieldTargetQuotaCapacity)
+	}
+	if m.adddedicated_quota_capacity != nil {
+		fields = append(fields, heading.FieldDedicatedQuotaCapacity)
+	}
+	if m.addspecial_quota_capacity != nil {
+		fields = append(fields, heading.FieldSpecialQuotaCapacity)
+	}
+	if m.addpaid_capacity != nil {
+		fields = append(fields, heading.FieldPaidCapacity)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *HeadingMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case heading.FieldRegularCapacity:
+		return m.AddedRegularCapacity()
+	case heading.FieldTargetQuotaCapacity:
+		return m.AddedTargetQuotaCapacity()
+	case heading.FieldDedicatedQuotaCapacity:
+		return m.AddedDedicatedQuotaCapacity()
+	case heading.FieldSpecialQuotaCapacity:
+		return m.AddedSpecialQuotaCapacity()
+	case heading.FieldPaidCapacity:
+		return m.AddedPaidCapacity()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *HeadingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case heading.FieldRegularCapacity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRegularCapacity(v)
+		return nil
+	case heading.FieldTargetQuotaCapacity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTargetQuotaCapacity(v)
+		return nil
+	case heading.FieldDedicatedQuotaCapacity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDedicatedQuotaCapacity(v)
+		return nil
+	case heading.FieldSpecialQuotaCapacity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSpecialQuotaCapacity(v)
+		return nil
+	case heading.FieldPaidCapacity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPaidCapacity(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Heading numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *HeadingMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(heading.FieldSubjects) {
+		fields = append(fields, heading.FieldSubjects)
+	}
+	if m.FieldCleared(heading.FieldTargetSubQuotas) {
+		fields = append(fields, heading.FieldTargetSubQuotas)
+	}
+	if m.FieldCleared(heading.FieldExams) {
+		fields = append(fields, heading.FieldExams)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *HeadingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *HeadingMutation) ClearField(name string) error {
+	switch name {
+	case heading.FieldSubjects:
+		m.ClearSubjects()
+		return nil
+	case heading.FieldTargetSubQuotas:
+		m.ClearTargetSubQuotas()
+		return nil
+	case heading.FieldExams:
+		m.ClearExams()
+		return nil
+	}
+	return fmt.Errorf("unknown Heading nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *HeadingMutation) ResetField(name string) error {
+	switch name {
+	case heading.FieldRegularCapacity:
+		m.ResetRegularCapacity()
+		return nil
+	case heading.FieldTargetQuotaCapacity:
+		m.ResetTargetQuotaCapacity()
+		return nil
+	case heading.FieldDedicatedQuotaCapacity:
+		m.ResetDedicatedQuotaCapacity()
+		return nil
+	case heading.FieldSpecialQuotaCapacity:
+		m.ResetSpecialQuotaCapacity()
+		return nil
+	case heading.FieldCode:
+		m.ResetCode()
+		return nil
+	case heading.FieldName:
+		m.ResetName()
+		return nil
+	case heading.FieldSubjects:
+		m.ResetSubjects()
+		return nil
+	case heading.FieldTargetSubQuotas:
+		m.ResetTargetSubQuotas()
+		return nil
+	case heading.FieldPaidCapacity:
+		m.ResetPaidCapacity()
+		return nil
+	case heading.FieldDirectionCode:
+		m.ResetDirectionCode()
+		return nil
+	case heading.FieldLevel:
+		m.ResetLevel()
+		return nil
+	case heading.FieldStudyForm:
+		m.ResetStudyForm()
+		return nil
+	case heading.FieldExams:
+		m.ResetExams()
+		return nil
+	}
+	return fmt.Errorf("unknown Heading field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *HeadingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.varsity != nil {
+		edges = append(edges, heading.EdgeVarsity)
+	}
+	if m.applications != nil {
+		edges = append(edges, heading.EdgeApplications)
+	}
+	if m.calculations != nil {
+		edges = append(edges, heading.EdgeCalculations)
+	}
+	if m.drained_results != nil {
+		edges = append(edges, heading.EdgeDrainedResults)
+	}
+	if m.admission_chances != nil {
+		edges = append(edges, heading.EdgeAdmissionChances)
+	}
+	if m.admission_events != nil {
+		edges = append(edges, heading.EdgeAdmissionEvents)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *HeadingMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case heading.EdgeVarsity:
+		if id := m.varsity; id != nil {
+			return []ent.Value{*id}
+		}
+	case heading.EdgeApplications:
+		ids := make([]ent.Value, 0, len(m.applications))
+		for id := range m.applications {
+			ids = append(ids, id)
+		}
+		return ids
+	case heading.EdgeCalculations:
+		ids := make([]ent.Value, 0, len(m.calculations))
+		for id := range m.calculations {
+			ids = append(ids, id)
+		}
+		return ids
+	case heading.EdgeDrainedResults:
+		ids := make([]ent.Value, 0, len(m.drained_results))
+		for id := range m.drained_results {
+			ids = append(ids, id)
+		}
+		return ids
+	case heading.EdgeAdmissionChances:
+		ids := make([]ent.Value, 0, len(m.admission_chances))
+		for id := range m.admission_chances {
+			ids = append(ids, id)
+		}
+		return ids
+	case heading.EdgeAdmissionEvents:
+		ids := make([]ent.Value, 0, len(m.admission_events))
+		for id := range m.admission_events {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *HeadingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.removedapplications != nil {
+		edges = append(edges, heading.EdgeApplications)
+	}
+	if m.removedcalculations != nil {
+		edges = append(edges, heading.EdgeCalculations)
+	}
+	if m.removeddrained_results != nil {
+		edges = append(edges, heading.EdgeDrainedResults)
+	}
+	if m.removedadmission_chances != nil {
+		edges = append(edges, heading.EdgeAdmissionChances)
+	}
+	if m.removedadmission_events != nil {
+		edges = append(edges, heading.EdgeAdmissionEvents)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *HeadingMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case heading.EdgeApplications:
+		ids := make([]ent.Value, 0, len(m.removedapplications))
+		for id := range m.removedapplications {
+			ids = append(ids, id)
+		}
+		return ids
+	case heading.EdgeCalculations:
+		ids := make([]ent.Value, 0, len(m.removedcalculations))
+		for id := range m.removedcalculations {
+			ids = append(ids, id)
+		}
+		return ids
+	case heading.EdgeDrainedResults:
+		ids := make([]ent.Value, 0, len(m.removeddrained_results))
+		for id := range m.removeddrained_results {
+			ids = append(ids, id)
+		}
+		return ids
+	case heading.EdgeAdmissionChances:
+		ids := make([]ent.Value, 0, len(m.removedadmission_chances))
+		for id := range m.removedadmission_chances {
+			ids = append(ids, id)
+		}
+		return ids
+	case heading.EdgeAdmissionEvents:
+		ids := make([]ent.Value, 0, len(m.removedadmission_events))
+		for id := range m.removedadmission_events {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *HeadingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.clearedvarsity {
+		edges = append(edges, heading.EdgeVarsity)
+	}
+	if m.clearedapplications {
+		edges = append(edges, heading.EdgeApplications)
+	}
+	if m.clearedcalculations {
+		edges = append(edges, heading.EdgeCalculations)
+	}
+	if m.cleareddrained_results {
+		edges = append(edges, heading.EdgeDrainedResults)
+	}
+	if m.clearedadmission_chances {
+		edges = append(edges, heading.EdgeAdmissionChances)
+	}
+	if m.clearedadmission_events {
+		edges = append(edges, heading.EdgeAdmissionEvents)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *HeadingMutation) EdgeCleared(name string) bool {
+	switch name {
+	case heading.EdgeVarsity:
+		return m.clearedvarsity
+	case heading.EdgeApplications:
+		return m.clearedapplications
+	case heading.EdgeCalculations:
+		return m.clearedcalculations
+	case heading.EdgeDrainedResults:
+		return m.cleareddrained_results
+	case heading.EdgeAdmissionChances:
+		return m.clearedadmission_chances
+	case heading.EdgeAdmissionEvents:
+		return m.clearedadmission_events
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *HeadingMutation) ClearEdge(name string) error {
+	switch name {
+	case heading.EdgeVarsity:
+		m.ClearVarsity()
+		return nil
+	}
+	return fmt.Errorf("unknown Heading unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *HeadingMutation) ResetEdge(name string) error {
+	switch name {
+	case heading.EdgeVarsity:
+		m.ResetVarsity()
+		return nil
+	case heading.EdgeApplications:
+		m.ResetApplications()
+		return nil
+	case heading.EdgeCalculations:
+		m.ResetCalculations()
+		return nil
+	case heading.EdgeDrainedResults:
+		m.ResetDrainedResults()
+		return nil
+	case heading.EdgeAdmissionChances:
+		m.ResetAdmissionChances()
+		return nil
+	case heading.EdgeAdmissionEvents:
+		m.ResetAdmissionEvents()
+		return nil
+	}
+	return fmt.Errorf("unknown Heading edge %s", name)
+}
+
+// RunMutation represents an operation that mutates the Run nodes in the graph.
+type RunMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	triggered_at  *time.Time
+	payload_meta  *map[string]interface{}
+	finished      *bool
+	finished_at   *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Run, error)
+	predicates    []predicate.Run
+}
+
+var _ ent.Mutation = (*RunMutation)(nil)
+
+// runOption allows management of the mutation configuration using functional options.
+type runOption func(*RunMutation)
+
+// newRunMutation creates new mutation for the Run entity.
+func newRunMutation(c config, op Op, opts ...runOption) *RunMutation {
+	m := &RunMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRun,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRunID sets the ID field of the mutation.
+func withRunID(id int) runOption {
+	return func(m *RunMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Run
+		)
+		m.oldValue = func(ctx context.Context) (*Run, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Run.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRun sets the old Run of the mutation.
+func withRun(node *Run) runOption {
+	return func(m *RunMutation) {
+		m.oldValue = func(context.Context) (*Run, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RunMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RunMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RunMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RunMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Run.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTriggeredAt sets the "triggered_at" field.
+func (m *RunMutation) SetTriggeredAt(t time.Time) {
+	m.triggered_at = &t
+}
+
+// TriggeredAt returns the value of the "triggered_at" field in the mutation.
+func (m *RunMutation) TriggeredAt() (r time.Time, exists bool) {
+	v := m.triggered_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTriggeredAt returns the old "triggered_at" field's value of the Run entity.
+// If the Run object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RunMutation) OldTriggeredAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTriggeredAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTriggeredAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTriggeredAt: %w", err)
+	}
+	return oldValue.TriggeredAt, nil
+}
+
+// ResetTriggeredAt resets all changes to the "triggered_at" field.
+func (m *RunMutation) ResetTriggeredAt() {
+	m.triggered_at = nil
+}
+
+// SetPayloadMeta sets the "payload_meta" field.
+func (m *RunMutation) SetPayloadMeta(value map[string]interface{}) {
+	m.payload_meta = &value
+}
+
+// PayloadMeta returns the value of the "payload_meta" field in the mutation.
+func (m *RunMutation) PayloadMeta() (r map[string]interface{}, exists bool) {
+	v := m.payload_meta
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayloadMeta returns the old "payload_meta" field's value of the Run entity.
+// If the Run object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RunMutation) OldPayloadMeta(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayloadMeta is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayloadMeta requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayloadMeta: %w", err)
+	}
+	return oldValue.PayloadMeta, nil
+}
+
+// ClearPayloadMeta clears the value of the "payload_meta" field.
+func (m *RunMutation) ClearPayloadMeta() {
+	m.payload_meta = nil
+	m.clearedFields[run.FieldPayloadMeta] = struct{}{}
+}
+
+// PayloadMetaCleared returns if the "payload_meta" field was cleared in this mutation.
+func (m *RunMutation) PayloadMetaCleared() bool {
+	_, ok := m.clearedFields[run.FieldPayloadMeta]
+	return ok
+}
+
+// ResetPayloadMeta resets all changes to the "payload_meta" field.
+func (m *RunMutation) ResetPayloadMeta() {
+	m.payload_meta = nil
+	delete(m.clearedFields, run.FieldPayloadMeta)
+}
+
+// SetFinished sets the "finished" field.
+func (m *RunMutation) SetFinished(b bool) {
+	m.finished = &b
+}
+
+// Finished returns the value of the "finished" field in the mutation.
+func (m *RunMutation) Finished() (r bool, exists bool) {
+	v := m.finished
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFinished returns the old "finished" field's value of the Run entity.
+// If the Run object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RunMutation) OldFinished(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFinished is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFinished requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinished: %w", err)
+	}
+	return oldValue.Finished, nil
+}
+
+// ResetFinished resets all changes to the "finished" field.
+func (m *RunMutation) ResetFinished() {
+	m.finished = nil
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (m *RunMutation) SetFinishedAt(t time.Time) {
+	m.finished_at = &t
+}
+
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *RunMutation) FinishedAt() (r time.Time, exists bool) {
+	v := m.finished_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFinishedAt returns the old "finished_at" field's value of the Run entity.
+// If the Run object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RunMutation) OldFinishedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
+	}
+	return oldValue.FinishedAt, nil
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (m *RunMutation) ClearFinishedAt() {
+	m.finished_at = nil
+	m.clearedFields[run.FieldFinishedAt] = struct{}{}
+}
+
+// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
+func (m *RunMutation) FinishedAtCleared() bool {
+	_, ok := m.clearedFields[run.FieldFinishedAt]
+	return ok
+}
+
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *RunMutation) ResetFinishedAt() {
+	m.finished_at = nil
+	delete(m.clearedFields, run.FieldFinishedAt)
+}
+
+// Where appends a list predicates to the RunMutation builder.
+func (m *RunMutation) Where(ps ...predicate.Run) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RunMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RunMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Run, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RunMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RunMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Run).
+func (m *RunMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RunMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.triggered_at != nil {
+		fields = append(fields, run.FieldTriggeredAt)
+	}
+	if m.payload_meta != nil {
+		fields = append(fields, run.FieldPayloadMeta)
+	}
+	if m.finished != nil {
+		fields = append(fields, run.FieldFinished)
+	}
+	if m.finished_at != nil {
+		fields = append(fields, run.FieldFinishedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RunMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case run.FieldTriggeredAt:
+		return m.TriggeredAt()
+	case run.FieldPayloadMeta:
+		return m.PayloadMeta()
+	case run.FieldFinished:
+		return m.Finished()
+	case run.FieldFinishedAt:
+		return m.FinishedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RunMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case run.FieldTriggeredAt:
+		return m.OldTriggeredAt(ctx)
+	case run.FieldPayloadMeta:
+		return m.OldPayloadMeta(ctx)
+	case run.FieldFinished:
+		return m.OldFinished(ctx)
+	case run.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Run field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RunMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case run.FieldTriggeredAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTriggeredAt(v)
+		return nil
+	case run.FieldPayloadMeta:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayloadMeta(v)
+		return nil
+	case run.FieldFinished:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinished(v)
+		return nil
+	case run.FieldFinishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Run field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RunMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RunMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RunMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Run numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RunMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(run.FieldPayloadMeta) {
+		fields = append(fields, run.FieldPayloadMeta)
+	}
+	if m.FieldCleared(run.FieldFinishedAt) {
+		fields = append(fields, run.FieldFinishedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RunMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RunMutation) ClearField(name string) error {
+	switch name {
+	case run.FieldPayloadMeta:
+		m.ClearPayloadMeta()
+		return nil
+	case run.FieldFinishedAt:
+		m.ClearFinishedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Run nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RunMutation) ResetField(name string) error {
+	switch name {
+	case run.FieldTriggeredAt:
+		m.ResetTriggeredAt()
+		return nil
+	case run.FieldPayloadMeta:
+		m.ResetPayloadMeta()
+		return nil
+	case run.FieldFinished:
+		m.ResetFinished()
+		return nil
+	case run.FieldFinishedAt:
+		m.ResetFinishedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Run field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RunMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RunMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RunMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RunMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RunMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RunMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RunMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Run unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RunMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Run edge %s", name)
+}
+
+// SourceReportMutation represents an operation that mutates the SourceReport nodes in the graph.
+type SourceReportMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	varsity_code        *string
+	source              *string
+	name                *string
+	url                 *string
+	heading_codes       *[]string
+	appendheading_codes []string
+	status              *core.SourceLoadStatus
+	error               *string
+	started_at          *time.Time
+	duration_ms         *int64
+	addduration_ms      *int64
+	retries             *int
+	addretries          *int
+	headings            *int
+	addheadings         *int
+	applications        *int
+	addapplications     *int
+	skipped_rows        *int
+	addskipped_rows     *int
+	failed_lists        *int
+	addfailed_lists     *int
+	clearedFields       map[string]struct{}
+	run                 *int
+	clearedrun          bool
+	done                bool
+	oldValue            func(context.Context) (*SourceReport, error)
+	predicates          []predicate.SourceReport
+}
+
+var _ ent.Mutation = (*SourceReportMutation)(nil)
+
+// sourcereportOption allows management of the mutation configuration using functional options.
+type sourcereportOption func(*SourceReportMutation)
+
+// newSourceReportMutation creates new mutation for the SourceReport entity.
+func newSourceReportMutation(c config, op Op, opts ...sourcereportOption) *SourceReportMutation {
+	m := &SourceReportMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSourceReport,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSourceReportID sets the ID field of the mutation.
+func withSourceReportID(id int) sourcereportOption {
+	return func(m *SourceReportMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SourceReport
+		)
+		m.oldValue = func(ctx context.Context) (*SourceReport, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SourceReport.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSourceReport sets the old SourceReport of the mutation.
+func withSourceReport(node *SourceReport) sourcereportOption {
+	return func(m *SourceReportMutation) {
+		m.oldValue = func(context.Context) (*SourceReport, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SourceReportMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SourceReportMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SourceReportMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SourceReportMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SourceReport.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetVarsityCode sets the "varsity_code" field.
+func (m *SourceReportMutation) SetVarsityCode(s string) {
+	m.varsity_code = &s
+}
+
+// VarsityCode returns the value of the "varsity_code" field in the mutation.
+func (m *SourceReportMutation) VarsityCode() (r string, exists bool) {
+	v := m.varsity_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVarsityCode returns the old "varsity_code" field's value of the SourceReport entity.
+// If the SourceReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceReportMutation) OldVarsityCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVarsityCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVarsityCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVarsityCode: %w", err)
+	}
+	return oldValue.VarsityCode, nil
+}
+
+// ResetVarsityCode resets all changes to the "varsity_code" field.
+func (m *SourceReportMutation) ResetVarsityCode() {
+	m.varsity_code = nil
+}
+
+// SetSource sets the "source" field.
+func (m *SourceReportMutation) SetSource(s string) {
+	m.source = &s
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *SourceReportMutation) Source() (r string, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the SourceReport entity.
+// If the SourceReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceReportMutation) OldSource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *SourceReportMutation) ResetSource() {
+	m.source = nil
+}
+
+// SetName sets the "name" field.
+func (m *SourceReportMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *SourceReportMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the SourceReport entity.
+// If the SourceReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceReportMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ClearName clears the value of the "name" field.
+func (m *SourceReportMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[sourcereport.FieldName] = struct{}{}
+}
+
+// NameCleared returns if the "name" field was cleared in this mutation.
+func (m *SourceReportMutation) NameCleared() bool {
+	_, ok := m.clearedFields[sourcereport.FieldName]
+	return ok
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *SourceReportMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, sourcereport.FieldName)
+}
+
+// SetURL sets the "url" field.
+func (m *SourceReportMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *SourceReportMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the SourceReport entity.
+// If the SourceReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceReportMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ClearURL clears the value of the "url" field.
+func (m *SourceReportMutation) ClearURL() {
+	m.url = nil
+	m.clearedFields[sourcereport.FieldURL] = struct{}{}
+}
+
+// URLCleared returns if the "url" field was cleared in this mutation.
+func (m *SourceReportMutation) URLCleared() bool {
+	_, ok := m.clearedFields[sourcereport.FieldURL]
+	return ok
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *SourceReportMutation) ResetURL() {
+	m.url = nil
+	delete(m.clearedFields, sourcereport.FieldURL)
+}
+
+// SetHeadingCodes sets the "heading_codes" field.
+func (m *SourceReportMutation) SetHeadingCodes(s []string) {
+	m.heading_codes = &s
+	m.appendheading_codes = nil
+}
+
+// HeadingCodes returns the value of the "heading_codes" field in the mutation.
+func (m *SourceReportMutation) HeadingCodes() (r []string, exists bool) {
+	v := m.heading_codes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHeadingCodes returns the old "heading_codes" field's value of the SourceReport entity.
+// If the SourceReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceReportMutation) OldHeadingCodes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeadingCodes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeadingCodes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeadingCodes: %w", err)
+	}
+	return oldValue.HeadingCodes, nil
+}
+
+// AppendHeadingCodes adds s to the "heading_codes" field.
+func (m *SourceReportMutation) AppendHeadingCodes(s []string) {
+	m.appendheading_codes = append(m.appendheading_codes, s...)
+}
+
+// AppendedHeadingCodes returns the list of values that were appended to the "heading_codes" field in this mutation.
+func (m *SourceReportMutation) AppendedHeadingCodes() ([]string, bool) {
+	if len(m.appendheading_codes) == 0 {
+		return nil, false
+	}
+	return m.appendheading_codes, true
+}
+
+// ClearHeadingCodes clears the value of the "heading_codes" field.
+func (m *SourceReportMutation) ClearHeadingCodes() {
+	m.heading_codes = nil
+	m.appendheading_codes = nil
+	m.clearedFields[sourcereport.FieldHeadingCodes] = struct{}{}
+}
+
+// HeadingCodesCleared returns if the "heading_codes" field was cleared in this mutation.
+func (m *SourceReportMutation) HeadingCodesCleared() bool {
+	_, ok := m.clearedFields[sourcereport.FieldHeadingCodes]
+	return ok
+}
+
+// ResetHeadingCodes resets all changes to the "heading_codes" field.
+func (m *SourceReportMutation) ResetHeadingCodes() {
+	m.heading_codes = nil
+	m.appendheading_codes = nil
+	delete(m.clearedFields, sourcereport.FieldHeadingCodes)
+}
+
+// SetStatus sets the "status" field.
+func (m *SourceReportMutation) SetStatus(cls core.SourceLoadStatus) {
+	m.status = &cls
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *SourceReportMutation) Status() (r core.SourceLoadStatus, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the SourceReport entity.
+// If the SourceReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceReportMutation) OldStatus(ctx context.Context) (v core.SourceLoadStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *SourceReportMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetError sets the "error" field.
+func (m *SourceReportMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *SourceReportMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the SourceReport entity.
+// If the SourceReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceReportMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *SourceReportMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[sourcereport.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *SourceReportMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[sourcereport.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *SourceReportMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, sourcereport.FieldError)
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *SourceReportMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *SourceReportMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the SourceReport entity.
+// If the SourceReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceReportMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *SourceReportMutation) ResetStartedAt() {
+	m.started_at = nil
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (m *SourceReportMutation) SetDurationMs(i int64) {
+	m.duration_ms = &i
+	m.addduration_ms = nil
+}
+
+// DurationMs returns the value of the "duration_ms" field in the mutation.
+func (m *SourceReportMutation) DurationMs() (r int64, exists bool) {
+	v := m.duration_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDurationMs returns the old "duration_ms" field's value of the SourceReport entity.
+// If the SourceReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceReportMutation) OldDurationMs(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDurationMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDurationMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDurationMs: %w", err)
+	}
+	return oldValue.DurationMs, nil
+}
+
+// AddDurationMs adds i to the "duration_ms" field.
+func (m *SourceReportMutation) AddDurationMs(i int64) {
+	if m.addduration_ms != nil {
+		*m.addduration_ms += i
+	} else {
+		m.addduration_ms = &i
+	}
+}
+
+// AddedDurationMs returns the value that was added to the "duration_ms" field in this mutation.
+func (m *SourceReportMutation) AddedDurationMs() (r int64, exists bool) {
+	v := m.addduration_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDurationMs resets all changes to the "duration_ms" field.
+func (m *SourceReportMutation) ResetDurationMs() {
+	m.duration_ms = nil
+	m.addduration_ms = nil
+}
+
+// SetRetries sets the "retries" field.
+func (m *SourceReportMutation) SetRetries(i int) {
+	m.retries = &i
+	m.addretries = nil
+}
+
+// Retries returns the value of the "retries" field in the mutation.
+func (m *SourceReportMutation) Retries() (r int, exists bool) {
+	v := m.retries
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRetries returns the old "retries" field's value of the SourceReport entity.
+// If the SourceReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceReportMutation) OldRetries(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRetries is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRetries requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRetries: %w", err)
+	}
+	return oldValue.Retries, nil
+}
+
+// AddRetries adds i to the "retries" field.
+func (m *SourceReportMutation) AddRetries(i int) {
+	if m.addretries != nil {
+		*m.addretries += i
+	} else {
+		m.addretries = &i
+	}
+}
+
+// AddedRetries returns the value that was added to the "retries" field in this mutation.
+func (m *SourceReportMutation) AddedRetries() (r int, exists bool) {
+	v := m.addretries
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRetries resets all changes to the "retries" field.
+func (m *SourceReportMutation) ResetRetries() {
+	m.retries = nil
+	m.addretries = nil
+}
+
+// SetHeadings sets the "headings" field.
+func (m *SourceReportMutation) SetHeadings(i int) {
+	m.headings = &i
+	m.addheadings = nil
+}
+
+// Headings returns the value of the "headings" field in the mutation.
+func (m *SourceReportMutation) Headings() (r int, exists bool) {
+	v := m.headings
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHeadings returns the old "headings" field's value of the SourceReport entity.
+// If the SourceReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SourceReportMutation) OldHeadings(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeadings is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeadings requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeadings: %w", err)
+	}
+	return oldValue.Headings, nil
+}
+
+// AddHeadings adds i to the "headings" field.
+func (m *SourceReportMutation) AddHeadings(i int) {
+	if m.addheadings != nil {
+		*m.addheadings += i
+	} else {
+		m.addheadings = &i
+	}
 }
 
-// TriggeredAt returns the value of the "triggered_at" field in the mutation.
-func (m *RunMutation) TriggeredAt() (r time.Time, exists bool) {
-	v := m.triggered_at
+// AddedHeadings returns the value that was added to the "headings" field in this mutation.
+func (m *SourceReportMutation) AddedHeadings() (r int, exists bool) {
+	v := m.addheadings
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTriggeredAt returns the old "triggered_at" field's value of the Run entity.
-// If the Run object wasn't provided to the builder, the object is fetched from the database.
+// ResetHeadings resets all changes to the "headings" field.
+func (m *SourceReportMutation) ResetHeadings() {
+	m.headings = nil
+	m.addheadings = nil
+}
+
+// SetApplications sets the "applications" field.
+func (m *SourceReportMutation) SetApplications(i int) {
+	m.applications = &i
+	m.addapplications = nil
+}
+
+// Applications returns the value of the "applications" field in the mutation.
+func (m *SourceReportMutation) Applications() (r int, exists bool) {
+	v := m.applications
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldApplications returns the old "applications" field's value of the SourceReport entity.
+// If the SourceReport object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RunMutation) OldTriggeredAt(ctx context.Context) (v time.Time, err error) {
+func (m *SourceReportMutation) OldApplications(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTriggeredAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldApplications is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTriggeredAt requires an ID field in the mutation")
+		return v, errors.New("OldApplications requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTriggeredAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldApplications: %w", err)
 	}
-	return oldValue.TriggeredAt, nil
+	return oldValue.Applications, nil
 }
 
-// ResetTriggeredAt resets all changes to the "triggered_at" field.
-func (m *RunMutation) ResetTriggeredAt() {
-	m.triggered_at = nil
+// AddApplications adds i to the "applications" field.
+func (m *SourceReportMutation) AddApplications(i int) {
+	if m.addapplications != nil {
+		*m.addapplications += i
+	} else {
+		m.addapplications = &i
+	}
 }
 
-// SetPayloadMeta sets the "payload_meta" field.
-func (m *RunMutation) SetPayloadMeta(value map[string]interface{}) {
-	m.payload_meta = &value
+// AddedApplications returns the value that was added to the "applications" field in this mutation.
+func (m *SourceReportMutation) AddedApplications() (r int, exists bool) {
+	v := m.addapplications
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// PayloadMeta returns the value of the "payload_meta" field in the mutation.
-func (m *RunMutation) PayloadMeta() (r map[string]interface{}, exists bool) {
-	v := m.payload_meta
+// ResetApplications resets all changes to the "applications" field.
+func (m *SourceReportMutation) ResetApplications() {
+	m.applications = nil
+	m.addapplications = nil
+}
+
+// SetSkippedRows sets the "skipped_rows" field.
+func (m *SourceReportMutation) SetSkippedRows(i int) {
+	m.skipped_rows = &i
+	m.addskipped_rows = nil
+}
+
+// SkippedRows returns the value of the "skipped_rows" field in the mutation.
+func (m *SourceReportMutation) SkippedRows() (r int, exists bool) {
+	v := m.skipped_rows
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPayloadMeta returns the old "payload_meta" field's value of the Run entity.
-// If the Run object wasn't provided to the builder, the object is fetched from the database.
+// OldSkippedRows returns the old "skipped_rows" field's value of the SourceReport entity.
+// If the SourceReport object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RunMutation) OldPayloadMeta(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *SourceReportMutation) OldSkippedRows(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPayloadMeta is only allowed on UpdateOne operations")
+		return v, errors.New("OldSkippedRows is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPayloadMeta requires an ID field in the mutation")
+		return v, errors.New("OldSkippedRows requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPayloadMeta: %w", err)
+		return v, fmt.Errorf("querying old value for OldSkippedRows: %w", err)
 	}
-	return oldValue.PayloadMeta, nil
+	return oldValue.SkippedRows, nil
 }
 
-// ClearPayloadMeta clears the value of the "payload_meta" field.
-func (m *RunMutation) ClearPayloadMeta() {
-	m.payload_meta = nil
-	m.clearedFields[run.FieldPayloadMeta] = struct{}{}
+// AddSkippedRows adds i to the "skipped_rows" field.
+func (m *SourceReportMutation) AddSkippedRows(i int) {
+	if m.addskipped_rows != nil {
+		*m.addskipped_rows += i
+	} else {
+		m.addskipped_rows = &i
+	}
 }
 
-// PayloadMetaCleared returns if the "payload_meta" field was cleared in this mutation.
-func (m *RunMutation) PayloadMetaCleared() bool {
-	_, ok := m.clearedFields[run.FieldPayloadMeta]
-	return ok
+// AddedSkippedRows returns the value that was added to the "skipped_rows" field in this mutation.
+func (m *SourceReportMutation) AddedSkippedRows() (r int, exists bool) {
+	v := m.addskipped_rows
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetPayloadMeta resets all changes to the "payload_meta" field.
-func (m *RunMutation) ResetPayloadMeta() {
-	m.payload_meta = nil
-	delete(m.clearedFields, run.FieldPayloadMeta)
+// ResetSkippedRows resets all changes to the "skipped_rows" field.
+func (m *SourceReportMutation) ResetSkippedRows() {
+	m.skipped_rows = nil
+	m.addskipped_rows = nil
 }
 
-// SetFinished sets the "finished" field.
-func (m *RunMutation) SetFinished(b bool) {
-	m.finished = &b
+// SetFailedLists sets the "failed_lists" field.
+func (m *SourceReportMutation) SetFailedLists(i int) {
+	m.failed_lists = &i
+	m.addfailed_lists = nil
 }
 
-// Finished returns the value of the "finished" field in the mutation.
-func (m *RunMutation) Finished() (r bool, exists bool) {
-	v := m.finished
+// FailedLists returns the value of the "failed_lists" field in the mutation.
+func (m *SourceReportMutation) FailedLists() (r int, exists bool) {
+	v := m.failed_lists
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFinished returns the old "finished" field's value of the Run entity.
-// If the Run object wasn't provided to the builder, the object is fetched from the database.
+// OldFailedLists returns the old "failed_lists" field's value of the SourceReport entity.
+// If the SourceReport object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RunMutation) OldFinished(ctx context.Context) (v bool, err error) {
+func (m *SourceReportMutation) OldFailedLists(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFinished is only allowed on UpdateOne operations")
+		return v, errors.New("OldFailedLists is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFinished requires an ID field in the mutation")
+		return v, errors.New("OldFailedLists requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFinished: %w", err)
+		return v, fmt.Errorf("querying old value for OldFailedLists: %w", err)
 	}
-	return oldValue.Finished, nil
+	return oldValue.FailedLists, nil
 }
 
-// ResetFinished resets all changes to the "finished" field.
-func (m *RunMutation) ResetFinished() {
-	m.finished = nil
+// AddFailedLists adds i to the "failed_lists" field.
+func (m *SourceReportMutation) AddFailedLists(i int) {
+	if m.addfailed_lists != nil {
+		*m.addfailed_lists += i
+	} else {
+		m.addfailed_lists = &i
+	}
 }
 
-// SetFinishedAt sets the "finished_at" field.
-func (m *RunMutation) SetFinishedAt(t time.Time) {
-	m.finished_at = &t
+// AddedFailedLists returns the value that was added to the "failed_lists" field in this mutation.
+func (m *SourceReportMutation) AddedFailedLists() (r int, exists bool) {
+	v := m.addfailed_lists
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// FinishedAt returns the value of the "finished_at" field in the mutation.
-func (m *RunMutation) FinishedAt() (r time.Time, exists bool) {
-	v := m.finished_at
+// ResetFailedLists resets all changes to the "failed_lists" field.
+func (m *SourceReportMutation) ResetFailedLists() {
+	m.failed_lists = nil
+	m.addfailed_lists = nil
+}
+
+// SetRunID sets the "run_id" field.
+func (m *SourceReportMutation) SetRunID(i int) {
+	m.run = &i
+}
+
+// RunID returns the value of the "run_id" field in the mutation.
+func (m *SourceReportMutation) RunID() (r int, exists bool) {
+	v := m.run
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFinishedAt returns the old "finished_at" field's value of the Run entity.
-// If the Run object wasn't provided to the builder, the object is fetched from the database.
+// OldRunID returns the old "run_id" field's value of the SourceReport entity.
+// If the SourceReport object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RunMutation) OldFinishedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SourceReportMutation) OldRunID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldRunID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
+		return v, errors.New("OldRunID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldRunID: %w", err)
 	}
-	return oldValue.FinishedAt, nil
+	return oldValue.RunID, nil
 }
 
-// ClearFinishedAt clears the value of the "finished_at" field.
-func (m *RunMutation) ClearFinishedAt() {
-	m.finished_at = nil
-	m.clearedFields[run.FieldFinishedAt] = struct{}{}
+// ResetRunID resets all changes to the "run_id" field.
+func (m *SourceReportMutation) ResetRunID() {
+	m.run = nil
 }
 
-// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
-func (m *RunMutation) FinishedAtCleared() bool {
-	_, ok := m.clearedFields[run.FieldFinishedAt]
-	return ok
+// ClearRun clears the "run" edge to the Run entity.
+func (m *SourceReportMutation) ClearRun() {
+	m.clearedrun = true
+	m.clearedFields[sourcereport.FieldRunID] = struct{}{}
 }
 
-// ResetFinishedAt resets all changes to the "finished_at" field.
-func (m *RunMutation) ResetFinishedAt() {
-	m.finished_at = nil
-	delete(m.clearedFields, run.FieldFinishedAt)
+// RunCleared reports if the "run" edge to the Run entity was cleared.
+func (m *SourceReportMutation) RunCleared() bool {
+	return m.clearedrun
 }
 
-// Where appends a list predicates to the RunMutation builder.
-func (m *RunMutation) Where(ps ...predicate.Run) {
+// RunIDs returns the "run" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RunID instead. It exists only for internal usage by the builders.
+func (m *SourceReportMutation) RunIDs() (ids []int) {
+	if id := m.run; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRun resets all changes to the "run" edge.
+func (m *SourceReportMutation) ResetRun() {
+	m.run = nil
+	m.clearedrun = false
+}
+
+// Where appends a list predicates to the SourceReportMutation builder.
+func (m *SourceReportMutation) Where(ps ...predicate.SourceReport) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the RunMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SourceReportMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *RunMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Run, len(ps))
+func (m *SourceReportMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SourceReport, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4385,36 +11863,69 @@ func (m *RunMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *RunMutation) Op() Op {
+func (m *SourceReportMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *RunMutation) SetOp(op Op) {
+func (m *SourceReportMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Run).
-func (m *RunMutation) Type() string {
+// Type returns the node type of this mutation (SourceReport).
+func (m *SourceReportMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *RunMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.triggered_at != nil {
-		fields = append(fields, run.FieldTriggeredAt)
+func (m *SourceReportMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.varsity_code != nil {
+		fields = append(fields, sourcereport.FieldVarsityCode)
 	}
-	if m.payload_meta != nil {
-		fields = append(fields, run.FieldPayloadMeta)
+	if m.source != nil {
+		fields = append(fields, sourcereport.FieldSource)
 	}
-	if m.finished != nil {
-		fields = append(fields, run.FieldFinished)
+	if m.name != nil {
+		fields = append(fields, sourcereport.FieldName)
 	}
-	if m.finished_at != nil {
-		fields = append(fields, run.FieldFinishedAt)
+	if m.url != nil {
+		fields = append(fields, sourcereport.FieldURL)
+	}
+	if m.heading_codes != nil {
+		fields = append(fields, sourcereport.FieldHeadingCodes)
+	}
+	if m.status != nil {
+		fields = append(fields, sourcereport.FieldStatus)
+	}
+	if m.error != nil {
+		fields = append(fields, sourcereport.FieldError)
+	}
+	if m.started_at != nil {
+		fields = append(fields, sourcereport.FieldStartedAt)
+	}
+	if m.duration_ms != nil {
+		fields = append(fields, sourcereport.FieldDurationMs)
+	}
+	if m.retries != nil {
+		fields = append(fields, sourcereport.FieldRetries)
+	}
+	if m.headings != nil {
+		fields = append(fields, sourcereport.FieldHeadings)
+	}
+	if m.applications != nil {
+		fields = append(fields, sourcereport.FieldApplications)
+	}
+	if m.skipped_rows != nil {
+		fields = append(fields, sourcereport.FieldSkippedRows)
+	}
+	if m.failed_lists != nil {
+		fields = append(fields, sourcereport.FieldFailedLists)
+	}
+	if m.run != nil {
+		fields = append(fields, sourcereport.FieldRunID)
 	}
 	return fields
 }
@@ -4422,16 +11933,38 @@ func (m *RunMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *RunMutation) Field(name string) (ent.Value, bool) {
+func (m *SourceReportMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case run.FieldTriggeredAt:
-		return m.TriggeredAt()
-	case run.FieldPayloadMeta:
-		return m.PayloadMeta()
-	case run.FieldFinished:
-		return m.Finished()
-	case run.FieldFinishedAt:
-		return m.FinishedAt()
+	case sourcereport.FieldVarsityCode:
+		return m.VarsityCode()
+	case sourcereport.FieldSource:
+		return m.Source()
+	case sourcereport.FieldName:
+		return m.Name()
+	case sourcereport.FieldURL:
+		return m.URL()
+	case sourcereport.FieldHeadingCodes:
+		return m.HeadingCodes()
+	case sourcereport.FieldStatus:
+		return m.Status()
+	case sourcereport.FieldError:
+		return m.Error()
+	case sourcereport.FieldStartedAt:
+		return m.StartedAt()
+	case sourcereport.FieldDurationMs:
+		return m.DurationMs()
+	case sourcereport.FieldRetries:
+		return m.Retries()
+	case sourcereport.FieldHeadings:
+		return m.Headings()
+	case sourcereport.FieldApplications:
+		return m.Applications()
+	case sourcereport.FieldSkippedRows:
+		return m.SkippedRows()
+	case sourcereport.FieldFailedLists:
+		return m.FailedLists()
+	case sourcereport.FieldRunID:
+		return m.RunID()
 	}
 	return nil, false
 }
@@ -4439,179 +11972,424 @@ func (m *RunMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *RunMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SourceReportMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case run.FieldTriggeredAt:
-		return m.OldTriggeredAt(ctx)
-	case run.FieldPayloadMeta:
-		return m.OldPayloadMeta(ctx)
-	case run.FieldFinished:
-		return m.OldFinished(ctx)
-	case run.FieldFinishedAt:
-		return m.OldFinishedAt(ctx)
+	case sourcereport.FieldVarsityCode:
+		return m.OldVarsityCode(ctx)
+	case sourcereport.FieldSource:
+		return m.OldSource(ctx)
+	case sourcereport.FieldName:
+		return m.OldName(ctx)
+	case sourcereport.FieldURL:
+		return m.OldURL(ctx)
+	case sourcereport.FieldHeadingCodes:
+		return m.OldHeadingCodes(ctx)
+	case sourcereport.FieldStatus:
+		return m.OldStatus(ctx)
+	case sourcereport.FieldError:
+		return m.OldError(ctx)
+	case sourcereport.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case sourcereport.FieldDurationMs:
+		return m.OldDurationMs(ctx)
+	case sourcereport.FieldRetries:
+		return m.OldRetries(ctx)
+	case sourcereport.FieldHeadings:
+		return m.OldHeadings(ctx)
+	case sourcereport.FieldApplications:
+		return m.OldApplications(ctx)
+	case sourcereport.FieldSkippedRows:
+		return m.OldSkippedRows(ctx)
+	case sourcereport.FieldFailedLists:
+		return m.OldFailedLists(ctx)
+	case sourcereport.FieldRunID:
+		return m.OldRunID(ctx)
 	}
-	return nil, fmt.Errorf("unknown Run field %s", name)
+	return nil, fmt.Errorf("unknown SourceReport field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RunMutation) SetField(name string, value ent.Value) error {
+func (m *SourceReportMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case run.FieldTriggeredAt:
+	case sourcereport.FieldVarsityCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVarsityCode(v)
+		return nil
+	case sourcereport.FieldSource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case sourcereport.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case sourcereport.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case sourcereport.FieldHeadingCodes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeadingCodes(v)
+		return nil
+	case sourcereport.FieldStatus:
+		v, ok := value.(core.SourceLoadStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case sourcereport.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case sourcereport.FieldStartedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTriggeredAt(v)
+		m.SetStartedAt(v)
+		return nil
+	case sourcereport.FieldDurationMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDurationMs(v)
+		return nil
+	case sourcereport.FieldRetries:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRetries(v)
+		return nil
+	case sourcereport.FieldHeadings:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeadings(v)
 		return nil
-	case run.FieldPayloadMeta:
-		v, ok := value.(map[string]interface{})
+	case sourcereport.FieldApplications:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPayloadMeta(v)
+		m.SetApplications(v)
 		return nil
-	case run.FieldFinished:
-		v, ok := value.(bool)
+	case sourcereport.FieldSkippedRows:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFinished(v)
+		m.SetSkippedRows(v)
 		return nil
-	case run.FieldFinishedAt:
-		v, ok := value.(time.Time)
+	case sourcereport.FieldFailedLists:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFinishedAt(v)
+		m.SetFailedLists(v)
+		return nil
+	case sourcereport.FieldRunID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRunID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Run field %s", name)
+	return fmt.Errorf("unknown SourceReport field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *RunMutation) AddedFields() []string {
-	return nil
+func (m *SourceReportMutation) AddedFields() []string {
+	var fields []string
+	if m.addduration_ms != nil {
+		fields = append(fields, sourcereport.FieldDurationMs)
+	}
+	if m.addretries != nil {
+		fields = append(fields, sourcereport.FieldRetries)
+	}
+	if m.addheadings != nil {
+		fields = append(fields, sourcereport.FieldHeadings)
+	}
+	if m.addapplications != nil {
+		fields = append(fields, sourcereport.FieldApplications)
+	}
+	if m.addskipped_rows != nil {
+		fields = append(fields, sourcereport.FieldSkippedRows)
+	}
+	if m.addfailed_lists != nil {
+		fields = append(fields, sourcereport.FieldFailedLists)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *RunMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SourceReportMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case sourcereport.FieldDurationMs:
+		return m.AddedDurationMs()
+	case sourcereport.FieldRetries:
+		return m.AddedRetries()
+	case sourcereport.FieldHeadings:
+		return m.AddedHeadings()
+	case sourcereport.FieldApplications:
+		return m.AddedApplications()
+	case sourcereport.FieldSkippedRows:
+		return m.AddedSkippedRows()
+	case sourcereport.FieldFailedLists:
+		return m.AddedFailedLists()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RunMutation) AddField(name string, value ent.Value) error {
+func (m *SourceReportMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case sourcereport.FieldDurationMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDurationMs(v)
+		return nil
+	case sourcereport.FieldRetries:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRetries(v)
+		return nil
+	case sourcereport.FieldHeadings:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHeadings(v)
+		return nil
+	case sourcereport.FieldApplications:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddApplications(v)
+		return nil
+	case sourcereport.FieldSkippedRows:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSkippedRows(v)
+		return nil
+	case sourcereport.FieldFailedLists:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFailedLists(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Run numeric field %s", name)
+	return fmt.Errorf("unknown SourceReport numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *RunMutation) ClearedFields() []string {
+func (m *SourceReportMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(run.FieldPayloadMeta) {
-		fields = append(fields, run.FieldPayloadMeta)
+	if m.FieldCleared(sourcereport.FieldName) {
+		fields = append(fields, sourcereport.FieldName)
 	}
-	if m.FieldCleared(run.FieldFinishedAt) {
-		fields = append(fields, run.FieldFinishedAt)
+	if m.FieldCleared(sourcereport.FieldURL) {
+		fields = append(fields, sourcereport.FieldURL)
+	}
+	if m.FieldCleared(sourcereport.FieldHeadingCodes) {
+		fields = append(fields, sourcereport.FieldHeadingCodes)
+	}
+	if m.FieldCleared(sourcereport.FieldError) {
+		fields = append(fields, sourcereport.FieldError)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *RunMutation) FieldCleared(name string) bool {
+func (m *SourceReportMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *RunMutation) ClearField(name string) error {
+func (m *SourceReportMutation) ClearField(name string) error {
 	switch name {
-	case run.FieldPayloadMeta:
-		m.ClearPayloadMeta()
+	case sourcereport.FieldName:
+		m.ClearName()
 		return nil
-	case run.FieldFinishedAt:
-		m.ClearFinishedAt()
+	case sourcereport.FieldURL:
+		m.ClearURL()
+		return nil
+	case sourcereport.FieldHeadingCodes:
+		m.ClearHeadingCodes()
+		return nil
+	case sourcereport.FieldError:
+		m.ClearError()
 		return nil
 	}
-	return fmt.Errorf("unknown Run nullable field %s", name)
+	return fmt.Errorf("unknown SourceReport nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *RunMutation) ResetField(name string) error {
+func (m *SourceReportMutation) ResetField(name string) error {
 	switch name {
-	case run.FieldTriggeredAt:
-		m.ResetTriggeredAt()
+	case sourcereport.FieldVarsityCode:
+		m.ResetVarsityCode()
 		return nil
-	case run.FieldPayloadMeta:
-		m.ResetPayloadMeta()
+	case sourcereport.FieldSource:
+		m.ResetSource()
 		return nil
-	case run.FieldFinished:
-		m.ResetFinished()
+	case sourcereport.FieldName:
+		m.ResetName()
 		return nil
-	case run.FieldFinishedAt:
-		m.ResetFinishedAt()
+	case sourcereport.FieldURL:
+		m.ResetURL()
+		return nil
+	case sourcereport.FieldHeadingCodes:
+		m.ResetHeadingCodes()
+		return nil
+	case sourcereport.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case sourcereport.FieldError:
+		m.ResetError()
+		return nil
+	case sourcereport.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case sourcereport.FieldDurationMs:
+		m.ResetDurationMs()
+		return nil
+	case sourcereport.FieldRetries:
+		m.ResetRetries()
+		return nil
+	case sourcereport.FieldHeadings:
+		m.ResetHeadings()
+		return nil
+	case sourcereport.FieldApplications:
+		m.ResetApplications()
+		return nil
+	case sourcereport.FieldSkippedRows:
+		m.ResetSkippedRows()
+		return nil
+	case sourcereport.FieldFailedLists:
+		m.ResetFailedLists()
+		return nil
+	case sourcereport.FieldRunID:
+		m.ResetRunID()
 		return nil
 	}
-	return fmt.Errorf("unknown Run field %s", name)
+	return fmt.Errorf("unknown SourceReport field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *RunMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *SourceReportMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.run != nil {
+		edges = append(edges, sourcereport.EdgeRun)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *RunMutation) AddedIDs(name string) []ent.Value {
+func (m *SourceReportMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case sourcereport.EdgeRun:
+		if id := m.run; id != nil {
+			return []ent.Value{*id}
+		}
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *RunMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *SourceReportMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *RunMutation) RemovedIDs(name string) []ent.Value {
+func (m *SourceReportMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *RunMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *SourceReportMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedrun {
+		edges = append(edges, sourcereport.EdgeRun)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *RunMutation) EdgeCleared(name string) bool {
+func (m *SourceReportMutation) EdgeCleared(name string) bool {
+	switch name {
+	case sourcereport.EdgeRun:
+		return m.clearedrun
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *RunMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Run unique edge %s", name)
+func (m *SourceReportMutation) ClearEdge(name string) error {
+	switch name {
+	case sourcereport.EdgeRun:
+		m.ClearRun()
+		return nil
+	}
+	return fmt.Errorf("unknown SourceReport unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *RunMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Run edge %s", name)
+func (m *SourceReportMutation) ResetEdge(name string) error {
+	switch name {
+	case sourcereport.EdgeRun:
+		m.ResetRun()
+		return nil
+	}
+	return fmt.Errorf("unknown SourceReport edge %s", name)
 }
 
 // VarsityMutation represents an operation that mutates the Varsity nodes in the graph.
@@ -4622,6 +12400,10 @@ type VarsityMutation struct {
 	id              *int
 	code            *string
 	name            *string
+	city            *string
+	region          *string
+	parent          *string
+	site            *string
 	clearedFields   map[string]struct{}
 	headings        map[int]struct{}
 	removedheadings map[int]struct{}
@@ -4801,6 +12583,150 @@ func (m *VarsityMutation) ResetName() {
 	m.name = nil
 }
 
+// SetCity sets the "city" field.
+func (m *VarsityMutation) SetCity(s string) {
+	m.city = &s
+}
+
+// City returns the value of the "city" field in the mutation.
+func (m *VarsityMutation) City() (r string, exists bool) {
+	v := m.city
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCity returns the old "city" field's value of the Varsity entity.
+// If the Varsity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VarsityMutation) OldCity(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCity: %w", err)
+	}
+	return oldValue.City, nil
+}
+
+// ResetCity resets all changes to the "city" field.
+func (m *VarsityMutation) ResetCity() {
+	m.city = nil
+}
+
+// SetRegion sets the "region" field.
+func (m *VarsityMutation) SetRegion(s string) {
+	m.region = &s
+}
+
+// Region returns the value of the "region" field in the mutation.
+func (m *VarsityMutation) Region() (r string, exists bool) {
+	v := m.region
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRegion returns the old "region" field's value of the Varsity entity.
+// If the Varsity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VarsityMutation) OldRegion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRegion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRegion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRegion: %w", err)
+	}
+	return oldValue.Region, nil
+}
+
+// ResetRegion resets all changes to the "region" field.
+func (m *VarsityMutation) ResetRegion() {
+	m.region = nil
+}
+
+// SetParent sets the "parent" field.
+func (m *VarsityMutation) SetParent(s string) {
+	m.parent = &s
+}
+
+// Parent returns the value of the "parent" field in the mutation.
+func (m *VarsityMutation) Parent() (r string, exists bool) {
+	v := m.parent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParent returns the old "parent" field's value of the Varsity entity.
+// If the Varsity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VarsityMutation) OldParent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParent: %w", err)
+	}
+	return oldValue.Parent, nil
+}
+
+// ResetParent resets all changes to the "parent" field.
+func (m *VarsityMutation) ResetParent() {
+	m.parent = nil
+}
+
+// SetSite sets the "site" field.
+func (m *VarsityMutation) SetSite(s string) {
+	m.site = &s
+}
+
+// Site returns the value of the "site" field in the mutation.
+func (m *VarsityMutation) Site() (r string, exists bool) {
+	v := m.site
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSite returns the old "site" field's value of the Varsity entity.
+// If the Varsity object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VarsityMutation) OldSite(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSite is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSite requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSite: %w", err)
+	}
+	return oldValue.Site, nil
+}
+
+// ResetSite resets all changes to the "site" field.
+func (m *VarsityMutation) ResetSite() {
+	m.site = nil
+}
+
 // AddHeadingIDs adds the "headings" edge to the Heading entity by ids.
 func (m *VarsityMutation) AddHeadingIDs(ids ...int) {
 	if m.headings == nil {
@@ -4889,13 +12815,25 @@ func (m *VarsityMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *VarsityMutation) Fields() []string {
-	fields := make([]string, 0, 2)
+	fields := make([]string, 0, 6)
 	if m.code != nil {
 		fields = append(fields, varsity.FieldCode)
 	}
 	if m.name != nil {
 		fields = append(fields, varsity.FieldName)
 	}
+	if m.city != nil {
+		fields = append(fields, varsity.FieldCity)
+	}
+	if m.region != nil {
+		fields = append(fields, varsity.FieldRegion)
+	}
+	if m.parent != nil {
+		fields = append(fields, varsity.FieldParent)
+	}
+	if m.site != nil {
+		fields = append(fields, varsity.FieldSite)
+	}
 	return fields
 }
 
@@ -4908,6 +12846,14 @@ func (m *VarsityMutation) Field(name string) (ent.Value, bool) {
 		return m.Code()
 	case varsity.FieldName:
 		return m.Name()
+	case varsity.FieldCity:
+		return m.City()
+	case varsity.FieldRegion:
+		return m.Region()
+	case varsity.FieldParent:
+		return m.Parent()
+	case varsity.FieldSite:
+		return m.Site()
 	}
 	return nil, false
 }
@@ -4921,6 +12867,14 @@ func (m *VarsityMutation) OldField(ctx context.Context, name string) (ent.Value,
 		return m.OldCode(ctx)
 	case varsity.FieldName:
 		return m.OldName(ctx)
+	case varsity.FieldCity:
+		return m.OldCity(ctx)
+	case varsity.FieldRegion:
+		return m.OldRegion(ctx)
+	case varsity.FieldParent:
+		return m.OldParent(ctx)
+	case varsity.FieldSite:
+		return m.OldSite(ctx)
 	}
 	return nil, fmt.Errorf("unknown Varsity field %s", name)
 }
@@ -4944,6 +12898,34 @@ func (m *VarsityMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetName(v)
 		return nil
+	case varsity.FieldCity:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCity(v)
+		return nil
+	case varsity.FieldRegion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRegion(v)
+		return nil
+	case varsity.FieldParent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParent(v)
+		return nil
+	case varsity.FieldSite:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSite(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Varsity field %s", name)
 }
@@ -4999,6 +12981,18 @@ func (m *VarsityMutation) ResetField(name string) error {
 	case varsity.FieldName:
 		m.ResetName()
 		return nil
+	case varsity.FieldCity:
+		m.ResetCity()
+		return nil
+	case varsity.FieldRegion:
+		m.ResetRegion()
+		return nil
+	case varsity.FieldParent:
+		m.ResetParent()
+		return nil
+	case varsity.FieldSite:
+		m.ResetSite()
+		return nil
 	}
 	return fmt.Errorf("unknown Varsity field %s", name)
 }
@@ -6,12 +6,21 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AdmissionChance is the predicate function for admissionchance builders.
+type AdmissionChance func(*sql.Selector)
+
+// AdmissionEvent is the predicate function for admissionevent builders.
+type AdmissionEvent func(*sql.Selector)
+
 // Application is the predicate function for application builders.
 type Application func(*sql.Selector)
 
 // Calculation is the predicate function for calculation builders.
 type Calculation func(*sql.Selector)
 
+// CapacityDrift is the predicate function for capacitydrift builders.
+type CapacityDrift func(*sql.Selector)
+
 // DrainedResult is the predicate function for drainedresult builders.
 type DrainedResult func(*sql.Selector)
 
@@ -21,5 +30,8 @@ type Heading func(*sql.Selector)
 // Run is the predicate function for run builders.
 type Run func(*sql.Selector)
 
+// SourceReport is the predicate function for sourcereport builders.
+type SourceReport func(*sql.Selector)
+
 // Varsity is the predicate function for varsity builders.
 type Varsity func(*sql.Selector)
@@ -7,9 +7,13 @@ import (
 
 	"github.com/trueegorletov/analabit/core/ent/application"
 	"github.com/trueegorletov/analabit/core/ent/calculation"
+	"github.com/trueegorletov/analabit/core/ent/capacitydrift"
 	"github.com/trueegorletov/analabit/core/ent/drainedresult"
+	"github.com/trueegorletov/analabit/core/ent/heading"
 	"github.com/trueegorletov/analabit/core/ent/run"
 	"github.com/trueegorletov/analabit/core/ent/schema"
+	"github.com/trueegorletov/analabit/core/ent/sourcereport"
+	"github.com/trueegorletov/analabit/core/ent/varsity"
 )
 
 // The init function reads all schema descriptors with runtime code
@@ -18,24 +22,42 @@ import (
 func init() {
 	applicationFields := schema.Application{}.Fields()
 	_ = applicationFields
+	// applicationDescAchievementScore is the schema descriptor for achievement_score field.
+	applicationDescAchievementScore := applicationFields[6].Descriptor()
+	// application.DefaultAchievementScore holds the default value on creation for the achievement_score field.
+	application.DefaultAchievementScore = applicationDescAchievementScore.Default.(int)
 	// applicationDescOriginalSubmitted is the schema descriptor for original_submitted field.
-	applicationDescOriginalSubmitted := applicationFields[6].Descriptor()
+	applicationDescOriginalSubmitted := applicationFields[9].Descriptor()
 	// application.DefaultOriginalSubmitted holds the default value on creation for the original_submitted field.
 	application.DefaultOriginalSubmitted = applicationDescOriginalSubmitted.Default.(bool)
 	// applicationDescUpdatedAt is the schema descriptor for updated_at field.
-	applicationDescUpdatedAt := applicationFields[7].Descriptor()
+	applicationDescUpdatedAt := applicationFields[10].Descriptor()
 	// application.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	application.DefaultUpdatedAt = applicationDescUpdatedAt.Default.(func() time.Time)
 	// application.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	application.UpdateDefaultUpdatedAt = applicationDescUpdatedAt.UpdateDefault.(func() time.Time)
 	calculationFields := schema.Calculation{}.Fields()
 	_ = calculationFields
+	// calculationDescPaid is the schema descriptor for paid field.
+	calculationDescPaid := calculationFields[3].Descriptor()
+	// calculation.DefaultPaid holds the default value on creation for the paid field.
+	calculation.DefaultPaid = calculationDescPaid.Default.(bool)
 	// calculationDescUpdatedAt is the schema descriptor for updated_at field.
-	calculationDescUpdatedAt := calculationFields[3].Descriptor()
+	calculationDescUpdatedAt := calculationFields[5].Descriptor()
 	// calculation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	calculation.DefaultUpdatedAt = calculationDescUpdatedAt.Default.(func() time.Time)
 	// calculation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	calculation.UpdateDefaultUpdatedAt = calculationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	capacitydriftFields := schema.CapacityDrift{}.Fields()
+	_ = capacitydriftFields
+	// capacitydriftDescHeadingCode is the schema descriptor for heading_code field.
+	capacitydriftDescHeadingCode := capacitydriftFields[1].Descriptor()
+	// capacitydrift.DefaultHeadingCode holds the default value on creation for the heading_code field.
+	capacitydrift.DefaultHeadingCode = capacitydriftDescHeadingCode.Default.(string)
+	// capacitydriftDescTotalOnly is the schema descriptor for total_only field.
+	capacitydriftDescTotalOnly := capacitydriftFields[13].Descriptor()
+	// capacitydrift.DefaultTotalOnly holds the default value on creation for the total_only field.
+	capacitydrift.DefaultTotalOnly = capacitydriftDescTotalOnly.Default.(bool)
 	drainedresultFields := schema.DrainedResult{}.Fields()
 	_ = drainedresultFields
 	// drainedresultDescRegularsAdmitted is the schema descriptor for regulars_admitted field.
@@ -46,6 +68,24 @@ func init() {
 	drainedresultDescIsVirtual := drainedresultFields[11].Descriptor()
 	// drainedresult.DefaultIsVirtual holds the default value on creation for the is_virtual field.
 	drainedresult.DefaultIsVirtual = drainedresultDescIsVirtual.Default.(bool)
+	headingFields := schema.Heading{}.Fields()
+	_ = headingFields
+	// headingDescPaidCapacity is the schema descriptor for paid_capacity field.
+	headingDescPaidCapacity := headingFields[8].Descriptor()
+	// heading.DefaultPaidCapacity holds the default value on creation for the paid_capacity field.
+	heading.DefaultPaidCapacity = headingDescPaidCapacity.Default.(int)
+	// headingDescDirectionCode is the schema descriptor for direction_code field.
+	headingDescDirectionCode := headingFields[9].Descriptor()
+	// heading.DefaultDirectionCode holds the default value on creation for the direction_code field.
+	heading.DefaultDirectionCode = headingDescDirectionCode.Default.(string)
+	// headingDescLevel is the schema descriptor for level field.
+	headingDescLevel := headingFields[10].Descriptor()
+	// heading.DefaultLevel holds the default value on creation for the level field.
+	heading.DefaultLevel = headingDescLevel.Default.(string)
+	// headingDescStudyForm is the schema descriptor for study_form field.
+	headingDescStudyForm := headingFields[11].Descriptor()
+	// heading.DefaultStudyForm holds the default value on creation for the study_form field.
+	heading.DefaultStudyForm = headingDescStudyForm.Default.(string)
 	runFields := schema.Run{}.Fields()
 	_ = runFields
 	// runDescTriggeredAt is the schema descriptor for triggered_at field.
@@ -56,4 +96,28 @@ func init() {
 	runDescFinished := runFields[2].Descriptor()
 	// run.DefaultFinished holds the default value on creation for the finished field.
 	run.DefaultFinished = runDescFinished.Default.(bool)
+	sourcereportFields := schema.SourceReport{}.Fields()
+	_ = sourcereportFields
+	// sourcereportDescFailedLists is the schema descriptor for failed_lists field.
+	sourcereportDescFailedLists := sourcereportFields[13].Descriptor()
+	// sourcereport.DefaultFailedLists holds the default value on creation for the failed_lists field.
+	sourcereport.DefaultFailedLists = sourcereportDescFailedLists.Default.(int)
+	varsityFields := schema.Varsity{}.Fields()
+	_ = varsityFields
+	// varsityDescCity is the schema descriptor for city field.
+	varsityDescCity := varsityFields[2].Descriptor()
+	// varsity.DefaultCity holds the default value on creation for the city field.
+	varsity.DefaultCity = varsityDescCity.Default.(string)
+	// varsityDescRegion is the schema descriptor for region field.
+	varsityDescRegion := varsityFields[3].Descriptor()
+	// varsity.DefaultRegion holds the default value on creation for the region field.
+	varsity.DefaultRegion = varsityDescRegion.Default.(string)
+	// varsityDescParent is the schema descriptor for parent field.
+	varsityDescParent := varsityFields[4].Descriptor()
+	// varsity.DefaultParent holds the default value on creation for the parent field.
+	varsity.DefaultParent = varsityDescParent.Default.(string)
+	// varsityDescSite is the schema descriptor for site field.
+	varsityDescSite := varsityFields[5].Descriptor()
+	// varsity.DefaultSite holds the default value on creation for the site field.
+	varsity.DefaultSite = varsityDescSite.Default.(string)
 }
@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AdmissionChance holds the schema definition for the AdmissionChance entity.
+type AdmissionChance struct {
+	ent.Schema
+}
+
+// Fields of the AdmissionChance.
+func (AdmissionChance) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("student_id"),
+		field.Int("drained_percent"),
+		field.Int("admitted_count"),
+		field.Int("iterations"),
+		field.Float("probability"),
+		field.Int("run_id"),
+	}
+}
+
+// Edges of the AdmissionChance.
+func (AdmissionChance) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("heading", Heading.Type).
+			Ref("admission_chances").
+			Unique().
+			Required(),
+		edge.To("run", Run.Type).
+			Unique().
+			Required().
+			Field("run_id"),
+	}
+}
+
+// Indexes of the AdmissionChance.
+func (AdmissionChance) Indexes() []ent.Index {
+	return []ent.Index{
+		// Index for run-based queries
+		index.Fields("run_id"),
+		// Composite index for run + student queries (used in student chances API)
+		index.Fields("run_id", "student_id"),
+	}
+}
@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"github.com/trueegorletov/analabit/core"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AdmissionEvent holds the schema definition for the AdmissionEvent entity.
+// It is a single step of the admission trace of a primary calculation.
+type AdmissionEvent struct {
+	ent.Schema
+}
+
+// Fields of the AdmissionEvent.
+func (AdmissionEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("seq"),
+		field.Int("kind").GoType(core.TraceEventKind(0)),
+		field.Int("stage").GoType(core.AdmissionStage(0)).
+			Optional(),
+		field.String("student_id"),
+		field.Int("priority"),
+		field.Int("competition_type").GoType(core.Competition(0)),
+		// The other applicant involved: the marginal one for rejections, the displacer for displacements
+		field.String("other_student_id").
+			Optional(),
+		field.Int("run_id"),
+	}
+}
+
+// Edges of the AdmissionEvent.
+func (AdmissionEvent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("heading", Heading.Type).
+			Ref("admission_events").
+			Unique().
+			Required(),
+		edge.To("run", Run.Type).
+			Unique().
+			Required().
+			Field("run_id"),
+	}
+}
+
+// Indexes of the AdmissionEvent.
+func (AdmissionEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		// Index for run-based queries
+		index.Fields("run_id"),
+		// Composite index for run + student queries (used in student trace API)
+		index.Fields("run_id", "student_id"),
+	}
+}
@@ -24,12 +24,22 @@ func (Application) Fields() []ent.Field {
 		field.Int("competition_type").GoType(core.Competition(0)),
 		field.Int("rating_place"),
 		field.Int("score"),
+		// Entrance exam scores in the order of the heading's subject priority
+		field.JSON("subject_scores", []int{}).
+			Optional(),
+		field.Int("achievement_score").
+			Default(0),
+		// Detailed target quota the application competes in
+		field.String("sub_quota").
+			Optional(),
 		field.Int("run_id"),
 		field.Bool("original_submitted").Default(false),
 		field.Time("updated_at").
 			Default(time.Now).
 			UpdateDefault(time.Now),
-		field.String("msu_internal_id").
+		// Student ID as published by the varsity, if it publishes internal IDs instead of the canonical ones
+		field.String("internal_id").
+			StorageKey("msu_internal_id").
 			Optional().
 			Nillable(),
 	}
@@ -3,6 +3,8 @@ package schema
 import (
 	"time"
 
+	"github.com/trueegorletov/analabit/core"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -19,6 +21,12 @@ func (Calculation) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("student_id"),
 		field.Int("admitted_place"),
+		// Enrollment stage the student was admitted through (0 for single-pass calculations)
+		field.Int("stage").GoType(core.AdmissionStage(0)).
+			Optional(),
+		// Whether the student was admitted to a paid place by the paid enrollment rather than to a budget one
+		field.Bool("paid").
+			Default(false),
 		field.Int("run_id"),
 		field.Time("updated_at").
 			Default(time.Now).
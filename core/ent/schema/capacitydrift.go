@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CapacityDrift holds the schema definition for the CapacityDrift entity.
+// It compares the capacities of a heading in the registry with the ones its varsity published for a run.
+type CapacityDrift struct {
+	ent.Schema
+}
+
+// Fields of the CapacityDrift.
+func (CapacityDrift) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("varsity_code"),
+		// Code of the heading within its varsity, empty if its source didn't load
+		field.String("heading_code").
+			Default(""),
+		field.String("name").
+			Optional(),
+		field.Int("registry_regular"),
+		field.Int("registry_target_quota"),
+		field.Int("registry_dedicated_quota"),
+		field.Int("registry_special_quota"),
+		field.JSON("registry_target_sub_quotas", map[string]int{}).
+			Optional(),
+		field.Int("published_regular"),
+		field.Int("published_target_quota"),
+		field.Int("published_dedicated_quota"),
+		field.Int("published_special_quota"),
+		field.JSON("published_target_sub_quotas", map[string]int{}).
+			Optional(),
+		// Whether the varsity only publishes the total capacities, stored as the published regular ones
+		field.Bool("total_only").
+			Default(false),
+		// Whether the published capacities differ from the registry ones
+		field.Bool("drifted"),
+		// Whether the heading was loaded with the published capacities
+		field.Bool("applied"),
+		field.Int("run_id"),
+	}
+}
+
+// Edges of the CapacityDrift.
+func (CapacityDrift) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("run", Run.Type).
+			Unique().
+			Required().
+			Field("run_id"),
+	}
+}
+
+// Indexes of the CapacityDrift.
+func (CapacityDrift) Indexes() []ent.Index {
+	return []ent.Index{
+		// Composite index for the capacity history of a heading
+		index.Fields("varsity_code", "heading_code", "run_id"),
+	}
+}
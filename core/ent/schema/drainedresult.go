@@ -1,6 +1,8 @@
 package schema
 
 import (
+	"github.com/trueegorletov/analabit/core"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -27,6 +29,50 @@ func (DrainedResult) Fields() []ent.Field {
 		field.Int("run_id"),
 		field.Bool("regulars_admitted").Default(false),
 		field.Bool("is_virtual").Default(false),
+		// Drainer seed the result was simulated with, allows reproducing it from the cached data
+		field.Int64("seed").
+			Optional(),
+		// Name of the drain model the result was simulated with
+		field.String("drain_model").
+			Optional(),
+		// Average numbers of students admitted at each enrollment stage, only set for staged enrollment
+		field.Int("avg_priority_stage_admitted").
+			Optional(),
+		field.Int("avg_main_stage_admitted").
+			Optional(),
+		// Passing data of the heading's detailed target quotas
+		field.JSON("sub_quotas", []core.SubQuotaResultDTO{}).
+			Optional(),
+		// Average passing data of the heading's paid places
+		field.Int("avg_paid_passing_score").
+			Optional(),
+		field.Int("avg_paid_last_admitted_rating_place").
+			Optional(),
+		// Percentile bands of the passing score and the last admitted rating place over the drain iterations
+		field.Int("p10_passing_score").
+			Optional(),
+		field.Int("p25_passing_score").
+			Optional(),
+		field.Int("p75_passing_score").
+			Optional(),
+		field.Int("p90_passing_score").
+			Optional(),
+		field.Int("p10_last_admitted_rating_place").
+			Optional(),
+		field.Int("p25_last_admitted_rating_place").
+			Optional(),
+		field.Int("p75_last_admitted_rating_place").
+			Optional(),
+		field.Int("p90_last_admitted_rating_place").
+			Optional(),
+		// Distributions of the passing score and the last admitted rating place over the drain iterations
+		field.JSON("passing_score_histogram", &core.HistogramDTO{}).
+			Optional(),
+		field.JSON("last_admitted_rating_place_histogram", &core.HistogramDTO{}).
+			Optional(),
+		// Number of drain iterations the result was aggregated from
+		field.Int("iterations").
+			Optional(),
 	}
 }
 
@@ -1,6 +1,8 @@
 package schema
 
 import (
+	"github.com/trueegorletov/analabit/core"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -20,6 +22,25 @@ func (Heading) Fields() []ent.Field {
 		field.Int("special_quota_capacity"),
 		field.String("code").Unique(),
 		field.String("name"),
+		// Entrance exam subjects in the order of their tie-break priority
+		field.JSON("subjects", []string{}).
+			Optional(),
+		// Capacities of the detailed target quotas (sub-quota name -> capacity), included in target_quota_capacity
+		field.JSON("target_sub_quotas", map[string]int{}).
+			Optional(),
+		// Number of paid (contract) places, not included in any of the budget capacities
+		field.Int("paid_capacity").
+			Default(0),
+		// OKSO code of the direction, e.g. 01.03.02, and the level and study form of the heading; empty if unknown
+		field.String("direction_code").
+			Default(""),
+		field.String("level").
+			Default(""),
+		field.String("study_form").
+			Default(""),
+		// Entrance exams with their minimum scores, if known
+		field.JSON("exams", []core.EntranceExam{}).
+			Optional(),
 	}
 }
 
@@ -30,5 +51,7 @@ func (Heading) Edges() []ent.Edge {
 		edge.To("applications", Application.Type),
 		edge.To("calculations", Calculation.Type),
 		edge.To("drained_results", DrainedResult.Type),
+		edge.To("admission_chances", AdmissionChance.Type),
+		edge.To("admission_events", AdmissionEvent.Type),
 	}
 }
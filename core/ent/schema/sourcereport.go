@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"github.com/trueegorletov/analabit/core"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SourceReport holds the schema definition for the SourceReport entity.
+// It tells how a single heading source of a varsity was loaded for a run.
+type SourceReport struct {
+	ent.Schema
+}
+
+// Fields of the SourceReport.
+func (SourceReport) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("varsity_code"),
+		field.String("source"),
+		field.String("name").
+			Optional(),
+		field.String("url").
+			Optional(),
+		field.JSON("heading_codes", []string{}).
+			Optional(),
+		field.String("status").GoType(core.SourceLoadStatus("")),
+		// Error of the last attempt, empty if the source loaded
+		field.String("error").
+			Optional(),
+		field.Time("started_at"),
+		field.Int64("duration_ms"),
+		field.Int("retries"),
+		field.Int("headings"),
+		field.Int("applications"),
+		field.Int("skipped_rows"),
+		// Lists the source loaded its heading without
+		field.Int("failed_lists").
+			Default(0),
+		field.Int("run_id"),
+	}
+}
+
+// Edges of the SourceReport.
+func (SourceReport) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("run", Run.Type).
+			Unique().
+			Required().
+			Field("run_id"),
+	}
+}
+
+// Indexes of the SourceReport.
+func (SourceReport) Indexes() []ent.Index {
+	return []ent.Index{
+		// Composite index for run + varsity queries (used in run sources API)
+		index.Fields("run_id", "varsity_code"),
+	}
+}
@@ -16,6 +16,11 @@ func (Varsity) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("code").Unique(),
 		field.String("name"),
+		field.String("city").Default(""),
+		field.String("region").Default(""),
+		// Code of the organization the varsity is a campus of, empty if it's an organization of its own
+		field.String("parent").Default(""),
+		field.String("site").Default(""),
 	}
 }
 
@@ -0,0 +1,295 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/ent/run"
+	"github.com/trueegorletov/analabit/core/ent/sourcereport"
+)
+
+// SourceReport is the model entity for the SourceReport schema.
+type SourceReport struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// VarsityCode holds the value of the "varsity_code" field.
+	VarsityCode string `json:"varsity_code,omitempty"`
+	// Source holds the value of the "source" field.
+	Source string `json:"source,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// URL holds the value of the "url" field.
+	URL string `json:"url,omitempty"`
+	// HeadingCodes holds the value of the "heading_codes" field.
+	HeadingCodes []string `json:"heading_codes,omitempty"`
+	// Status holds the value of the "status" field.
+	Status core.SourceLoadStatus `json:"status,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// DurationMs holds the value of the "duration_ms" field.
+	DurationMs int64 `json:"duration_ms,omitempty"`
+	// Retries holds the value of the "retries" field.
+	Retries int `json:"retries,omitempty"`
+	// Headings holds the value of the "headings" field.
+	Headings int `json:"headings,omitempty"`
+	// Applications holds the value of the "applications" field.
+	Applications int `json:"applications,omitempty"`
+	// SkippedRows holds the value of the "skipped_rows" field.
+	SkippedRows int `json:"skipped_rows,omitempty"`
+	// FailedLists holds the value of the "failed_lists" field.
+	FailedLists int `json:"failed_lists,omitempty"`
+	// RunID holds the value of the "run_id" field.
+	RunID int `json:"run_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SourceReportQuery when eager-loading is set.
+	Edges        SourceReportEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SourceReportEdges holds the relations/edges for other nodes in the graph.
+type SourceReportEdges struct {
+	// Run holds the value of the run edge.
+	Run *Run `json:"run,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// RunOrErr returns the Run value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SourceReportEdges) RunOrErr() (*Run, error) {
+	if e.Run != nil {
+		return e.Run, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: run.Label}
+	}
+	return nil, &NotLoadedError{edge: "run"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SourceReport) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case sourcereport.FieldHeadingCodes:
+			values[i] = new([]byte)
+		case sourcereport.FieldID, sourcereport.FieldDurationMs, sourcereport.FieldRetries, sourcereport.FieldHeadings, sourcereport.FieldApplications, sourcereport.FieldSkippedRows, sourcereport.FieldFailedLists, sourcereport.FieldRunID:
+			values[i] = new(sql.NullInt64)
+		case sourcereport.FieldVarsityCode, sourcereport.FieldSource, sourcereport.FieldName, sourcereport.FieldURL, sourcereport.FieldStatus, sourcereport.FieldError:
+			values[i] = new(sql.NullString)
+		case sourcereport.FieldStartedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SourceReport fields.
+func (sr *SourceReport) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case sourcereport.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sr.ID = int(value.Int64)
+		case sourcereport.FieldVarsityCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field varsity_code", values[i])
+			} else if value.Valid {
+				sr.VarsityCode = value.String
+			}
+		case sourcereport.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				sr.Source = value.String
+			}
+		case sourcereport.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				sr.Name = value.String
+			}
+		case sourcereport.FieldURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field url", values[i])
+			} else if value.Valid {
+				sr.URL = value.String
+			}
+		case sourcereport.FieldHeadingCodes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field heading_codes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &sr.HeadingCodes); err != nil {
+					return fmt.Errorf("unmarshal field heading_codes: %w", err)
+				}
+			}
+		case sourcereport.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				sr.Status = core.SourceLoadStatus(value.String)
+			}
+		case sourcereport.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				sr.Error = value.String
+			}
+		case sourcereport.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				sr.StartedAt = value.Time
+			}
+		case sourcereport.FieldDurationMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration_ms", values[i])
+			} else if value.Valid {
+				sr.DurationMs = value.Int64
+			}
+		case sourcereport.FieldRetries:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field retries", values[i])
+			} else if value.Valid {
+				sr.Retries = int(value.Int64)
+			}
+		case sourcereport.FieldHeadings:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field headings", values[i])
+			} else if value.Valid {
+				sr.Headings = int(value.Int64)
+			}
+		case sourcereport.FieldApplications:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field applications", values[i])
+			} else if value.Valid {
+				sr.Applications = int(value.Int64)
+			}
+		case sourcereport.FieldSkippedRows:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field skipped_rows", values[i])
+			} else if value.Valid {
+				sr.SkippedRows = int(value.Int64)
+			}
+		case sourcereport.FieldFailedLists:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field failed_lists", values[i])
+			} else if value.Valid {
+				sr.FailedLists = int(value.Int64)
+			}
+		case sourcereport.FieldRunID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field run_id", values[i])
+			} else if value.Valid {
+				sr.RunID = int(value.Int64)
+			}
+		default:
+			sr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SourceReport.
+// This includes values selected through modifiers, order, etc.
+func (sr *SourceReport) Value(name string) (ent.Value, error) {
+	return sr.selectValues.Get(name)
+}
+
+// QueryRun queries the "run" edge of the SourceReport entity.
+func (sr *SourceReport) QueryRun() *RunQuery {
+	return NewSourceReportClient(sr.config).QueryRun(sr)
+}
+
+// Update returns a builder for updating this SourceReport.
+// Note that you need to call SourceReport.Unwrap() before calling this method if this SourceReport
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sr *SourceReport) Update() *SourceReportUpdateOne {
+	return NewSourceReportClient(sr.config).UpdateOne(sr)
+}
+
+// Unwrap unwraps the SourceReport entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sr *SourceReport) Unwrap() *SourceReport {
+	_tx, ok := sr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SourceReport is not a transactional entity")
+	}
+	sr.config.driver = _tx.drv
+	return sr
+}
+
+// String implements the fmt.Stringer.
+func (sr *SourceReport) String() string {
+	var builder strings.Builder
+	builder.WriteString("SourceReport(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sr.ID))
+	builder.WriteString("varsity_code=")
+	builder.WriteString(sr.VarsityCode)
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(sr.Source)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(sr.Name)
+	builder.WriteString(", ")
+	builder.WriteString("url=")
+	builder.WriteString(sr.URL)
+	builder.WriteString(", ")
+	builder.WriteString("heading_codes=")
+	builder.WriteString(fmt.Sprintf("%v", sr.HeadingCodes))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", sr.Status))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(sr.Error)
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(sr.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("duration_ms=")
+	builder.WriteString(fmt.Sprintf("%v", sr.DurationMs))
+	builder.WriteString(", ")
+	builder.WriteString("retries=")
+	builder.WriteString(fmt.Sprintf("%v", sr.Retries))
+	builder.WriteString(", ")
+	builder.WriteString("headings=")
+	builder.WriteString(fmt.Sprintf("%v", sr.Headings))
+	builder.WriteString(", ")
+	builder.WriteString("applications=")
+	builder.WriteString(fmt.Sprintf("%v", sr.Applications))
+	builder.WriteString(", ")
+	builder.WriteString("skipped_rows=")
+	builder.WriteString(fmt.Sprintf("%v", sr.SkippedRows))
+	builder.WriteString(", ")
+	builder.WriteString("failed_lists=")
+	builder.WriteString(fmt.Sprintf("%v", sr.FailedLists))
+	builder.WriteString(", ")
+	builder.WriteString("run_id=")
+	builder.WriteString(fmt.Sprintf("%v", sr.RunID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SourceReports is a parsable slice of SourceReport.
+type SourceReports []*SourceReport
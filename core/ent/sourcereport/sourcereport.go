@@ -0,0 +1,183 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sourcereport
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the sourcereport type in the database.
+	Label = "source_report"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldVarsityCode holds the string denoting the varsity_code field in the database.
+	FieldVarsityCode = "varsity_code"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldURL holds the string denoting the url field in the database.
+	FieldURL = "url"
+	// FieldHeadingCodes holds the string denoting the heading_codes field in the database.
+	FieldHeadingCodes = "heading_codes"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldStartedAt holds the string denoting the started_at field in the database.
+	FieldStartedAt = "started_at"
+	// FieldDurationMs holds the string denoting the duration_ms field in the database.
+	FieldDurationMs = "duration_ms"
+	// FieldRetries holds the string denoting the retries field in the database.
+	FieldRetries = "retries"
+	// FieldHeadings holds the string denoting the headings field in the database.
+	FieldHeadings = "headings"
+	// FieldApplications holds the string denoting the applications field in the database.
+	FieldApplications = "applications"
+	// FieldSkippedRows holds the string denoting the skipped_rows field in the database.
+	FieldSkippedRows = "skipped_rows"
+	// FieldFailedLists holds the string denoting the failed_lists field in the database.
+	FieldFailedLists = "failed_lists"
+	// FieldRunID holds the string denoting the run_id field in the database.
+	FieldRunID = "run_id"
+	// EdgeRun holds the string denoting the run edge name in mutations.
+	EdgeRun = "run"
+	// Table holds the table name of the sourcereport in the database.
+	Table = "source_reports"
+	// RunTable is the table that holds the run relation/edge.
+	RunTable = "source_reports"
+	// RunInverseTable is the table name for the Run entity.
+	// It exists in this package in order to avoid circular dependency with the "run" package.
+	RunInverseTable = "runs"
+	// RunColumn is the table column denoting the run relation/edge.
+	RunColumn = "run_id"
+)
+
+// Columns holds all SQL columns for sourcereport fields.
+var Columns = []string{
+	FieldID,
+	FieldVarsityCode,
+	FieldSource,
+	FieldName,
+	FieldURL,
+	FieldHeadingCodes,
+	FieldStatus,
+	FieldError,
+	FieldStartedAt,
+	FieldDurationMs,
+	FieldRetries,
+	FieldHeadings,
+	FieldApplications,
+	FieldSkippedRows,
+	FieldFailedLists,
+	FieldRunID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultFailedLists holds the default value on creation for the "failed_lists" field.
+	DefaultFailedLists int
+)
+
+// OrderOption defines the ordering options for the SourceReport queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByVarsityCode orders the results by the varsity_code field.
+func ByVarsityCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVarsityCode, opts...).ToFunc()
+}
+
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByURL orders the results by the url field.
+func ByURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldURL, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
+
+// ByStartedAt orders the results by the started_at field.
+func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
+}
+
+// ByDurationMs orders the results by the duration_ms field.
+func ByDurationMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDurationMs, opts...).ToFunc()
+}
+
+// ByRetries orders the results by the retries field.
+func ByRetries(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRetries, opts...).ToFunc()
+}
+
+// ByHeadings orders the results by the headings field.
+func ByHeadings(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHeadings, opts...).ToFunc()
+}
+
+// ByApplications orders the results by the applications field.
+func ByApplications(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApplications, opts...).ToFunc()
+}
+
+// BySkippedRows orders the results by the skipped_rows field.
+func BySkippedRows(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSkippedRows, opts...).ToFunc()
+}
+
+// ByFailedLists orders the results by the failed_lists field.
+func ByFailedLists(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFailedLists, opts...).ToFunc()
+}
+
+// ByRunID orders the results by the run_id field.
+func ByRunID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRunID, opts...).ToFunc()
+}
+
+// ByRunField orders the results by run field.
+func ByRunField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRunStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newRunStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RunInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, RunTable, RunColumn),
+	)
+}
@@ -0,0 +1,915 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sourcereport
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldLTE(FieldID, id))
+}
+
+// VarsityCode applies equality check predicate on the "varsity_code" field. It's identical to VarsityCodeEQ.
+func VarsityCode(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldEQ(FieldVarsityCode, v))
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldEQ(FieldSource, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldEQ(FieldName, v))
+}
+
+// URL applies equality check predicate on the "url" field. It's identical to URLEQ.
+func URL(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldEQ(FieldURL, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v core.SourceLoadStatus) predicate.SourceReport {
+	vc := string(v)
+	return predicate.SourceReport(sql.FieldEQ(FieldStatus, vc))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldEQ(FieldError, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// DurationMs applies equality check predicate on the "duration_ms" field. It's identical to DurationMsEQ.
+func DurationMs(v int64) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldEQ(FieldDurationMs, v))
+}
+
+// Retries applies equality check predicate on the "retries" field. It's identical to RetriesEQ.
+func Retries(v int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldEQ(FieldRetries, v))
+}
+
+// Headings applies equality check predicate on the "headings" field. It's identical to HeadingsEQ.
+func Headings(v int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldEQ(FieldHeadings, v))
+}
+
+// Applications applies equality check predicate on the "applications" field. It's identical to ApplicationsEQ.
+func Applications(v int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldEQ(FieldApplications, v))
+}
+
+// SkippedRows applies equality check predicate on the "skipped_rows" field. It's identical to SkippedRowsEQ.
+func SkippedRows(v int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldEQ(FieldSkippedRows, v))
+}
+
+// FailedLists applies equality check predicate on the "failed_lists" field. It's identical to FailedListsEQ.
+func FailedLists(v int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldEQ(FieldFailedLists, v))
+}
+
+// RunID applies equality check predicate on the "run_id" field. It's identical to RunIDEQ.
+func RunID(v int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldEQ(FieldRunID, v))
+}
+
+// VarsityCodeEQ applies the EQ predicate on the "varsity_code" field.
+func VarsityCodeEQ(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldEQ(FieldVarsityCode, v))
+}
+
+// VarsityCodeNEQ applies the NEQ predicate on the "varsity_code" field.
+func VarsityCodeNEQ(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldNEQ(FieldVarsityCode, v))
+}
+
+// VarsityCodeIn applies the In predicate on the "varsity_code" field.
+func VarsityCodeIn(vs ...string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldIn(FieldVarsityCode, vs...))
+}
+
+// VarsityCodeNotIn applies the NotIn predicate on the "varsity_code" field.
+func VarsityCodeNotIn(vs ...string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldNotIn(FieldVarsityCode, vs...))
+}
+
+// VarsityCodeGT applies the GT predicate on the "varsity_code" field.
+func VarsityCodeGT(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldGT(FieldVarsityCode, v))
+}
+
+// VarsityCodeGTE applies the GTE predicate on the "varsity_code" field.
+func VarsityCodeGTE(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldGTE(FieldVarsityCode, v))
+}
+
+// VarsityCodeLT applies the LT predicate on the "varsity_code" field.
+func VarsityCodeLT(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldLT(FieldVarsityCode, v))
+}
+
+// VarsityCodeLTE applies the LTE predicate on the "varsity_code" field.
+func VarsityCodeLTE(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldLTE(FieldVarsityCode, v))
+}
+
+// VarsityCodeContains applies the Contains predicate on the "varsity_code" field.
+func VarsityCodeContains(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldContains(FieldVarsityCode, v))
+}
+
+// VarsityCodeHasPrefix applies the HasPrefix predicate on the "varsity_code" field.
+func VarsityCodeHasPrefix(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldHasPrefix(FieldVarsityCode, v))
+}
+
+// VarsityCodeHasSuffix applies the HasSuffix predicate on the "varsity_code" field.
+func VarsityCodeHasSuffix(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldHasSuffix(FieldVarsityCode, v))
+}
+
+// VarsityCodeEqualFold applies the EqualFold predicate on the "varsity_code" field.
+func VarsityCodeEqualFold(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldEqualFold(FieldVarsityCode, v))
+}
+
+// VarsityCodeContainsFold applies the ContainsFold predicate on the "varsity_code" field.
+func VarsityCodeContainsFold(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldContainsFold(FieldVarsityCode, v))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldGT(FieldSource, v))
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldGTE(FieldSource, v))
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldLT(FieldSource, v))
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldLTE(FieldSource, v))
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldContains(FieldSource, v))
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldHasPrefix(FieldSource, v))
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldHasSuffix(FieldSource, v))
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldEqualFold(FieldSource, v))
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldContainsFold(FieldSource, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldIsNull(FieldName))
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldNotNull(FieldName))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldContainsFold(FieldName, v))
+}
+
+// URLEQ applies the EQ predicate on the "url" field.
+func URLEQ(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldEQ(FieldURL, v))
+}
+
+// URLNEQ applies the NEQ predicate on the "url" field.
+func URLNEQ(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldNEQ(FieldURL, v))
+}
+
+// URLIn applies the In predicate on the "url" field.
+func URLIn(vs ...string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldIn(FieldURL, vs...))
+}
+
+// URLNotIn applies the NotIn predicate on the "url" field.
+func URLNotIn(vs ...string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldNotIn(FieldURL, vs...))
+}
+
+// URLGT applies the GT predicate on the "url" field.
+func URLGT(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldGT(FieldURL, v))
+}
+
+// URLGTE applies the GTE predicate on the "url" field.
+func URLGTE(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldGTE(FieldURL, v))
+}
+
+// URLLT applies the LT predicate on the "url" field.
+func URLLT(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldLT(FieldURL, v))
+}
+
+// URLLTE applies the LTE predicate on the "url" field.
+func URLLTE(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldLTE(FieldURL, v))
+}
+
+// URLContains applies the Contains predicate on the "url" field.
+func URLContains(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldContains(FieldURL, v))
+}
+
+// URLHasPrefix applies the HasPrefix predicate on the "url" field.
+func URLHasPrefix(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldHasPrefix(FieldURL, v))
+}
+
+// URLHasSuffix applies the HasSuffix predicate on the "url" field.
+func URLHasSuffix(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldHasSuffix(FieldURL, v))
+}
+
+// URLIsNil applies the IsNil predicate on the "url" field.
+func URLIsNil() predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldIsNull(FieldURL))
+}
+
+// URLNotNil applies the NotNil predicate on the "url" field.
+func URLNotNil() predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldNotNull(FieldURL))
+}
+
+// URLEqualFold applies the EqualFold predicate on the "url" field.
+func URLEqualFold(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldEqualFold(FieldURL, v))
+}
+
+// URLContainsFold applies the ContainsFold predicate on the "url" field.
+func URLContainsFold(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldContainsFold(FieldURL, v))
+}
+
+// HeadingCodesIsNil applies the IsNil predicate on the "heading_codes" field.
+func HeadingCodesIsNil() predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldIsNull(FieldHeadingCodes))
+}
+
+// HeadingCodesNotNil applies the NotNil predicate on the "heading_codes" field.
+func HeadingCodesNotNil() predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldNotNull(FieldHeadingCodes))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v core.SourceLoadStatus) predicate.SourceReport {
+	vc := string(v)
+	return predicate.SourceReport(sql.FieldEQ(FieldStatus, vc))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v core.SourceLoadStatus) predicate.SourceReport {
+	vc := string(v)
+	return predicate.SourceReport(sql.FieldNEQ(FieldStatus, vc))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...core.SourceLoadStatus) predicate.SourceReport {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.SourceReport(sql.FieldIn(FieldStatus, v...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...core.SourceLoadStatus) predicate.SourceReport {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.SourceReport(sql.FieldNotIn(FieldStatus, v...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v core.SourceLoadStatus) predicate.SourceReport {
+	vc := string(v)
+	return predicate.SourceReport(sql.FieldGT(FieldStatus, vc))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v core.SourceLoadStatus) predicate.SourceReport {
+	vc := string(v)
+	return predicate.SourceReport(sql.FieldGTE(FieldStatus, vc))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v core.SourceLoadStatus) predicate.SourceReport {
+	vc := string(v)
+	return predicate.SourceReport(sql.FieldLT(FieldStatus, vc))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v core.SourceLoadStatus) predicate.SourceReport {
+	vc := string(v)
+	return predicate.SourceReport(sql.FieldLTE(FieldStatus, vc))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v core.SourceLoadStatus) predicate.SourceReport {
+	vc := string(v)
+	return predicate.SourceReport(sql.FieldContains(FieldStatus, vc))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v core.SourceLoadStatus) predicate.SourceReport {
+	vc := string(v)
+	return predicate.SourceReport(sql.FieldHasPrefix(FieldStatus, vc))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v core.SourceLoadStatus) predicate.SourceReport {
+	vc := string(v)
+	return predicate.SourceReport(sql.FieldHasSuffix(FieldStatus, vc))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v core.SourceLoadStatus) predicate.SourceReport {
+	vc := string(v)
+	return predicate.SourceReport(sql.FieldEqualFold(FieldStatus, vc))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v core.SourceLoadStatus) predicate.SourceReport {
+	vc := string(v)
+	return predicate.SourceReport(sql.FieldContainsFold(FieldStatus, vc))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldContainsFold(FieldError, v))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// DurationMsEQ applies the EQ predicate on the "duration_ms" field.
+func DurationMsEQ(v int64) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldEQ(FieldDurationMs, v))
+}
+
+// DurationMsNEQ applies the NEQ predicate on the "duration_ms" field.
+func DurationMsNEQ(v int64) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldNEQ(FieldDurationMs, v))
+}
+
+// DurationMsIn applies the In predicate on the "duration_ms" field.
+func DurationMsIn(vs ...int64) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldIn(FieldDurationMs, vs...))
+}
+
+// DurationMsNotIn applies the NotIn predicate on the "duration_ms" field.
+func DurationMsNotIn(vs ...int64) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldNotIn(FieldDurationMs, vs...))
+}
+
+// DurationMsGT applies the GT predicate on the "duration_ms" field.
+func DurationMsGT(v int64) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldGT(FieldDurationMs, v))
+}
+
+// DurationMsGTE applies the GTE predicate on the "duration_ms" field.
+func DurationMsGTE(v int64) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldGTE(FieldDurationMs, v))
+}
+
+// DurationMsLT applies the LT predicate on the "duration_ms" field.
+func DurationMsLT(v int64) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldLT(FieldDurationMs, v))
+}
+
+// DurationMsLTE applies the LTE predicate on the "duration_ms" field.
+func DurationMsLTE(v int64) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldLTE(FieldDurationMs, v))
+}
+
+// RetriesEQ applies the EQ predicate on the "retries" field.
+func RetriesEQ(v int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldEQ(FieldRetries, v))
+}
+
+// RetriesNEQ applies the NEQ predicate on the "retries" field.
+func RetriesNEQ(v int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldNEQ(FieldRetries, v))
+}
+
+// RetriesIn applies the In predicate on the "retries" field.
+func RetriesIn(vs ...int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldIn(FieldRetries, vs...))
+}
+
+// RetriesNotIn applies the NotIn predicate on the "retries" field.
+func RetriesNotIn(vs ...int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldNotIn(FieldRetries, vs...))
+}
+
+// RetriesGT applies the GT predicate on the "retries" field.
+func RetriesGT(v int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldGT(FieldRetries, v))
+}
+
+// RetriesGTE applies the GTE predicate on the "retries" field.
+func RetriesGTE(v int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldGTE(FieldRetries, v))
+}
+
+// RetriesLT applies the LT predicate on the "retries" field.
+func RetriesLT(v int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldLT(FieldRetries, v))
+}
+
+// RetriesLTE applies the LTE predicate on the "retries" field.
+func RetriesLTE(v int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldLTE(FieldRetries, v))
+}
+
+// HeadingsEQ applies the EQ predicate on the "headings" field.
+func HeadingsEQ(v int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldEQ(FieldHeadings, v))
+}
+
+// HeadingsNEQ applies the NEQ predicate on the "headings" field.
+func HeadingsNEQ(v int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldNEQ(FieldHeadings, v))
+}
+
+// HeadingsIn applies the In predicate on the "headings" field.
+func HeadingsIn(vs ...int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldIn(FieldHeadings, vs...))
+}
+
+// HeadingsNotIn applies the NotIn predicate on the "headings" field.
+func HeadingsNotIn(vs ...int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldNotIn(FieldHeadings, vs...))
+}
+
+// HeadingsGT applies the GT predicate on the "headings" field.
+func HeadingsGT(v int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldGT(FieldHeadings, v))
+}
+
+// HeadingsGTE applies the GTE predicate on the "headings" field.
+func HeadingsGTE(v int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldGTE(FieldHeadings, v))
+}
+
+// HeadingsLT applies the LT predicate on the "headings" field.
+func HeadingsLT(v int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldLT(FieldHeadings, v))
+}
+
+// HeadingsLTE applies the LTE predicate on the "headings" field.
+func HeadingsLTE(v int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldLTE(FieldHeadings, v))
+}
+
+// ApplicationsEQ applies the EQ predicate on the "applications" field.
+func ApplicationsEQ(v int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldEQ(FieldApplications, v))
+}
+
+// ApplicationsNEQ applies the NEQ predicate on the "applications" field.
+func ApplicationsNEQ(v int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldNEQ(FieldApplications, v))
+}
+
+// ApplicationsIn applies the In predicate on the "applications" field.
+func ApplicationsIn(vs ...int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldIn(FieldApplications, vs...))
+}
+
+// ApplicationsNotIn applies the NotIn predicate on the "applications" field.
+func ApplicationsNotIn(vs ...int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldNotIn(FieldApplications, vs...))
+}
+
+// ApplicationsGT applies the GT predicate on the "applications" field.
+func ApplicationsGT(v int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldGT(FieldApplications, v))
+}
+
+// ApplicationsGTE applies the GTE predicate on the "applications" field.
+func ApplicationsGTE(v int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldGTE(FieldApplications, v))
+}
+
+// ApplicationsLT applies the LT predicate on the "applications" field.
+func ApplicationsLT(v int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldLT(FieldApplications, v))
+}
+
+// ApplicationsLTE applies the LTE predicate on the "applications" field.
+func ApplicationsLTE(v int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldLTE(FieldApplications, v))
+}
+
+// SkippedRowsEQ applies the EQ predicate on the "skipped_rows" field.
+func SkippedRowsEQ(v int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldEQ(FieldSkippedRows, v))
+}
+
+// SkippedRowsNEQ applies the NEQ predicate on the "skipped_rows" field.
+func SkippedRowsNEQ(v int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldNEQ(FieldSkippedRows, v))
+}
+
+// SkippedRowsIn applies the In predicate on the "skipped_rows" field.
+func SkippedRowsIn(vs ...int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldIn(FieldSkippedRows, vs...))
+}
+
+// SkippedRowsNotIn applies the NotIn predicate on the "skipped_rows" field.
+func SkippedRowsNotIn(vs ...int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldNotIn(FieldSkippedRows, vs...))
+}
+
+// SkippedRowsGT applies the GT predicate on the "skipped_rows" field.
+func SkippedRowsGT(v int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldGT(FieldSkippedRows, v))
+}
+
+// SkippedRowsGTE applies the GTE predicate on the "skipped_rows" field.
+func SkippedRowsGTE(v int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldGTE(FieldSkippedRows, v))
+}
+
+// SkippedRowsLT applies the LT predicate on the "skipped_rows" field.
+func SkippedRowsLT(v int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldLT(FieldSkippedRows, v))
+}
+
+// SkippedRowsLTE applies the LTE predicate on the "skipped_rows" field.
+func SkippedRowsLTE(v int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldLTE(FieldSkippedRows, v))
+}
+
+// FailedListsEQ applies the EQ predicate on the "failed_lists" field.
+func FailedListsEQ(v int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldEQ(FieldFailedLists, v))
+}
+
+// FailedListsNEQ applies the NEQ predicate on the "failed_lists" field.
+func FailedListsNEQ(v int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldNEQ(FieldFailedLists, v))
+}
+
+// FailedListsIn applies the In predicate on the "failed_lists" field.
+func FailedListsIn(vs ...int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldIn(FieldFailedLists, vs...))
+}
+
+// FailedListsNotIn applies the NotIn predicate on the "failed_lists" field.
+func FailedListsNotIn(vs ...int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldNotIn(FieldFailedLists, vs...))
+}
+
+// FailedListsGT applies the GT predicate on the "failed_lists" field.
+func FailedListsGT(v int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldGT(FieldFailedLists, v))
+}
+
+// FailedListsGTE applies the GTE predicate on the "failed_lists" field.
+func FailedListsGTE(v int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldGTE(FieldFailedLists, v))
+}
+
+// FailedListsLT applies the LT predicate on the "failed_lists" field.
+func FailedListsLT(v int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldLT(FieldFailedLists, v))
+}
+
+// FailedListsLTE applies the LTE predicate on the "failed_lists" field.
+func FailedListsLTE(v int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldLTE(FieldFailedLists, v))
+}
+
+// RunIDEQ applies the EQ predicate on the "run_id" field.
+func RunIDEQ(v int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldEQ(FieldRunID, v))
+}
+
+// RunIDNEQ applies the NEQ predicate on the "run_id" field.
+func RunIDNEQ(v int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldNEQ(FieldRunID, v))
+}
+
+// RunIDIn applies the In predicate on the "run_id" field.
+func RunIDIn(vs ...int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldIn(FieldRunID, vs...))
+}
+
+// RunIDNotIn applies the NotIn predicate on the "run_id" field.
+func RunIDNotIn(vs ...int) predicate.SourceReport {
+	return predicate.SourceReport(sql.FieldNotIn(FieldRunID, vs...))
+}
+
+// HasRun applies the HasEdge predicate on the "run" edge.
+func HasRun() predicate.SourceReport {
+	return predicate.SourceReport(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, RunTable, RunColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRunWith applies the HasEdge predicate on the "run" edge with a given conditions (other predicates).
+func HasRunWith(preds ...predicate.Run) predicate.SourceReport {
+	return predicate.SourceReport(func(s *sql.Selector) {
+		step := newRunStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SourceReport) predicate.SourceReport {
+	return predicate.SourceReport(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SourceReport) predicate.SourceReport {
+	return predicate.SourceReport(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SourceReport) predicate.SourceReport {
+	return predicate.SourceReport(sql.NotPredicates(p))
+}
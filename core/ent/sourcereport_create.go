@@ -0,0 +1,419 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/ent/run"
+	"github.com/trueegorletov/analabit/core/ent/sourcereport"
+)
+
+// SourceReportCreate is the builder for creating a SourceReport entity.
+type SourceReportCreate struct {
+	config
+	mutation *SourceReportMutation
+	hooks    []Hook
+}
+
+// SetVarsityCode sets the "varsity_code" field.
+func (src *SourceReportCreate) SetVarsityCode(s string) *SourceReportCreate {
+	src.mutation.SetVarsityCode(s)
+	return src
+}
+
+// SetSource sets the "source" field.
+func (src *SourceReportCreate) SetSource(s string) *SourceReportCreate {
+	src.mutation.SetSource(s)
+	return src
+}
+
+// SetName sets the "name" field.
+func (src *SourceReportCreate) SetName(s string) *SourceReportCreate {
+	src.mutation.SetName(s)
+	return src
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (src *SourceReportCreate) SetNillableName(s *string) *SourceReportCreate {
+	if s != nil {
+		src.SetName(*s)
+	}
+	return src
+}
+
+// SetURL sets the "url" field.
+func (src *SourceReportCreate) SetURL(s string) *SourceReportCreate {
+	src.mutation.SetURL(s)
+	return src
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (src *SourceReportCreate) SetNillableURL(s *string) *SourceReportCreate {
+	if s != nil {
+		src.SetURL(*s)
+	}
+	return src
+}
+
+// SetHeadingCodes sets the "heading_codes" field.
+func (src *SourceReportCreate) SetHeadingCodes(s []string) *SourceReportCreate {
+	src.mutation.SetHeadingCodes(s)
+	return src
+}
+
+// SetStatus sets the "status" field.
+func (src *SourceReportCreate) SetStatus(cls core.SourceLoadStatus) *SourceReportCreate {
+	src.mutation.SetStatus(cls)
+	return src
+}
+
+// SetError sets the "error" field.
+func (src *SourceReportCreate) SetError(s string) *SourceReportCreate {
+	src.mutation.SetError(s)
+	return src
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (src *SourceReportCreate) SetNillableError(s *string) *SourceReportCreate {
+	if s != nil {
+		src.SetError(*s)
+	}
+	return src
+}
+
+// SetStartedAt sets the "started_at" field.
+func (src *SourceReportCreate) SetStartedAt(t time.Time) *SourceReportCreate {
+	src.mutation.SetStartedAt(t)
+	return src
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (src *SourceReportCreate) SetDurationMs(i int64) *SourceReportCreate {
+	src.mutation.SetDurationMs(i)
+	return src
+}
+
+// SetRetries sets the "retries" field.
+func (src *SourceReportCreate) SetRetries(i int) *SourceReportCreate {
+	src.mutation.SetRetries(i)
+	return src
+}
+
+// SetHeadings sets the "headings" field.
+func (src *SourceReportCreate) SetHeadings(i int) *SourceReportCreate {
+	src.mutation.SetHeadings(i)
+	return src
+}
+
+// SetApplications sets the "applications" field.
+func (src *SourceReportCreate) SetApplications(i int) *SourceReportCreate {
+	src.mutation.SetApplications(i)
+	return src
+}
+
+// SetSkippedRows sets the "skipped_rows" field.
+func (src *SourceReportCreate) SetSkippedRows(i int) *SourceReportCreate {
+	src.mutation.SetSkippedRows(i)
+	return src
+}
+
+// SetFailedLists sets the "failed_lists" field.
+func (src *SourceReportCreate) SetFailedLists(i int) *SourceReportCreate {
+	src.mutation.SetFailedLists(i)
+	return src
+}
+
+// SetNillableFailedLists sets the "failed_lists" field if the given value is not nil.
+func (src *SourceReportCreate) SetNillableFailedLists(i *int) *SourceReportCreate {
+	if i != nil {
+		src.SetFailedLists(*i)
+	}
+	return src
+}
+
+// SetRunID sets the "run_id" field.
+func (src *SourceReportCreate) SetRunID(i int) *SourceReportCreate {
+	src.mutation.SetRunID(i)
+	return src
+}
+
+// SetRun sets the "run" edge to the Run entity.
+func (src *SourceReportCreate) SetRun(r *Run) *SourceReportCreate {
+	return src.SetRunID(r.ID)
+}
+
+// Mutation returns the SourceReportMutation object of the builder.
+func (src *SourceReportCreate) Mutation() *SourceReportMutation {
+	return src.mutation
+}
+
+// Save creates the SourceReport in the database.
+func (src *SourceReportCreate) Save(ctx context.Context) (*SourceReport, error) {
+	src.defaults()
+	return withHooks(ctx, src.sqlSave, src.mutation, src.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (src *SourceReportCreate) SaveX(ctx context.Context) *SourceReport {
+	v, err := src.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (src *SourceReportCreate) Exec(ctx context.Context) error {
+	_, err := src.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (src *SourceReportCreate) ExecX(ctx context.Context) {
+	if err := src.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (src *SourceReportCreate) defaults() {
+	if _, ok := src.mutation.FailedLists(); !ok {
+		v := sourcereport.DefaultFailedLists
+		src.mutation.SetFailedLists(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (src *SourceReportCreate) check() error {
+	if _, ok := src.mutation.VarsityCode(); !ok {
+		return &ValidationError{Name: "varsity_code", err: errors.New(`ent: missing required field "SourceReport.varsity_code"`)}
+	}
+	if _, ok := src.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "SourceReport.source"`)}
+	}
+	if _, ok := src.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "SourceReport.status"`)}
+	}
+	if _, ok := src.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "SourceReport.started_at"`)}
+	}
+	if _, ok := src.mutation.DurationMs(); !ok {
+		return &ValidationError{Name: "duration_ms", err: errors.New(`ent: missing required field "SourceReport.duration_ms"`)}
+	}
+	if _, ok := src.mutation.Retries(); !ok {
+		return &ValidationError{Name: "retries", err: errors.New(`ent: missing required field "SourceReport.retries"`)}
+	}
+	if _, ok := src.mutation.Headings(); !ok {
+		return &ValidationError{Name: "headings", err: errors.New(`ent: missing required field "SourceReport.headings"`)}
+	}
+	if _, ok := src.mutation.Applications(); !ok {
+		return &ValidationError{Name: "applications", err: errors.New(`ent: missing required field "SourceReport.applications"`)}
+	}
+	if _, ok := src.mutation.SkippedRows(); !ok {
+		return &ValidationError{Name: "skipped_rows", err: errors.New(`ent: missing required field "SourceReport.skipped_rows"`)}
+	}
+	if _, ok := src.mutation.FailedLists(); !ok {
+		return &ValidationError{Name: "failed_lists", err: errors.New(`ent: missing required field "SourceReport.failed_lists"`)}
+	}
+	if _, ok := src.mutation.RunID(); !ok {
+		return &ValidationError{Name: "run_id", err: errors.New(`ent: missing required field "SourceReport.run_id"`)}
+	}
+	if len(src.mutation.RunIDs()) == 0 {
+		return &ValidationError{Name: "run", err: errors.New(`ent: missing required edge "SourceReport.run"`)}
+	}
+	return nil
+}
+
+func (src *SourceReportCreate) sqlSave(ctx context.Context) (*SourceReport, error) {
+	if err := src.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := src.createSpec()
+	if err := sqlgraph.CreateNode(ctx, src.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	src.mutation.id = &_node.ID
+	src.mutation.done = true
+	return _node, nil
+}
+
+func (src *SourceReportCreate) createSpec() (*SourceReport, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SourceReport{config: src.config}
+		_spec = sqlgraph.NewCreateSpec(sourcereport.Table, sqlgraph.NewFieldSpec(sourcereport.FieldID, field.TypeInt))
+	)
+	if value, ok := src.mutation.VarsityCode(); ok {
+		_spec.SetField(sourcereport.FieldVarsityCode, field.TypeString, value)
+		_node.VarsityCode = value
+	}
+	if value, ok := src.mutation.Source(); ok {
+		_spec.SetField(sourcereport.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := src.mutation.Name(); ok {
+		_spec.SetField(sourcereport.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := src.mutation.URL(); ok {
+		_spec.SetField(sourcereport.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := src.mutation.HeadingCodes(); ok {
+		_spec.SetField(sourcereport.FieldHeadingCodes, field.TypeJSON, value)
+		_node.HeadingCodes = value
+	}
+	if value, ok := src.mutation.Status(); ok {
+		_spec.SetField(sourcereport.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := src.mutation.Error(); ok {
+		_spec.SetField(sourcereport.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := src.mutation.StartedAt(); ok {
+		_spec.SetField(sourcereport.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := src.mutation.DurationMs(); ok {
+		_spec.SetField(sourcereport.FieldDurationMs, field.TypeInt64, value)
+		_node.DurationMs = value
+	}
+	if value, ok := src.mutation.Retries(); ok {
+		_spec.SetField(sourcereport.FieldRetries, field.TypeInt, value)
+		_node.Retries = value
+	}
+	if value, ok := src.mutation.Headings(); ok {
+		_spec.SetField(sourcereport.FieldHeadings, field.TypeInt, value)
+		_node.Headings = value
+	}
+	if value, ok := src.mutation.Applications(); ok {
+		_spec.SetField(sourcereport.FieldApplications, field.TypeInt, value)
+		_node.Applications = value
+	}
+	if value, ok := src.mutation.SkippedRows(); ok {
+		_spec.SetField(sourcereport.FieldSkippedRows, field.TypeInt, value)
+		_node.SkippedRows = value
+	}
+	if value, ok := src.mutation.FailedLists(); ok {
+		_spec.SetField(sourcereport.FieldFailedLists, field.TypeInt, value)
+		_node.FailedLists = value
+	}
+	if nodes := src.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   sourcereport.RunTable,
+			Columns: []string{sourcereport.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(run.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RunID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SourceReportCreateBulk is the builder for creating many SourceReport entities in bulk.
+type SourceReportCreateBulk struct {
+	config
+	err      error
+	builders []*SourceReportCreate
+}
+
+// Save creates the SourceReport entities in the database.
+func (srcb *SourceReportCreateBulk) Save(ctx context.Context) ([]*SourceReport, error) {
+	if srcb.err != nil {
+		return nil, srcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(srcb.builders))
+	nodes := make([]*SourceReport, len(srcb.builders))
+	mutators := make([]Mutator, len(srcb.builders))
+	for i := range srcb.builders {
+		func(i int, root context.Context) {
+			builder := srcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SourceReportMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, srcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, srcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, srcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (srcb *SourceReportCreateBulk) SaveX(ctx context.Context) []*SourceReport {
+	v, err := srcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (srcb *SourceReportCreateBulk) Exec(ctx context.Context) error {
+	_, err := srcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srcb *SourceReportCreateBulk) ExecX(ctx context.Context) {
+	if err := srcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,983 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/ent/predicate"
+	"github.com/trueegorletov/analabit/core/ent/run"
+	"github.com/trueegorletov/analabit/core/ent/sourcereport"
+)
+
+// SourceReportUpdate is the builder for updating SourceReport entities.
+type SourceReportUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SourceReportMutation
+}
+
+// Where appends a list predicates to the SourceReportUpdate builder.
+func (sru *SourceReportUpdate) Where(ps ...predicate.SourceReport) *SourceReportUpdate {
+	sru.mutation.Where(ps...)
+	return sru
+}
+
+// SetVarsityCode sets the "varsity_code" field.
+func (sru *SourceReportUpdate) SetVarsityCode(s string) *SourceReportUpdate {
+	sru.mutation.SetVarsityCode(s)
+	return sru
+}
+
+// SetNillableVarsityCode sets the "varsity_code" field if the given value is not nil.
+func (sru *SourceReportUpdate) SetNillableVarsityCode(s *string) *SourceReportUpdate {
+	if s != nil {
+		sru.SetVarsityCode(*s)
+	}
+	return sru
+}
+
+// SetSource sets the "source" field.
+func (sru *SourceReportUpdate) SetSource(s string) *SourceReportUpdate {
+	sru.mutation.SetSource(s)
+	return sru
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (sru *SourceReportUpdate) SetNillableSource(s *string) *SourceReportUpdate {
+	if s != nil {
+		sru.SetSource(*s)
+	}
+	return sru
+}
+
+// SetName sets the "name" field.
+func (sru *SourceReportUpdate) SetName(s string) *SourceReportUpdate {
+	sru.mutation.SetName(s)
+	return sru
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (sru *SourceReportUpdate) SetNillableName(s *string) *SourceReportUpdate {
+	if s != nil {
+		sru.SetName(*s)
+	}
+	return sru
+}
+
+// ClearName clears the value of the "name" field.
+func (sru *SourceReportUpdate) ClearName() *SourceReportUpdate {
+	sru.mutation.ClearName()
+	return sru
+}
+
+// SetURL sets the "url" field.
+func (sru *SourceReportUpdate) SetURL(s string) *SourceReportUpdate {
+	sru.mutation.SetURL(s)
+	return sru
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (sru *SourceReportUpdate) SetNillableURL(s *string) *SourceReportUpdate {
+	if s != nil {
+		sru.SetURL(*s)
+	}
+	return sru
+}
+
+// ClearURL clears the value of the "url" field.
+func (sru *SourceReportUpdate) ClearURL() *SourceReportUpdate {
+	sru.mutation.ClearURL()
+	return sru
+}
+
+// SetHeadingCodes sets the "heading_codes" field.
+func (sru *SourceReportUpdate) SetHeadingCodes(s []string) *SourceReportUpdate {
+	sru.mutation.SetHeadingCodes(s)
+	return sru
+}
+
+// AppendHeadingCodes appends s to the "heading_codes" field.
+func (sru *SourceReportUpdate) AppendHeadingCodes(s []string) *SourceReportUpdate {
+	sru.mutation.AppendHeadingCodes(s)
+	return sru
+}
+
+// ClearHeadingCodes clears the value of the "heading_codes" field.
+func (sru *SourceReportUpdate) ClearHeadingCodes() *SourceReportUpdate {
+	sru.mutation.ClearHeadingCodes()
+	return sru
+}
+
+// SetStatus sets the "status" field.
+func (sru *SourceReportUpdate) SetStatus(cls core.SourceLoadStatus) *SourceReportUpdate {
+	sru.mutation.SetStatus(cls)
+	return sru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (sru *SourceReportUpdate) SetNillableStatus(cls *core.SourceLoadStatus) *SourceReportUpdate {
+	if cls != nil {
+		sru.SetStatus(*cls)
+	}
+	return sru
+}
+
+// SetError sets the "error" field.
+func (sru *SourceReportUpdate) SetError(s string) *SourceReportUpdate {
+	sru.mutation.SetError(s)
+	return sru
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (sru *SourceReportUpdate) SetNillableError(s *string) *SourceReportUpdate {
+	if s != nil {
+		sru.SetError(*s)
+	}
+	return sru
+}
+
+// ClearError clears the value of the "error" field.
+func (sru *SourceReportUpdate) ClearError() *SourceReportUpdate {
+	sru.mutation.ClearError()
+	return sru
+}
+
+// SetStartedAt sets the "started_at" field.
+func (sru *SourceReportUpdate) SetStartedAt(t time.Time) *SourceReportUpdate {
+	sru.mutation.SetStartedAt(t)
+	return sru
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (sru *SourceReportUpdate) SetNillableStartedAt(t *time.Time) *SourceReportUpdate {
+	if t != nil {
+		sru.SetStartedAt(*t)
+	}
+	return sru
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (sru *SourceReportUpdate) SetDurationMs(i int64) *SourceReportUpdate {
+	sru.mutation.ResetDurationMs()
+	sru.mutation.SetDurationMs(i)
+	return sru
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (sru *SourceReportUpdate) SetNillableDurationMs(i *int64) *SourceReportUpdate {
+	if i != nil {
+		sru.SetDurationMs(*i)
+	}
+	return sru
+}
+
+// AddDurationMs adds i to the "duration_ms" field.
+func (sru *SourceReportUpdate) AddDurationMs(i int64) *SourceReportUpdate {
+	sru.mutation.AddDurationMs(i)
+	return sru
+}
+
+// SetRetries sets the "retries" field.
+func (sru *SourceReportUpdate) SetRetries(i int) *SourceReportUpdate {
+	sru.mutation.ResetRetries()
+	sru.mutation.SetRetries(i)
+	return sru
+}
+
+// SetNillableRetries sets the "retries" field if the given value is not nil.
+func (sru *SourceReportUpdate) SetNillableRetries(i *int) *SourceReportUpdate {
+	if i != nil {
+		sru.SetRetries(*i)
+	}
+	return sru
+}
+
+// AddRetries adds i to the "retries" field.
+func (sru *SourceReportUpdate) AddRetries(i int) *SourceReportUpdate {
+	sru.mutation.AddRetries(i)
+	return sru
+}
+
+// SetHeadings sets the "headings" field.
+func (sru *SourceReportUpdate) SetHeadings(i int) *SourceReportUpdate {
+	sru.mutation.ResetHeadings()
+	sru.mutation.SetHeadings(i)
+	return sru
+}
+
+// SetNillableHeadings sets the "headings" field if the given value is not nil.
+func (sru *SourceReportUpdate) SetNillableHeadings(i *int) *SourceReportUpdate {
+	if i != nil {
+		sru.SetHeadings(*i)
+	}
+	return sru
+}
+
+// AddHeadings adds i to the "headings" field.
+func (sru *SourceReportUpdate) AddHeadings(i int) *SourceReportUpdate {
+	sru.mutation.AddHeadings(i)
+	return sru
+}
+
+// SetApplications sets the "applications" field.
+func (sru *SourceReportUpdate) SetApplications(i int) *SourceReportUpdate {
+	sru.mutation.ResetApplications()
+	sru.mutation.SetApplications(i)
+	return sru
+}
+
+// SetNillableApplications sets the "applications" field if the given value is not nil.
+func (sru *SourceReportUpdate) SetNillableApplications(i *int) *SourceReportUpdate {
+	if i != nil {
+		sru.SetApplications(*i)
+	}
+	return sru
+}
+
+// AddApplications adds i to the "applications" field.
+func (sru *SourceReportUpdate) AddApplications(i int) *SourceReportUpdate {
+	sru.mutation.AddApplications(i)
+	return sru
+}
+
+// SetSkippedRows sets the "skipped_rows" field.
+func (sru *SourceReportUpdate) SetSkippedRows(i int) *SourceReportUpdate {
+	sru.mutation.ResetSkippedRows()
+	sru.mutation.SetSkippedRows(i)
+	return sru
+}
+
+// SetNillableSkippedRows sets the "skipped_rows" field if the given value is not nil.
+func (sru *SourceReportUpdate) SetNillableSkippedRows(i *int) *SourceReportUpdate {
+	if i != nil {
+		sru.SetSkippedRows(*i)
+	}
+	return sru
+}
+
+// AddSkippedRows adds i to the "skipped_rows" field.
+func (sru *SourceReportUpdate) AddSkippedRows(i int) *SourceReportUpdate {
+	sru.mutation.AddSkippedRows(i)
+	return sru
+}
+
+// SetFailedLists sets the "failed_lists" field.
+func (sru *SourceReportUpdate) SetFailedLists(i int) *SourceReportUpdate {
+	sru.mutation.ResetFailedLists()
+	sru.mutation.SetFailedLists(i)
+	return sru
+}
+
+// SetNillableFailedLists sets the "failed_lists" field if the given value is not nil.
+func (sru *SourceReportUpdate) SetNillableFailedLists(i *int) *SourceReportUpdate {
+	if i != nil {
+		sru.SetFailedLists(*i)
+	}
+	return sru
+}
+
+// AddFailedLists adds i to the "failed_lists" field.
+func (sru *SourceReportUpdate) AddFailedLists(i int) *SourceReportUpdate {
+	sru.mutation.AddFailedLists(i)
+	return sru
+}
+
+// SetRunID sets the "run_id" field.
+func (sru *SourceReportUpdate) SetRunID(i int) *SourceReportUpdate {
+	sru.mutation.SetRunID(i)
+	return sru
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (sru *SourceReportUpdate) SetNillableRunID(i *int) *SourceReportUpdate {
+	if i != nil {
+		sru.SetRunID(*i)
+	}
+	return sru
+}
+
+// SetRun sets the "run" edge to the Run entity.
+func (sru *SourceReportUpdate) SetRun(r *Run) *SourceReportUpdate {
+	return sru.SetRunID(r.ID)
+}
+
+// Mutation returns the SourceReportMutation object of the builder.
+func (sru *SourceReportUpdate) Mutation() *SourceReportMutation {
+	return sru.mutation
+}
+
+// ClearRun clears the "run" edge to the Run entity.
+func (sru *SourceReportUpdate) ClearRun() *SourceReportUpdate {
+	sru.mutation.ClearRun()
+	return sru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sru *SourceReportUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, sru.sqlSave, sru.mutation, sru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sru *SourceReportUpdate) SaveX(ctx context.Context) int {
+	affected, err := sru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sru *SourceReportUpdate) Exec(ctx context.Context) error {
+	_, err := sru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sru *SourceReportUpdate) ExecX(ctx context.Context) {
+	if err := sru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sru *SourceReportUpdate) check() error {
+	if sru.mutation.RunCleared() && len(sru.mutation.RunIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SourceReport.run"`)
+	}
+	return nil
+}
+
+func (sru *SourceReportUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := sru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sourcereport.Table, sourcereport.Columns, sqlgraph.NewFieldSpec(sourcereport.FieldID, field.TypeInt))
+	if ps := sru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sru.mutation.VarsityCode(); ok {
+		_spec.SetField(sourcereport.FieldVarsityCode, field.TypeString, value)
+	}
+	if value, ok := sru.mutation.Source(); ok {
+		_spec.SetField(sourcereport.FieldSource, field.TypeString, value)
+	}
+	if value, ok := sru.mutation.Name(); ok {
+		_spec.SetField(sourcereport.FieldName, field.TypeString, value)
+	}
+	if sru.mutation.NameCleared() {
+		_spec.ClearField(sourcereport.FieldName, field.TypeString)
+	}
+	if value, ok := sru.mutation.URL(); ok {
+		_spec.SetField(sourcereport.FieldURL, field.TypeString, value)
+	}
+	if sru.mutation.URLCleared() {
+		_spec.ClearField(sourcereport.FieldURL, field.TypeString)
+	}
+	if value, ok := sru.mutation.HeadingCodes(); ok {
+		_spec.SetField(sourcereport.FieldHeadingCodes, field.TypeJSON, value)
+	}
+	if value, ok := sru.mutation.AppendedHeadingCodes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, sourcereport.FieldHeadingCodes, value)
+		})
+	}
+	if sru.mutation.HeadingCodesCleared() {
+		_spec.ClearField(sourcereport.FieldHeadingCodes, field.TypeJSON)
+	}
+	if value, ok := sru.mutation.Status(); ok {
+		_spec.SetField(sourcereport.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := sru.mutation.Error(); ok {
+		_spec.SetField(sourcereport.FieldError, field.TypeString, value)
+	}
+	if sru.mutation.ErrorCleared() {
+		_spec.ClearField(sourcereport.FieldError, field.TypeString)
+	}
+	if value, ok := sru.mutation.StartedAt(); ok {
+		_spec.SetField(sourcereport.FieldStartedAt, field.TypeTime, value)
+	}
+	if value, ok := sru.mutation.DurationMs(); ok {
+		_spec.SetField(sourcereport.FieldDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := sru.mutation.AddedDurationMs(); ok {
+		_spec.AddField(sourcereport.FieldDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := sru.mutation.Retries(); ok {
+		_spec.SetField(sourcereport.FieldRetries, field.TypeInt, value)
+	}
+	if value, ok := sru.mutation.AddedRetries(); ok {
+		_spec.AddField(sourcereport.FieldRetries, field.TypeInt, value)
+	}
+	if value, ok := sru.mutation.Headings(); ok {
+		_spec.SetField(sourcereport.FieldHeadings, field.TypeInt, value)
+	}
+	if value, ok := sru.mutation.AddedHeadings(); ok {
+		_spec.AddField(sourcereport.FieldHeadings, field.TypeInt, value)
+	}
+	if value, ok := sru.mutation.Applications(); ok {
+		_spec.SetField(sourcereport.FieldApplications, field.TypeInt, value)
+	}
+	if value, ok := sru.mutation.AddedApplications(); ok {
+		_spec.AddField(sourcereport.FieldApplications, field.TypeInt, value)
+	}
+	if value, ok := sru.mutation.SkippedRows(); ok {
+		_spec.SetField(sourcereport.FieldSkippedRows, field.TypeInt, value)
+	}
+	if value, ok := sru.mutation.AddedSkippedRows(); ok {
+		_spec.AddField(sourcereport.FieldSkippedRows, field.TypeInt, value)
+	}
+	if value, ok := sru.mutation.FailedLists(); ok {
+		_spec.SetField(sourcereport.FieldFailedLists, field.TypeInt, value)
+	}
+	if value, ok := sru.mutation.AddedFailedLists(); ok {
+		_spec.AddField(sourcereport.FieldFailedLists, field.TypeInt, value)
+	}
+	if sru.mutation.RunCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   sourcereport.RunTable,
+			Columns: []string{sourcereport.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(run.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sru.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   sourcereport.RunTable,
+			Columns: []string{sourcereport.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(run.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sourcereport.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sru.mutation.done = true
+	return n, nil
+}
+
+// SourceReportUpdateOne is the builder for updating a single SourceReport entity.
+type SourceReportUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SourceReportMutation
+}
+
+// SetVarsityCode sets the "varsity_code" field.
+func (sruo *SourceReportUpdateOne) SetVarsityCode(s string) *SourceReportUpdateOne {
+	sruo.mutation.SetVarsityCode(s)
+	return sruo
+}
+
+// SetNillableVarsityCode sets the "varsity_code" field if the given value is not nil.
+func (sruo *SourceReportUpdateOne) SetNillableVarsityCode(s *string) *SourceReportUpdateOne {
+	if s != nil {
+		sruo.SetVarsityCode(*s)
+	}
+	return sruo
+}
+
+// SetSource sets the "source" field.
+func (sruo *SourceReportUpdateOne) SetSource(s string) *SourceReportUpdateOne {
+	sruo.mutation.SetSource(s)
+	return sruo
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (sruo *SourceReportUpdateOne) SetNillableSource(s *string) *SourceReportUpdateOne {
+	if s != nil {
+		sruo.SetSource(*s)
+	}
+	return sruo
+}
+
+// SetName sets the "name" field.
+func (sruo *SourceReportUpdateOne) SetName(s string) *SourceReportUpdateOne {
+	sruo.mutation.SetName(s)
+	return sruo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (sruo *SourceReportUpdateOne) SetNillableName(s *string) *SourceReportUpdateOne {
+	if s != nil {
+		sruo.SetName(*s)
+	}
+	return sruo
+}
+
+// ClearName clears the value of the "name" field.
+func (sruo *SourceReportUpdateOne) ClearName() *SourceReportUpdateOne {
+	sruo.mutation.ClearName()
+	return sruo
+}
+
+// SetURL sets the "url" field.
+func (sruo *SourceReportUpdateOne) SetURL(s string) *SourceReportUpdateOne {
+	sruo.mutation.SetURL(s)
+	return sruo
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (sruo *SourceReportUpdateOne) SetNillableURL(s *string) *SourceReportUpdateOne {
+	if s != nil {
+		sruo.SetURL(*s)
+	}
+	return sruo
+}
+
+// ClearURL clears the value of the "url" field.
+func (sruo *SourceReportUpdateOne) ClearURL() *SourceReportUpdateOne {
+	sruo.mutation.ClearURL()
+	return sruo
+}
+
+// SetHeadingCodes sets the "heading_codes" field.
+func (sruo *SourceReportUpdateOne) SetHeadingCodes(s []string) *SourceReportUpdateOne {
+	sruo.mutation.SetHeadingCodes(s)
+	return sruo
+}
+
+// AppendHeadingCodes appends s to the "heading_codes" field.
+func (sruo *SourceReportUpdateOne) AppendHeadingCodes(s []string) *SourceReportUpdateOne {
+	sruo.mutation.AppendHeadingCodes(s)
+	return sruo
+}
+
+// ClearHeadingCodes clears the value of the "heading_codes" field.
+func (sruo *SourceReportUpdateOne) ClearHeadingCodes() *SourceReportUpdateOne {
+	sruo.mutation.ClearHeadingCodes()
+	return sruo
+}
+
+// SetStatus sets the "status" field.
+func (sruo *SourceReportUpdateOne) SetStatus(cls core.SourceLoadStatus) *SourceReportUpdateOne {
+	sruo.mutation.SetStatus(cls)
+	return sruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (sruo *SourceReportUpdateOne) SetNillableStatus(cls *core.SourceLoadStatus) *SourceReportUpdateOne {
+	if cls != nil {
+		sruo.SetStatus(*cls)
+	}
+	return sruo
+}
+
+// SetError sets the "error" field.
+func (sruo *SourceReportUpdateOne) SetError(s string) *SourceReportUpdateOne {
+	sruo.mutation.SetError(s)
+	return sruo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (sruo *SourceReportUpdateOne) SetNillableError(s *string) *SourceReportUpdateOne {
+	if s != nil {
+		sruo.SetError(*s)
+	}
+	return sruo
+}
+
+// ClearError clears the value of the "error" field.
+func (sruo *SourceReportUpdateOne) ClearError() *SourceReportUpdateOne {
+	sruo.mutation.ClearError()
+	return sruo
+}
+
+// SetStartedAt sets the "started_at" field.
+func (sruo *SourceReportUpdateOne) SetStartedAt(t time.Time) *SourceReportUpdateOne {
+	sruo.mutation.SetStartedAt(t)
+	return sruo
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (sruo *SourceReportUpdateOne) SetNillableStartedAt(t *time.Time) *SourceReportUpdateOne {
+	if t != nil {
+		sruo.SetStartedAt(*t)
+	}
+	return sruo
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (sruo *SourceReportUpdateOne) SetDurationMs(i int64) *SourceReportUpdateOne {
+	sruo.mutation.ResetDurationMs()
+	sruo.mutation.SetDurationMs(i)
+	return sruo
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (sruo *SourceReportUpdateOne) SetNillableDurationMs(i *int64) *SourceReportUpdateOne {
+	if i != nil {
+		sruo.SetDurationMs(*i)
+	}
+	return sruo
+}
+
+// AddDurationMs adds i to the "duration_ms" field.
+func (sruo *SourceReportUpdateOne) AddDurationMs(i int64) *SourceReportUpdateOne {
+	sruo.mutation.AddDurationMs(i)
+	return sruo
+}
+
+// SetRetries sets the "retries" field.
+func (sruo *SourceReportUpdateOne) SetRetries(i int) *SourceReportUpdateOne {
+	sruo.mutation.ResetRetries()
+	sruo.mutation.SetRetries(i)
+	return sruo
+}
+
+// SetNillableRetries sets the "retries" field if the given value is not nil.
+func (sruo *SourceReportUpdateOne) SetNillableRetries(i *int) *SourceReportUpdateOne {
+	if i != nil {
+		sruo.SetRetries(*i)
+	}
+	return sruo
+}
+
+// AddRetries adds i to the "retries" field.
+func (sruo *SourceReportUpdateOne) AddRetries(i int) *SourceReportUpdateOne {
+	sruo.mutation.AddRetries(i)
+	return sruo
+}
+
+// SetHeadings sets the "headings" field.
+func (sruo *SourceReportUpdateOne) SetHeadings(i int) *SourceReportUpdateOne {
+	sruo.mutation.ResetHeadings()
+	sruo.mutation.SetHeadings(i)
+	return sruo
+}
+
+// SetNillableHeadings sets the "headings" field if the given value is not nil.
+func (sruo *SourceReportUpdateOne) SetNillableHeadings(i *int) *SourceReportUpdateOne {
+	if i != nil {
+		sruo.SetHeadings(*i)
+	}
+	return sruo
+}
+
+// AddHeadings adds i to the "headings" field.
+func (sruo *SourceReportUpdateOne) AddHeadings(i int) *SourceReportUpdateOne {
+	sruo.mutation.AddHeadings(i)
+	return sruo
+}
+
+// SetApplications sets the "applications" field.
+func (sruo *SourceReportUpdateOne) SetApplications(i int) *SourceReportUpdateOne {
+	sruo.mutation.ResetApplications()
+	sruo.mutation.SetApplications(i)
+	return sruo
+}
+
+// SetNillableApplications sets the "applications" field if the given value is not nil.
+func (sruo *SourceReportUpdateOne) SetNillableApplications(i *int) *SourceReportUpdateOne {
+	if i != nil {
+		sruo.SetApplications(*i)
+	}
+	return sruo
+}
+
+// AddApplications adds i to the "applications" field.
+func (sruo *SourceReportUpdateOne) AddApplications(i int) *SourceReportUpdateOne {
+	sruo.mutation.AddApplications(i)
+	return sruo
+}
+
+// SetSkippedRows sets the "skipped_rows" field.
+func (sruo *SourceReportUpdateOne) SetSkippedRows(i int) *SourceReportUpdateOne {
+	sruo.mutation.ResetSkippedRows()
+	sruo.mutation.SetSkippedRows(i)
+	return sruo
+}
+
+// SetNillableSkippedRows sets the "skipped_rows" field if the given value is not nil.
+func (sruo *SourceReportUpdateOne) SetNillableSkippedRows(i *int) *SourceReportUpdateOne {
+	if i != nil {
+		sruo.SetSkippedRows(*i)
+	}
+	return sruo
+}
+
+// AddSkippedRows adds i to the "skipped_rows" field.
+func (sruo *SourceReportUpdateOne) AddSkippedRows(i int) *SourceReportUpdateOne {
+	sruo.mutation.AddSkippedRows(i)
+	return sruo
+}
+
+// SetFailedLists sets the "failed_lists" field.
+func (sruo *SourceReportUpdateOne) SetFailedLists(i int) *SourceReportUpdateOne {
+	sruo.mutation.ResetFailedLists()
+	sruo.mutation.SetFailedLists(i)
+	return sruo
+}
+
+// SetNillableFailedLists sets the "failed_lists" field if the given value is not nil.
+func (sruo *SourceReportUpdateOne) SetNillableFailedLists(i *int) *SourceReportUpdateOne {
+	if i != nil {
+		sruo.SetFailedLists(*i)
+	}
+	return sruo
+}
+
+// AddFailedLists adds i to the "failed_lists" field.
+func (sruo *SourceReportUpdateOne) AddFailedLists(i int) *SourceReportUpdateOne {
+	sruo.mutation.AddFailedLists(i)
+	return sruo
+}
+
+// SetRunID sets the "run_id" field.
+func (sruo *SourceReportUpdateOne) SetRunID(i int) *SourceReportUpdateOne {
+	sruo.mutation.SetRunID(i)
+	return sruo
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (sruo *SourceReportUpdateOne) SetNillableRunID(i *int) *SourceReportUpdateOne {
+	if i != nil {
+		sruo.SetRunID(*i)
+	}
+	return sruo
+}
+
+// SetRun sets the "run" edge to the Run entity.
+func (sruo *SourceReportUpdateOne) SetRun(r *Run) *SourceReportUpdateOne {
+	return sruo.SetRunID(r.ID)
+}
+
+// Mutation returns the SourceReportMutation object of the builder.
+func (sruo *SourceReportUpdateOne) Mutation() *SourceReportMutation {
+	return sruo.mutation
+}
+
+// ClearRun clears the "run" edge to the Run entity.
+func (sruo *SourceReportUpdateOne) ClearRun() *SourceReportUpdateOne {
+	sruo.mutation.ClearRun()
+	return sruo
+}
+
+// Where appends a list predicates to the SourceReportUpdate builder.
+func (sruo *SourceReportUpdateOne) Where(ps ...predicate.SourceReport) *SourceReportUpdateOne {
+	sruo.mutation.Where(ps...)
+	return sruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sruo *SourceReportUpdateOne) Select(field string, fields ...string) *SourceReportUpdateOne {
+	sruo.fields = append([]string{field}, fields...)
+	return sruo
+}
+
+// Save executes the query and returns the updated SourceReport entity.
+func (sruo *SourceReportUpdateOne) Save(ctx context.Context) (*SourceReport, error) {
+	return withHooks(ctx, sruo.sqlSave, sruo.mutation, sruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sruo *SourceReportUpdateOne) SaveX(ctx context.Context) *SourceReport {
+	node, err := sruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sruo *SourceReportUpdateOne) Exec(ctx context.Context) error {
+	_, err := sruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sruo *SourceReportUpdateOne) ExecX(ctx context.Context) {
+	if err := sruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sruo *SourceReportUpdateOne) check() error {
+	if sruo.mutation.RunCleared() && len(sruo.mutation.RunIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SourceReport.run"`)
+	}
+	return nil
+}
+
+func (sruo *SourceReportUpdateOne) sqlSave(ctx context.Context) (_node *SourceReport, err error) {
+	if err := sruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sourcereport.Table, sourcereport.Columns, sqlgraph.NewFieldSpec(sourcereport.FieldID, field.TypeInt))
+	id, ok := sruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SourceReport.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sourcereport.FieldID)
+		for _, f := range fields {
+			if !sourcereport.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != sourcereport.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sruo.mutation.VarsityCode(); ok {
+		_spec.SetField(sourcereport.FieldVarsityCode, field.TypeString, value)
+	}
+	if value, ok := sruo.mutation.Source(); ok {
+		_spec.SetField(sourcereport.FieldSource, field.TypeString, value)
+	}
+	if value, ok := sruo.mutation.Name(); ok {
+		_spec.SetField(sourcereport.FieldName, field.TypeString, value)
+	}
+	if sruo.mutation.NameCleared() {
+		_spec.ClearField(sourcereport.FieldName, field.TypeString)
+	}
+	if value, ok := sruo.mutation.URL(); ok {
+		_spec.SetField(sourcereport.FieldURL, field.TypeString, value)
+	}
+	if sruo.mutation.URLCleared() {
+		_spec.ClearField(sourcereport.FieldURL, field.TypeString)
+	}
+	if value, ok := sruo.mutation.HeadingCodes(); ok {
+		_spec.SetField(sourcereport.FieldHeadingCodes, field.TypeJSON, value)
+	}
+	if value, ok := sruo.mutation.AppendedHeadingCodes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, sourcereport.FieldHeadingCodes, value)
+		})
+	}
+	if sruo.mutation.HeadingCodesCleared() {
+		_spec.ClearField(sourcereport.FieldHeadingCodes, field.TypeJSON)
+	}
+	if value, ok := sruo.mutation.Status(); ok {
+		_spec.SetField(sourcereport.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := sruo.mutation.Error(); ok {
+		_spec.SetField(sourcereport.FieldError, field.TypeString, value)
+	}
+	if sruo.mutation.ErrorCleared() {
+		_spec.ClearField(sourcereport.FieldError, field.TypeString)
+	}
+	if value, ok := sruo.mutation.StartedAt(); ok {
+		_spec.SetField(sourcereport.FieldStartedAt, field.TypeTime, value)
+	}
+	if value, ok := sruo.mutation.DurationMs(); ok {
+		_spec.SetField(sourcereport.FieldDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := sruo.mutation.AddedDurationMs(); ok {
+		_spec.AddField(sourcereport.FieldDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := sruo.mutation.Retries(); ok {
+		_spec.SetField(sourcereport.FieldRetries, field.TypeInt, value)
+	}
+	if value, ok := sruo.mutation.AddedRetries(); ok {
+		_spec.AddField(sourcereport.FieldRetries, field.TypeInt, value)
+	}
+	if value, ok := sruo.mutation.Headings(); ok {
+		_spec.SetField(sourcereport.FieldHeadings, field.TypeInt, value)
+	}
+	if value, ok := sruo.mutation.AddedHeadings(); ok {
+		_spec.AddField(sourcereport.FieldHeadings, field.TypeInt, value)
+	}
+	if value, ok := sruo.mutation.Applications(); ok {
+		_spec.SetField(sourcereport.FieldApplications, field.TypeInt, value)
+	}
+	if value, ok := sruo.mutation.AddedApplications(); ok {
+		_spec.AddField(sourcereport.FieldApplications, field.TypeInt, value)
+	}
+	if value, ok := sruo.mutation.SkippedRows(); ok {
+		_spec.SetField(sourcereport.FieldSkippedRows, field.TypeInt, value)
+	}
+	if value, ok := sruo.mutation.AddedSkippedRows(); ok {
+		_spec.AddField(sourcereport.FieldSkippedRows, field.TypeInt, value)
+	}
+	if value, ok := sruo.mutation.FailedLists(); ok {
+		_spec.SetField(sourcereport.FieldFailedLists, field.TypeInt, value)
+	}
+	if value, ok := sruo.mutation.AddedFailedLists(); ok {
+		_spec.AddField(sourcereport.FieldFailedLists, field.TypeInt, value)
+	}
+	if sruo.mutation.RunCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   sourcereport.RunTable,
+			Columns: []string{sourcereport.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(run.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sruo.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   sourcereport.RunTable,
+			Columns: []string{sourcereport.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(run.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SourceReport{config: sruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sourcereport.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	sruo.mutation.done = true
+	return _node, nil
+}
@@ -14,16 +14,24 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AdmissionChance is the client for interacting with the AdmissionChance builders.
+	AdmissionChance *AdmissionChanceClient
+	// AdmissionEvent is the client for interacting with the AdmissionEvent builders.
+	AdmissionEvent *AdmissionEventClient
 	// Application is the client for interacting with the Application builders.
 	Application *ApplicationClient
 	// Calculation is the client for interacting with the Calculation builders.
 	Calculation *CalculationClient
+	// CapacityDrift is the client for interacting with the CapacityDrift builders.
+	CapacityDrift *CapacityDriftClient
 	// DrainedResult is the client for interacting with the DrainedResult builders.
 	DrainedResult *DrainedResultClient
 	// Heading is the client for interacting with the Heading builders.
 	Heading *HeadingClient
 	// Run is the client for interacting with the Run builders.
 	Run *RunClient
+	// SourceReport is the client for interacting with the SourceReport builders.
+	SourceReport *SourceReportClient
 	// Varsity is the client for interacting with the Varsity builders.
 	Varsity *VarsityClient
 
@@ -157,11 +165,15 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AdmissionChance = NewAdmissionChanceClient(tx.config)
+	tx.AdmissionEvent = NewAdmissionEventClient(tx.config)
 	tx.Application = NewApplicationClient(tx.config)
 	tx.Calculation = NewCalculationClient(tx.config)
+	tx.CapacityDrift = NewCapacityDriftClient(tx.config)
 	tx.DrainedResult = NewDrainedResultClient(tx.config)
 	tx.Heading = NewHeadingClient(tx.config)
 	tx.Run = NewRunClient(tx.config)
+	tx.SourceReport = NewSourceReportClient(tx.config)
 	tx.Varsity = NewVarsityClient(tx.config)
 }
 
@@ -172,7 +184,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Application.QueryXXX(), the query will be executed
+// applies a query, for example: AdmissionChance.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -20,6 +20,14 @@ type Varsity struct {
 	Code string `json:"code,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
+	// City holds the value of the "city" field.
+	City string `json:"city,omitempty"`
+	// Region holds the value of the "region" field.
+	Region string `json:"region,omitempty"`
+	// Parent holds the value of the "parent" field.
+	Parent string `json:"parent,omitempty"`
+	// Site holds the value of the "site" field.
+	Site string `json:"site,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the VarsityQuery when eager-loading is set.
 	Edges        VarsityEdges `json:"edges"`
@@ -51,7 +59,7 @@ func (*Varsity) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case varsity.FieldID:
 			values[i] = new(sql.NullInt64)
-		case varsity.FieldCode, varsity.FieldName:
+		case varsity.FieldCode, varsity.FieldName, varsity.FieldCity, varsity.FieldRegion, varsity.FieldParent, varsity.FieldSite:
 			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -86,6 +94,30 @@ func (v *Varsity) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				v.Name = value.String
 			}
+		case varsity.FieldCity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field city", values[i])
+			} else if value.Valid {
+				v.City = value.String
+			}
+		case varsity.FieldRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field region", values[i])
+			} else if value.Valid {
+				v.Region = value.String
+			}
+		case varsity.FieldParent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field parent", values[i])
+			} else if value.Valid {
+				v.Parent = value.String
+			}
+		case varsity.FieldSite:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field site", values[i])
+			} else if value.Valid {
+				v.Site = value.String
+			}
 		default:
 			v.selectValues.Set(columns[i], values[i])
 		}
@@ -132,6 +164,18 @@ func (v *Varsity) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(v.Name)
+	builder.WriteString(", ")
+	builder.WriteString("city=")
+	builder.WriteString(v.City)
+	builder.WriteString(", ")
+	builder.WriteString("region=")
+	builder.WriteString(v.Region)
+	builder.WriteString(", ")
+	builder.WriteString("parent=")
+	builder.WriteString(v.Parent)
+	builder.WriteString(", ")
+	builder.WriteString("site=")
+	builder.WriteString(v.Site)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -16,6 +16,14 @@ const (
 	FieldCode = "code"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
+	// FieldCity holds the string denoting the city field in the database.
+	FieldCity = "city"
+	// FieldRegion holds the string denoting the region field in the database.
+	FieldRegion = "region"
+	// FieldParent holds the string denoting the parent field in the database.
+	FieldParent = "parent"
+	// FieldSite holds the string denoting the site field in the database.
+	FieldSite = "site"
 	// EdgeHeadings holds the string denoting the headings edge name in mutations.
 	EdgeHeadings = "headings"
 	// Table holds the table name of the varsity in the database.
@@ -34,6 +42,10 @@ var Columns = []string{
 	FieldID,
 	FieldCode,
 	FieldName,
+	FieldCity,
+	FieldRegion,
+	FieldParent,
+	FieldSite,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -46,6 +58,17 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+var (
+	// DefaultCity holds the default value on creation for the "city" field.
+	DefaultCity string
+	// DefaultRegion holds the default value on creation for the "region" field.
+	DefaultRegion string
+	// DefaultParent holds the default value on creation for the "parent" field.
+	DefaultParent string
+	// DefaultSite holds the default value on creation for the "site" field.
+	DefaultSite string
+)
+
 // OrderOption defines the ordering options for the Varsity queries.
 type OrderOption func(*sql.Selector)
 
@@ -64,6 +87,26 @@ func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
 }
 
+// ByCity orders the results by the city field.
+func ByCity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCity, opts...).ToFunc()
+}
+
+// ByRegion orders the results by the region field.
+func ByRegion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRegion, opts...).ToFunc()
+}
+
+// ByParent orders the results by the parent field.
+func ByParent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldParent, opts...).ToFunc()
+}
+
+// BySite orders the results by the site field.
+func BySite(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSite, opts...).ToFunc()
+}
+
 // ByHeadingsCount orders the results by headings count.
 func ByHeadingsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
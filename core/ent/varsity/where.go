@@ -63,6 +63,26 @@ func Name(v string) predicate.Varsity {
 	return predicate.Varsity(sql.FieldEQ(FieldName, v))
 }
 
+// City applies equality check predicate on the "city" field. It's identical to CityEQ.
+func City(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldEQ(FieldCity, v))
+}
+
+// Region applies equality check predicate on the "region" field. It's identical to RegionEQ.
+func Region(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldEQ(FieldRegion, v))
+}
+
+// Parent applies equality check predicate on the "parent" field. It's identical to ParentEQ.
+func Parent(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldEQ(FieldParent, v))
+}
+
+// Site applies equality check predicate on the "site" field. It's identical to SiteEQ.
+func Site(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldEQ(FieldSite, v))
+}
+
 // CodeEQ applies the EQ predicate on the "code" field.
 func CodeEQ(v string) predicate.Varsity {
 	return predicate.Varsity(sql.FieldEQ(FieldCode, v))
@@ -193,6 +213,266 @@ func NameContainsFold(v string) predicate.Varsity {
 	return predicate.Varsity(sql.FieldContainsFold(FieldName, v))
 }
 
+// CityEQ applies the EQ predicate on the "city" field.
+func CityEQ(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldEQ(FieldCity, v))
+}
+
+// CityNEQ applies the NEQ predicate on the "city" field.
+func CityNEQ(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldNEQ(FieldCity, v))
+}
+
+// CityIn applies the In predicate on the "city" field.
+func CityIn(vs ...string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldIn(FieldCity, vs...))
+}
+
+// CityNotIn applies the NotIn predicate on the "city" field.
+func CityNotIn(vs ...string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldNotIn(FieldCity, vs...))
+}
+
+// CityGT applies the GT predicate on the "city" field.
+func CityGT(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldGT(FieldCity, v))
+}
+
+// CityGTE applies the GTE predicate on the "city" field.
+func CityGTE(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldGTE(FieldCity, v))
+}
+
+// CityLT applies the LT predicate on the "city" field.
+func CityLT(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldLT(FieldCity, v))
+}
+
+// CityLTE applies the LTE predicate on the "city" field.
+func CityLTE(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldLTE(FieldCity, v))
+}
+
+// CityContains applies the Contains predicate on the "city" field.
+func CityContains(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldContains(FieldCity, v))
+}
+
+// CityHasPrefix applies the HasPrefix predicate on the "city" field.
+func CityHasPrefix(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldHasPrefix(FieldCity, v))
+}
+
+// CityHasSuffix applies the HasSuffix predicate on the "city" field.
+func CityHasSuffix(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldHasSuffix(FieldCity, v))
+}
+
+// CityEqualFold applies the EqualFold predicate on the "city" field.
+func CityEqualFold(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldEqualFold(FieldCity, v))
+}
+
+// CityContainsFold applies the ContainsFold predicate on the "city" field.
+func CityContainsFold(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldContainsFold(FieldCity, v))
+}
+
+// RegionEQ applies the EQ predicate on the "region" field.
+func RegionEQ(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldEQ(FieldRegion, v))
+}
+
+// RegionNEQ applies the NEQ predicate on the "region" field.
+func RegionNEQ(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldNEQ(FieldRegion, v))
+}
+
+// RegionIn applies the In predicate on the "region" field.
+func RegionIn(vs ...string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldIn(FieldRegion, vs...))
+}
+
+// RegionNotIn applies the NotIn predicate on the "region" field.
+func RegionNotIn(vs ...string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldNotIn(FieldRegion, vs...))
+}
+
+// RegionGT applies the GT predicate on the "region" field.
+func RegionGT(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldGT(FieldRegion, v))
+}
+
+// RegionGTE applies the GTE predicate on the "region" field.
+func RegionGTE(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldGTE(FieldRegion, v))
+}
+
+// RegionLT applies the LT predicate on the "region" field.
+func RegionLT(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldLT(FieldRegion, v))
+}
+
+// RegionLTE applies the LTE predicate on the "region" field.
+func RegionLTE(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldLTE(FieldRegion, v))
+}
+
+// RegionContains applies the Contains predicate on the "region" field.
+func RegionContains(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldContains(FieldRegion, v))
+}
+
+// RegionHasPrefix applies the HasPrefix predicate on the "region" field.
+func RegionHasPrefix(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldHasPrefix(FieldRegion, v))
+}
+
+// RegionHasSuffix applies the HasSuffix predicate on the "region" field.
+func RegionHasSuffix(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldHasSuffix(FieldRegion, v))
+}
+
+// RegionEqualFold applies the EqualFold predicate on the "region" field.
+func RegionEqualFold(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldEqualFold(FieldRegion, v))
+}
+
+// RegionContainsFold applies the ContainsFold predicate on the "region" field.
+func RegionContainsFold(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldContainsFold(FieldRegion, v))
+}
+
+// ParentEQ applies the EQ predicate on the "parent" field.
+func ParentEQ(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldEQ(FieldParent, v))
+}
+
+// ParentNEQ applies the NEQ predicate on the "parent" field.
+func ParentNEQ(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldNEQ(FieldParent, v))
+}
+
+// ParentIn applies the In predicate on the "parent" field.
+func ParentIn(vs ...string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldIn(FieldParent, vs...))
+}
+
+// ParentNotIn applies the NotIn predicate on the "parent" field.
+func ParentNotIn(vs ...string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldNotIn(FieldParent, vs...))
+}
+
+// ParentGT applies the GT predicate on the "parent" field.
+func ParentGT(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldGT(FieldParent, v))
+}
+
+// ParentGTE applies the GTE predicate on the "parent" field.
+func ParentGTE(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldGTE(FieldParent, v))
+}
+
+// ParentLT applies the LT predicate on the "parent" field.
+func ParentLT(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldLT(FieldParent, v))
+}
+
+// ParentLTE applies the LTE predicate on the "parent" field.
+func ParentLTE(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldLTE(FieldParent, v))
+}
+
+// ParentContains applies the Contains predicate on the "parent" field.
+func ParentContains(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldContains(FieldParent, v))
+}
+
+// ParentHasPrefix applies the HasPrefix predicate on the "parent" field.
+func ParentHasPrefix(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldHasPrefix(FieldParent, v))
+}
+
+// ParentHasSuffix applies the HasSuffix predicate on the "parent" field.
+func ParentHasSuffix(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldHasSuffix(FieldParent, v))
+}
+
+// ParentEqualFold applies the EqualFold predicate on the "parent" field.
+func ParentEqualFold(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldEqualFold(FieldParent, v))
+}
+
+// ParentContainsFold applies the ContainsFold predicate on the "parent" field.
+func ParentContainsFold(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldContainsFold(FieldParent, v))
+}
+
+// SiteEQ applies the EQ predicate on the "site" field.
+func SiteEQ(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldEQ(FieldSite, v))
+}
+
+// SiteNEQ applies the NEQ predicate on the "site" field.
+func SiteNEQ(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldNEQ(FieldSite, v))
+}
+
+// SiteIn applies the In predicate on the "site" field.
+func SiteIn(vs ...string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldIn(FieldSite, vs...))
+}
+
+// SiteNotIn applies the NotIn predicate on the "site" field.
+func SiteNotIn(vs ...string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldNotIn(FieldSite, vs...))
+}
+
+// SiteGT applies the GT predicate on the "site" field.
+func SiteGT(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldGT(FieldSite, v))
+}
+
+// SiteGTE applies the GTE predicate on the "site" field.
+func SiteGTE(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldGTE(FieldSite, v))
+}
+
+// SiteLT applies the LT predicate on the "site" field.
+func SiteLT(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldLT(FieldSite, v))
+}
+
+// SiteLTE applies the LTE predicate on the "site" field.
+func SiteLTE(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldLTE(FieldSite, v))
+}
+
+// SiteContains applies the Contains predicate on the "site" field.
+func SiteContains(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldContains(FieldSite, v))
+}
+
+// SiteHasPrefix applies the HasPrefix predicate on the "site" field.
+func SiteHasPrefix(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldHasPrefix(FieldSite, v))
+}
+
+// SiteHasSuffix applies the HasSuffix predicate on the "site" field.
+func SiteHasSuffix(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldHasSuffix(FieldSite, v))
+}
+
+// SiteEqualFold applies the EqualFold predicate on the "site" field.
+func SiteEqualFold(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldEqualFold(FieldSite, v))
+}
+
+// SiteContainsFold applies the ContainsFold predicate on the "site" field.
+func SiteContainsFold(v string) predicate.Varsity {
+	return predicate.Varsity(sql.FieldContainsFold(FieldSite, v))
+}
+
 // HasHeadings applies the HasEdge predicate on the "headings" edge.
 func HasHeadings() predicate.Varsity {
 	return predicate.Varsity(func(s *sql.Selector) {
@@ -32,6 +32,62 @@ func (vc *VarsityCreate) SetName(s string) *VarsityCreate {
 	return vc
 }
 
+// SetCity sets the "city" field.
+func (vc *VarsityCreate) SetCity(s string) *VarsityCreate {
+	vc.mutation.SetCity(s)
+	return vc
+}
+
+// SetNillableCity sets the "city" field if the given value is not nil.
+func (vc *VarsityCreate) SetNillableCity(s *string) *VarsityCreate {
+	if s != nil {
+		vc.SetCity(*s)
+	}
+	return vc
+}
+
+// SetRegion sets the "region" field.
+func (vc *VarsityCreate) SetRegion(s string) *VarsityCreate {
+	vc.mutation.SetRegion(s)
+	return vc
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (vc *VarsityCreate) SetNillableRegion(s *string) *VarsityCreate {
+	if s != nil {
+		vc.SetRegion(*s)
+	}
+	return vc
+}
+
+// SetParent sets the "parent" field.
+func (vc *VarsityCreate) SetParent(s string) *VarsityCreate {
+	vc.mutation.SetParent(s)
+	return vc
+}
+
+// SetNillableParent sets the "parent" field if the given value is not nil.
+func (vc *VarsityCreate) SetNillableParent(s *string) *VarsityCreate {
+	if s != nil {
+		vc.SetParent(*s)
+	}
+	return vc
+}
+
+// SetSite sets the "site" field.
+func (vc *VarsityCreate) SetSite(s string) *VarsityCreate {
+	vc.mutation.SetSite(s)
+	return vc
+}
+
+// SetNillableSite sets the "site" field if the given value is not nil.
+func (vc *VarsityCreate) SetNillableSite(s *string) *VarsityCreate {
+	if s != nil {
+		vc.SetSite(*s)
+	}
+	return vc
+}
+
 // AddHeadingIDs adds the "headings" edge to the Heading entity by IDs.
 func (vc *VarsityCreate) AddHeadingIDs(ids ...int) *VarsityCreate {
 	vc.mutation.AddHeadingIDs(ids...)
@@ -54,6 +110,7 @@ func (vc *VarsityCreate) Mutation() *VarsityMutation {
 
 // Save creates the Varsity in the database.
 func (vc *VarsityCreate) Save(ctx context.Context) (*Varsity, error) {
+	vc.defaults()
 	return withHooks(ctx, vc.sqlSave, vc.mutation, vc.hooks)
 }
 
@@ -79,6 +136,26 @@ func (vc *VarsityCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (vc *VarsityCreate) defaults() {
+	if _, ok := vc.mutation.City(); !ok {
+		v := varsity.DefaultCity
+		vc.mutation.SetCity(v)
+	}
+	if _, ok := vc.mutation.Region(); !ok {
+		v := varsity.DefaultRegion
+		vc.mutation.SetRegion(v)
+	}
+	if _, ok := vc.mutation.Parent(); !ok {
+		v := varsity.DefaultParent
+		vc.mutation.SetParent(v)
+	}
+	if _, ok := vc.mutation.Site(); !ok {
+		v := varsity.DefaultSite
+		vc.mutation.SetSite(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (vc *VarsityCreate) check() error {
 	if _, ok := vc.mutation.Code(); !ok {
@@ -87,6 +164,18 @@ func (vc *VarsityCreate) check() error {
 	if _, ok := vc.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Varsity.name"`)}
 	}
+	if _, ok := vc.mutation.City(); !ok {
+		return &ValidationError{Name: "city", err: errors.New(`ent: missing required field "Varsity.city"`)}
+	}
+	if _, ok := vc.mutation.Region(); !ok {
+		return &ValidationError{Name: "region", err: errors.New(`ent: missing required field "Varsity.region"`)}
+	}
+	if _, ok := vc.mutation.Parent(); !ok {
+		return &ValidationError{Name: "parent", err: errors.New(`ent: missing required field "Varsity.parent"`)}
+	}
+	if _, ok := vc.mutation.Site(); !ok {
+		return &ValidationError{Name: "site", err: errors.New(`ent: missing required field "Varsity.site"`)}
+	}
 	return nil
 }
 
@@ -121,6 +210,22 @@ func (vc *VarsityCreate) createSpec() (*Varsity, *sqlgraph.CreateSpec) {
 		_spec.SetField(varsity.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
+	if value, ok := vc.mutation.City(); ok {
+		_spec.SetField(varsity.FieldCity, field.TypeString, value)
+		_node.City = value
+	}
+	if value, ok := vc.mutation.Region(); ok {
+		_spec.SetField(varsity.FieldRegion, field.TypeString, value)
+		_node.Region = value
+	}
+	if value, ok := vc.mutation.Parent(); ok {
+		_spec.SetField(varsity.FieldParent, field.TypeString, value)
+		_node.Parent = value
+	}
+	if value, ok := vc.mutation.Site(); ok {
+		_spec.SetField(varsity.FieldSite, field.TypeString, value)
+		_node.Site = value
+	}
 	if nodes := vc.mutation.HeadingsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -158,6 +263,7 @@ func (vcb *VarsityCreateBulk) Save(ctx context.Context) ([]*Varsity, error) {
 	for i := range vcb.builders {
 		func(i int, root context.Context) {
 			builder := vcb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*VarsityMutation)
 				if !ok {
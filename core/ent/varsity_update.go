@@ -56,6 +56,62 @@ func (vu *VarsityUpdate) SetNillableName(s *string) *VarsityUpdate {
 	return vu
 }
 
+// SetCity sets the "city" field.
+func (vu *VarsityUpdate) SetCity(s string) *VarsityUpdate {
+	vu.mutation.SetCity(s)
+	return vu
+}
+
+// SetNillableCity sets the "city" field if the given value is not nil.
+func (vu *VarsityUpdate) SetNillableCity(s *string) *VarsityUpdate {
+	if s != nil {
+		vu.SetCity(*s)
+	}
+	return vu
+}
+
+// SetRegion sets the "region" field.
+func (vu *VarsityUpdate) SetRegion(s string) *VarsityUpdate {
+	vu.mutation.SetRegion(s)
+	return vu
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (vu *VarsityUpdate) SetNillableRegion(s *string) *VarsityUpdate {
+	if s != nil {
+		vu.SetRegion(*s)
+	}
+	return vu
+}
+
+// SetParent sets the "parent" field.
+func (vu *VarsityUpdate) SetParent(s string) *VarsityUpdate {
+	vu.mutation.SetParent(s)
+	return vu
+}
+
+// SetNillableParent sets the "parent" field if the given value is not nil.
+func (vu *VarsityUpdate) SetNillableParent(s *string) *VarsityUpdate {
+	if s != nil {
+		vu.SetParent(*s)
+	}
+	return vu
+}
+
+// SetSite sets the "site" field.
+func (vu *VarsityUpdate) SetSite(s string) *VarsityUpdate {
+	vu.mutation.SetSite(s)
+	return vu
+}
+
+// SetNillableSite sets the "site" field if the given value is not nil.
+func (vu *VarsityUpdate) SetNillableSite(s *string) *VarsityUpdate {
+	if s != nil {
+		vu.SetSite(*s)
+	}
+	return vu
+}
+
 // AddHeadingIDs adds the "headings" edge to the Heading entity by IDs.
 func (vu *VarsityUpdate) AddHeadingIDs(ids ...int) *VarsityUpdate {
 	vu.mutation.AddHeadingIDs(ids...)
@@ -139,6 +195,18 @@ func (vu *VarsityUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := vu.mutation.Name(); ok {
 		_spec.SetField(varsity.FieldName, field.TypeString, value)
 	}
+	if value, ok := vu.mutation.City(); ok {
+		_spec.SetField(varsity.FieldCity, field.TypeString, value)
+	}
+	if value, ok := vu.mutation.Region(); ok {
+		_spec.SetField(varsity.FieldRegion, field.TypeString, value)
+	}
+	if value, ok := vu.mutation.Parent(); ok {
+		_spec.SetField(varsity.FieldParent, field.TypeString, value)
+	}
+	if value, ok := vu.mutation.Site(); ok {
+		_spec.SetField(varsity.FieldSite, field.TypeString, value)
+	}
 	if vu.mutation.HeadingsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -232,6 +300,62 @@ func (vuo *VarsityUpdateOne) SetNillableName(s *string) *VarsityUpdateOne {
 	return vuo
 }
 
+// SetCity sets the "city" field.
+func (vuo *VarsityUpdateOne) SetCity(s string) *VarsityUpdateOne {
+	vuo.mutation.SetCity(s)
+	return vuo
+}
+
+// SetNillableCity sets the "city" field if the given value is not nil.
+func (vuo *VarsityUpdateOne) SetNillableCity(s *string) *VarsityUpdateOne {
+	if s != nil {
+		vuo.SetCity(*s)
+	}
+	return vuo
+}
+
+// SetRegion sets the "region" field.
+func (vuo *VarsityUpdateOne) SetRegion(s string) *VarsityUpdateOne {
+	vuo.mutation.SetRegion(s)
+	return vuo
+}
+
+// SetNillableRegion sets the "region" field if the given value is not nil.
+func (vuo *VarsityUpdateOne) SetNillableRegion(s *string) *VarsityUpdateOne {
+	if s != nil {
+		vuo.SetRegion(*s)
+	}
+	return vuo
+}
+
+// SetParent sets the "parent" field.
+func (vuo *VarsityUpdateOne) SetParent(s string) *VarsityUpdateOne {
+	vuo.mutation.SetParent(s)
+	return vuo
+}
+
+// SetNillableParent sets the "parent" field if the given value is not nil.
+func (vuo *VarsityUpdateOne) SetNillableParent(s *string) *VarsityUpdateOne {
+	if s != nil {
+		vuo.SetParent(*s)
+	}
+	return vuo
+}
+
+// SetSite sets the "site" field.
+func (vuo *VarsityUpdateOne) SetSite(s string) *VarsityUpdateOne {
+	vuo.mutation.SetSite(s)
+	return vuo
+}
+
+// SetNillableSite sets the "site" field if the given value is not nil.
+func (vuo *VarsityUpdateOne) SetNillableSite(s *string) *VarsityUpdateOne {
+	if s != nil {
+		vuo.SetSite(*s)
+	}
+	return vuo
+}
+
 // AddHeadingIDs adds the "headings" edge to the Heading entity by IDs.
 func (vuo *VarsityUpdateOne) AddHeadingIDs(ids ...int) *VarsityUpdateOne {
 	vuo.mutation.AddHeadingIDs(ids...)
@@ -345,6 +469,18 @@ func (vuo *VarsityUpdateOne) sqlSave(ctx context.Context) (_node *Varsity, err e
 	if value, ok := vuo.mutation.Name(); ok {
 		_spec.SetField(varsity.FieldName, field.TypeString, value)
 	}
+	if value, ok := vuo.mutation.City(); ok {
+		_spec.SetField(varsity.FieldCity, field.TypeString, value)
+	}
+	if value, ok := vuo.mutation.Region(); ok {
+		_spec.SetField(varsity.FieldRegion, field.TypeString, value)
+	}
+	if value, ok := vuo.mutation.Parent(); ok {
+		_spec.SetField(varsity.FieldParent, field.TypeString, value)
+	}
+	if value, ok := vuo.mutation.Site(); ok {
+		_spec.SetField(varsity.FieldSite, field.TypeString, value)
+	}
 	if vuo.mutation.HeadingsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -10,6 +10,8 @@ import (
 	"os"
 	"strconv"
 	"time"
+
+	"github.com/trueegorletov/analabit/core/source/httparchive"
 )
 
 // IDMSUClient implements StudentIDResolver by calling the idmsu service
@@ -29,6 +31,8 @@ func NewIDMSUClient() *IDMSUClient {
 		baseURL: baseURL,
 		httpClient: &http.Client{
 			Timeout: 45 * time.Minute,
+			// Resolutions are recorded and replayed along with the lists, so that replayed crawls resolve alike
+			Transport: httparchive.Transport(http.DefaultTransport),
 		},
 	}
 }
@@ -0,0 +1,129 @@
+package core
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Education levels of a heading, as used in HeadingMetadata.Level.
+const (
+	LevelBachelor   = "bachelor"
+	LevelSpecialist = "specialist"
+	LevelMaster     = "master"
+)
+
+// Study forms of a heading, as used in HeadingMetadata.StudyForm.
+const (
+	StudyFormFullTime   = "full_time"
+	StudyFormPartTime   = "part_time"
+	StudyFormExtramural = "extramural"
+)
+
+// EntranceExam is an entrance exam of a heading together with its minimum passing score.
+type EntranceExam struct {
+	Subject  string `json:"subject"`
+	MinScore int    `json:"min_score,omitempty"` // 0 if unknown
+}
+
+// HeadingMetadata describes a heading beyond what the calculations need, for filtering and display.
+// Every field is empty if unknown.
+type HeadingMetadata struct {
+	// OKSO code of the direction the heading belongs to, e.g. "01.03.02"
+	DirectionCode string `json:"direction_code,omitempty"`
+	// One of the Level* constants
+	Level string `json:"level,omitempty"`
+	// One of the StudyForm* constants
+	StudyForm string         `json:"study_form,omitempty"`
+	Exams     []EntranceExam `json:"exams,omitempty"`
+}
+
+var (
+	directionCodePattern = regexp.MustCompile(`\b\d{2}\.\d{2}\.\d{2}\b`)
+
+	// Study forms are matched at the start of a word only: "очн" is also a part of e.g. "восточный"
+	partTimePattern   = regexp.MustCompile(`(?i)(^|\P{L})очно-заочн`)
+	extramuralPattern = regexp.MustCompile(`(?i)(^|\P{L})заочн`)
+	fullTimePattern   = regexp.MustCompile(`(?i)(^|\P{L})очн`)
+)
+
+// ParseDirectionCode returns the first OKSO direction code found in the text, or "" if there's none.
+func ParseDirectionCode(text string) string {
+	return directionCodePattern.FindString(text)
+}
+
+// LevelFromDirectionCode returns the education level encoded in the middle part of an OKSO direction code,
+// or "" if it's not one of the known levels.
+func LevelFromDirectionCode(code string) string {
+	parts := strings.Split(code, ".")
+	if len(parts) != 3 {
+		return ""
+	}
+
+	switch parts[1] {
+	case "03":
+		return LevelBachelor
+	case "04":
+		return LevelMaster
+	case "05":
+		return LevelSpecialist
+	default:
+		return ""
+	}
+}
+
+// ParseStudyForm returns the study form mentioned in the text (in Russian), or "" if there's none.
+func ParseStudyForm(text string) string {
+	switch {
+	// "очно-заочная" contains both of the other forms, so it's checked first
+	case partTimePattern.MatchString(text):
+		return StudyFormPartTime
+	case extramuralPattern.MatchString(text):
+		return StudyFormExtramural
+	case fullTimePattern.MatchString(text):
+		return StudyFormFullTime
+	default:
+		return ""
+	}
+}
+
+// Complete fills the unknown fields of the metadata from what the heading's name and exam subjects tell:
+// the direction code and study form mentioned in the name, the level of the direction code and the exams
+// of the subjects, without minimum scores.
+func (m HeadingMetadata) Complete(prettyName string, subjects []string) HeadingMetadata {
+	if m.DirectionCode == "" {
+		m.DirectionCode = ParseDirectionCode(prettyName)
+	}
+	if m.Level == "" {
+		m.Level = LevelFromDirectionCode(m.DirectionCode)
+	}
+	if m.StudyForm == "" {
+		m.StudyForm = ParseStudyForm(prettyName)
+	}
+	if len(m.Exams) == 0 {
+		for _, subject := range subjects {
+			m.Exams = append(m.Exams, EntranceExam{Subject: subject})
+		}
+	}
+	return m
+}
+
+// VarsityMetadata describes where a varsity is and which organization it belongs to, for filtering and grouping.
+// Every field is empty if unknown.
+type VarsityMetadata struct {
+	City   string `json:"city,omitempty"`
+	Region string `json:"region,omitempty"`
+	// Code of the organization the varsity is a campus of, e.g. "hse" for every HSE campus;
+	// empty if the varsity is an organization of its own
+	Parent string `json:"parent,omitempty"`
+	// URL of the official site
+	Site string `json:"site,omitempty"`
+}
+
+// GroupCode returns the code of the organization the varsity with the given code belongs to:
+// its parent if it has one, or the varsity itself otherwise.
+func (m VarsityMetadata) GroupCode(varsityCode string) string {
+	if m.Parent != "" {
+		return m.Parent
+	}
+	return varsityCode
+}
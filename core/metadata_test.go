@@ -0,0 +1,39 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseStudyForm(t *testing.T) {
+	assert.Equal(t, StudyFormFullTime, ParseStudyForm("Прикладная математика (очная форма)"))
+	assert.Equal(t, StudyFormPartTime, ParseStudyForm("Менеджмент, Очно-заочная"))
+	assert.Equal(t, StudyFormExtramural, ParseStudyForm("Юриспруденция (заочная)"))
+	// "восточный" contains "очн" in the middle of the word
+	assert.Equal(t, "", ParseStudyForm("Языки и литература стран Азии и Африки: восточная филология"))
+}
+
+func TestHeadingMetadata_Complete(t *testing.T) {
+	m := HeadingMetadata{}.Complete("01.03.02 Прикладная математика и информатика (очная)", []string{"Математика", "Информатика"})
+	assert.Equal(t, HeadingMetadata{
+		DirectionCode: "01.03.02",
+		Level:         LevelBachelor,
+		StudyForm:     StudyFormFullTime,
+		Exams:         []EntranceExam{{Subject: "Математика"}, {Subject: "Информатика"}},
+	}, m)
+
+	// Known fields are kept
+	known := HeadingMetadata{DirectionCode: "31.05.01", Exams: []EntranceExam{{Subject: "Химия", MinScore: 40}}}
+	m = known.Complete("Лечебное дело", []string{"Биология"})
+	assert.Equal(t, LevelSpecialist, m.Level)
+	assert.Equal(t, "", m.StudyForm)
+	assert.Equal(t, known.Exams, m.Exams)
+
+	assert.Equal(t, "", LevelFromDirectionCode(""))
+}
+
+func TestVarsityMetadata_GroupCode(t *testing.T) {
+	assert.Equal(t, "hse", VarsityMetadata{City: "Пермь", Parent: "hse"}.GroupCode("hse_perm"))
+	assert.Equal(t, "spbsu", VarsityMetadata{City: "Санкт-Петербург"}.GroupCode("spbsu"))
+}
@@ -0,0 +1,55 @@
+package metrics
+
+import (
+	"github.com/trueegorletov/analabit/core"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// CalculationMetrics holds the metrics of admission calculations
+type CalculationMetrics struct {
+	ValidationRuns       *prometheus.CounterVec
+	ValidationViolations *prometheus.CounterVec
+}
+
+// NewCalculationMetrics creates and registers calculation metrics
+func NewCalculationMetrics() *CalculationMetrics {
+	return &CalculationMetrics{
+		ValidationRuns: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "analabit_calculation_validations_total",
+				Help: "Total number of validated primary calculations",
+			},
+			[]string{"varsity", "status"},
+		),
+		ValidationViolations: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "analabit_calculation_violations_total",
+				Help: "Total number of invariant violations found in primary calculations",
+			},
+			[]string{"varsity", "kind"},
+		),
+	}
+}
+
+// RecordValidation records the outcome of validating the primary calculation of a varsity
+func (m *CalculationMetrics) RecordValidation(varsityCode string, violations []core.Violation) {
+	status := "valid"
+	if len(violations) > 0 {
+		status = "invalid"
+	}
+	m.ValidationRuns.WithLabelValues(varsityCode, status).Inc()
+
+	for _, v := range violations {
+		m.ValidationViolations.WithLabelValues(varsityCode, string(v.Kind)).Inc()
+	}
+}
+
+// Global calculation metrics instance
+var CalcMetrics *CalculationMetrics
+
+// InitCalculationMetrics initializes the global calculation metrics instance
+func InitCalculationMetrics() {
+	CalcMetrics = NewCalculationMetrics()
+}
@@ -8,8 +8,11 @@ import (
 // applicationsNormalizer takes a set of applications for a heading and normalizes them.
 // It ensures that each student is represented only by their best application and that
 // rating places are re-numbered consecutively according to official precedence rules.
+// If the published rating places are missing or inconsistent with the scores (or recompute is set),
+// applicants are ranked by the official tie-break rules instead.
 type applicationsNormalizer struct {
-	apps []*Application
+	apps      []*Application
+	recompute bool
 }
 
 // newApplicationsNormalizer creates a normalizer for a given slice of applications.
@@ -45,6 +48,11 @@ func (n *applicationsNormalizer) normalize() []*Application {
 		uniqueApps = append(uniqueApps, bestApp)
 	}
 
+	recompute := n.recompute || !officialRanksConsistent(uniqueApps)
+	if recompute && !n.recompute {
+		slog.Debug("Published rating places are missing or inconsistent, ranking by tie-break rules", "heading", uniqueApps[0].Heading().FullCode())
+	}
+
 	// Step 3: Sort the unique applications based on the defined multi-level precedence.
 	sort.SliceStable(uniqueApps, func(i, j int) bool {
 		appI := uniqueApps[i]
@@ -57,6 +65,13 @@ func (n *applicationsNormalizer) normalize() []*Application {
 			return precI > precJ
 		}
 
+		// Secondary sort key when the official ranking can't be trusted: the tie-break rules
+		if recompute {
+			if cmp := compareByTieBreak(appI, appJ); cmp != 0 {
+				return cmp < 0
+			}
+		}
+
 		// Secondary sort key: Rating Place (ascending)
 		if appI.RatingPlace() != appJ.RatingPlace() {
 			return appI.RatingPlace() < appJ.RatingPlace()
@@ -1,14 +1,21 @@
 package core
 
+import "slices"
+
 // UploadPayload is the contract between producer → aggregator.
 type UploadPayload struct {
-	VarsityCode  string                     `json:"varsity_code"`
-	VarsityName  string                     `json:"varsity_name"`
-	Headings     []HeadingDTO               `json:"headings"`
-	Students     []StudentDTO               `json:"students"`
-	Applications []ApplicationDTO           `json:"applications"`
-	Calculations []CalculationResultDTO     `json:"calculations"`
-	Drained      map[int][]DrainedResultDTO `json:"drained"` // key = drainedPercent
+	VarsityCode     string                       `json:"varsity_code"`
+	VarsityName     string                       `json:"varsity_name"`
+	VarsityMetadata VarsityMetadata              `json:"varsity_metadata"`
+	Headings        []HeadingDTO                 `json:"headings"`
+	Students        []StudentDTO                 `json:"students"`
+	Applications    []ApplicationDTO             `json:"applications"`
+	Calculations    []CalculationResultDTO       `json:"calculations"`
+	Drained         map[int][]DrainedResultDTO   `json:"drained"` // key = drainedPercent
+	Chances         map[int][]AdmissionChanceDTO `json:"chances"` // key = drainedPercent
+	Trace           []TraceEvent                 `json:"trace,omitempty"`
+	SourceReports   []SourceReport               `json:"source_reports,omitempty"`  // How each heading source was loaded
+	CapacityDrifts  []CapacityDrift              `json:"capacity_drifts,omitempty"` // Published capacities against the registry ones
 }
 
 // StudentDTO contains only essential data for an uploader.
@@ -19,32 +26,70 @@ type StudentDTO struct {
 
 // ApplicationDTO is a lean version of core.Application.
 type ApplicationDTO struct {
-	StudentID       string      `json:"student_id"`
-	HeadingCode     string      `json:"heading_code"`
-	Priority        int         `json:"priority"`
-	CompetitionType Competition `json:"competition_type"`
-	RatingPlace     int         `json:"rating_place"`
-	Score           int         `json:"score"`
-	MSUInternalID   *string     `json:"msu_internal_id,omitempty"`
+	StudentID        string      `json:"student_id"`
+	HeadingCode      string      `json:"heading_code"`
+	Priority         int         `json:"priority"`
+	CompetitionType  Competition `json:"competition_type"`
+	RatingPlace      int         `json:"rating_place"`
+	Score            int         `json:"score"`
+	SubjectScores    []int       `json:"subject_scores,omitempty"`
+	AchievementScore int         `json:"achievement_score"`
+	SubQuota         string      `json:"sub_quota,omitempty"` // Detailed target quota the application competes in
+	InternalID       *string     `json:"internal_id,omitempty"`
+	// Deprecated: MSUInternalID is InternalID under its name from when only MSU published internal IDs,
+	// still filled for the consumers of the old name. Use InternalID.
+	MSUInternalID *string `json:"msu_internal_id,omitempty"`
 }
 
 // HeadingDTO carries all essential information about a heading.
 type HeadingDTO struct {
-	Code                   string `json:"code"`
-	Name                   string `json:"name"`
-	RegularCapacity        int    `json:"regular_capacity"`
-	TargetQuotaCapacity    int    `json:"target_quota_capacity"`
-	DedicatedQuotaCapacity int    `json:"dedicated_quota_capacity"`
-	SpecialQuotaCapacity   int    `json:"special_quota_capacity"`
+	Code                   string         `json:"code"`
+	Name                   string         `json:"name"`
+	RegularCapacity        int            `json:"regular_capacity"`
+	TargetQuotaCapacity    int            `json:"target_quota_capacity"`
+	DedicatedQuotaCapacity int            `json:"dedicated_quota_capacity"`
+	SpecialQuotaCapacity   int            `json:"special_quota_capacity"`
+	Subjects               []string       `json:"subjects,omitempty"`          // In the order of tie-break priority
+	TargetSubQuotas        map[string]int `json:"target_sub_quotas,omitempty"` // Detailed target quota name -> capacity
+	PaidCapacity           int            `json:"paid_capacity,omitempty"`
+	HeadingMetadata
 }
 
 // CalculationResultDTO is a lean version of core.CalculationResult.
 type CalculationResultDTO struct {
-	HeadingCode             string       `json:"heading_code"`
-	Admitted                []StudentDTO `json:"admitted"`
-	RegularsAdmitted        bool         `json:"regulars_admitted"`
-	PassingScore            int          `json:"passing_score"`
-	LastAdmittedRatingPlace int          `json:"last_admitted_rating_place"`
+	HeadingCode             string                    `json:"heading_code"`
+	Admitted                []StudentDTO              `json:"admitted"`
+	Stages                  map[string]AdmissionStage `json:"stages,omitempty"` // student ID -> stage, only for staged enrollment
+	RegularsAdmitted        bool                      `json:"regulars_admitted"`
+	PassingScore            int                       `json:"passing_score"`
+	LastAdmittedRatingPlace int                       `json:"last_admitted_rating_place"`
+	SubQuotas               []SubQuotaResultDTO       `json:"sub_quotas,omitempty"` // Only for headings with detailed target quotas
+	// Results of the paid enrollment, only for headings with paid places
+	PaidAdmitted                []StudentDTO `json:"paid_admitted,omitempty"`
+	PaidPassingScore            int          `json:"paid_passing_score,omitempty"`
+	PaidLastAdmittedRatingPlace int          `json:"paid_last_admitted_rating_place,omitempty"`
+}
+
+// SubQuotaResultDTO carries the passing data of a single detailed target quota of a heading.
+// For primary calculations the average, min and max values are equal.
+type SubQuotaResultDTO struct {
+	Name                       string `json:"name"`
+	Capacity                   int    `json:"capacity"`
+	AvgAdmitted                int    `json:"avg_admitted"`
+	AvgPassingScore            int    `json:"avg_passing_score"`
+	MinPassingScore            int    `json:"min_passing_score"`
+	MaxPassingScore            int    `json:"max_passing_score"`
+	AvgLastAdmittedRatingPlace int    `json:"avg_last_admitted_rating_place"`
+	MinLastAdmittedRatingPlace int    `json:"min_last_admitted_rating_place"`
+	MaxLastAdmittedRatingPlace int    `json:"max_last_admitted_rating_place"`
+}
+
+// HistogramDTO is a compact histogram of the values a heading's statistic took across the drain iterations:
+// Counts[i] iterations had a value in [Start + i*Width, Start + (i+1)*Width).
+type HistogramDTO struct {
+	Start  int   `json:"start"`
+	Width  int   `json:"width"`
+	Counts []int `json:"counts"`
 }
 
 // DrainedResultDTO is a lean version of drainer.DrainedResult.
@@ -52,6 +97,8 @@ type CalculationResultDTO struct {
 type DrainedResultDTO struct {
 	HeadingCode                string `json:"heading_code"`
 	DrainedPercent             int    `json:"drained_percent"`
+	Seed                       int64  `json:"seed"`                  // Drainer seed, reproduces this result from the same cached data
+	DrainModel                 string `json:"drain_model,omitempty"` // Name of the drain model, empty means uniform
 	AvgPassingScore            int    `json:"avg_passing_score"`
 	MinPassingScore            int    `json:"min_passing_score"`
 	MaxPassingScore            int    `json:"max_passing_score"`
@@ -60,23 +107,52 @@ type DrainedResultDTO struct {
 	MinLastAdmittedRatingPlace int    `json:"min_last_admitted_rating_place"`
 	MaxLastAdmittedRatingPlace int    `json:"max_last_admitted_rating_place"`
 	MedLastAdmittedRatingPlace int    `json:"med_last_admitted_rating_place"`
+	P10PassingScore            int    `json:"p10_passing_score,omitempty"`
+	P25PassingScore            int    `json:"p25_passing_score,omitempty"`
+	P75PassingScore            int    `json:"p75_passing_score,omitempty"`
+	P90PassingScore            int    `json:"p90_passing_score,omitempty"`
+	P10LastAdmittedRatingPlace int    `json:"p10_last_admitted_rating_place,omitempty"`
+	P25LastAdmittedRatingPlace int    `json:"p25_last_admitted_rating_place,omitempty"`
+	P75LastAdmittedRatingPlace int    `json:"p75_last_admitted_rating_place,omitempty"`
+	P90LastAdmittedRatingPlace int    `json:"p90_last_admitted_rating_place,omitempty"`
+	Iterations                 int    `json:"iterations,omitempty"` // Number of drain iterations actually run, 0 for virtual results
 	RegularsAdmitted           bool   `json:"regulars_admitted"`
 	IsVirtual                  bool   `json:"is_virtual"`
+	AvgPriorityStageAdmitted   int    `json:"avg_priority_stage_admitted,omitempty"` // Only set for staged enrollment
+	AvgMainStageAdmitted       int    `json:"avg_main_stage_admitted,omitempty"`
+	// Passing data of the detailed target quotas, only for headings that have them
+	SubQuotas []SubQuotaResultDTO `json:"sub_quotas,omitempty"`
+	// Passing data of the paid places, only for headings that have them
+	AvgPaidPassingScore            int `json:"avg_paid_passing_score,omitempty"`
+	AvgPaidLastAdmittedRatingPlace int `json:"avg_paid_last_admitted_rating_place,omitempty"`
+	// Distributions of the passing score and the last admitted rating place over the iterations
+	PassingScoreHistogram            *HistogramDTO `json:"passing_score_histogram,omitempty"`
+	LastAdmittedRatingPlaceHistogram *HistogramDTO `json:"last_admitted_rating_place_histogram,omitempty"`
+}
+
+// AdmissionChanceDTO tells how often a student was admitted to a heading across the drain iterations of one stage.
+type AdmissionChanceDTO struct {
+	HeadingCode    string `json:"heading_code"`
+	StudentID      string `json:"student_id"`
+	DrainedPercent int    `json:"drained_percent"`
+	AdmittedCount  int    `json:"admitted_count"`
+	Iterations     int    `json:"iterations"`
 }
 
 // NewUploadPayloadFromCalculator creates an UploadPayload from a VarsityCalculator and its results
 // Note: drainedResults parameter should be map[int][]drainer.DrainedResult but we avoid the import cycle
-// msuInternalIDs parameter is optional map of studentID -> msuInternalID for MSU-specific data
-func NewUploadPayloadFromCalculator(vc *VarsityCalculator, results []CalculationResult, drainedDTOs map[int][]DrainedResultDTO, msuInternalIDs map[string]string) *UploadPayload {
+// internalIDs parameter is optional map of studentID -> internal ID published by the varsity
+func NewUploadPayloadFromCalculator(vc *VarsityCalculator, results []CalculationResult, drainedDTOs map[int][]DrainedResultDTO, internalIDs map[string]string) *UploadPayload {
 
 	payload := &UploadPayload{
-		VarsityCode:  vc.code,
-		VarsityName:  vc.prettyName,
-		Headings:     make([]HeadingDTO, 0, len(vc.Headings())),
-		Students:     make([]StudentDTO, 0),
-		Applications: make([]ApplicationDTO, 0),
-		Calculations: make([]CalculationResultDTO, 0, len(results)),
-		Drained:      drainedDTOs,
+		VarsityCode:     vc.code,
+		VarsityName:     vc.prettyName,
+		VarsityMetadata: vc.metadata,
+		Headings:        make([]HeadingDTO, 0, len(vc.Headings())),
+		Students:        make([]StudentDTO, 0),
+		Applications:    make([]ApplicationDTO, 0),
+		Calculations:    make([]CalculationResultDTO, 0, len(results)),
+		Drained:         drainedDTOs,
 	}
 
 	// Create a map to track students we've already added
@@ -91,6 +167,10 @@ func NewUploadPayloadFromCalculator(vc *VarsityCalculator, results []Calculation
 			TargetQuotaCapacity:    h.Capacities().TargetQuota,
 			DedicatedQuotaCapacity: h.Capacities().DedicatedQuota,
 			SpecialQuotaCapacity:   h.Capacities().SpecialQuota,
+			Subjects:               h.Subjects(),
+			TargetSubQuotas:        h.Capacities().TargetSubQuotas,
+			PaidCapacity:           h.Capacities().Paid,
+			HeadingMetadata:        h.Metadata(),
 		})
 	}
 
@@ -104,22 +184,26 @@ func NewUploadPayloadFromCalculator(vc *VarsityCalculator, results []Calculation
 			studentMap[student.ID()] = true
 		}
 
-		// Convert applications for this student
-		for _, app := range student.Applications() {
-			// Get MSU internal ID if available
-			var msuInternalID *string
-			if internalID, exists := msuInternalIDs[app.StudentID()]; exists {
-				msuInternalID = &internalID
+		// Convert applications for this student, paid ones included
+		for _, app := range slices.Concat(student.Applications(), student.PaidApplications()) {
+			// Get internal ID if available
+			var internalID *string
+			if id, exists := internalIDs[app.StudentID()]; exists {
+				internalID = &id
 			}
 
 			payload.Applications = append(payload.Applications, ApplicationDTO{
-				StudentID:       app.StudentID(),
-				HeadingCode:     app.Heading().FullCode(),
-				Priority:        app.Priority(),
-				CompetitionType: app.CompetitionType(),
-				RatingPlace:     app.RatingPlace(),
-				Score:           app.Score(),
-				MSUInternalID:   msuInternalID,
+				StudentID:        app.StudentID(),
+				HeadingCode:      app.Heading().FullCode(),
+				Priority:         app.Priority(),
+				CompetitionType:  app.CompetitionType(),
+				RatingPlace:      app.RatingPlace(),
+				Score:            app.Score(),
+				SubjectScores:    app.SubjectScores(),
+				AchievementScore: app.AchievementScore(),
+				SubQuota:         app.SubQuota(),
+				InternalID:       internalID,
+				MSUInternalID:    internalID,
 			})
 		}
 	}
@@ -146,14 +230,55 @@ func NewUploadPayloadFromCalculator(vc *VarsityCalculator, results []Calculation
 			larp = 0
 		}
 
+		paidAdmitted := make([]StudentDTO, 0, len(result.PaidAdmitted))
+		for _, student := range result.PaidAdmitted {
+			paidAdmitted = append(paidAdmitted, StudentDTO{
+				ID:                student.ID(),
+				OriginalSubmitted: student.OriginalSubmitted(),
+			})
+		}
+
+		// Both are left 0 for headings nobody was admitted to on paid places
+		paidPassingScore, _ := result.PaidPassingScore()
+		paidLarp, _ := result.PaidLastAdmittedRatingPlace()
+
 		payload.Calculations = append(payload.Calculations, CalculationResultDTO{
 			HeadingCode:             result.Heading.FullCode(),
 			Admitted:                admittedStudents,
+			Stages:                  result.Stages,
 			RegularsAdmitted:        result.CheckRegularsAdmitted(),
 			PassingScore:            passingScore,
 			LastAdmittedRatingPlace: larp,
+			SubQuotas:               newSubQuotaResultDTOs(result.SubQuotaResults()),
+
+			PaidAdmitted:                paidAdmitted,
+			PaidPassingScore:            paidPassingScore,
+			PaidLastAdmittedRatingPlace: paidLarp,
 		})
 	}
 
 	return payload
 }
+
+// newSubQuotaResultDTOs converts the sub-quota results of a single calculation to DTOs.
+func newSubQuotaResultDTOs(results []SubQuotaResult) []SubQuotaResultDTO {
+	if len(results) == 0 {
+		return nil
+	}
+
+	dtos := make([]SubQuotaResultDTO, 0, len(results))
+	for _, r := range results {
+		dtos = append(dtos, SubQuotaResultDTO{
+			Name:                       r.Name,
+			Capacity:                   r.Capacity,
+			AvgAdmitted:                r.Admitted,
+			AvgPassingScore:            r.PassingScore,
+			MinPassingScore:            r.PassingScore,
+			MaxPassingScore:            r.PassingScore,
+			AvgLastAdmittedRatingPlace: r.LastAdmittedRatingPlace,
+			MinLastAdmittedRatingPlace: r.LastAdmittedRatingPlace,
+			MaxLastAdmittedRatingPlace: r.LastAdmittedRatingPlace,
+		})
+	}
+	return dtos
+}
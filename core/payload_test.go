@@ -117,7 +117,7 @@ func TestApplicationDTO(t *testing.T) {
 	}
 }
 
-func TestNewUploadPayloadFromCalculatorWithMSUInternalIDs(t *testing.T) {
+func TestNewUploadPayloadFromCalculatorWithInternalIDs(t *testing.T) {
 	// Create a VarsityCalculator with test data
 	vc := NewVarsityCalculator("msu", "Moscow State University")
 
@@ -133,14 +133,14 @@ func TestNewUploadPayloadFromCalculatorWithMSUInternalIDs(t *testing.T) {
 	// Calculate admissions
 	results := vc.CalculateAdmissions()
 
-	// Create MSU internal IDs map (VarsityCalculator prepends "0" to student IDs)
-	msuInternalIDs := map[string]string{
+	// Create internal IDs map (VarsityCalculator prepends "0" to student IDs)
+	internalIDs := map[string]string{
 		"0202310001234": "001234", // Last 6 digits
 		"0202310005678": "005678", // Last 6 digits
 	}
 
-	// Convert to UploadPayload with MSU internal IDs
-	payload := NewUploadPayloadFromCalculator(vc, results, nil, msuInternalIDs)
+	// Convert to UploadPayload with internal IDs
+	payload := NewUploadPayloadFromCalculator(vc, results, nil, internalIDs)
 
 	// Debug: Print all applications
 	t.Logf("Total applications in payload: %d", len(payload.Applications))
@@ -148,24 +148,27 @@ func TestNewUploadPayloadFromCalculatorWithMSUInternalIDs(t *testing.T) {
 		t.Logf("Application %d: StudentID=%s, HeadingCode=%s", i, app.StudentID, app.HeadingCode)
 	}
 
-	// Verify applications have MSU internal IDs
+	// Verify applications have internal IDs
 	foundApp1 := false
 	foundApp2 := false
 	for _, app := range payload.Applications {
 		if app.StudentID == "0202310001234" { // Note: VarsityCalculator prepends "0"
 			foundApp1 = true
-			if app.MSUInternalID == nil {
-				t.Error("Expected MSU internal ID for student 0202310001234, got nil")
-			} else if *app.MSUInternalID != "001234" {
-				t.Errorf("Expected MSU internal ID '001234', got '%s'", *app.MSUInternalID)
+			if app.InternalID == nil {
+				t.Error("Expected internal ID for student 0202310001234, got nil")
+			} else if *app.InternalID != "001234" {
+				t.Errorf("Expected internal ID '001234', got '%s'", *app.InternalID)
+			}
+			if app.MSUInternalID != app.InternalID {
+				t.Error("Expected the deprecated MSU internal ID to alias the internal ID")
 			}
 		}
 		if app.StudentID == "0202310005678" { // Note: VarsityCalculator prepends "0"
 			foundApp2 = true
-			if app.MSUInternalID == nil {
-				t.Error("Expected MSU internal ID for student 0202310005678, got nil")
-			} else if *app.MSUInternalID != "005678" {
-				t.Errorf("Expected MSU internal ID '005678', got '%s'", *app.MSUInternalID)
+			if app.InternalID == nil {
+				t.Error("Expected internal ID for student 0202310005678, got nil")
+			} else if *app.InternalID != "005678" {
+				t.Errorf("Expected internal ID '005678', got '%s'", *app.InternalID)
 			}
 		}
 	}
@@ -1,6 +1,7 @@
 package registry
 
 import (
+	"context"
 	"fmt"
 	"io/fs"
 	"log"
@@ -12,6 +13,7 @@ import (
 	"time"
 
 	"github.com/trueegorletov/analabit/core/source"
+	"github.com/trueegorletov/analabit/core/source/httparchive"
 )
 
 type CrawlOptions struct {
@@ -21,17 +23,70 @@ type CrawlOptions struct {
 	CacheTTLMinutes  int
 	DrainStages      []int
 	DrainIterations  int
+	// DrainMaxIterations and DrainTolerance make drain simulations adaptive: after DrainIterations, iterations go on
+	// until every heading's average passing score has a standard error of at most DrainTolerance points, but
+	// no longer than DrainMaxIterations in total. A zero tolerance runs exactly DrainIterations
+	DrainMaxIterations int
+	DrainTolerance     float64
+	// DrainSeed is the master seed of drain simulations; 0 means "pick a fresh one"
+	DrainSeed int64
+	// CacheFile pins a specific cache file (absolute or relative to CacheDir) regardless of its age,
+	// which allows re-running the calculations of a past run over exactly the same data
+	CacheFile string
+	// DrainModel is the name of the drain model (see drainer.NewModel) used for varsities missing from DrainModels;
+	// empty means uniform
+	DrainModel string
+	// DrainModels selects the drain model per varsity code
+	DrainModels map[string]string
+	// PreviousCacheFile is the data file of the previous run (absolute or relative to CacheDir),
+	// which the calibrated drain model estimates the observed quits from
+	PreviousCacheFile string
+	// HTTPRecord records every HTTP exchange of the sources crawled anew into an archive file in CacheDir,
+	// named by the crawl's timestamp like the cache files
+	HTTPRecord bool
+	// HTTPReplayFile is an archive recorded with HTTPRecord (absolute or relative to CacheDir) to serve the
+	// crawl from instead of the network. A replayed crawl loads all varsities from their sources, neither
+	// looking up nor saving caches
+	HTTPReplayFile string
+	// ApplyPublishedCapacities loads the headings with the capacities published by the capacity feeds of
+	// their varsities where they drifted from the registry, whatever the definitions say
+	ApplyPublishedCapacities bool
+}
+
+// DrainModelFor returns the name of the drain model selected for the varsity with the given code.
+func (o CrawlOptions) DrainModelFor(varsityCode string) string {
+	if model, ok := o.DrainModels[varsityCode]; ok {
+		return model
+	}
+	return o.DrainModel
 }
 
 type CrawlResult struct {
 	LoadedVarsities []*source.Varsity
 	CacheUsed       bool
 	CacheFile       string
+	// DataFile is the cache file holding exactly the data of LoadedVarsities (empty if it wasn't saved).
+	// Passing it back as CrawlOptions.CacheFile reproduces the run's input.
+	DataFile string
+	// PreviousVarsities holds the varsities loaded from CrawlOptions.PreviousCacheFile, if it was set
+	PreviousVarsities []*source.Varsity
+	// HTTPArchiveFile is the archive the HTTP exchanges of the crawl were recorded into, if CrawlOptions.HTTPRecord was set
+	HTTPArchiveFile string
+}
+
+// PreviousVarsity returns the varsity with the given code as loaded from the previous run's data, or nil.
+func (r *CrawlResult) PreviousVarsity(code string) *source.Varsity {
+	for _, v := range r.PreviousVarsities {
+		if v.Code == code {
+			return v
+		}
+	}
+	return nil
 }
 
 // CrawlWithOptions performs crawling and cache lookup, given a set of definitions.
 // If cacheTTLMinutes == -1, disables cache lookup and always crawls.
-func CrawlWithOptions(defs []source.VarsityDefinition, params CrawlOptions) (*CrawlResult, error) {
+func CrawlWithOptions(ctx context.Context, defs []source.VarsityDefinition, params CrawlOptions) (*CrawlResult, error) {
 
 	var filteredDefs []source.VarsityDefinition
 	varsitiesToUse := make(map[string]bool)
@@ -49,6 +104,7 @@ func CrawlWithOptions(defs []source.VarsityDefinition, params CrawlOptions) (*Cr
 	}
 	for _, def := range defs {
 		if varsitiesToUse[def.Code] {
+			def.ApplyPublishedCapacities = def.ApplyPublishedCapacities || params.ApplyPublishedCapacities
 			filteredDefs = append(filteredDefs, def)
 		}
 	}
@@ -58,11 +114,56 @@ func CrawlWithOptions(defs []source.VarsityDefinition, params CrawlOptions) (*Cr
 
 	cacheDir := params.CacheDir
 	cacheTTL := params.CacheTTLMinutes
+
+	var httpArchiveFile string
+	switch {
+	case params.HTTPReplayFile != "" && params.HTTPRecord:
+		return nil, fmt.Errorf("HTTP exchanges can't be recorded while replaying them")
+	case params.HTTPReplayFile != "":
+		if params.CacheFile != "" {
+			return nil, fmt.Errorf("a replayed crawl can't use the pinned cache file %s", params.CacheFile)
+		}
+		archive, err := httparchive.StartReplaying(resolveCacheFile(params.HTTPReplayFile, cacheDir))
+		if err != nil {
+			return nil, err
+		}
+		defer archive.Close()
+		cacheTTL = -1
+	case params.HTTPRecord:
+		_ = os.MkdirAll(cacheDir, 0755)
+		archive, err := httparchive.StartRecording(filepath.Join(cacheDir, fmt.Sprintf("%d.http.gz", time.Now().Unix())))
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			if err := archive.Close(); err != nil {
+				log.Printf("Failed to save the HTTP archive: %v", err)
+			}
+		}()
+		httpArchiveFile = archive.Path()
+	}
+
+	var previousVarsities []*source.Varsity
+	if params.PreviousCacheFile != "" {
+		var err error
+		previousVarsities, err = loadPrevious(ctx, filteredDefs, resolveCacheFile(params.PreviousCacheFile, cacheDir))
+		if err != nil {
+			return nil, err
+		}
+		log.Printf("Loaded %d varsities of the previous run from %s", len(previousVarsities), params.PreviousCacheFile)
+	}
 	var validCacheFile string
+	var dataFile string
 	var latestTimestamp int64 = -1
 	cacheUsed := false
 
-	if cacheTTL != -1 {
+	if params.CacheFile != "" {
+		validCacheFile = resolveCacheFile(params.CacheFile, cacheDir)
+		if _, err := os.Stat(validCacheFile); err != nil {
+			return nil, fmt.Errorf("pinned cache file %s is not available: %w", params.CacheFile, err)
+		}
+		log.Printf("Using pinned cache file: %s", validCacheFile)
+	} else if cacheTTL != -1 {
 		ttlSeconds := int64(cacheTTL * 60)
 		currentTime := time.Now().Unix()
 
@@ -131,18 +232,28 @@ func CrawlWithOptions(defs []source.VarsityDefinition, params CrawlOptions) (*Cr
 		if err != nil {
 			log.Println("Error happened")
 			log.Printf("Failed to open cache file: %v", err)
+			if params.CacheFile != "" {
+				return nil, fmt.Errorf("failed to open pinned cache file %s: %w", validCacheFile, err)
+			}
 		} else {
 			defer file.Close()
 			caches, err := source.DeserializeList(file)
 			if err != nil {
 				log.Printf("Failed to deserialize cache file: %v", err)
+				if params.CacheFile != "" {
+					return nil, fmt.Errorf("failed to deserialize pinned cache file %s: %w", validCacheFile, err)
+				}
 			} else {
 				var cacheComplete bool
-				loadedVarsities, cacheComplete = source.LoadWithCaches(filteredDefs, caches)
+				loadedVarsities, cacheComplete = source.LoadWithCaches(ctx, filteredDefs, caches)
 				cacheUsed = true
 
+				if !cacheComplete && params.CacheFile != "" {
+					log.Printf("Pinned cache file %s does not cover all requested varsities, the missing ones were crawled anew", validCacheFile)
+				}
+
 				// If cache was incomplete, save the updated cache
-				if !cacheComplete && len(loadedVarsities) > 0 && cacheTTL != -1 {
+				if !cacheComplete && len(loadedVarsities) > 0 && cacheTTL != -1 && params.CacheFile == "" {
 					_ = os.MkdirAll(cacheDir, 0755)
 					newFile := filepath.Join(cacheDir, fmt.Sprintf("%d.gob", time.Now().Unix()))
 					if file, err := os.Create(newFile); err != nil {
@@ -157,14 +268,18 @@ func CrawlWithOptions(defs []source.VarsityDefinition, params CrawlOptions) (*Cr
 						}
 						if err := source.SerializeList(cachesToSave, file); err != nil {
 							log.Printf("Failed to serialize cache list: %v", err)
+						} else {
+							dataFile = newFile
 						}
 					}
+				} else if cacheComplete {
+					dataFile = validCacheFile
 				}
 			}
 		}
 	}
 	if len(loadedVarsities) == 0 {
-		loadedVarsities = source.LoadFromDefinitions(filteredDefs)
+		loadedVarsities = source.LoadFromDefinitions(ctx, filteredDefs)
 		if len(loadedVarsities) > 0 && cacheTTL != -1 {
 			_ = os.MkdirAll(cacheDir, 0755)
 			newFile := filepath.Join(cacheDir, fmt.Sprintf("%d.gob", time.Now().Unix()))
@@ -180,6 +295,8 @@ func CrawlWithOptions(defs []source.VarsityDefinition, params CrawlOptions) (*Cr
 				}
 				if err := source.SerializeList(cachesToSave, file); err != nil {
 					log.Printf("Failed to serialize cache list: %v", err)
+				} else {
+					dataFile = newFile
 				}
 			}
 		}
@@ -189,9 +306,52 @@ func CrawlWithOptions(defs []source.VarsityDefinition, params CrawlOptions) (*Cr
 		return loadedVarsities[i].Name < loadedVarsities[j].Name
 	})
 	return &CrawlResult{
-		LoadedVarsities: loadedVarsities,
-		CacheUsed:       cacheUsed,
-		CacheFile:       validCacheFile,
+		LoadedVarsities:   loadedVarsities,
+		CacheUsed:         cacheUsed,
+		CacheFile:         validCacheFile,
+		DataFile:          dataFile,
+		PreviousVarsities: previousVarsities,
+		HTTPArchiveFile:   httpArchiveFile,
 	}, nil
 
 }
+
+// resolveCacheFile resolves a cache file path given relative to the working directory or to cacheDir.
+func resolveCacheFile(path, cacheDir string) string {
+	if !filepath.IsAbs(path) {
+		if _, err := os.Stat(path); err != nil {
+			return filepath.Join(cacheDir, path)
+		}
+	}
+	return path
+}
+
+// loadPrevious loads the varsities of the given definitions from a previous run's cache file.
+// Varsities missing from the file are skipped rather than crawled.
+func loadPrevious(ctx context.Context, defs []source.VarsityDefinition, path string) ([]*source.Varsity, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open previous run's cache file %s: %w", path, err)
+	}
+	defer file.Close()
+
+	caches, err := source.DeserializeList(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deserialize previous run's cache file %s: %w", path, err)
+	}
+
+	cachedCodes := make(map[string]bool, len(caches))
+	for _, cache := range caches {
+		cachedCodes[cache.Definition.Code] = true
+	}
+
+	var cachedDefs []source.VarsityDefinition
+	for _, def := range defs {
+		if cachedCodes[def.Code] {
+			cachedDefs = append(cachedDefs, def)
+		}
+	}
+
+	previous, _ := source.LoadWithCaches(ctx, cachedDefs, caches)
+	return previous, nil
+}
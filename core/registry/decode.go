@@ -0,0 +1,197 @@
+package registry
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// decodeValue stores the value decoded from a definition file into v, failing on the keys v has no fields
+// for and on the values of other types than the fields'. Structs are decoded from mappings whose keys are
+// the JSON names of their fields, or the snake_case ones of the fields without a JSON name.
+func decodeValue(path string, raw any, v reflect.Value) error {
+	if raw == nil {
+		return nil
+	}
+
+	if v.Type() == durationType {
+		s, ok := raw.(string)
+		if !ok {
+			return fmt.Errorf("%s: expected a duration like \"30m\", got %v", path, raw)
+		}
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		s, ok := raw.(string)
+		if !ok {
+			return fmt.Errorf("%s: expected a string, got %v", path, raw)
+		}
+		v.SetString(s)
+	case reflect.Bool:
+		b, ok := raw.(bool)
+		if !ok {
+			return fmt.Errorf("%s: expected a boolean, got %v", path, raw)
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, ok := raw.(int)
+		if !ok || v.OverflowInt(int64(n)) {
+			return fmt.Errorf("%s: expected an integer, got %v", path, raw)
+		}
+		v.SetInt(int64(n))
+	case reflect.Float32, reflect.Float64:
+		switch n := raw.(type) {
+		case float64:
+			v.SetFloat(n)
+		case int:
+			v.SetFloat(float64(n))
+		default:
+			return fmt.Errorf("%s: expected a number, got %v", path, raw)
+		}
+	case reflect.Slice:
+		items, ok := raw.([]any)
+		if !ok {
+			return fmt.Errorf("%s: expected a list, got %v", path, raw)
+		}
+		slice := reflect.MakeSlice(v.Type(), len(items), len(items))
+		for i, item := range items {
+			if err := decodeValue(fmt.Sprintf("%s[%d]", path, i), item, slice.Index(i)); err != nil {
+				return err
+			}
+		}
+		v.Set(slice)
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return fmt.Errorf("%s: maps keyed by %s can't be decoded", path, v.Type().Key())
+		}
+		entries, err := mapping(path, raw)
+		if err != nil {
+			return err
+		}
+		m := reflect.MakeMapWithSize(v.Type(), len(entries))
+		for _, key := range sortedKeys(entries) {
+			elem := reflect.New(v.Type().Elem()).Elem()
+			if err := decodeValue(joinPath(path, key), entries[key], elem); err != nil {
+				return err
+			}
+			m.SetMapIndex(reflect.ValueOf(key).Convert(v.Type().Key()), elem)
+		}
+		v.Set(m)
+	case reflect.Struct:
+		entries, err := mapping(path, raw)
+		if err != nil {
+			return err
+		}
+		fields := structFields(v.Type())
+		for _, key := range sortedKeys(entries) {
+			index, ok := fields[key]
+			if !ok {
+				return fmt.Errorf("%s: unknown field %q of %s", path, key, v.Type())
+			}
+			if err := decodeValue(joinPath(path, key), entries[key], v.Field(index)); err != nil {
+				return err
+			}
+		}
+	case reflect.Pointer:
+		elem := reflect.New(v.Type().Elem())
+		if err := decodeValue(path, raw, elem.Elem()); err != nil {
+			return err
+		}
+		v.Set(elem)
+	default:
+		return fmt.Errorf("%s: fields of type %s can't be set in definition files", path, v.Type())
+	}
+	return nil
+}
+
+// mapping returns the entries of a mapping by their keys, which are strings even if they look like numbers.
+func mapping(path string, raw any) (map[string]any, error) {
+	switch m := raw.(type) {
+	case map[string]any:
+		return m, nil
+	case map[any]any:
+		entries := make(map[string]any, len(m))
+		for k, v := range m {
+			entries[fmt.Sprint(k)] = v
+		}
+		return entries, nil
+	default:
+		return nil, fmt.Errorf("%s: expected a mapping, got %v", path, raw)
+	}
+}
+
+// structFields returns the indexes of the exported fields of t by their keys in definition files.
+func structFields(t reflect.Type) map[string]int {
+	fields := make(map[string]int, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		if key := fieldKey(t.Field(i)); key != "" {
+			fields[key] = i
+		}
+	}
+	return fields
+}
+
+// fieldKey returns the key of the field in definition files, empty if it can't be set in them.
+func fieldKey(f reflect.StructField) string {
+	if !f.IsExported() {
+		return ""
+	}
+	if tag, ok := f.Tag.Lookup("json"); ok {
+		name, _, _ := strings.Cut(tag, ",")
+		if name == "-" {
+			return ""
+		}
+		if name != "" {
+			return name
+		}
+	}
+	return snakeCase(f.Name)
+}
+
+// snakeCase converts a Go identifier to snake_case, keeping initialisms whole: "TargetQuotaListIDs"
+// becomes "target_quota_list_ids" and "RegularBVIListURL" becomes "regular_bvi_list_url".
+func snakeCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			// A lowercase letter after an initialism starts a new word, unless it's the plural "s" ending it
+			startsWord := i+1 < len(runes) && unicode.IsLower(runes[i+1]) &&
+				!(runes[i+1] == 's' && (i+2 == len(runes) || unicode.IsUpper(runes[i+2])))
+			if !unicode.IsUpper(prev) || startsWord {
+				b.WriteByte('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,274 @@
+package registry
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"reflect"
+	"regexp"
+	"sort"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/source"
+)
+
+// DefinitionVersion is the version of the varsity definition file format; files of other versions are rejected.
+const DefinitionVersion = 1
+
+// DefinitionsDirEnv names the environment variable with the directory the varsity definitions are loaded
+// from instead of the embedded ones, so that e.g. capacities can be corrected without a rebuild.
+const DefinitionsDirEnv = "VARSITY_DEFINITIONS_DIR"
+
+//go:embed definitions/*.yaml
+var embeddedDefinitions embed.FS
+
+// EmbeddedDefinitions returns the varsity definition files built into the binary.
+func EmbeddedDefinitions() fs.FS {
+	sub, err := fs.Sub(embeddedDefinitions, "definitions")
+	if err != nil {
+		panic(err)
+	}
+	return sub
+}
+
+var varsityCodePattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// definitionFile is a varsity definition file but its heading sources, each of which is decoded into
+// the heading source type registered under its "type".
+type definitionFile struct {
+	Version int
+	Code    string
+	// Position of the varsity in the registry, unique among the definitions. A student's applications of
+	// equal priority to several varsities are preferred in this order.
+	Order            int
+	Name             string
+	RecomputeRatings bool
+	StagedEnrollment bool
+	Metadata         core.VarsityMetadata
+	// Name of a registered ID resolution
+	IDResolution string
+	LoadTimeout  time.Duration
+	// ApplyPublishedCapacities loads the headings with the capacities of the varsity's capacity feed
+	// where they drifted from the registry ones
+	ApplyPublishedCapacities bool
+}
+
+// LoadDefinitions decodes the varsity definitions of every *.yaml file of fsys, one varsity per file named
+// after its code, in the order of their "order". All files are validated and the errors of all of them
+// are returned together.
+func LoadDefinitions(fsys fs.FS) ([]source.VarsityDefinition, error) {
+	names, err := fs.Glob(fsys, "*.yaml")
+	if err != nil {
+		return nil, err
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no varsity definition files found")
+	}
+	sort.Strings(names)
+
+	var errs []error
+	defs := make([]source.VarsityDefinition, 0, len(names))
+	orders := make(map[string]int, len(names))
+	orderNames := make(map[int]string, len(names))
+	for _, name := range names {
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		def, order, err := decodeDefinition(data)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+			continue
+		}
+		if want := def.Code + path.Ext(name); name != want {
+			errs = append(errs, fmt.Errorf("%s: the definition of varsity %s must be named %s", name, def.Code, want))
+			continue
+		}
+		if other, ok := orderNames[order]; ok {
+			errs = append(errs, fmt.Errorf("%s: order %d is taken by %s", name, order, other))
+			continue
+		}
+		orders[def.Code], orderNames[order] = order, name
+		defs = append(defs, def)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	sort.Slice(defs, func(i, j int) bool {
+		return orders[defs[i].Code] < orders[defs[j].Code]
+	})
+	return defs, nil
+}
+
+// DecodeDefinition decodes and validates a varsity definition file.
+func DecodeDefinition(data []byte) (source.VarsityDefinition, error) {
+	def, _, err := decodeDefinition(data)
+	return def, err
+}
+
+// decodeDefinition decodes and validates a varsity definition file and returns its order too.
+func decodeDefinition(data []byte) (source.VarsityDefinition, int, error) {
+	var raw map[string]any
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return source.VarsityDefinition{}, 0, err
+	}
+
+	rawSources, rawCapacityFeed := raw["sources"], raw["capacity_feed"]
+	delete(raw, "sources")
+	delete(raw, "capacity_feed")
+
+	var file definitionFile
+	if err := decodeValue("", raw, reflect.ValueOf(&file).Elem()); err != nil {
+		return source.VarsityDefinition{}, 0, err
+	}
+
+	switch {
+	case file.Version != DefinitionVersion:
+		return source.VarsityDefinition{}, 0, fmt.Errorf("unsupported version %d, expected %d", file.Version, DefinitionVersion)
+	case !varsityCodePattern.MatchString(file.Code):
+		return source.VarsityDefinition{}, 0, fmt.Errorf("code: invalid varsity code %q", file.Code)
+	case file.Order <= 0:
+		return source.VarsityDefinition{}, 0, fmt.Errorf("order: expected a positive number, got %d", file.Order)
+	case file.Name == "":
+		return source.VarsityDefinition{}, 0, fmt.Errorf("name: missing")
+	case file.LoadTimeout < 0:
+		return source.VarsityDefinition{}, 0, fmt.Errorf("load_timeout: negative")
+	}
+
+	def := source.VarsityDefinition{
+		Code:                     file.Code,
+		Name:                     file.Name,
+		RecomputeRatings:         file.RecomputeRatings,
+		StagedEnrollment:         file.StagedEnrollment,
+		Metadata:                 file.Metadata,
+		LoadTimeout:              file.LoadTimeout,
+		ApplyPublishedCapacities: file.ApplyPublishedCapacities,
+	}
+
+	if file.IDResolution != "" {
+		resolution, err := source.NewIDResolution(file.IDResolution)
+		if err != nil {
+			return source.VarsityDefinition{}, 0, fmt.Errorf("id_resolution: %w", err)
+		}
+		def.IDResolution = resolution
+	}
+
+	if rawCapacityFeed != nil {
+		feed, err := decodeCapacityFeed(rawCapacityFeed)
+		if err != nil {
+			return source.VarsityDefinition{}, 0, err
+		}
+		def.CapacityFeed = feed.FetchCapacities
+		if totals, ok := feed.(source.TotalCapacityFeed); ok {
+			def.CapacityFeedTotals = totals.PublishesTotals()
+		}
+	}
+
+	sources, err := decodeSources(rawSources)
+	if err != nil {
+		return source.VarsityDefinition{}, 0, err
+	}
+	def.HeadingSources = sources
+
+	return def, file.Order, nil
+}
+
+func decodeSources(raw any) ([]source.HeadingSource, error) {
+	items, ok := raw.([]any)
+	if !ok || len(items) == 0 {
+		return nil, fmt.Errorf("sources: expected a non-empty list of heading sources")
+	}
+
+	sources := make([]source.HeadingSource, 0, len(items))
+	for i, item := range items {
+		path := fmt.Sprintf("sources[%d]", i)
+
+		typeName, fields, err := typedMapping(path, item, source.HeadingSourceTypes)
+		if err != nil {
+			return nil, err
+		}
+		hs, err := source.NewHeadingSource(typeName)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		if err := decodeValue(path, fields, reflect.ValueOf(hs).Elem()); err != nil {
+			return nil, err
+		}
+		if err := validateCapacities(path, reflect.ValueOf(hs).Elem()); err != nil {
+			return nil, err
+		}
+
+		sources = append(sources, hs)
+	}
+	return sources, nil
+}
+
+// decodeCapacityFeed decodes the capacity feed of a varsity into the capacity feed type registered under
+// its "type".
+func decodeCapacityFeed(raw any) (source.CapacityFeed, error) {
+	const path = "capacity_feed"
+
+	typeName, fields, err := typedMapping(path, raw, source.CapacityFeedTypes)
+	if err != nil {
+		return nil, err
+	}
+	feed, err := source.NewCapacityFeed(typeName)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if err := decodeValue(path, fields, reflect.ValueOf(feed).Elem()); err != nil {
+		return nil, err
+	}
+	return feed, nil
+}
+
+// typedMapping splits a mapping into the name of its "type", one of the given types, and its other entries.
+func typedMapping(path string, raw any, types func() []string) (string, map[string]any, error) {
+	entries, err := mapping(path, raw)
+	if err != nil {
+		return "", nil, err
+	}
+	typeName, ok := entries["type"].(string)
+	if !ok {
+		return "", nil, fmt.Errorf("%s: missing type, one of %v", path, types())
+	}
+
+	fields := make(map[string]any, len(entries)-1)
+	for k, v := range entries {
+		if k != "type" {
+			fields[k] = v
+		}
+	}
+	return typeName, fields, nil
+}
+
+var capacitiesType = reflect.TypeOf(core.Capacities{})
+
+// validateCapacities checks the capacities fields of a heading source for negative numbers of places.
+func validateCapacities(path string, v reflect.Value) error {
+	for i := 0; i < v.NumField(); i++ {
+		if v.Type().Field(i).Type != capacitiesType {
+			continue
+		}
+
+		c := v.Field(i).Interface().(core.Capacities)
+		key := joinPath(path, fieldKey(v.Type().Field(i)))
+		if c.Regular < 0 || c.TargetQuota < 0 || c.DedicatedQuota < 0 || c.SpecialQuota < 0 || c.Paid < 0 {
+			return fmt.Errorf("%s: negative capacity in %+v", key, c)
+		}
+		for list, capacity := range c.TargetSubQuotas {
+			if capacity < 0 {
+				return fmt.Errorf("%s.target_sub_quotas.%s: negative capacity %d", key, list, capacity)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,276 @@
+package registry
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/source"
+	"github.com/trueegorletov/analabit/core/source/httparchive"
+	"github.com/trueegorletov/analabit/core/source/itmo"
+	"github.com/trueegorletov/analabit/core/source/msu"
+	"github.com/trueegorletov/analabit/core/source/spbsu"
+)
+
+func TestEmbeddedDefinitions(t *testing.T) {
+	defs, err := LoadDefinitions(EmbeddedDefinitions())
+	require.NoError(t, err)
+
+	codes := make(map[string]bool)
+	for _, def := range defs {
+		assert.False(t, codes[def.Code], "duplicate varsity %s", def.Code)
+		codes[def.Code] = true
+		assert.NotEmpty(t, def.HeadingSources, def.Code)
+	}
+	assert.Len(t, defs, 14)
+
+	// The varsities are ordered as the registry was before the definition files
+	var order []string
+	for _, def := range defs {
+		order = append(order, def.Code)
+	}
+	assert.Equal(t, []string{
+		"mirea", "msu", "spbsu", "hse_msk", "hse_spb", "hse_nn", "hse_perm",
+		"spbstu", "rsmu", "rzgmu", "itmo", "fmsmu", "mephi", "mipt",
+	}, order)
+
+	for _, def := range defs {
+		if def.Code == "msu" {
+			assert.NotNil(t, def.IDResolution)
+			assert.Equal(t, time.Hour, def.LoadTimeout)
+			assert.IsType(t, &msu.HTTPHeadingSource{}, def.HeadingSources[0])
+		}
+		if def.Code == "spbstu" {
+			assert.NotNil(t, def.CapacityFeed)
+			assert.False(t, def.CapacityFeedTotals)
+		}
+		if def.Code == "mephi" {
+			assert.NotNil(t, def.CapacityFeed)
+			assert.True(t, def.CapacityFeedTotals)
+		}
+	}
+}
+
+func TestDecodeDefinition(t *testing.T) {
+	def, err := DecodeDefinition([]byte(`
+version: 1
+code: test
+order: 1
+name: Тест
+staged_enrollment: true
+metadata:
+  city: Москва
+load_timeout: 45m
+sources:
+  - type: itmo.http
+    url: https://abit.itmo.ru/rating/bachelor/budget/2190
+    pretty_name: Физика
+    capacities: {regular: 25, target_quota: 3}
+  - type: spbsu.http
+    pretty_name: Математика
+    regular_list_id: 101
+    target_quota_list_ids: [102, 103]
+    capacities:
+      regular: 10
+      target_quota: 4
+      target_sub_quotas: {103: 2}
+`))
+	require.NoError(t, err)
+
+	assert.Equal(t, "test", def.Code)
+	assert.Equal(t, "Тест", def.Name)
+	assert.True(t, def.StagedEnrollment)
+	assert.Equal(t, "Москва", def.Metadata.City)
+	assert.Equal(t, 45*time.Minute, def.LoadTimeout)
+	assert.Nil(t, def.IDResolution)
+
+	require.Len(t, def.HeadingSources, 2)
+	assert.Equal(t, &itmo.HTTPHeadingSource{
+		URL:        "https://abit.itmo.ru/rating/bachelor/budget/2190",
+		PrettyName: "Физика",
+		Capacities: core.Capacities{Regular: 25, TargetQuota: 3},
+	}, def.HeadingSources[0])
+	assert.Equal(t, &spbsu.HttpHeadingSource{
+		PrettyName:         "Математика",
+		RegularListID:      101,
+		TargetQuotaListIDs: []int{102, 103},
+		Capacities:         core.Capacities{Regular: 10, TargetQuota: 4, TargetSubQuotas: map[string]int{"103": 2}},
+	}, def.HeadingSources[1])
+}
+
+func TestDecodeDefinition_CapacityFeed(t *testing.T) {
+	def, err := DecodeDefinition([]byte(`
+version: 1
+code: test
+order: 1
+name: Тест
+capacity_feed:
+  type: mephi.kcp
+  url: https://example.org/kcp
+apply_published_capacities: true
+sources:
+  - type: mephi.http
+    heading_name: Физика
+`))
+	require.NoError(t, err)
+	assert.NotNil(t, def.CapacityFeed)
+	assert.True(t, def.CapacityFeedTotals)
+	assert.True(t, def.ApplyPublishedCapacities)
+
+	def, err = DecodeDefinition([]byte("version: 1\ncode: test\norder: 1\nname: Тест\nsources: [{type: itmo.http}]"))
+	require.NoError(t, err)
+	assert.Nil(t, def.CapacityFeed)
+	assert.False(t, def.ApplyPublishedCapacities)
+}
+
+func TestDecodeDefinition_CapacityDrifts(t *testing.T) {
+	const kcpURL = "https://example.org/kcp"
+
+	// The pages are served from an HTTP archive, as FlareSolverr isn't running
+	path := filepath.Join(t.TempDir(), "crawl.http.gz")
+	recording, err := httparchive.StartRecording(path)
+	require.NoError(t, err)
+	for url, body := range map[string]string{
+		kcpURL: `<table id="myTable"><tbody>
+			<tr class="header"><td>УГСН</td><td>Направления</td><td>Код</td><td>Оч</td><td>ОЗО</td></tr>
+			<tr><td>03.00.00</td><td>Физика</td><td>03.03.02</td><td>35</td><td></td></tr>
+			<tr><td>04.00.00</td><td>Химия</td><td>04.03.01</td><td>16</td><td></td></tr>
+		</tbody></table>`,
+		"https://example.org/lists/1": "Нет заявлений",
+		"https://example.org/lists/2": "Нет заявлений",
+	} {
+		recording.Record(&httparchive.Entry{Via: httparchive.ViaFlareSolverr, Method: http.MethodGet, URL: url, StatusCode: http.StatusOK, Body: []byte(body)})
+	}
+	require.NoError(t, recording.Close())
+
+	replaying, err := httparchive.StartReplaying(path)
+	require.NoError(t, err)
+	defer replaying.Close()
+
+	def, err := DecodeDefinition([]byte(`
+version: 1
+code: mephi
+order: 13
+name: МИФИ
+capacity_feed:
+  type: mephi.kcp
+  url: ` + kcpURL + `
+apply_published_capacities: true
+sources:
+  - type: mephi.http
+    heading_name: Физика
+    capacities: {regular: 20, target_quota: 3, dedicated_quota: 3, special_quota: 3}
+    regular_urls: [https://example.org/lists/1]
+  - type: mephi.http
+    heading_name: Химия
+    capacities: {regular: 10, target_quota: 2, dedicated_quota: 2, special_quota: 2}
+    regular_urls: [https://example.org/lists/2]
+`))
+	require.NoError(t, err)
+
+	varsities := source.LoadFromDefinitions(context.Background(), []source.VarsityDefinition{def})
+	require.Len(t, varsities, 1)
+	v := varsities[0]
+
+	drifts := make(map[string]core.CapacityDrift)
+	for _, drift := range v.CapacityDrifts {
+		drifts[drift.Name] = drift
+	}
+	require.Len(t, drifts, 2)
+
+	// Only the total is published: it drifted, and the registry quotas are kept applying it
+	physics := drifts["Физика"]
+	assert.True(t, physics.TotalOnly)
+	assert.True(t, physics.Drifted())
+	assert.True(t, physics.Applied)
+	assert.Equal(t, core.Capacities{Regular: 35}, physics.Published)
+	assert.NotEmpty(t, physics.HeadingCode)
+
+	chemistry := drifts["Химия"]
+	assert.False(t, chemistry.Drifted())
+	assert.False(t, chemistry.Applied)
+
+	capacities := make(map[string]core.Capacities)
+	for _, hd := range v.HeadingsCache {
+		capacities[hd.PrettyName] = hd.Capacities
+	}
+	assert.Equal(t, core.Capacities{Regular: 26, TargetQuota: 3, DedicatedQuota: 3, SpecialQuota: 3}, capacities["Физика"])
+	assert.Equal(t, core.Capacities{Regular: 10, TargetQuota: 2, DedicatedQuota: 2, SpecialQuota: 2}, capacities["Химия"])
+}
+
+func TestDecodeDefinition_Invalid(t *testing.T) {
+	const header = "version: 1\ncode: test\norder: 1\nname: Тест\n"
+
+	tests := []struct {
+		name string
+		data string
+		err  string
+	}{
+		{"unsupported version", "version: 2\ncode: test\norder: 1\nname: Тест\nsources: [{type: itmo.http}]", "unsupported version 2"},
+		{"invalid code", "version: 1\ncode: Test\norder: 1\nname: Тест\nsources: [{type: itmo.http}]", `invalid varsity code "Test"`},
+		{"missing order", "version: 1\ncode: test\nname: Тест\nsources: [{type: itmo.http}]", "order: expected a positive number"},
+		{"unknown varsity field", header + "capacity: 5\nsources: [{type: itmo.http}]", `unknown field "capacity"`},
+		{"no sources", header, "sources: expected a non-empty list"},
+		{"missing type", header + "sources: [{url: x}]", "sources[0]: missing type"},
+		{"unknown type", header + "sources: [{type: nope.http}]", `sources[0]: unknown heading source type "nope.http"`},
+		{"unknown source field", header + "sources: [{type: itmo.http, urls: [x]}]", `sources[0]: unknown field "urls"`},
+		{"wrong value type", header + "sources: [{type: itmo.http, capacities: {regular: many}}]", "sources[0].capacities.regular: expected an integer"},
+		{"negative capacity", header + "sources: [{type: itmo.http, capacities: {regular: -1}}]", "sources[0].capacities: negative capacity"},
+		{"unknown ID resolution", header + "id_resolution: nope\nsources: [{type: itmo.http}]", `id_resolution: unknown ID resolution "nope"`},
+		{"invalid duration", header + "load_timeout: soon\nsources: [{type: itmo.http}]", "load_timeout:"},
+		{"missing capacity feed type", header + "capacity_feed: {url: x}\nsources: [{type: itmo.http}]", "capacity_feed: missing type"},
+		{"unknown capacity feed", header + "capacity_feed: {type: nope}\nsources: [{type: itmo.http}]", `capacity_feed: unknown capacity feed type "nope"`},
+		{"unknown capacity feed field", header + "capacity_feed: {type: mephi.kcp, urls: [x]}\nsources: [{type: itmo.http}]", `capacity_feed: unknown field "urls"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := DecodeDefinition([]byte(tt.data))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
+
+func TestLoadDefinitions(t *testing.T) {
+	fsys := fstest.MapFS{
+		"a.yaml":    {Data: []byte("version: 1\ncode: a\norder: 2\nname: A\nsources: [{type: itmo.http}]")},
+		"b.yaml":    {Data: []byte("version: 1\ncode: b\norder: 1\nname: B\nsources: [{type: itmo.http}]")},
+		"notes.txt": {Data: []byte("not a definition")},
+	}
+	defs, err := LoadDefinitions(fsys)
+	require.NoError(t, err)
+	require.Len(t, defs, 2)
+	assert.Equal(t, "b", defs[0].Code)
+	assert.Equal(t, "a", defs[1].Code)
+
+	// The errors of all files are reported at once
+	fsys["c.yaml"] = &fstest.MapFile{Data: []byte("version: 1\ncode: d\norder: 3\nname: D\nsources: [{type: itmo.http}]")}
+	fsys["e.yaml"] = &fstest.MapFile{Data: []byte("version: 1\ncode: e\norder: 4\nname: E\nsources: []")}
+	fsys["f.yaml"] = &fstest.MapFile{Data: []byte("version: 1\ncode: f\norder: 1\nname: F\nsources: [{type: itmo.http}]")}
+	_, err = LoadDefinitions(fsys)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "c.yaml: the definition of varsity d must be named d.yaml")
+	assert.Contains(t, err.Error(), "e.yaml: sources")
+	assert.Contains(t, err.Error(), "f.yaml: order 1 is taken by b.yaml")
+}
+
+func TestSnakeCase(t *testing.T) {
+	for name, want := range map[string]string{
+		"PrettyName":         "pretty_name",
+		"URL":                "url",
+		"RegularURLs":        "regular_urls",
+		"TargetQuotaListIDs": "target_quota_list_ids",
+		"RegularBVIListURL":  "regular_bvi_list_url",
+		"BVIAnchor":          "bvi_anchor",
+		"IDResolution":       "id_resolution",
+	} {
+		assert.Equal(t, want, snakeCase(name), name)
+	}
+}
@@ -1,34 +1,38 @@
 package registry
 
 import (
-	"github.com/trueegorletov/analabit/core/registry/fmsmu"
-	"github.com/trueegorletov/analabit/core/registry/hse"
-	"github.com/trueegorletov/analabit/core/registry/itmo"
-	"github.com/trueegorletov/analabit/core/registry/mephi"
-	"github.com/trueegorletov/analabit/core/registry/mipt"
-	"github.com/trueegorletov/analabit/core/registry/mirea"
-	"github.com/trueegorletov/analabit/core/registry/msu"
-	"github.com/trueegorletov/analabit/core/registry/rsmu"
-	"github.com/trueegorletov/analabit/core/registry/rzgmu"
-	"github.com/trueegorletov/analabit/core/registry/spbstu"
-	"github.com/trueegorletov/analabit/core/registry/spbsu"
+	"log"
+	"os"
+
 	"github.com/trueegorletov/analabit/core/source"
+
+	// The heading source types and ID resolutions the definition files refer to
+	_ "github.com/trueegorletov/analabit/core/source/fmsmu"
+	_ "github.com/trueegorletov/analabit/core/source/hse"
+	_ "github.com/trueegorletov/analabit/core/source/itmo"
+	_ "github.com/trueegorletov/analabit/core/source/mephi"
+	_ "github.com/trueegorletov/analabit/core/source/mipt"
+	_ "github.com/trueegorletov/analabit/core/source/mirea"
+	_ "github.com/trueegorletov/analabit/core/source/msu"
+	_ "github.com/trueegorletov/analabit/core/source/rsmu"
+	_ "github.com/trueegorletov/analabit/core/source/rzgmu"
+	_ "github.com/trueegorletov/analabit/core/source/spbstu"
+	_ "github.com/trueegorletov/analabit/core/source/spbsu"
 )
 
-var AllDefinitions = []source.VarsityDefinition{
-	mirea.Varsity,
-	msu.Varsity,
-	spbsu.Varsity,
-	hse.VarsityMsk,
-	hse.VarsitySpb,
-	hse.VarsityNn,
-	hse.VarsityPerm,
+// AllDefinitions are the definitions of all varsities, loaded on startup from the definition files of the
+// directory named by DefinitionsDirEnv if set, from the embedded ones otherwise.
+var AllDefinitions = mustLoadDefinitions()
+
+func mustLoadDefinitions() []source.VarsityDefinition {
+	fsys := EmbeddedDefinitions()
+	if dir := os.Getenv(DefinitionsDirEnv); dir != "" {
+		fsys = os.DirFS(dir)
+	}
 
-	spbstu.Varsity,
-	rsmu.Varsity,
-	rzgmu.Varsity,
-	itmo.Varsity,
-	fmsmu.Varsity,
-	mephi.Varsity,
-	mipt.Varsity,
+	defs, err := LoadDefinitions(fsys)
+	if err != nil {
+		log.Fatalf("failed to load varsity definitions: %v", err)
+	}
+	return defs
 }
@@ -0,0 +1,195 @@
+package registry
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/trueegorletov/analabit/core/source"
+)
+
+// EncodeSources encodes heading sources into the "sources" list of a definition file, the inverse of their
+// decoding. Fields with zero values are left out; capacities and lists of numbers are written in flow style.
+func EncodeSources(sources []source.HeadingSource) (*yaml.Node, error) {
+	list := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+	for i, hs := range sources {
+		path := fmt.Sprintf("sources[%d]", i)
+
+		typeName, ok := source.HeadingSourceType(hs)
+		if !ok {
+			return nil, fmt.Errorf("%s: heading source type %T is not registered", path, hs)
+		}
+		node, err := encodeValue(path, reflect.ValueOf(hs).Elem())
+		if err != nil {
+			return nil, err
+		}
+		if node == nil {
+			node = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		}
+		node.Content = append([]*yaml.Node{plainScalar("type"), plainScalar(typeName)}, node.Content...)
+		list.Content = append(list.Content, node)
+	}
+	return list, nil
+}
+
+// ReplaceSources returns the definition file data with its heading sources replaced by sources. Everything
+// else, comments included, is kept as it is.
+func ReplaceSources(data []byte, sources []source.HeadingSource) ([]byte, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("expected a mapping")
+	}
+
+	list, err := EncodeSources(sources)
+	if err != nil {
+		return nil, err
+	}
+
+	root := doc.Content[0]
+	replaced := false
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		if root.Content[i].Value == "sources" {
+			root.Content[i+1] = list
+			replaced = true
+		}
+	}
+	if !replaced {
+		root.Content = append(root.Content, plainScalar("sources"), list)
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// encodeValue encodes v the way decodeValue decodes it, nil if v is zero.
+func encodeValue(path string, v reflect.Value) (*yaml.Node, error) {
+	if v.IsZero() {
+		return nil, nil
+	}
+
+	if v.Type() == durationType {
+		return taggedScalar("!!str", v.Interface().(fmt.Stringer).String()), nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return taggedScalar("!!str", v.String()), nil
+	case reflect.Bool:
+		return taggedScalar("!!bool", strconv.FormatBool(v.Bool())), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return taggedScalar("!!int", strconv.FormatInt(v.Int(), 10)), nil
+	case reflect.Float32, reflect.Float64:
+		return taggedScalar("!!float", strconv.FormatFloat(v.Float(), 'g', -1, 64)), nil
+	case reflect.Slice:
+		if v.Len() == 0 {
+			return nil, nil
+		}
+		node := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+		if kind := v.Type().Elem().Kind(); kind >= reflect.Int && kind <= reflect.Float64 {
+			node.Style = yaml.FlowStyle
+		}
+		for i := 0; i < v.Len(); i++ {
+			item, err := encodeValue(fmt.Sprintf("%s[%d]", path, i), v.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			if item == nil {
+				// Zero items can't be left out of lists
+				item = zeroNode(v.Index(i))
+			}
+			node.Content = append(node.Content, item)
+		}
+		return node, nil
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("%s: maps keyed by %s can't be encoded", path, v.Type().Key())
+		}
+		if v.Len() == 0 {
+			return nil, nil
+		}
+		node := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		entries := make(map[string]any, v.Len())
+		for _, key := range v.MapKeys() {
+			entries[key.String()] = nil
+		}
+		for _, key := range sortedKeys(entries) {
+			elem := v.MapIndex(reflect.ValueOf(key).Convert(v.Type().Key()))
+			value, err := encodeValue(joinPath(path, key), elem)
+			if err != nil {
+				return nil, err
+			}
+			if value == nil {
+				value = zeroNode(elem)
+			}
+			node.Content = append(node.Content, plainScalar(key), value)
+		}
+		return node, nil
+	case reflect.Struct:
+		node := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		if v.Type() == capacitiesType {
+			node.Style = yaml.FlowStyle
+		}
+		for i := 0; i < v.NumField(); i++ {
+			key := fieldKey(v.Type().Field(i))
+			if key == "" {
+				continue
+			}
+			value, err := encodeValue(joinPath(path, key), v.Field(i))
+			if err != nil {
+				return nil, err
+			}
+			if value != nil {
+				node.Content = append(node.Content, plainScalar(key), value)
+			}
+		}
+		return node, nil
+	case reflect.Pointer:
+		node, err := encodeValue(path, v.Elem())
+		if err != nil || node != nil {
+			return node, err
+		}
+		// A pointer to a zero value differs from a nil one
+		return zeroNode(v.Elem()), nil
+	default:
+		return nil, fmt.Errorf("%s: fields of type %s can't be set in definition files", path, v.Type())
+	}
+}
+
+// zeroNode returns the node of the zero value of v's type.
+func zeroNode(v reflect.Value) *yaml.Node {
+	switch v.Kind() {
+	case reflect.String:
+		return taggedScalar("!!str", "")
+	case reflect.Bool:
+		return taggedScalar("!!bool", "false")
+	case reflect.Slice:
+		return &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq", Style: yaml.FlowStyle}
+	case reflect.Map, reflect.Struct:
+		return &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map", Style: yaml.FlowStyle}
+	default:
+		return taggedScalar("", "0")
+	}
+}
+
+func plainScalar(value string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Value: value}
+}
+
+// taggedScalar returns a scalar the encoder quotes if its value would otherwise be read as another type.
+func taggedScalar(tag, value string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: tag, Value: value}
+}
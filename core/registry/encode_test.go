@@ -0,0 +1,82 @@
+package registry
+
+import (
+	"context"
+	"io/fs"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/source"
+	"github.com/trueegorletov/analabit/core/source/mirea"
+	"github.com/trueegorletov/analabit/core/source/spbsu"
+)
+
+func TestReplaceSources_RoundTrip(t *testing.T) {
+	fsys := EmbeddedDefinitions()
+	names, err := fs.Glob(fsys, "*.yaml")
+	require.NoError(t, err)
+
+	for _, name := range names {
+		data, err := fs.ReadFile(fsys, name)
+		require.NoError(t, err)
+		def, err := DecodeDefinition(data)
+		require.NoError(t, err)
+
+		encoded, err := ReplaceSources(data, def.HeadingSources)
+		require.NoError(t, err, name)
+		assert.Equal(t, string(data), string(encoded), name)
+	}
+}
+
+func TestEncodeSources(t *testing.T) {
+	sources := []source.HeadingSource{
+		&spbsu.HttpHeadingSource{
+			PrettyName:           "Математика",
+			RegularListID:        101,
+			TargetQuotaListIDs:   []int{102, 103},
+			DedicatedQuotaListID: -1,
+			Capacities:           core.Capacities{Regular: 10, TargetQuota: 4, TargetSubQuotas: map[string]int{"103": 2}},
+		},
+		&mirea.HTTPHeadingSource{
+			PrettyName:     "Физика",
+			RegularListIDs: []string{"1829098830464818486"},
+			Metadata:       core.HeadingMetadata{DirectionCode: "03.03.02"},
+		},
+	}
+
+	list, err := EncodeSources(sources)
+	require.NoError(t, err)
+	data, err := yaml.Marshal(map[string]any{"sources": list})
+	require.NoError(t, err)
+	assert.Equal(t, `sources:
+    - type: spbsu.http
+      pretty_name: Математика
+      regular_list_id: 101
+      target_quota_list_ids: [102, 103]
+      dedicated_quota_list_id: -1
+      capacities: {regular: 10, target_quota: 4, target_sub_quotas: {103: 2}}
+    - type: mirea.http
+      pretty_name: Физика
+      regular_list_ids:
+        - "1829098830464818486"
+      metadata:
+        direction_code: 03.03.02
+`, string(data))
+
+	def, err := DecodeDefinition(append([]byte("version: 1\ncode: test\norder: 1\nname: Тест\n"), data...))
+	require.NoError(t, err)
+	assert.Equal(t, sources, def.HeadingSources)
+}
+
+func TestEncodeSources_Unregistered(t *testing.T) {
+	_, err := EncodeSources([]source.HeadingSource{&unregisteredSource{}})
+	assert.ErrorContains(t, err, "sources[0]: heading source type *registry.unregisteredSource is not registered")
+}
+
+type unregisteredSource struct{}
+
+func (*unregisteredSource) LoadTo(context.Context, source.DataReceiver) error { return nil }
@@ -1,6 +1,7 @@
 package oldhse
 
 import (
+	"github.com/trueegorletov/analabit/core"
 	"github.com/trueegorletov/analabit/core/source"
 )
 
@@ -8,20 +9,44 @@ var VarsitySpb = source.VarsityDefinition{
 	Code:           spbCode,
 	Name:           spbName,
 	HeadingSources: spbSourcesList(),
+	Metadata: core.VarsityMetadata{
+		City:   "Санкт-Петербург",
+		Region: "Санкт-Петербург",
+		Parent: "hse",
+		Site:   "https://spb.hse.ru",
+	},
 }
 
 var VarsityMsk = source.VarsityDefinition{
 	Code:           mskCode,
 	Name:           mskName,
 	HeadingSources: mskSourcesList(),
+	Metadata: core.VarsityMetadata{
+		City:   "Москва",
+		Region: "Москва",
+		Parent: "hse",
+		Site:   "https://www.hse.ru",
+	},
 }
 var VarsityNn = source.VarsityDefinition{
 	Code:           nnCode,
 	Name:           nnName,
 	HeadingSources: nnSourcesList(),
+	Metadata: core.VarsityMetadata{
+		City:   "Нижний Новгород",
+		Region: "Нижегородская область",
+		Parent: "hse",
+		Site:   "https://nnov.hse.ru",
+	},
 }
 var VarsityPerm = source.VarsityDefinition{
 	Code:           permCode,
 	Name:           permName,
 	HeadingSources: permSourcesList(),
+	Metadata: core.VarsityMetadata{
+		City:   "Пермь",
+		Region: "Пермский край",
+		Parent: "hse",
+		Site:   "https://perm.hse.ru",
+	},
 }
@@ -0,0 +1,140 @@
+package core
+
+import (
+	"log/slog"
+	"slices"
+)
+
+// VarsitySnapshot is a read-only, index-based view of a loaded varsity calculator: its headings and students are
+// frozen in fixed order and shared by every SnapshotOverlay made from it. Building a snapshot is cheap compared to
+// reloading the varsity, so drain simulations make one snapshot and run every iteration on an overlay of it.
+//
+// The calculator must not be changed after the snapshot is made: students, applications and headings are shared,
+// not copied.
+type VarsitySnapshot struct {
+	stagedEnrollment bool
+
+	headings []*Heading
+	// students sorted by ID; a student's index in this slice identifies them in the overlays
+	students []*Student
+	indexOf  map[*Student]int
+	// quit flags of the students at the time the snapshot was made, by student index
+	quit []bool
+	// Students who may be drained: neither quit nor submitted their original, sorted by ID
+	drainable []*Student
+}
+
+// Snapshot freezes the current state of the calculator into a VarsitySnapshot.
+func (v *VarsityCalculator) Snapshot() *VarsitySnapshot {
+	v.checkNotWasted()
+
+	students := v.Students()
+	s := &VarsitySnapshot{
+		stagedEnrollment: v.stagedEnrollment,
+		headings:         v.Headings(),
+		students:         students,
+		indexOf:          make(map[*Student]int, len(students)),
+		quit:             make([]bool, len(students)),
+	}
+
+	for i, student := range students {
+		s.indexOf[student] = i
+		s.quit[i] = student.Quit()
+		if !s.quit[i] && !student.OriginalSubmitted() {
+			s.drainable = append(s.drainable, student)
+		}
+	}
+
+	return s
+}
+
+// Headings returns the headings of the snapshot, in the same order as VarsityCalculator.Headings.
+func (s *VarsitySnapshot) Headings() []*Heading {
+	return s.headings
+}
+
+// NewOverlay returns a fresh overlay of the snapshot in which no more students have quit than in the snapshot.
+func (s *VarsitySnapshot) NewOverlay() *SnapshotOverlay {
+	return &SnapshotOverlay{
+		snapshot: s,
+		quit:     slices.Clone(s.quit),
+	}
+}
+
+// DrainTarget is a set of students that drain simulations can make quit: a VarsityCalculator or a SnapshotOverlay.
+type DrainTarget interface {
+	SimulateOriginalsDrain(drainPercent int, seed int64)
+	SimulateWeightedOriginalsDrain(drainPercent int, seed int64, weight func(*Student) float64)
+}
+
+var (
+	_ DrainTarget = (*VarsityCalculator)(nil)
+	_ DrainTarget = (*SnapshotOverlay)(nil)
+)
+
+// SnapshotOverlay is a single drain iteration over a VarsitySnapshot: it holds its own quit flags only, so
+// any number of overlays of the same snapshot can be drained and calculated concurrently.
+type SnapshotOverlay struct {
+	snapshot       *VarsitySnapshot
+	quit           []bool
+	drainedPercent int
+}
+
+// SimulateOriginalsDrain works like VarsityCalculator.SimulateOriginalsDrain, drains the same students for the same
+// seed, but only changes the overlay.
+func (o *SnapshotOverlay) SimulateOriginalsDrain(drainPercent int, seed int64) {
+	o.simulateDrain(drainPercent, seed, nil)
+}
+
+// SimulateWeightedOriginalsDrain works like VarsityCalculator.SimulateWeightedOriginalsDrain, drains the same
+// students for the same seed and weights, but only changes the overlay.
+func (o *SnapshotOverlay) SimulateWeightedOriginalsDrain(drainPercent int, seed int64, weight func(*Student) float64) {
+	o.simulateDrain(drainPercent, seed, weight)
+}
+
+func (o *SnapshotOverlay) simulateDrain(drainPercent int, seed int64, weight func(*Student) float64) {
+	if drainPercent == 0 {
+		return
+	}
+
+	if drainPercent < 0 || drainPercent > 100 {
+		slog.Warn("Invalid value, must be in [0, 100]", "drainPercent", drainPercent)
+		return
+	}
+
+	if o.drainedPercent != 0 {
+		slog.Warn("Already drained students, cannot drain again", "drainedPercent", o.drainedPercent)
+		return
+	}
+
+	if len(o.snapshot.drainable) == 0 {
+		return
+	}
+
+	// pickDrained reorders the students it picks from, and the snapshot's list is shared
+	drainableStudents := slices.Clone(o.snapshot.drainable)
+	for _, student := range pickDrained(drainableStudents, drainPercent, seed, weight) {
+		o.quit[o.snapshot.indexOf[student]] = true
+	}
+
+	o.drainedPercent = drainPercent
+}
+
+// DrainedPercent returns the percent of drainable students the overlay was drained by.
+func (o *SnapshotOverlay) DrainedPercent() int {
+	return o.drainedPercent
+}
+
+// CalculateAdmissions calculates the admissions of the snapshot's varsity as if the students quit in the overlay
+// had quit the varsity. It gives the same results as VarsityCalculator.CalculateAdmissions on a copy of the
+// calculator with the same students quit, and may be called any number of times.
+func (o *SnapshotOverlay) CalculateAdmissions() []CalculationResult {
+	activeStudents := make([]*Student, 0, len(o.snapshot.students))
+	for i, student := range o.snapshot.students {
+		if !o.quit[i] {
+			activeStudents = append(activeStudents, student)
+		}
+	}
+
+	return calculateAdmissions(o.snapshot.headings, activeStudents, o.snapshot.stagedEnrollment, nil)
+}
@@ -0,0 +1,76 @@
+package core
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// admittedByHeadingCode returns the IDs of the budget and paid admitted students of every heading (heading code -> IDs).
+func admittedByHeadingCode(results []CalculationResult) map[string][]string {
+	ids := make(map[string][]string, len(results))
+	for _, r := range results {
+		for _, s := range r.Admitted {
+			ids[r.Heading.Code()] = append(ids[r.Heading.Code()], s.ID())
+		}
+		for _, s := range r.PaidAdmitted {
+			ids[r.Heading.Code()+"/paid"] = append(ids[r.Heading.Code()+"/paid"], s.ID())
+		}
+	}
+	return ids
+}
+
+func TestSnapshotOverlay_MatchesCalculator(t *testing.T) {
+	weight := func(s *Student) float64 {
+		return float64(len(s.Applications()))
+	}
+
+	for seed := int64(1); seed <= 100; seed++ {
+		for _, staged := range []bool{false, true} {
+			for _, weighted := range []bool{false, true} {
+				r := rand.New(rand.NewSource(seed))
+				vc := randomVarsity(r, "V", randomStudentIDs(r, 1+r.Intn(40)))
+				vc.SetStagedEnrollment(staged)
+
+				// The overlay is calculated first: draining the calculator changes the students the snapshot shares
+				overlay := vc.Snapshot().NewOverlay()
+				if weighted {
+					overlay.SimulateWeightedOriginalsDrain(50, seed, weight)
+				} else {
+					overlay.SimulateOriginalsDrain(50, seed)
+				}
+				overlayResults := overlay.CalculateAdmissions()
+
+				if weighted {
+					vc.SimulateWeightedOriginalsDrain(50, seed, weight)
+				} else {
+					vc.SimulateOriginalsDrain(50, seed)
+				}
+
+				assert.Equal(t, admittedByHeadingCode(vc.CalculateAdmissions()), admittedByHeadingCode(overlayResults),
+					"seed %d, staged %v, weighted %v", seed, staged, weighted)
+			}
+		}
+	}
+}
+
+func TestSnapshotOverlay_DoesNotChangeSnapshot(t *testing.T) {
+	v := NewVarsityCalculator("TEST_VARSITY", "")
+	v.AddHeading("H1", Capacities{Regular: 1}, "Heading 1")
+
+	v.AddApplication("H1", sid(1), 1, 1, CompetitionRegular, 250)
+	v.AddApplication("H1", sid(2), 2, 1, CompetitionRegular, 240)
+
+	snapshot := v.Snapshot()
+
+	drained := snapshot.NewOverlay()
+	drained.SimulateOriginalsDrain(100, 1)
+	assert.Empty(t, getAdmittedStudentIDs(drained.CalculateAdmissions(), "H1"))
+	assert.Equal(t, 100, drained.DrainedPercent())
+
+	// Neither the calculator's students nor fresh overlays see the drain
+	assert.False(t, v.GetStudent(sid(1)).Quit())
+	assert.Equal(t, []string{"0000000000001"}, getAdmittedStudentIDs(snapshot.NewOverlay().CalculateAdmissions(), "H1"))
+	assert.Equal(t, []string{"0000000000001"}, getAdmittedStudentIDs(v.CalculateAdmissions(), "H1"))
+}
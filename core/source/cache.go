@@ -3,12 +3,18 @@ package source
 import (
 	"encoding/gob"
 	"io"
+
+	"github.com/trueegorletov/analabit/core"
 )
 
 type VarsityDataCache struct {
 	Definition        *VarsityDefinition
 	HeadingsCache     []*HeadingData
 	ApplicationsCache []*ApplicationData
+	// SourceReports tell how each heading source was loaded when the data was cached
+	SourceReports []core.SourceReport
+	// CapacityDrifts compare the published capacities with the registry ones when the data was cached
+	CapacityDrifts []core.CapacityDrift
 }
 
 func NewVarsityDataCache(definition *VarsityDefinition) *VarsityDataCache {
@@ -36,6 +42,8 @@ func (c *VarsityDataCache) SaveApplicationData(application *ApplicationData) {
 func (c *VarsityDataCache) Reset() {
 	c.HeadingsCache = nil
 	c.ApplicationsCache = nil
+	c.SourceReports = nil
+	c.CapacityDrifts = nil
 }
 
 func SerializeList(caches []*VarsityDataCache, w io.Writer) error {
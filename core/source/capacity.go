@@ -0,0 +1,147 @@
+package source
+
+import (
+	"context"
+	"log/slog"
+	"reflect"
+	"slices"
+
+	"github.com/trueegorletov/analabit/core"
+)
+
+// CapacityFeed fetches the capacities a varsity currently publishes for its headings. The registry holds
+// the capacities as of its last update, while varsities revise their control numbers and quota splits
+// during the admission campaign.
+type CapacityFeed interface {
+	// FetchCapacities returns the published capacities of the given heading sources of the varsity by
+	// their indexes. The headings the varsity doesn't publish or whose capacities couldn't be fetched are
+	// missing from the result; an error means that the feed failed as a whole.
+	FetchCapacities(ctx context.Context, sources []HeadingSource) (map[int]core.Capacities, error)
+}
+
+// TotalCapacityFeed is a CapacityFeed of a varsity which only publishes the total capacities of its
+// headings. FetchCapacities returns them as the Regular ones, and only they are compared with the registry.
+type TotalCapacityFeed interface {
+	CapacityFeed
+	PublishesTotals() bool
+}
+
+// CapacityFeedFunc is the FetchCapacities method of the CapacityFeed of a varsity. Being a func, it is
+// skipped when the VarsityDefinition is serialized, like IDResolution.
+type CapacityFeedFunc func(ctx context.Context, sources []HeadingSource) (map[int]core.Capacities, error)
+
+// checkCapacities fetches the capacities the varsity publishes and compares them with the ones of its
+// heading sources. It returns the sources to load, the drifts of the published headings and the indexes
+// of their sources. The published capacities are loaded instead of the registry ones for the headings
+// without any in the registry, as the only ones known, and for the drifted ones if ApplyPublishedCapacities
+// is set; the sources they apply to are replaced by copies.
+func (v *Varsity) checkCapacities(ctx context.Context) ([]HeadingSource, []core.CapacityDrift, []int) {
+	if v.CapacityFeed == nil {
+		return v.HeadingSources, nil, nil
+	}
+
+	published, err := v.CapacityFeed(ctx, v.HeadingSources)
+	if err != nil {
+		slog.Error("Failed to fetch the published capacities, loading the registry ones", "varsity", v.Code, "error", err)
+		return v.HeadingSources, nil, nil
+	}
+
+	sources := slices.Clone(v.HeadingSources)
+	var drifts []core.CapacityDrift
+	var driftSources []int
+	for i, hs := range sources {
+		capacities, ok := published[i]
+		if !ok {
+			continue
+		}
+		registry, ok := sourceCapacities(hs)
+		if !ok {
+			continue
+		}
+
+		drift := core.CapacityDrift{
+			Name:      describeSource(hs).Name,
+			Registry:  registry,
+			Published: capacities,
+			TotalOnly: v.CapacityFeedTotals,
+		}
+		if capacities.Total() > 0 && (registry.Total() == 0 || (drift.Drifted() && v.ApplyPublishedCapacities)) {
+			if drift.TotalOnly {
+				sources[i] = withCapacities(hs, appliedTotal(registry, capacities.Total()))
+			} else {
+				sources[i] = withCapacities(hs, appliedCapacities(registry, capacities))
+			}
+			drift.Applied = true
+		}
+		if drift.Drifted() {
+			slog.Warn("Published capacities drifted from the registry", "varsity", v.Code, "heading", drift.Name,
+				"registry", registry, "published", capacities, "applied", drift.Applied)
+		}
+
+		drifts = append(drifts, drift)
+		driftSources = append(driftSources, i)
+	}
+	return sources, drifts, driftSources
+}
+
+// appliedCapacities returns the capacities a heading loads with once the published ones are applied. The
+// paid places and, unless the target quota changed, its detailed split are kept from the registry if the
+// varsity doesn't publish them.
+func appliedCapacities(registry, published core.Capacities) core.Capacities {
+	applied := published
+	if applied.Paid == 0 {
+		applied.Paid = registry.Paid
+	}
+	if len(applied.TargetSubQuotas) == 0 && applied.TargetQuota == registry.TargetQuota {
+		applied.TargetSubQuotas = registry.TargetSubQuotas
+	}
+	return applied
+}
+
+// appliedTotal returns the capacities a heading loads with once the published total is applied: the quotas
+// are kept from the registry, and the regular places take up the difference.
+func appliedTotal(registry core.Capacities, total int) core.Capacities {
+	applied := registry
+	applied.Regular = max(total-registry.TargetQuota-registry.DedicatedQuota-registry.SpecialQuota, 0)
+	return applied
+}
+
+var capacitiesType = reflect.TypeOf(core.Capacities{})
+
+// capacitiesField returns the capacities field of the struct a heading source points to, an invalid value
+// if it has none.
+func capacitiesField(hs HeadingSource) reflect.Value {
+	v := reflect.ValueOf(hs)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}
+	}
+	v = v.Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if v.Type().Field(i).IsExported() && v.Field(i).Type() == capacitiesType {
+			return v.Field(i)
+		}
+	}
+	return reflect.Value{}
+}
+
+// sourceCapacities returns the capacities a heading source is defined with, false if it has no such field.
+func sourceCapacities(hs HeadingSource) (core.Capacities, bool) {
+	f := capacitiesField(hs)
+	if !f.IsValid() {
+		return core.Capacities{}, false
+	}
+	return f.Interface().(core.Capacities), true
+}
+
+// withCapacities returns a copy of the heading source with its capacities set, leaving hs as is.
+func withCapacities(hs HeadingSource, capacities core.Capacities) HeadingSource {
+	if !capacitiesField(hs).IsValid() {
+		return hs
+	}
+	copied := reflect.New(reflect.TypeOf(hs).Elem())
+	copied.Elem().Set(reflect.ValueOf(hs).Elem())
+
+	copiedSource := copied.Interface().(HeadingSource)
+	capacitiesField(copiedSource).Set(reflect.ValueOf(capacities))
+	return copiedSource
+}
@@ -0,0 +1,122 @@
+package source
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/utils"
+)
+
+type capacitiesSource struct {
+	PrettyName string
+	Capacities core.Capacities
+}
+
+func (s *capacitiesSource) LoadTo(_ context.Context, receiver DataReceiver) error {
+	receiver.PutHeadingData(&HeadingData{Code: utils.GenerateHeadingCode(s.PrettyName), PrettyName: s.PrettyName, Capacities: s.Capacities})
+	return nil
+}
+
+func publishing(capacities map[int]core.Capacities, err error) CapacityFeedFunc {
+	return func(context.Context, []HeadingSource) (map[int]core.Capacities, error) {
+		return capacities, err
+	}
+}
+
+func TestCheckCapacities(t *testing.T) {
+	physics := &capacitiesSource{PrettyName: "Физика", Capacities: core.Capacities{Regular: 20, TargetQuota: 2, Paid: 10}}
+	chemistry := &capacitiesSource{PrettyName: "Химия", Capacities: core.Capacities{Regular: 15}}
+	biology := &capacitiesSource{PrettyName: "Биология"}
+	history := &capacitiesSource{PrettyName: "История", Capacities: core.Capacities{Regular: 5}}
+
+	v := &Varsity{VarsityDefinition: &VarsityDefinition{
+		Code:           "test",
+		HeadingSources: []HeadingSource{physics, chemistry, biology, history},
+		CapacityFeed: publishing(map[int]core.Capacities{
+			0: {Regular: 18, TargetQuota: 4},
+			1: {Regular: 15},
+			2: {Regular: 30},
+		}, nil),
+	}}
+
+	sources, drifts, driftSources := v.checkCapacities(context.Background())
+	assert.Equal(t, []int{0, 1, 2}, driftSources)
+	require.Len(t, drifts, 3)
+
+	// Drifted, but applying the published capacities is off
+	assert.True(t, drifts[0].Drifted())
+	assert.False(t, drifts[0].Applied)
+	assert.Equal(t, "Физика", drifts[0].Name)
+	assert.Same(t, physics, sources[0])
+	assert.False(t, drifts[1].Drifted())
+	assert.Same(t, chemistry, sources[1])
+	// The published capacities are the only ones known
+	assert.False(t, drifts[2].Drifted())
+	assert.True(t, drifts[2].Applied)
+	assert.Equal(t, core.Capacities{Regular: 30}, sources[2].(*capacitiesSource).Capacities)
+	assert.Same(t, history, sources[3])
+
+	v.ApplyPublishedCapacities = true
+	sources, drifts, _ = v.checkCapacities(context.Background())
+	assert.True(t, drifts[0].Applied)
+	assert.Equal(t, core.Capacities{Regular: 18, TargetQuota: 4, Paid: 10}, sources[0].(*capacitiesSource).Capacities)
+	// The registry sources are left as is
+	assert.Equal(t, core.Capacities{Regular: 20, TargetQuota: 2, Paid: 10}, physics.Capacities)
+	assert.Equal(t, core.Capacities{}, biology.Capacities)
+
+	v.CapacityFeed = publishing(nil, errors.New("unavailable"))
+	sources, drifts, _ = v.checkCapacities(context.Background())
+	assert.Equal(t, v.HeadingSources, sources)
+	assert.Empty(t, drifts)
+}
+
+func TestCheckCapacities_Totals(t *testing.T) {
+	physics := &capacitiesSource{PrettyName: "Физика", Capacities: core.Capacities{Regular: 20, TargetQuota: 3, DedicatedQuota: 3, SpecialQuota: 3}}
+	v := &Varsity{VarsityDefinition: &VarsityDefinition{
+		Code:                     "test",
+		HeadingSources:           []HeadingSource{physics, &capacitiesSource{PrettyName: "Химия"}},
+		CapacityFeed:             publishing(map[int]core.Capacities{0: {Regular: 24}, 1: {Regular: 12}}, nil),
+		CapacityFeedTotals:       true,
+		ApplyPublishedCapacities: true,
+	}}
+
+	sources, drifts, _ := v.checkCapacities(context.Background())
+	require.Len(t, drifts, 2)
+	assert.True(t, drifts[0].TotalOnly)
+	assert.True(t, drifts[0].Drifted())
+	// The registry quotas are kept, the regular places take up the difference
+	assert.Equal(t, core.Capacities{Regular: 15, TargetQuota: 3, DedicatedQuota: 3, SpecialQuota: 3}, sources[0].(*capacitiesSource).Capacities)
+	assert.Equal(t, core.Capacities{Regular: 12}, sources[1].(*capacitiesSource).Capacities)
+}
+
+func TestLoadFromSources_CapacityDrifts(t *testing.T) {
+	v := &Varsity{VarsityDefinition: &VarsityDefinition{
+		Code: "test",
+		HeadingSources: []HeadingSource{
+			&capacitiesSource{PrettyName: "Физика", Capacities: core.Capacities{Regular: 20}},
+			&capacitiesSource{PrettyName: "Химия", Capacities: core.Capacities{Regular: 15}},
+		},
+		CapacityFeed:             publishing(map[int]core.Capacities{1: {Regular: 12, TargetQuota: 3}}, nil),
+		ApplyPublishedCapacities: true,
+	}}
+	v.loadFromSources(context.Background())
+
+	assert.Equal(t, []core.CapacityDrift{{
+		HeadingCode: utils.GenerateHeadingCode("Химия"),
+		Name:        "Химия",
+		Registry:    core.Capacities{Regular: 15},
+		Published:   core.Capacities{Regular: 12, TargetQuota: 3},
+		Applied:     true,
+	}}, v.CapacityDrifts)
+
+	capacities := make(map[string]core.Capacities)
+	for _, hd := range v.HeadingsCache {
+		capacities[hd.PrettyName] = hd.Capacities
+	}
+	assert.Equal(t, core.Capacities{Regular: 20}, capacities["Физика"])
+	assert.Equal(t, core.Capacities{Regular: 12, TargetQuota: 3}, capacities["Химия"])
+}
@@ -12,11 +12,19 @@ type ApplicationData struct {
 	Priority          int
 	CompetitionType   core.Competition
 	OriginalSubmitted bool
-	// MSU-specific fields for ID resolution
-	DVIScore      int    // DVI (additional entrance exam) score, 0 if not applicable
-	EGEScores     []int  // Individual EGE scores, empty if not available
-	HeadingName   string // MSU-specific field for pretty name
-	MSUInternalID *string
+	// Entrance exam scores in the order of the heading's subject priority (see HeadingData.Subjects), if published
+	SubjectScores []int
+	// Individual achievement points, 0 if not published
+	AchievementScore int
+	// Detailed target quota the application competes in (see core.Capacities.TargetSubQuotas), empty if none
+	SubQuota string
+	// Fields needed by the IDResolver of varsities which publish internal student IDs
+	DVIScore    int    // DVI (additional entrance exam) score, 0 if not applicable
+	EGEScores   []int  // Individual EGE scores, empty if not available
+	HeadingName string // Pretty name of the heading
+	// Internal ID of the student as published by the varsity, empty if the varsity publishes canonical IDs;
+	// set by the varsity's IDResolution
+	InternalID string
 }
 
 // HeadingData mirrors the data needed for core.VarsityCalculator.AddHeading.
@@ -24,4 +32,8 @@ type HeadingData struct {
 	Code       string          // Unique code for the heading
 	Capacities core.Capacities // Number of places available in this heading
 	PrettyName string          // Name of the heading
+	Subjects   []string        // Entrance exam subjects in the order of their tie-break priority, if known
+	// Direction code, level, study form and entrance exams, as far as the source knows them; the rest is
+	// completed from PrettyName and Subjects on loading
+	Metadata core.HeadingMetadata
 }
@@ -14,6 +14,8 @@ import (
 	"strings"
 	"sync"
 	"time"
+
+	"github.com/trueegorletov/analabit/core/source/httparchive"
 )
 
 // Client represents a FlareSolverr client wrapper
@@ -426,6 +428,12 @@ func (c *Client) GetTimeout() time.Duration {
 // SafeGet performs a GET request with graceful fallback handling
 // Returns an error that can be checked for FlareSolverr availability
 func SafeGet(url string) (*GetResponse, error) {
+	return archived(http.MethodGet, url, nil, func() (*GetResponse, error) {
+		return safeGet(url)
+	})
+}
+
+func safeGet(url string) (*GetResponse, error) {
 	client, err := GetClient()
 	if err != nil {
 		return &GetResponse{Error: err}, fmt.Errorf("FlareSolverr unavailable: %w", err)
@@ -436,6 +444,12 @@ func SafeGet(url string) (*GetResponse, error) {
 
 // SafeGetWithHeaders performs a GET request with headers and graceful fallback handling
 func SafeGetWithHeaders(url string, headers map[string]string) (*GetResponse, error) {
+	return archived(http.MethodGet, url, nil, func() (*GetResponse, error) {
+		return safeGetWithHeaders(url, headers)
+	})
+}
+
+func safeGetWithHeaders(url string, headers map[string]string) (*GetResponse, error) {
 	client, err := GetClient()
 	if err != nil {
 		return &GetResponse{Error: err}, fmt.Errorf("FlareSolverr unavailable: %w", err)
@@ -446,6 +460,12 @@ func SafeGetWithHeaders(url string, headers map[string]string) (*GetResponse, er
 
 // SafePostWithData performs a POST request with JSON data and graceful fallback handling
 func SafePostWithData(url string, postData map[string]interface{}, headers map[string]string) (*GetResponse, error) {
+	return archived(http.MethodPost, url, postData, func() (*GetResponse, error) {
+		return safePostWithData(url, postData, headers)
+	})
+}
+
+func safePostWithData(url string, postData map[string]interface{}, headers map[string]string) (*GetResponse, error) {
 	client, err := GetClient()
 	if err != nil {
 		return &GetResponse{Error: err}, fmt.Errorf("FlareSolverr unavailable: %w", err)
@@ -457,6 +477,12 @@ func SafePostWithData(url string, postData map[string]interface{}, headers map[s
 // GetWithDomain performs a GET request using domain-specific session management
 // This function automatically manages sessions for the domain extracted from the URL
 func GetWithDomain(url string, headers map[string]string) (*GetResponse, error) {
+	return archived(http.MethodGet, url, nil, func() (*GetResponse, error) {
+		return getWithDomain(url, headers)
+	})
+}
+
+func getWithDomain(url string, headers map[string]string) (*GetResponse, error) {
 	sessionMutex.RLock()
 	defer sessionMutex.RUnlock()
 
@@ -469,11 +495,17 @@ func GetWithDomain(url string, headers map[string]string) (*GetResponse, error)
 
 // SafeGetWithDomain performs a GET request with domain-specific session management and graceful fallback
 func SafeGetWithDomain(url string, headers map[string]string) (*GetResponse, error) {
+	return archived(http.MethodGet, url, nil, func() (*GetResponse, error) {
+		return safeGetWithDomain(url, headers)
+	})
+}
+
+func safeGetWithDomain(url string, headers map[string]string) (*GetResponse, error) {
 	sessionMutex.RLock()
 	defer sessionMutex.RUnlock()
 
 	if sessionManager == nil {
-		return SafeGetWithHeaders(url, headers)
+		return safeGetWithHeaders(url, headers)
 	}
 
 	resp, err := sessionManager.GetWithDomain(url, headers)
@@ -483,6 +515,53 @@ func SafeGetWithDomain(url string, headers map[string]string) (*GetResponse, err
 	return resp, err
 }
 
+// archived serves the request from the active HTTP archive when replaying, without FlareSolverr running,
+// and records the solved page into it when recording; fetch performs the request otherwise.
+func archived(method, url string, postData map[string]interface{}, fetch func() (*GetResponse, error)) (*GetResponse, error) {
+	archive := httparchive.Current()
+	if archive == nil {
+		return fetch()
+	}
+
+	var body []byte
+	if postData != nil {
+		var err error
+		if body, err = json.Marshal(postData); err != nil {
+			return &GetResponse{Error: err}, fmt.Errorf("failed to encode post data for %s: %w", url, err)
+		}
+	}
+
+	if archive.Replaying() {
+		e, err := archive.Lookup(httparchive.ViaFlareSolverr, method, url, body)
+		if err != nil {
+			return &GetResponse{Error: err}, err
+		}
+		headers := make(map[string]string, len(e.Header))
+		for k := range e.Header {
+			headers[k] = e.Header.Get(k)
+		}
+		return &GetResponse{StatusCode: e.StatusCode, Body: string(e.Body), Headers: headers, URL: url}, nil
+	}
+
+	resp, err := fetch()
+	if err == nil && resp != nil {
+		header := make(http.Header, len(resp.Headers))
+		for k, v := range resp.Headers {
+			header.Set(k, v)
+		}
+		archive.Record(&httparchive.Entry{
+			Via:         httparchive.ViaFlareSolverr,
+			Method:      method,
+			URL:         url,
+			RequestBody: body,
+			StatusCode:  resp.StatusCode,
+			Header:      header,
+			Body:        []byte(resp.Body),
+		})
+	}
+	return resp, err
+}
+
 // IsFlareSolverrError checks if an error is related to FlareSolverr unavailability
 func IsFlareSolverrError(err error) bool {
 	if err == nil {
@@ -529,7 +608,7 @@ func (sm *SessionManager) GetWithDomain(url string, headers map[string]string) (
 	session, err := sm.GetSessionForDomain(domain)
 	if err != nil {
 		// Fallback to sessionless request
-		return SafeGetWithHeaders(url, headers)
+		return safeGetWithHeaders(url, headers)
 	}
 	defer sm.ReleaseSession(session)
 
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/source"
 	"golang.org/x/net/html"
 )
 
@@ -51,9 +52,7 @@ func fetchRegistryPage(ctx context.Context, pageURL string) (*html.Node, error)
 			timeout = 60 * time.Second
 		}
 
-		client := &http.Client{
-			Timeout: timeout,
-		}
+		client := source.NewHTTPClient(timeout)
 
 		req, err := http.NewRequestWithContext(ctx, "GET", pageURL, nil)
 		if err != nil {
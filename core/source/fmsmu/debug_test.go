@@ -54,7 +54,7 @@ func testSampleHTML(t *testing.T, source *HTTPHeadingSource) {
 	}
 
 	// Use production parsing method
-	applications, err := source.parseApplicationsFromHTML(doc, core.CompetitionRegular)
+	applications, err := source.parseApplicationsFromHTML(doc, core.CompetitionRegular, nil)
 	if err != nil {
 		t.Fatalf("Failed to parse applications: %v", err)
 	}
@@ -191,7 +191,7 @@ func testRealHTTP(t *testing.T, source *HTTPHeadingSource) {
 
 	fmt.Printf("Fetching: %s\n", url)
 
-	applications, doc, err := source.fetchListPage(ctx, url, core.CompetitionRegular)
+	applications, doc, err := source.fetchListPage(ctx, url, core.CompetitionRegular, nil)
 	if err != nil {
 		// Don't fail the test for network issues, just log
 		fmt.Printf("⚠️  Could not fetch real HTTP data: %v\n", err)
@@ -29,9 +29,9 @@ type HTTPHeadingSource struct {
 }
 
 // LoadTo loads data from HTTP source, downloading HTML pages and sending HeadingData and ApplicationData to the provided receiver.
-func (s *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
+func (s *HTTPHeadingSource) LoadTo(ctx context.Context, receiver source.DataReceiver) error {
 	// Acquire a semaphore slot, respecting context cancellation
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Minute)
 	defer cancel()
 
 	release, err := source.AcquireHTTPSemaphores(ctx, "fmsmu")
@@ -71,9 +71,10 @@ func (s *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
 			continue
 		}
 
-		applications, err := s.fetchListByID(ctx, config.ListID, config.Competition)
+		applications, err := s.fetchListByID(ctx, config.ListID, config.Competition, receiver)
 		if err != nil {
 			log.Printf("Error fetching %s (ID: %s): %v", config.ListName, config.ListID, err)
+			source.FailList(receiver, fmt.Errorf("%s (ID: %s): %w", config.ListName, config.ListID, err))
 			continue
 		}
 
@@ -96,9 +97,10 @@ func (s *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
 			continue
 		}
 
-		applications, err := s.fetchListByID(ctx, listID, core.CompetitionTargetQuota)
+		applications, err := s.fetchListByID(ctx, listID, core.CompetitionTargetQuota, receiver)
 		if err != nil {
 			log.Printf("Error fetching Target Quota List %d (ID: %s): %v", i+1, listID, err)
+			source.FailList(receiver, fmt.Errorf("Target Quota List %d (ID: %s): %w", i+1, listID, err))
 			continue
 		}
 
@@ -119,8 +121,9 @@ func (s *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
 	return nil
 }
 
-// fetchListByID fetches and parses all pages of a FMSMU list by ID
-func (s *HTTPHeadingSource) fetchListByID(ctx context.Context, listID string, competitionType core.Competition) ([]*source.ApplicationData, error) {
+// fetchListByID fetches and parses all pages of a FMSMU list by ID, telling the receiver of the pages and
+// rows it skips.
+func (s *HTTPHeadingSource) fetchListByID(ctx context.Context, listID string, competitionType core.Competition, receiver source.DataReceiver) ([]*source.ApplicationData, error) {
 	var allApplications []*source.ApplicationData
 	page := 1
 	maxPages := 300
@@ -129,11 +132,12 @@ func (s *HTTPHeadingSource) fetchListByID(ctx context.Context, listID string, co
 		// Construct URL for this page
 		url := fmt.Sprintf("https://priem.sechenov.ru/local/components/firstbit/competition.list/templates/.default/applications.php?COMPETITIVE_GROUP_ID=%s&appPage_%s=page-%d&ADMISSION_LISTS=N&CONTRACT_IS_PAID=N&lang=ru&search=", listID, listID, page)
 
-		applications, doc, err := s.fetchListPage(ctx, url, competitionType)
+		applications, doc, err := s.fetchListPage(ctx, url, competitionType, receiver)
 		if err != nil {
 			// If a single page fails, we might want to continue or stop.
 			// For now, let's log and continue to the next page if we have a max page count.
 			log.Printf("Error fetching page %d for list %s: %v. Skipping page.", page, listID, err)
+			source.FailList(receiver, fmt.Errorf("page %d of list %s: %w", page, listID, err))
 			if page < maxPages {
 				page++
 				continue
@@ -166,7 +170,7 @@ func (s *HTTPHeadingSource) fetchListByID(ctx context.Context, listID string, co
 }
 
 // fetchListPage fetches and parses a single page of a FMSMU list
-func (s *HTTPHeadingSource) fetchListPage(ctx context.Context, url string, competitionType core.Competition) ([]*source.ApplicationData, *html.Node, error) {
+func (s *HTTPHeadingSource) fetchListPage(ctx context.Context, url string, competitionType core.Competition, receiver source.DataReceiver) ([]*source.ApplicationData, *html.Node, error) {
 	var lastErr error
 	const maxRetries = 3
 
@@ -187,9 +191,7 @@ func (s *HTTPHeadingSource) fetchListPage(ctx context.Context, url string, compe
 			timeout = 60 * time.Second
 		}
 
-		client := &http.Client{
-			Timeout: timeout,
-		}
+		client := source.NewHTTPClient(timeout)
 
 		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
@@ -221,7 +223,7 @@ func (s *HTTPHeadingSource) fetchListPage(ctx context.Context, url string, compe
 		}
 
 		// Parse applications from the table
-		applications, err := s.parseApplicationsFromHTML(doc, competitionType)
+		applications, err := s.parseApplicationsFromHTML(doc, competitionType, receiver)
 		if err != nil {
 			return nil, nil, fmt.Errorf("failed to parse applications: %w", err)
 		}
@@ -232,8 +234,9 @@ func (s *HTTPHeadingSource) fetchListPage(ctx context.Context, url string, compe
 	return nil, nil, lastErr
 }
 
-// parseApplicationsFromHTML extracts applications from FMSMU HTML document
-func (s *HTTPHeadingSource) parseApplicationsFromHTML(doc *html.Node, defaultCompetitionType core.Competition) ([]*source.ApplicationData, error) {
+// parseApplicationsFromHTML extracts applications from FMSMU HTML document, telling the receiver of the rows
+// it skips
+func (s *HTTPHeadingSource) parseApplicationsFromHTML(doc *html.Node, defaultCompetitionType core.Competition, receiver source.DataReceiver) ([]*source.ApplicationData, error) {
 	// Find the table with class "table-competition-lists"
 	table := findElementByClass(doc, "table-competition-lists")
 	if table == nil {
@@ -254,6 +257,7 @@ func (s *HTTPHeadingSource) parseApplicationsFromHTML(doc *html.Node, defaultCom
 		app, err := s.parseApplicationFromTableRow(row, defaultCompetitionType)
 		if err != nil {
 			errors = append(errors, fmt.Sprintf("row %d: %v", i+1, err))
+			source.SkipRow(receiver, err)
 			continue
 		}
 
@@ -352,6 +356,20 @@ func (s *HTTPHeadingSource) parseApplicationFromTableRow(row *html.Node, default
 		log.Printf("Warning: Score %d outside expected range [0, 320] for Student ID: %s", scoresSum, studentID)
 	}
 
+	// Extract the entrance exam scores (5th column in outer cells), listed in the order of the exams' priority
+	var subjectScores []int
+	for _, number := range findElementsByClass(cells[4], "pseudo-ol__number") {
+		subjectScore, err := strconv.Atoi(strings.TrimSpace(getTextContent(number)))
+		if err != nil {
+			subjectScores = nil
+			break
+		}
+		subjectScores = append(subjectScores, subjectScore)
+	}
+
+	// Extract individual achievements sum (6th column in outer cells)
+	achievementScore, _ := strconv.Atoi(strings.TrimSpace(getTextContent(cells[5])))
+
 	// Extract original submitted (11th column in outer cells)
 	originalSubmittedText := strings.TrimSpace(getTextContent(cells[10]))
 	originalSubmitted := originalSubmittedText == "Да"
@@ -375,6 +393,8 @@ func (s *HTTPHeadingSource) parseApplicationFromTableRow(row *html.Node, default
 	return &source.ApplicationData{
 		StudentID:         studentID,
 		ScoresSum:         scoresSum,
+		SubjectScores:     subjectScores,
+		AchievementScore:  achievementScore,
 		RatingPlace:       ratingPlace,
 		Priority:          priority,
 		CompetitionType:   competitionType,
@@ -0,0 +1,49 @@
+package fmsmu
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/trueegorletov/analabit/core"
+	"golang.org/x/net/html"
+)
+
+func TestParseApplicationFromTableRow_Scores(t *testing.T) {
+	htmlContent, err := os.ReadFile(filepath.Join("..", "..", "..", "sample_data", "fmsmu", "sample_table_element.html"))
+	if err != nil {
+		t.Fatalf("Failed to load sample HTML file: %v", err)
+	}
+
+	doc, err := html.Parse(strings.NewReader(string(htmlContent)))
+	if err != nil {
+		t.Fatalf("Failed to parse HTML: %v", err)
+	}
+
+	s := &HTTPHeadingSource{}
+	applications, err := s.parseApplicationsFromHTML(doc, core.CompetitionRegular, nil)
+	if err != nil {
+		t.Fatalf("Failed to parse applications: %v", err)
+	}
+	if len(applications) < 2 {
+		t.Fatalf("Expected at least 2 applications, got %d", len(applications))
+	}
+
+	first := applications[0]
+	if want := []int{84, 78, 81}; !slices.Equal(first.SubjectScores, want) {
+		t.Errorf("Expected subject scores %v for %s, got %v", want, first.StudentID, first.SubjectScores)
+	}
+	if first.AchievementScore != 0 {
+		t.Errorf("Expected no achievement points for %s, got %d", first.StudentID, first.AchievementScore)
+	}
+
+	second := applications[1]
+	if want := []int{80, 75, 81}; !slices.Equal(second.SubjectScores, want) {
+		t.Errorf("Expected subject scores %v for %s, got %v", want, second.StudentID, second.SubjectScores)
+	}
+	if second.AchievementScore != 5 {
+		t.Errorf("Expected 5 achievement points for %s, got %d", second.StudentID, second.AchievementScore)
+	}
+}
@@ -31,7 +31,7 @@ func TestParseSampleFile(t *testing.T) {
 
 	// Test parsing applications
 	mockReceiver := &MockDataReceiver{}
-	err = parseApplicationsFromXLSX(f, "TEST_CODE", mockReceiver, "test_file")
+	err = parseApplicationsFromXLSX(f, "TEST_CODE", mockReceiver, "test_file", false)
 	if err != nil {
 		t.Fatalf("Failed to parse applications: %v", err)
 	}
@@ -4,6 +4,7 @@ import (
 	"github.com/trueegorletov/analabit/core"
 	"github.com/trueegorletov/analabit/core/source"
 	"github.com/trueegorletov/analabit/core/utils"
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -40,7 +41,7 @@ func openLocalExcelFile(filePath string, listName string) (*excelize.File, error
 }
 
 // LoadTo loads data from local file system sources, sending HeadingData and ApplicationData to the provided receiver.
-func (s *FileHeadingSource) LoadTo(receiver source.DataReceiver) error {
+func (s *FileHeadingSource) LoadTo(_ context.Context, receiver source.DataReceiver) error {
 	if s.FilePath == "" {
 		return fmt.Errorf("FilePath is required for HSE FileHeadingSource")
 	}
@@ -78,7 +79,7 @@ func (s *FileHeadingSource) LoadTo(receiver source.DataReceiver) error {
 	log.Printf("Sent HSE heading: %s (Code: %s, Caps: %v)", prettyName, headingCode, s.Capacities)
 
 	// Parse applications from the XLSX and send to receiver
-	err = parseApplicationsFromXLSX(f, headingCode, receiver, s.FilePath)
+	err = parseApplicationsFromXLSX(f, headingCode, receiver, s.FilePath, s.Capacities.Paid > 0)
 	if err != nil {
 		return fmt.Errorf("failed to parse applications from HSE list at %s: %w", s.FilePath, err)
 	}
@@ -21,7 +21,7 @@ type HTTPHeadingSource struct {
 }
 
 // LoadTo loads data from HTTP source, sending HeadingData and ApplicationData to the provided receiver.
-func (s *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
+func (s *HTTPHeadingSource) LoadTo(ctx context.Context, receiver source.DataReceiver) error {
 	if s.URL == "" {
 		return fmt.Errorf("URL is required for HSE HttpHeadingSource")
 	}
@@ -29,16 +29,18 @@ func (s *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
 	log.Printf("Downloading HSE admission list from: %s", s.URL)
 
 	// Acquire a semaphore slot, respecting context cancellation
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-
 	release, err := source.AcquireHTTPSemaphores(ctx, "hse")
 	if err != nil {
 		return fmt.Errorf("failed to acquire semaphores for HSE list from %s: %w", s.URL, err)
 	}
 	defer release()
 
-	resp, err := http.Get(s.URL)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.URL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request for HSE list from %s: %w", s.URL, err)
+	}
+
+	resp, err := source.HTTPClient.Do(req)
 	if err != nil {
 		return fmt.Errorf("failed to download HSE list from %s: %w", s.URL, err)
 	}
@@ -81,7 +83,7 @@ func (s *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
 	log.Printf("Sent HSE heading: %s (Code: %s, Caps: %v)", prettyName, headingCode, s.Capacities)
 
 	// Parse applications from the XLSX and send to receiver
-	err = parseApplicationsFromXLSX(f, headingCode, receiver, s.URL)
+	err = parseApplicationsFromXLSX(f, headingCode, receiver, s.URL, s.Capacities.Paid > 0)
 	if err != nil {
 		return fmt.Errorf("failed to parse applications from HSE list at %s: %w", s.URL, err)
 	}
@@ -2,9 +2,14 @@ package hse
 
 import (
 	"encoding/gob"
+
+	"github.com/trueegorletov/analabit/core/source"
 )
 
 func init() {
 	gob.RegisterName("HseHTTPHeadingSource", &HTTPHeadingSource{})
 	gob.RegisterName("HseFileHeadingSource", &FileHeadingSource{})
+
+	source.RegisterHeadingSourceType("hse.http", func() source.HeadingSource { return &HTTPHeadingSource{} })
+	source.RegisterHeadingSourceType("hse.file", func() source.HeadingSource { return &FileHeadingSource{} })
 }
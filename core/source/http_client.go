@@ -0,0 +1,22 @@
+package source
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/trueegorletov/analabit/core/source/httparchive"
+)
+
+var httpTransport = httparchive.Transport(http.DefaultTransport)
+
+// HTTPClient is the client the sources send their requests with unless they need a timeout of their own.
+var HTTPClient = NewHTTPClient(0)
+
+// NewHTTPClient creates a client for the sources with the given timeout, zero meaning none. The requests of
+// the sources must go through such clients to be recorded into or replayed from the active HTTP archive.
+func NewHTTPClient(timeout time.Duration) *http.Client {
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: httpTransport,
+	}
+}
@@ -0,0 +1,271 @@
+// Package httparchive records the HTTP exchanges of the heading sources into an archive file and serves them
+// back from it, so that a whole crawl can be rerun offline and the parse bugs of production reproduced.
+//
+// An archive is a gzipped stream of JSON-encoded entries. At most one archive is active at a time: the
+// transport returned by Transport and the FlareSolverr client consult it on every request.
+//
+// Recorded archives can be published into a BlobStore, which keeps every raw document once under its content
+// hash plus an index per crawl, and restored from it later to reparse a historical crawl with fixed parsers.
+package httparchive
+
+import (
+	"bufio"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// The ways requests are sent, kept apart as the same URL is fetched differently by them.
+const (
+	ViaHTTP         = "http"
+	ViaFlareSolverr = "flaresolverr"
+)
+
+// ErrNotRecorded is returned when replaying a request the archive has no response for.
+var ErrNotRecorded = errors.New("request not recorded in the HTTP archive")
+
+// Entry is a single recorded exchange.
+type Entry struct {
+	Via         string      `json:"via"`
+	Method      string      `json:"method"`
+	URL         string      `json:"url"`
+	RequestBody []byte      `json:"request_body,omitempty"`
+	StatusCode  int         `json:"status_code"`
+	Header      http.Header `json:"header,omitempty"`
+	Body        []byte      `json:"body"`
+	RecordedAt  time.Time   `json:"recorded_at"`
+}
+
+// Archive is an archive file being recorded or replayed.
+type Archive struct {
+	path      string
+	replaying bool
+
+	mu sync.Mutex
+
+	// Recording
+	file *os.File
+	gz   *gzip.Writer
+	enc  *json.Encoder
+
+	// Replaying: the entries of every exact request and, for the requests whose body differs from the
+	// recorded one, of every URL; both served in the recorded order, the last one repeatedly
+	byRequest map[string]*queue
+	byURL     map[string]*queue
+}
+
+type queue struct {
+	entries []*Entry
+	next    int
+}
+
+func (q *queue) pop() *Entry {
+	e := q.entries[min(q.next, len(q.entries)-1)]
+	q.next++
+	return e
+}
+
+var (
+	currentMu sync.RWMutex
+	current   *Archive
+)
+
+// Current returns the active archive, nil if none.
+func Current() *Archive {
+	currentMu.RLock()
+	defer currentMu.RUnlock()
+	return current
+}
+
+func activate(a *Archive) error {
+	currentMu.Lock()
+	defer currentMu.Unlock()
+	if current != nil {
+		return fmt.Errorf("HTTP archive %s is already active", current.path)
+	}
+	current = a
+	return nil
+}
+
+// StartRecording creates the archive file at path and records every exchange into it until Close.
+func StartRecording(path string) (*Archive, error) {
+	a, err := create(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := activate(a); err != nil {
+		a.Close()
+		return nil, err
+	}
+
+	slog.Info("Recording HTTP exchanges", "archive", path)
+	return a, nil
+}
+
+// StartReplaying reads the archive file at path and serves every request from it until Close.
+func StartReplaying(path string) (*Archive, error) {
+	a := &Archive{
+		path:      path,
+		replaying: true,
+		byRequest: make(map[string]*queue),
+		byURL:     make(map[string]*queue),
+	}
+
+	count, err := readEntries(path, func(e *Entry) error {
+		a.add(e)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := activate(a); err != nil {
+		return nil, err
+	}
+
+	slog.Info("Replaying HTTP exchanges", "archive", path, "entries", count)
+	return a, nil
+}
+
+// create creates the archive file at path to record into, without making it the active archive.
+func create(path string) (*Archive, error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP archive %s: %w", path, err)
+	}
+
+	gz := gzip.NewWriter(file)
+	return &Archive{path: path, file: file, gz: gz, enc: json.NewEncoder(gz)}, nil
+}
+
+// readEntries calls fn with every entry of the archive file at path in the recorded order, returning their count.
+func readEntries(path string, fn func(e *Entry) error) (int, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open HTTP archive %s: %w", path, err)
+	}
+	defer file.Close()
+
+	gz, err := gzip.NewReader(bufio.NewReader(file))
+	if err != nil {
+		return 0, fmt.Errorf("failed to read HTTP archive %s: %w", path, err)
+	}
+	defer gz.Close()
+
+	count := 0
+	dec := json.NewDecoder(gz)
+	for {
+		var e Entry
+		if err := dec.Decode(&e); err != nil {
+			if err == io.EOF || errors.Is(err, io.ErrUnexpectedEOF) {
+				// An archive whose recording was cut short is still read up to where it ends
+				return count, nil
+			}
+			return count, fmt.Errorf("failed to decode entry %d of HTTP archive %s: %w", count+1, path, err)
+		}
+		if err := fn(&e); err != nil {
+			return count, err
+		}
+		count++
+	}
+}
+
+func (a *Archive) add(e *Entry) {
+	push(a.byRequest, requestKey(e.Via, e.Method, e.URL, e.RequestBody), e)
+	push(a.byURL, urlKey(e.Via, e.Method, e.URL), e)
+}
+
+func push(queues map[string]*queue, key string, e *Entry) {
+	q, ok := queues[key]
+	if !ok {
+		q = &queue{}
+		queues[key] = q
+	}
+	q.entries = append(q.entries, e)
+}
+
+// Path returns the path of the archive file.
+func (a *Archive) Path() string {
+	return a.path
+}
+
+// Replaying tells whether the archive serves requests rather than records them.
+func (a *Archive) Replaying() bool {
+	return a.replaying
+}
+
+// Record appends the exchange to an archive being recorded.
+func (a *Archive) Record(e *Entry) {
+	if a.replaying {
+		return
+	}
+	if e.RecordedAt.IsZero() {
+		e.RecordedAt = time.Now()
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.enc == nil {
+		return // Closed
+	}
+	if err := a.enc.Encode(e); err != nil {
+		slog.Error("Failed to record HTTP exchange", "archive", a.path, "url", e.URL, "error", err)
+	}
+}
+
+// Lookup returns the recorded response to the request, preferring the one recorded for the same body.
+func (a *Archive) Lookup(via, method, url string, body []byte) (*Entry, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if q, ok := a.byRequest[requestKey(via, method, url, body)]; ok {
+		return q.pop(), nil
+	}
+	if q, ok := a.byURL[urlKey(via, method, url)]; ok {
+		return q.pop(), nil
+	}
+	return nil, fmt.Errorf("%w: %s %s via %s", ErrNotRecorded, method, url, via)
+}
+
+// Close stops recording or replaying, flushing a recorded archive to its file.
+func (a *Archive) Close() error {
+	currentMu.Lock()
+	if current == a {
+		current = nil
+	}
+	currentMu.Unlock()
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.replaying || a.enc == nil {
+		return nil
+	}
+
+	a.enc = nil
+	err := a.gz.Close()
+	if closeErr := a.file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to finish HTTP archive %s: %w", a.path, err)
+	}
+	return nil
+}
+
+func urlKey(via, method, url string) string {
+	return via + " " + method + " " + url
+}
+
+func requestKey(via, method, url string, body []byte) string {
+	sum := sha256.Sum256(body)
+	return urlKey(via, method, url) + " " + hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,105 @@
+package httparchive
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func get(t *testing.T, client *http.Client, method, url, body string) (int, string, error) {
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	require.NoError(t, err)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, "", err
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp.StatusCode, string(b), nil
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	var hits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := hits.Add(1)
+		body, _ := io.ReadAll(r.Body)
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+		fmt.Fprintf(w, "%s %s %s #%d", r.Method, r.URL.Path, body, n)
+	}))
+	client := &http.Client{Transport: Transport(http.DefaultTransport)}
+	path := filepath.Join(t.TempDir(), "crawl.http.gz")
+
+	recording, err := StartRecording(path)
+	require.NoError(t, err)
+
+	_, first, err := get(t, client, http.MethodGet, server.URL+"/list", "")
+	require.NoError(t, err)
+	_, second, err := get(t, client, http.MethodGet, server.URL+"/list", "")
+	require.NoError(t, err)
+	_, posted, err := get(t, client, http.MethodPost, server.URL+"/capacity", "id=1")
+	require.NoError(t, err)
+	status, _, err := get(t, client, http.MethodGet, server.URL+"/missing", "")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, status)
+
+	require.NoError(t, recording.Close())
+	assert.Nil(t, Current())
+	server.Close()
+
+	replaying, err := StartReplaying(path)
+	require.NoError(t, err)
+	defer replaying.Close()
+
+	// Repeated requests get their responses in the recorded order, the last one over and over
+	for _, want := range []string{first, second, second} {
+		_, body, err := get(t, client, http.MethodGet, server.URL+"/list", "")
+		require.NoError(t, err)
+		assert.Equal(t, want, body)
+	}
+
+	_, body, err := get(t, client, http.MethodPost, server.URL+"/capacity", "id=1")
+	require.NoError(t, err)
+	assert.Equal(t, posted, body)
+
+	// A differing body falls back to the responses recorded for the URL
+	_, body, err = get(t, client, http.MethodPost, server.URL+"/capacity", "id=2")
+	require.NoError(t, err)
+	assert.Equal(t, posted, body)
+
+	status, _, err = get(t, client, http.MethodGet, server.URL+"/missing", "")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, status)
+
+	_, _, err = get(t, client, http.MethodGet, server.URL+"/other", "")
+	assert.ErrorIs(t, err, ErrNotRecorded)
+
+	assert.EqualValues(t, 4, hits.Load(), "nothing is sent while replaying")
+}
+
+func TestSingleActiveArchive(t *testing.T) {
+	dir := t.TempDir()
+
+	a, err := StartRecording(filepath.Join(dir, "a.http.gz"))
+	require.NoError(t, err)
+
+	_, err = StartRecording(filepath.Join(dir, "b.http.gz"))
+	assert.Error(t, err)
+
+	require.NoError(t, a.Close())
+	b, err := StartRecording(filepath.Join(dir, "b.http.gz"))
+	require.NoError(t, err)
+	require.NoError(t, b.Close())
+}
@@ -0,0 +1,167 @@
+package httparchive
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// BlobStore is an object storage the raw documents of the crawls are archived into.
+type BlobStore interface {
+	// Exists tells whether an object is stored under key.
+	Exists(ctx context.Context, key string) (bool, error)
+	// Put stores data under key, replacing the object stored under it if any.
+	Put(ctx context.Context, key string, data []byte) error
+	// Get returns the object stored under key.
+	Get(ctx context.Context, key string) ([]byte, error)
+}
+
+// IndexEntry is an Entry whose response body is kept in the BlobStore under the hash of its content.
+type IndexEntry struct {
+	Via         string      `json:"via"`
+	Method      string      `json:"method"`
+	URL         string      `json:"url"`
+	RequestBody []byte      `json:"request_body,omitempty"`
+	StatusCode  int         `json:"status_code"`
+	Header      http.Header `json:"header,omitempty"`
+	BodyHash    string      `json:"body_hash"`
+	BodySize    int         `json:"body_size"`
+	RecordedAt  time.Time   `json:"recorded_at"`
+}
+
+// Index lists the exchanges of an archived crawl in the recorded order.
+type Index struct {
+	Crawl     string       `json:"crawl"`
+	CreatedAt time.Time    `json:"created_at"`
+	Entries   []IndexEntry `json:"entries"`
+}
+
+// BlobKey is the key the document with the given SHA-256 hash is stored under. The same document fetched by
+// many crawls is stored once.
+func BlobKey(hash string) string {
+	return "raw/sha256/" + hash[:2] + "/" + hash
+}
+
+// IndexKey is the key the index of the given crawl is stored under.
+func IndexKey(crawl string) string {
+	return "raw/index/" + crawl + ".json"
+}
+
+// CrawlName returns the name a crawl recorded into the archive file at path is archived under.
+func CrawlName(path string) string {
+	return strings.TrimSuffix(filepath.Base(path), ".http.gz")
+}
+
+// Publish archives the exchanges recorded into the archive file at path into the store: every response
+// body is stored under its content hash unless already there, then the index of the crawl under its name.
+func Publish(ctx context.Context, store BlobStore, path, crawl string) (*Index, error) {
+	index := &Index{Crawl: crawl, CreatedAt: time.Now()}
+	stored := make(map[string]bool)
+	uploaded := 0
+
+	_, err := readEntries(path, func(e *Entry) error {
+		sum := sha256.Sum256(e.Body)
+		hash := hex.EncodeToString(sum[:])
+
+		if !stored[hash] {
+			key := BlobKey(hash)
+			exists, err := store.Exists(ctx, key)
+			if err != nil {
+				return fmt.Errorf("failed to check raw document %s: %w", key, err)
+			}
+			if !exists {
+				if err := store.Put(ctx, key, e.Body); err != nil {
+					return fmt.Errorf("failed to store raw document %s of %s: %w", key, e.URL, err)
+				}
+				uploaded++
+			}
+			stored[hash] = true
+		}
+
+		index.Entries = append(index.Entries, IndexEntry{
+			Via:         e.Via,
+			Method:      e.Method,
+			URL:         e.URL,
+			RequestBody: e.RequestBody,
+			StatusCode:  e.StatusCode,
+			Header:      e.Header,
+			BodyHash:    hash,
+			BodySize:    len(e.Body),
+			RecordedAt:  e.RecordedAt,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(index)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode index of crawl %s: %w", crawl, err)
+	}
+	if err := store.Put(ctx, IndexKey(crawl), data); err != nil {
+		return nil, fmt.Errorf("failed to store index of crawl %s: %w", crawl, err)
+	}
+
+	slog.Info("Archived raw documents of crawl", "crawl", crawl, "exchanges", len(index.Entries), "documents", len(stored), "uploaded", uploaded)
+	return index, nil
+}
+
+// Restore fetches the index and the raw documents of the crawl archived under the given name from the store
+// and writes them as an archive file at path, to be replayed with StartReplaying.
+func Restore(ctx context.Context, store BlobStore, crawl, path string) error {
+	data, err := store.Get(ctx, IndexKey(crawl))
+	if err != nil {
+		return fmt.Errorf("failed to get index of crawl %s: %w", crawl, err)
+	}
+
+	var index Index
+	if err := json.Unmarshal(data, &index); err != nil {
+		return fmt.Errorf("failed to decode index of crawl %s: %w", crawl, err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create directory of HTTP archive %s: %w", path, err)
+	}
+	a, err := create(path)
+	if err != nil {
+		return err
+	}
+
+	// Documents are fetched anew for every exchange, as holding all of a crawl's at once takes too much memory
+	documents := make(map[string]bool)
+	for _, ie := range index.Entries {
+		body, err := store.Get(ctx, BlobKey(ie.BodyHash))
+		if err != nil {
+			a.Close()
+			return fmt.Errorf("failed to get raw document %s of %s: %w", ie.BodyHash, ie.URL, err)
+		}
+		documents[ie.BodyHash] = true
+
+		a.Record(&Entry{
+			Via:         ie.Via,
+			Method:      ie.Method,
+			URL:         ie.URL,
+			RequestBody: ie.RequestBody,
+			StatusCode:  ie.StatusCode,
+			Header:      ie.Header,
+			Body:        body,
+			RecordedAt:  ie.RecordedAt,
+		})
+	}
+
+	if err := a.Close(); err != nil {
+		return err
+	}
+
+	slog.Info("Restored raw documents of crawl", "crawl", crawl, "exchanges", len(index.Entries), "documents", len(documents), "archive", path)
+	return nil
+}
@@ -0,0 +1,101 @@
+package httparchive
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryStore struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	puts    int
+}
+
+func (s *memoryStore) Exists(_ context.Context, key string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.objects[key]
+	return ok, nil
+}
+
+func (s *memoryStore) Put(_ context.Context, key string, data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.objects[key] = append([]byte(nil), data...)
+	s.puts++
+	return nil
+}
+
+func (s *memoryStore) Get(_ context.Context, key string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, ok := s.objects[key]
+	if !ok {
+		return nil, fmt.Errorf("no object %s", key)
+	}
+	return data, nil
+}
+
+func TestPublishAndRestore(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Both lists are the same document
+		fmt.Fprint(w, "list of "+strings.Split(r.URL.Path, "/")[1])
+	}))
+	client := &http.Client{Transport: Transport(http.DefaultTransport)}
+	store := &memoryStore{objects: make(map[string][]byte)}
+	dir := t.TempDir()
+
+	record := func(path string) {
+		a, err := StartRecording(path)
+		require.NoError(t, err)
+		for _, p := range []string{"/hse/1", "/hse/2", "/mipt"} {
+			_, _, err := get(t, client, http.MethodGet, server.URL+p, "")
+			require.NoError(t, err)
+		}
+		require.NoError(t, a.Close())
+	}
+
+	first := filepath.Join(dir, "100.http.gz")
+	record(first)
+	assert.Equal(t, "100", CrawlName(first))
+
+	index, err := Publish(ctx, store, first, CrawlName(first))
+	require.NoError(t, err)
+	require.Len(t, index.Entries, 3)
+	assert.Equal(t, index.Entries[0].BodyHash, index.Entries[1].BodyHash)
+	assert.Equal(t, 3, store.puts, "two documents and the index")
+
+	// A later crawl fetching the same documents stores only its index
+	second := filepath.Join(dir, "200.http.gz")
+	record(second)
+	_, err = Publish(ctx, store, second, CrawlName(second))
+	require.NoError(t, err)
+	assert.Equal(t, 4, store.puts)
+	server.Close()
+
+	restored := filepath.Join(dir, "reparse", "100.http.gz")
+	require.NoError(t, Restore(ctx, store, "100", restored))
+
+	a, err := StartReplaying(restored)
+	require.NoError(t, err)
+	defer a.Close()
+
+	_, body, err := get(t, client, http.MethodGet, server.URL+"/hse/2", "")
+	require.NoError(t, err)
+	assert.Equal(t, "list of hse", body)
+	_, body, err = get(t, client, http.MethodGet, server.URL+"/mipt", "")
+	require.NoError(t, err)
+	assert.Equal(t, "list of mipt", body)
+
+	assert.Error(t, Restore(ctx, store, "300", filepath.Join(dir, "300.http.gz")))
+}
@@ -0,0 +1,83 @@
+package httparchive
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Transport returns an http.RoundTripper sending the requests through base while no archive is active,
+// recording the exchanges into the active archive or serving them from it otherwise.
+func Transport(base http.RoundTripper) http.RoundTripper {
+	return &transport{base: base}
+}
+
+type transport struct {
+	base http.RoundTripper
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	a := Current()
+	if a == nil {
+		return t.base.RoundTrip(req)
+	}
+
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read request body of %s: %w", req.URL, err)
+		}
+
+		// The request must not be modified, so the body is sent with a copy of it
+		req = req.Clone(req.Context())
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	if a.Replaying() {
+		if err := req.Context().Err(); err != nil {
+			return nil, err
+		}
+		e, err := a.Lookup(ViaHTTP, req.Method, req.URL.String(), body)
+		if err != nil {
+			return nil, err
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", e.StatusCode, http.StatusText(e.StatusCode)),
+			StatusCode:    e.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        e.Header.Clone(),
+			Body:          io.NopCloser(bytes.NewReader(e.Body)),
+			ContentLength: int64(len(e.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body of %s: %w", req.URL, err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	a.Record(&Entry{
+		Via:         ViaHTTP,
+		Method:      req.Method,
+		URL:         req.URL.String(),
+		RequestBody: body,
+		StatusCode:  resp.StatusCode,
+		Header:      resp.Header.Clone(),
+		Body:        respBody,
+	})
+	return resp, nil
+}
@@ -0,0 +1,108 @@
+package source
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/trueegorletov/analabit/core/utils"
+)
+
+// IDResolver maps the internal student IDs a varsity publishes in its lists to canonical student IDs.
+type IDResolver interface {
+	// InternalID extracts the internal ID of the application's student from the application as published.
+	InternalID(ad *ApplicationData) string
+	// Resolve returns the canonical student ID of every internal ID, given all applications of each of them.
+	// Applications of an internal ID missing from the result are dropped.
+	Resolve(ctx context.Context, applications map[string][]*ApplicationData) (map[string]string, error)
+}
+
+// IDResolvingReceiver is a DataReceiver which holds the applications back until all sources of the varsity
+// are loaded, as the internal IDs can only be resolved with all applications at hand.
+type IDResolvingReceiver interface {
+	DataReceiver
+	// Finalize resolves the internal IDs of the received applications and forwards the applications
+	// downstream with StudentID set to the canonical ID and InternalID to the internal one.
+	Finalize(ctx context.Context) error
+}
+
+// IDResolution creates the IDResolvingReceiver of a varsity forwarding to the downstream receiver.
+// Being a func, it is skipped when the VarsityDefinition is serialized: the cached applications
+// already carry the resolved IDs.
+type IDResolution func(downstream DataReceiver) IDResolvingReceiver
+
+// NewBufferingIDResolution returns the IDResolution buffering all applications by their internal IDs
+// and resolving them at once with the resolver.
+func NewBufferingIDResolution(resolver IDResolver) IDResolution {
+	return func(downstream DataReceiver) IDResolvingReceiver {
+		return &bufferingReceiver{
+			downstream: downstream,
+			resolver:   resolver,
+			buffer:     make(map[string][]*ApplicationData),
+		}
+	}
+}
+
+type bufferingReceiver struct {
+	downstream DataReceiver
+	resolver   IDResolver
+
+	mu     sync.Mutex
+	buffer map[string][]*ApplicationData // internal ID -> applications
+}
+
+func (r *bufferingReceiver) PutHeadingData(hd *HeadingData) {
+	r.downstream.PutHeadingData(hd)
+}
+
+func (r *bufferingReceiver) PutApplicationData(ad *ApplicationData) {
+	internalID := r.resolver.InternalID(ad)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.buffer[internalID] = append(r.buffer[internalID], ad)
+}
+
+func (r *bufferingReceiver) Finalize(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.buffer) == 0 {
+		return nil
+	}
+
+	canonicalIDs, err := r.resolver.Resolve(ctx, r.buffer)
+	if err != nil {
+		return fmt.Errorf("failed to resolve %d internal IDs: %w", len(r.buffer), err)
+	}
+
+	dropped := 0
+	for internalID, applications := range r.buffer {
+		canonicalID, ok := canonicalIDs[internalID]
+		if !ok {
+			dropped += len(applications)
+			continue
+		}
+
+		// Internal IDs are stored padded like student IDs, so that they are looked up the same way
+		storedID, err := utils.PrepareStudentID(internalID)
+		if err != nil {
+			storedID = internalID
+		}
+
+		for _, ad := range applications {
+			resolved := *ad
+			resolved.StudentID = canonicalID
+			resolved.InternalID = storedID
+			r.downstream.PutApplicationData(&resolved)
+		}
+	}
+
+	if dropped > 0 {
+		slog.Warn("Dropped applications with unresolved internal IDs", "applications", dropped)
+	}
+
+	r.buffer = make(map[string][]*ApplicationData)
+	return nil
+}
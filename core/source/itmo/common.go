@@ -115,30 +115,30 @@ func parseApplicant(node *html.Node, competitionType core.Competition) (*source.
 		priority, _ = strconv.Atoi(priorityMatches[1])
 	}
 
-	// Extract scores - for ITMO we need to calculate total score
-	var scoresSum int
+	// Subject scores in the order of their tie-break priority: mathematics, informatics, Russian
+	var subjectScores []int
+	for _, re := range []*regexp.Regexp{mathRegex, csRegex, russianRegex} {
+		if subjectMatches := re.FindStringSubmatch(text); len(subjectMatches) > 1 {
+			score, _ := strconv.Atoi(subjectMatches[1])
+			subjectScores = append(subjectScores, score)
+		}
+	}
 
-	// Try to get total score directly first
+	// Individual achievement points are told apart from the exam scores by the tie-break rules
+	var achievementScore int
+	if indMatches := individualRegex.FindStringSubmatch(text); len(indMatches) > 1 {
+		achievementScore, _ = strconv.Atoi(indMatches[1])
+	}
+
+	// Extract scores - the total is taken as published, or calculated from the subjects if it isn't
+	var scoresSum int
 	if totalMatches := totalScoreRegex.FindStringSubmatch(text); len(totalMatches) > 1 {
 		scoresSum, _ = strconv.Atoi(totalMatches[1])
 	} else {
-		// If no total score, try to calculate from individual subjects
-		var mathScore, csScore, russianScore, individualScore int
-
-		if mathMatches := mathRegex.FindStringSubmatch(text); len(mathMatches) > 1 {
-			mathScore, _ = strconv.Atoi(mathMatches[1])
-		}
-		if csMatches := csRegex.FindStringSubmatch(text); len(csMatches) > 1 {
-			csScore, _ = strconv.Atoi(csMatches[1])
-		}
-		if russianMatches := russianRegex.FindStringSubmatch(text); len(russianMatches) > 1 {
-			russianScore, _ = strconv.Atoi(russianMatches[1])
+		scoresSum = achievementScore
+		for _, score := range subjectScores {
+			scoresSum += score
 		}
-		if indMatches := individualRegex.FindStringSubmatch(text); len(indMatches) > 1 {
-			individualScore, _ = strconv.Atoi(indMatches[1])
-		}
-
-		scoresSum = mathScore + csScore + russianScore + individualScore
 	}
 
 	// Extract consent status
@@ -150,6 +150,8 @@ func parseApplicant(node *html.Node, competitionType core.Competition) (*source.
 		Priority:          priority,
 		CompetitionType:   competitionType,
 		ScoresSum:         scoresSum,
+		SubjectScores:     subjectScores,
+		AchievementScore:  achievementScore,
 		OriginalSubmitted: originalSubmitted,
 	}, nil
 }
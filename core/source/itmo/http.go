@@ -21,16 +21,20 @@ type HTTPHeadingSource struct {
 }
 
 // LoadTo fetches and parses ITMO application data from the configured URL
-func (h *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
+func (h *HTTPHeadingSource) LoadTo(ctx context.Context, receiver source.DataReceiver) error {
 	// Fetch the HTML content
-	ctx := context.Background()
 	release, err := source.AcquireHTTPSemaphores(ctx, "itmo")
 	if err != nil {
 		return fmt.Errorf("failed to acquire semaphores for ITMO list from %s: %v", h.URL, err)
 	}
 	defer release()
 
-	resp, err := http.Get(h.URL)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, h.URL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request for URL %s: %v", h.URL, err)
+	}
+
+	resp, err := source.HTTPClient.Do(req)
 	if err != nil {
 		return fmt.Errorf("failed to fetch URL %s: %v", h.URL, err)
 	}
@@ -132,6 +136,7 @@ func (h *HTTPHeadingSource) parseApplicationsByCategory(doc *html.Node, receiver
 						if err != nil {
 							// Log error but continue processing
 							fmt.Printf("Warning: failed to parse application in category %s: %v\n", currentCategory, err)
+							source.SkipRow(receiver, err)
 							break
 						}
 
@@ -423,7 +423,7 @@ func TestAllRegistryURLsCapacityExtraction(t *testing.T) {
 			extractedCapacities := extractCapacities(doc)
 
 			// Log in requested format
-			t.Logf("%s => %s => %d", tc.name, tc.url, extractedCapacities)
+			t.Logf("%s => %s => %+v", tc.name, tc.url, extractedCapacities)
 		})
 	}
 }
@@ -3,6 +3,7 @@ package itmo
 import (
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"testing"
 
@@ -110,6 +111,12 @@ func TestParseApplicant(t *testing.T) {
 	if app.OriginalSubmitted != false {
 		t.Errorf("Expected OriginalSubmitted false, got %t", app.OriginalSubmitted)
 	}
+	if !slices.Equal(app.SubjectScores, []int{100, 100, 100}) {
+		t.Errorf("Expected SubjectScores [100 100 100], got %v", app.SubjectScores)
+	}
+	if app.AchievementScore != 10 {
+		t.Errorf("Expected AchievementScore 10, got %d", app.AchievementScore)
+	}
 }
 
 func TestExtractCapacities(t *testing.T) {
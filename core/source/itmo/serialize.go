@@ -2,8 +2,12 @@ package itmo
 
 import (
 	"encoding/gob"
+
+	"github.com/trueegorletov/analabit/core/source"
 )
 
 func init() {
 	gob.RegisterName("ItmoHTTPHeadingSource", &HTTPHeadingSource{})
+
+	source.RegisterHeadingSourceType("itmo.http", func() source.HeadingSource { return &HTTPHeadingSource{} })
 }
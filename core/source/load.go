@@ -16,19 +16,47 @@ type VarsityDefinition struct {
 	Code           string
 	Name           string
 	HeadingSources []HeadingSource
+	// RecomputeRatings makes the calculator rank applicants by the official tie-break rules instead of
+	// the published rating places; needed for varsities that publish unordered lists
+	RecomputeRatings bool
+	// StagedEnrollment makes the calculator enroll BVI and quota applicants at a separate priority stage
+	// before the general competition, as the admission rules prescribe
+	StagedEnrollment bool
+	// Metadata holds the location and parent organization of the varsity
+	Metadata core.VarsityMetadata
+	// IDResolution, if set, resolves the internal student IDs the varsity publishes to canonical ones
+	// after all of its sources are loaded
+	IDResolution IDResolution
+	// LoadTimeout bounds loading all sources of the varsity, retries included; DefaultLoadTimeout if zero.
+	// The sources which haven't finished by then are cancelled and their data is missing
+	LoadTimeout time.Duration
+	// CapacityFeed, if set, fetches the capacities the varsity publishes to compare them with the registry
+	// ones before its sources are loaded
+	CapacityFeed CapacityFeedFunc
+	// CapacityFeedTotals tells that the capacity feed only publishes the total capacities of the headings
+	CapacityFeedTotals bool
+	// ApplyPublishedCapacities makes the headings whose published capacities drifted from the registry
+	// load with the published ones
+	ApplyPublishedCapacities bool
 }
 
+// DefaultLoadTimeout is the time a varsity's sources may take to load unless VarsityDefinition.LoadTimeout is set.
+const DefaultLoadTimeout = 30 * time.Minute
+
 type Varsity struct {
 	*VarsityDefinition
 	*core.VarsityCalculator
 	*VarsityDataCache
-	// MSUInternalIDs holds MSU internal ID mapping: studentID -> msuInternalID
-	MSUInternalIDs map[string]string
+	// InternalIDs maps the student IDs to the internal IDs published by the varsity, if it has IDResolution
+	InternalIDs map[string]string
 }
 
 func (v *Varsity) Prepare() {
 	v.VarsityCalculator = core.NewVarsityCalculator(v.Code, v.Name)
-	v.MSUInternalIDs = make(map[string]string)
+	v.VarsityCalculator.SetRecomputeRatings(v.RecomputeRatings)
+	v.VarsityCalculator.SetStagedEnrollment(v.StagedEnrollment)
+	v.VarsityCalculator.SetVarsityMetadata(v.VarsityDefinition.Metadata)
+	v.InternalIDs = make(map[string]string)
 
 	if v.VarsityDataCache == nil {
 		v.VarsityDataCache = NewVarsityDataCache(v.VarsityDefinition)
@@ -40,17 +68,10 @@ func (v *Varsity) Clone() *Varsity {
 		panic("trying to copy an unloaded varsity")
 	}
 
-	// Copy MSU internal IDs map
-	msuIDsCopy := make(map[string]string)
-	for k, v := range v.MSUInternalIDs {
-		msuIDsCopy[k] = v
-	}
-
 	clone := Varsity{
 		VarsityDefinition: v.VarsityDefinition,
 		VarsityCalculator: nil,
 		VarsityDataCache:  v.VarsityDataCache,
-		MSUInternalIDs:    msuIDsCopy,
 	}
 	clone.LoadFromCache()
 
@@ -80,29 +101,52 @@ func (cr *channelledReceiver) PutApplicationData(ad *ApplicationData) {
 
 func (v *Varsity) AddHeading(hd *HeadingData) {
 	v.VarsityCalculator.AddHeading(hd.Code, hd.Capacities, hd.PrettyName)
+	if len(hd.Subjects) > 0 {
+		v.VarsityCalculator.SetHeadingSubjects(hd.Code, hd.Subjects)
+	}
+	v.VarsityCalculator.SetHeadingMetadata(hd.Code, hd.Metadata.Complete(hd.PrettyName, hd.Subjects))
 }
 
 func (v *Varsity) AddApplication(ad *ApplicationData) {
-	if ad.CompetitionType > core.CompetitionBVI && !ad.OriginalSubmitted {
+	if ad.CompetitionType.IsQuota() && !ad.OriginalSubmitted {
 		return // Most of the quota guys never submit their originals, so consider only those who did
 	}
 
-	if ad.MSUInternalID != nil {
-		k, err := utils.PrepareStudentID(ad.StudentID)
-
-		if err == nil {
-			v.MSUInternalIDs[k] = *ad.MSUInternalID
+	if ad.InternalID != "" {
+		// The calculator stores student IDs prepared, so they are keyed the same way
+		if k, err := utils.PrepareStudentID(ad.StudentID); err == nil {
+			v.InternalIDs[k] = ad.InternalID
 		}
 	}
 
-	v.VarsityCalculator.AddApplication(ad.HeadingCode, ad.StudentID, ad.RatingPlace, ad.Priority, ad.CompetitionType, ad.ScoresSum)
+	details := core.ScoreDetails{
+		SubjectScores:    ad.SubjectScores,
+		AchievementScore: ad.AchievementScore,
+	}
+
+	if ad.SubQuota != "" && ad.CompetitionType == core.CompetitionTargetQuota {
+		v.VarsityCalculator.AddSubQuotaApplication(ad.HeadingCode, ad.StudentID, ad.SubQuota, ad.RatingPlace, ad.Priority, ad.ScoresSum, details)
+		return
+	}
+
+	v.VarsityCalculator.AddApplicationDetailed(ad.HeadingCode, ad.StudentID, ad.RatingPlace, ad.Priority, ad.CompetitionType, ad.ScoresSum, details)
 }
 
-// LoadFromDefinitions loads data from all given HeadingSources asynchronously, starting one goroutine per source.
+// loadFromSources loads data from all given HeadingSources asynchronously, starting one goroutine per source.
 // It sets Calculator to the clean VarsityCalculator instance and adds received data to it.
-func (v *Varsity) loadFromSources() map[string]bool {
+// The sources are cancelled when ctx is done or the varsity's LoadTimeout passes.
+func (v *Varsity) loadFromSources(ctx context.Context) map[string]bool {
 	v.Prepare()
 
+	timeout := v.LoadTimeout
+	if timeout <= 0 {
+		timeout = DefaultLoadTimeout
+	}
+	loadCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	sources, drifts, driftSources := v.checkCapacities(loadCtx)
+
 	submittedOriginals := make(map[string]bool)
 	// Mutex for submittedOriginals map, as multiple application data might be processed concurrently
 	// if the processor goroutine was parallelized. For current single processor, it's not strictly needed
@@ -161,19 +205,20 @@ func (v *Varsity) loadFromSources() map[string]bool {
 		}
 	}()
 
-	// Special handling for MSU with buffered receiver for ID resolution
-	if v.Code == "msu" {
-		// Create MSU buffered receiver
-		msuReceiver := MSUFactory.CreateMSUReceiver(receiver)
+	// Every source reports how it was loaded into its own slot
+	reports := make([]core.SourceReport, len(sources))
 
-		// Load all MSU sources into the buffered receiver with enhanced retry logic
-		for _, hs := range v.HeadingSources {
+	if v.IDResolution != nil {
+		resolvingReceiver := v.IDResolution(receiver)
+
+		// The IDs are resolved from all lists at once, so a single missing source worsens the resolution
+		// of every student: retry longer, 7 attempts with backoff delays of 10s, 30s, 60s, 120s, 240s, 300s
+		for i, hs := range sources {
 			sourceWg.Add(1)
-			go func(s HeadingSource) {
+			go func(i int, s HeadingSource) {
 				defer sourceWg.Done()
-				// Enhanced retry strategy for MSU network issues
-				// 7 attempts with longer backoff delays: 10s, 30s, 60s, 120s, 240s, 300s
-				err := Retry(func() error { return s.LoadTo(msuReceiver) }, 7, func(attempt int) time.Duration {
+				var err error
+				reports[i], err = loadSource(loadCtx, s, resolvingReceiver, 7, func(attempt int) time.Duration {
 					switch attempt {
 					case 1:
 						return 10 * time.Second
@@ -190,19 +235,21 @@ func (v *Varsity) loadFromSources() map[string]bool {
 					}
 				})
 				if err != nil {
-					slog.Error("Failed to load MSU source after enhanced retries", "error", err, "attempts", 7)
+					slog.Error("Failed to load source after retries", "varsity", v.Code, "error", err, "attempts", 7)
 				}
-			}(hs)
+			}(i, hs)
 		}
 
-		// Wait for all MSU sources to finish, then finalize ID resolution
+		// Wait for all sources to finish, then resolve the IDs of whatever was loaded; the load deadline
+		// doesn't apply to the resolution
 		sourceWg.Wait()
-		if err := msuReceiver.Finalize(context.Background()); err != nil {
-			slog.Error("Failed to finalize MSU ID resolution", "error", err)
+		if err := resolvingReceiver.Finalize(ctx); err != nil {
+			slog.Error("Failed to resolve internal student IDs", "varsity", v.Code, "error", err)
 		}
 	} else if v.Code == "spbsu" {
-		for _, hs := range v.HeadingSources {
-			err := Retry(func() error { return hs.LoadTo(receiver) }, 3, func(attempt int) time.Duration {
+		for i, hs := range sources {
+			var err error
+			reports[i], err = loadSource(loadCtx, hs, receiver, 3, func(attempt int) time.Duration {
 				return time.Duration(math.Pow(2, float64(attempt-1))) * 10 * time.Second
 			})
 			if err != nil {
@@ -210,27 +257,37 @@ func (v *Varsity) loadFromSources() map[string]bool {
 			}
 		}
 	} else {
-		for _, hs := range v.HeadingSources {
+		for i, hs := range sources {
 			sourceWg.Add(1)
-			go func(s HeadingSource) {
+			go func(i int, s HeadingSource) {
 				defer sourceWg.Done()
-				err := Retry(func() error { return s.LoadTo(receiver) }, 3, func(attempt int) time.Duration {
+				var err error
+				reports[i], err = loadSource(loadCtx, s, receiver, 3, func(attempt int) time.Duration {
 					return time.Duration(math.Pow(2, float64(attempt-1))) * 10 * time.Second
 				})
 				if err != nil {
 					slog.Error("Failed to load source after retries", "error", err)
 				}
-			}(hs)
+			}(i, hs)
 		}
 	}
 
-	// For non-MSU sources, wait for completion here
-	if v.Code != "msu" {
-		sourceWg.Wait() // Wait for all sources to finish sending data
+	sourceWg.Wait() // Wait for all sources to finish sending data
+	if err := loadCtx.Err(); err != nil {
+		slog.Error("Loading varsity sources was cut short, the data is incomplete", "varsity", v.Code, "error", err)
 	}
 	close(headingDataChan)     // Close data channels
 	close(applicationDataChan) //
 	processingWg.Wait()        // Wait for the processor goroutine to finish all writes
+	v.SourceReports = reports
+
+	// The drifts are told apart by the headings their sources sent
+	for j, i := range driftSources {
+		if codes := reports[i].HeadingCodes; len(codes) == 1 {
+			drifts[j].HeadingCode = codes[0]
+		}
+	}
+	v.CapacityDrifts = drifts
 
 	// After all applications are loaded, normalize them.
 	v.VarsityCalculator.NormalizeApplications()
@@ -324,7 +381,9 @@ func loadAll(varsities []*Varsity, loadFunc func(*Varsity) map[string]bool) ([]*
 	return varsities, studentOriginals
 }
 
-func LoadFromDefinitions(defs []VarsityDefinition) []*Varsity {
+// LoadFromDefinitions loads the varsities of the given definitions from their sources. The loading is
+// cancelled when ctx is done, and every varsity's sources are bounded by its LoadTimeout.
+func LoadFromDefinitions(ctx context.Context, defs []VarsityDefinition) []*Varsity {
 	log.Printf("🔍 LOAD DEBUG: LoadFromDefinitions called with %d definitions", len(defs))
 
 	var varsities []*Varsity
@@ -347,13 +406,16 @@ func LoadFromDefinitions(defs []VarsityDefinition) []*Varsity {
 	}
 
 	vs, _ := loadAll(varsities, func(v *Varsity) map[string]bool {
-		return v.loadFromSources()
+		return v.loadFromSources(ctx)
 	})
 
 	return vs
 }
 
-func LoadWithCaches(defs []VarsityDefinition, caches []*VarsityDataCache) ([]*Varsity, bool) {
+// LoadWithCaches loads the varsities of the given definitions from the caches, loading the ones missing from
+// the caches from their sources like LoadFromDefinitions. The returned flag tells whether the caches covered
+// all definitions.
+func LoadWithCaches(ctx context.Context, defs []VarsityDefinition, caches []*VarsityDataCache) ([]*Varsity, bool) {
 	codeToCache := make(map[string]*VarsityDataCache)
 	for _, cache := range caches {
 		codeToCache[cache.Definition.Code] = cache
@@ -381,7 +443,7 @@ func LoadWithCaches(defs []VarsityDefinition, caches []*VarsityDataCache) ([]*Va
 	})
 
 	added, addedOrigs := loadAll(newVarsities, func(v *Varsity) map[string]bool {
-		return v.loadFromSources()
+		return v.loadFromSources(ctx)
 	})
 
 	removedFromCached := make(map[string]bool)
@@ -0,0 +1,113 @@
+package mephi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"golang.org/x/net/html"
+
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/source"
+	"github.com/trueegorletov/analabit/core/source/flaresolverr"
+)
+
+func init() {
+	source.RegisterCapacityFeedType("mephi.kcp", func() source.CapacityFeed { return &CapacityFeed{} })
+}
+
+// CapacityFeed fetches the control numbers MEPhI publishes in its registry of headings and capacities,
+// the page ParseCapacitiesRegistry parses.
+type CapacityFeed struct {
+	URL string
+}
+
+// PublishesTotals implements source.TotalCapacityFeed: MEPhI only publishes the total capacities.
+func (f *CapacityFeed) PublishesTotals() bool {
+	return true
+}
+
+// FetchCapacities implements source.CapacityFeed for the MEPhI heading sources, returning the published
+// totals as the Regular capacities.
+func (f *CapacityFeed) FetchCapacities(ctx context.Context, sources []source.HeadingSource) (map[int]core.Capacities, error) {
+	if f.URL == "" {
+		return nil, fmt.Errorf("URL of the capacities registry is required for the MEPhI capacity feed")
+	}
+
+	totals, err := fetchCapacitiesRegistry(ctx, f.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	published := make(map[int]core.Capacities)
+	for i, hs := range sources {
+		s, ok := hs.(*HTTPHeadingSource)
+		if !ok {
+			continue
+		}
+		total, ok := totals[s.HeadingName]
+		if !ok {
+			total, ok = totals[CapacitiesRegistryName(s.HeadingName)]
+		}
+		if !ok || total == 0 {
+			continue
+		}
+		published[i] = core.Capacities{Regular: total}
+	}
+	return published, nil
+}
+
+func fetchCapacitiesRegistry(ctx context.Context, url string) (map[string]int, error) {
+	release, err := source.AcquireHTTPSemaphores(ctx, "mephi")
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire semaphores for %s: %w", url, err)
+	}
+	defer release()
+
+	if err := source.WaitBeforeHTTPRequest("mephi", ctx); err != nil {
+		return nil, fmt.Errorf("timeout coordination failed for %s: %w", url, err)
+	}
+
+	fsResp, err := flaresolverr.SafeGetWithDomain(url, getMephiHeaders())
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch URL %s via FlareSolverr: %w", url, err)
+	}
+	if fsResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("HTTP error %d for URL %s", fsResp.StatusCode, url)
+	}
+
+	doc, err := html.Parse(strings.NewReader(fsResp.Body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse HTML from %s: %w", url, err)
+	}
+	return ParseCapacitiesRegistry(doc)
+}
+
+// capacitiesRegistryNames maps the heading names of the lists registry to the differently written ones of
+// the capacities registry.
+var capacitiesRegistryNames = map[string]string{
+	"Применение и эксплуатация автоматизированных систем специального назначения специалитет": "Применение и эксплуатация автом. систем специального назначения",
+	"Системный анализ и управление": "Системный анализ\u00a0 и управление",
+}
+
+// CapacitiesRegistryName returns the name a heading is written with in the capacities registry, given
+// the one of the lists registry the heading sources are named by.
+func CapacitiesRegistryName(name string) string {
+	if canonical, ok := capacitiesRegistryNames[name]; ok {
+		return canonical
+	}
+	return name
+}
+
+// EstimateCapacities splits the total capacity of a heading into the competitions: MEPhI only publishes
+// the totals, so every quota is estimated as 10% of the total and the rest is regular.
+func EstimateCapacities(total int) core.Capacities {
+	quota := total / 10
+	return core.Capacities{
+		Regular:        max(total-3*quota, 0),
+		TargetQuota:    quota,
+		DedicatedQuota: quota,
+		SpecialQuota:   quota,
+	}
+}
@@ -200,8 +200,9 @@ func ParseListLinksRegistry(doc *html.Node) (map[string]map[core.Competition][]s
 	return result, nil
 }
 
-// ParseApplicationList parses the MEPhI application list HTML to extract application data.
-func ParseApplicationList(doc *html.Node, competitionType core.Competition) ([]*source.ApplicationData, error) {
+// ParseApplicationList parses the MEPhI application list HTML to extract application data. The rows without
+// a student ID are skipped and reported to the receiver, which may be nil.
+func ParseApplicationList(doc *html.Node, competitionType core.Competition, receiver source.DataReceiver) ([]*source.ApplicationData, error) {
 	var applications []*source.ApplicationData
 
 	// Find the rating table
@@ -248,6 +249,7 @@ func ParseApplicationList(doc *html.Node, competitionType core.Competition) ([]*
 		// Extract student ID from fourth cell (ID участника на ЕПГУ)
 		studentIDText := strings.TrimSpace(getTextContent(cells[3]))
 		if studentIDText == "" {
+			source.SkipRow(receiver, fmt.Errorf("empty student ID in row %d", ratingPlace+1))
 			continue
 		}
 
@@ -260,6 +262,15 @@ func ParseApplicationList(doc *html.Node, competitionType core.Competition) ([]*
 			}
 		}
 
+		// Extract the entrance exam scores sum from sixth cell (Баллы за экзамены). The list shows only the
+		// sum, the scores by subject are loaded on click, so the rest of the total are the achievement points
+		achievementScore := 0
+		if examMarks := findAllNodes(cells[5], "span", "class", "sumMark"); scoresSum != nil && len(examMarks) > 0 {
+			if examSum, err := strconv.Atoi(strings.TrimSpace(getTextContent(examMarks[0]))); err == nil && examSum <= *scoresSum {
+				achievementScore = *scoresSum - examSum
+			}
+		}
+
 		// Extract document status from eighth cell (Документы)
 		documentStatus := strings.TrimSpace(getTextContent(cells[7]))
 		originalSubmitted := strings.Contains(documentStatus, "Согласие подано")
@@ -290,6 +301,7 @@ func ParseApplicationList(doc *html.Node, competitionType core.Competition) ([]*
 		application := &source.ApplicationData{
 			StudentID:         studentIDText,
 			ScoresSum:         scoreValue,
+			AchievementScore:  achievementScore,
 			Priority:          priorityValue,
 			OriginalSubmitted: originalSubmitted,
 			CompetitionType:   actualCompetitionType,
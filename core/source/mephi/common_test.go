@@ -0,0 +1,34 @@
+package mephi
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/trueegorletov/analabit/core"
+	"golang.org/x/net/html"
+)
+
+func TestParseApplicationList_AchievementScore(t *testing.T) {
+	f, err := os.Open(filepath.Join("..", "..", "..", "sample_data", "mephi", "mephi_applications_list_body_table_example.html"))
+	require.NoError(t, err)
+	defer f.Close()
+
+	doc, err := html.Parse(f)
+	require.NoError(t, err)
+
+	applications, err := ParseApplicationList(doc, core.CompetitionRegular, nil)
+	require.NoError(t, err)
+
+	byID := make(map[string]int)
+	for _, app := range applications {
+		if app.CompetitionType == core.CompetitionRegular {
+			byID[app.StudentID] = app.AchievementScore
+		}
+	}
+
+	assert.Equal(t, 10, byID["4057656"], "310 total with 300 exam points")
+	assert.Equal(t, 7, byID["3835374"], "307 total with 300 exam points")
+}
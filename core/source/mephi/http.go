@@ -35,7 +35,7 @@ type HTTPHeadingSource struct {
 }
 
 // LoadTo implements the source.HeadingSource interface for MEPhI HTTP heading sources.
-func (s *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
+func (s *HTTPHeadingSource) LoadTo(ctx context.Context, receiver source.DataReceiver) error {
 	// Generate heading code from the pretty name
 	headingCode := utils.GenerateHeadingCode(s.HeadingName)
 
@@ -56,7 +56,7 @@ func (s *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
 
 	for competitionType, urls := range competitionURLs {
 		for _, url := range urls {
-			if err := s.loadApplicationsFromURL(receiver, headingCode, url, competitionType); err != nil {
+			if err := s.loadApplicationsFromURL(ctx, receiver, headingCode, url, competitionType); err != nil {
 				return fmt.Errorf("failed to load applications from %s: %w", url, err)
 			}
 		}
@@ -84,8 +84,7 @@ func getMephiHeaders() map[string]string {
 }
 
 // loadApplicationsFromURL fetches and parses applications from a single URL.
-func (s *HTTPHeadingSource) loadApplicationsFromURL(receiver source.DataReceiver, headingCode string, url string, competitionType core.Competition) error {
-	ctx := context.Background()
+func (s *HTTPHeadingSource) loadApplicationsFromURL(ctx context.Context, receiver source.DataReceiver, headingCode string, url string, competitionType core.Competition) error {
 	release, err := source.AcquireHTTPSemaphores(ctx, "mephi")
 	if err != nil {
 		return fmt.Errorf("failed to acquire semaphores for %s: %w", url, err)
@@ -119,12 +118,16 @@ func (s *HTTPHeadingSource) loadApplicationsFromURL(receiver source.DataReceiver
 		}
 
 		// Parse applications from the HTML
-		applications, err = ParseApplicationList(doc, competitionType)
+		applications, err = ParseApplicationList(doc, competitionType, receiver)
 		if err != nil {
 			return fmt.Errorf("failed to parse applications from %s: %w", url, err)
 		}
 	}
 
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	// Send applications to the receiver
 	for _, app := range applications {
 		app.HeadingCode = headingCode
@@ -2,8 +2,12 @@ package mephi
 
 import (
 	"encoding/gob"
+
+	"github.com/trueegorletov/analabit/core/source"
 )
 
 func init() {
 	gob.RegisterName("MephiHTTPHeadingSource", &HTTPHeadingSource{})
+
+	source.RegisterHeadingSourceType("mephi.http", func() source.HeadingSource { return &HTTPHeadingSource{} })
 }
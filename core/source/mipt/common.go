@@ -77,19 +77,35 @@ type tableFormat struct {
 	studentIDColumn   int
 	priorityColumn    int
 	totalScoreColumn  int
+	achievementColumn int
 	bviColumn         int
 	consentColumn     int
+	subjectColumns    []int
 }
 
 // detectTableFormat returns a hardcoded table format for the new MIPT layout.
+// The subject columns depend on the heading, so they are found in the header:
+// every subject score column is followed by its "ВИ (<subject>)" column.
 func detectTableFormat(headerRow *html.Node) *tableFormat {
-	return &tableFormat{
+	format := &tableFormat{
 		studentIDColumn:   2,  // "Уникальный код"
 		priorityColumn:    1,  // Priority is the 2nd column
 		totalScoreColumn:  5,  // "Сумма баллов"
+		achievementColumn: 7,  // "Сумма баллов за инд.дост.(конкурсные)"
 		bviColumn:         16, // "Без вступительных испытаний"
 		consentColumn:     11, // "Согласие на зачисление"
 	}
+
+	headers := extractTableCells(headerRow)
+	for i := 0; i+1 < len(headers); i++ {
+		subject := strings.TrimSpace(getTextContent(headers[i]))
+		next := strings.TrimSpace(getTextContent(headers[i+1]))
+		if subject != "" && next == "ВИ ("+subject+")" {
+			format.subjectColumns = append(format.subjectColumns, i)
+		}
+	}
+
+	return format
 }
 
 // parseApplicantFromTableRow extracts application data from a MIPT table row.
@@ -127,6 +143,29 @@ func parseApplicantFromTableRow(row *html.Node, defaultCompetitionType core.Comp
 		}
 	}
 
+	// Column with the individual achievement points
+	var achievementScore int
+	if format.achievementColumn < len(cells) {
+		achievementText := strings.TrimSpace(getTextContent(cells[format.achievementColumn]))
+		if scoreRegex.MatchString(achievementText) {
+			achievementScore, _ = strconv.Atoi(achievementText)
+		}
+	}
+
+	// Subject score columns, in the order of the entrance exams' priority
+	var subjectScores []int
+	for _, column := range format.subjectColumns {
+		if column >= len(cells) {
+			break
+		}
+		subjectText := strings.TrimSpace(getTextContent(cells[column]))
+		if !scoreRegex.MatchString(subjectText) {
+			break
+		}
+		subjectScore, _ := strconv.Atoi(subjectText)
+		subjectScores = append(subjectScores, subjectScore)
+	}
+
 	// Column with Priority
 	priority := 1 // Default priority
 	if format.priorityColumn < len(cells) {
@@ -160,6 +199,8 @@ func parseApplicantFromTableRow(row *html.Node, defaultCompetitionType core.Comp
 		Priority:          priority,
 		CompetitionType:   competitionType,
 		ScoresSum:         scoresSum,
+		AchievementScore:  achievementScore,
+		SubjectScores:     subjectScores,
 		OriginalSubmitted: originalSubmitted,
 	}, nil
 }
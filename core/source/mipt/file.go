@@ -1,6 +1,7 @@
 package mipt
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -20,7 +21,7 @@ type FileHeadingSource struct {
 }
 
 // LoadTo parses MIPT application data from a local HTML file
-func (f *FileHeadingSource) LoadTo(receiver source.DataReceiver) error {
+func (f *FileHeadingSource) LoadTo(_ context.Context, receiver source.DataReceiver) error {
 	if f.FilePath == "" {
 		return fmt.Errorf("FilePath is required for MIPT FileHeadingSource")
 	}
@@ -117,6 +118,7 @@ func (f *FileHeadingSource) parseApplicationsFromTable(doc *html.Node, receiver
 		app, err := parseApplicantFromTableRow(row, competitionType, format)
 		if err != nil {
 			errors = append(errors, fmt.Sprintf("row %d: %v", i+1, err))
+			source.SkipRow(receiver, err)
 			continue
 		}
 
@@ -24,15 +24,13 @@ type HTTPHeadingSource struct {
 	Capacities            core.Capacities `json:"capacities"`
 }
 
-// fetchMiptListByURL fetches and parses the MIPT HTML list from a URL.
-func fetchMiptListByURL(listURL string, competitionType core.Competition) ([]*source.ApplicationData, error) {
+// fetchMiptListByURL fetches and parses the MIPT HTML list from a URL, telling the receiver of the rows it
+// skips.
+func fetchMiptListByURL(ctx context.Context, listURL string, competitionType core.Competition, receiver source.DataReceiver) ([]*source.ApplicationData, error) {
 	if listURL == "" {
 		return nil, nil
 	}
 
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-
 	release, err := source.AcquireHTTPSemaphores(ctx, "mipt")
 	if err != nil {
 		return nil, fmt.Errorf("failed to acquire semaphores for %s: %w", listURL, err)
@@ -44,7 +42,12 @@ func fetchMiptListByURL(listURL string, competitionType core.Competition) ([]*so
 		return nil, fmt.Errorf("timeout coordination failed for %s: %w", listURL, err)
 	}
 
-	resp, err := http.Get(listURL)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, listURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request for %s: %w", listURL, err)
+	}
+
+	resp, err := source.HTTPClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to download %s: %w", listURL, err)
 	}
@@ -67,11 +70,11 @@ func fetchMiptListByURL(listURL string, competitionType core.Competition) ([]*so
 	}
 
 	// Extract applications from the table
-	return parseApplicationsFromHTML(doc, competitionType)
+	return parseApplicationsFromHTML(doc, competitionType, receiver)
 }
 
 // parseApplicationsFromHTML extracts applications from MIPT HTML document
-func parseApplicationsFromHTML(doc *html.Node, defaultCompetitionType core.Competition) ([]*source.ApplicationData, error) {
+func parseApplicationsFromHTML(doc *html.Node, defaultCompetitionType core.Competition, receiver source.DataReceiver) ([]*source.ApplicationData, error) {
 	// Find the header row to detect the table format
 	headerRow, tableNode := findTableHeaderRow(doc)
 	if headerRow == nil {
@@ -93,6 +96,7 @@ func parseApplicationsFromHTML(doc *html.Node, defaultCompetitionType core.Compe
 		app, err := parseApplicantFromTableRow(row, defaultCompetitionType, format)
 		if err != nil {
 			errors = append(errors, fmt.Sprintf("row %d: %v", i+2, err)) // i+2 because we skip the header
+			source.SkipRow(receiver, err)
 			continue
 		}
 		applications = append(applications, app)
@@ -109,7 +113,7 @@ func parseApplicationsFromHTML(doc *html.Node, defaultCompetitionType core.Compe
 }
 
 // LoadTo implements source.HeadingSource for HTTPHeadingSource.
-func (s *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
+func (s *HTTPHeadingSource) LoadTo(ctx context.Context, receiver source.DataReceiver) error {
 	if s.PrettyName == "" {
 		return fmt.Errorf("PrettyName is required for MIPT HTTPHeadingSource")
 	}
@@ -138,9 +142,10 @@ func (s *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
 			continue
 		}
 
-		applications, err := fetchMiptListByURL(config.URL, config.Competition)
+		applications, err := fetchMiptListByURL(ctx, config.URL, config.Competition, receiver)
 		if err != nil {
 			log.Printf("Error fetching %s (%s): %v", config.ListName, config.URL, err)
+			source.FailList(receiver, fmt.Errorf("%s: %w", config.ListName, err))
 			continue
 		}
 
@@ -162,9 +167,10 @@ func (s *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
 			continue
 		}
 
-		applications, err := fetchMiptListByURL(listURL, core.CompetitionTargetQuota)
+		applications, err := fetchMiptListByURL(ctx, listURL, core.CompetitionTargetQuota, receiver)
 		if err != nil {
 			log.Printf("Error fetching Target Quota List %d (%s): %v", i+1, listURL, err)
+			source.FailList(receiver, fmt.Errorf("Target Quota List %d: %w", i+1, err))
 			continue
 		}
 
@@ -180,5 +186,6 @@ func (s *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
 		}
 	}
 
-	return nil
+	// Failed lists are skipped, but not when the whole load is cancelled
+	return ctx.Err()
 }
@@ -2,10 +2,12 @@ package mipt
 
 import (
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/trueegorletov/analabit/core"
 	"golang.org/x/net/html"
 )
@@ -20,7 +22,7 @@ func TestParseNewTableFormat(t *testing.T) {
 	assert.NoError(t, err)
 
 	// Parse the applications
-	applications, err := parseApplicationsFromHTML(doc, core.CompetitionRegular)
+	applications, err := parseApplicationsFromHTML(doc, core.CompetitionRegular, nil)
 	assert.NoError(t, err)
 
 	// Validate the parsed data
@@ -31,4 +33,27 @@ func TestParseNewTableFormat(t *testing.T) {
 		app := applications[i]
 		t.Logf("App %d: ID=%s, Priority=%d, Original=%v, Competition=%s", i+1, app.StudentID, app.Priority, app.OriginalSubmitted, app.CompetitionType)
 	}
-}
\ No newline at end of file
+}
+func TestParseNewTableFormat_Scores(t *testing.T) {
+	htmlContent, err := os.ReadFile(filepath.Join("..", "..", "..", "sample_data", "mipt", "mipt-NEW-TABLE-FORMAT.html"))
+	require.NoError(t, err)
+
+	doc, err := html.Parse(strings.NewReader(string(htmlContent)))
+	require.NoError(t, err)
+
+	applications, err := parseApplicationsFromHTML(doc, core.CompetitionRegular, nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, applications)
+
+	first := applications[0]
+	assert.Equal(t, "3847475", first.StudentID)
+	assert.Equal(t, 307, first.ScoresSum)
+	assert.Equal(t, 10, first.AchievementScore)
+	assert.Equal(t, []int{100, 100, 97}, first.SubjectScores)
+
+	for _, app := range applications {
+		if len(app.SubjectScores) == 3 {
+			assert.Equal(t, app.ScoresSum, app.AchievementScore+app.SubjectScores[0]+app.SubjectScores[1]+app.SubjectScores[2], app.StudentID)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package mipt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"testing"
@@ -18,7 +19,7 @@ func testOriginalDocumentDetection() error {
 	log.Printf("Fetching MIPT applications to debug originalSubmitted field from LIVE SITE...")
 
 	// Use fetchMiptListByURL to get applications with proper originalSubmitted detection
-	fetchedApps, err := fetchMiptListByURL(testURL, core.CompetitionRegular)
+	fetchedApps, err := fetchMiptListByURL(context.Background(), testURL, core.CompetitionRegular, nil)
 	if err != nil {
 		return fmt.Errorf("failed to fetch applications with fetchMiptListByURL: %v", err)
 	}
@@ -2,9 +2,14 @@ package mipt
 
 import (
 	"encoding/gob"
+
+	"github.com/trueegorletov/analabit/core/source"
 )
 
 func init() {
 	gob.RegisterName("MiptFileHeadingSource", &FileHeadingSource{})
 	gob.RegisterName("MiptHTTPHeadingSource", &HTTPHeadingSource{})
+
+	source.RegisterHeadingSourceType("mipt.file", func() source.HeadingSource { return &FileHeadingSource{} })
+	source.RegisterHeadingSourceType("mipt.http", func() source.HeadingSource { return &HTTPHeadingSource{} })
 }
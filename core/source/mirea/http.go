@@ -58,10 +58,12 @@ type HTTPHeadingSource struct {
 	TargetQuotaListIDs    []string
 	DedicatedQuotaListIDs []string
 	SpecialQuotaListIDs   []string
+	PaidListIDs           []string             // Contract lists, their places go to Capacities.Paid
+	Metadata              core.HeadingMetadata // Direction code from the lists registry
 }
 
 // fetchMireaListByID fetches and decodes a single MIREA list using FlareSolverr
-func fetchMireaListByID(listID string) (*MireaListResponse, error) {
+func fetchMireaListByID(ctx context.Context, listID string) (*MireaListResponse, error) {
 	if listID == "" {
 		return nil, nil
 	}
@@ -69,10 +71,6 @@ func fetchMireaListByID(listID string) (*MireaListResponse, error) {
 	// Build API URL
 	apiURL := "https://priem.mirea.ru/competitions_api/entrants?competitions[]=" + listID
 
-	// Create context with timeout to prevent deadlocks
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-
 	// Acquire semaphore for rate limiting
 	release, err := source.AcquireHTTPSemaphores(ctx, "mirea")
 	if err != nil {
@@ -128,7 +126,7 @@ func fetchMireaListByID(listID string) (*MireaListResponse, error) {
 
 
 // fetchOrGetCachedMireaList fetches a MIREA list or returns it from cache
-func fetchOrGetCachedMireaList(listID string, cache map[string]*MireaListResponse) (*MireaListResponse, error) {
+func fetchOrGetCachedMireaList(ctx context.Context, listID string, cache map[string]*MireaListResponse) (*MireaListResponse, error) {
 	if listID == "" {
 		return nil, nil
 	}
@@ -142,7 +140,7 @@ func fetchOrGetCachedMireaList(listID string, cache map[string]*MireaListRespons
 			log.Printf("MIREA: Cached ID mismatch for %s (requested: %s, cached: %s), bypassing cache", apiURL, listID, cached.Data[0].ID)
 			// Remove invalid cache entry and fetch fresh
 			delete(cache, listID)
-			resp, err := fetchMireaListByID(listID)
+			resp, err := fetchMireaListByID(ctx, listID)
 			if err != nil {
 				return nil, err
 			}
@@ -153,7 +151,7 @@ func fetchOrGetCachedMireaList(listID string, cache map[string]*MireaListRespons
 	}
 
 	// Not in cache, fetch from API
-	resp, err := fetchMireaListByID(listID)
+	resp, err := fetchMireaListByID(ctx, listID)
 	if err != nil {
 		return nil, err
 	}
@@ -181,7 +179,7 @@ func validateListForHeading(resp *MireaListResponse, listID string, expectedHead
 }
 
 // LoadTo implements source.HeadingSource for HTTPHeadingSource.
-func (s *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
+func (s *HTTPHeadingSource) LoadTo(ctx context.Context, receiver source.DataReceiver) error {
 	// Initialize response cache to avoid duplicate requests
 	listResponseCache := make(map[string]*MireaListResponse)
 
@@ -192,12 +190,12 @@ func (s *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
 	}
 
 	// Extract metadata deterministically - prioritize Regular lists for consistent heading names
-	var regularCapacity, targetQuotaCapacity, dedicatedQuotaCapacity, specialQuotaCapacity int
+	var regularCapacity, targetQuotaCapacity, dedicatedQuotaCapacity, specialQuotaCapacity, paidCapacity int
 
 	// Calculate capacities
 	// Regular/BVI: use the same capacity value
 	if len(s.RegularListIDs) > 0 && s.RegularListIDs[0] != "" {
-		resp, err := fetchOrGetCachedMireaList(s.RegularListIDs[0], listResponseCache)
+		resp, err := fetchOrGetCachedMireaList(ctx, s.RegularListIDs[0], listResponseCache)
 		if err == nil && resp != nil && len(resp.Data) > 0 {
 			if validateListForHeading(resp, s.RegularListIDs[0], prettyName) == nil {
 				regularCapacity = resp.Data[0].Plan
@@ -208,7 +206,7 @@ func (s *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
 	// TargetQuota: sum capacities from all lists
 	for _, listID := range s.TargetQuotaListIDs {
 		if listID != "" {
-			resp, err := fetchOrGetCachedMireaList(listID, listResponseCache)
+			resp, err := fetchOrGetCachedMireaList(ctx, listID, listResponseCache)
 			if err == nil && resp != nil && len(resp.Data) > 0 {
 				if validateListForHeading(resp, listID, prettyName) == nil {
 					targetQuotaCapacity += resp.Data[0].Plan
@@ -220,7 +218,7 @@ func (s *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
 	// DedicatedQuota: sum capacities
 	for _, listID := range s.DedicatedQuotaListIDs {
 		if listID != "" {
-			resp, err := fetchOrGetCachedMireaList(listID, listResponseCache)
+			resp, err := fetchOrGetCachedMireaList(ctx, listID, listResponseCache)
 			if err == nil && resp != nil && len(resp.Data) > 0 {
 				if validateListForHeading(resp, listID, prettyName) == nil {
 					dedicatedQuotaCapacity += resp.Data[0].Plan
@@ -232,7 +230,7 @@ func (s *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
 	// SpecialQuota: sum capacities
 	for _, listID := range s.SpecialQuotaListIDs {
 		if listID != "" {
-			resp, err := fetchOrGetCachedMireaList(listID, listResponseCache)
+			resp, err := fetchOrGetCachedMireaList(ctx, listID, listResponseCache)
 			if err == nil && resp != nil && len(resp.Data) > 0 {
 				if validateListForHeading(resp, listID, prettyName) == nil {
 					specialQuotaCapacity += resp.Data[0].Plan
@@ -241,8 +239,25 @@ func (s *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
 		}
 	}
 
+	// Paid: sum capacities
+	for _, listID := range s.PaidListIDs {
+		if listID != "" {
+			resp, err := fetchOrGetCachedMireaList(ctx, listID, listResponseCache)
+			if err == nil && resp != nil && len(resp.Data) > 0 {
+				if validateListForHeading(resp, listID, prettyName) == nil {
+					paidCapacity += resp.Data[0].Plan
+				}
+			}
+		}
+	}
+
 	headingCode := utils.GenerateHeadingCode(prettyName)
 
+	// The capacities are incomplete if the lists failed to load because of cancellation
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	// Send heading data
 	receiver.PutHeadingData(&source.HeadingData{
 		Code: headingCode,
@@ -251,8 +266,10 @@ func (s *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
 			TargetQuota:    targetQuotaCapacity,
 			DedicatedQuota: dedicatedQuotaCapacity,
 			SpecialQuota:   specialQuotaCapacity,
+			Paid:           paidCapacity,
 		},
 		PrettyName: prettyName,
+		Metadata:   s.Metadata,
 	})
 
 	log.Printf("MIREA: Sent MIREA heading %s", prettyName)
@@ -263,9 +280,10 @@ func (s *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
 		if listID == "" {
 			continue
 		}
-		resp, err := fetchOrGetCachedMireaList(listID, listResponseCache)
+		resp, err := fetchOrGetCachedMireaList(ctx, listID, listResponseCache)
 		if err != nil {
-			continue // Skip failed requests
+			source.FailList(receiver, fmt.Errorf("list %s: %w", listID, err))
+			continue
 		}
 		if resp == nil || len(resp.Data) == 0 {
 			continue
@@ -281,9 +299,10 @@ func (s *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
 		if listID == "" {
 			continue
 		}
-		resp, err := fetchOrGetCachedMireaList(listID, listResponseCache)
+		resp, err := fetchOrGetCachedMireaList(ctx, listID, listResponseCache)
 		if err != nil {
-			continue // Skip failed requests
+			source.FailList(receiver, fmt.Errorf("list %s: %w", listID, err))
+			continue
 		}
 		if resp == nil || len(resp.Data) == 0 {
 			continue
@@ -299,9 +318,10 @@ func (s *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
 		if listID == "" {
 			continue
 		}
-		resp, err := fetchOrGetCachedMireaList(listID, listResponseCache)
+		resp, err := fetchOrGetCachedMireaList(ctx, listID, listResponseCache)
 		if err != nil {
-			continue // Skip failed requests
+			source.FailList(receiver, fmt.Errorf("list %s: %w", listID, err))
+			continue
 		}
 		if resp == nil || len(resp.Data) == 0 {
 			continue
@@ -317,9 +337,10 @@ func (s *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
 		if listID == "" {
 			continue
 		}
-		resp, err := fetchOrGetCachedMireaList(listID, listResponseCache)
+		resp, err := fetchOrGetCachedMireaList(ctx, listID, listResponseCache)
 		if err != nil {
-			continue // Skip failed requests
+			source.FailList(receiver, fmt.Errorf("list %s: %w", listID, err))
+			continue
 		}
 		if resp == nil || len(resp.Data) == 0 {
 			continue
@@ -335,9 +356,10 @@ func (s *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
 		if listID == "" {
 			continue
 		}
-		resp, err := fetchOrGetCachedMireaList(listID, listResponseCache)
+		resp, err := fetchOrGetCachedMireaList(ctx, listID, listResponseCache)
 		if err != nil {
-			continue // Skip failed requests
+			source.FailList(receiver, fmt.Errorf("list %s: %w", listID, err))
+			continue
 		}
 		if resp == nil || len(resp.Data) == 0 {
 			continue
@@ -348,9 +370,26 @@ func (s *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
 		parseAndLoadApplications(resp.Data[0].Entrants, core.CompetitionSpecialQuota, headingCode, receiver)
 	}
 
+	// Paid Lists
+	for _, listID := range s.PaidListIDs {
+		if listID == "" {
+			continue
+		}
+		resp, err := fetchOrGetCachedMireaList(ctx, listID, listResponseCache)
+		if err != nil {
+			source.FailList(receiver, fmt.Errorf("list %s: %w", listID, err))
+			continue
+		}
+		if resp == nil || len(resp.Data) == 0 {
+			continue
+		}
+		if validateListForHeading(resp, listID, prettyName) != nil {
+			continue // Skip lists with heading name mismatch
+		}
+		parseAndLoadApplications(resp.Data[0].Entrants, core.CompetitionPaid, headingCode, receiver)
+	}
+
 	// Add random delay at the end after all sources are loaded (0.05s to 0.15s)
 	randomDelay := time.Duration(50+rand.Intn(100)) * time.Millisecond
-	time.Sleep(randomDelay)
-
-	return nil
+	return source.Sleep(ctx, randomDelay)
 }
@@ -2,8 +2,12 @@ package mirea
 
 import (
 	"encoding/gob"
+
+	"github.com/trueegorletov/analabit/core/source"
 )
 
 func init() {
 	gob.Register(&HTTPHeadingSource{})
-}
\ No newline at end of file
+
+	source.RegisterHeadingSourceType("mirea.http", func() source.HeadingSource { return &HTTPHeadingSource{} })
+}
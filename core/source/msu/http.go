@@ -48,6 +48,8 @@ type HTTPHeadingSource struct {
 	// TargetQuotaAnchors holds anchor ids for all target quota lists,
 	// including detalisised target quotas.  Applicants from all such
 	// lists are parsed with CompetitionType set to CompetitionTargetQuota.
+	// A list whose anchor is a key of Capacities.TargetSubQuotas is a
+	// detailed target quota: its applicants compete only within it.
 	TargetQuotaAnchors []string
 	// DedicatedQuotaAnchors holds anchor ids for all dedicated quota lists.
 	DedicatedQuotaAnchors []string
@@ -66,9 +68,9 @@ type HTTPHeadingSource struct {
 // applications are silently skipped, allowing the remaining lists to
 // continue.  A non‑nil error is returned only if the faculty page itself
 // cannot be retrieved or parsed.
-func (hs *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
+func (hs *HTTPHeadingSource) LoadTo(ctx context.Context, receiver source.DataReceiver) error {
 	// Acquire semaphore slots for rate limiting
-	ctx, cancel := context.WithTimeout(context.Background(), 8*time.Minute) // Increased timeout
+	ctx, cancel := context.WithTimeout(ctx, 8*time.Minute) // Increased timeout
 	defer cancel()
 
 	release, err := source.AcquireHTTPSemaphores(ctx, "msu")
@@ -87,9 +89,7 @@ func (hs *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
 	receiver.PutHeadingData(hd)
 
 	// Create HTTP client with longer timeout for network resilience
-	client := &http.Client{
-		Timeout: 7 * time.Minute, // Individual request timeout
-	}
+	client := source.NewHTTPClient(7 * time.Minute) // Individual request timeout
 
 	// fetch the faculty page with context and enhanced error logging
 	req, err := http.NewRequestWithContext(ctx, "GET", hs.FacultyURL, nil)
@@ -119,6 +119,7 @@ func (hs *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
 	type listDesc struct {
 		anchor      string
 		competition core.Competition
+		subQuota    string
 	}
 	lists := make([]listDesc, 0)
 	// order matters here: BVI is processed before regular so that the
@@ -131,7 +132,11 @@ func (hs *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
 	}
 	for _, a := range hs.TargetQuotaAnchors {
 		if strings.TrimSpace(a) != "" {
-			lists = append(lists, listDesc{anchor: a, competition: core.CompetitionTargetQuota})
+			ld := listDesc{anchor: a, competition: core.CompetitionTargetQuota}
+			if _, ok := hs.Capacities.TargetSubQuotas[a]; ok {
+				ld.subQuota = a
+			}
+			lists = append(lists, ld)
 		}
 	}
 	for _, a := range hs.DedicatedQuotaAnchors {
@@ -192,6 +197,7 @@ func (hs *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
 			studentID := strings.TrimSpace(cells.Eq(1).Text())
 			if studentID == "" {
 				// no ID means a malformed row
+				source.SkipRow(receiver, fmt.Errorf("empty student ID in row %d of %s", i+1, ld.anchor))
 				return
 			}
 			// original / consent submitted (3rd column contains "Да" or "Нет")
@@ -244,11 +250,10 @@ func (hs *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
 				}
 			}
 
-			// extract MSU internal ID from student ID (last 6 digits)
-			var msuInternalID *string
-			if len(studentID) >= 6 {
-				inner, _ := utils.PrepareStudentID(studentID[len(studentID)-6:])
-				msuInternalID = &inner
+			// MSU ranks equal totals by its own additional exam (DVI) first, then by the EGE subjects in column order
+			subjectScores := egeScores
+			if dviScore > 0 {
+				subjectScores = append([]int{dviScore}, egeScores...)
 			}
 
 			// create and send application data
@@ -260,10 +265,11 @@ func (hs *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
 				Priority:          priority,
 				CompetitionType:   ld.competition,
 				OriginalSubmitted: originalSubmitted,
+				SubjectScores:     subjectScores,
 				DVIScore:          dviScore,
 				EGEScores:         egeScores,
 				HeadingName:       hs.PrettyName,
-				MSUInternalID:     msuInternalID,
+				SubQuota:          ld.subQuota,
 			}
 			receiver.PutApplicationData(ad)
 		})
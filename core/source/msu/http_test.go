@@ -1,6 +1,7 @@
 package msu
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -47,7 +48,7 @@ func TestHTTPHeadingSource(t *testing.T) {
 	}
 
 	receiver := &testReceiver{}
-	err := s.LoadTo(receiver)
+	err := s.LoadTo(context.Background(), receiver)
 	if err != nil {
 		t.Fatalf("error loading data: %v", err)
 	}
@@ -0,0 +1,130 @@
+package msu
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"regexp"
+
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/idresolver"
+	"github.com/trueegorletov/analabit/core/source"
+)
+
+var nonDigits = regexp.MustCompile(`[^0-9]`)
+
+// IDResolver implements source.IDResolver for the internal IDs MSU publishes instead of the canonical ones,
+// resolving them with the idmsu service. Students the service can't resolve get fallback IDs derived from
+// their internal IDs.
+type IDResolver struct {
+	resolver idresolver.StudentIDResolver
+}
+
+func init() {
+	// MSU lists show internal IDs instead of the Gosuslugi ones, which the idmsu service matches
+	source.RegisterIDResolution("msu.idmsu", func() source.IDResolution {
+		return source.NewBufferingIDResolution(NewIDResolver(idresolver.NewIDMSUClient()))
+	})
+}
+
+// NewIDResolver creates an IDResolver asking the given resolver.
+func NewIDResolver(resolver idresolver.StudentIDResolver) *IDResolver {
+	return &IDResolver{resolver: resolver}
+}
+
+// InternalID extracts the internal ID from the published student ID: the whole number for the dedicated
+// quota lists and its last 6 digits for the rest, padded with leading zeros to 6 digits.
+func (r *IDResolver) InternalID(ad *source.ApplicationData) string {
+	numericOnly := nonDigits.ReplaceAllString(ad.StudentID, "")
+
+	if ad.CompetitionType != core.CompetitionDedicatedQuota && len(numericOnly) >= 6 {
+		return numericOnly[len(numericOnly)-6:]
+	}
+	if len(numericOnly) < 6 {
+		return fmt.Sprintf("%06s", numericOnly)
+	}
+	return numericOnly
+}
+
+// Resolve asks the idmsu service for the canonical IDs of all internal IDs at once.
+func (r *IDResolver) Resolve(ctx context.Context, applications map[string][]*source.ApplicationData) (map[string]string, error) {
+	totalApps := 0
+	request := make([]idresolver.ResolveRequestItem, 0, len(applications))
+	for internalID, apps := range applications {
+		resolveApps := make([]idresolver.MSUAppDetails, len(apps))
+		for i, app := range apps {
+			resolveApps[i] = idresolver.MSUAppDetails{
+				PrettyName:  app.HeadingName,
+				ScoreSum:    app.ScoresSum,
+				RatingPlace: app.RatingPlace,
+				Priority:    app.Priority,
+				DVIScore:    app.DVIScore,
+				EGEScores:   app.EGEScores,
+			}
+		}
+		request = append(request, idresolver.ResolveRequestItem{
+			InternalID: internalID,
+			Apps:       resolveApps,
+		})
+		totalApps += len(apps)
+	}
+
+	slog.Info("Requesting ID resolution for MSU students", "uniqueStudents", len(request), "totalApplications", totalApps)
+	response, err := r.resolver.ResolveBatch(ctx, request)
+	if err != nil {
+		slog.Error("Failed to resolve MSU student IDs, using fallback IDs for all students", "error", err, "requestCount", len(request))
+	} else {
+		slog.Info("Received ID resolution response", "responseCount", len(response))
+	}
+
+	canonicalIDs := make(map[string]string, len(applications))
+	var highConfidence, prettyHighConfidence, mediumConfidence, lowConfidence, fallback int
+	for _, resolution := range response {
+		if resolution.CanonicalID == "" {
+			continue
+		}
+		canonicalIDs[resolution.InternalID] = resolution.CanonicalID
+
+		switch {
+		case resolution.Confidence >= 0.8:
+			highConfidence++
+		case resolution.Confidence >= 0.6:
+			prettyHighConfidence++
+		case resolution.Confidence >= 0.4:
+			mediumConfidence++
+		case resolution.Confidence > 0.0:
+			lowConfidence++
+		default:
+			fallback++
+		}
+	}
+
+	for internalID := range applications {
+		if _, ok := canonicalIDs[internalID]; !ok {
+			canonicalIDs[internalID] = fallbackID(internalID)
+			fallback++
+		}
+	}
+
+	slog.Info("MSU ID resolution statistics",
+		"highConfidence", highConfidence,
+		"prettyHighConfidence", prettyHighConfidence,
+		"mediumConfidence", mediumConfidence,
+		"lowConfidence", lowConfidence,
+		"fallbackIDs", fallback)
+
+	return canonicalIDs, nil
+}
+
+// fallbackID creates a canonical ID from the internal ID for a student who couldn't be resolved:
+// MSU- prefix plus the internal ID, padded or truncated from the beginning to the 13 characters of a student ID.
+func fallbackID(internalID string) string {
+	id := fmt.Sprintf("MSU-%s", internalID)
+	for len(id) < 13 {
+		id = "0" + id
+	}
+	if len(id) > 13 {
+		id = id[len(id)-13:]
+	}
+	return id
+}
@@ -0,0 +1,84 @@
+package msu
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/idresolver"
+	"github.com/trueegorletov/analabit/core/source"
+)
+
+type stubStudentIDResolver struct {
+	canonicalIDs map[string]string
+	err          error
+}
+
+func (r *stubStudentIDResolver) ResolveBatch(_ context.Context, req []idresolver.ResolveRequestItem) ([]idresolver.ResolveResponseItem, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	var resp []idresolver.ResolveResponseItem
+	for _, item := range req {
+		if id, ok := r.canonicalIDs[item.InternalID]; ok {
+			resp = append(resp, idresolver.ResolveResponseItem{InternalID: item.InternalID, CanonicalID: id, Confidence: 1})
+		}
+	}
+	return resp, nil
+}
+
+func TestIDResolver_InternalID(t *testing.T) {
+	r := NewIDResolver(&stubStudentIDResolver{})
+
+	assert.Equal(t, "028478", r.InternalID(&source.ApplicationData{StudentID: "2025-1028478", CompetitionType: core.CompetitionRegular}))
+	assert.Equal(t, "001234", r.InternalID(&source.ApplicationData{StudentID: "1234", CompetitionType: core.CompetitionBVI}))
+	assert.Equal(t, "1028478", r.InternalID(&source.ApplicationData{StudentID: "1028478", CompetitionType: core.CompetitionDedicatedQuota}))
+}
+
+func TestBufferingIDResolution(t *testing.T) {
+	downstream := &testReceiver{}
+	resolution := source.NewBufferingIDResolution(NewIDResolver(&stubStudentIDResolver{
+		canonicalIDs: map[string]string{"028478": "1234567"},
+	}))
+	receiver := resolution(downstream)
+
+	receiver.PutHeadingData(&source.HeadingData{Code: "math"})
+	receiver.PutApplicationData(&source.ApplicationData{HeadingCode: "math", StudentID: "1028478", Priority: 1, SubjectScores: []int{90}})
+	receiver.PutApplicationData(&source.ApplicationData{HeadingCode: "phys", StudentID: "2028478", Priority: 2})
+	receiver.PutApplicationData(&source.ApplicationData{HeadingCode: "math", StudentID: "555555", Priority: 1})
+
+	assert.Len(t, downstream.headings, 1)
+	assert.Empty(t, downstream.applications, "applications are held back until Finalize")
+
+	require.NoError(t, receiver.Finalize(context.Background()))
+	require.Len(t, downstream.applications, 3)
+
+	byInternalID := make(map[string][]*source.ApplicationData)
+	for _, ad := range downstream.applications {
+		byInternalID[ad.InternalID] = append(byInternalID[ad.InternalID], ad)
+	}
+
+	resolved := byInternalID["0000000028478"]
+	require.Len(t, resolved, 2)
+	for _, ad := range resolved {
+		assert.Equal(t, "1234567", ad.StudentID)
+	}
+
+	unresolved := byInternalID["0000000555555"]
+	require.Len(t, unresolved, 1)
+	assert.Equal(t, "000MSU-555555", unresolved[0].StudentID)
+}
+
+func TestBufferingIDResolution_ResolverFailure(t *testing.T) {
+	downstream := &testReceiver{}
+	receiver := source.NewBufferingIDResolution(NewIDResolver(&stubStudentIDResolver{err: errors.New("unavailable")}))(downstream)
+
+	receiver.PutApplicationData(&source.ApplicationData{HeadingCode: "math", StudentID: "1028478"})
+	require.NoError(t, receiver.Finalize(context.Background()))
+
+	require.Len(t, downstream.applications, 1)
+	assert.Equal(t, "000MSU-028478", downstream.applications[0].StudentID)
+}
@@ -2,8 +2,12 @@ package msu
 
 import (
 	"encoding/gob"
+
+	"github.com/trueegorletov/analabit/core/source"
 )
 
 func init() {
 	gob.RegisterName("MsuHTTPHeadingSource", &HTTPHeadingSource{})
+
+	source.RegisterHeadingSourceType("msu.http", func() source.HeadingSource { return &HTTPHeadingSource{} })
 }
@@ -80,6 +80,7 @@ func parseAndLoadApplicationsInternal(
 		ratingPlace, err = strconv.Atoi(ratingPlaceStr)
 		if err != nil {
 			log.Printf("Warning: Invalid rating place '%s' in row %d of %s (sheet %s): %v. Skipping this row.", ratingPlaceStr, i+1, sourceHint, sheetName, err)
+			source.SkipRow(receiver, err)
 			continue // Skip this row if conversion fails
 		}
 
@@ -94,6 +95,7 @@ func parseAndLoadApplicationsInternal(
 		preparedID, errPrepare := utils.PrepareStudentID(studentID) // Changed to exported function
 		if errPrepare != nil {
 			log.Printf("Warning: Invalid student ID '%s' in row %d of %s (sheet %s): %v. Skipping this row.", studentID, i+1, sourceHint, sheetName, errPrepare)
+			source.SkipRow(receiver, errPrepare)
 			continue
 		}
 		studentID = preparedID
@@ -186,6 +188,7 @@ func processApplicationsFromLists(
 			currentFile, err = openFileFunc(listDef.Source, listDef.ListName)
 			if err != nil { // An actual error occurred during opening/downloading
 				log.Printf("Error opening/processing source for %s from %s: %v. Continuing.", listDef.ListName, listDef.Source, err)
+				source.FailList(receiver, fmt.Errorf("%s: %w", listDef.ListName, err))
 				continue
 			}
 			if currentFile == nil { // openFileFunc decided to skip this list (e.g., invalid URL/path but not a hard error)
@@ -200,6 +203,7 @@ func processApplicationsFromLists(
 		if err != nil {
 			log.Printf("Warning: Failed to load applications from %s (%s): %v. Continuing.",
 				listDef.ListName, listDef.Source, err)
+			source.FailList(receiver, fmt.Errorf("%s: %w", listDef.ListName, err))
 		}
 
 		if closeCurrentFile {
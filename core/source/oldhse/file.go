@@ -4,6 +4,7 @@ import (
 	"github.com/trueegorletov/analabit/core"
 	"github.com/trueegorletov/analabit/core/source"
 	"github.com/trueegorletov/analabit/core/utils"
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -44,7 +45,7 @@ func openLocalExcelFile(filePath string, listName string) (*excelize.File, error
 }
 
 // LoadTo loads data from local file system sources, sending HeadingData and ApplicationData to the provided channels.
-func (s *FileHeadingSource) LoadTo(receiver source.DataReceiver) error {
+func (s *FileHeadingSource) LoadTo(_ context.Context, receiver source.DataReceiver) error {
 	if s.RCListPath == "" {
 		return fmt.Errorf("RCListPath is mandatory and was not provided in HseFileHeadingSource")
 	}
@@ -75,7 +76,7 @@ func (s *FileHeadingSource) LoadTo(receiver source.DataReceiver) error {
 		Capacities: s.HeadingCapacities,
 		PrettyName: prettyName,
 	})
-	log.Printf("Sent heading: %s (Code: %s, Capacities: %d) using name from %s", prettyName, headingCode, s.HeadingCapacities, s.RCListPath)
+	log.Printf("Sent heading: %s (Code: %s, Capacities: %+v) using name from %s", prettyName, headingCode, s.HeadingCapacities, s.RCListPath)
 
 	definitions := []listDefinition{
 		{Source: s.BListPath, CompetitionType: core.CompetitionBVI, ListName: "BVI List"},
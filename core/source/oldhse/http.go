@@ -28,23 +28,25 @@ type HttpHeadingSource struct {
 // openHttpExcelFile downloads and opens an Excel file from a URL.
 // Returns (nil, nil) if urlStr is empty or invalid, to allow skipping.
 // Returns (nil, error) for actual download/open errors.
-func openHttpExcelFile(urlStr string, listName string) (*excelize.File, error) {
+func openHttpExcelFile(ctx context.Context, urlStr string, listName string) (*excelize.File, error) {
 	if urlStr == "" || urlStr == "." || urlStr == "/" { // Check for effectively empty URLs
 		log.Printf("Skipping %s: URL ('%s') is empty or invalid.", listName, urlStr)
 		return nil, nil // Indicate skippable
 	}
 
 	// Acquire a semaphore slot, respecting context cancellation
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel() // Ensure cancellation on function exit
-
 	release, err := source.AcquireHTTPSemaphores(ctx, "oldhse")
 	if err != nil {
 		return nil, fmt.Errorf("failed to acquire semaphores for %s from %s: %w", listName, urlStr, err)
 	}
 	defer release()
 
-	resp, err := http.Get(urlStr)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, urlStr, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request for %s from %s: %w", listName, urlStr, err)
+	}
+
+	resp, err := source.HTTPClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to download %s from %s: %w", listName, urlStr, err)
 	}
@@ -66,7 +68,7 @@ func openHttpExcelFile(urlStr string, listName string) (*excelize.File, error) {
 }
 
 // LoadTo loads data from HTTP sources, sending HeadingData and ApplicationData to the provided channels.
-func (s *HttpHeadingSource) LoadTo(receiver source.DataReceiver) error {
+func (s *HttpHeadingSource) LoadTo(ctx context.Context, receiver source.DataReceiver) error {
 	var primaryURL string
 	var primaryFileSourceHint string
 	var primaryFileListName string
@@ -100,7 +102,7 @@ func (s *HttpHeadingSource) LoadTo(receiver source.DataReceiver) error {
 	}
 
 	log.Printf("Attempting to extract heading name from %s: %s", primaryFileListName, primaryURL)
-	primaryFile, err := openHttpExcelFile(primaryURL, primaryFileListName)
+	primaryFile, err := openHttpExcelFile(ctx, primaryURL, primaryFileListName)
 	if err != nil {
 		return fmt.Errorf("failed to open primary file (%s) from %s: %w", primaryFileListName, primaryURL, err)
 	}
@@ -125,7 +127,7 @@ func (s *HttpHeadingSource) LoadTo(receiver source.DataReceiver) error {
 		Capacities: s.Capacities,
 		PrettyName: prettyName,
 	})
-	log.Printf("Sent heading: %s (Code: %s, Caps: %d) using name from %s (%s)", prettyName, headingCode, s.Capacities, primaryFileListName, primaryURL)
+	log.Printf("Sent heading: %s (Code: %s, Caps: %+v) using name from %s (%s)", prettyName, headingCode, s.Capacities, primaryFileListName, primaryURL)
 
 	// Define rcListURLForDefinitions for clarity, ensuring it's the original s.RCListURL for the Common List definition.
 	// If s.RCListURL is empty, it will be handled by processApplicationsFromLists (skipped).
@@ -145,7 +147,10 @@ func (s *HttpHeadingSource) LoadTo(receiver source.DataReceiver) error {
 
 	// Pass the applications channel to processApplicationsFromLists
 	// primaryFileSourceHint is the URL of the file already opened as primaryFile
-	return processApplicationsFromLists(receiver, headingCode, prettyName, definitions, primaryFile, primaryFileSourceHint, openHttpExcelFile)
+	openFile := func(urlStr string, listName string) (*excelize.File, error) {
+		return openHttpExcelFile(ctx, urlStr, listName)
+	}
+	return processApplicationsFromLists(receiver, headingCode, prettyName, definitions, primaryFile, primaryFileSourceHint, openFile)
 }
 
 // urlPtrToString safely converts a *url.URL to a string, returning "" if nil.
@@ -2,9 +2,14 @@ package oldhse
 
 import (
 	"encoding/gob"
+
+	"github.com/trueegorletov/analabit/core/source"
 )
 
 func init() {
 	gob.RegisterName("HseFileHeadingSource", &FileHeadingSource{})
 	gob.RegisterName("HseHttpHeadingSource", &HttpHeadingSource{})
+
+	source.RegisterHeadingSourceType("oldhse.file", func() source.HeadingSource { return &FileHeadingSource{} })
+	source.RegisterHeadingSourceType("oldhse.http", func() source.HeadingSource { return &HttpHeadingSource{} })
 }
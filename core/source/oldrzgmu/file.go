@@ -7,6 +7,7 @@ import (
 	"github.com/trueegorletov/analabit/core/source"
 	"github.com/trueegorletov/analabit/core/utils"
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -26,7 +27,7 @@ type FileHeadingSource struct {
 
 // LoadTo loads data from file source, extracting text with rsc.io/pdf,
 // and sending HeadingData and ApplicationData to the provided receiver.
-func (s *FileHeadingSource) LoadTo(receiver source.DataReceiver) error {
+func (s *FileHeadingSource) LoadTo(_ context.Context, receiver source.DataReceiver) error {
 	if s.Path == "" {
 		return fmt.Errorf("Path is required for RZGMU FileHeadingSource")
 	}
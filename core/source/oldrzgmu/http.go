@@ -30,7 +30,7 @@ type HTTPHeadingSource struct {
 
 // LoadTo loads data from HTTP source, downloading PDF, extracting text with rsc.io/pdf,
 // and sending HeadingData and ApplicationData to the provided receiver.
-func (s *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
+func (s *HTTPHeadingSource) LoadTo(ctx context.Context, receiver source.DataReceiver) error {
 	if s.URL == "" {
 		return fmt.Errorf("URL is required for RZGMU HTTPHeadingSource")
 	}
@@ -38,7 +38,7 @@ func (s *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
 	log.Printf("Processing RZGMU admission list from: %s", s.URL)
 
 	// Acquire a semaphore slot, respecting context cancellation
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
 	defer cancel()
 
 	release, err := source.AcquireHTTPSemaphores(ctx, "rzgmu")
@@ -115,7 +115,7 @@ func downloadPDFToTemp(ctx context.Context, url string) (string, error) {
 		return "", fmt.Errorf("failed to create request: %w", err)
 	}
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := source.HTTPClient.Do(req)
 	if err != nil {
 		return "", fmt.Errorf("failed to download PDF: %w", err)
 	}
@@ -2,9 +2,14 @@ package oldrzgmu
 
 import (
 	"encoding/gob"
+
+	"github.com/trueegorletov/analabit/core/source"
 )
 
 func init() {
 	gob.RegisterName("RzgmuHttpHeadingSource", &HTTPHeadingSource{})
 	gob.RegisterName("RzgmuFileHeadingSource", &FileHeadingSource{})
+
+	source.RegisterHeadingSourceType("oldrzgmu.http", func() source.HeadingSource { return &HTTPHeadingSource{} })
+	source.RegisterHeadingSourceType("oldrzgmu.file", func() source.HeadingSource { return &FileHeadingSource{} })
 }
@@ -0,0 +1,194 @@
+package source
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/trueegorletov/analabit/core"
+)
+
+// RowSkipper is implemented by the receivers accounting for the rows their sources skip.
+type RowSkipper interface {
+	SkipRow(err error)
+}
+
+// SkipRow tells the receiver that the source skipped a row of a list as it couldn't parse it.
+// Receivers which don't account for skipped rows ignore it.
+func SkipRow(receiver DataReceiver, err error) {
+	if skipper, ok := receiver.(RowSkipper); ok {
+		skipper.SkipRow(err)
+	}
+}
+
+// ListFailer is implemented by the receivers accounting for the lists their sources fail to load.
+type ListFailer interface {
+	FailList(err error)
+}
+
+// FailList tells the receiver that the source goes on loading its heading without a list which failed to
+// load, so that the source is reported as partially loaded. Receivers which don't account for failed lists
+// ignore it.
+func FailList(receiver DataReceiver, err error) {
+	if failer, ok := receiver.(ListFailer); ok {
+		failer.FailList(err)
+	}
+}
+
+// reportingReceiver forwards the data of a single source downstream, counting it for the source's report.
+type reportingReceiver struct {
+	downstream DataReceiver
+
+	mu           sync.Mutex
+	headingCodes []string
+	headings     int
+	applications int
+	skippedRows  int
+	listErrs     []error
+}
+
+func (r *reportingReceiver) PutHeadingData(heading *HeadingData) {
+	r.mu.Lock()
+	r.headings++
+	if heading != nil && !slices.Contains(r.headingCodes, heading.Code) {
+		r.headingCodes = append(r.headingCodes, heading.Code)
+	}
+	r.mu.Unlock()
+
+	r.downstream.PutHeadingData(heading)
+}
+
+func (r *reportingReceiver) PutApplicationData(application *ApplicationData) {
+	r.mu.Lock()
+	r.applications++
+	r.mu.Unlock()
+
+	r.downstream.PutApplicationData(application)
+}
+
+func (r *reportingReceiver) SkipRow(err error) {
+	r.mu.Lock()
+	r.skippedRows++
+	r.mu.Unlock()
+
+	SkipRow(r.downstream, err)
+}
+
+func (r *reportingReceiver) FailList(err error) {
+	r.mu.Lock()
+	r.listErrs = append(r.listErrs, err)
+	r.mu.Unlock()
+
+	FailList(r.downstream, err)
+}
+
+// reset clears the counts before another attempt, so that a source which fails halfway and then loads
+// isn't reported with the data of both attempts.
+func (r *reportingReceiver) reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.headingCodes = nil
+	r.headings = 0
+	r.applications = 0
+	r.skippedRows = 0
+	r.listErrs = nil
+}
+
+// loadSource loads the source with retries and reports how it went.
+func loadSource(ctx context.Context, hs HeadingSource, receiver DataReceiver, maxAttempts int, backoff func(attempt int) time.Duration) (core.SourceReport, error) {
+	reporting := &reportingReceiver{downstream: receiver}
+
+	attempts := 0
+	startedAt := time.Now()
+	err := Retry(ctx, func() error {
+		attempts++
+		reporting.reset()
+		return hs.LoadTo(ctx, reporting)
+	}, maxAttempts, backoff)
+
+	report := describeSource(hs)
+	report.StartedAt = startedAt
+	report.DurationMs = time.Since(startedAt).Milliseconds()
+	report.Retries = max(attempts-1, 0)
+
+	reporting.mu.Lock()
+	report.HeadingCodes = reporting.headingCodes
+	report.Headings = reporting.headings
+	report.Applications = reporting.applications
+	report.SkippedRows = reporting.skippedRows
+	report.FailedLists = len(reporting.listErrs)
+	listErr := errors.Join(reporting.listErrs...)
+	reporting.mu.Unlock()
+
+	switch {
+	case err == nil && listErr != nil:
+		report.Status = core.SourceLoadPartial
+		report.Error = listErr.Error()
+	case err == nil:
+		report.Status = core.SourceLoadOK
+	case ctx.Err() != nil:
+		report.Status = core.SourceLoadCancelled
+		report.Error = err.Error()
+	default:
+		report.Status = core.SourceLoadFailed
+		report.Error = err.Error()
+	}
+
+	return report, err
+}
+
+// describeSource identifies the source for its report: its type, the heading name it is defined with and
+// the first URL it loads, both looked up among the source's fields.
+func describeSource(hs HeadingSource) core.SourceReport {
+	if adapter, ok := hs.(*LegacyAdapter); ok {
+		return describe(adapter.Source)
+	}
+	return describe(hs)
+}
+
+func describe(s any) core.SourceReport {
+	report := core.SourceReport{Source: strings.TrimPrefix(fmt.Sprintf("%T", s), "*")}
+
+	val := reflect.ValueOf(s)
+	for val.Kind() == reflect.Pointer || val.Kind() == reflect.Interface {
+		if val.IsNil() {
+			return report
+		}
+		val = val.Elem()
+	}
+	if val.Kind() != reflect.Struct {
+		return report
+	}
+
+	for i := 0; i < val.NumField(); i++ {
+		field := val.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		var value string
+		switch f := val.Field(i); {
+		case f.Kind() == reflect.String:
+			value = f.String()
+		case f.Kind() == reflect.Slice && f.Type().Elem().Kind() == reflect.String && f.Len() > 0:
+			value = f.Index(0).String()
+		}
+		if value == "" {
+			continue
+		}
+
+		switch {
+		case report.Name == "" && (field.Name == "PrettyName" || field.Name == "HeadingName" || field.Name == "ProgramName"):
+			report.Name = value
+		case report.URL == "" && (strings.HasSuffix(field.Name, "URL") || strings.HasSuffix(field.Name, "URLs")):
+			report.URL = value
+		}
+	}
+
+	return report
+}
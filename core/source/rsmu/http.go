@@ -27,9 +27,9 @@ type HTTPHeadingSource struct {
 }
 
 // LoadTo loads data from HTTP source, downloading JSON files and sending HeadingData and ApplicationData to the provided receiver.
-func (s *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
+func (s *HTTPHeadingSource) LoadTo(ctx context.Context, receiver source.DataReceiver) error {
 	// Acquire a semaphore slot, respecting context cancellation
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
 	defer cancel()
 
 	release, err := source.AcquireHTTPSemaphores(ctx, "rsmu")
@@ -49,6 +49,7 @@ func (s *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
 		list, err := s.downloadIndividualList(ctx, url)
 		if err != nil {
 			log.Printf("Warning: failed to download target quota list from %s: %v", url, err)
+			source.FailList(receiver, fmt.Errorf("target quota list %s: %w", url, err))
 			continue
 		}
 		// Mark this list as target quota
@@ -103,10 +104,20 @@ func (s *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
 	headingCode := utils.GenerateHeadingCode(s.ProgramName)
 
 	// Send HeadingData to the receiver
+	// Exams are listed in the order of their priority, which is also the order of the applicants' exam scores
+	var subjects []string
+	for _, list := range allLists {
+		if len(list.Exams) > 0 {
+			subjects = list.Exams
+			break
+		}
+	}
+
 	receiver.PutHeadingData(&source.HeadingData{
 		Code:       headingCode,
 		Capacities: *capacities,
 		PrettyName: s.ProgramName,
+		Subjects:   subjects,
 	})
 
 	log.Printf("Sent RSMU heading: %s (Code: %s, Caps: %v)", s.ProgramName, headingCode, *capacities)
@@ -134,6 +145,8 @@ func (s *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
 				Priority:          applicant.Priority,
 				CompetitionType:   competitionType,
 				OriginalSubmitted: applicant.Approval || applicant.Original,
+				SubjectScores:     applicant.Exams,
+				AchievementScore:  applicant.AchievementScore,
 			}
 			receiver.PutApplicationData(appData)
 			totalApplicants++
@@ -151,7 +164,7 @@ func (s *HTTPHeadingSource) downloadIndividualList(ctx context.Context, url stri
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := source.HTTPClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to download individual list: %w", err)
 	}
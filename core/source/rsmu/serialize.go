@@ -2,8 +2,12 @@ package rsmu
 
 import (
 	"encoding/gob"
+
+	"github.com/trueegorletov/analabit/core/source"
 )
 
 func init() {
 	gob.RegisterName("RsmuHTTPHeadingSource", &HTTPHeadingSource{})
+
+	source.RegisterHeadingSourceType("rsmu.http", func() source.HeadingSource { return &HTTPHeadingSource{} })
 }
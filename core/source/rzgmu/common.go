@@ -46,6 +46,9 @@ var programNames = []string{
 var (
 	// Regex to normalize program names (remove any parenthetical suffix)
 	programNameRegex = regexp.MustCompile(`^(.+?)\s*\([^)]*\)\s*`)
+
+	// Regex to extract the score from a subject entry like "Русский язык 97"
+	subjectScoreRegex = regexp.MustCompile(`(\d+)$`)
 )
 
 // isBVI checks if the score cell indicates "Без вступительных испытаний" (BVI)
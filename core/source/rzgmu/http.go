@@ -1,6 +1,7 @@
 package rzgmu
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -19,8 +20,8 @@ type HTTPHeadingSource struct {
 }
 
 // LoadTo downloads and parses all four RZGMU HTML pages, filtering for the specified program
-func (h *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
-	client := &http.Client{}
+func (h *HTTPHeadingSource) LoadTo(ctx context.Context, receiver source.DataReceiver) error {
+	client := source.HTTPClient
 	
 	// Generate consistent heading code based on program name
 	headingCode := utils.GenerateHeadingCode(h.ProgramName)
@@ -38,7 +39,11 @@ func (h *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
 	
 	// Parse application data from all pages
 	for _, pageInfo := range pageURLs {
-		resp, err := client.Get(pageInfo.URL)
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, pageInfo.URL, nil)
+		if err != nil {
+			return fmt.Errorf("failed to create request for %s: %w", pageInfo.URL, err)
+		}
+		resp, err := client.Do(req)
 		if err != nil {
 			return fmt.Errorf("failed to fetch %s: %w", pageInfo.URL, err)
 		}
@@ -99,12 +104,13 @@ func (h *HTTPHeadingSource) parseTable(table *goquery.Selection, headingCode str
 		}
 		
 		// Extract data from table cells
-		// Based on sample: Position | StudentID | ScoresSum | Subjects | OriginalSubmitted | SpecialRight | Priority | ...
+		// Based on sample: Position | StudentID | ScoresSum | Subjects | Achievements | SpecialRight | Priority | HighestPriority | PassingHighestPriority | Consent
 		positionText := strings.TrimSpace(cells.Eq(0).Text())
 		studentIDText := strings.TrimSpace(cells.Eq(1).Text())
 		scoreText := strings.TrimSpace(cells.Eq(2).Text())
-		originalText := strings.TrimSpace(cells.Eq(4).Text())
+		achievementText := strings.TrimSpace(cells.Eq(4).Text())
 		priorityText := strings.TrimSpace(cells.Eq(6).Text())
+		consentText := strings.TrimSpace(cells.Eq(9).Text())
 		
 		// Skip header rows or empty rows
 		if positionText == "" || studentIDText == "" {
@@ -120,7 +126,8 @@ func (h *HTTPHeadingSource) parseTable(table *goquery.Selection, headingCode str
 		// Parse student ID
 		studentID, err := strconv.Atoi(studentIDText)
 		if err != nil {
-			return // Skip invalid student IDs
+			source.SkipRow(receiver, fmt.Errorf("invalid student ID %q at position %d", studentIDText, position))
+			return
 		}
 		
 		// Parse scores sum (extract number from bold text like "<b>144</b>")
@@ -140,18 +147,35 @@ func (h *HTTPHeadingSource) parseTable(table *goquery.Selection, headingCode str
 				scoreText = strings.TrimSpace(cells.Eq(2).Text())
 			}
 			if scoresSum, err = strconv.Atoi(scoreText); err != nil {
-				return // Skip rows with invalid scores
+				source.SkipRow(receiver, fmt.Errorf("invalid score %q of student %d", scoreText, studentID))
+				return
 			}
 		}
 		
-		// Parse original submitted (0 or positive integer)
-		originalSubmitted := 0
-		if originalText != "" {
-			if val, err := strconv.Atoi(originalText); err == nil {
-				originalSubmitted = val
+		// Parse subject scores, listed as "<subject> <score>" in the order of the exams' priority
+		var subjectScores []int
+		cells.Eq(3).Find("li").EachWithBreak(func(_ int, li *goquery.Selection) bool {
+			matches := subjectScoreRegex.FindStringSubmatch(strings.TrimSpace(li.Text()))
+			if matches == nil {
+				subjectScores = nil
+				return false
+			}
+			subjectScore, _ := strconv.Atoi(matches[1])
+			subjectScores = append(subjectScores, subjectScore)
+			return true
+		})
+		
+		// Parse individual achievement points (0 or positive integer)
+		achievementScore := 0
+		if achievementText != "" {
+			if val, err := strconv.Atoi(achievementText); err == nil {
+				achievementScore = val
 			}
 		}
 		
+		// Parse original submitted (the consent column reads "согласие")
+		originalSubmitted := strings.Contains(strings.ToLower(consentText), "согласие")
+		
 		// Parse priority (0 or positive integer)
 		priority := 0
 		if priorityText != "" {
@@ -165,10 +189,12 @@ func (h *HTTPHeadingSource) parseTable(table *goquery.Selection, headingCode str
 			HeadingCode:       headingCode,
 			StudentID:         strconv.Itoa(studentID),
 			ScoresSum:         scoresSum,
+			SubjectScores:     subjectScores,
+			AchievementScore:  achievementScore,
 			RatingPlace:       position,
 			Priority:          priority,
 			CompetitionType:   actualCompetitionType,
-			OriginalSubmitted: originalSubmitted > 0,
+			OriginalSubmitted: originalSubmitted,
 		}
 		
 		receiver.PutApplicationData(appData)
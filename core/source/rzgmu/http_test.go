@@ -1,10 +1,13 @@
 package rzgmu
 
 import (
+	"slices"
 	"strings"
 	"testing"
 
+	"github.com/PuerkitoBio/goquery"
 	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/source"
 )
 
 // TestHTTPHeadingSource_Basic tests basic functionality of HTTPHeadingSource
@@ -54,4 +57,78 @@ func TestHelperFunctions(t *testing.T) {
 	if isBVI("<b>180</b>") {
 		t.Error("Expected isBVI to return false for numeric score")
 	}
-}
\ No newline at end of file
+}
+
+type skipCountingReceiver struct {
+	applications []*source.ApplicationData
+	skipped      int
+}
+
+func (r *skipCountingReceiver) PutHeadingData(*source.HeadingData) {}
+
+func (r *skipCountingReceiver) PutApplicationData(app *source.ApplicationData) {
+	r.applications = append(r.applications, app)
+}
+
+func (r *skipCountingReceiver) SkipRow(error) {
+	r.skipped++
+}
+
+// TestParseTable_SkippedRows tests that the rows with an invalid student ID or score are reported as skipped
+func TestParseTable_SkippedRows(t *testing.T) {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(`<table>
+		<tr><td>№</td><td>Код</td><td>Балл</td><td>ВИ</td><td>ИД</td><td>ПП</td><td>Приоритет</td></tr>
+		<tr><td>1</td><td>1001</td><td><b>БВИ</b></td><td></td><td>5</td><td></td><td>1</td></tr>
+		<tr><td>2</td><td>1002</td><td><b>250</b></td><td></td><td>0</td><td></td><td>2</td></tr>
+		<tr><td>3</td><td>x1003</td><td><b>240</b></td><td></td><td>0</td><td></td><td>1</td></tr>
+		<tr><td>4</td><td>1004</td><td>—</td><td></td><td>0</td><td></td><td>1</td></tr>
+	</table>`))
+	if err != nil {
+		t.Fatalf("Failed to parse HTML: %v", err)
+	}
+
+	r := &skipCountingReceiver{}
+	(&HTTPHeadingSource{}).parseTable(doc.Find("table"), "test", core.CompetitionRegular, r)
+
+	if len(r.applications) != 2 {
+		t.Errorf("Expected 2 applications, got %d", len(r.applications))
+	}
+	if r.skipped != 2 {
+		t.Errorf("Expected 2 skipped rows, got %d", r.skipped)
+	}
+}
+
+// TestParseTable_Scores tests that the subject scores, achievement points and consent are read from their columns
+func TestParseTable_Scores(t *testing.T) {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(`<table>
+		<tr><td>3</td><td>4265863</td><td><b>279</b></td><td><ul class="list-unstyled"><li>Биология 78</li><li>Обществознание 94</li><li>Русский язык 97</li></ul></td><td>10</td><td></td><td>1</td><td>высший приоритет</td><td></td><td></td></tr>
+		<tr><td>4</td><td>4373622</td><td><b>277</b></td><td><ul class="list-unstyled"><li>Биология 90</li><li>Математика 80</li><li>Русский язык 97</li></ul></td><td>10</td><td></td><td>1</td><td>высший приоритет</td><td>проходной высший приоритет</td><td>согласие</td></tr>
+	</table>`))
+	if err != nil {
+		t.Fatalf("Failed to parse HTML: %v", err)
+	}
+
+	r := &skipCountingReceiver{}
+	(&HTTPHeadingSource{}).parseTable(doc.Find("table"), "test", core.CompetitionRegular, r)
+
+	if len(r.applications) != 2 {
+		t.Fatalf("Expected 2 applications, got %d", len(r.applications))
+	}
+
+	first, second := r.applications[0], r.applications[1]
+	if want := []int{78, 94, 97}; !slices.Equal(first.SubjectScores, want) {
+		t.Errorf("Expected subject scores %v, got %v", want, first.SubjectScores)
+	}
+	if first.AchievementScore != 10 {
+		t.Errorf("Expected 10 achievement points, got %d", first.AchievementScore)
+	}
+	if first.OriginalSubmitted {
+		t.Error("Expected no original submitted without consent")
+	}
+	if want := []int{90, 80, 97}; !slices.Equal(second.SubjectScores, want) {
+		t.Errorf("Expected subject scores %v, got %v", want, second.SubjectScores)
+	}
+	if !second.OriginalSubmitted {
+		t.Error("Expected original submitted with consent")
+	}
+}
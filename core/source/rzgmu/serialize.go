@@ -2,8 +2,12 @@ package rzgmu
 
 import (
 	"encoding/gob"
+
+	"github.com/trueegorletov/analabit/core/source"
 )
 
 func init() {
 	gob.RegisterName("RzgmuHttpHeadingSource", &HTTPHeadingSource{})
+
+	source.RegisterHeadingSourceType("rzgmu.http", func() source.HeadingSource { return &HTTPHeadingSource{} })
 }
@@ -1,13 +1,105 @@
 package source
 
+import (
+	"context"
+	"encoding/gob"
+	"sync"
+)
+
 // HeadingSource is an interface for loading heading and application data.
-// Implementations should send data to the provided channels.
-// It is the responsibility of the caller to close the channels after LoadTo returns or if an error occurs.
+// Implementations should send data to the provided receiver and stop loading, returning the context's error,
+// once ctx is done; nothing may be sent to the receiver after LoadTo returns.
 type HeadingSource interface {
-	LoadTo(receiver DataReceiver) error
+	LoadTo(ctx context.Context, receiver DataReceiver) error
 }
 
 type DataReceiver interface {
 	PutHeadingData(heading *HeadingData)
 	PutApplicationData(application *ApplicationData)
 }
+
+// LegacyHeadingSource is a HeadingSource which can't be cancelled, as they used to be.
+type LegacyHeadingSource interface {
+	LoadTo(receiver DataReceiver) error
+}
+
+// FromLegacy adapts a LegacyHeadingSource to HeadingSource. When ctx is done before the legacy source
+// finishes, LoadTo returns right away and the data the source still sends is dropped.
+func FromLegacy(s LegacyHeadingSource) HeadingSource {
+	return &LegacyAdapter{Source: s}
+}
+
+// LegacyAdapter is the HeadingSource returned by FromLegacy.
+type LegacyAdapter struct {
+	Source LegacyHeadingSource
+}
+
+func (a *LegacyAdapter) LoadTo(ctx context.Context, receiver DataReceiver) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	gate := &gatedReceiver{downstream: receiver}
+	done := make(chan error, 1)
+	go func() {
+		done <- a.Source.LoadTo(gate)
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		gate.close()
+		return ctx.Err()
+	}
+}
+
+// gatedReceiver forwards data to downstream until closed.
+type gatedReceiver struct {
+	downstream DataReceiver
+
+	mu     sync.Mutex
+	closed bool
+}
+
+func (r *gatedReceiver) PutHeadingData(heading *HeadingData) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.closed {
+		r.downstream.PutHeadingData(heading)
+	}
+}
+
+func (r *gatedReceiver) PutApplicationData(application *ApplicationData) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.closed {
+		r.downstream.PutApplicationData(application)
+	}
+}
+
+func (r *gatedReceiver) SkipRow(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.closed {
+		SkipRow(r.downstream, err)
+	}
+}
+
+func (r *gatedReceiver) FailList(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.closed {
+		FailList(r.downstream, err)
+	}
+}
+
+func (r *gatedReceiver) close() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.closed = true
+}
+
+func init() {
+	gob.RegisterName("LegacyHeadingSourceAdapter", &LegacyAdapter{})
+}
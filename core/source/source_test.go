@@ -0,0 +1,164 @@
+package source
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/trueegorletov/analabit/core"
+)
+
+type collectingReceiver struct {
+	headings     []*HeadingData
+	applications []*ApplicationData
+}
+
+func (r *collectingReceiver) PutHeadingData(heading *HeadingData) {
+	r.headings = append(r.headings, heading)
+}
+
+func (r *collectingReceiver) PutApplicationData(application *ApplicationData) {
+	r.applications = append(r.applications, application)
+}
+
+type blockingLegacySource struct {
+	unblock chan struct{}
+	done    chan struct{}
+}
+
+func (s *blockingLegacySource) LoadTo(receiver DataReceiver) error {
+	defer close(s.done)
+	receiver.PutHeadingData(&HeadingData{Code: "math"})
+	<-s.unblock
+	receiver.PutApplicationData(&ApplicationData{HeadingCode: "math", StudentID: "1"})
+	return nil
+}
+
+func TestFromLegacy(t *testing.T) {
+	receiver := &collectingReceiver{}
+	legacy := &blockingLegacySource{unblock: make(chan struct{}), done: make(chan struct{})}
+	close(legacy.unblock)
+
+	require.NoError(t, FromLegacy(legacy).LoadTo(context.Background(), receiver))
+	assert.Len(t, receiver.headings, 1)
+	assert.Len(t, receiver.applications, 1)
+}
+
+func TestFromLegacy_Cancelled(t *testing.T) {
+	receiver := &collectingReceiver{}
+	legacy := &blockingLegacySource{unblock: make(chan struct{}), done: make(chan struct{})}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := FromLegacy(legacy).LoadTo(ctx, receiver)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// The data the legacy source sends after LoadTo returned is dropped
+	close(legacy.unblock)
+	<-legacy.done
+	assert.Empty(t, receiver.applications)
+}
+
+func TestRetry_StopsWhenCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	failure := errors.New("unavailable")
+
+	attempts := 0
+	start := time.Now()
+	err := Retry(ctx, func() error {
+		attempts++
+		cancel()
+		return failure
+	}, 3, func(int) time.Duration { return time.Hour })
+
+	assert.ErrorIs(t, err, failure)
+	assert.Equal(t, 1, attempts)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestSleep(t *testing.T) {
+	assert.NoError(t, Sleep(context.Background(), time.Millisecond))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, Sleep(ctx, time.Hour), context.Canceled)
+}
+
+type skippingSource struct {
+	PrettyName string
+	ListURLs   []string
+	failures   int
+}
+
+func (s *skippingSource) LoadTo(_ context.Context, receiver DataReceiver) error {
+	receiver.PutHeadingData(&HeadingData{Code: "math"})
+	if s.failures > 0 {
+		s.failures--
+		return errors.New("unavailable")
+	}
+	receiver.PutApplicationData(&ApplicationData{HeadingCode: "math", StudentID: "1"})
+	SkipRow(receiver, errors.New("invalid score"))
+	return nil
+}
+
+func TestLoadSource_Report(t *testing.T) {
+	receiver := &collectingReceiver{}
+	hs := &skippingSource{PrettyName: "Математика", ListURLs: []string{"https://example.com/math"}, failures: 1}
+
+	report, err := loadSource(context.Background(), hs, receiver, 3, func(int) time.Duration { return 0 })
+	require.NoError(t, err)
+
+	assert.Equal(t, "source.skippingSource", report.Source)
+	assert.Equal(t, "Математика", report.Name)
+	assert.Equal(t, "https://example.com/math", report.URL)
+	assert.Equal(t, core.SourceLoadOK, report.Status)
+	assert.Equal(t, 1, report.Retries)
+	assert.Equal(t, []string{"math"}, report.HeadingCodes)
+	// Only the attempt which loaded is counted
+	assert.Equal(t, 1, report.Headings)
+	assert.Equal(t, 1, report.Applications)
+	assert.Equal(t, 1, report.SkippedRows)
+	assert.Len(t, receiver.applications, 1)
+}
+
+func TestLoadSource_ReportFailure(t *testing.T) {
+	legacy := &blockingLegacySource{unblock: make(chan struct{}), done: make(chan struct{})}
+	defer close(legacy.unblock)
+	hs := FromLegacy(legacy)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	report, err := loadSource(ctx, hs, &collectingReceiver{}, 3, func(int) time.Duration { return 0 })
+	assert.Error(t, err)
+	assert.Equal(t, "source.blockingLegacySource", report.Source, "legacy sources are reported as themselves")
+	assert.Equal(t, core.SourceLoadCancelled, report.Status)
+	assert.NotEmpty(t, report.Error)
+
+	report, err = loadSource(context.Background(), &skippingSource{failures: 2}, &collectingReceiver{}, 2, func(int) time.Duration { return 0 })
+	assert.Error(t, err)
+	assert.Equal(t, core.SourceLoadFailed, report.Status)
+	assert.Equal(t, 1, report.Retries)
+	assert.Equal(t, 0, report.Applications)
+}
+
+type partialSource struct{}
+
+func (s *partialSource) LoadTo(_ context.Context, receiver DataReceiver) error {
+	receiver.PutHeadingData(&HeadingData{Code: "math"})
+	receiver.PutApplicationData(&ApplicationData{HeadingCode: "math", StudentID: "1"})
+	FailList(receiver, errors.New("target quota list: unavailable"))
+	return nil
+}
+
+func TestLoadSource_ReportPartial(t *testing.T) {
+	report, err := loadSource(context.Background(), &partialSource{}, &collectingReceiver{}, 3, func(int) time.Duration { return 0 })
+	require.NoError(t, err)
+	assert.Equal(t, core.SourceLoadPartial, report.Status)
+	assert.Equal(t, 1, report.FailedLists)
+	assert.Equal(t, "target quota list: unavailable", report.Error)
+	assert.Equal(t, 1, report.Applications)
+}
@@ -0,0 +1,100 @@
+package spbstu
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/source"
+)
+
+func init() {
+	source.RegisterCapacityFeedType("spbstu.places", func() source.CapacityFeed { return &CapacityFeed{} })
+}
+
+// CapacityFeed fetches the number of places SPbSTU publishes for every list of its headings.
+type CapacityFeed struct{}
+
+// FetchCapacities implements source.CapacityFeed for the SPbSTU heading sources. The headings any list of
+// which fails to fetch are left out rather than published with a part of their places.
+func (f *CapacityFeed) FetchCapacities(ctx context.Context, sources []source.HeadingSource) (map[int]core.Capacities, error) {
+	published := make(map[int]core.Capacities)
+	var errs []error
+	for i, hs := range sources {
+		s, ok := hs.(*HTTPHeadingSource)
+		if !ok {
+			continue
+		}
+
+		capacities, err := s.fetchCapacities(ctx, core.Capacities{})
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", s.PrettyName, err))
+			continue
+		}
+		published[i] = capacities
+	}
+
+	// Failing every heading means the site is unavailable rather than a few lists being broken
+	if len(published) == 0 && len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return published, nil
+}
+
+// fetchCapacities fetches the places of the lists of the heading whose capacities are unknown, zero, in
+// known. The places of all target quota lists are summed up, skipping the ones which fail to fetch; the
+// errors of all lists are returned together.
+func (s *HTTPHeadingSource) fetchCapacities(ctx context.Context, known core.Capacities) (core.Capacities, error) {
+	capacities := known
+	var errs []error
+
+	if capacities.Regular == 0 && s.RegularListID != -1 {
+		regular, err := fetchSpbstuCapacity(ctx, s.RegularListID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("regular capacity: %w", err))
+		} else {
+			capacities.Regular = regular
+		}
+	}
+
+	if capacities.DedicatedQuota == 0 && s.DedicatedQuotaListID != -1 {
+		dedicated, err := fetchSpbstuCapacity(ctx, s.DedicatedQuotaListID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("dedicated quota capacity: %w", err))
+		} else {
+			capacities.DedicatedQuota = dedicated
+		}
+	}
+
+	if capacities.SpecialQuota == 0 && s.SpecialQuotaListID != -1 {
+		special, err := fetchSpbstuCapacity(ctx, s.SpecialQuotaListID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("special quota capacity: %w", err))
+		} else {
+			capacities.SpecialQuota = special
+		}
+	}
+
+	// Sum TargetQuota capacities when multiple lists exist
+	if capacities.TargetQuota == 0 && len(s.TargetQuotaListIDs) > 0 {
+		totalTarget := 0
+		for _, listID := range s.TargetQuotaListIDs {
+			if listID == -1 {
+				continue
+			}
+			target, err := fetchSpbstuCapacity(ctx, listID)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("target quota capacity for list %d: %w", listID, err))
+				continue
+			}
+			totalTarget += target
+		}
+		capacities.TargetQuota = totalTarget
+	}
+
+	return capacities, errors.Join(errs...)
+}
@@ -24,6 +24,7 @@ type HTTPHeadingSource struct {
 	DedicatedQuotaListID int
 	SpecialQuotaListID   int
 	Capacities           core.Capacities
+	Metadata             core.HeadingMetadata // Direction code and study form from the lists registry
 }
 
 // SpbstuCapacityResponse represents the capacity response from SPbSTU API.
@@ -60,16 +61,12 @@ func getSpbstuRequestDelay() time.Duration {
 }
 
 // fetchSpbstuListByID fetches and decodes the SPbSTU list from a list ID using FlareSolverr
-func fetchSpbstuListByID(listID int, competitionFilter int) ([]SpbstuApplicationEntry, error) {
+func fetchSpbstuListByID(ctx context.Context, listID int, competitionFilter int) ([]SpbstuApplicationEntry, error) {
 	if listID == -1 {
 		return nil, nil
 	}
 	url := fmt.Sprintf("https://my.spbstu.ru/home/get-abit-list?filter_1=2&filter_2=%d&filter_3=%d&education_level=bachelor", competitionFilter, listID)
 
-	// Create context with timeout to prevent deadlocks
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-
 	// Acquire semaphore for rate limiting
 	release, err := source.AcquireHTTPSemaphores(ctx, "spbstu")
 	if err != nil {
@@ -117,13 +114,15 @@ func fetchSpbstuListByID(listID int, competitionFilter int) ([]SpbstuApplication
 	}
 
 	// Apply delay after each request
-	time.Sleep(getSpbstuRequestDelay())
+	if err := source.Sleep(ctx, getSpbstuRequestDelay()); err != nil {
+		return nil, err
+	}
 
 	return entries, nil
 }
 
 // fetchSpbstuCapacity fetches capacity data for a specific list ID using FlareSolverr
-func fetchSpbstuCapacity(listID int) (int, error) {
+func fetchSpbstuCapacity(ctx context.Context, listID int) (int, error) {
 	if listID == -1 {
 		return 0, nil
 	}
@@ -135,10 +134,6 @@ func fetchSpbstuCapacity(listID int) (int, error) {
 		"education_level": "bachelor",
 	}
 
-	// Create context with timeout to prevent deadlocks
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-
 	// Acquire semaphore for rate limiting
 	release, err := source.AcquireHTTPSemaphores(ctx, "spbstu")
 	if err != nil {
@@ -189,13 +184,15 @@ func fetchSpbstuCapacity(listID int) (int, error) {
 	}
 
 	// Apply delay after each request
-	time.Sleep(getSpbstuRequestDelay())
+	if err := source.Sleep(ctx, getSpbstuRequestDelay()); err != nil {
+		return 0, err
+	}
 
 	return responses[0].Places, nil
 }
 
 // LoadTo implements source.HeadingSource for HTTPHeadingSource.
-func (s *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
+func (s *HTTPHeadingSource) LoadTo(ctx context.Context, receiver source.DataReceiver) error {
 	if s.PrettyName == "" {
 		return fmt.Errorf("PrettyName is required for SPbSTU HTTPHeadingSource")
 	}
@@ -203,55 +200,20 @@ func (s *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
 	headingCode := utils.GenerateHeadingCode(s.PrettyName)
 
 	// Fetch capacities at runtime if not provided
-	capacities := s.Capacities
-	if capacities.Regular == 0 && s.RegularListID != -1 {
-		regular, err := fetchSpbstuCapacity(s.RegularListID)
-		if err != nil {
-			log.Printf("Error fetching regular capacity for %s: %v", s.PrettyName, err)
-		} else {
-			capacities.Regular = regular
-		}
-	}
-
-	if capacities.DedicatedQuota == 0 && s.DedicatedQuotaListID != -1 {
-		dedicated, err := fetchSpbstuCapacity(s.DedicatedQuotaListID)
-		if err != nil {
-			log.Printf("Error fetching dedicated quota capacity for %s: %v", s.PrettyName, err)
-		} else {
-			capacities.DedicatedQuota = dedicated
-		}
-	}
-
-	if capacities.SpecialQuota == 0 && s.SpecialQuotaListID != -1 {
-		special, err := fetchSpbstuCapacity(s.SpecialQuotaListID)
-		if err != nil {
-			log.Printf("Error fetching special quota capacity for %s: %v", s.PrettyName, err)
-		} else {
-			capacities.SpecialQuota = special
-		}
+	capacities, err := s.fetchCapacities(ctx, s.Capacities)
+	if err != nil {
+		log.Printf("Error fetching capacities for %s: %v", s.PrettyName, err)
 	}
 
-	// Sum TargetQuota capacities when multiple lists exist
-	if capacities.TargetQuota == 0 && len(s.TargetQuotaListIDs) > 0 {
-		totalTarget := 0
-		for _, listID := range s.TargetQuotaListIDs {
-			if listID == -1 {
-				continue
-			}
-			target, err := fetchSpbstuCapacity(listID)
-			if err != nil {
-				log.Printf("Error fetching target quota capacity for list %d in %s: %v", listID, s.PrettyName, err)
-				continue
-			}
-			totalTarget += target
-		}
-		capacities.TargetQuota = totalTarget
+	if err := ctx.Err(); err != nil {
+		return err
 	}
 
 	receiver.PutHeadingData(&source.HeadingData{
 		Code:       headingCode,
 		Capacities: capacities,
 		PrettyName: s.PrettyName,
+		Metadata:   s.Metadata,
 	})
 
 	// Define list types with their competition filters
@@ -270,9 +232,13 @@ func (s *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
 		if def.ListID == -1 {
 			continue
 		}
-		entries, err := fetchSpbstuListByID(def.ListID, def.CompetitionFilter)
+		entries, err := fetchSpbstuListByID(ctx, def.ListID, def.CompetitionFilter)
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
 		if err != nil {
 			log.Printf("Error fetching %s (%d): %v", def.ListName, def.ListID, err)
+			source.FailList(receiver, fmt.Errorf("%s (%d): %w", def.ListName, def.ListID, err))
 			continue
 		}
 		if entries == nil {
@@ -287,9 +253,13 @@ func (s *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
 		if listID == -1 {
 			continue
 		}
-		entries, err := fetchSpbstuListByID(listID, 5) // Competition filter 5 for TargetQuota
+		entries, err := fetchSpbstuListByID(ctx, listID, 5) // Competition filter 5 for TargetQuota
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
 		if err != nil {
 			log.Printf("Error fetching Target Quota List %d (%d): %v", i+1, listID, err)
+			source.FailList(receiver, fmt.Errorf("Target Quota List %d (%d): %w", i+1, listID, err))
 			continue
 		}
 		if entries == nil {
@@ -299,5 +269,5 @@ func (s *HTTPHeadingSource) LoadTo(receiver source.DataReceiver) error {
 		parseAndLoadApplications(entries, core.CompetitionTargetQuota, headingCode, receiver)
 	}
 
-	return nil
+	return ctx.Err()
 }
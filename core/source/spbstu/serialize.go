@@ -2,8 +2,12 @@ package spbstu
 
 import (
 	"encoding/gob"
+
+	"github.com/trueegorletov/analabit/core/source"
 )
 
 func init() {
 	gob.Register(&HTTPHeadingSource{})
+
+	source.RegisterHeadingSourceType("spbstu.http", func() source.HeadingSource { return &HTTPHeadingSource{} })
 }
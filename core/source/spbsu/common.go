@@ -40,7 +40,8 @@ type SpbsuListResponse struct {
 type FetchListFunc func(source string) ([]SpbsuApplicationEntry, error)
 
 // parseAndLoadApplications parses entries and emits ApplicationData to the receiver.
-func parseAndLoadApplications(entries []SpbsuApplicationEntry, competitionType core.Competition, headingCode string, receiver source.DataReceiver) {
+// subQuota names the detailed target quota the list belongs to; it's empty for all other lists.
+func parseAndLoadApplications(entries []SpbsuApplicationEntry, competitionType core.Competition, subQuota string, headingCode string, receiver source.DataReceiver) {
 	for _, entry := range entries {
 		competition := competitionType
 
@@ -60,6 +61,7 @@ func parseAndLoadApplications(entries []SpbsuApplicationEntry, competitionType c
 			Priority:          entry.PriorityNumber,
 			CompetitionType:   competition,
 			OriginalSubmitted: entry.AdmissionAgreement,
+			SubQuota:          subQuota,
 		})
 	}
 }
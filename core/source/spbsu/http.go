@@ -17,35 +17,39 @@ import (
 type HttpHeadingSource struct {
 	PrettyName           string
 	RegularListID        int
-	TargetQuotaListIDs   []int
+	TargetQuotaListIDs   []int // A list whose ID is a key of Capacities.TargetSubQuotas is a detailed target quota
 	DedicatedQuotaListID int
 	SpecialQuotaListID   int
+	PaidListID           int // Contract list, loaded only if set; its places are Capacities.Paid
 	Capacities           core.Capacities
 }
 
-func makeSingleRequest(url string) (*http.Response, error) {
+func makeSingleRequest(ctx context.Context, url string) (*http.Response, error) {
 	var attempt int
 	for {
 		attempt++
-		if err := source.WaitBeforeHTTPRequest("spbsu", context.Background()); err != nil {
+		if err := source.WaitBeforeHTTPRequest("spbsu", ctx); err != nil {
 			return nil, fmt.Errorf("timeout coordination failed: %w", err)
 		}
-		release, err := source.AcquireHTTPSemaphores(context.Background(), "spbsu")
+		release, err := source.AcquireHTTPSemaphores(ctx, "spbsu")
 		if err != nil {
 			return nil, fmt.Errorf("failed to acquire semaphore: %w", err)
 		}
-		ctx := context.Background()
 		resp, err := source.GlobalSpbsuRateLimiter.MakeRequest(ctx, url)
 		release()
 		if err != nil {
 			slog.Warn("SPbSU request failed, will retry", "url", url, "attempt", attempt, "error", err)
-			time.Sleep(time.Duration(attempt) * time.Second)
+			if err := source.Sleep(ctx, time.Duration(attempt)*time.Second); err != nil {
+				return nil, err
+			}
 			continue
 		}
 		if resp.StatusCode == http.StatusTooManyRequests || (resp.StatusCode >= 500 && resp.StatusCode <= 599) {
 			slog.Warn("SPbSU request failed with retriable status, will retry", "url", url, "attempt", attempt, "status", resp.Status)
 			resp.Body.Close()
-			time.Sleep(time.Duration(attempt) * time.Second)
+			if err := source.Sleep(ctx, time.Duration(attempt)*time.Second); err != nil {
+				return nil, err
+			}
 			continue
 		}
 		if resp.StatusCode != http.StatusOK {
@@ -56,7 +60,7 @@ func makeSingleRequest(url string) (*http.Response, error) {
 	}
 }
 
-func fetchListResiliently(listID int) ([]SpbsuApplicationEntry, error) {
+func fetchListResiliently(ctx context.Context, listID int) ([]SpbsuApplicationEntry, error) {
 	if listID == -1 {
 		return nil, nil
 	}
@@ -65,17 +69,24 @@ func fetchListResiliently(listID int) ([]SpbsuApplicationEntry, error) {
 	for attempt := 1; ; attempt++ {
 		var allEntries []SpbsuApplicationEntry
 		firstPageURL := baseURL + "&page=1"
-		resp, err := makeSingleRequest(firstPageURL)
+		resp, err := makeSingleRequest(ctx, firstPageURL)
 		if err != nil {
+			if ctx.Err() != nil {
+				return nil, err
+			}
 			slog.Warn("Failed to fetch first page, retrying", "list_id", listID, "attempt", attempt, "error", err)
-			time.Sleep(time.Duration(attempt) * time.Second)
+			if err := source.Sleep(ctx, time.Duration(attempt)*time.Second); err != nil {
+				return nil, err
+			}
 			continue
 		}
 		var firstPageResp SpbsuListResponse
 		if err := json.NewDecoder(resp.Body).Decode(&firstPageResp); err != nil {
 			resp.Body.Close()
 			slog.Warn("Failed to decode first page, retrying", "list_id", listID, "attempt", attempt, "error", err)
-			time.Sleep(time.Duration(attempt) * time.Second)
+			if err := source.Sleep(ctx, time.Duration(attempt)*time.Second); err != nil {
+				return nil, err
+			}
 			continue
 		}
 		resp.Body.Close()
@@ -90,7 +101,9 @@ func fetchListResiliently(listID int) ([]SpbsuApplicationEntry, error) {
 				return allEntries, nil
 			}
 			slog.Warn("First page entry count mismatch, retrying", "list_id", listID, "expected", expectedTotal, "got", len(allEntries))
-			time.Sleep(time.Duration(attempt) * time.Second)
+			if err := source.Sleep(ctx, time.Duration(attempt)*time.Second); err != nil {
+				return nil, err
+			}
 			continue
 		}
 		for page := 2; page <= totalPages; page++ {
@@ -98,17 +111,24 @@ func fetchListResiliently(listID int) ([]SpbsuApplicationEntry, error) {
 			for {
 				pageAttempt++
 				pageURL := baseURL + "&page=" + strconv.Itoa(page)
-				resp, err := makeSingleRequest(pageURL)
+				resp, err := makeSingleRequest(ctx, pageURL)
 				if err != nil {
+					if ctx.Err() != nil {
+						return nil, err
+					}
 					slog.Warn("Failed to fetch page, retrying page", "list_id", listID, "page", page, "attempt", pageAttempt, "error", err)
-					time.Sleep(time.Duration(pageAttempt) * time.Second)
+					if err := source.Sleep(ctx, time.Duration(pageAttempt)*time.Second); err != nil {
+						return nil, err
+					}
 					continue
 				}
 				var pageResp SpbsuListResponse
 				if err := json.NewDecoder(resp.Body).Decode(&pageResp); err != nil {
 					resp.Body.Close()
 					slog.Warn("Failed to decode page, retrying page", "list_id", listID, "page", page, "attempt", pageAttempt, "error", err)
-					time.Sleep(time.Duration(pageAttempt) * time.Second)
+					if err := source.Sleep(ctx, time.Duration(pageAttempt)*time.Second); err != nil {
+						return nil, err
+					}
 					continue
 				}
 				resp.Body.Close()
@@ -121,11 +141,13 @@ func fetchListResiliently(listID int) ([]SpbsuApplicationEntry, error) {
 			return allEntries, nil
 		}
 		slog.Warn("Entry count mismatch, retrying entire list", "list_id", listID, "expected", expectedTotal, "got", len(allEntries), "attempt", attempt)
-		time.Sleep(time.Duration(attempt) * 5 * time.Second)
+		if err := source.Sleep(ctx, time.Duration(attempt)*5*time.Second); err != nil {
+			return nil, err
+		}
 	}
 }
 
-func (s *HttpHeadingSource) LoadTo(receiver source.DataReceiver) error {
+func (s *HttpHeadingSource) LoadTo(ctx context.Context, receiver source.DataReceiver) error {
 	if s.PrettyName == "" {
 		return fmt.Errorf("PrettyName is required for SPbSU HttpHeadingSource")
 	}
@@ -140,32 +162,50 @@ func (s *HttpHeadingSource) LoadTo(receiver source.DataReceiver) error {
 		{s.DedicatedQuotaListID, core.CompetitionDedicatedQuota},
 		{s.SpecialQuotaListID, core.CompetitionSpecialQuota},
 	}
+	if s.PaidListID > 0 {
+		listDefs = append(listDefs, struct {
+			ListID      int
+			Competition core.Competition
+		}{s.PaidListID, core.CompetitionPaid})
+	}
 
 	var allListsLoaded = true
 
 	// Fetch main lists synchronously
 	for _, def := range listDefs {
 		if def.ListID != -1 {
-			entries, err := fetchListResiliently(def.ListID)
+			entries, err := fetchListResiliently(ctx, def.ListID)
 			if err != nil {
+				if ctx.Err() != nil {
+					return err
+				}
 				slog.Error("Failed to fetch list after all retries", "list_id", def.ListID, "error", err)
+				source.FailList(receiver, fmt.Errorf("list %d: %w", def.ListID, err))
 				allListsLoaded = false
 				continue
 			}
-			parseAndLoadApplications(entries, def.Competition, headingCode, receiver)
+			parseAndLoadApplications(entries, def.Competition, "", headingCode, receiver)
 		}
 	}
 
 	// Fetch target quota lists synchronously
 	for _, listID := range s.TargetQuotaListIDs {
 		if listID != -1 {
-			entries, err := fetchListResiliently(listID)
+			entries, err := fetchListResiliently(ctx, listID)
 			if err != nil {
+				if ctx.Err() != nil {
+					return err
+				}
 				slog.Error("Failed to fetch target quota list after all retries", "list_id", listID, "error", err)
+				source.FailList(receiver, fmt.Errorf("target quota list %d: %w", listID, err))
 				allListsLoaded = false
 				continue
 			}
-			parseAndLoadApplications(entries, core.CompetitionTargetQuota, headingCode, receiver)
+			subQuota := ""
+			if _, ok := s.Capacities.TargetSubQuotas[strconv.Itoa(listID)]; ok {
+				subQuota = strconv.Itoa(listID)
+			}
+			parseAndLoadApplications(entries, core.CompetitionTargetQuota, subQuota, headingCode, receiver)
 		}
 	}
 
@@ -2,8 +2,12 @@ package spbsu
 
 import (
 	"encoding/gob"
+
+	"github.com/trueegorletov/analabit/core/source"
 )
 
 func init() {
 	gob.RegisterName("SpbsuHttpHeadingSource", &HttpHeadingSource{})
+
+	source.RegisterHeadingSourceType("spbsu.http", func() source.HeadingSource { return &HttpHeadingSource{} })
 }
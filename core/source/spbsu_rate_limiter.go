@@ -75,8 +75,7 @@ func (rl *SpbsuRateLimiter) MakeRequest(ctx context.Context, url string) (*http.
 		if err != nil {
 			return nil, err
 		}
-		client := &http.Client{Timeout: 0} // No timeout, rely on context
-		resp, err := client.Do(req)
+		resp, err := HTTPClient.Do(req) // No timeout, rely on context
 		if err != nil {
 			slog.Warn("SPbSU request error, retrying", "attempt", attempt, "url", url, "error", err)
 			time.Sleep(time.Duration(attempt) * time.Second)
@@ -0,0 +1,91 @@
+package table
+
+import (
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// HTMLTables returns the <table> elements of doc in document order, the nested ones after their parents.
+func HTMLTables(doc *html.Node) []*html.Node {
+	var tables []*html.Node
+	var find func(*html.Node)
+	find = func(n *html.Node) {
+		if n.Type == html.ElementNode && n.Data == "table" {
+			tables = append(tables, n)
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			find(c)
+		}
+	}
+	find(doc)
+	return tables
+}
+
+// HTMLRows returns the text of the <th> and <td> cells of the rows of table, header rows included. The rows
+// of the tables nested into its cells are left out, and a cell spanning several columns is repeated in each
+// of them so that the cells of the data rows stay under their headers.
+func HTMLRows(table *html.Node) [][]string {
+	var rows [][]string
+	var find func(*html.Node)
+	find = func(n *html.Node) {
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			if c.Type != html.ElementNode {
+				continue
+			}
+			switch c.Data {
+			case "table":
+				// Nested table
+			case "tr":
+				rows = append(rows, rowCells(c))
+			default:
+				find(c)
+			}
+		}
+	}
+	find(table)
+	return rows
+}
+
+func rowCells(tr *html.Node) []string {
+	var cells []string
+	for c := tr.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type != html.ElementNode || (c.Data != "td" && c.Data != "th") {
+			continue
+		}
+
+		text := strings.Join(strings.Fields(textContent(c)), " ")
+		for i := 0; i < colspan(c); i++ {
+			cells = append(cells, text)
+		}
+	}
+	return cells
+}
+
+func colspan(n *html.Node) int {
+	for _, attr := range n.Attr {
+		if attr.Key != "colspan" {
+			continue
+		}
+		if span, ok := ParseInt(attr.Val); ok && span > 1 {
+			return span
+		}
+	}
+	return 1
+}
+
+func textContent(n *html.Node) string {
+	if n.Type == html.TextNode {
+		return n.Data
+	}
+
+	var sb strings.Builder
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type == html.ElementNode && c.Data == "br" {
+			sb.WriteString(" ")
+			continue
+		}
+		sb.WriteString(textContent(c))
+	}
+	return sb.String()
+}
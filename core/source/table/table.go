@@ -0,0 +1,375 @@
+// Package table extracts applications from the tabular admission lists varsities publish as XLSX files or
+// HTML tables. A Spec describes a list by configuration: the header texts its columns are located by, the
+// markers of the competitions, the values meaning yes and the rows to leave out. The engine finds the header
+// row, resolves the columns and parses every row below it into source.ApplicationData.
+package table
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/source"
+)
+
+// Column locates a column by the text of its header cell. Headers are compared case-insensitively, with the
+// surrounding spaces trimmed and the inner runs of spaces, including line breaks and non-breaking spaces,
+// collapsed into one. A zero Column locates nothing.
+type Column struct {
+	Header   string   // Exact header text; if set, Contains must also be satisfied
+	Contains []string // Substrings all of which the header contains
+	Exclude  []string // Substrings none of which the header contains
+}
+
+// IsZero reports whether the column is not declared.
+func (c Column) IsZero() bool {
+	return c.Header == "" && len(c.Contains) == 0
+}
+
+// Matches reports whether header is the header of the column.
+func (c Column) Matches(header string) bool {
+	if c.IsZero() {
+		return false
+	}
+	header = normalize(header)
+	if c.Header != "" && header != normalize(c.Header) {
+		return false
+	}
+	for _, s := range c.Contains {
+		if !strings.Contains(header, normalize(s)) {
+			return false
+		}
+	}
+	for _, s := range c.Exclude {
+		if strings.Contains(header, normalize(s)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Dictionary lists the cell values meaning yes; any other value, the empty one included, means no. Values
+// are compared like headers.
+type Dictionary struct {
+	Yes         []string // Values meaning yes
+	YesContains []string // Substrings of the values meaning yes, like "✓" of a "✓ Диплом" cell
+}
+
+// YesNo is the dictionary of the lists marking flags with "Да" and "Нет".
+var YesNo = Dictionary{Yes: []string{"Да"}}
+
+// Means reports whether value means yes.
+func (d Dictionary) Means(value string) bool {
+	value = normalize(value)
+	if value == "" {
+		return false
+	}
+	for _, s := range d.Yes {
+		if value == normalize(s) {
+			return true
+		}
+	}
+	for _, s := range d.YesContains {
+		if strings.Contains(value, normalize(s)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Marker marks the applications of a competition by a flag column, like the "Право поступления без
+// вступительных испытаний" one of the lists that put all competitions into one table.
+type Marker struct {
+	Column      Column
+	Competition core.Competition
+	Values      *Dictionary // Values of the flag meaning the competition, Spec.Yes if nil
+}
+
+// CompetitionValue names a competition in the competition column of a list.
+type CompetitionValue struct {
+	Contains    string // Substring of the values naming the competition
+	Competition core.Competition
+}
+
+// Spec describes the layout of an admission list.
+type Spec struct {
+	// Required columns: rows without a position are left out, rows without a student ID are invalid
+	Position  Column
+	StudentID Column
+
+	ScoresSum   Column
+	Achievement Column
+	Consent     Column // Flag of the submitted original or of the consent to enrollment
+	Priority    Column
+	// Priority columns of the competitions whose priorities are published apart from the Priority one
+	Priorities map[core.Competition]Column
+
+	// Competition markers, checked in order; the first whose flag is set gives the competition of the row
+	Markers []Marker
+	// Column naming the competition of the row, like the "Категория" one, and the values naming them,
+	// checked in order after the markers
+	CompetitionColumn Column
+	CompetitionValues []CompetitionValue
+	// Competition of the rows no marker nor CompetitionColumn value applies to
+	DefaultCompetition core.Competition
+
+	// Priority of the rows with no valid priority, 1 if 0
+	DefaultPriority int
+	// Values of the flag columns meaning yes, YesNo if zero
+	Yes Dictionary
+
+	// Number of top rows the header row is searched in, all if 0
+	HeaderSearchRows int
+	// Additional columns the varsity parser reads by name with Layout.Value
+	Extra map[string]Column
+	// Filters leave out the rows any of them returns false for, before they are parsed
+	Filters []func(cells []string) bool
+}
+
+// ErrHeaderNotFound is wrapped by the errors of Locate when no row holds the headers of the required columns.
+var ErrHeaderNotFound = errors.New("header row not found")
+
+// Layout is a Spec resolved against the header row of a table.
+type Layout struct {
+	spec *Spec
+
+	// HeaderRow is the index of the header row; the data rows follow it
+	HeaderRow int
+
+	position, studentID, scoresSum, achievement, consent, priority int
+	priorities                                                     map[core.Competition]int
+	markers                                                        []int
+	competition                                                    int
+	extra                                                          map[string]int
+}
+
+// Locate finds the header row of rows, the first holding the headers of both required columns, and
+// resolves the columns of the spec against it. Every column is resolved to the first cell matching it;
+// undeclared and missing optional columns are left unresolved.
+func (s *Spec) Locate(rows [][]string) (*Layout, error) {
+	if s.Position.IsZero() || s.StudentID.IsZero() {
+		return nil, fmt.Errorf("position and student ID columns are required")
+	}
+
+	search := len(rows)
+	if s.HeaderSearchRows > 0 {
+		search = min(search, s.HeaderSearchRows)
+	}
+	for i := 0; i < search; i++ {
+		header := rows[i]
+		if find(header, s.Position) == -1 || find(header, s.StudentID) == -1 {
+			continue
+		}
+
+		l := &Layout{
+			spec:        s,
+			HeaderRow:   i,
+			position:    find(header, s.Position),
+			studentID:   find(header, s.StudentID),
+			scoresSum:   find(header, s.ScoresSum),
+			achievement: find(header, s.Achievement),
+			consent:     find(header, s.Consent),
+			priority:    find(header, s.Priority),
+			priorities:  make(map[core.Competition]int, len(s.Priorities)),
+			markers:     make([]int, len(s.Markers)),
+			competition: find(header, s.CompetitionColumn),
+			extra:       make(map[string]int, len(s.Extra)),
+		}
+		for competition, c := range s.Priorities {
+			l.priorities[competition] = find(header, c)
+		}
+		for j, m := range s.Markers {
+			l.markers[j] = find(header, m.Column)
+		}
+		for name, c := range s.Extra {
+			l.extra[name] = find(header, c)
+		}
+		return l, nil
+	}
+
+	return nil, fmt.Errorf("%w within the %d top rows", ErrHeaderNotFound, search)
+}
+
+// Has reports whether the extra column name was found in the header row.
+func (l *Layout) Has(name string) bool {
+	col, ok := l.extra[name]
+	return ok && col != -1
+}
+
+// Value returns the trimmed value of the extra column name in cells, empty if the column is missing.
+func (l *Layout) Value(cells []string, name string) string {
+	col, ok := l.extra[name]
+	if !ok {
+		return ""
+	}
+	return cell(cells, col)
+}
+
+// Application parses the data row cells into an application without a heading code. It returns nil and no
+// error for the rows the spec leaves out: the ones failing a filter and the ones without a position.
+func (l *Layout) Application(cells []string) (*source.ApplicationData, error) {
+	for _, keep := range l.spec.Filters {
+		if !keep(cells) {
+			return nil, nil
+		}
+	}
+
+	positionStr := cell(cells, l.position)
+	if positionStr == "" {
+		return nil, nil
+	}
+	position, ok := ParseInt(positionStr)
+	if !ok {
+		return nil, fmt.Errorf("invalid position number: %s", positionStr)
+	}
+
+	studentID := cell(cells, l.studentID)
+	if studentID == "" {
+		return nil, fmt.Errorf("empty student ID in row with position %d", position)
+	}
+
+	competitionType := l.competitionType(cells)
+	scoresSum, _ := ParseInt(cell(cells, l.scoresSum))
+	achievement, _ := ParseInt(cell(cells, l.achievement))
+
+	return &source.ApplicationData{
+		StudentID:         studentID,
+		ScoresSum:         scoresSum,
+		RatingPlace:       position,
+		Priority:          l.priorityOf(cells, competitionType),
+		CompetitionType:   competitionType,
+		OriginalSubmitted: l.spec.yes().Means(cell(cells, l.consent)),
+		AchievementScore:  achievement,
+	}, nil
+}
+
+// LoadTo parses the data rows of rows into applications of the heading headingCode and sends them to the
+// receiver. The invalid rows are logged and reported as skipped; sourceHint names the list in the logs.
+func (s *Spec) LoadTo(rows [][]string, headingCode string, receiver source.DataReceiver, sourceHint string) error {
+	l, err := s.Locate(rows)
+	if err != nil {
+		return fmt.Errorf("failed to locate columns in %s: %w", sourceHint, err)
+	}
+
+	for i := l.HeaderRow + 1; i < len(rows); i++ {
+		app, err := l.Application(rows[i])
+		if err != nil {
+			log.Printf("Error parsing row %d from %s: %v", i+1, sourceHint, err)
+			source.SkipRow(receiver, err)
+			continue
+		}
+		if app == nil {
+			continue
+		}
+
+		app.HeadingCode = headingCode
+		receiver.PutApplicationData(app)
+	}
+
+	return nil
+}
+
+func (l *Layout) competitionType(cells []string) core.Competition {
+	for j, m := range l.spec.Markers {
+		values := l.spec.yes()
+		if m.Values != nil {
+			values = *m.Values
+		}
+		if values.Means(cell(cells, l.markers[j])) {
+			return m.Competition
+		}
+	}
+
+	if name := normalize(cell(cells, l.competition)); name != "" {
+		for _, v := range l.spec.CompetitionValues {
+			if strings.Contains(name, normalize(v.Contains)) {
+				return v.Competition
+			}
+		}
+	}
+
+	return l.spec.DefaultCompetition
+}
+
+func (l *Layout) priorityOf(cells []string, competitionType core.Competition) int {
+	col := l.priority
+	if c, ok := l.priorities[competitionType]; ok {
+		col = c
+	}
+
+	if priority, ok := ParseInt(cell(cells, col)); ok && priority > 0 {
+		return priority
+	}
+	if l.spec.DefaultPriority != 0 {
+		return l.spec.DefaultPriority
+	}
+	return 1
+}
+
+func (s *Spec) yes() Dictionary {
+	if len(s.Yes.Yes) == 0 && len(s.Yes.YesContains) == 0 {
+		return YesNo
+	}
+	return s.Yes
+}
+
+// ParseInt parses a number of a list cell, ignoring the spaces and commas separating thousands, like the
+// ones of "1,000", and a zero fractional part, like the one of the "262.0" and "262,0" scores of some lists.
+func ParseInt(s string) (int, bool) {
+	s = strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, s)
+	if groups := strings.Split(s, ","); len(groups) > 1 && thousands(groups[1:]) {
+		s = strings.Join(groups, "")
+	}
+	s = strings.ReplaceAll(s, ",", ".")
+	if whole, frac, ok := strings.Cut(s, "."); ok && strings.Trim(frac, "0") == "" {
+		s = whole
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
+
+// thousands reports whether groups are the groups of three digits of a number written with separated
+// thousands.
+func thousands(groups []string) bool {
+	for _, g := range groups {
+		if len(g) != 3 || strings.Trim(g, "0123456789") != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// find returns the index of the first cell of header matching c, -1 if none does.
+func find(header []string, c Column) int {
+	for i, h := range header {
+		if c.Matches(h) {
+			return i
+		}
+	}
+	return -1
+}
+
+// cell returns the trimmed value of the column col of cells, empty if the row is shorter or col is -1.
+func cell(cells []string, col int) string {
+	if col < 0 || col >= len(cells) {
+		return ""
+	}
+	return strings.TrimSpace(cells[col])
+}
+
+func normalize(s string) string {
+	return strings.ToLower(strings.Join(strings.Fields(s), " "))
+}
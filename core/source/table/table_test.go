@@ -0,0 +1,159 @@
+package table
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/html"
+
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/source"
+)
+
+type receiver struct {
+	applications []*source.ApplicationData
+	skipped      int
+}
+
+func (r *receiver) PutHeadingData(*source.HeadingData) {}
+
+func (r *receiver) PutApplicationData(app *source.ApplicationData) {
+	r.applications = append(r.applications, app)
+}
+
+func (r *receiver) SkipRow(error) {
+	r.skipped++
+}
+
+var flagsSpec = Spec{
+	Position:  Column{Header: "№ п/п"},
+	StudentID: Column{Header: "Уникальный идентификатор"},
+	ScoresSum: Column{Contains: []string{"Сумма конкурсных баллов"}, Exclude: []string{"целевой квоте"}},
+	Consent:   Column{Contains: []string{"согласия на зачисление"}},
+	Priority:  Column{Contains: []string{"Приоритет иных мест"}},
+	Priorities: map[core.Competition]Column{
+		core.CompetitionTargetQuota: {Contains: []string{"Приоритет целевой квоты"}},
+	},
+	Markers: []Marker{
+		{Column: Column{Contains: []string{"без вступительных испытаний"}}, Competition: core.CompetitionBVI},
+		{Column: Column{Contains: []string{"квоты", "целевого приема"}}, Competition: core.CompetitionTargetQuota},
+	},
+	DefaultCompetition: core.CompetitionRegular,
+	HeaderSearchRows:   5,
+}
+
+func TestSpec_LoadTo(t *testing.T) {
+	rows := [][]string{
+		{`Образовательная программа "Физика"`},
+		{},
+		{"№ п/п", "Уникальный идентификатор", "Право поступления\nбез вступительных испытаний", "Поступление в рамках квоты целевого приема",
+			"Приоритет целевой квоты", "Приоритет иных мест", "Сумма конкурсных баллов по целевой квоте", "Сумма конкурсных  баллов", "Наличие согласия на зачисление"},
+		{"1", "1001", "Да", "", "", "2", "", "310", "Да"},
+		{"2", "1002", "", "да", "3", "1", "280", "275.0", "Нет"},
+		{"1,001", "1003", "", "", "", "", "", "250"},
+		{"", "", "", "", "", "", "", "", ""},
+		{"4", "", "", "", "", "1", "", "200", ""},
+		{"five", "1005"},
+	}
+
+	r := &receiver{}
+	require.NoError(t, flagsSpec.LoadTo(rows, "physics", r, "test"))
+	assert.Equal(t, 2, r.skipped)
+	assert.Equal(t, []*source.ApplicationData{
+		{HeadingCode: "physics", StudentID: "1001", ScoresSum: 310, RatingPlace: 1, Priority: 2, CompetitionType: core.CompetitionBVI, OriginalSubmitted: true},
+		{HeadingCode: "physics", StudentID: "1002", ScoresSum: 275, RatingPlace: 2, Priority: 3, CompetitionType: core.CompetitionTargetQuota},
+		// The trailing empty cells are left out, as excelize does
+		{HeadingCode: "physics", StudentID: "1003", ScoresSum: 250, RatingPlace: 1001, Priority: 1, CompetitionType: core.CompetitionRegular},
+	}, r.applications)
+
+	_, err := flagsSpec.Locate(rows[3:])
+	assert.True(t, errors.Is(err, ErrHeaderNotFound))
+}
+
+func TestLayout_CompetitionColumn(t *testing.T) {
+	spec := Spec{
+		Position:          Column{Contains: []string{"№"}},
+		StudentID:         Column{Contains: []string{"Уникальный код"}},
+		Priority:          Column{Header: "Приоритет"},
+		Consent:           Column{Contains: []string{"Согласие"}},
+		CompetitionColumn: Column{Header: "Категория"},
+		CompetitionValues: []CompetitionValue{
+			{Contains: "БВИ", Competition: core.CompetitionBVI},
+			{Contains: "Особая", Competition: core.CompetitionSpecialQuota},
+		},
+		Markers: []Marker{
+			{Column: Column{Contains: []string{"Без вступительных испытаний"}}, Competition: core.CompetitionBVI, Values: &Dictionary{YesContains: []string{"✓", "Диплом"}}},
+		},
+		Yes:             Dictionary{YesContains: []string{"✓"}},
+		DefaultPriority: 1,
+		Extra:           map[string]Column{"origin": {Header: "Оригинал"}, "missing": {Header: "Нет такого"}},
+		Filters: []func(cells []string) bool{
+			func(cells []string) bool { return !strings.HasPrefix(cells[0], "Итого") },
+		},
+	}
+
+	l, err := spec.Locate([][]string{{"№", "Приоритет", "Уникальный код", "Категория", "Согласие", "Без вступительных испытаний", "Оригинал"}})
+	require.NoError(t, err)
+	assert.Equal(t, 0, l.HeaderRow)
+	assert.True(t, l.Has("origin"))
+	assert.False(t, l.Has("missing"))
+
+	app, err := l.Application([]string{"7", "0", "4102004", "Особая квота", "✓", "", "Копия"})
+	require.NoError(t, err)
+	assert.Equal(t, core.CompetitionSpecialQuota, app.CompetitionType)
+	assert.Equal(t, 1, app.Priority)
+	assert.True(t, app.OriginalSubmitted)
+	assert.Equal(t, "Копия", l.Value([]string{"7", "0", "4102004", "", "", "", " Копия "}, "origin"))
+
+	// Markers take precedence over the competition column
+	app, err = l.Application([]string{"8", "2", "4102005", "Общий конкурс", "", "Диплом олимпиады", ""})
+	require.NoError(t, err)
+	assert.Equal(t, core.CompetitionBVI, app.CompetitionType)
+	assert.False(t, app.OriginalSubmitted)
+
+	app, err = l.Application([]string{"Итого: 2"})
+	assert.NoError(t, err)
+	assert.Nil(t, app)
+}
+
+func TestParseInt(t *testing.T) {
+	for s, expected := range map[string]int{
+		"42":      42,
+		" 1 000 ": 1000,
+		"1,000":   1000,
+		"262.0":   262,
+		"262,0":   262,
+		"1 234":   1234,
+	} {
+		n, ok := ParseInt(s)
+		assert.True(t, ok, s)
+		assert.Equal(t, expected, n, s)
+	}
+
+	for _, s := range []string{"", "262,5", "12.5", "abc"} {
+		_, ok := ParseInt(s)
+		assert.False(t, ok, s)
+	}
+}
+
+func TestHTMLRows(t *testing.T) {
+	doc, err := html.Parse(strings.NewReader(`<html><body>
+		<table>
+			<thead><tr><th>№</th><th colspan="2">Баллы</th><th>Уникальный<br>код</th></tr></thead>
+			<tbody>
+				<tr><td>1</td><td>90</td><td>95</td><td><b>4102004</b></td><td><table><tr><td>nested</td></tr></table></td></tr>
+			</tbody>
+		</table>
+	</body></html>`))
+	require.NoError(t, err)
+
+	tables := HTMLTables(doc)
+	require.Len(t, tables, 2)
+	assert.Equal(t, [][]string{
+		{"№", "Баллы", "Баллы", "Уникальный код"},
+		{"1", "90", "95", "4102004", "nested"},
+	}, HTMLRows(tables[0]))
+}
@@ -0,0 +1,25 @@
+package table
+
+import (
+	"fmt"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// XLSXRows returns the rows of the sheet of f, of its first sheet if sheet is empty. As excelize leaves out
+// the trailing empty cells of a row, rows may be shorter than the header; the missing cells read as empty.
+func XLSXRows(f *excelize.File, sheet string) ([][]string, error) {
+	if sheet == "" {
+		sheets := f.GetSheetList()
+		if len(sheets) == 0 {
+			return nil, fmt.Errorf("no sheets found in Excel file")
+		}
+		sheet = sheets[0]
+	}
+
+	rows, err := f.GetRows(sheet)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rows of sheet %s: %w", sheet, err)
+	}
+	return rows, nil
+}
@@ -0,0 +1,117 @@
+package source
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+)
+
+// The heading source types, ID resolutions and capacity feed types varsity definition files refer to by name.
+// Every source package registers its own types on init, next to their gob registration.
+var (
+	typesMu           sync.RWMutex
+	headingSourceNews = make(map[string]func() HeadingSource)
+	headingSourceType = make(map[reflect.Type]string)
+	idResolutionNews  = make(map[string]func() IDResolution)
+	capacityFeedNews  = make(map[string]func() CapacityFeed)
+)
+
+// RegisterHeadingSourceType makes the heading source type created by newSource available to varsity
+// definition files under name. It panics if the name is already taken.
+func RegisterHeadingSourceType(name string, newSource func() HeadingSource) {
+	typesMu.Lock()
+	defer typesMu.Unlock()
+	if _, ok := headingSourceNews[name]; ok {
+		panic(fmt.Sprintf("heading source type %q registered twice", name))
+	}
+	headingSourceNews[name] = newSource
+	headingSourceType[reflect.TypeOf(newSource())] = name
+}
+
+// NewHeadingSource returns a new zero heading source of the type registered under name.
+func NewHeadingSource(name string) (HeadingSource, error) {
+	typesMu.RLock()
+	newSource, ok := headingSourceNews[name]
+	typesMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown heading source type %q", name)
+	}
+	return newSource(), nil
+}
+
+// HeadingSourceType returns the name the type of hs is registered under, false if it isn't.
+func HeadingSourceType(hs HeadingSource) (string, bool) {
+	typesMu.RLock()
+	defer typesMu.RUnlock()
+	name, ok := headingSourceType[reflect.TypeOf(hs)]
+	return name, ok
+}
+
+// HeadingSourceTypes returns the names of all registered heading source types, sorted.
+func HeadingSourceTypes() []string {
+	typesMu.RLock()
+	defer typesMu.RUnlock()
+	names := make([]string, 0, len(headingSourceNews))
+	for name := range headingSourceNews {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// RegisterIDResolution makes the ID resolution created by newResolution available to varsity definition
+// files under name. It panics if the name is already taken.
+func RegisterIDResolution(name string, newResolution func() IDResolution) {
+	typesMu.Lock()
+	defer typesMu.Unlock()
+	if _, ok := idResolutionNews[name]; ok {
+		panic(fmt.Sprintf("ID resolution %q registered twice", name))
+	}
+	idResolutionNews[name] = newResolution
+}
+
+// NewIDResolution returns the ID resolution registered under name.
+func NewIDResolution(name string) (IDResolution, error) {
+	typesMu.RLock()
+	newResolution, ok := idResolutionNews[name]
+	typesMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown ID resolution %q", name)
+	}
+	return newResolution(), nil
+}
+
+// RegisterCapacityFeedType makes the capacity feed type created by newFeed available to varsity definition
+// files under name. It panics if the name is already taken.
+func RegisterCapacityFeedType(name string, newFeed func() CapacityFeed) {
+	typesMu.Lock()
+	defer typesMu.Unlock()
+	if _, ok := capacityFeedNews[name]; ok {
+		panic(fmt.Sprintf("capacity feed type %q registered twice", name))
+	}
+	capacityFeedNews[name] = newFeed
+}
+
+// NewCapacityFeed returns a new zero capacity feed of the type registered under name.
+func NewCapacityFeed(name string) (CapacityFeed, error) {
+	typesMu.RLock()
+	newFeed, ok := capacityFeedNews[name]
+	typesMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown capacity feed type %q", name)
+	}
+	return newFeed(), nil
+}
+
+// CapacityFeedTypes returns the names of all registered capacity feed types, sorted.
+func CapacityFeedTypes() []string {
+	typesMu.RLock()
+	defer typesMu.RUnlock()
+	names := make([]string, 0, len(capacityFeedNews))
+	for name := range capacityFeedNews {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
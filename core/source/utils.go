@@ -14,11 +14,7 @@ func AcquireHTTPSemaphores(ctx context.Context, varsityCode string) (func(), err
 
 	// Acquire per-varsity if exists
 	if sem, ok := VarsitySemaphores[varsityCode]; ok {
-		acquireCtx := ctx
-		if varsityCode == "spbsu" {
-			acquireCtx = context.Background()
-		}
-		if err := sem.Acquire(acquireCtx, 1); err != nil {
+		if err := sem.Acquire(ctx, 1); err != nil {
 			slog.Error("Failed to acquire varsity semaphore", "varsity", varsityCode, "error", err)
 			return nil, err
 		}
@@ -26,11 +22,7 @@ func AcquireHTTPSemaphores(ctx context.Context, varsityCode string) (func(), err
 	}
 
 	// Acquire global
-	globalAcquireCtx := ctx
-	if varsityCode == "spbsu" {
-		globalAcquireCtx = context.Background()
-	}
-	if err := GlobalHTTPSemaphore.Acquire(globalAcquireCtx, 1); err != nil {
+	if err := GlobalHTTPSemaphore.Acquire(ctx, 1); err != nil {
 		slog.Error("Failed to acquire global semaphore", "error", err)
 		// Release any acquired per-varsity
 		for _, rel := range releases {
@@ -48,13 +40,17 @@ func AcquireHTTPSemaphores(ctx context.Context, varsityCode string) (func(), err
 	}, nil
 }
 
-// Retry executes the operation with exponential backoff retries.
-func Retry(operation func() error, maxAttempts int, backoff func(attempt int) time.Duration) error {
+// Retry executes the operation with exponential backoff retries. It gives up once ctx is done.
+func Retry(ctx context.Context, operation func() error, maxAttempts int, backoff func(attempt int) time.Duration) error {
 	for attempt := 1; attempt <= maxAttempts; attempt++ {
 		err := operation()
 		if err == nil {
 			return nil
 		}
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			slog.Error("Operation cancelled, not retrying", "attempt", attempt, "error", err, "cause", ctxErr)
+			return err
+		}
 		if attempt < maxAttempts {
 			sleepDuration := backoff(attempt)
 			// Enhanced logging with error type classification
@@ -70,7 +66,9 @@ func Retry(operation func() error, maxAttempts int, backoff func(attempt int) ti
 				"error", err, 
 				"error_type", errorType,
 				"backoff", sleepDuration)
-			time.Sleep(sleepDuration)
+			if Sleep(ctx, sleepDuration) != nil {
+				return err
+			}
 		} else {
 			slog.Error("Operation failed after max retries", "attempts", maxAttempts, "error", err)
 			return err
@@ -79,6 +77,19 @@ func Retry(operation func() error, maxAttempts int, backoff func(attempt int) ti
 	return nil // Unreachable, but for completeness
 }
 
+// Sleep pauses for d, returning the context's error early if ctx is done first.
+func Sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // isTimeoutError checks if the error is a timeout-related error
 func isTimeoutError(err error) bool {
 	if err == nil {
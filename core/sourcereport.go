@@ -0,0 +1,35 @@
+package core
+
+import "time"
+
+// SourceLoadStatus tells how loading a heading source ended.
+type SourceLoadStatus string
+
+const (
+	// SourceLoadOK marks a source loaded completely, possibly after retries.
+	SourceLoadOK SourceLoadStatus = "ok"
+	// SourceLoadFailed marks a source whose every attempt failed; whatever it sent before failing is kept.
+	SourceLoadFailed SourceLoadStatus = "failed"
+	// SourceLoadPartial marks a source which loaded, but without some of its lists, which failed to load.
+	SourceLoadPartial SourceLoadStatus = "partial"
+	// SourceLoadCancelled marks a source cut short by the load deadline of its varsity or by cancellation.
+	SourceLoadCancelled SourceLoadStatus = "cancelled"
+)
+
+// SourceReport describes how a single heading source of a varsity was loaded. The counts are of its last
+// attempt, though whatever the failed attempts sent is kept too.
+type SourceReport struct {
+	Source       string           `json:"source"`                  // Type of the source, e.g. msu.HTTPHeadingSource
+	Name         string           `json:"name,omitempty"`          // Heading name the source is defined with, if any
+	URL          string           `json:"url,omitempty"`           // First URL the source loads, if it loads by URLs
+	HeadingCodes []string         `json:"heading_codes,omitempty"` // Codes of the headings the source sent
+	Status       SourceLoadStatus `json:"status"`
+	Error        string           `json:"error,omitempty"` // Error of the last attempt or of its failed lists, unless the source loaded
+	StartedAt    time.Time        `json:"started_at"`
+	DurationMs   int64            `json:"duration_ms"`
+	Retries      int              `json:"retries"`
+	Headings     int              `json:"headings"`
+	Applications int              `json:"applications"`
+	SkippedRows  int              `json:"skipped_rows"` // Rows the source couldn't parse
+	FailedLists  int              `json:"failed_lists"` // Lists the source loaded its heading without
+}
@@ -0,0 +1,106 @@
+package core
+
+import (
+	"sort"
+)
+
+// quotaKey identifies a quota heap of a heading: a quota competition type and, for detailed target quotas,
+// the name of the sub-quota. The general target quota and the other quotas have an empty sub-quota name.
+type quotaKey struct {
+	competition Competition
+	subQuota    string
+}
+
+// SubQuotaNames returns the names of the detailed target quotas in sorted order.
+func (c Capacities) SubQuotaNames() []string {
+	names := make([]string, 0, len(c.TargetSubQuotas))
+	for name := range c.TargetSubQuotas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// generalTargetQuota returns the target quota places left for applicants outside of any detailed target quota.
+func (c Capacities) generalTargetQuota() int {
+	subQuotasTotal := 0
+	for _, capacity := range c.TargetSubQuotas {
+		subQuotasTotal += capacity
+	}
+	return max(c.TargetQuota-subQuotasTotal, 0)
+}
+
+// quotaKeyOf returns the quota heap the given quota application competes in. Applications naming
+// a sub-quota the capacities don't know compete in the general target quota.
+func (c Capacities) quotaKeyOf(app *Application) quotaKey {
+	if app.competitionType == CompetitionTargetQuota && app.subQuota != "" {
+		if _, ok := c.TargetSubQuotas[app.subQuota]; ok {
+			return quotaKey{competition: CompetitionTargetQuota, subQuota: app.subQuota}
+		}
+	}
+	return quotaKey{competition: app.competitionType}
+}
+
+// quotaKeyCapacity returns the number of places of the given quota heap.
+func (c Capacities) quotaKeyCapacity(key quotaKey) int {
+	if key.competition != CompetitionTargetQuota {
+		return c.quotaCapacity(key.competition)
+	}
+	if key.subQuota != "" {
+		return c.TargetSubQuotas[key.subQuota]
+	}
+	if len(c.TargetSubQuotas) > 0 {
+		return c.generalTargetQuota()
+	}
+	return c.TargetQuota
+}
+
+// SubQuotaResult holds the outcome of the admission process for a single detailed target quota of a heading.
+type SubQuotaResult struct {
+	Name     string
+	Capacity int
+	// Admitted is the number of students admitted through the sub-quota.
+	Admitted int
+	// PassingScore and LastAdmittedRatingPlace describe the last student admitted through the sub-quota;
+	// both are 0 if nobody was.
+	PassingScore            int
+	LastAdmittedRatingPlace int
+}
+
+// SubQuotaResults returns the outcome of every detailed target quota of the heading, sorted by name.
+// It is empty for headings without detailed target quotas.
+func (r *CalculationResult) SubQuotaResults() []SubQuotaResult {
+	capacities := r.Heading.Capacities()
+	if len(capacities.TargetSubQuotas) == 0 {
+		return nil
+	}
+
+	results := make([]SubQuotaResult, 0, len(capacities.TargetSubQuotas))
+	index := make(map[string]int, len(capacities.TargetSubQuotas))
+	for _, name := range capacities.SubQuotaNames() {
+		index[name] = len(results)
+		results = append(results, SubQuotaResult{Name: name, Capacity: capacities.TargetSubQuotas[name]})
+	}
+
+	for _, student := range r.Admitted {
+		for _, app := range student.applications {
+			if app.heading != r.Heading {
+				continue
+			}
+			key := capacities.quotaKeyOf(app)
+			if key.subQuota == "" {
+				continue
+			}
+
+			result := &results[index[key.subQuota]]
+			result.Admitted++
+			// Within a sub-quota a greater rating place always means a worse applicant
+			if app.ratingPlace > result.LastAdmittedRatingPlace {
+				result.LastAdmittedRatingPlace = app.ratingPlace
+				result.PassingScore = app.score
+			}
+		}
+	}
+
+	return results
+}
@@ -0,0 +1,70 @@
+package core
+
+import "sort"
+
+// ScoreDetails holds the parts of an applicant's score that the official tie-break rules look at
+// once the total scores of two applicants are equal.
+type ScoreDetails struct {
+	// Entrance exam scores in the order of the heading's subject priority (the most important subject first).
+	SubjectScores []int
+	// Points for individual achievements; they are already included into the total score.
+	AchievementScore int
+}
+
+// compareByTieBreak compares two applications of the same competition type according to the ranking order of
+// Order 1076: the total score first, then the sum of the entrance exam scores (the total without the individual
+// achievement points, so fewer achievement points rank higher) and then the exam scores in the order of their
+// priority. The preferential right, which the order ranks by next, isn't published by the lists. It returns
+// a negative value if app1 ranks higher than app2, a positive value if it ranks lower and 0 if the rules can't
+// tell them apart.
+func compareByTieBreak(app1, app2 *Application) int {
+	if app1.score != app2.score {
+		return app2.score - app1.score
+	}
+
+	examSum1, examSum2 := app1.score-app1.achievementScore, app2.score-app2.achievementScore
+	if examSum1 != examSum2 {
+		return examSum2 - examSum1
+	}
+
+	for i := 0; i < len(app1.subjectScores) && i < len(app2.subjectScores); i++ {
+		if app1.subjectScores[i] != app2.subjectScores[i] {
+			return app2.subjectScores[i] - app1.subjectScores[i]
+		}
+	}
+
+	return 0
+}
+
+// officialRanksConsistent checks whether the published rating places of the heading's applications can be trusted:
+// every application must have a rating place, and within each competition type (and each detailed target quota,
+// which has a list of its own) a better place must never belong to an applicant with a lower total score.
+func officialRanksConsistent(apps []*Application) bool {
+	byList := make(map[quotaKey][]*Application)
+	for _, app := range apps {
+		if app.ratingPlace <= 0 {
+			return false
+		}
+		key := quotaKey{competition: app.competitionType, subQuota: app.subQuota}
+		byList[key] = append(byList[key], app)
+	}
+
+	for key, group := range byList {
+		if key.competition == CompetitionBVI {
+			continue // BVI applicants are ranked without exam scores
+		}
+
+		sort.Slice(group, func(i, j int) bool {
+			return group[i].ratingPlace < group[j].ratingPlace
+		})
+
+		for i := 1; i < len(group); i++ {
+			prev, cur := group[i-1], group[i]
+			if prev.score > 0 && cur.score > 0 && prev.score < cur.score {
+				return false
+			}
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,219 @@
+package core
+
+import (
+	"sort"
+	"sync"
+)
+
+// TraceEventKind tells what happened to an application during the deferred-acceptance pass.
+type TraceEventKind int
+
+const (
+	// TraceProposed marks a student proposing to a heading with one of their applications.
+	TraceProposed TraceEventKind = iota
+	// TraceAccepted marks a proposal provisionally accepted by the heading.
+	TraceAccepted
+	// TraceRejected marks a proposal rejected by the heading: there were no seats or the student
+	// didn't outscore the marginal admitted applicant.
+	TraceRejected
+	// TraceDisplaced marks a provisionally accepted student losing their seat to a better applicant.
+	TraceDisplaced
+)
+
+func (k TraceEventKind) String() string {
+	switch k {
+	case TraceProposed:
+		return "Proposed"
+	case TraceAccepted:
+		return "Accepted"
+	case TraceRejected:
+		return "Rejected"
+	case TraceDisplaced:
+		return "Displaced"
+	default:
+		return "UnknownEvent"
+	}
+}
+
+// TraceEvent is a single step of the admission process recorded by AdmissionTrace.
+type TraceEvent struct {
+	Seq             int            `json:"seq"` // Order of the event in the whole trace
+	Kind            TraceEventKind `json:"kind"`
+	Stage           AdmissionStage `json:"stage"`
+	StudentID       string         `json:"student_id"`
+	HeadingCode     string         `json:"heading_code"` // Full heading code
+	Priority        int            `json:"priority"`
+	CompetitionType Competition    `json:"competition_type"`
+	// The other applicant involved: for TraceRejected the marginal applicant the student failed to outscore
+	// (empty if the heading had no seats at all), for TraceDisplaced the applicant who took the seat and
+	// for TraceAccepted the applicant displaced by this acceptance, if any.
+	OtherStudentID string `json:"other_student_id,omitempty"`
+}
+
+// AdmissionTrace records every proposal, acceptance, rejection and displacement of a calculation.
+// A nil *AdmissionTrace is valid and records nothing, so the calculation code can call it unconditionally.
+type AdmissionTrace struct {
+	mu     sync.Mutex
+	events []TraceEvent
+	stage  AdmissionStage
+}
+
+// NewAdmissionTrace creates an empty trace.
+func NewAdmissionTrace() *AdmissionTrace {
+	return &AdmissionTrace{}
+}
+
+// setStage makes the following events be recorded as belonging to the given enrollment stage.
+func (t *AdmissionTrace) setStage(stage AdmissionStage) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.stage = stage
+}
+
+func (t *AdmissionTrace) record(kind TraceEventKind, app *Application, other *Application) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	event := TraceEvent{
+		Seq:             len(t.events),
+		Kind:            kind,
+		Stage:           t.stage,
+		StudentID:       app.StudentID(),
+		HeadingCode:     app.Heading().FullCode(),
+		Priority:        app.Priority(),
+		CompetitionType: app.CompetitionType(),
+	}
+	if other != nil {
+		event.OtherStudentID = other.StudentID()
+	}
+	t.events = append(t.events, event)
+}
+
+// Events returns all recorded events in the order they happened.
+func (t *AdmissionTrace) Events() []TraceEvent {
+	if t == nil {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]TraceEvent(nil), t.events...)
+}
+
+// StudentEvents returns the events of the given student's applications in the order they happened.
+func (t *AdmissionTrace) StudentEvents(studentID string) []TraceEvent {
+	var events []TraceEvent
+	for _, event := range t.Events() {
+		if event.StudentID == studentID {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+// subset returns a new trace holding only the events of the given headings, with their original sequence numbers.
+func (t *AdmissionTrace) subset(headings []*Heading) *AdmissionTrace {
+	codes := make(map[string]bool, len(headings))
+	for _, h := range headings {
+		codes[h.FullCode()] = true
+	}
+
+	sub := NewAdmissionTrace()
+	for _, event := range t.Events() {
+		if codes[event.HeadingCode] {
+			sub.events = append(sub.events, event)
+		}
+	}
+	return sub
+}
+
+// HeadingLoss describes why a student didn't get a seat at a heading they preferred to the final one.
+type HeadingLoss struct {
+	HeadingCode     string         `json:"heading_code"`
+	Priority        int            `json:"priority"`
+	CompetitionType Competition    `json:"competition_type"`
+	Kind            TraceEventKind `json:"kind"` // TraceRejected or TraceDisplaced
+	// The marginal applicant the student finally lost the seat to; empty if the heading had no seats at all.
+	LostToStudentID string `json:"lost_to_student_id,omitempty"`
+}
+
+// AdmissionExplanation is the chain of admission events of a single student together with
+// the reasons they didn't pass to each of their higher-priority headings.
+type AdmissionExplanation struct {
+	StudentID string       `json:"student_id"`
+	Events    []TraceEvent `json:"events"`
+	// Heading the student ended up admitted to; empty if they weren't admitted anywhere.
+	AdmittedHeadingCode string        `json:"admitted_heading_code,omitempty"`
+	AdmittedPriority    int           `json:"admitted_priority,omitempty"`
+	Losses              []HeadingLoss `json:"losses"`
+}
+
+// ExplainAdmission builds the explanation of the given student's admission from the trace events.
+// The events may belong to other students too; only the student's own events are used.
+func ExplainAdmission(studentID string, events []TraceEvent) AdmissionExplanation {
+	explanation := AdmissionExplanation{StudentID: studentID}
+
+	for _, event := range events {
+		if event.StudentID == studentID {
+			explanation.Events = append(explanation.Events, event)
+		}
+	}
+	sort.SliceStable(explanation.Events, func(i, j int) bool {
+		return explanation.Events[i].Seq < explanation.Events[j].Seq
+	})
+
+	// The final seat is the last acceptance that was never followed by a displacement
+	var admitted *TraceEvent
+	for i := range explanation.Events {
+		event := &explanation.Events[i]
+		switch event.Kind {
+		case TraceAccepted:
+			admitted = event
+		case TraceDisplaced:
+			if admitted != nil && admitted.HeadingCode == event.HeadingCode && admitted.CompetitionType == event.CompetitionType {
+				admitted = nil
+			}
+		}
+	}
+	if admitted != nil {
+		explanation.AdmittedHeadingCode = admitted.HeadingCode
+		explanation.AdmittedPriority = admitted.Priority
+	}
+
+	lossIndex := make(map[string]int) // heading code + competition type -> index in Losses
+	for _, event := range explanation.Events {
+		if event.Kind != TraceRejected && event.Kind != TraceDisplaced {
+			continue
+		}
+		if admitted != nil && event.Priority >= admitted.Priority {
+			continue
+		}
+
+		loss := HeadingLoss{
+			HeadingCode:     event.HeadingCode,
+			Priority:        event.Priority,
+			CompetitionType: event.CompetitionType,
+			Kind:            event.Kind,
+			LostToStudentID: event.OtherStudentID,
+		}
+
+		key := event.HeadingCode + "/" + event.CompetitionType.String()
+		if i, ok := lossIndex[key]; ok {
+			explanation.Losses[i] = loss // The last loss at a heading is the one that counts
+		} else {
+			lossIndex[key] = len(explanation.Losses)
+			explanation.Losses = append(explanation.Losses, loss)
+		}
+	}
+
+	sort.SliceStable(explanation.Losses, func(i, j int) bool {
+		return explanation.Losses[i].Priority < explanation.Losses[j].Priority
+	})
+
+	return explanation
+}
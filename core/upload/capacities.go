@@ -0,0 +1,44 @@
+package upload
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/ent"
+)
+
+// CapacityDrifts stores the comparisons of the published capacities of the varsity's headings with the
+// registry ones for the run. Like the source reports they are only inserted, so no lock is needed.
+func CapacityDrifts(ctx context.Context, client *ent.Client, runID int, varsityCode string, drifts []core.CapacityDrift) error {
+	if len(drifts) == 0 {
+		return nil
+	}
+
+	builders := make([]*ent.CapacityDriftCreate, 0, len(drifts))
+	for _, drift := range drifts {
+		builders = append(builders, client.CapacityDrift.Create().
+			SetVarsityCode(varsityCode).
+			SetHeadingCode(drift.HeadingCode).
+			SetName(drift.Name).
+			SetRegistryRegular(drift.Registry.Regular).
+			SetRegistryTargetQuota(drift.Registry.TargetQuota).
+			SetRegistryDedicatedQuota(drift.Registry.DedicatedQuota).
+			SetRegistrySpecialQuota(drift.Registry.SpecialQuota).
+			SetRegistryTargetSubQuotas(drift.Registry.TargetSubQuotas).
+			SetPublishedRegular(drift.Published.Regular).
+			SetPublishedTargetQuota(drift.Published.TargetQuota).
+			SetPublishedDedicatedQuota(drift.Published.DedicatedQuota).
+			SetPublishedSpecialQuota(drift.Published.SpecialQuota).
+			SetPublishedTargetSubQuotas(drift.Published.TargetSubQuotas).
+			SetTotalOnly(drift.TotalOnly).
+			SetDrifted(drift.Drifted()).
+			SetApplied(drift.Applied).
+			SetRunID(runID))
+	}
+
+	if err := client.CapacityDrift.CreateBulk(builders...).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to create capacity drifts: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package upload
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/ent"
+)
+
+const (
+	admissionChancesLockID = 4
+	admissionChancesBatch  = 1000
+)
+
+func AdmissionChances(ctx context.Context, client *ent.Client, runID int, chances []core.AdmissionChanceDTO) error {
+	h := &helper{
+		client: client,
+		runID:  runID,
+	}
+
+	return h.doUploadChances(ctx, chances)
+}
+
+func (u *helper) doUploadChances(ctx context.Context, chances []core.AdmissionChanceDTO) error {
+	return WithTx(ctx, u.client, func(tx *ent.Tx) error {
+		if err := lock(ctx, tx, admissionChancesLockID); err != nil {
+			return err
+		}
+		defer unlock(ctx, tx, admissionChancesLockID)
+
+		txu := &helper{
+			client: tx.Client(),
+			runID:  u.runID,
+		}
+
+		return txu.uploadChances(ctx, chances)
+	})
+}
+
+func (u *helper) uploadChances(ctx context.Context, chances []core.AdmissionChanceDTO) error {
+	headings := make(map[string]*ent.Heading)
+	builders := make([]*ent.AdmissionChanceCreate, 0, admissionChancesBatch)
+
+	flush := func() error {
+		if len(builders) == 0 {
+			return nil
+		}
+		if err := u.client.AdmissionChance.CreateBulk(builders...).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to create admission chances: %w", err)
+		}
+		builders = builders[:0]
+		return nil
+	}
+
+	for _, chance := range chances {
+		if chance.Iterations <= 0 {
+			continue
+		}
+
+		h, ok := headings[chance.HeadingCode]
+		if !ok {
+			var err error
+			h, err = u.headingByCodeSimple(ctx, chance.HeadingCode)
+			if err != nil {
+				return err
+			}
+			headings[chance.HeadingCode] = h
+		}
+
+		builders = append(builders, u.client.AdmissionChance.Create().
+			SetStudentID(chance.StudentID).
+			SetDrainedPercent(chance.DrainedPercent).
+			SetAdmittedCount(chance.AdmittedCount).
+			SetIterations(chance.Iterations).
+			SetProbability(float64(chance.AdmittedCount)/float64(chance.Iterations)).
+			SetRunID(u.runID).
+			SetHeading(h))
+
+		if len(builders) == admissionChancesBatch {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return flush()
+}
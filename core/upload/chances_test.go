@@ -0,0 +1,47 @@
+package upload
+
+import (
+	"context"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/ent/admissionchance"
+	"github.com/trueegorletov/analabit/core/ent/enttest"
+)
+
+func TestUploadChances(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:upload_chances?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+
+	run := client.Run.Create().SaveX(ctx)
+	v := client.Varsity.Create().SetCode("test").SetName("Test Varsity").SaveX(ctx)
+	client.Heading.Create().
+		SetCode("test:H1").SetName("Heading 1").
+		SetRegularCapacity(10).SetTargetQuotaCapacity(0).SetDedicatedQuotaCapacity(0).SetSpecialQuotaCapacity(0).
+		SetVarsity(v).
+		SaveX(ctx)
+
+	u := &helper{client: client, runID: run.ID}
+	require.NoError(t, u.uploadChances(ctx, []core.AdmissionChanceDTO{
+		{HeadingCode: "test:H1", StudentID: "1", DrainedPercent: 50, AdmittedCount: 5, Iterations: 20},
+		{HeadingCode: "test:H1", StudentID: "1", DrainedPercent: 90, AdmittedCount: 20, Iterations: 20},
+		// Chances of stages that ran no iterations are left out
+		{HeadingCode: "test:H1", StudentID: "2", DrainedPercent: 50, AdmittedCount: 1},
+	}))
+
+	chances := client.AdmissionChance.Query().Order(admissionchance.ByDrainedPercent()).AllX(ctx)
+	require.Len(t, chances, 2)
+	assert.Equal(t, 0.25, chances[0].Probability)
+	assert.Equal(t, 1.0, chances[1].Probability)
+	assert.Equal(t, run.ID, chances[0].RunID)
+
+	// Chances are uploaded after the headings, so an unknown heading is an error
+	assert.Error(t, u.uploadChances(ctx, []core.AdmissionChanceDTO{
+		{HeadingCode: "test:H2", StudentID: "1", DrainedPercent: 50, AdmittedCount: 5, Iterations: 20},
+	}))
+}
@@ -58,6 +58,24 @@ func (u *helper) uploadDrained(ctx context.Context, results []core.DrainedResult
 			SetMedLastAdmittedRatingPlace(result.MedLastAdmittedRatingPlace).
 			SetIsVirtual(result.IsVirtual).
 			SetRegularsAdmitted(result.RegularsAdmitted).
+			SetSeed(result.Seed).
+			SetDrainModel(result.DrainModel).
+			SetAvgPriorityStageAdmitted(result.AvgPriorityStageAdmitted).
+			SetAvgMainStageAdmitted(result.AvgMainStageAdmitted).
+			SetSubQuotas(result.SubQuotas).
+			SetAvgPaidPassingScore(result.AvgPaidPassingScore).
+			SetAvgPaidLastAdmittedRatingPlace(result.AvgPaidLastAdmittedRatingPlace).
+			SetP10PassingScore(result.P10PassingScore).
+			SetP25PassingScore(result.P25PassingScore).
+			SetP75PassingScore(result.P75PassingScore).
+			SetP90PassingScore(result.P90PassingScore).
+			SetP10LastAdmittedRatingPlace(result.P10LastAdmittedRatingPlace).
+			SetP25LastAdmittedRatingPlace(result.P25LastAdmittedRatingPlace).
+			SetP75LastAdmittedRatingPlace(result.P75LastAdmittedRatingPlace).
+			SetP90LastAdmittedRatingPlace(result.P90LastAdmittedRatingPlace).
+			SetIterations(result.Iterations).
+			SetPassingScoreHistogram(result.PassingScoreHistogram).
+			SetLastAdmittedRatingPlaceHistogram(result.LastAdmittedRatingPlaceHistogram).
 			SetRunID(u.runID).
 			SetHeading(h).
 			Exec(ctx)
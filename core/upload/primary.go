@@ -1,9 +1,12 @@
 package upload
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"log/slog"
+	"maps"
+	"slices"
 	"strings"
 
 	"github.com/trueegorletov/analabit/core"
@@ -60,6 +63,10 @@ func (u *helper) doUploadPrimary(ctx context.Context) (err error) {
 			runID:       u.runID,
 		}
 
+		if err := txu.updateVarsityMetadataIfNeeded(ctx); err != nil {
+			return err
+		}
+
 		if len(u.payload.Applications) > 0 {
 			if err := txu.uploadApplications(ctx, u.payload.Applications, u.payload.Students); err != nil {
 				return fmt.Errorf("failed to uploadPrimary applications: %w", err)
@@ -98,12 +105,22 @@ func (u *helper) uploadApplications(ctx context.Context, applications []core.App
 			SetCompetitionType(app.CompetitionType).
 			SetRatingPlace(app.RatingPlace).
 			SetScore(app.Score).
+			SetAchievementScore(app.AchievementScore).
 			SetOriginalSubmitted(originalSubmitted).
 			SetRunID(u.runID).
 			SetHeading(h)
 
-		if app.MSUInternalID != nil {
-			createApp = createApp.SetMsuInternalID(*app.MSUInternalID)
+		if len(app.SubjectScores) > 0 {
+			createApp = createApp.SetSubjectScores(app.SubjectScores)
+		}
+
+		if app.SubQuota != "" {
+			createApp = createApp.SetSubQuota(app.SubQuota)
+		}
+
+		// Payloads of older producers have the internal ID under its deprecated name only
+		if internalID := cmp.Or(app.InternalID, app.MSUInternalID); internalID != nil {
+			createApp = createApp.SetInternalID(*internalID)
 		}
 
 		err = createApp.Exec(ctx)
@@ -130,6 +147,7 @@ func (u *helper) uploadCalculations(ctx context.Context, calculations []core.Cal
 			err = u.client.Calculation.Create().
 				SetStudentID(student.ID).
 				SetAdmittedPlace(admittedPlace).
+				SetStage(result.Stages[student.ID]).
 				SetRunID(u.runID).
 				SetHeading(h).
 				Exec(ctx)
@@ -140,6 +158,21 @@ func (u *helper) uploadCalculations(ctx context.Context, calculations []core.Cal
 			}
 		}
 
+		for j, student := range result.PaidAdmitted {
+			err = u.client.Calculation.Create().
+				SetStudentID(student.ID).
+				SetAdmittedPlace(j + 1).
+				SetPaid(true).
+				SetRunID(u.runID).
+				SetHeading(h).
+				Exec(ctx)
+
+			if err != nil {
+				return fmt.Errorf("failed to create paid calculation for student %s in heading %s: %v",
+					student.ID, h.Code, err)
+			}
+		}
+
 	}
 
 	return nil
@@ -205,6 +238,13 @@ func (u *helper) createHeadingFromDTO(ctx context.Context, dto core.HeadingDTO,
 		SetTargetQuotaCapacity(dto.TargetQuotaCapacity).
 		SetDedicatedQuotaCapacity(dto.DedicatedQuotaCapacity).
 		SetSpecialQuotaCapacity(dto.SpecialQuotaCapacity).
+		SetSubjects(dto.Subjects).
+		SetTargetSubQuotas(dto.TargetSubQuotas).
+		SetPaidCapacity(dto.PaidCapacity).
+		SetDirectionCode(dto.DirectionCode).
+		SetLevel(dto.Level).
+		SetStudyForm(dto.StudyForm).
+		SetExams(dto.Exams).
 		SetVarsity(v).
 		Exec(ctx)
 	if err != nil {
@@ -246,10 +286,21 @@ func (u *helper) varsity(ctx context.Context, code string) (*ent.Varsity, error)
 }
 
 func (u *helper) createVarsity(ctx context.Context, code, prettyName string) (*ent.Varsity, error) {
-	err := u.client.Varsity.Create().
+	create := u.client.Varsity.Create().
 		SetCode(code).
-		SetName(prettyName).
-		Exec(ctx)
+		SetName(prettyName)
+
+	// The payload only describes its own varsity
+	if code == u.payload.VarsityCode {
+		m := u.payload.VarsityMetadata
+		create = create.
+			SetCity(m.City).
+			SetRegion(m.Region).
+			SetParent(m.Parent).
+			SetSite(m.Site)
+	}
+
+	err := create.Exec(ctx)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to create varsity %s: %w", code, err)
@@ -264,6 +315,36 @@ func (u *helper) createVarsity(ctx context.Context, code, prettyName string) (*e
 	return save, nil
 }
 
+// updateVarsityMetadataIfNeeded updates the location and parent organization of the payload's varsity if
+// it already exists and they differ from the payload's. New varsities get them on creation.
+func (u *helper) updateVarsityMetadataIfNeeded(ctx context.Context) error {
+	v, err := u.client.Varsity.Query().Where(varsity.Code(u.payload.VarsityCode)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to query varsity %s: %w", u.payload.VarsityCode, err)
+	}
+
+	m := u.payload.VarsityMetadata
+	if v.City == m.City && v.Region == m.Region && v.Parent == m.Parent && v.Site == m.Site {
+		return nil
+	}
+
+	err = v.Update().
+		SetCity(m.City).
+		SetRegion(m.Region).
+		SetParent(m.Parent).
+		SetSite(m.Site).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update metadata of varsity %s: %w", v.Code, err)
+	}
+
+	slog.Info("updated varsity metadata", "code", v.Code)
+	return nil
+}
+
 // updateHeadingCapacitiesIfNeeded compares the existing heading capacities with DTO values
 // and updates the heading if any capacities differ
 func (u *helper) updateHeadingCapacitiesIfNeeded(ctx context.Context, existingHeading *ent.Heading, dto core.HeadingDTO) (*ent.Heading, error) {
@@ -271,7 +352,14 @@ func (u *helper) updateHeadingCapacitiesIfNeeded(ctx context.Context, existingHe
 	needsUpdate := existingHeading.RegularCapacity != dto.RegularCapacity ||
 		existingHeading.TargetQuotaCapacity != dto.TargetQuotaCapacity ||
 		existingHeading.DedicatedQuotaCapacity != dto.DedicatedQuotaCapacity ||
-		existingHeading.SpecialQuotaCapacity != dto.SpecialQuotaCapacity
+		existingHeading.SpecialQuotaCapacity != dto.SpecialQuotaCapacity ||
+		!slices.Equal(existingHeading.Subjects, dto.Subjects) ||
+		!maps.Equal(existingHeading.TargetSubQuotas, dto.TargetSubQuotas) ||
+		existingHeading.PaidCapacity != dto.PaidCapacity ||
+		existingHeading.DirectionCode != dto.DirectionCode ||
+		existingHeading.Level != dto.Level ||
+		existingHeading.StudyForm != dto.StudyForm ||
+		!slices.Equal(existingHeading.Exams, dto.Exams)
 
 	if !needsUpdate {
 		return existingHeading, nil
@@ -288,7 +376,9 @@ func (u *helper) updateHeadingCapacitiesIfNeeded(ctx context.Context, existingHe
 		"old_dedicated_quota", existingHeading.DedicatedQuotaCapacity,
 		"new_dedicated_quota", dto.DedicatedQuotaCapacity,
 		"old_special_quota", existingHeading.SpecialQuotaCapacity,
-		"new_special_quota", dto.SpecialQuotaCapacity)
+		"new_special_quota", dto.SpecialQuotaCapacity,
+		"old_paid", existingHeading.PaidCapacity,
+		"new_paid", dto.PaidCapacity)
 
 	// Update the heading capacities
 	err := u.client.Heading.UpdateOneID(existingHeading.ID).
@@ -296,6 +386,13 @@ func (u *helper) updateHeadingCapacitiesIfNeeded(ctx context.Context, existingHe
 		SetTargetQuotaCapacity(dto.TargetQuotaCapacity).
 		SetDedicatedQuotaCapacity(dto.DedicatedQuotaCapacity).
 		SetSpecialQuotaCapacity(dto.SpecialQuotaCapacity).
+		SetSubjects(dto.Subjects).
+		SetTargetSubQuotas(dto.TargetSubQuotas).
+		SetPaidCapacity(dto.PaidCapacity).
+		SetDirectionCode(dto.DirectionCode).
+		SetLevel(dto.Level).
+		SetStudyForm(dto.StudyForm).
+		SetExams(dto.Exams).
 		Exec(ctx)
 
 	if err != nil {
@@ -0,0 +1,45 @@
+package upload
+
+import (
+	"context"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/ent/application"
+	"github.com/trueegorletov/analabit/core/ent/enttest"
+)
+
+func TestUploadApplications_InternalID(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:upload_applications_internal_id?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+
+	run := client.Run.Create().SaveX(ctx)
+	v := client.Varsity.Create().SetCode("msu").SetName("MSU").SaveX(ctx)
+	client.Heading.Create().
+		SetCode("msu:H1").SetName("Heading 1").
+		SetRegularCapacity(10).SetTargetQuotaCapacity(0).SetDedicatedQuotaCapacity(0).SetSpecialQuotaCapacity(0).
+		SetVarsity(v).
+		SaveX(ctx)
+
+	internalID, deprecatedInternalID := "001234", "005678"
+	u := &helper{client: client, runID: run.ID}
+	require.NoError(t, u.uploadApplications(ctx, []core.ApplicationDTO{
+		{StudentID: "1", HeadingCode: "msu:H1", Priority: 1, CompetitionType: core.CompetitionRegular, RatingPlace: 1, Score: 280, InternalID: &internalID},
+		// Payloads of older producers only have the deprecated name
+		{StudentID: "2", HeadingCode: "msu:H1", Priority: 1, CompetitionType: core.CompetitionRegular, RatingPlace: 2, Score: 270, MSUInternalID: &deprecatedInternalID},
+		{StudentID: "3", HeadingCode: "msu:H1", Priority: 1, CompetitionType: core.CompetitionRegular, RatingPlace: 3, Score: 260},
+	}, nil))
+
+	apps := client.Application.Query().Order(application.ByRatingPlace()).AllX(ctx)
+	require.Len(t, apps, 3)
+	require.NotNil(t, apps[0].InternalID)
+	assert.Equal(t, internalID, *apps[0].InternalID)
+	require.NotNil(t, apps[1].InternalID)
+	assert.Equal(t, deprecatedInternalID, *apps[1].InternalID)
+	assert.Nil(t, apps[2].InternalID)
+}
@@ -0,0 +1,42 @@
+package upload
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/ent"
+)
+
+// SourceReports stores the reports of how the heading sources of the varsity were loaded for the run.
+// The reports are only inserted, so unlike the other uploads it needs no lock.
+func SourceReports(ctx context.Context, client *ent.Client, runID int, varsityCode string, reports []core.SourceReport) error {
+	if len(reports) == 0 {
+		return nil
+	}
+
+	builders := make([]*ent.SourceReportCreate, 0, len(reports))
+	for _, report := range reports {
+		builders = append(builders, client.SourceReport.Create().
+			SetVarsityCode(varsityCode).
+			SetSource(report.Source).
+			SetName(report.Name).
+			SetURL(report.URL).
+			SetHeadingCodes(report.HeadingCodes).
+			SetStatus(report.Status).
+			SetError(report.Error).
+			SetStartedAt(report.StartedAt).
+			SetDurationMs(report.DurationMs).
+			SetRetries(report.Retries).
+			SetHeadings(report.Headings).
+			SetApplications(report.Applications).
+			SetSkippedRows(report.SkippedRows).
+			SetFailedLists(report.FailedLists).
+			SetRunID(runID))
+	}
+
+	if err := client.SourceReport.CreateBulk(builders...).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to create source reports: %w", err)
+	}
+	return nil
+}
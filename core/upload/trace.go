@@ -0,0 +1,86 @@
+package upload
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/ent"
+)
+
+const (
+	admissionEventsLockID = 5
+	admissionEventsBatch  = 1000
+)
+
+func AdmissionTrace(ctx context.Context, client *ent.Client, runID int, events []core.TraceEvent) error {
+	h := &helper{
+		client: client,
+		runID:  runID,
+	}
+
+	return h.doUploadTrace(ctx, events)
+}
+
+func (u *helper) doUploadTrace(ctx context.Context, events []core.TraceEvent) error {
+	return WithTx(ctx, u.client, func(tx *ent.Tx) error {
+		if err := lock(ctx, tx, admissionEventsLockID); err != nil {
+			return err
+		}
+		defer unlock(ctx, tx, admissionEventsLockID)
+
+		txu := &helper{
+			client: tx.Client(),
+			runID:  u.runID,
+		}
+
+		return txu.uploadTrace(ctx, events)
+	})
+}
+
+func (u *helper) uploadTrace(ctx context.Context, events []core.TraceEvent) error {
+	headings := make(map[string]*ent.Heading)
+	builders := make([]*ent.AdmissionEventCreate, 0, admissionEventsBatch)
+
+	flush := func() error {
+		if len(builders) == 0 {
+			return nil
+		}
+		if err := u.client.AdmissionEvent.CreateBulk(builders...).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to create admission events: %w", err)
+		}
+		builders = builders[:0]
+		return nil
+	}
+
+	for _, event := range events {
+		h, ok := headings[event.HeadingCode]
+		if !ok {
+			var err error
+			h, err = u.headingByCodeSimple(ctx, event.HeadingCode)
+			if err != nil {
+				return err
+			}
+			headings[event.HeadingCode] = h
+		}
+
+		builders = append(builders, u.client.AdmissionEvent.Create().
+			SetSeq(event.Seq).
+			SetKind(event.Kind).
+			SetStage(event.Stage).
+			SetStudentID(event.StudentID).
+			SetPriority(event.Priority).
+			SetCompetitionType(event.CompetitionType).
+			SetOtherStudentID(event.OtherStudentID).
+			SetRunID(u.runID).
+			SetHeading(h))
+
+		if len(builders) == admissionEventsBatch {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return flush()
+}
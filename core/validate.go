@@ -0,0 +1,399 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+)
+
+// ViolationKind names an invariant of the admission results that has been broken.
+type ViolationKind string
+
+const (
+	// ViolationOverCapacity: a heading admitted more students than its total capacity.
+	ViolationOverCapacity ViolationKind = "over_capacity"
+	// ViolationQuotaOverCapacity: a quota admitted more students than its capacity, so quota applicants
+	// spilled into the general seats.
+	ViolationQuotaOverCapacity ViolationKind = "quota_over_capacity"
+	// ViolationNotApplicant: a student was admitted although they don't take part in the enrollment
+	// (they quit, have no applications or were already admitted by an earlier stage).
+	ViolationNotApplicant ViolationKind = "not_applicant"
+	// ViolationNoApplication: a student was admitted to a heading they have no application to.
+	ViolationNoApplication ViolationKind = "no_application"
+	// ViolationDuplicateAdmission: a student was admitted to more than one heading.
+	ViolationDuplicateAdmission ViolationKind = "duplicate_admission"
+	// ViolationBlockingPair: a student prefers a heading that would accept them over its worst admitted
+	// applicant (or has a free seat), so the matching isn't stable.
+	ViolationBlockingPair ViolationKind = "blocking_pair"
+)
+
+// Violation describes a single broken invariant of the admission results.
+type Violation struct {
+	Kind        ViolationKind
+	VarsityCode string
+	HeadingCode string // Full code of the heading the violation concerns
+	StudentID   string // Empty for violations concerning a heading as a whole
+	Message     string
+}
+
+func newViolation(kind ViolationKind, h *Heading, studentID, message string) Violation {
+	return Violation{
+		Kind:        kind,
+		VarsityCode: h.VarsityCode(),
+		HeadingCode: h.FullCode(),
+		StudentID:   studentID,
+		Message:     message,
+	}
+}
+
+func (v Violation) String() string {
+	if v.StudentID == "" {
+		return fmt.Sprintf("%s at %s: %s", v.Kind, v.HeadingCode, v.Message)
+	}
+	return fmt.Sprintf("%s at %s for student %s: %s", v.Kind, v.HeadingCode, v.StudentID, v.Message)
+}
+
+// Validate checks the results of CalculateAdmissions against the calculator's input and returns every violation
+// found, or nil if the results are a valid stable matching. Every enrollment pass (both stages of the staged
+// enrollment and the paid enrollment) is checked separately against its own participants and capacities. The
+// participants, their preferences and the capacities of the passes are derived here from the applications of the
+// students rather than taken from the calculator, so that a mistake in how the calculator builds them is caught too.
+func (v *VarsityCalculator) Validate(results []CalculationResult) []Violation {
+	allHeadings := v.Headings()
+	allStudents := v.Students()
+
+	var violations []Violation
+
+	if v.stagedEnrollment {
+		priorityAdmitted := make(map[*Heading][]*Student)
+		mainAdmitted := make(map[*Heading][]*Student)
+		priorityAdmittedIDs := make(map[string]bool)
+		takenSeats := make(map[*Heading]int)
+		for _, r := range results {
+			for _, s := range r.Admitted {
+				if r.StageOf(s.ID()) == StagePriority {
+					priorityAdmitted[r.Heading] = append(priorityAdmitted[r.Heading], s)
+					priorityAdmittedIDs[s.ID()] = true
+					takenSeats[r.Heading]++
+				} else {
+					mainAdmitted[r.Heading] = append(mainAdmitted[r.Heading], s)
+				}
+			}
+		}
+
+		// The priority stage is competed for with the quota and BVI applications, the main stage with the
+		// Regular ones by whoever the priority stage left out, for all seats the priority stage didn't take
+		isRegular := func(app *Application) bool { return app.competitionType == CompetitionRegular }
+		priorityProposers := validationProposers(allStudents, nil, func(app *Application) bool { return !isRegular(app) })
+		mainProposers := validationProposers(allStudents, priorityAdmittedIDs, isRegular)
+		mainCapacities := make(map[*Heading]Capacities, len(allHeadings))
+		for _, h := range allHeadings {
+			mainCapacities[h] = Capacities{Regular: max(h.TotalCapacity()-takenSeats[h], 0)}
+		}
+
+		violations = append(violations, validatePass(allHeadings, priorityProposers, nil, priorityAdmitted)...)
+		violations = append(violations, validatePass(allHeadings, mainProposers, mainCapacities, mainAdmitted)...)
+	} else {
+		violations = append(violations, validatePass(allHeadings, validationProposers(allStudents, nil, nil), nil, admittedByHeading(results))...)
+	}
+
+	var paidProposers []*admissionProposer
+	budgetAdmitted := admittedIn(results)
+	for _, s := range allStudents {
+		if s.Quit() || budgetAdmitted[s.ID()] {
+			continue
+		}
+		if preferences := preferencesOf(s.PaidApplications(), nil); len(preferences) > 0 {
+			paidProposers = append(paidProposers, &admissionProposer{id: s.ID(), applications: preferences})
+		}
+	}
+
+	paidAdmitted := make(map[*Heading][]*Student)
+	for _, r := range results {
+		paidAdmitted[r.Heading] = append(paidAdmitted[r.Heading], r.PaidAdmitted...)
+	}
+	violations = append(violations, validatePass(allHeadings, paidProposers, validationPaidCapacities(allHeadings), paidAdmitted)...)
+
+	return violations
+}
+
+// Validate checks the results of CalculateAdmissions (varsity code -> results) against the input of all
+// varsities, the same way VarsityCalculator.Validate does for a single varsity. A student takes part in a
+// single global pass with the applications to all varsities they haven't quit, ordered by priority first and
+// by the varsity order second; a student who submitted their original keeps the applications to the first
+// varsity holding it only. The paid pass takes the paid applications to all varsities regardless of originals.
+func (m *MultiVarsityCalculator) Validate(results map[string][]CalculationResult) []Violation {
+	var allHeadings []*Heading
+	var allResults []CalculationResult
+	applications := make(map[string][][]*Application)     // student ID -> applications to each varsity
+	paidApplications := make(map[string][][]*Application) // student ID -> paid applications to each varsity
+	originalVarsity := make(map[string]int)               // student ID -> index of the varsity holding the original
+
+	for i, v := range m.calculators {
+		allHeadings = append(allHeadings, v.Headings()...)
+		allResults = append(allResults, results[v.code]...)
+
+		for _, s := range v.Students() {
+			if _, found := originalVarsity[s.ID()]; !found && s.OriginalSubmitted() {
+				originalVarsity[s.ID()] = i
+			}
+			if s.Quit() {
+				continue
+			}
+
+			if applications[s.ID()] == nil {
+				applications[s.ID()] = make([][]*Application, len(m.calculators))
+				paidApplications[s.ID()] = make([][]*Application, len(m.calculators))
+			}
+			applications[s.ID()][i] = s.Applications()
+			paidApplications[s.ID()][i] = s.PaidApplications()
+		}
+	}
+
+	budgetAdmitted := admittedIn(allResults)
+	var proposers, paidProposers []*admissionProposer
+	for _, id := range sortedKeys(applications) {
+		byVarsity := applications[id]
+		if origIdx, ok := originalVarsity[id]; ok {
+			byVarsity = [][]*Application{byVarsity[origIdx]}
+		}
+		if preferences := mergedValidationPreferences(byVarsity); len(preferences) > 0 {
+			proposers = append(proposers, &admissionProposer{id: id, applications: preferences})
+		}
+
+		if budgetAdmitted[id] {
+			continue
+		}
+		if preferences := mergedValidationPreferences(paidApplications[id]); len(preferences) > 0 {
+			paidProposers = append(paidProposers, &admissionProposer{id: id, applications: preferences})
+		}
+	}
+
+	violations := validatePass(allHeadings, proposers, nil, admittedByHeading(allResults))
+
+	paidAdmitted := make(map[*Heading][]*Student)
+	for _, r := range allResults {
+		paidAdmitted[r.Heading] = append(paidAdmitted[r.Heading], r.PaidAdmitted...)
+	}
+	violations = append(violations, validatePass(allHeadings, paidProposers, validationPaidCapacities(allHeadings), paidAdmitted)...)
+
+	return violations
+}
+
+// validationProposers returns the participants of a budget pass: the students who haven't quit and aren't
+// excluded, proposing with their applications keep returns true for (all if keep is nil) by priority.
+// Students left without applications don't take part.
+func validationProposers(students []*Student, excluded map[string]bool, keep func(*Application) bool) []*admissionProposer {
+	var proposers []*admissionProposer
+	for _, s := range students {
+		if s.Quit() || excluded[s.ID()] {
+			continue
+		}
+		if preferences := preferencesOf(s.Applications(), keep); len(preferences) > 0 {
+			proposers = append(proposers, &admissionProposer{id: s.ID(), applications: preferences})
+		}
+	}
+	return proposers
+}
+
+// preferencesOf returns a copy of the applications keep returns true for (all if keep is nil), ordered by
+// priority.
+func preferencesOf(apps []*Application, keep func(*Application) bool) []*Application {
+	var preferences []*Application
+	for _, app := range apps {
+		if keep == nil || keep(app) {
+			preferences = append(preferences, app)
+		}
+	}
+	sort.SliceStable(preferences, func(i, j int) bool {
+		return preferences[i].priority < preferences[j].priority
+	})
+	return preferences
+}
+
+// mergedValidationPreferences orders the applications of a student to several varsities (given in the
+// varsity order) by priority first and by the varsity order second.
+func mergedValidationPreferences(byVarsity [][]*Application) []*Application {
+	var all []*Application
+	for _, apps := range byVarsity {
+		all = append(all, apps...)
+	}
+	// The sort is stable, so the applications of the same priority stay in the varsity order
+	return preferencesOf(all, nil)
+}
+
+// validationPaidCapacities returns the capacities of the paid pass: the paid places of every heading make up
+// a single general competition.
+func validationPaidCapacities(allHeadings []*Heading) map[*Heading]Capacities {
+	capacities := make(map[*Heading]Capacities, len(allHeadings))
+	for _, h := range allHeadings {
+		capacities[h] = Capacities{Regular: h.CapacitiesValue.Paid}
+	}
+	return capacities
+}
+
+// sortedKeys returns the keys of m in ascending order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// admittedByHeading returns the students admitted to budget places by each heading of the results.
+func admittedByHeading(results []CalculationResult) map[*Heading][]*Student {
+	admitted := make(map[*Heading][]*Student, len(results))
+	for _, r := range results {
+		admitted[r.Heading] = append(admitted[r.Heading], r.Admitted...)
+	}
+	return admitted
+}
+
+// validatePass checks the outcome of a single deferred-acceptance pass, given the same headings, proposers and
+// capacity overrides as runDeferredAcceptance was, and the students each heading admitted.
+func validatePass(allHeadings []*Heading, proposers []*admissionProposer, capacities map[*Heading]Capacities, admitted map[*Heading][]*Student) []Violation {
+	capacitiesOf := func(h *Heading) Capacities {
+		if c, ok := capacities[h]; ok {
+			return c
+		}
+		return h.CapacitiesValue
+	}
+
+	proposerByID := make(map[string]*admissionProposer, len(proposers))
+	for _, p := range proposers {
+		proposerByID[p.id] = p
+	}
+
+	var violations []Violation
+	matches := make(map[string]*Application)
+	// The admitted applications of every heading, split into the general competition and the quota heaps
+	generalAdmitted := make(map[*Heading][]*Application)
+	quotaAdmitted := make(map[*Heading]map[quotaKey][]*Application)
+
+	for _, h := range allHeadings {
+		quotaAdmitted[h] = make(map[quotaKey][]*Application)
+
+		for _, s := range admitted[h] {
+			p, ok := proposerByID[s.ID()]
+			if !ok {
+				violations = append(violations, newViolation(ViolationNotApplicant, h, s.ID(), "admitted without taking part in the enrollment"))
+				continue
+			}
+
+			var app *Application
+			for _, a := range p.applications {
+				if a.heading == h {
+					app = a
+					break
+				}
+			}
+			if app == nil {
+				violations = append(violations, newViolation(ViolationNoApplication, h, s.ID(), "admitted without an application to the heading"))
+				continue
+			}
+
+			if other, ok := matches[s.ID()]; ok {
+				violations = append(violations, newViolation(ViolationDuplicateAdmission, h, s.ID(), fmt.Sprintf("also admitted to %s", other.heading.FullCode())))
+				continue
+			}
+			matches[s.ID()] = app
+
+			if app.competitionType.IsQuota() {
+				key := capacitiesOf(h).quotaKeyOf(app)
+				quotaAdmitted[h][key] = append(quotaAdmitted[h][key], app)
+			} else {
+				generalAdmitted[h] = append(generalAdmitted[h], app)
+			}
+		}
+
+		c := capacitiesOf(h)
+		filledQuotas := 0
+		for _, key := range sortedQuotaKeys(quotaAdmitted[h]) {
+			apps := quotaAdmitted[h][key]
+			filledQuotas += len(apps)
+			if capacity := c.quotaKeyCapacity(key); len(apps) > capacity {
+				violations = append(violations, newViolation(ViolationQuotaOverCapacity, h, "", fmt.Sprintf("%s admitted %d students over capacity %d", key, len(apps), capacity)))
+			}
+		}
+		if total := filledQuotas + len(generalAdmitted[h]); total > c.Total() {
+			violations = append(violations, newViolation(ViolationOverCapacity, h, "", fmt.Sprintf("admitted %d students over capacity %d", total, c.Total())))
+		}
+	}
+
+	// No student may prefer a heading that would take them: one with a free seat in their competition
+	// or whose worst admitted applicant of their competition they outscore
+	for _, p := range proposers {
+		matched := matches[p.id]
+		for _, app := range p.applications {
+			if app == matched {
+				break
+			}
+			h := app.heading
+			if _, known := quotaAdmitted[h]; !known {
+				continue
+			}
+			c := capacitiesOf(h)
+
+			var competitors []*Application
+			var capacity int
+			var outscores func(a, b *Application) bool
+			if app.competitionType.IsQuota() {
+				key := c.quotaKeyOf(app)
+				competitors = quotaAdmitted[h][key]
+				capacity = c.quotaKeyCapacity(key)
+				outscores = func(a, b *Application) bool { return a.ratingPlace < b.ratingPlace }
+			} else {
+				filledQuotas := 0
+				for _, apps := range quotaAdmitted[h] {
+					filledQuotas += len(apps)
+				}
+				competitors = generalAdmitted[h]
+				capacity = max(c.Total()-filledQuotas, 0)
+				outscores = h.outscores
+			}
+
+			if capacity == 0 {
+				continue
+			}
+			if len(competitors) < capacity {
+				violations = append(violations, newViolation(ViolationBlockingPair, h, p.id, fmt.Sprintf("prefers the heading (priority %d) which has a free %s seat", app.priority, app.competitionType)))
+				continue
+			}
+
+			worst := competitors[0]
+			for _, other := range competitors[1:] {
+				if outscores(worst, other) {
+					worst = other
+				}
+			}
+			if outscores(app, worst) {
+				violations = append(violations, newViolation(ViolationBlockingPair, h, p.id, fmt.Sprintf("prefers the heading (priority %d) and outscores its worst admitted %s applicant %s", app.priority, app.competitionType, worst.StudentID())))
+			}
+		}
+	}
+
+	return violations
+}
+
+// sortedQuotaKeys returns the keys of the given quota heaps in a deterministic order.
+func sortedQuotaKeys(heaps map[quotaKey][]*Application) []quotaKey {
+	keys := make([]quotaKey, 0, len(heaps))
+	for key := range heaps {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].competition != keys[j].competition {
+			return keys[i].competition < keys[j].competition
+		}
+		return keys[i].subQuota < keys[j].subQuota
+	})
+	return keys
+}
+
+func (k quotaKey) String() string {
+	if k.subQuota == "" {
+		return k.competition.String()
+	}
+	return fmt.Sprintf("%s %q", k.competition, k.subQuota)
+}
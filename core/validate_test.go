@@ -0,0 +1,208 @@
+package core
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// randomVarsity fills a calculator with random headings and applications: all competition types, detailed target
+// quotas, paid places, shuffled priorities, quits and submitted originals.
+func randomVarsity(r *rand.Rand, code string, studentIDs []int) *VarsityCalculator {
+	v := NewVarsityCalculator(code, code)
+
+	headingCodes := make([]string, 1+r.Intn(5))
+	for i := range headingCodes {
+		headingCodes[i] = fmt.Sprintf("%s_H%d", code, i+1)
+		c := Capacities{
+			Regular:        r.Intn(5),
+			TargetQuota:    r.Intn(3),
+			DedicatedQuota: r.Intn(2),
+			SpecialQuota:   r.Intn(2),
+			Paid:           r.Intn(4),
+		}
+		if c.TargetQuota > 1 && r.Intn(2) == 0 {
+			c.TargetSubQuotas = map[string]int{"A": 1}
+		}
+		v.AddHeading(headingCodes[i], c, headingCodes[i])
+	}
+
+	competitions := []Competition{
+		CompetitionRegular, CompetitionRegular, CompetitionRegular, CompetitionBVI,
+		CompetitionTargetQuota, CompetitionDedicatedQuota, CompetitionSpecialQuota,
+	}
+
+	for _, id := range studentIDs {
+		score := 150 + r.Intn(160)
+		applied := r.Perm(len(headingCodes))[:1+r.Intn(len(headingCodes))]
+
+		for priority, hIdx := range applied {
+			competition := competitions[r.Intn(len(competitions))]
+			if competition == CompetitionTargetQuota && r.Intn(2) == 0 {
+				v.AddSubQuotaApplication(headingCodes[hIdx], sid(id), "A", 0, priority+1, score, ScoreDetails{})
+			} else {
+				v.AddApplication(headingCodes[hIdx], sid(id), 0, priority+1, competition, score)
+			}
+		}
+
+		if r.Intn(3) == 0 {
+			for priority, hIdx := range r.Perm(len(headingCodes))[:1+r.Intn(len(headingCodes))] {
+				v.AddApplication(headingCodes[hIdx], sid(id), 0, priority+1, CompetitionPaid, score)
+			}
+		}
+
+		if r.Intn(10) == 0 {
+			v.SetQuit(sid(id))
+		}
+		if r.Intn(4) == 0 {
+			v.SetOriginalSubmitted(sid(id))
+		}
+	}
+
+	// Rating places are missing, so every heading ranks its applicants by scores
+	v.NormalizeApplications()
+	return v
+}
+
+// randomStudentIDs returns a random subset of the IDs 1..n.
+func randomStudentIDs(r *rand.Rand, n int) []int {
+	var ids []int
+	for id := 1; id <= n; id++ {
+		if r.Intn(3) != 0 {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func TestValidate_RandomSinglePass(t *testing.T) {
+	for seed := int64(1); seed <= 300; seed++ {
+		r := rand.New(rand.NewSource(seed))
+		v := randomVarsity(r, "V", randomStudentIDs(r, 1+r.Intn(40)))
+
+		results := v.CalculateAdmissions()
+		assert.Empty(t, v.Validate(results), "seed %d", seed)
+	}
+}
+
+func TestValidate_RandomStaged(t *testing.T) {
+	for seed := int64(1); seed <= 300; seed++ {
+		r := rand.New(rand.NewSource(seed))
+		v := randomVarsity(r, "V", randomStudentIDs(r, 1+r.Intn(40)))
+		v.SetStagedEnrollment(true)
+
+		results := v.CalculateAdmissions()
+		assert.Empty(t, v.Validate(results), "seed %d", seed)
+	}
+}
+
+func TestValidate_RandomMultiVarsity(t *testing.T) {
+	for seed := int64(1); seed <= 200; seed++ {
+		r := rand.New(rand.NewSource(seed))
+		n := 1 + r.Intn(40)
+
+		calculators := make([]*VarsityCalculator, 1+r.Intn(3))
+		for i := range calculators {
+			calculators[i] = randomVarsity(r, fmt.Sprintf("V%d", i+1), randomStudentIDs(r, n))
+		}
+
+		m := NewMultiVarsityCalculator(calculators...)
+		results := m.CalculateAdmissions()
+		assert.Empty(t, m.Validate(results), "seed %d", seed)
+	}
+}
+
+func TestValidate_QuotaSeatEvictsGeneralApplicant(t *testing.T) {
+	v := NewVarsityCalculator("TEST_VARSITY", "")
+	v.AddHeading("H1", Capacities{Regular: 1, TargetQuota: 1}, "Heading 1")
+
+	// Both regular applicants propose before the target one; the quota seat is still guaranteed
+	v.AddApplication("H1", sid(1), 1, 1, CompetitionRegular, 250)
+	v.AddApplication("H1", sid(2), 2, 1, CompetitionRegular, 240)
+	v.AddApplication("H1", sid(3), 1, 1, CompetitionTargetQuota, 200)
+
+	results := v.CalculateAdmissions()
+
+	assert.ElementsMatch(t, []string{"0000000000001", "0000000000003"}, getAdmittedStudentIDs(results, "H1"))
+	assert.Empty(t, v.Validate(results))
+}
+
+func TestValidate_DetectsViolations(t *testing.T) {
+	v := NewVarsityCalculator("TEST_VARSITY", "")
+	v.AddHeading("H1", Capacities{Regular: 1, TargetQuota: 1}, "Heading 1")
+	v.AddHeading("H2", Capacities{Regular: 1}, "Heading 2")
+
+	v.AddApplication("H1", sid(1), 1, 1, CompetitionRegular, 250)
+	v.AddApplication("H2", sid(1), 1, 2, CompetitionRegular, 250)
+	v.AddApplication("H1", sid(2), 2, 1, CompetitionRegular, 240)
+	v.AddApplication("H1", sid(3), 1, 1, CompetitionTargetQuota, 200)
+	v.AddApplication("H1", sid(4), 2, 1, CompetitionTargetQuota, 190)
+
+	results := v.CalculateAdmissions()
+	assert.Empty(t, v.Validate(results))
+
+	s1 := v.GetStudent(sid(1))
+	s2 := v.GetStudent(sid(2))
+	s4 := v.GetStudent(sid(4))
+
+	kinds := func(violations []Violation) []ViolationKind {
+		var ks []ViolationKind
+		for _, violation := range violations {
+			ks = append(ks, violation.Kind)
+		}
+		return ks
+	}
+
+	// Student 1 is moved to their second choice although H1 would take them over student 2
+	tampered := []CalculationResult{
+		{Heading: v.GetHeading("H1"), Admitted: []*Student{s2, v.GetStudent(sid(3))}},
+		{Heading: v.GetHeading("H2"), Admitted: []*Student{s1}},
+	}
+	assert.Equal(t, []ViolationKind{ViolationBlockingPair}, kinds(v.Validate(tampered)))
+
+	// The second target applicant takes a general seat
+	tampered = []CalculationResult{
+		{Heading: v.GetHeading("H1"), Admitted: []*Student{v.GetStudent(sid(3)), s4}},
+		{Heading: v.GetHeading("H2"), Admitted: []*Student{s1}},
+	}
+	assert.Contains(t, kinds(v.Validate(tampered)), ViolationQuotaOverCapacity)
+
+	// Student 2 is admitted twice, once to a heading they didn't apply to
+	tampered = []CalculationResult{
+		{Heading: v.GetHeading("H1"), Admitted: []*Student{s1, s2, v.GetStudent(sid(3))}},
+		{Heading: v.GetHeading("H2"), Admitted: []*Student{s2}},
+	}
+	assert.ElementsMatch(t, []ViolationKind{ViolationOverCapacity, ViolationNoApplication}, kinds(v.Validate(tampered)))
+}
+
+func TestValidate_MultiVarsityOriginal(t *testing.T) {
+	a := NewVarsityCalculator("a", "Varsity A")
+	a.AddHeading("H", Capacities{Regular: 1}, "A heading")
+	b := NewVarsityCalculator("b", "Varsity B")
+	b.AddHeading("H", Capacities{Regular: 1}, "B heading")
+
+	a.AddApplication("H", "1", 1, 2, CompetitionRegular, 300)
+	b.AddApplication("H", "1", 1, 1, CompetitionRegular, 300)
+	b.AddApplication("H", "2", 2, 1, CompetitionRegular, 250)
+	a.SetOriginalSubmitted("1")
+
+	m := NewMultiVarsityCalculator(a, b)
+	results := m.CalculateAdmissions()
+	require.Len(t, results["a"], 1)
+	require.Len(t, results["b"], 1)
+	assert.Empty(t, m.Validate(results))
+
+	// Student 1 is taken by their first priority, although their original is at the other varsity
+	s1 := results["a"][0].Admitted[0]
+	tampered := map[string][]CalculationResult{
+		"a": {{Heading: results["a"][0].Heading}},
+		"b": {{Heading: results["b"][0].Heading, Admitted: []*Student{s1}}},
+	}
+	violations := m.Validate(tampered)
+	require.NotEmpty(t, violations)
+	assert.Equal(t, ViolationNoApplication, violations[0].Kind)
+	assert.Equal(t, "b:H", violations[0].HeadingCode)
+}
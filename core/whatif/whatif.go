@@ -0,0 +1,343 @@
+// Package whatif calculates the admission outcome of a hypothetical applicant: the applicant is inserted into
+// cached varsity data, the admissions are recalculated and nothing is persisted.
+package whatif
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/drainer"
+	"github.com/trueegorletov/analabit/core/source"
+	"github.com/trueegorletov/analabit/core/utils"
+)
+
+// DefaultDrainIterations is the number of iterations run for every drain stage if Request.DrainIterations is unset.
+const DefaultDrainIterations = 20
+
+// ErrInvalidRequest is wrapped by the errors Calculate returns for requests that don't match the data.
+var ErrInvalidRequest = errors.New("invalid what-if request")
+
+// Application is a single application of the hypothetical applicant. Its competition_type is given either as
+// the number of the competition or by its name, one of Regular, BVI, TargetQuota, DedicatedQuota, SpecialQuota
+// and Paid.
+type Application struct {
+	VarsityCode       string           `json:"varsity_code"`
+	HeadingCode       string           `json:"heading_code"`
+	Competition       core.Competition `json:"competition_type"`
+	Score             int              `json:"score"`
+	Priority          int              `json:"priority"`
+	OriginalSubmitted bool             `json:"original_submitted"`
+}
+
+// Request describes the hypothetical applicant and how to calculate their outcome.
+type Request struct {
+	Applications []Application `json:"applications"`
+	// CrossVarsity calculates all varsities in one pass, so the applicant holds at most one seat across varsities
+	CrossVarsity bool `json:"cross_varsity"`
+	// Drain stages (percent of drained students) to simulate after the primary calculation, none if empty
+	DrainStages     []int `json:"drain_stages,omitempty"`
+	DrainIterations int   `json:"drain_iterations,omitempty"`
+	DrainSeed       int64 `json:"drain_seed,omitempty"`
+}
+
+// ApplicationOutcome is the outcome of a single application of the hypothetical applicant.
+type ApplicationOutcome struct {
+	Application
+	// Rating place of the application among the heading's applicants, as computed from the score
+	RatingPlace int  `json:"rating_place"`
+	Admitted    bool `json:"admitted"`
+	// Passing data of the application's competition (budget or paid) in the primary calculation,
+	// including the hypothetical applicant; 0 if nobody was admitted
+	PassingScore            int              `json:"passing_score"`
+	LastAdmittedRatingPlace int              `json:"last_admitted_rating_place"`
+	Drained                 []DrainedOutcome `json:"drained,omitempty"`
+}
+
+// DrainedOutcome is the outcome of an application at a single drain stage.
+type DrainedOutcome struct {
+	DrainPercent int `json:"drain_percent"`
+	// Share of the stage's iterations the applicant was admitted to the heading in
+	AdmittedShare float64 `json:"admitted_share"`
+}
+
+// Result is the outcome of all applications of the hypothetical applicant, in the order of the request.
+type Result struct {
+	StudentID    string               `json:"student_id"`
+	Applications []ApplicationOutcome `json:"applications"`
+}
+
+// Calculate inserts the hypothetical applicant of the request into the given varsity data caches, recalculates
+// the admissions and, if requested, drain stages, and returns the applicant's outcome. The caches aren't changed,
+// so the same caches may be used by any number of calculations at once.
+func Calculate(caches []*source.VarsityDataCache, req Request) (*Result, error) {
+	if err := validate(caches, req); err != nil {
+		return nil, err
+	}
+
+	studentID := freeStudentID(caches)
+
+	appsByVarsity := make(map[string][]Application)
+	for _, app := range req.Applications {
+		appsByVarsity[app.VarsityCode] = append(appsByVarsity[app.VarsityCode], app)
+	}
+
+	defs := make([]source.VarsityDefinition, 0, len(caches))
+	withApplicant := make([]*source.VarsityDataCache, 0, len(caches))
+	for _, cache := range caches {
+		defs = append(defs, *cache.Definition)
+
+		apps, ok := appsByVarsity[cache.Definition.Code]
+		if !ok {
+			withApplicant = append(withApplicant, cache)
+			continue
+		}
+
+		// The cache may be shared, so the applications are appended to a copy of its list
+		c := *cache
+		c.ApplicationsCache = slices.Clip(c.ApplicationsCache)
+		for _, app := range apps {
+			c.ApplicationsCache = append(c.ApplicationsCache, &source.ApplicationData{
+				HeadingCode:       app.HeadingCode,
+				StudentID:         studentID,
+				ScoresSum:         app.Score,
+				RatingPlace:       ratingPlaceFor(cache, app),
+				Priority:          app.Priority,
+				CompetitionType:   app.Competition,
+				OriginalSubmitted: app.OriginalSubmitted,
+			})
+		}
+		withApplicant = append(withApplicant, &c)
+	}
+
+	varsities, _ := source.LoadWithCaches(context.Background(), defs, withApplicant)
+	varsityByCode := make(map[string]*source.Varsity, len(varsities))
+	for _, v := range varsities {
+		varsityByCode[v.Code] = v
+	}
+
+	result := &Result{StudentID: utils.PrettifyStudentID(studentID)}
+	for _, app := range req.Applications {
+		result.Applications = append(result.Applications, ApplicationOutcome{
+			Application: app,
+			RatingPlace: ratingPlaceOf(varsityByCode[app.VarsityCode].VarsityCalculator, studentID, app),
+		})
+	}
+
+	// Calculating wastes the calculators, so the drain snapshots are made first
+	snapshots := make(map[string]*core.VarsitySnapshot)
+	if len(req.DrainStages) > 0 {
+		for code := range appsByVarsity {
+			snapshots[code] = varsityByCode[code].VarsityCalculator.Snapshot()
+		}
+	}
+
+	primaryResults := make(map[string][]core.CalculationResult)
+	if req.CrossVarsity {
+		calculators := make([]*core.VarsityCalculator, 0, len(varsities))
+		for _, v := range varsities {
+			calculators = append(calculators, v.VarsityCalculator)
+		}
+		primaryResults = core.NewMultiVarsityCalculator(calculators...).CalculateAdmissions()
+	} else {
+		for code := range appsByVarsity {
+			primaryResults[code] = varsityByCode[code].VarsityCalculator.CalculateAdmissions()
+		}
+	}
+
+	for i := range result.Applications {
+		outcome := &result.Applications[i]
+		r := findResult(primaryResults[outcome.VarsityCode], outcome.HeadingCode)
+		if r == nil {
+			continue
+		}
+
+		outcome.Admitted = admitted(r, studentID, outcome.Competition)
+		if outcome.Competition == core.CompetitionPaid {
+			outcome.PassingScore, _ = r.PaidPassingScore()
+			outcome.LastAdmittedRatingPlace, _ = r.PaidLastAdmittedRatingPlace()
+		} else {
+			outcome.PassingScore, _ = r.PassingScore()
+			outcome.LastAdmittedRatingPlace, _ = r.LastAdmittedRatingPlace()
+		}
+	}
+
+	iterations := req.DrainIterations
+	if iterations <= 0 {
+		iterations = DefaultDrainIterations
+	}
+	for _, stage := range req.DrainStages {
+		admittedCounts := make([]int, len(result.Applications))
+		for code, snapshot := range snapshots {
+			seed := drainer.DeriveSeed(req.DrainSeed, code, stage)
+			for it := 0; it < iterations; it++ {
+				overlay := snapshot.NewOverlay()
+				overlay.SimulateWeightedOriginalsDrain(stage, drainer.IterationSeed(seed, it), keepStudent(studentID))
+				results := overlay.CalculateAdmissions()
+
+				for i, outcome := range result.Applications {
+					if outcome.VarsityCode != code {
+						continue
+					}
+					if r := findResult(results, outcome.HeadingCode); r != nil && admitted(r, studentID, outcome.Competition) {
+						admittedCounts[i]++
+					}
+				}
+			}
+		}
+
+		for i := range result.Applications {
+			result.Applications[i].Drained = append(result.Applications[i].Drained, DrainedOutcome{
+				DrainPercent:  stage,
+				AdmittedShare: float64(admittedCounts[i]) / float64(iterations),
+			})
+		}
+	}
+
+	return result, nil
+}
+
+// validate checks that every application of the request refers to a cached heading and can take part in the
+// enrollment, and that the drain parameters are valid.
+func validate(caches []*source.VarsityDataCache, req Request) error {
+	if len(req.Applications) == 0 {
+		return fmt.Errorf("%w: no applications given", ErrInvalidRequest)
+	}
+
+	cacheByCode := make(map[string]*source.VarsityDataCache, len(caches))
+	for _, cache := range caches {
+		cacheByCode[cache.Definition.Code] = cache
+	}
+
+	type headingKey struct {
+		varsity, heading string
+		paid             bool
+	}
+	seen := make(map[headingKey]bool)
+	for _, app := range req.Applications {
+		cache, ok := cacheByCode[app.VarsityCode]
+		if !ok {
+			return fmt.Errorf("%w: no data for varsity %q", ErrInvalidRequest, app.VarsityCode)
+		}
+		if !slices.ContainsFunc(cache.HeadingsCache, func(hd *source.HeadingData) bool { return hd.Code == app.HeadingCode }) {
+			return fmt.Errorf("%w: varsity %q has no heading %q", ErrInvalidRequest, app.VarsityCode, app.HeadingCode)
+		}
+
+		// A heading may be applied to once for the budget places and once for the paid ones
+		key := headingKey{app.VarsityCode, app.HeadingCode, app.Competition == core.CompetitionPaid}
+		if seen[key] {
+			return fmt.Errorf("%w: more than one %s application to heading %q of varsity %q", ErrInvalidRequest, app.Competition, app.HeadingCode, app.VarsityCode)
+		}
+		seen[key] = true
+
+		if app.Competition < core.CompetitionRegular || app.Competition > core.CompetitionPaid {
+			return fmt.Errorf("%w: unknown competition %d", ErrInvalidRequest, app.Competition)
+		}
+		if app.Priority < 1 {
+			return fmt.Errorf("%w: priority must be positive, got %d", ErrInvalidRequest, app.Priority)
+		}
+		if app.Competition.IsQuota() && !app.OriginalSubmitted {
+			// source.Varsity.AddApplication ignores such applications, as do the real calculations
+			return fmt.Errorf("%w: %s applications take part only with the original submitted", ErrInvalidRequest, app.Competition)
+		}
+	}
+
+	for _, stage := range req.DrainStages {
+		if stage <= 0 || stage > 100 {
+			return fmt.Errorf("%w: drain stage must be in (0, 100], got %d", ErrInvalidRequest, stage)
+		}
+	}
+
+	return nil
+}
+
+// freeStudentID returns a student ID that no application of the caches uses.
+func freeStudentID(caches []*source.VarsityDataCache) string {
+	used := make(map[string]bool)
+	for _, cache := range caches {
+		for _, ad := range cache.ApplicationsCache {
+			if id, err := utils.PrepareStudentID(ad.StudentID); err == nil {
+				used[id] = true
+			}
+		}
+	}
+
+	for n := int64(9999999999999); ; n-- {
+		id, _ := utils.PrepareStudentID(strconv.FormatInt(n, 10))
+		if !used[id] {
+			return id
+		}
+	}
+}
+
+// ratingPlaceFor computes the rating place of the application from its score: it takes the place of the first
+// applicant of the same heading and competition with a lower score, or the place after the last one. The
+// calculator's normalization renumbers the places afterwards, ranking the application above that applicant.
+func ratingPlaceFor(cache *source.VarsityDataCache, app Application) int {
+	place, last := 0, 0
+	for _, ad := range cache.ApplicationsCache {
+		if ad.HeadingCode != app.HeadingCode || ad.CompetitionType != app.Competition {
+			continue
+		}
+		last = max(last, ad.RatingPlace)
+		if ad.ScoresSum < app.Score && (place == 0 || ad.RatingPlace < place) {
+			place = ad.RatingPlace
+		}
+	}
+
+	if place == 0 {
+		return last + 1
+	}
+	return place
+}
+
+// ratingPlaceOf returns the rating place the calculator assigned to the application after normalization.
+func ratingPlaceOf(vc *core.VarsityCalculator, studentID string, app Application) int {
+	student := vc.GetStudent(studentID)
+	if student == nil {
+		return 0
+	}
+
+	apps := student.Applications()
+	if app.Competition == core.CompetitionPaid {
+		apps = student.PaidApplications()
+	}
+	for _, a := range apps {
+		if a.Heading().Code() == app.HeadingCode {
+			return a.RatingPlace()
+		}
+	}
+	return 0
+}
+
+func findResult(results []core.CalculationResult, headingCode string) *core.CalculationResult {
+	for i := range results {
+		if results[i].Heading.Code() == headingCode {
+			return &results[i]
+		}
+	}
+	return nil
+}
+
+// admitted reports whether the student was admitted to the result's heading in the given competition.
+func admitted(r *core.CalculationResult, studentID string, competition core.Competition) bool {
+	students := r.Admitted
+	if competition == core.CompetitionPaid {
+		students = r.PaidAdmitted
+	}
+	return slices.ContainsFunc(students, func(s *core.Student) bool { return s.ID() == studentID })
+}
+
+// keepStudent returns a drain weight that drains the other students uniformly and the given student only after
+// all of them, that is only at the 100% stage.
+func keepStudent(studentID string) func(*core.Student) float64 {
+	return func(s *core.Student) float64 {
+		if s.ID() == studentID {
+			return 0
+		}
+		return 1
+	}
+}
@@ -0,0 +1,112 @@
+package whatif
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/source"
+)
+
+// testCache returns the data of a varsity with a single heading of 10 places and 30 applicants scoring 300 to 271.
+func testCache() *source.VarsityDataCache {
+	cache := source.NewVarsityDataCache(&source.VarsityDefinition{Code: "test", Name: "Test Varsity"})
+	cache.SaveHeadingData(&source.HeadingData{Code: "H1", Capacities: core.Capacities{Regular: 10}, PrettyName: "Heading 1"})
+	for i := 1; i <= 30; i++ {
+		cache.SaveApplicationData(&source.ApplicationData{
+			HeadingCode:     "H1",
+			StudentID:       fmt.Sprint(i),
+			ScoresSum:       301 - i,
+			RatingPlace:     i,
+			Priority:        1,
+			CompetitionType: core.CompetitionRegular,
+		})
+	}
+	return cache
+}
+
+func TestCalculate(t *testing.T) {
+	cache := testCache()
+	apply := func(score int) Request {
+		return Request{Applications: []Application{{VarsityCode: "test", HeadingCode: "H1", Competition: core.CompetitionRegular, Score: score, Priority: 1}}}
+	}
+
+	high, err := Calculate([]*source.VarsityDataCache{cache}, apply(295))
+	require.NoError(t, err)
+	if assert.Len(t, high.Applications, 1) {
+		assert.True(t, high.Applications[0].Admitted)
+		assert.Equal(t, 7, high.Applications[0].RatingPlace)
+		assert.Equal(t, 292, high.Applications[0].PassingScore)
+	}
+
+	low, err := Calculate([]*source.VarsityDataCache{cache}, apply(280))
+	require.NoError(t, err)
+	if assert.Len(t, low.Applications, 1) {
+		assert.False(t, low.Applications[0].Admitted)
+		assert.Equal(t, 22, low.Applications[0].RatingPlace)
+		assert.Equal(t, 291, low.Applications[0].PassingScore)
+	}
+
+	// Nothing is persisted into the data
+	assert.Len(t, cache.ApplicationsCache, 30)
+}
+
+func TestCalculate_Drained(t *testing.T) {
+	req := Request{
+		Applications: []Application{{VarsityCode: "test", HeadingCode: "H1", Competition: core.CompetitionRegular, Score: 285, Priority: 1}},
+		DrainStages:  []int{50, 90},
+		DrainSeed:    42,
+	}
+
+	result, err := Calculate([]*source.VarsityDataCache{testCache()}, req)
+	require.NoError(t, err)
+	if assert.Len(t, result.Applications, 1) {
+		outcome := result.Applications[0]
+		assert.False(t, outcome.Admitted)
+		if assert.Len(t, outcome.Drained, 2) {
+			assert.Equal(t, 50, outcome.Drained[0].DrainPercent)
+			// The applicant is drained last, so with nearly everyone else gone they always pass
+			assert.Equal(t, 90, outcome.Drained[1].DrainPercent)
+			assert.Equal(t, 1.0, outcome.Drained[1].AdmittedShare)
+		}
+	}
+}
+
+func TestCalculate_InvalidRequest(t *testing.T) {
+	caches := []*source.VarsityDataCache{testCache()}
+
+	for name, app := range map[string]Application{
+		"unknown varsity":        {VarsityCode: "other", HeadingCode: "H1", Priority: 1},
+		"unknown heading":        {VarsityCode: "test", HeadingCode: "H2", Priority: 1},
+		"no priority":            {VarsityCode: "test", HeadingCode: "H1"},
+		"quota without original": {VarsityCode: "test", HeadingCode: "H1", Competition: core.CompetitionTargetQuota, Priority: 1},
+	} {
+		_, err := Calculate(caches, Request{Applications: []Application{app}})
+		assert.ErrorIs(t, err, ErrInvalidRequest, name)
+	}
+
+	_, err := Calculate(caches, Request{})
+	assert.ErrorIs(t, err, ErrInvalidRequest)
+}
+
+func TestRequest_CompetitionNames(t *testing.T) {
+	var req Request
+	require.NoError(t, json.Unmarshal([]byte(`{"applications": [
+		{"varsity_code": "test", "heading_code": "H1", "competition_type": "targetquota", "priority": 1},
+		{"varsity_code": "test", "heading_code": "H1", "competition_type": "BVI", "priority": 2},
+		{"varsity_code": "test", "heading_code": "H1", "competition_type": 5, "priority": 3},
+		{"varsity_code": "test", "heading_code": "H1", "priority": 4}
+	]}`), &req))
+
+	var competitions []core.Competition
+	for _, app := range req.Applications {
+		competitions = append(competitions, app.Competition)
+	}
+	assert.Equal(t, []core.Competition{core.CompetitionTargetQuota, core.CompetitionBVI, core.CompetitionPaid, core.CompetitionRegular}, competitions)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"applications": [{"competition_type": "Budget"}]}`), &req))
+}
@@ -17,6 +17,7 @@ require (
 	github.com/xuri/excelize/v2 v2.9.1
 	golang.org/x/net v0.41.0
 	golang.org/x/sync v0.15.0
+	gopkg.in/yaml.v3 v3.0.1
 	rsc.io/pdf v0.1.1
 )
 
@@ -66,7 +67,6 @@ require (
 	golang.org/x/sys v0.33.0 // indirect
 	golang.org/x/text v0.26.0 // indirect
 	google.golang.org/protobuf v1.36.6 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
 
 replace github.com/trueegorletov/analabit => .
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"os"
 
 	"github.com/trueegorletov/analabit/core"
@@ -31,7 +32,7 @@ func Ser() {
 		},
 	}
 
-	varsities := source.LoadFromDefinitions(defs)
+	varsities := source.LoadFromDefinitions(context.Background(), defs)
 
 	var caches []*source.VarsityDataCache
 
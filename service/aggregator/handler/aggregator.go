@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"bytes"
 	"context"
 	"encoding/gob"
 	"encoding/json"
@@ -83,30 +84,14 @@ func (a *Aggregator) StartSubscriber() {
 		log.Println("RabbitMQ consumer started. Waiting for messages.")
 		for d := range msgs {
 			log.Printf("Received a message: %s", d.Body)
-			var notification map[string]interface{}
-			if err := json.Unmarshal(d.Body, &notification); err != nil {
-				log.Printf("Error unmarshalling notification: %v", err)
-				continue
-			}
-			bucketName, ok := notification["bucket_name"].(string)
-			if !ok {
-				log.Println("Invalid notification: missing or invalid bucket_name")
-				continue
-			}
-
-			payloadObjects, ok := notification["payload_objects"].([]interface{})
-			if !ok {
-				log.Println("Invalid notification: missing or invalid payload_objects")
+			bucketName, objectNames, drainMeta, err := decodeNotification(d.Body)
+			if err != nil {
+				log.Printf("Invalid notification: %v", err)
 				continue
 			}
 
-			objectNames := make([]string, len(payloadObjects))
-			for i, v := range payloadObjects {
-				objectNames[i] = v.(string)
-			}
-
 			log.Printf("Processing bucket: %s with %d objects", bucketName, len(objectNames))
-			if err := a.processBucket(context.Background(), bucketName, objectNames); err != nil {
+			if err := a.processBucket(context.Background(), bucketName, objectNames, drainMeta); err != nil {
 				log.Printf("Failed to process bucket %s: %v", bucketName, err)
 			}
 		}
@@ -116,7 +101,45 @@ func (a *Aggregator) StartSubscriber() {
 	}()
 }
 
-func (a *Aggregator) processBucket(ctx context.Context, bucketName string, objectNames []string) error {
+// decodeNotification decodes a producer notification into the bucket name, the payload object names and the
+// drain reproduction parameters, which are recorded in the run metadata as-is. Numbers are kept as
+// json.Number, so that the int64 drain seed does not lose precision in a float64.
+func decodeNotification(body []byte) (string, []string, map[string]any, error) {
+	var notification map[string]interface{}
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	if err := dec.Decode(&notification); err != nil {
+		return "", nil, nil, fmt.Errorf("failed to unmarshal notification: %w", err)
+	}
+
+	bucketName, ok := notification["bucket_name"].(string)
+	if !ok {
+		return "", nil, nil, fmt.Errorf("missing or invalid bucket_name")
+	}
+
+	payloadObjects, ok := notification["payload_objects"].([]interface{})
+	if !ok {
+		return "", nil, nil, fmt.Errorf("missing or invalid payload_objects")
+	}
+
+	objectNames := make([]string, len(payloadObjects))
+	for i, v := range payloadObjects {
+		if objectNames[i], ok = v.(string); !ok {
+			return "", nil, nil, fmt.Errorf("invalid payload object %v", v)
+		}
+	}
+
+	drainMeta := make(map[string]any)
+	for _, key := range []string{"drain_seed", "drain_stages", "drain_iterations", "drain_max_iterations", "drain_tolerance", "drain_models", "cache_file", "http_archive_file", "raw_crawl", "capacity_drifts"} {
+		if v, ok := notification[key]; ok {
+			drainMeta[key] = v
+		}
+	}
+
+	return bucketName, objectNames, drainMeta, nil
+}
+
+func (a *Aggregator) processBucket(ctx context.Context, bucketName string, objectNames []string, drainMeta map[string]any) error {
 	var cfg config
 
 	if err := env.Parse(&cfg); err != nil {
@@ -214,14 +237,19 @@ func (a *Aggregator) processBucket(ctx context.Context, bucketName string, objec
 		}
 		log.Printf("Migrations complete for %s database.", dbType)
 
+		payloadMeta := map[string]any{
+			"bucket_name":    bucketName,
+			"object_count":   len(objectNames),
+			"object_names":   objectNames,
+			"conn_string_id": fmt.Sprintf("%s-%s", dbType, connStr), // More descriptive ID
+		}
+		for k, v := range drainMeta {
+			payloadMeta[k] = v
+		}
+
 		// Create one Run per RabbitMQ notification before processing objects
 		run, err := client.Run.Create().
-			SetPayloadMeta(map[string]any{
-				"bucket_name":    bucketName,
-				"object_count":   len(objectNames),
-				"object_names":   objectNames,
-				"conn_string_id": fmt.Sprintf("%s-%s", dbType, connStr), // More descriptive ID
-			}).
+			SetPayloadMeta(payloadMeta).
 			Save(ctx)
 		if err != nil {
 			err = fmt.Errorf("failed to create run for bucket %s with %s database %q: %w", bucketName, dbType, connStr, err)
@@ -278,8 +306,20 @@ func (a *Aggregator) processBucket(ctx context.Context, bucketName string, objec
 						MinLastAdmittedRatingPlace: larp,
 						MaxLastAdmittedRatingPlace: larp,
 						MedLastAdmittedRatingPlace: larp,
+						P10PassingScore:            passingScore,
+						P25PassingScore:            passingScore,
+						P75PassingScore:            passingScore,
+						P90PassingScore:            passingScore,
+						P10LastAdmittedRatingPlace: larp,
+						P25LastAdmittedRatingPlace: larp,
+						P75LastAdmittedRatingPlace: larp,
+						P90LastAdmittedRatingPlace: larp,
 						RegularsAdmitted:           regularsAdmitted,
 						IsVirtual:                  true,
+						SubQuotas:                  calc.SubQuotas,
+
+						AvgPaidPassingScore:            calc.PaidPassingScore,
+						AvgPaidLastAdmittedRatingPlace: calc.PaidLastAdmittedRatingPlace,
 					})
 				}
 				// Combine synthetic and simulated drained results
@@ -295,6 +335,36 @@ func (a *Aggregator) processBucket(ctx context.Context, bucketName string, objec
 				} else {
 					log.Printf("Successfully uploaded drained results for object %s in run %d (%s database)", objectName, run.ID, dbType)
 				}
+				// Upload per-student admission chances of every drain stage
+				var chanceDTOs []core.AdmissionChanceDTO
+				for _, dtos := range payload.Chances {
+					chanceDTOs = append(chanceDTOs, dtos...)
+				}
+				if err := upload.AdmissionChances(ctx, client, run.ID, chanceDTOs); err != nil {
+					err = fmt.Errorf("failed to upload admission chances from object %s with %s database %q: %w", objectName, dbType, connStr, err)
+					multierr.AppendInto(&allErrors, err)
+				} else {
+					log.Printf("Successfully uploaded %d admission chances for object %s in run %d (%s database)", len(chanceDTOs), objectName, run.ID, dbType)
+				}
+				// Upload the admission trace of the primary calculation, if the producer recorded it
+				if len(payload.Trace) > 0 {
+					if err := upload.AdmissionTrace(ctx, client, run.ID, payload.Trace); err != nil {
+						err = fmt.Errorf("failed to upload admission trace from object %s with %s database %q: %w", objectName, dbType, connStr, err)
+						multierr.AppendInto(&allErrors, err)
+					} else {
+						log.Printf("Successfully uploaded %d admission events for object %s in run %d (%s database)", len(payload.Trace), objectName, run.ID, dbType)
+					}
+				}
+				// Upload the reports of how the heading sources were loaded
+				if err := upload.SourceReports(ctx, client, run.ID, payload.VarsityCode, payload.SourceReports); err != nil {
+					err = fmt.Errorf("failed to upload source reports from object %s with %s database %q: %w", objectName, dbType, connStr, err)
+					multierr.AppendInto(&allErrors, err)
+				}
+				// Upload the published capacities compared with the registry ones, if the varsity has a capacity feed
+				if err := upload.CapacityDrifts(ctx, client, run.ID, payload.VarsityCode, payload.CapacityDrifts); err != nil {
+					err = fmt.Errorf("failed to upload capacity drifts from object %s with %s database %q: %w", objectName, dbType, connStr, err)
+					multierr.AppendInto(&allErrors, err)
+				}
 			}
 		}
 
@@ -316,6 +386,13 @@ func (a *Aggregator) processBucket(ctx context.Context, bucketName string, objec
 					}
 				}
 
+				if deleted, err := dbClient.CleanupAdmissionEvents(ctx, run.ID, cfg.CleanupTraceRetentionRuns); err != nil {
+					log.Printf("Error: Admission trace cleanup failed for run %d: %v", run.ID, err)
+					multierr.AppendInto(&allErrors, err)
+				} else if deleted > 0 {
+					slog.Info("Deleted the admission traces of old runs", "events", deleted)
+				}
+
 				slog.Info("Cleanup job finished, proceeding to refresh materialized views...")
 
 				// Refresh materialized views after cleanup
@@ -0,0 +1,39 @@
+package handler
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeNotification(t *testing.T) {
+	const seed int64 = 1760659200123456789 // A UnixNano seed a float64 cannot hold exactly
+	body, err := json.Marshal(map[string]any{
+		"bucket_name":     "bucket",
+		"payload_objects": []string{"a.gob", "b.gob"},
+		"drain_seed":      seed,
+		"drain_stages":    []int{33, 50},
+		"unrelated":       true,
+	})
+	require.NoError(t, err)
+
+	bucketName, objectNames, drainMeta, err := decodeNotification(body)
+	require.NoError(t, err)
+	assert.Equal(t, "bucket", bucketName)
+	assert.Equal(t, []string{"a.gob", "b.gob"}, objectNames)
+	assert.NotContains(t, drainMeta, "unrelated")
+
+	// The seed survives the round trip into the run metadata
+	meta, err := json.Marshal(drainMeta)
+	require.NoError(t, err)
+	var recorded struct {
+		DrainSeed int64 `json:"drain_seed"`
+	}
+	require.NoError(t, json.Unmarshal(meta, &recorded))
+	assert.Equal(t, seed, recorded.DrainSeed)
+
+	_, _, _, err = decodeNotification([]byte(`{"bucket_name": "bucket"}`))
+	assert.Error(t, err)
+}
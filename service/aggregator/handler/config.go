@@ -30,6 +30,9 @@ type config struct {
 	// Cleanup configuration
 	CleanupRetentionRuns int    `env:"CLEANUP_RETENTION_RUNS" envDefault:"5"`
 	CleanupBackupDir     string `env:"CLEANUP_BACKUP_DIR" envDefault:"./backups"`
+	// CleanupTraceRetentionRuns is the number of latest runs whose admission traces are kept, 0 keeps them
+	// as long as the rest of the runs' data
+	CleanupTraceRetentionRuns int `env:"CLEANUP_TRACE_RETENTION_RUNS" envDefault:"1"`
 
 	// SPbSTU fallback configuration
 	SpbstuFallbackEnabled bool   `env:"SPBSTU_FALLBACK_ENABLED" envDefault:"false"`
@@ -31,6 +31,10 @@ type Config struct {
 	MinioUseSSL     bool   `env:"MINIO_USE_SSL" envDefault:"false"`
 	MinioBucketName string `env:"MINIO_BUCKET_NAME" envDefault:"analabit-results"`
 
+	// What-if calculations: the varsity data object the producer uploads and how many calculations run at once
+	DataCacheObjectName string `env:"DATA_CACHE_OBJECT_NAME" envDefault:"varsity_data_latest.gob"`
+	WhatIfConcurrency   int    `env:"WHATIF_CONCURRENCY" envDefault:"2"`
+
 	// Logging configuration
 	LogLevel string `env:"LOG_LEVEL" envDefault:"info"`
 }
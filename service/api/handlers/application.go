@@ -29,6 +29,9 @@ type ApplicationResponse struct {
 	CompetitionType       string    `json:"competition_type"`
 	RatingPlace           int       `json:"rating_place"`
 	Score                 int       `json:"score"`
+	SubjectScores         []int     `json:"subject_scores,omitempty"`
+	AchievementScore      int       `json:"achievement_score"`
+	SubQuota              string    `json:"sub_quota,omitempty"`
 	RunID                 int       `json:"run_id"`
 	UpdatedAt             time.Time `json:"updated_at"`
 	HeadingID             int       `json:"heading_id"`
@@ -37,7 +40,10 @@ type ApplicationResponse struct {
 	PassingNow            bool      `json:"passing_now"`
 	PassingToMorePriority bool      `json:"passing_to_more_priority"`
 	AnotherVarsitiesCount int       `json:"another_varsities_count"`
-	MSUInternalID         *string   `json:"msu_internal_id,omitempty"`
+	InternalID            *string   `json:"internal_id,omitempty"`
+	// Deprecated: MSUInternalID is InternalID under its name from when only MSU published internal IDs,
+	// kept for the clients of the old name. Use InternalID.
+	MSUInternalID *string `json:"msu_internal_id,omitempty"`
 }
 
 // GetApplications retrieves a list of applications with cursor-based pagination.
@@ -63,26 +69,26 @@ func GetApplications(client *ent.Client) fiber.Handler {
 
 		// Validate and prepare student ID if provided
 		var studentID string
-		var msuInternalID string
+		var internalID string
 		if studentIDRaw != "" {
-			// Check if it's an MSU internal ID query (prefixed with @)
+			// Check if it's an internal ID query (prefixed with @), for varsities publishing their own IDs
 			if strings.HasPrefix(studentIDRaw, "@") {
-				rawMsuID := strings.TrimPrefix(studentIDRaw, "@")
-				if rawMsuID == "" {
-					log.Printf("empty MSU internal ID parameter '%s'", studentIDRaw)
-					return fiber.NewError(fiber.StatusBadRequest, "empty MSU internal ID parameter")
+				rawInternalID := strings.TrimPrefix(studentIDRaw, "@")
+				if rawInternalID == "" {
+					log.Printf("empty internal ID parameter '%s'", studentIDRaw)
+					return fiber.NewError(fiber.StatusBadRequest, "empty internal ID parameter")
 				}
 				// Remove leading zeros and then prepare the ID
-				trimmedMsuID := strings.TrimLeft(rawMsuID, "0")
-				if trimmedMsuID == "" {
-					trimmedMsuID = "0" // preserve at least one digit
+				trimmedInternalID := strings.TrimLeft(rawInternalID, "0")
+				if trimmedInternalID == "" {
+					trimmedInternalID = "0" // preserve at least one digit
 				}
-				preparedMsuID, err := utils.PrepareStudentID(trimmedMsuID)
+				preparedInternalID, err := utils.PrepareStudentID(trimmedInternalID)
 				if err != nil {
-					log.Printf("invalid MSU internal ID parameter '%s': %v", studentIDRaw, err)
-					return fiber.NewError(fiber.StatusBadRequest, "invalid MSU internal ID parameter")
+					log.Printf("invalid internal ID parameter '%s': %v", studentIDRaw, err)
+					return fiber.NewError(fiber.StatusBadRequest, "invalid internal ID parameter")
 				}
-				msuInternalID = preparedMsuID
+				internalID = preparedInternalID
 			} else {
 				// Remove leading zeros from regular student ID before preparing
 				trimmedStudentID := strings.TrimLeft(studentIDRaw, "0")
@@ -112,25 +118,29 @@ func GetApplications(client *ent.Client) fiber.Handler {
 			q = q.Where(application.StudentID(studentID))
 		}
 
-		if msuInternalID != "" {
-			// First, find an application with the MSU internal ID to get the student ID
-			appWithMsuID, err := client.Application.Query().
+		if internalID != "" {
+			// First, find an application with the internal ID to get the student ID; internal IDs are
+			// only unique within a varsity, so the varsity narrows the search down if given
+			internalQ := client.Application.Query().
 				Where(application.And(
 					application.RunIDEQ(runResolution.RunID),
-					application.MsuInternalID(msuInternalID),
-				)).
-				First(ctx)
+					application.InternalID(internalID),
+				))
+			if varsityCode != "" {
+				internalQ = internalQ.Where(application.HasHeadingWith(heading.HasVarsityWith(varsity.CodeEQ(varsityCode))))
+			}
+			appWithInternalID, err := internalQ.First(ctx)
 			if err != nil {
 				if ent.IsNotFound(err) {
-					log.Printf("no application found with MSU internal ID '%s' in run %d", msuInternalID, runResolution.RunID)
-					return fiber.NewError(fiber.StatusNotFound, "no application found with the specified MSU internal ID")
+					log.Printf("no application found with internal ID '%s' in run %d", internalID, runResolution.RunID)
+					return fiber.NewError(fiber.StatusNotFound, "no application found with the specified internal ID")
 				}
-				log.Printf("error finding application with MSU internal ID '%s': %v", msuInternalID, err)
+				log.Printf("error finding application with internal ID '%s': %v", internalID, err)
 				return fiber.ErrInternalServerError
 			}
-			
+
 			// Now query for all applications with the same student ID
-			q = q.Where(application.StudentID(appWithMsuID.StudentID))
+			q = q.Where(application.StudentID(appWithInternalID.StudentID))
 		}
 
 		if varsityCode != "" {
@@ -228,6 +238,9 @@ func GetApplications(client *ent.Client) fiber.Handler {
 				CompetitionType:       app.CompetitionType.String(),
 				RatingPlace:           app.RatingPlace,
 				Score:                 app.Score,
+				SubjectScores:         app.SubjectScores,
+				AchievementScore:      app.AchievementScore,
+				SubQuota:              app.SubQuota,
 				RunID:                 app.RunID,
 				UpdatedAt:             app.UpdatedAt,
 				HeadingID:             app.Edges.Heading.ID,
@@ -236,7 +249,8 @@ func GetApplications(client *ent.Client) fiber.Handler {
 				PassingNow:            flags.PassingNow,
 				PassingToMorePriority: flags.PassingToMorePriority,
 				AnotherVarsitiesCount: flags.AnotherVarsitiesCount,
-				MSUInternalID:         app.MsuInternalID,
+				InternalID:            app.InternalID,
+				MSUInternalID:         app.InternalID,
 			}
 			cursorStr := fmt.Sprintf("%d:%d", app.RatingPlace, app.ID)
 			edges[i] = ApplicationEdge{
@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/trueegorletov/analabit/core/ent"
+	"github.com/trueegorletov/analabit/core/ent/capacitydrift"
+	"github.com/trueegorletov/analabit/core/ent/heading"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// CapacitiesDTO holds the capacities of a heading by competition.
+type CapacitiesDTO struct {
+	Regular         int            `json:"regular"`
+	TargetQuota     int            `json:"target_quota"`
+	DedicatedQuota  int            `json:"dedicated_quota"`
+	SpecialQuota    int            `json:"special_quota"`
+	TargetSubQuotas map[string]int `json:"target_sub_quotas,omitempty"` // Detailed target quota name -> capacity
+}
+
+// CapacityHistoryEntry compares the capacities a varsity published for a heading with the registry ones
+// as of a run.
+type CapacityHistoryEntry struct {
+	RunID       int           `json:"run_id"`
+	TriggeredAt time.Time     `json:"triggered_at"`
+	Registry    CapacitiesDTO `json:"registry"`
+	Published   CapacitiesDTO `json:"published"`
+	TotalOnly   bool          `json:"total_only,omitempty"` // The varsity only publishes the total, given as the published regular capacity
+	Drifted     bool          `json:"drifted"`
+	Applied     bool          `json:"applied"` // Whether the run used the published capacities
+}
+
+// CapacityHistoryResponse lists the published capacities of a heading over the runs, the latest first.
+type CapacityHistoryResponse struct {
+	HeadingID   int                    `json:"heading_id"`
+	HeadingCode string                 `json:"heading_code"`
+	Current     CapacitiesDTO          `json:"current"` // Capacities the latest results were calculated with
+	History     []CapacityHistoryEntry `json:"history"`
+}
+
+// GetHeadingCapacityHistory returns the capacities the varsity of the heading published on every run
+// alongside the registry ones, for the varsities with a capacity feed. The history can be limited to the
+// runs on which they drifted with drifted=true and to the latest runs with limit.
+func GetHeadingCapacityHistory(client *ent.Client) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		ctx := context.Background()
+
+		id, err := strconv.Atoi(c.Params("id"))
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, "Invalid heading ID")
+		}
+		limit, _ := strconv.Atoi(c.Query("limit", "0"))
+
+		h, err := client.Heading.Query().Where(heading.ID(id)).WithVarsity().Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return fiber.ErrNotFound
+			}
+			log.Printf("error getting heading %d: %v", id, err)
+			return fiber.ErrInternalServerError
+		}
+		if h.Edges.Varsity == nil {
+			return fiber.ErrNotFound
+		}
+
+		// Headings are stored with their full codes, the drifts with the codes within their varsities
+		varsityCode := h.Edges.Varsity.Code
+		q := client.CapacityDrift.Query().
+			Where(
+				capacitydrift.VarsityCodeEQ(varsityCode),
+				capacitydrift.HeadingCodeEQ(strings.TrimPrefix(h.Code, varsityCode+":")),
+			).
+			WithRun()
+		if c.Query("drifted") == "true" {
+			q = q.Where(capacitydrift.Drifted(true))
+		}
+		if limit > 0 {
+			q = q.Limit(limit)
+		}
+
+		drifts, err := q.Order(ent.Desc(capacitydrift.FieldRunID)).All(ctx)
+		if err != nil {
+			log.Printf("error getting capacity drifts of heading %d: %v", id, err)
+			return fiber.ErrInternalServerError
+		}
+
+		response := CapacityHistoryResponse{
+			HeadingID:   h.ID,
+			HeadingCode: h.Code,
+			Current: CapacitiesDTO{
+				Regular:         h.RegularCapacity,
+				TargetQuota:     h.TargetQuotaCapacity,
+				DedicatedQuota:  h.DedicatedQuotaCapacity,
+				SpecialQuota:    h.SpecialQuotaCapacity,
+				TargetSubQuotas: h.TargetSubQuotas,
+			},
+			History: make([]CapacityHistoryEntry, 0, len(drifts)),
+		}
+		for _, d := range drifts {
+			entry := CapacityHistoryEntry{
+				RunID: d.RunID,
+				Registry: CapacitiesDTO{
+					Regular:         d.RegistryRegular,
+					TargetQuota:     d.RegistryTargetQuota,
+					DedicatedQuota:  d.RegistryDedicatedQuota,
+					SpecialQuota:    d.RegistrySpecialQuota,
+					TargetSubQuotas: d.RegistryTargetSubQuotas,
+				},
+				Published: CapacitiesDTO{
+					Regular:         d.PublishedRegular,
+					TargetQuota:     d.PublishedTargetQuota,
+					DedicatedQuota:  d.PublishedDedicatedQuota,
+					SpecialQuota:    d.PublishedSpecialQuota,
+					TargetSubQuotas: d.PublishedTargetSubQuotas,
+				},
+				TotalOnly: d.TotalOnly,
+				Drifted:   d.Drifted,
+				Applied:   d.Applied,
+			}
+			if r := d.Edges.Run; r != nil {
+				entry.TriggeredAt = r.TriggeredAt
+			}
+			response.History = append(response.History, entry)
+		}
+
+		return c.JSON(response)
+	}
+}
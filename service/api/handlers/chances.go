@@ -0,0 +1,185 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"sort"
+
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/ent"
+	"github.com/trueegorletov/analabit/core/ent/admissionchance"
+	"github.com/trueegorletov/analabit/core/ent/application"
+	"github.com/trueegorletov/analabit/core/ent/drainedresult"
+	"github.com/trueegorletov/analabit/core/ent/heading"
+	"github.com/trueegorletov/analabit/core/utils"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// StageChanceDTO is the probability of admission at a single drain stage.
+type StageChanceDTO struct {
+	DrainedPercent int     `json:"drained_percent"`
+	Probability    float64 `json:"probability"`
+	AdmittedCount  int     `json:"admitted_count"`
+	Iterations     int     `json:"iterations"`
+}
+
+// StudentChanceResponse holds the admission probabilities of one student's application.
+type StudentChanceResponse struct {
+	StudentID       string           `json:"student_id"`
+	HeadingID       int              `json:"heading_id"`
+	HeadingCode     string           `json:"heading_code"`
+	HeadingName     string           `json:"heading_name"`
+	VarsityCode     string           `json:"varsity_code"`
+	Priority        int              `json:"priority"`
+	CompetitionType core.Competition `json:"competition_type"`
+	RatingPlace     int              `json:"rating_place"`
+	RunID           int              `json:"run_id"`
+	Chances         []StageChanceDTO `json:"chances"`
+}
+
+// GetStudentChances returns, for every application of the student, how often the student was admitted
+// to its heading across the drain iterations of each stage of the requested run.
+func GetStudentChances(client *ent.Client) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		ctx := context.Background()
+		studentIDRaw := c.Params("id")
+
+		studentID, err := utils.PrepareStudentID(studentIDRaw)
+		if err != nil {
+			log.Printf("invalid student ID parameter '%s': %v", studentIDRaw, err)
+			return fiber.NewError(fiber.StatusBadRequest, "invalid student ID parameter")
+		}
+
+		runParam := c.Query("run", "latest")
+		runResolution, err := ResolveRunFromIteration(ctx, client, runParam)
+		if err != nil {
+			log.Printf("error resolving run from parameter '%s': %v", runParam, err)
+			return fiber.NewError(fiber.StatusBadRequest, "invalid run parameter")
+		}
+		runID := runResolution.RunID
+
+		applications, err := client.Application.Query().
+			Where(
+				application.StudentID(studentID),
+				application.RunIDEQ(runID),
+			).
+			WithHeading(func(q *ent.HeadingQuery) {
+				q.WithVarsity()
+			}).
+			All(ctx)
+		if err != nil {
+			log.Printf("error getting student applications: %v", err)
+			return fiber.ErrInternalServerError
+		}
+
+		if len(applications) == 0 {
+			return fiber.NewError(fiber.StatusNotFound, "Student not found")
+		}
+
+		headingIDs := make([]int, 0, len(applications))
+		for _, app := range applications {
+			if app.Edges.Heading != nil {
+				headingIDs = append(headingIDs, app.Edges.Heading.ID)
+			}
+		}
+
+		chances, err := client.AdmissionChance.Query().
+			Where(
+				admissionchance.RunIDEQ(runID),
+				admissionchance.StudentIDEQ(studentID),
+			).
+			WithHeading().
+			All(ctx)
+		if err != nil {
+			log.Printf("error getting admission chances: %v", err)
+			return fiber.ErrInternalServerError
+		}
+
+		// Only non-zero counts are stored, so the simulated stages of every heading and their iterations are taken
+		// from drained results
+		drained, err := client.DrainedResult.Query().
+			Where(
+				drainedresult.RunIDEQ(runID),
+				drainedresult.DrainedPercentGT(0),
+				drainedresult.IsVirtualEQ(false),
+				drainedresult.HasHeadingWith(heading.IDIn(headingIDs...)),
+			).
+			WithHeading().
+			All(ctx)
+		if err != nil {
+			log.Printf("error getting drained results: %v", err)
+			return fiber.ErrInternalServerError
+		}
+
+		stagesByHeading := make(map[int]map[int]StageChanceDTO)
+		for _, dr := range drained {
+			if dr.Edges.Heading == nil {
+				continue
+			}
+			hid := dr.Edges.Heading.ID
+			if stagesByHeading[hid] == nil {
+				stagesByHeading[hid] = make(map[int]StageChanceDTO)
+			}
+			stagesByHeading[hid][dr.DrainedPercent] = StageChanceDTO{DrainedPercent: dr.DrainedPercent, Iterations: dr.Iterations}
+		}
+		for _, ch := range chances {
+			if ch.Edges.Heading == nil {
+				continue
+			}
+			hid := ch.Edges.Heading.ID
+			if stagesByHeading[hid] == nil {
+				stagesByHeading[hid] = make(map[int]StageChanceDTO)
+			}
+			stagesByHeading[hid][ch.DrainedPercent] = StageChanceDTO{
+				DrainedPercent: ch.DrainedPercent,
+				Probability:    ch.Probability,
+				AdmittedCount:  ch.AdmittedCount,
+				Iterations:     ch.Iterations,
+			}
+		}
+
+		response := make([]StudentChanceResponse, 0, len(applications))
+		for _, app := range applications {
+			h := app.Edges.Heading
+			if h == nil {
+				continue
+			}
+
+			stageChances := make([]StageChanceDTO, 0, len(stagesByHeading[h.ID]))
+			for _, sc := range stagesByHeading[h.ID] {
+				stageChances = append(stageChances, sc)
+			}
+			sort.Slice(stageChances, func(i, j int) bool {
+				return stageChances[i].DrainedPercent < stageChances[j].DrainedPercent
+			})
+
+			varsityCode := ""
+			if h.Edges.Varsity != nil {
+				varsityCode = h.Edges.Varsity.Code
+			}
+
+			response = append(response, StudentChanceResponse{
+				StudentID:       utils.PrettifyStudentID(app.StudentID),
+				HeadingID:       h.ID,
+				HeadingCode:     h.Code,
+				HeadingName:     h.Name,
+				VarsityCode:     varsityCode,
+				Priority:        app.Priority,
+				CompetitionType: app.CompetitionType,
+				RatingPlace:     app.RatingPlace,
+				RunID:           runID,
+				Chances:         stageChances,
+			})
+		}
+
+		sort.Slice(response, func(i, j int) bool {
+			if response[i].VarsityCode != response[j].VarsityCode {
+				return response[i].VarsityCode < response[j].VarsityCode
+			}
+			return response[i].Priority < response[j].Priority
+		})
+
+		return c.JSON(response)
+	}
+}
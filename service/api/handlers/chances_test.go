@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/ent"
+	"github.com/trueegorletov/analabit/core/ent/enttest"
+)
+
+func TestGetStudentChances(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:chances?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+
+	run := client.Run.Create().SetFinished(true).SaveX(ctx)
+	v := client.Varsity.Create().SetCode("test").SetName("Test Varsity").SaveX(ctx)
+	newHeading := func(code string) *ent.Heading {
+		return client.Heading.Create().
+			SetCode(code).SetName(code).
+			SetRegularCapacity(10).SetTargetQuotaCapacity(0).SetDedicatedQuotaCapacity(0).SetSpecialQuotaCapacity(0).
+			SetVarsity(v).
+			SaveX(ctx)
+	}
+	h1 := newHeading("test:H1")
+	h2 := newHeading("test:H2")
+
+	const studentID = "0000000000042"
+	for i, h := range []*ent.Heading{h2, h1} {
+		client.Application.Create().
+			SetStudentID(studentID).SetPriority(2 - i).SetCompetitionType(core.CompetitionRegular).
+			SetRatingPlace(7).SetScore(250).
+			SetHeading(h).SetRunID(run.ID).
+			SaveX(ctx)
+		for _, percent := range []int{90, 50} {
+			client.DrainedResult.Create().
+				SetDrainedPercent(percent).SetIterations(20).
+				SetAvgPassingScore(0).SetMinPassingScore(0).SetMaxPassingScore(0).SetMedPassingScore(0).
+				SetAvgLastAdmittedRatingPlace(0).SetMinLastAdmittedRatingPlace(0).SetMaxLastAdmittedRatingPlace(0).SetMedLastAdmittedRatingPlace(0).
+				SetHeading(h).SetRunID(run.ID).
+				SaveX(ctx)
+		}
+	}
+	// The student got into H1 in 5 of 20 iterations at 50% and in every one at 90%, and never into H2
+	for percent, count := range map[int]int{50: 5, 90: 20} {
+		client.AdmissionChance.Create().
+			SetStudentID(studentID).SetDrainedPercent(percent).
+			SetAdmittedCount(count).SetIterations(20).SetProbability(float64(count) / 20).
+			SetHeading(h1).SetRunID(run.ID).
+			SaveX(ctx)
+	}
+
+	app := fiber.New()
+	app.Get("/students/:id/chances", GetStudentChances(client))
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/students/42/chances", nil))
+	require.NoError(t, err)
+	require.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	var chances []StudentChanceResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&chances))
+	require.Len(t, chances, 2)
+
+	// Applications come in priority order and stages in drain order
+	assert.Equal(t, "42", chances[0].StudentID)
+	assert.Equal(t, "test:H1", chances[0].HeadingCode)
+	assert.Equal(t, []StageChanceDTO{
+		{DrainedPercent: 50, Probability: 0.25, AdmittedCount: 5, Iterations: 20},
+		{DrainedPercent: 90, Probability: 1, AdmittedCount: 20, Iterations: 20},
+	}, chances[0].Chances)
+
+	assert.Equal(t, "test:H2", chances[1].HeadingCode)
+	assert.Equal(t, []StageChanceDTO{
+		{DrainedPercent: 50, Iterations: 20},
+		{DrainedPercent: 90, Iterations: 20},
+	}, chances[1].Chances)
+
+	resp, err = app.Test(httptest.NewRequest("GET", "/students/43/chances", nil))
+	require.NoError(t, err)
+	assert.Equal(t, fiber.StatusNotFound, resp.StatusCode)
+}
@@ -2,17 +2,29 @@ package handlers
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"math"
 	"strconv"
+	"strings"
 
+	"entgo.io/ent/dialect/sql"
+	"github.com/trueegorletov/analabit/core"
 	"github.com/trueegorletov/analabit/core/ent"
 	"github.com/trueegorletov/analabit/core/ent/heading"
+	"github.com/trueegorletov/analabit/core/ent/predicate"
 	"github.com/trueegorletov/analabit/core/ent/varsity"
 
 	"github.com/gofiber/fiber/v3"
 )
 
-// GetHeadings retrieves a list of headings, with optional filtering.
+// GetHeadings retrieves a list of headings, with optional filtering:
+//   - varsityCode: headings of the varsity
+//   - directionCode: headings whose OKSO direction code starts with the value, e.g. 01.03.02 or 01.03
+//   - level, studyForm: headings of the level (bachelor, specialist, master) and study form (full_time,
+//     part_time, extramural)
+//   - exams: comma-separated entrance exams the headings must all have, each given as "subject" or as
+//     "subject:score" to also require the exam's minimum score, if known, to be at most score
 func GetHeadings(client *ent.Client) fiber.Handler {
 	return func(c fiber.Ctx) error {
 		limit, _ := strconv.Atoi(c.Query("limit", "0"))
@@ -24,6 +36,24 @@ func GetHeadings(client *ent.Client) fiber.Handler {
 		if varsityCode != "" {
 			q = q.Where(heading.HasVarsityWith(varsity.CodeEQ(varsityCode)))
 		}
+		if directionCode := c.Query("directionCode"); directionCode != "" {
+			q = q.Where(heading.DirectionCodeHasPrefix(directionCode))
+		}
+		if level := c.Query("level"); level != "" {
+			q = q.Where(heading.LevelEQ(level))
+		}
+		if studyForm := c.Query("studyForm"); studyForm != "" {
+			q = q.Where(heading.StudyFormEQ(studyForm))
+		}
+		if exams := c.Query("exams"); exams != "" {
+			for _, exam := range strings.Split(exams, ",") {
+				subject, score, err := parseExamFilter(exam)
+				if err != nil {
+					return fiber.NewError(fiber.StatusBadRequest, err.Error())
+				}
+				q = q.Where(headingHasExam(subject, score))
+			}
+		}
 
 		// preload varsity to access code
 
@@ -56,6 +86,13 @@ func GetHeadings(client *ent.Client) fiber.Handler {
 				TargetQuotaCapacity:    h.TargetQuotaCapacity,
 				DedicatedQuotaCapacity: h.DedicatedQuotaCapacity,
 				SpecialQuotaCapacity:   h.SpecialQuotaCapacity,
+				Subjects:               h.Subjects,
+				TargetSubQuotas:        h.TargetSubQuotas,
+				PaidCapacity:           h.PaidCapacity,
+				DirectionCode:          h.DirectionCode,
+				Level:                  h.Level,
+				StudyForm:              h.StudyForm,
+				Exams:                  h.Exams,
 				Varsity:                vDTO,
 			}
 		}
@@ -91,6 +128,13 @@ func GetHeadingByID(client *ent.Client) fiber.Handler {
 			TargetQuotaCapacity:    h.TargetQuotaCapacity,
 			DedicatedQuotaCapacity: h.DedicatedQuotaCapacity,
 			SpecialQuotaCapacity:   h.SpecialQuotaCapacity,
+			Subjects:               h.Subjects,
+			TargetSubQuotas:        h.TargetSubQuotas,
+			PaidCapacity:           h.PaidCapacity,
+			DirectionCode:          h.DirectionCode,
+			Level:                  h.Level,
+			StudyForm:              h.StudyForm,
+			Exams:                  h.Exams,
 			Varsity:                vDTO,
 		}
 
@@ -98,6 +142,35 @@ func GetHeadingByID(client *ent.Client) fiber.Handler {
 	}
 }
 
+// parseExamFilter parses an exams filter item given as "subject" or "subject:score". Without a score,
+// any minimum score passes.
+func parseExamFilter(exam string) (string, int, error) {
+	subject, scoreRaw, hasScore := strings.Cut(strings.TrimSpace(exam), ":")
+	if subject == "" {
+		return "", 0, fmt.Errorf("invalid exams filter %q", exam)
+	}
+	if !hasScore {
+		return subject, math.MaxInt32, nil
+	}
+
+	score, err := strconv.Atoi(scoreRaw)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid score in exams filter %q", exam)
+	}
+	return subject, score, nil
+}
+
+// headingHasExam matches the headings having the entrance exam whose minimum score is unknown or at most score.
+func headingHasExam(subject string, score int) predicate.Heading {
+	return func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.WriteString("jsonb_path_exists(").WriteString(s.C(heading.FieldExams)).WriteString("::jsonb, ")
+			b.WriteString(`'$[*] ? (@.subject == $subject && (!exists(@.min_score) || @.min_score <= $score))', `)
+			b.WriteString("jsonb_build_object('subject', ").Arg(subject).WriteString("::text, 'score', ").Arg(score).WriteString("::int))")
+		}))
+	}
+}
+
 // ADD: heading response DTO
 
 type VarsityDTO struct {
@@ -107,12 +180,20 @@ type VarsityDTO struct {
 }
 
 type HeadingResponse struct {
-	ID                     int        `json:"id"`
-	Code                   string     `json:"code"`
-	Name                   string     `json:"name"`
-	RegularCapacity        int        `json:"regular_capacity"`
-	TargetQuotaCapacity    int        `json:"target_quota_capacity"`
-	DedicatedQuotaCapacity int        `json:"dedicated_quota_capacity"`
-	SpecialQuotaCapacity   int        `json:"special_quota_capacity"`
-	Varsity                VarsityDTO `json:"varsity"`
+	ID                     int            `json:"id"`
+	Code                   string         `json:"code"`
+	Name                   string         `json:"name"`
+	RegularCapacity        int            `json:"regular_capacity"`
+	TargetQuotaCapacity    int            `json:"target_quota_capacity"`
+	DedicatedQuotaCapacity int            `json:"dedicated_quota_capacity"`
+	SpecialQuotaCapacity   int            `json:"special_quota_capacity"`
+	Subjects               []string       `json:"subjects,omitempty"`
+	TargetSubQuotas        map[string]int `json:"target_sub_quotas,omitempty"` // Detailed target quota name -> capacity
+	PaidCapacity           int            `json:"paid_capacity,omitempty"`
+	Varsity                VarsityDTO     `json:"varsity"`
+
+	DirectionCode string              `json:"direction_code,omitempty"` // OKSO code, e.g. 01.03.02
+	Level         string              `json:"level,omitempty"`
+	StudyForm     string              `json:"study_form,omitempty"`
+	Exams         []core.EntranceExam `json:"exams,omitempty"`
 }
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/trueegorletov/analabit/core"
 	"github.com/trueegorletov/analabit/core/ent"
 	"github.com/trueegorletov/analabit/core/ent/application"
 	"github.com/trueegorletov/analabit/core/ent/calculation"
@@ -26,6 +27,11 @@ type CalculationResultDTO struct {
 	LastAdmittedRatingPlace int    `json:"last_admitted_rating_place"`
 	RunID                   int    `json:"run_id"`
 	RegularsAdmitted        bool   `json:"regulars_admitted"`
+	// Passing data per detailed target quota, only for headings that have them
+	SubQuotas []core.SubQuotaResultDTO `json:"sub_quotas,omitempty"`
+	// Passing data of the paid places, only for headings that have them
+	PaidPassingScore            int `json:"paid_passing_score,omitempty"`
+	PaidLastAdmittedRatingPlace int `json:"paid_last_admitted_rating_place,omitempty"`
 }
 
 // DrainedResultDTO represents aggregated drained statistics per heading.
@@ -33,6 +39,7 @@ type DrainedResultDTO struct {
 	HeadingID                  int    `json:"heading_id"`
 	HeadingCode                string `json:"heading_code"`
 	DrainedPercent             int    `json:"drained_percent"`
+	DrainModel                 string `json:"drain_model,omitempty"`
 	AvgPassingScore            int    `json:"avg_passing_score"`
 	MinPassingScore            int    `json:"min_passing_score"`
 	MaxPassingScore            int    `json:"max_passing_score"`
@@ -41,8 +48,27 @@ type DrainedResultDTO struct {
 	MinLastAdmittedRatingPlace int    `json:"min_last_admitted_rating_place"`
 	MaxLastAdmittedRatingPlace int    `json:"max_last_admitted_rating_place"`
 	MedLastAdmittedRatingPlace int    `json:"med_last_admitted_rating_place"`
+	P10PassingScore            int    `json:"p10_passing_score,omitempty"`
+	P25PassingScore            int    `json:"p25_passing_score,omitempty"`
+	P75PassingScore            int    `json:"p75_passing_score,omitempty"`
+	P90PassingScore            int    `json:"p90_passing_score,omitempty"`
+	P10LastAdmittedRatingPlace int    `json:"p10_last_admitted_rating_place,omitempty"`
+	P25LastAdmittedRatingPlace int    `json:"p25_last_admitted_rating_place,omitempty"`
+	P75LastAdmittedRatingPlace int    `json:"p75_last_admitted_rating_place,omitempty"`
+	P90LastAdmittedRatingPlace int    `json:"p90_last_admitted_rating_place,omitempty"`
+	Iterations                 int    `json:"iterations,omitempty"` // Number of drain iterations the result was aggregated from
 	RunID                      int    `json:"run_id"`
 	RegularsAdmitted           bool   `json:"regulars_admitted"`
+	AvgPriorityStageAdmitted   int    `json:"avg_priority_stage_admitted,omitempty"`
+	AvgMainStageAdmitted       int    `json:"avg_main_stage_admitted,omitempty"`
+	// Passing data per detailed target quota, only for headings that have them
+	SubQuotas []core.SubQuotaResultDTO `json:"sub_quotas,omitempty"`
+	// Average passing data of the paid places, only for headings that have them
+	AvgPaidPassingScore            int `json:"avg_paid_passing_score,omitempty"`
+	AvgPaidLastAdmittedRatingPlace int `json:"avg_paid_last_admitted_rating_place,omitempty"`
+	// Distributions over the drain iterations, only returned when requested with `distribution`
+	PassingScoreHistogram            *core.HistogramDTO `json:"passing_score_histogram,omitempty"`
+	LastAdmittedRatingPlaceHistogram *core.HistogramDTO `json:"last_admitted_rating_place_histogram,omitempty"`
 }
 
 // ResultsResponse aggregates requested result kinds.
@@ -95,6 +121,8 @@ func GetResults(client *ent.Client) fiber.Handler {
 		// `drained` parameter, if present, determines which drained results to return.
 		drainedParam := c.Query("drained") // "all" | "<csv steps>" | ""
 		includeDrained := drainedParam != ""
+		// `distribution=1` adds the passing score and rating place histograms to the drained results.
+		includeDistribution := c.Query("distribution") == "1" || c.Query("distribution") == "true"
 
 		// Determine requested drained steps
 		var requestedSteps []int
@@ -209,6 +237,10 @@ func GetResults(client *ent.Client) fiber.Handler {
 					LastAdmittedRatingPlace: dr.AvgLastAdmittedRatingPlace,
 					RunID:                   runID,
 					RegularsAdmitted:        dr.RegularsAdmitted,
+					SubQuotas:               dr.SubQuotas,
+
+					PaidPassingScore:            dr.AvgPaidPassingScore,
+					PaidLastAdmittedRatingPlace: dr.AvgPaidLastAdmittedRatingPlace,
 				}
 				primaryMap[hid] = dto
 				coveredHeading[hid] = struct{}{}
@@ -227,6 +259,7 @@ func GetResults(client *ent.Client) fiber.Handler {
 			if len(headingIDs) == 0 || len(uncoveredIDs) > 0 { // if no filter, try all
 				calcQuery := client.Calculation.Query().Where(
 					calculation.RunIDEQ(runResolution.RunID),
+					calculation.PaidEQ(false),
 				).WithHeading().WithRun()
 				if len(uncoveredIDs) > 0 {
 					calcQuery = calcQuery.Where(calculation.HasHeadingWith(heading.IDIn(uncoveredIDs...)))
@@ -255,6 +288,7 @@ func GetResults(client *ent.Client) fiber.Handler {
 							application.StudentIDEQ(c.StudentID),
 							application.HasHeadingWith(heading.ID(hid)),
 							application.RunIDEQ(runResolution.RunID),
+							application.CompetitionTypeNEQ(core.CompetitionPaid),
 						).Only(ctx)
 
 						passingScore := 0
@@ -339,6 +373,7 @@ func GetResults(client *ent.Client) fiber.Handler {
 						HeadingID:                  hid,
 						HeadingCode:                chosen.Edges.Heading.Code,
 						DrainedPercent:             chosen.DrainedPercent,
+						DrainModel:                 chosen.DrainModel,
 						AvgPassingScore:            chosen.AvgPassingScore,
 						MinPassingScore:            chosen.MinPassingScore,
 						MaxPassingScore:            chosen.MaxPassingScore,
@@ -347,8 +382,27 @@ func GetResults(client *ent.Client) fiber.Handler {
 						MinLastAdmittedRatingPlace: chosen.MinLastAdmittedRatingPlace,
 						MaxLastAdmittedRatingPlace: chosen.MaxLastAdmittedRatingPlace,
 						MedLastAdmittedRatingPlace: chosen.MedLastAdmittedRatingPlace,
+						P10PassingScore:            chosen.P10PassingScore,
+						P25PassingScore:            chosen.P25PassingScore,
+						P75PassingScore:            chosen.P75PassingScore,
+						P90PassingScore:            chosen.P90PassingScore,
+						P10LastAdmittedRatingPlace: chosen.P10LastAdmittedRatingPlace,
+						P25LastAdmittedRatingPlace: chosen.P25LastAdmittedRatingPlace,
+						P75LastAdmittedRatingPlace: chosen.P75LastAdmittedRatingPlace,
+						P90LastAdmittedRatingPlace: chosen.P90LastAdmittedRatingPlace,
+						Iterations:                 chosen.Iterations,
 						RunID:                      runID,
 						RegularsAdmitted:           chosen.RegularsAdmitted,
+						AvgPriorityStageAdmitted:   chosen.AvgPriorityStageAdmitted,
+						AvgMainStageAdmitted:       chosen.AvgMainStageAdmitted,
+						SubQuotas:                  chosen.SubQuotas,
+
+						AvgPaidPassingScore:            chosen.AvgPaidPassingScore,
+						AvgPaidLastAdmittedRatingPlace: chosen.AvgPaidLastAdmittedRatingPlace,
+					}
+					if includeDistribution {
+						dto.PassingScoreHistogram = chosen.PassingScoreHistogram
+						dto.LastAdmittedRatingPlaceHistogram = chosen.LastAdmittedRatingPlaceHistogram
 					}
 					drainedMap[hid] = []DrainedResultDTO{dto}
 				}
@@ -366,6 +420,7 @@ func GetResults(client *ent.Client) fiber.Handler {
 						HeadingID:                  hid,
 						HeadingCode:                dr.Edges.Heading.Code,
 						DrainedPercent:             dr.DrainedPercent,
+						DrainModel:                 dr.DrainModel,
 						AvgPassingScore:            dr.AvgPassingScore,
 						MinPassingScore:            dr.MinPassingScore,
 						MaxPassingScore:            dr.MaxPassingScore,
@@ -374,8 +429,27 @@ func GetResults(client *ent.Client) fiber.Handler {
 						MinLastAdmittedRatingPlace: dr.MinLastAdmittedRatingPlace,
 						MaxLastAdmittedRatingPlace: dr.MaxLastAdmittedRatingPlace,
 						MedLastAdmittedRatingPlace: dr.MedLastAdmittedRatingPlace,
+						P10PassingScore:            dr.P10PassingScore,
+						P25PassingScore:            dr.P25PassingScore,
+						P75PassingScore:            dr.P75PassingScore,
+						P90PassingScore:            dr.P90PassingScore,
+						P10LastAdmittedRatingPlace: dr.P10LastAdmittedRatingPlace,
+						P25LastAdmittedRatingPlace: dr.P25LastAdmittedRatingPlace,
+						P75LastAdmittedRatingPlace: dr.P75LastAdmittedRatingPlace,
+						P90LastAdmittedRatingPlace: dr.P90LastAdmittedRatingPlace,
+						Iterations:                 dr.Iterations,
 						RunID:                      runID,
 						RegularsAdmitted:           dr.RegularsAdmitted,
+						AvgPriorityStageAdmitted:   dr.AvgPriorityStageAdmitted,
+						AvgMainStageAdmitted:       dr.AvgMainStageAdmitted,
+						SubQuotas:                  dr.SubQuotas,
+
+						AvgPaidPassingScore:            dr.AvgPaidPassingScore,
+						AvgPaidLastAdmittedRatingPlace: dr.AvgPaidLastAdmittedRatingPlace,
+					}
+					if includeDistribution {
+						dto.PassingScoreHistogram = dr.PassingScoreHistogram
+						dto.LastAdmittedRatingPlaceHistogram = dr.LastAdmittedRatingPlaceHistogram
 					}
 					drainedMap[hid] = append(drainedMap[hid], dto)
 				}
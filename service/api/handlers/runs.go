@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/ent"
+	"github.com/trueegorletov/analabit/core/ent/sourcereport"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// SourceReportResponse tells how a heading source of a varsity was loaded for a run.
+type SourceReportResponse struct {
+	VarsityCode string `json:"varsity_code"`
+	core.SourceReport
+}
+
+// RunSourcesResponse lists the source reports of a run.
+type RunSourcesResponse struct {
+	RunID   int                    `json:"run_id"`
+	Sources []SourceReportResponse `json:"sources"`
+}
+
+// GetRunSources returns the reports of how every heading source was loaded for the run, so that users can
+// tell which lists failed or came from an old cache. The run is given by its ID, or as "latest" or a
+// negative offset like the run parameter of the other endpoints. The reports can be filtered by varsity
+// code and status.
+func GetRunSources(client *ent.Client) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		ctx := context.Background()
+		runParam := c.Params("id")
+
+		var runID int
+		if id, err := strconv.Atoi(runParam); err == nil && id > 0 {
+			found, err := client.Run.Get(ctx, id)
+			if err != nil {
+				if ent.IsNotFound(err) {
+					return fiber.NewError(fiber.StatusNotFound, "run not found")
+				}
+				log.Printf("error getting run %d: %v", id, err)
+				return fiber.ErrInternalServerError
+			}
+			runID = found.ID
+		} else {
+			runResolution, err := ResolveRunFromIteration(ctx, client, runParam)
+			if err != nil {
+				log.Printf("error resolving run from parameter '%s': %v", runParam, err)
+				return fiber.NewError(fiber.StatusBadRequest, "invalid run parameter")
+			}
+			runID = runResolution.RunID
+		}
+
+		q := client.SourceReport.Query().
+			Where(sourcereport.RunIDEQ(runID))
+
+		if varsityCode := c.Query("varsity"); varsityCode != "" {
+			q = q.Where(sourcereport.VarsityCodeEQ(varsityCode))
+		}
+		if status := c.Query("status"); status != "" {
+			q = q.Where(sourcereport.StatusEQ(core.SourceLoadStatus(status)))
+		}
+
+		reports, err := q.
+			Order(ent.Asc(sourcereport.FieldVarsityCode), ent.Asc(sourcereport.FieldID)).
+			All(ctx)
+		if err != nil {
+			log.Printf("error getting source reports: %v", err)
+			return fiber.ErrInternalServerError
+		}
+
+		response := RunSourcesResponse{
+			RunID:   runID,
+			Sources: make([]SourceReportResponse, 0, len(reports)),
+		}
+		for _, r := range reports {
+			response.Sources = append(response.Sources, SourceReportResponse{
+				VarsityCode: r.VarsityCode,
+				SourceReport: core.SourceReport{
+					Source:       r.Source,
+					Name:         r.Name,
+					URL:          r.URL,
+					HeadingCodes: r.HeadingCodes,
+					Status:       r.Status,
+					Error:        r.Error,
+					StartedAt:    r.StartedAt,
+					DurationMs:   r.DurationMs,
+					Retries:      r.Retries,
+					Headings:     r.Headings,
+					Applications: r.Applications,
+					SkippedRows:  r.SkippedRows,
+					FailedLists:  r.FailedLists,
+				},
+			})
+		}
+
+		return c.JSON(response)
+	}
+}
@@ -21,6 +21,9 @@ type StudentApplicationResponse struct {
 	CompetitionType   core.Competition `json:"competition_type"`
 	RatingPlace       int              `json:"rating_place"`
 	Score             int              `json:"score"`
+	SubjectScores     []int            `json:"subject_scores,omitempty"`
+	AchievementScore  int              `json:"achievement_score"`
+	SubQuota          string           `json:"sub_quota,omitempty"`
 	RunID             int              `json:"run_id"`
 	UpdatedAt         time.Time        `json:"updated_at"`
 	OriginalSubmitted bool             `json:"original_submitted"`
@@ -66,6 +69,9 @@ func GetStudentByID(client *ent.Client) fiber.Handler {
 				CompetitionType:   app.CompetitionType,
 				RatingPlace:       app.RatingPlace,
 				Score:             app.Score,
+				SubjectScores:     app.SubjectScores,
+				AchievementScore:  app.AchievementScore,
+				SubQuota:          app.SubQuota,
 				RunID:             app.RunID,
 				UpdatedAt:         app.UpdatedAt,
 				OriginalSubmitted: app.OriginalSubmitted,
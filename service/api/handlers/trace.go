@@ -0,0 +1,127 @@
+package handlers
+
+import (
+	"context"
+	"log"
+	"sort"
+
+	"github.com/trueegorletov/analabit/core"
+	"github.com/trueegorletov/analabit/core/ent"
+	"github.com/trueegorletov/analabit/core/ent/admissionevent"
+	"github.com/trueegorletov/analabit/core/utils"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// StudentTraceResponse explains the primary admission results of one student within a single varsity.
+type StudentTraceResponse struct {
+	core.AdmissionExplanation
+	VarsityCode  string            `json:"varsity_code"`
+	RunID        int               `json:"run_id"`
+	HeadingNames map[string]string `json:"heading_names"` // heading code -> heading name
+}
+
+// GetStudentTrace returns, for every varsity the student applied to, the chain of admission events of the
+// student in the primary calculation of the requested run and the marginal applicant the student lost to
+// at each higher-priority heading.
+func GetStudentTrace(client *ent.Client) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		ctx := context.Background()
+		studentIDRaw := c.Params("id")
+
+		studentID, err := utils.PrepareStudentID(studentIDRaw)
+		if err != nil {
+			log.Printf("invalid student ID parameter '%s': %v", studentIDRaw, err)
+			return fiber.NewError(fiber.StatusBadRequest, "invalid student ID parameter")
+		}
+
+		runParam := c.Query("run", "latest")
+		runResolution, err := ResolveRunFromIteration(ctx, client, runParam)
+		if err != nil {
+			log.Printf("error resolving run from parameter '%s': %v", runParam, err)
+			return fiber.NewError(fiber.StatusBadRequest, "invalid run parameter")
+		}
+		runID := runResolution.RunID
+
+		events, err := client.AdmissionEvent.Query().
+			Where(
+				admissionevent.RunIDEQ(runID),
+				admissionevent.StudentIDEQ(studentID),
+			).
+			WithHeading(func(q *ent.HeadingQuery) {
+				q.WithVarsity()
+			}).
+			Order(ent.Asc(admissionevent.FieldSeq)).
+			All(ctx)
+		if err != nil {
+			log.Printf("error getting admission events: %v", err)
+			return fiber.ErrInternalServerError
+		}
+
+		if len(events) == 0 {
+			return fiber.NewError(fiber.StatusNotFound, "No admission trace recorded for the student")
+		}
+
+		// Priorities are per varsity, so every varsity is explained separately
+		eventsByVarsity := make(map[string][]core.TraceEvent)
+		headingNames := make(map[string]map[string]string)
+		for _, e := range events {
+			h := e.Edges.Heading
+			if h == nil {
+				continue
+			}
+			varsityCode := ""
+			if h.Edges.Varsity != nil {
+				varsityCode = h.Edges.Varsity.Code
+			}
+
+			eventsByVarsity[varsityCode] = append(eventsByVarsity[varsityCode], core.TraceEvent{
+				Seq:             e.Seq,
+				Kind:            e.Kind,
+				Stage:           e.Stage,
+				StudentID:       e.StudentID,
+				HeadingCode:     h.Code,
+				Priority:        e.Priority,
+				CompetitionType: e.CompetitionType,
+				OtherStudentID:  e.OtherStudentID,
+			})
+
+			if headingNames[varsityCode] == nil {
+				headingNames[varsityCode] = make(map[string]string)
+			}
+			headingNames[varsityCode][h.Code] = h.Name
+		}
+
+		response := make([]StudentTraceResponse, 0, len(eventsByVarsity))
+		for varsityCode, varsityEvents := range eventsByVarsity {
+			explanation := core.ExplainAdmission(studentID, varsityEvents)
+
+			// Student IDs are returned in the same form as by the other student endpoints
+			explanation.StudentID = utils.PrettifyStudentID(explanation.StudentID)
+			for i := range explanation.Events {
+				explanation.Events[i].StudentID = utils.PrettifyStudentID(explanation.Events[i].StudentID)
+				if explanation.Events[i].OtherStudentID != "" {
+					explanation.Events[i].OtherStudentID = utils.PrettifyStudentID(explanation.Events[i].OtherStudentID)
+				}
+			}
+			for i := range explanation.Losses {
+				if explanation.Losses[i].LostToStudentID != "" {
+					explanation.Losses[i].LostToStudentID = utils.PrettifyStudentID(explanation.Losses[i].LostToStudentID)
+				}
+			}
+
+			response = append(response, StudentTraceResponse{
+				AdmissionExplanation: explanation,
+				VarsityCode:          varsityCode,
+				RunID:                runID,
+				HeadingNames:         headingNames[varsityCode],
+			})
+		}
+
+		sort.Slice(response, func(i, j int) bool {
+			return response[i].VarsityCode < response[j].VarsityCode
+		})
+
+		return c.JSON(response)
+	}
+}
@@ -1,7 +1,9 @@
 package handlers
 
 import (
+	"github.com/trueegorletov/analabit/core"
 	"github.com/trueegorletov/analabit/core/ent"
+	"github.com/trueegorletov/analabit/core/ent/varsity"
 	"context"
 	"log"
 	"strconv"
@@ -9,13 +11,50 @@ import (
 	"github.com/gofiber/fiber/v3"
 )
 
+// VarsityGroup is a group of varsities sharing the value of the field they are grouped by.
+type VarsityGroup struct {
+	Key       string         `json:"key"`
+	Varsities []*ent.Varsity `json:"varsities"`
+}
+
+// GetVarsities retrieves a list of varsities, with optional filtering by city, region and parent (the code
+// of the organization the varsities are campuses of, e.g. hse). With groupBy set to city, region or parent
+// the varsities are returned as a list of VarsityGroup in the order of their first varsity; grouping by
+// parent puts a varsity without a parent into a group of its own keyed by its code.
 func GetVarsities(client *ent.Client) fiber.Handler {
 	return func(c fiber.Ctx) error {
 		limit, _ := strconv.Atoi(c.Query("limit", "100"))
 		offset, _ := strconv.Atoi(c.Query("offset", "0"))
 
-		varsities, err := client.Varsity.
-			Query().
+		var groupKey func(v *ent.Varsity) string
+		switch groupBy := c.Query("groupBy"); groupBy {
+		case "":
+		case "city":
+			groupKey = func(v *ent.Varsity) string { return v.City }
+		case "region":
+			groupKey = func(v *ent.Varsity) string { return v.Region }
+		case "parent":
+			groupKey = func(v *ent.Varsity) string {
+				return core.VarsityMetadata{Parent: v.Parent}.GroupCode(v.Code)
+			}
+		default:
+			return fiber.NewError(fiber.StatusBadRequest, "groupBy must be one of city, region, parent")
+		}
+
+		q := client.Varsity.Query()
+
+		if city := c.Query("city"); city != "" {
+			q = q.Where(varsity.CityEQ(city))
+		}
+		if region := c.Query("region"); region != "" {
+			q = q.Where(varsity.RegionEQ(region))
+		}
+		if parent := c.Query("parent"); parent != "" {
+			q = q.Where(varsity.ParentEQ(parent))
+		}
+
+		varsities, err := q.
+			Order(ent.Asc(varsity.FieldID)).
 			Limit(limit).
 			Offset(offset).
 			All(context.Background())
@@ -25,6 +64,30 @@ func GetVarsities(client *ent.Client) fiber.Handler {
 			return fiber.ErrInternalServerError
 		}
 
-		return c.JSON(varsities)
+		if groupKey == nil {
+			return c.JSON(varsities)
+		}
+
+		return c.JSON(groupVarsities(varsities, groupKey))
+	}
+}
+
+// groupVarsities groups the varsities by the key, keeping the order of the varsities within groups and
+// ordering the groups by their first varsity.
+func groupVarsities(varsities []*ent.Varsity, key func(v *ent.Varsity) string) []VarsityGroup {
+	groups := make([]VarsityGroup, 0)
+	indexOf := make(map[string]int)
+
+	for _, v := range varsities {
+		k := key(v)
+		i, ok := indexOf[k]
+		if !ok {
+			i = len(groups)
+			indexOf[k] = i
+			groups = append(groups, VarsityGroup{Key: k})
+		}
+		groups[i].Varsities = append(groups[i].Varsities, v)
 	}
+
+	return groups
 }
@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	_ "github.com/trueegorletov/analabit/core/registry" // registers the heading sources of the cached varsity definitions
+	"github.com/trueegorletov/analabit/core/source"
+	"github.com/trueegorletov/analabit/core/whatif"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/minio/minio-go/v7"
+)
+
+const (
+	// dataCacheRecheckInterval is how long the downloaded varsity data is used before checking for a newer upload
+	dataCacheRecheckInterval = time.Minute
+	// maxWhatIfDrainStages and maxWhatIfDrainIterations bound the work a single what-if request may ask for
+	maxWhatIfDrainStages     = 4
+	maxWhatIfDrainIterations = 100
+)
+
+// DataCacheStore keeps the varsity data uploaded by the producer after its latest run in memory, downloading it
+// again only when the uploaded object changes.
+type DataCacheStore struct {
+	client *minio.Client
+	bucket string
+	object string
+
+	mu        sync.Mutex
+	etag      string
+	checkedAt time.Time
+	caches    []*source.VarsityDataCache
+}
+
+// NewDataCacheStore creates a store of the varsity data uploaded to the given object.
+func NewDataCacheStore(client *minio.Client, bucket, object string) *DataCacheStore {
+	return &DataCacheStore{
+		client: client,
+		bucket: bucket,
+		object: object,
+	}
+}
+
+// Caches returns the latest uploaded varsity data. The returned caches are shared and must not be changed.
+func (s *DataCacheStore) Caches(ctx context.Context) ([]*source.VarsityDataCache, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.caches != nil && time.Since(s.checkedAt) < dataCacheRecheckInterval {
+		return s.caches, nil
+	}
+
+	info, err := s.client.StatObject(ctx, s.bucket, s.object, minio.StatObjectOptions{})
+	if err != nil {
+		if s.caches != nil {
+			log.Printf("failed to check varsity data object %s, using the loaded data: %v", s.object, err)
+			return s.caches, nil
+		}
+		return nil, fmt.Errorf("failed to stat varsity data object %s: %w", s.object, err)
+	}
+	s.checkedAt = time.Now()
+	if s.caches != nil && info.ETag == s.etag {
+		return s.caches, nil
+	}
+
+	obj, err := s.client.GetObject(ctx, s.bucket, s.object, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get varsity data object %s: %w", s.object, err)
+	}
+	defer obj.Close()
+
+	caches, err := source.DeserializeList(obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode varsity data object %s: %w", s.object, err)
+	}
+
+	log.Printf("loaded varsity data of %d varsities from %s", len(caches), s.object)
+	s.caches = caches
+	s.etag = info.ETag
+	return s.caches, nil
+}
+
+// PostWhatIf calculates the admission outcome of a hypothetical applicant described by the request body (see
+// whatif.Request) on the varsity data of the latest run. Nothing is persisted. Every calculation loads all
+// varsities anew, so at most maxConcurrent calculations run at once and the rest wait for their turn.
+func PostWhatIf(store *DataCacheStore, maxConcurrent int) fiber.Handler {
+	slots := make(chan struct{}, max(maxConcurrent, 1))
+
+	return func(c fiber.Ctx) error {
+		var req whatif.Request
+		if err := json.Unmarshal(c.Body(), &req); err != nil {
+			log.Printf("invalid what-if request body: %v", err)
+			return fiber.NewError(fiber.StatusBadRequest, "invalid request body")
+		}
+
+		if len(req.DrainStages) > maxWhatIfDrainStages {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("at most %d drain stages are allowed", maxWhatIfDrainStages))
+		}
+		if req.DrainIterations > maxWhatIfDrainIterations {
+			return fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("at most %d drain iterations are allowed", maxWhatIfDrainIterations))
+		}
+
+		caches, err := store.Caches(context.Background())
+		if err != nil {
+			log.Printf("error loading varsity data: %v", err)
+			return fiber.NewError(fiber.StatusServiceUnavailable, "varsity data is not available")
+		}
+
+		slots <- struct{}{}
+		defer func() { <-slots }()
+
+		result, err := whatif.Calculate(caches, req)
+		if err != nil {
+			if errors.Is(err, whatif.ErrInvalidRequest) {
+				return fiber.NewError(fiber.StatusBadRequest, err.Error())
+			}
+			log.Printf("error calculating what-if request: %v", err)
+			return fiber.ErrInternalServerError
+		}
+
+		return c.JSON(result)
+	}
+}
@@ -18,6 +18,8 @@ import (
 	"github.com/gofiber/fiber/v3"
 	"github.com/gofiber/fiber/v3/middleware/cors"
 	_ "github.com/lib/pq"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/common/expfmt"
 )
@@ -145,9 +147,23 @@ func main() {
 	api.Get("/varsities", handlers.GetVarsities(client))
 	api.Get("/headings", handlers.GetHeadings(client))
 	api.Get("/headings/:id", handlers.GetHeadingByID(client))
+	api.Get("/headings/:id/capacity-history", handlers.GetHeadingCapacityHistory(client))
 	api.Get("/applications", handlers.GetApplications(client))
 	api.Get("/students/:id", handlers.GetStudentByID(client))
+	api.Get("/students/:id/chances", handlers.GetStudentChances(client))
+	api.Get("/students/:id/trace", handlers.GetStudentTrace(client))
 	api.Get("/results", handlers.GetResults(client))
+	api.Get("/runs/:id/sources", handlers.GetRunSources(client))
+
+	minioClient, err := minio.New(cfg.MinioEndpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(cfg.MinioAccessKey, cfg.MinioSecretKey, ""),
+		Secure: cfg.MinioUseSSL,
+	})
+	if err != nil {
+		log.Fatalf("failed to initialize minio client: %v", err)
+	}
+	dataCacheStore := handlers.NewDataCacheStore(minioClient, cfg.MinioBucketName, cfg.DataCacheObjectName)
+	api.Post("/whatif", handlers.PostWhatIf(dataCacheStore, cfg.WhatIfConcurrency))
 
 
 
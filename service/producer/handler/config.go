@@ -11,6 +11,8 @@ type Config struct {
 	CacheTTLMinutes        int      `env:"CACHE_TTL_MINUTES" envDefault:"30"`
 	DrainStages            []int    `env:"DRAIN_SIM_STAGES" envSeparator:"," envDefault:"25,50,75,90"`
 	DrainIterations        int      `env:"DRAIN_SIM_ITERATIONS" envDefault:"100"`
+	DrainSeed              int64    `env:"DRAIN_SIM_SEED" envDefault:"0"` // 0 picks a fresh seed for every run
+	DrainModel             string   `env:"DRAIN_SIM_MODEL" envDefault:"uniform"`
 	MinioEndpoint          string   `env:"MINIO_ENDPOINT" envDefault:"minio:9000"`
 	MinioAccessKey         string   `env:"MINIO_ACCESS_KEY_ID" envDefault:"minioadmin"`
 	MinioSecretKey         string   `env:"MINIO_SECRET_ACCESS_KEY" envDefault:"minioadmin"`
@@ -20,6 +22,33 @@ type Config struct {
 	VarsitiesList          []string `env:"VARSITIES_LIST" envSeparator:"," envDefault:"all"`
 	VarsitiesExcluded      []string `env:"VARSITIES_EXCLUDED" envSeparator:"," envDefault:"spbstu"`
 	SelfQueryPeriodMinutes int      `env:"SELF_QUERY_PERIOD_MINUTES" envDefault:"45"`
+	// CrossVarsityCalculation runs primary calculations as one pass over all loaded varsities,
+	// so that a student holds at most one seat across varsities
+	CrossVarsityCalculation bool `env:"CROSS_VARSITY_CALCULATION" envDefault:"true"`
+	// RecordAdmissionTrace records the admission trace of primary calculations, used to explain a student's results.
+	// A trace holds every step of every application, so it is off by default and the aggregator keeps the traces
+	// of the latest CLEANUP_TRACE_RETENTION_RUNS runs only
+	RecordAdmissionTrace bool `env:"RECORD_ADMISSION_TRACE" envDefault:"false"`
+	// MetricsAddr is the address to serve Prometheus metrics on, empty disables the endpoint
+	MetricsAddr string `env:"METRICS_ADDR" envDefault:":9100"`
+	// DrainWorkers is the number of drain jobs (varsity and stage) run at once. Every job already spreads its
+	// iterations over all CPUs, so more workers mostly overlap one job's aggregation with another's iterations
+	DrainWorkers int `env:"DRAIN_SIM_WORKERS" envDefault:"2"`
+	// DrainMaxIterations and DrainTolerance make drain simulations adaptive: after DRAIN_SIM_ITERATIONS, iterations
+	// go on until passing scores converge to DrainTolerance points, up to DrainMaxIterations in total
+	DrainMaxIterations int     `env:"DRAIN_SIM_MAX_ITERATIONS" envDefault:"1000"`
+	DrainTolerance     float64 `env:"DRAIN_SIM_TOLERANCE" envDefault:"0.5"`
+	// DataCacheObjectName is the object the loaded varsity data of the latest run is uploaded to, for the
+	// what-if calculations of the API; empty disables the upload
+	DataCacheObjectName string `env:"DATA_CACHE_OBJECT_NAME" envDefault:"varsity_data_latest.gob"`
+	// HTTPRecord records every HTTP exchange of the crawls into an archive in CacheDir, to replay them offline
+	HTTPRecord bool `env:"HTTP_RECORD" envDefault:"false"`
+	// RawArchiveEnabled records the crawls like HTTPRecord and archives the fetched raw documents into the
+	// bucket by their content hash, with an index per crawl, so that any crawl can be reparsed later
+	RawArchiveEnabled bool `env:"RAW_ARCHIVE_ENABLED" envDefault:"false"`
+	// ApplyPublishedCapacities loads the headings whose capacities published by the capacity feed of their
+	// varsity drifted from the registry with the published ones, for all varsities with a capacity feed
+	ApplyPublishedCapacities bool `env:"APPLY_PUBLISHED_CAPACITIES" envDefault:"false"`
 	// SPbSTU fallback configuration
 	SpbstuFallbackEnabled bool   `env:"SPBSTU_FALLBACK_ENABLED" envDefault:"false"`
 	SpbstuFallbackGobName string `env:"SPBSTU_FALLBACK_GOB_NAME" envDefault:"payload_spbstu_a9dc55c5-addd-4269-a3b9-b40b175dfa52.gob"`
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"log/slog"
+	"path/filepath"
 	"sync"
 	"time"
 
@@ -19,9 +20,11 @@ import (
 
 	"github.com/trueegorletov/analabit/core"
 	"github.com/trueegorletov/analabit/core/drainer"
+	"github.com/trueegorletov/analabit/core/metrics"
 	"github.com/trueegorletov/analabit/core/registry"
 	"github.com/trueegorletov/analabit/core/source"
 	"github.com/trueegorletov/analabit/core/source/flaresolverr"
+	"github.com/trueegorletov/analabit/core/source/httparchive"
 	"github.com/trueegorletov/analabit/service/producer/proto"
 
 	"go.uber.org/multierr"
@@ -29,6 +32,22 @@ import (
 
 type Producer struct{}
 
+// reportViolations records the validation outcome of a varsity's primary calculation and logs any violations.
+// A broken calculation is still uploaded: the violations point at a calculator bug, not at bad source data.
+func reportViolations(varsityCode string, violations []core.Violation) {
+	if metrics.CalcMetrics != nil {
+		metrics.CalcMetrics.RecordValidation(varsityCode, violations)
+	}
+	if len(violations) == 0 {
+		return
+	}
+
+	slog.Error("Primary calculation violates admission invariants", "varsity", varsityCode, "violations", len(violations), "first", violations[0].String())
+	for _, violation := range violations {
+		slog.Debug("Admission invariant violation", "varsity", varsityCode, "violation", violation.String())
+	}
+}
+
 // loadSpbstuFromPayload loads SPbSTU data from a pre-serialized UploadPayload gob file
 // and converts it back to a VarsityCalculator for use in calculations
 func loadSpbstuFromPayload(minioClient *minio.Client, bucketName, objectName string, ctx context.Context) (*source.Varsity, error) {
@@ -52,10 +71,13 @@ func loadSpbstuFromPayload(minioClient *minio.Client, bucketName, objectName str
 		Code:           "spbstu",
 		Name:           payload.VarsityName,
 		HeadingSources: []source.HeadingSource{}, // Empty since we're loading from fallback
+		Metadata:       payload.VarsityMetadata,
 	}
 
 	// Create VarsityDataCache and populate it from the payload
 	cache := source.NewVarsityDataCache(&def)
+	cache.SourceReports = payload.SourceReports
+	cache.CapacityDrifts = payload.CapacityDrifts
 	
 	// Populate cache with headings first (ensuring headings come before applications)
 	for _, headingDTO := range payload.Headings {
@@ -66,10 +88,12 @@ func loadSpbstuFromPayload(minioClient *minio.Client, bucketName, objectName str
 		}
 
 		capacities := core.Capacities{
-			Regular:        headingDTO.RegularCapacity,
-			TargetQuota:    headingDTO.TargetQuotaCapacity,
-			DedicatedQuota: headingDTO.DedicatedQuotaCapacity,
-			SpecialQuota:   headingDTO.SpecialQuotaCapacity,
+			Regular:         headingDTO.RegularCapacity,
+			TargetQuota:     headingDTO.TargetQuotaCapacity,
+			DedicatedQuota:  headingDTO.DedicatedQuotaCapacity,
+			SpecialQuota:    headingDTO.SpecialQuotaCapacity,
+			TargetSubQuotas: headingDTO.TargetSubQuotas,
+			Paid:            headingDTO.PaidCapacity,
 		}
 		
 		headingData := &source.HeadingData{
@@ -95,6 +119,7 @@ func loadSpbstuFromPayload(minioClient *minio.Client, bucketName, objectName str
 			RatingPlace:       appDTO.RatingPlace,
 			Priority:          appDTO.Priority,
 			CompetitionType:   appDTO.CompetitionType,
+			SubQuota:          appDTO.SubQuota,
 			OriginalSubmitted: false, // Will be set below for specific students
 		}
 		
@@ -114,7 +139,7 @@ func loadSpbstuFromPayload(minioClient *minio.Client, bucketName, objectName str
 		VarsityDefinition: &def,
 		VarsityCalculator: nil, // Will be created by LoadFromCache
 		VarsityDataCache:  cache,
-		MSUInternalIDs:    make(map[string]string), // Empty for SPbSTU
+		InternalIDs:       make(map[string]string), // Empty for SPbSTU
 	}
 	
 	// Load VarsityCalculator from cache - this handles all the proper initialization
@@ -166,8 +191,6 @@ func (p *Producer) Produce(ctx context.Context, req *proto.ProduceRequest, rsp *
 	return p.runProduceWorkflow(ctx, req, rsp)
 }
 
-const maxWorkerCount = 1
-
 // runProduceWorkflow contains the core logic for crawling, calculating, and uploading results.
 // This can be called either by the public RPC endpoint or an internal ticker.
 func (p *Producer) runProduceWorkflow(ctx context.Context, req *proto.ProduceRequest, rsp *proto.ProduceResponse) error {
@@ -193,19 +216,62 @@ func (p *Producer) runProduceWorkflow(ctx context.Context, req *proto.ProduceReq
 		drainIterations = int32(Cfg.DrainIterations)
 	}
 
+	drainSeed := req.GetDrainSeed()
+	if drainSeed == 0 {
+		drainSeed = Cfg.DrainSeed
+	}
+	if drainSeed == 0 {
+		drainSeed = time.Now().UnixNano()
+	}
+
+	drainModel := req.GetDrainModel()
+	if drainModel == "" {
+		drainModel = Cfg.DrainModel
+	}
+
 	params := registry.CrawlOptions{
-		VarsitiesList:    varsitiesList,
-		VarsitiesExclude: varsitiesExcluded,
-		CacheDir:         Cfg.CacheDir,
-		CacheTTLMinutes:  int(cacheTTL),
-		DrainStages:      toIntSlice(drainStages),
-		DrainIterations:  int(drainIterations),
+		VarsitiesList:      varsitiesList,
+		VarsitiesExclude:   varsitiesExcluded,
+		CacheDir:           Cfg.CacheDir,
+		CacheTTLMinutes:    int(cacheTTL),
+		DrainStages:        toIntSlice(drainStages),
+		DrainIterations:    int(drainIterations),
+		DrainMaxIterations: Cfg.DrainMaxIterations,
+		DrainTolerance:     Cfg.DrainTolerance,
+		DrainSeed:          drainSeed,
+		CacheFile:          req.GetCacheFile(),
+		DrainModel:         drainModel,
+		DrainModels:        req.GetDrainModels(),
+		PreviousCacheFile:  req.GetPreviousCacheFile(),
+		HTTPRecord:         Cfg.HTTPRecord || Cfg.RawArchiveEnabled,
+
+		ApplyPublishedCapacities: Cfg.ApplyPublishedCapacities,
+	}
+
+	reparseCrawl := req.GetReparseCrawl()
+	if reparseCrawl != "" {
+		minioClient, err := minio.New(Cfg.MinioEndpoint, &minio.Options{
+			Creds:  credentials.NewStaticV4(Cfg.MinioAccessKey, Cfg.MinioSecretKey, ""),
+			Secure: Cfg.MinioUseSSL,
+		})
+		if err != nil {
+			log.Printf("failed to initialize minio client: %v", err)
+			return errors.InternalServerError("producer.produce.minio", "failed to initialize minio client: %v", err)
+		}
+
+		archiveFile, err := restoreRawCrawl(ctx, &minioBlobStore{client: minioClient, bucket: Cfg.MinioBucketName}, reparseCrawl)
+		if err != nil {
+			log.Printf("failed to prepare reparse: %v", err)
+			return errors.InternalServerError("producer.produce.reparse", "failed to prepare reparse: %v", err)
+		}
+		params.HTTPRecord = false
+		params.HTTPReplayFile = archiveFile
 	}
 
-	slog.Info("Producer configured", "varsitiesList", params.VarsitiesList, "varsitiesExclude", params.VarsitiesExclude, "cacheTTL", params.CacheTTLMinutes, "drainStages", params.DrainStages, "drainIterations", params.DrainIterations)
+	slog.Info("Producer configured", "varsitiesList", params.VarsitiesList, "varsitiesExclude", params.VarsitiesExclude, "cacheTTL", params.CacheTTLMinutes, "drainStages", params.DrainStages, "drainIterations", params.DrainIterations, "drainMaxIterations", params.DrainMaxIterations, "drainTolerance", params.DrainTolerance, "drainSeed", params.DrainSeed, "cacheFile", params.CacheFile, "drainModel", params.DrainModel, "drainModels", params.DrainModels, "reparseCrawl", reparseCrawl)
 
 	slog.Info("Starting crawl and cache phase")
-	result, err := registry.CrawlWithOptions(registry.AllDefinitions, params)
+	result, err := registry.CrawlWithOptions(ctx, registry.AllDefinitions, params)
 	if err != nil {
 		log.Printf("failed to crawl or cache: %v", err)
 		return err
@@ -255,13 +321,54 @@ func (p *Producer) runProduceWorkflow(ctx context.Context, req *proto.ProduceReq
 	}
 	slog.Info("Loaded varsity codes", "codes", loadedVarsityCodes)
 
-	slog.Info("Starting primary calculations")
+	slog.Info("Starting primary calculations", "crossVarsity", Cfg.CrossVarsityCalculation)
 	primaryResults := make(map[string][]core.CalculationResult)
+	primaryTraces := make(map[string]*core.AdmissionTrace)
+	if Cfg.CrossVarsityCalculation {
+		calculators := make([]*core.VarsityCalculator, 0, len(varsities))
+		for _, v := range varsities {
+			calculators = append(calculators, v.Clone().VarsityCalculator)
+		}
+		multiCalculator := core.NewMultiVarsityCalculator(calculators...)
+		if Cfg.RecordAdmissionTrace {
+			multiCalculator.EnableTrace()
+		}
+		primaryResults = multiCalculator.CalculateAdmissions()
+		for i, v := range varsities {
+			primaryTraces[v.Code] = calculators[i].Trace()
+		}
+
+		violationsByVarsity := make(map[string][]core.Violation)
+		for _, violation := range multiCalculator.Validate(primaryResults) {
+			violationsByVarsity[violation.VarsityCode] = append(violationsByVarsity[violation.VarsityCode], violation)
+		}
+		for _, v := range varsities {
+			reportViolations(v.Code, violationsByVarsity[v.Code])
+		}
+	} else {
+		for _, v := range varsities {
+			clonedVarsity := v.Clone()
+			if Cfg.RecordAdmissionTrace {
+				clonedVarsity.VarsityCalculator.EnableTrace()
+			}
+			results := clonedVarsity.VarsityCalculator.CalculateAdmissions()
+			primaryResults[v.Code] = results
+			primaryTraces[v.Code] = clonedVarsity.VarsityCalculator.Trace()
+			reportViolations(v.Code, clonedVarsity.VarsityCalculator.Validate(results))
+		}
+	}
+	drainModels := make(map[string]drainer.DrainModel, len(varsities))
+	drainModelNames := make(map[string]string, len(varsities))
 	for _, v := range varsities {
-		clonedVarsity := v.Clone()
-		results := clonedVarsity.VarsityCalculator.CalculateAdmissions()
-		primaryResults[v.Code] = results
+		model, err := drainer.NewModel(params.DrainModelFor(v.Code), v, varsities, result.PreviousVarsity(v.Code))
+		if err != nil {
+			slog.Error("Failed to create drain model, falling back to uniform", "varsity", v.Code, "error", err)
+			model = drainer.UniformModel{}
+		}
+		drainModels[v.Code] = model
+		drainModelNames[v.Code] = model.Name()
 	}
+
 	slog.Info("Calculations completed – starting drain simulations")
 	drainedResults := make(map[string]map[int][]drainer.DrainedResult)
 
@@ -280,8 +387,8 @@ func (p *Producer) runProduceWorkflow(ctx context.Context, req *proto.ProduceReq
 	// Calculate total jobs and determine worker count
 	totalJobs := len(varsities) * len(params.DrainStages)
 	workerCount := totalJobs
-	if workerCount > maxWorkerCount {
-		workerCount = maxWorkerCount
+	if workerCount > Cfg.DrainWorkers {
+		workerCount = max(Cfg.DrainWorkers, 1)
 	}
 
 	slog.Info("Starting parallel drain simulations", "totalJobs", totalJobs, "workers", workerCount)
@@ -311,8 +418,8 @@ func (p *Producer) runProduceWorkflow(ctx context.Context, req *proto.ProduceReq
 			defer wgDrainer.Done()
 			for job := range jobs {
 				// Create drainer instance and run simulation
-				drainerInstance := drainer.New(job.varsity, job.stage)
-				drainedResultSlice := drainerInstance.Run(params.DrainIterations)
+				drainerInstance := drainer.New(job.varsity, job.stage, drainer.DeriveSeed(params.DrainSeed, job.code, job.stage), drainModels[job.code])
+				drainedResultSlice := drainerInstance.RunAdaptive(params.DrainIterations, params.DrainMaxIterations, params.DrainTolerance)
 
 				// Safely write results to shared map
 				muDrainer.Lock()
@@ -366,6 +473,27 @@ func (p *Producer) runProduceWorkflow(ctx context.Context, req *proto.ProduceReq
 		return err // The error is already logged and formatted
 	}
 
+	// The documents of a reparsed crawl are archived already
+	rawCrawl := reparseCrawl
+	if Cfg.RawArchiveEnabled && result.HTTPArchiveFile != "" {
+		crawl := httparchive.CrawlName(result.HTTPArchiveFile)
+		if _, err := httparchive.Publish(ctx, &minioBlobStore{client: minioClient, bucket: bucketName}, result.HTTPArchiveFile, crawl); err != nil {
+			// The results of the run don't depend on the archive, only reparsing it later does
+			slog.Error("Failed to archive raw documents", "crawl", crawl, "error", err)
+		} else {
+			rawCrawl = crawl
+		}
+	}
+
+	if Cfg.DataCacheObjectName != "" {
+		if err := uploadDataCache(minioClient, bucketName, Cfg.DataCacheObjectName, varsities); err != nil {
+			// What-if calculations keep using the previous run's data, so the run goes on
+			slog.Error("Failed to upload varsity data cache", "object", Cfg.DataCacheObjectName, "error", err)
+		} else {
+			slog.Info("Uploaded varsity data cache", "object", Cfg.DataCacheObjectName)
+		}
+	}
+
 	for _, v := range varsities {
 		wgUpload.Add(1)
 		go func(v *source.Varsity) {
@@ -373,14 +501,20 @@ func (p *Producer) runProduceWorkflow(ctx context.Context, req *proto.ProduceReq
 
 			// 1. Prepare DTOs
 			drainedDTOs := make(map[int][]core.DrainedResultDTO)
+			chanceDTOs := make(map[int][]core.AdmissionChanceDTO)
 			if drainedStages, ok := drainedResults[v.Code]; ok {
 				for stage, results := range drainedStages {
 					drainedDTOs[stage] = drainer.NewDrainedResultDTOs(results)
+					chanceDTOs[stage] = drainer.NewAdmissionChanceDTOs(results)
 				}
 			}
 
 			// 2. Create Payload
-			payload := core.NewUploadPayloadFromCalculator(v.VarsityCalculator, primaryResults[v.Code], drainedDTOs, v.MSUInternalIDs)
+			payload := core.NewUploadPayloadFromCalculator(v.VarsityCalculator, primaryResults[v.Code], drainedDTOs, v.InternalIDs)
+			payload.Chances = chanceDTOs
+			payload.Trace = primaryTraces[v.Code].Events()
+			payload.SourceReports = v.SourceReports
+			payload.CapacityDrifts = v.CapacityDrifts
 
 			// 3. Encode Payload
 			var payloadBuf bytes.Buffer
@@ -485,9 +619,28 @@ func (p *Producer) runProduceWorkflow(ctx context.Context, req *proto.ProduceReq
 		return errors.InternalServerError("producer.produce.rabbitmq", "failed to declare a queue: %v", err)
 	}
 
+	// Everything needed to re-run this run's drains bit-for-bit goes along with the notification
 	notification := map[string]interface{}{
-		"bucket_name":     bucketName,
-		"payload_objects": allObjectNames,
+		"bucket_name":          bucketName,
+		"payload_objects":      allObjectNames,
+		"drain_seed":           params.DrainSeed,
+		"drain_stages":         params.DrainStages,
+		"drain_iterations":     params.DrainIterations,
+		"drain_max_iterations": params.DrainMaxIterations,
+		"drain_tolerance":      params.DrainTolerance,
+		"drain_models":         drainModelNames,
+	}
+	if result.DataFile != "" {
+		notification["cache_file"] = filepath.Base(result.DataFile)
+	}
+	if result.HTTPArchiveFile != "" {
+		notification["http_archive_file"] = filepath.Base(result.HTTPArchiveFile)
+	}
+	if rawCrawl != "" {
+		notification["raw_crawl"] = rawCrawl
+	}
+	if drifted := driftedHeadings(varsities); len(drifted) > 0 {
+		notification["capacity_drifts"] = drifted
 	}
 	body, err := json.Marshal(notification)
 	if err != nil {
@@ -517,6 +670,22 @@ func (p *Producer) runProduceWorkflow(ctx context.Context, req *proto.ProduceReq
 	return nil
 }
 
+// uploadDataCache uploads the data caches of the given varsities, serialized as a cache file, to the given object.
+func uploadDataCache(minioClient *minio.Client, bucket, objectName string, varsities []*source.Varsity) error {
+	caches := make([]*source.VarsityDataCache, 0, len(varsities))
+	for _, v := range varsities {
+		if v.VarsityDataCache != nil {
+			caches = append(caches, v.VarsityDataCache)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := source.SerializeList(caches, &buf); err != nil {
+		return fmt.Errorf("failed to serialize varsity data caches: %w", err)
+	}
+	return uploadObjectWithRetry(minioClient, bucket, objectName, &buf)
+}
+
 // ensureBucketExists checks if a bucket exists and creates it if not, with retry logic.
 func ensureBucketExists(minioClient *minio.Client, bucketName string) error {
 	timeouts := []time.Duration{1 * time.Minute, 2 * time.Minute, 3 * time.Minute}
@@ -578,3 +747,17 @@ func toIntSlice(in []int32) []int {
 	}
 	return out
 }
+
+// driftedHeadings counts the headings of every varsity whose published capacities drifted from the registry,
+// for the run metadata; the varsities without drifts are left out.
+func driftedHeadings(varsities []*source.Varsity) map[string]int {
+	drifted := make(map[string]int)
+	for _, v := range varsities {
+		for _, drift := range v.CapacityDrifts {
+			if drift.Drifted() {
+				drifted[v.Code]++
+			}
+		}
+	}
+	return drifted
+}
@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"github.com/minio/minio-go/v7"
+
+	"github.com/trueegorletov/analabit/core/source/httparchive"
+)
+
+// minioBlobStore is the httparchive.BlobStore the raw documents of the crawls are archived into.
+type minioBlobStore struct {
+	client *minio.Client
+	bucket string
+}
+
+func (s *minioBlobStore) Exists(ctx context.Context, key string) (bool, error) {
+	_, err := s.client.StatObject(ctx, s.bucket, key, minio.StatObjectOptions{})
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *minioBlobStore) Put(ctx context.Context, key string, data []byte) error {
+	_, err := s.client.PutObject(ctx, s.bucket, key, bytes.NewReader(data), int64(len(data)), minio.PutObjectOptions{ContentType: "application/octet-stream"})
+	return err
+}
+
+func (s *minioBlobStore) Get(ctx context.Context, key string) ([]byte, error) {
+	obj, err := s.client.GetObject(ctx, s.bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer obj.Close()
+	return io.ReadAll(obj)
+}
+
+// restoreRawCrawl writes the raw documents archived for the given crawl into an HTTP archive file in the
+// cache directory, for the crawl to be reparsed from it, and returns the path of the file.
+func restoreRawCrawl(ctx context.Context, store httparchive.BlobStore, crawl string) (string, error) {
+	path := filepath.Join(Cfg.CacheDir, "reparse", crawl+".http.gz")
+	if err := httparchive.Restore(ctx, store, crawl, path); err != nil {
+		return "", fmt.Errorf("failed to restore raw documents of crawl %s: %w", crawl, err)
+	}
+	return path, nil
+}
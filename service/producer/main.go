@@ -3,15 +3,15 @@ package main
 import (
 	"context"
 	"log"
+	"net/http"
 	"time"
 
 	"github.com/caarlos0/env/v11"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/trueegorletov/analabit/core/metrics"
 	"github.com/trueegorletov/analabit/service/producer/handler"
 	"github.com/trueegorletov/analabit/service/producer/proto"
 	micro "go-micro.dev/v5"
-
-	// Import MSU package to trigger init() and register IDMSU client
-	_ "github.com/trueegorletov/analabit/core/source/msu"
 )
 
 // startSelfQuery contains the logic for the self-triggering mechanism.
@@ -68,6 +68,17 @@ func main() {
 		log.Fatalf("failed to parse env config: %v", err)
 	}
 
+	metrics.InitCalculationMetrics()
+	if handler.Cfg.MetricsAddr != "" {
+		go func() {
+			mux := http.NewServeMux()
+			mux.Handle("/metrics", promhttp.Handler())
+			if err := http.ListenAndServe(handler.Cfg.MetricsAddr, mux); err != nil {
+				log.Printf("ERROR: metrics endpoint stopped: %v", err)
+			}
+		}()
+	}
+
 	// The global graceful shutdown is now handled by the iteration-based cleanup.
 	// flaresolverr.InitGracefulShutdown()
 